@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+
+	"HuaTug.com/cmd/api/rpc"
+	"HuaTug.com/kitex_gen/interactions"
+	jwt "HuaTug.com/pkg"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/utils"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// EditComment 作者修改自己的评论内容
+func EditComment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var v interface{}
+	var UserId int64
+	var Comment EditCommentParam
+	if err = c.BindAndValidate(&Comment); err != nil {
+		hlog.Info(err)
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if v, err = jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		UserId = utils.Transfer(v)
+	}
+
+	resp, err := rpc.EditComment(ctx, &interactions.EditCommentRequest{
+		CommentId: Comment.CommentId,
+		UserId:    UserId,
+		Content:   Comment.Content,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
+
+// ListCommentEdits 获取评论的编辑历史
+func ListCommentEdits(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var Comment ListCommentEditsParam
+	if err = c.BindAndValidate(&Comment); err != nil {
+		hlog.Info(err)
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	resp, err := rpc.ListCommentEdits(ctx, &interactions.ListCommentEditsRequest{
+		CommentId: Comment.CommentId,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
@@ -33,6 +33,8 @@ func ListComment(ctx context.Context, c *app.RequestContext) {
 		PageNum:   Comment.PageNum,
 		PageSize:  Comment.PageSize,
 		SortType:  Comment.SortType, // Pass sort type to service layer
+		Cursor:    Comment.Cursor,
+		UseCursor: Comment.UseCursor,
 	})
 	if err != nil {
 		SendResponse(c, errno.ConvertErr(err), nil)
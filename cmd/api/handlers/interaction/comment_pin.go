@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"context"
+
+	"HuaTug.com/cmd/api/rpc"
+	"HuaTug.com/kitex_gen/interactions"
+	jwt "HuaTug.com/pkg"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/utils"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// PinComment 视频作者置顶或取消置顶评论
+func PinComment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var v interface{}
+	var UserId int64
+	var Pin PinCommentParam
+	if err = c.BindAndValidate(&Pin); err != nil {
+		hlog.Info(err)
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if v, err = jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		UserId = utils.Transfer(v)
+	}
+
+	resp, err := rpc.PinComment(ctx, &interactions.PinCommentRequest{
+		VideoId:   Pin.VideoId,
+		CommentId: Pin.CommentId,
+		UserId:    UserId,
+		Pin:       Pin.Pin,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+
+	"HuaTug.com/cmd/api/rpc"
+	"HuaTug.com/kitex_gen/interactions"
+	jwt "HuaTug.com/pkg"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/utils"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// ListCommentReviews 管理员查看评论审核队列
+func ListCommentReviews(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var v interface{}
+	var AdminId int64
+	var Review ListCommentReviewsParam
+	if err = c.BindAndValidate(&Review); err != nil {
+		hlog.Info(err)
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if v, err = jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		AdminId = utils.Transfer(v)
+	}
+
+	resp, err := rpc.ListCommentReviews(ctx, &interactions.ListCommentReviewsRequest{
+		AdminId:  AdminId,
+		Status:   Review.Status,
+		Cursor:   Review.Cursor,
+		PageSize: Review.PageSize,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
+
+// ApproveCommentReview 管理员审核通过评论，评论随即发布
+func ApproveCommentReview(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var v interface{}
+	var AdminId int64
+	var Review ApproveCommentReviewParam
+	if err = c.BindAndValidate(&Review); err != nil {
+		hlog.Info(err)
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if v, err = jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		AdminId = utils.Transfer(v)
+	}
+
+	resp, err := rpc.ApproveCommentReview(ctx, &interactions.ApproveCommentReviewRequest{
+		AdminId:  AdminId,
+		ReviewId: Review.ReviewId,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
+
+// RejectCommentReview 管理员审核拒绝评论
+func RejectCommentReview(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var v interface{}
+	var AdminId int64
+	var Review RejectCommentReviewParam
+	if err = c.BindAndValidate(&Review); err != nil {
+		hlog.Info(err)
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if v, err = jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		AdminId = utils.Transfer(v)
+	}
+
+	resp, err := rpc.RejectCommentReview(ctx, &interactions.RejectCommentReviewRequest{
+		AdminId:  AdminId,
+		ReviewId: Review.ReviewId,
+		Note:     Review.Note,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
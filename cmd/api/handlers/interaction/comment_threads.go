@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"context"
+
+	"HuaTug.com/cmd/api/rpc"
+	"HuaTug.com/kitex_gen/interactions"
+	"HuaTug.com/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// ListCommentThreads 获取视频的一级评论及每条评论最早的几条回复
+func ListCommentThreads(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var Thread ListCommentThreadsParam
+	if err = c.BindAndValidate(&Thread); err != nil {
+		hlog.Info(err)
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	resp, err := rpc.ListCommentThreads(ctx, &interactions.ListCommentThreadsRequest{
+		VideoId:    Thread.VideoId,
+		SortType:   Thread.SortType,
+		Cursor:     Thread.Cursor,
+		PageSize:   Thread.PageSize,
+		ReplyCount: Thread.ReplyCount,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
@@ -34,7 +34,72 @@ type ListCommentParam struct {
 	CommentId int64  `form:"comment_id"`
 	PageNum   int64  `form:"page_num"`
 	PageSize  int64  `form:"page_size"`
-	SortType  string `form:"sort_type"` // "hot" for popular comments, "latest" for newest comments
+	SortType  string `form:"sort_type"`  // "hot" for popular comments, "latest" for newest comments
+	Cursor    string `form:"cursor"`     // 上一页返回的next_cursor，非空时按游标翻页
+	UseCursor bool   `form:"use_cursor"` // 没有游标时请求游标分页的第一页
+}
+
+type ListCommentThreadsParam struct {
+	VideoId    int64  `form:"video_id"`
+	SortType   string `form:"sort_type"`
+	Cursor     string `form:"cursor"`
+	PageSize   int64  `form:"page_size"`
+	ReplyCount int64  `form:"reply_count"` // 每条评论内联的回复数
+}
+
+type PinCommentParam struct {
+	VideoId   int64 `form:"video_id"`
+	CommentId int64 `form:"comment_id"`
+	Pin       bool  `form:"pin"` // false时取消置顶
+}
+
+type EditCommentParam struct {
+	CommentId int64  `form:"comment_id"`
+	Content   string `form:"content"`
+}
+
+type ListCommentEditsParam struct {
+	CommentId int64 `form:"comment_id"`
+}
+
+type ListCommentReviewsParam struct {
+	Status   int64 `form:"status"`
+	Cursor   int64 `form:"cursor"`
+	PageSize int64 `form:"page_size"`
+}
+
+type ApproveCommentReviewParam struct {
+	ReviewId int64 `form:"review_id"`
+}
+
+type RejectCommentReviewParam struct {
+	ReviewId int64  `form:"review_id"`
+	Note     string `form:"note"`
+}
+
+type ReportParam struct {
+	TargetType int64  `form:"target_type"`
+	TargetId   int64  `form:"target_id"`
+	Reason     int64  `form:"reason"`
+	Detail     string `form:"detail"`
+}
+
+type ListReportCasesParam struct {
+	Status     int64 `form:"status"`
+	TargetType int64 `form:"target_type"`
+	AssigneeId int64 `form:"assignee_id"`
+	Cursor     int64 `form:"cursor"`
+	PageSize   int64 `form:"page_size"`
+}
+
+type AssignReportCaseParam struct {
+	CaseId     int64 `form:"case_id"`
+	AssigneeId int64 `form:"assignee_id"`
+}
+
+type CloseReportCaseParam struct {
+	CaseId int64  `form:"case_id"`
+	Note   string `form:"note"`
 }
 
 type DeleteCommentParam struct {
@@ -50,6 +115,9 @@ type LikeParam struct {
 }
 
 type LikeListParam struct {
-	PageNum  int64 `form:"page_num"`
-	PageSize int64 `form:"page_size"`
+	UserId    int64  `form:"user_id"` // 列表所属的用户，为0时查看自己的
+	PageNum   int64  `form:"page_num"`
+	PageSize  int64  `form:"page_size"`
+	Cursor    string `form:"cursor"`
+	UseCursor bool   `form:"use_cursor"`
 }
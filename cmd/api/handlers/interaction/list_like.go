@@ -31,10 +31,18 @@ func LikeList(ctx context.Context, c *app.RequestContext) {
 	if err := c.Bind(&Like); err != nil {
 		SendResponse(c, errno.ConvertErr(err), nil)
 	}
+	// 未指定user_id时查看自己的列表
+	ownerId := Like.UserId
+	if ownerId == 0 {
+		ownerId = UserId
+	}
 	resp, err := rpc.LikeList(ctx, &interactions.LikeListRequest{
-		UserId:   UserId,
-		PageNum:  Like.PageNum,
-		PageSize: Like.PageSize,
+		UserId:    ownerId,
+		ViewerId:  UserId,
+		PageNum:   Like.PageNum,
+		PageSize:  Like.PageSize,
+		Cursor:    Like.Cursor,
+		UseCursor: Like.UseCursor,
 	})
 	if err != nil {
 		SendResponse(c, errno.ConvertErr(err), nil)
@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"context"
+
+	"HuaTug.com/cmd/api/rpc"
+	"HuaTug.com/kitex_gen/interactions"
+	jwt "HuaTug.com/pkg"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/utils"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// Report 举报视频、评论或用户
+func Report(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var v interface{}
+	var UserId int64
+	var Report ReportParam
+	if err = c.BindAndValidate(&Report); err != nil {
+		hlog.Info(err)
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if v, err = jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		UserId = utils.Transfer(v)
+	}
+
+	resp, err := rpc.Report(ctx, &interactions.ReportRequest{
+		ReporterId: UserId,
+		TargetType: Report.TargetType,
+		TargetId:   Report.TargetId,
+		Reason:     Report.Reason,
+		Detail:     Report.Detail,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
+
+// ListReportCases 管理员查看举报工单队列
+func ListReportCases(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var v interface{}
+	var AdminId int64
+	var Case ListReportCasesParam
+	if err = c.BindAndValidate(&Case); err != nil {
+		hlog.Info(err)
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if v, err = jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		AdminId = utils.Transfer(v)
+	}
+
+	resp, err := rpc.ListReportCases(ctx, &interactions.ListReportCasesRequest{
+		AdminId:    AdminId,
+		Status:     Case.Status,
+		TargetType: Case.TargetType,
+		AssigneeId: Case.AssigneeId,
+		Cursor:     Case.Cursor,
+		PageSize:   Case.PageSize,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
+
+// AssignReportCase 把举报工单分配给管理员
+func AssignReportCase(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var v interface{}
+	var AdminId int64
+	var Case AssignReportCaseParam
+	if err = c.BindAndValidate(&Case); err != nil {
+		hlog.Info(err)
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if v, err = jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		AdminId = utils.Transfer(v)
+	}
+
+	resp, err := rpc.AssignReportCase(ctx, &interactions.AssignReportCaseRequest{
+		AdminId:    AdminId,
+		CaseId:     Case.CaseId,
+		AssigneeId: Case.AssigneeId,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
+
+// ResolveReportCase 确认举报的内容违规
+func ResolveReportCase(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var v interface{}
+	var AdminId int64
+	var Case CloseReportCaseParam
+	if err = c.BindAndValidate(&Case); err != nil {
+		hlog.Info(err)
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if v, err = jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		AdminId = utils.Transfer(v)
+	}
+
+	resp, err := rpc.ResolveReportCase(ctx, &interactions.ResolveReportCaseRequest{
+		AdminId: AdminId,
+		CaseId:  Case.CaseId,
+		Note:    Case.Note,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
+
+// DismissReportCase 驳回举报，取消自动隐藏
+func DismissReportCase(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var v interface{}
+	var AdminId int64
+	var Case CloseReportCaseParam
+	if err = c.BindAndValidate(&Case); err != nil {
+		hlog.Info(err)
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if v, err = jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		AdminId = utils.Transfer(v)
+	}
+
+	resp, err := rpc.DismissReportCase(ctx, &interactions.DismissReportCaseRequest{
+		AdminId: AdminId,
+		CaseId:  Case.CaseId,
+		Note:    Case.Note,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+
+	"HuaTug.com/cmd/api/rpc"
+	"HuaTug.com/kitex_gen/relations"
+	jwt "HuaTug.com/pkg"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/utils"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func BlockAction(ctx context.Context, c *app.RequestContext) {
+	var param BlockActionParam
+	var userId int64
+	if err := c.Bind(&param); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if v, err := jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		userId = utils.Transfer(v)
+	}
+	resp, err := rpc.BlockAction(ctx, &relations.BlockActionRequest{
+		UserId:       userId,
+		TargetUserId: param.TargetUserId,
+		ActionType:   param.ActionType,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), resp)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
+
+func BlockList(ctx context.Context, c *app.RequestContext) {
+	var param BlockListParam
+	var userId int64
+	if err := c.Bind(&param); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if v, err := jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		userId = utils.Transfer(v)
+	}
+	resp, err := rpc.BlockList(ctx, &relations.BlockListRequest{
+		UserId:    userId,
+		BlockType: param.BlockType,
+		PageNum:   param.PageNum,
+		PageSize:  param.PageSize,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), resp)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
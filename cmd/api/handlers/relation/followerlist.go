@@ -24,12 +24,20 @@ func FollowerList(ctx context.Context, c *app.RequestContext) {
 	} else {
 		userId = utils.Transfer(v)
 	}
+	// 未指定user_id时查看自己的列表
+	ownerId := relationservice.UserId
+	if ownerId == 0 {
+		ownerId = userId
+	}
 	resp := new(relations.FollowerListResponse)
 	var err error
 	resp, err = rpc.FollowerList(ctx, &relations.FollowerListRequest{
-		PageNum:  relationservice.PageNum,
-		PageSize: relationservice.PageSize,
-		UserId:   userId,
+		PageNum:   relationservice.PageNum,
+		PageSize:  relationservice.PageSize,
+		UserId:    ownerId,
+		ViewerId:  userId,
+		Cursor:    relationservice.Cursor,
+		UseCursor: relationservice.UseCursor,
 	})
 	if err != nil {
 		SendResponse(c, errno.ConvertErr(err), resp)
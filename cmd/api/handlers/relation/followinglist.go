@@ -12,7 +12,7 @@ import (
 )
 
 func FollowingList(ctx context.Context, c *app.RequestContext) {
-	var relationservice RelationPageParam
+	var relationservice FollowingListParam
 	var userId int64
 	if err := c.Bind(&relationservice); err != nil {
 		SendResponse(c, errno.ConvertErr(err), nil)
@@ -24,12 +24,21 @@ func FollowingList(ctx context.Context, c *app.RequestContext) {
 	} else {
 		userId = utils.Transfer(v)
 	}
+	// 未指定user_id时查看自己的列表
+	ownerId := relationservice.UserId
+	if ownerId == 0 {
+		ownerId = userId
+	}
 	resp := new(relations.FollowingListResponse)
 	var err error
 	resp, err = rpc.FollowingList(ctx, &relations.FollowingListRequest{
-		PageNum:  relationservice.PageNum,
-		PageSize: relationservice.PageSize,
-		UserId:   userId,
+		PageNum:    relationservice.PageNum,
+		PageSize:   relationservice.PageSize,
+		UserId:     ownerId,
+		ViewerId:   userId,
+		FollowType: relationservice.FollowType,
+		Cursor:     relationservice.Cursor,
+		UseCursor:  relationservice.UseCursor,
 	})
 	if err != nil {
 		SendResponse(c, errno.ConvertErr(err), resp)
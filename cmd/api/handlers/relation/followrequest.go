@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+
+	"HuaTug.com/cmd/api/rpc"
+	"HuaTug.com/kitex_gen/relations"
+	jwt "HuaTug.com/pkg"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/utils"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func FollowRequestList(ctx context.Context, c *app.RequestContext) {
+	var param RelationPageParam
+	var userId int64
+	if err := c.Bind(&param); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if v, err := jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		userId = utils.Transfer(v)
+	}
+	resp, err := rpc.FollowRequestList(ctx, &relations.FollowRequestListRequest{
+		PageNum:  param.PageNum,
+		PageSize: param.PageSize,
+		UserId:   userId,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), resp)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
+
+func AcceptFollowRequest(ctx context.Context, c *app.RequestContext) {
+	var param FollowRequestParam
+	var userId int64
+	if err := c.Bind(&param); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if v, err := jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		userId = utils.Transfer(v)
+	}
+	resp, err := rpc.AcceptFollowRequest(ctx, &relations.AcceptFollowRequestRequest{
+		UserId:     userId,
+		FromUserId: param.FromUserId,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), resp)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
+
+func RejectFollowRequest(ctx context.Context, c *app.RequestContext) {
+	var param FollowRequestParam
+	var userId int64
+	if err := c.Bind(&param); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if v, err := jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		userId = utils.Transfer(v)
+	}
+	resp, err := rpc.RejectFollowRequest(ctx, &relations.RejectFollowRequestRequest{
+		UserId:     userId,
+		FromUserId: param.FromUserId,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), resp)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"context"
+
+	"HuaTug.com/cmd/api/rpc"
+	"HuaTug.com/kitex_gen/relations"
+	jwt "HuaTug.com/pkg"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/utils"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func UpdateFollowType(ctx context.Context, c *app.RequestContext) {
+	var param FollowTypeParam
+	var userId int64
+	if err := c.Bind(&param); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if v, err := jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		userId = utils.Transfer(v)
+	}
+	resp, err := rpc.UpdateFollowType(ctx, &relations.UpdateFollowTypeRequest{
+		UserId:       userId,
+		TargetUserId: param.TargetUserId,
+		FollowType:   param.FollowType,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), resp)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
+
+func SetFollowRemark(ctx context.Context, c *app.RequestContext) {
+	var param FollowRemarkParam
+	var userId int64
+	if err := c.Bind(&param); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if v, err := jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		userId = utils.Transfer(v)
+	}
+	resp, err := rpc.SetFollowRemark(ctx, &relations.SetFollowRemarkRequest{
+		UserId:       userId,
+		TargetUserId: param.TargetUserId,
+		Remark:       param.Remark,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), resp)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
@@ -29,7 +29,43 @@ type RelationParam struct {
 }
 
 type RelationPageParam struct {
-	PageNum  int64 `form:"page_num"`
-	PageSize int64 `form:"page_size"`
-	UserId   int64 `form:"user_id"`
+	PageNum   int64  `form:"page_num"`
+	PageSize  int64  `form:"page_size"`
+	UserId    int64  `form:"user_id"`
+	Cursor    string `form:"cursor"`
+	UseCursor bool   `form:"use_cursor"`
+}
+
+type FollowingListParam struct {
+	PageNum    int64  `form:"page_num"`
+	PageSize   int64  `form:"page_size"`
+	UserId     int64  `form:"user_id"`
+	FollowType int64  `form:"follow_type"`
+	Cursor     string `form:"cursor"`
+	UseCursor  bool   `form:"use_cursor"`
+}
+
+type FollowRequestParam struct {
+	FromUserId int64 `form:"from_user_id"`
+}
+
+type BlockActionParam struct {
+	TargetUserId int64 `form:"target_user_id"`
+	ActionType   int64 `form:"action_type"`
+}
+
+type FollowTypeParam struct {
+	TargetUserId int64 `form:"target_user_id"`
+	FollowType   int64 `form:"follow_type"`
+}
+
+type FollowRemarkParam struct {
+	TargetUserId int64  `form:"target_user_id"`
+	Remark       string `form:"remark"`
+}
+
+type BlockListParam struct {
+	BlockType int64 `form:"block_type"`
+	PageNum   int64 `form:"page_num"`
+	PageSize  int64 `form:"page_size"`
 }
@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"context"
+
+	"HuaTug.com/cmd/api/rpc"
+	"HuaTug.com/kitex_gen/relations"
+	jwt "HuaTug.com/pkg"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/utils"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func FollowSuggestion(ctx context.Context, c *app.RequestContext) {
+	var param RelationPageParam
+	var userId int64
+	if err := c.Bind(&param); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if v, err := jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		userId = utils.Transfer(v)
+	}
+	resp, err := rpc.FollowSuggestion(ctx, &relations.FollowSuggestionRequest{
+		UserId:   userId,
+		PageNum:  param.PageNum,
+		PageSize: param.PageSize,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), resp)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
@@ -70,3 +70,17 @@ type VerifyCodeParam struct {
 	Email string `form:"email"`
 	Code  string `form:"code"`
 }
+
+// ProfileParam 请求中没有出现的字段为nil，不修改对应的资料
+type ProfileParam struct {
+	Bio      *string `form:"bio"`
+	Website  *string `form:"website"`
+	Birthday *string `form:"birthday"`
+}
+
+// PrivacyParam 请求中没有出现的设置为nil，保持原值
+type PrivacyParam struct {
+	IsPrivate     *bool `form:"is_private"`
+	HideLikes     *bool `form:"hide_likes"`
+	HideFollowing *bool `form:"hide_following"`
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"context"
+	"io"
+
+	"HuaTug.com/cmd/api/rpc"
+	"HuaTug.com/kitex_gen/users"
+	jwt "HuaTug.com/pkg"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/utils"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func UpdateProfile(ctx context.Context, c *app.RequestContext) {
+	v, err := jwt.ConvertJWTPayloadToString(ctx, c)
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	userId := utils.Transfer(v)
+
+	var profile ProfileParam
+	if err := c.Bind(&profile); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	resp, err := rpc.UpdateProfile(ctx, &users.UpdateProfileRequest{
+		UserId:   userId,
+		Bio:      profile.Bio,
+		Website:  profile.Website,
+		Birthday: profile.Birthday,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
+
+func UploadBackground(ctx context.Context, c *app.RequestContext) {
+	v, err := jwt.ConvertJWTPayloadToString(ctx, c)
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	userId := utils.Transfer(v)
+
+	// 获取上传的文件
+	uploadData, err := c.FormFile("file")
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	file, err := uploadData.Open()
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	defer file.Close()
+	fileContent, err := io.ReadAll(file)
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	resp, err := rpc.UploadBackground(ctx, &users.UploadBackgroundRequest{
+		UserId:   userId,
+		Data:     fileContent,
+		Filesize: uploadData.Size,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
+
+func UpdatePrivacySettings(ctx context.Context, c *app.RequestContext) {
+	v, err := jwt.ConvertJWTPayloadToString(ctx, c)
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	userId := utils.Transfer(v)
+
+	var privacy PrivacyParam
+	if err := c.Bind(&privacy); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	resp, err := rpc.UpdatePrivacySettings(ctx, &users.UpdatePrivacySettingsRequest{
+		UserId:        userId,
+		IsPrivate:     privacy.IsPrivate,
+		HideLikes:     privacy.HideLikes,
+		HideFollowing: privacy.HideFollowing,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
@@ -30,12 +30,20 @@ func GetFavoriteVideoList(ctx context.Context, c *app.RequestContext) {
 		UserId = utils.Transfer(v)
 	}
 
+	// 未指定user_id时查看自己的收藏夹
+	ownerId := GetFavoriteVideo.UserId
+	if ownerId == 0 {
+		ownerId = UserId
+	}
 	resp, err := rpc.GetFavoriteVideoList(ctx, &videos.GetFavoriteVideoListRequestV2{
-		UserId:     UserId,
+		UserId:     ownerId,
+		ViewerId:   UserId,
 		FavoriteId: GetFavoriteVideo.FavoriteId,
 		PageNum:    GetFavoriteVideo.PageNum,
 		PageSize:   GetFavoriteVideo.PageSize,
 		SortBy:     "created_at DESC", // 添加默认值
+		Cursor:     GetFavoriteVideo.Cursor,
+		UseCursor:  GetFavoriteVideo.UseCursor,
 	})
 	if err != nil {
 		SendResponse(c, errno.ConvertErr(err), nil)
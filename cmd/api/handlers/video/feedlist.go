@@ -5,7 +5,9 @@ import (
 
 	"HuaTug.com/cmd/api/rpc"
 	"HuaTug.com/kitex_gen/videos"
+	jwt "HuaTug.com/pkg"
 	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/utils"
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 )
@@ -13,16 +15,22 @@ import (
 func FeedService(ctx context.Context, c *app.RequestContext) {
 	var err error
 	var FeedList FeedListParam
+	var v interface{}
+	var UserId int64
 	if err = c.Bind(&FeedList); err != nil {
 		hlog.Info(err)
 		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if v, err = jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		UserId = utils.Transfer(v)
 	}
-	resp, err := rpc.FeedList(ctx, &videos.VideoFeedListRequestV2{
-		PageNum:        1,
-		PageSize:       20,
-		CategoryFilter: "",
-		PrivacyFilter:  "public",
-		TagFilters:     []string{},
+	resp, err := rpc.ForYouFeed(ctx, &videos.ForYouFeedRequest{
+		UserId: UserId,
+		Count:  FeedList.Count,
 	})
 	if err != nil {
 		SendResponse(c, errno.ConvertErr(err), nil)
@@ -31,13 +31,15 @@ type UpLoadvideoParam struct {
 }
 
 type FeedListParam struct {
-	LastTime string `json:"last_time" form:"last_time"`
+	Count int32 `json:"count" form:"count"`
 }
 
 type VideoFeedListParam struct {
-	AuthorId int64 `form:"author_id" `
-	PageNum  int64 `form:"page_num"`
-	PageSize int64 `form:"page_size"`
+	AuthorId  int64  `form:"author_id" `
+	PageNum   int64  `form:"page_num"`
+	PageSize  int64  `form:"page_size"`
+	Cursor    string `form:"cursor"`
+	UseCursor bool   `form:"use_cursor"`
 }
 
 type VideoSearchParam struct {
@@ -48,6 +50,13 @@ type VideoSearchParam struct {
 	ToDate   string `form:"to_date"`
 }
 
+type VideoPopularParam struct {
+	PageNum   int64  `form:"page_num"`
+	PageSize  int64  `form:"page_size"`
+	TimeRange string `form:"time_range"`
+	Category  string `form:"category"`
+}
+
 type VideoPublishStartParam struct {
 	Title            string `form:"title"`
 	Description      string `form:"description"`
@@ -73,6 +82,22 @@ type VideoPublishCancleParam struct {
 	Uuid string `form:"uuid"`
 }
 
+type RecommendVideoParam struct {
+	Count         int32  `form:"count"`
+	Categories    string `form:"categories"` // 逗号分隔，为空时不限分类
+	AlgorithmType string `form:"algorithm_type"`
+}
+
+type RelatedVideosParam struct {
+	VideoId int64 `form:"video_id"`
+	Count   int32 `form:"count"`
+}
+
+type FollowingTimelineParam struct {
+	Cursor string `form:"cursor"`
+	Count  int32  `form:"count"`
+}
+
 type VideoDeleteParam struct {
 	VideoId int64 `form:"video_id"`
 }
@@ -99,9 +124,12 @@ type AddFavoriteVideoParam struct {
 }
 
 type GetFavoriteVideoListParam struct {
-	FavoriteId int64 `form:"favorite_id"`
-	PageNum    int64 `form:"page_num"`
-	PageSize   int64 `form:"page_size"`
+	UserId     int64  `form:"user_id"` // 收藏夹所属的用户，为0时查看自己的
+	FavoriteId int64  `form:"favorite_id"`
+	PageNum    int64  `form:"page_num"`
+	PageSize   int64  `form:"page_size"`
+	Cursor     string `form:"cursor"`
+	UseCursor  bool   `form:"use_cursor"`
 }
 
 type GetFavoriteVideoByIdParam struct {
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"strings"
 
 	"HuaTug.com/cmd/api/rpc"
 	"HuaTug.com/kitex_gen/videos"
@@ -9,13 +10,20 @@ import (
 	"HuaTug.com/pkg/errno"
 	"HuaTug.com/pkg/utils"
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
 )
 
 func RecommendVideo(ctx context.Context, c *app.RequestContext) {
+	var RecommendVideo RecommendVideoParam
 	var err error
 	var v interface{}
 	var UserId int64
 
+	if err = c.BindAndValidate(&RecommendVideo); err != nil {
+		hlog.Info(err)
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
 	if v, err = jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
 		SendResponse(c, errno.ConvertErr(err), nil)
 		return
@@ -25,9 +33,9 @@ func RecommendVideo(ctx context.Context, c *app.RequestContext) {
 
 	resp, err := rpc.RecommendVideo(ctx, &videos.RecommendVideoRequestV2{
 		UserId:        UserId,
-		Count:         10,
-		Categories:    []string{},
-		AlgorithmType: "default",
+		Count:         RecommendVideo.Count,
+		Categories:    strings.Split(RecommendVideo.Categories, ","),
+		AlgorithmType: RecommendVideo.AlgorithmType,
 	})
 	if err != nil {
 		SendResponse(c, errno.ConvertErr(err), nil)
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"context"
+
+	"HuaTug.com/cmd/api/rpc"
+	"HuaTug.com/kitex_gen/videos"
+	jwt "HuaTug.com/pkg"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/utils"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+func RelatedVideos(ctx context.Context, c *app.RequestContext) {
+	var RelatedVideos RelatedVideosParam
+	var err error
+	var v interface{}
+	var UserId int64
+
+	if err = c.BindAndValidate(&RelatedVideos); err != nil {
+		hlog.Info(err)
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if v, err = jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		UserId = utils.Transfer(v)
+	}
+
+	resp, err := rpc.RelatedVideos(ctx, &videos.RelatedVideosRequest{
+		VideoId: RelatedVideos.VideoId,
+		UserId:  UserId,
+		Count:   RelatedVideos.Count,
+	})
+	if err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	SendResponse(c, errno.Success, resp)
+}
@@ -5,7 +5,9 @@ import (
 
 	"HuaTug.com/cmd/api/rpc"
 	"HuaTug.com/kitex_gen/videos"
+	jwt "HuaTug.com/pkg"
 	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/utils"
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 )
@@ -13,15 +15,26 @@ import (
 func VideoFeedList(ctx context.Context, c *app.RequestContext) {
 	var VideoList VideoFeedListParam
 	var err error
+	var v interface{}
+	var UserId int64
 	if err = c.Bind(&VideoList); err != nil {
 		hlog.Info(err)
 		SendResponse(c, errno.ConvertErr(err), nil)
 	}
+	if v, err = jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		UserId = utils.Transfer(v)
+	}
 	hlog.Info(VideoList.AuthorId)
 	resp, err := rpc.VideoFeedList(ctx, &videos.VideoFeedListRequestV2{
-		UserId:   VideoList.AuthorId,
-		PageNum:  VideoList.PageNum,
-		PageSize: VideoList.PageSize,
+		UserId:    VideoList.AuthorId,
+		PageNum:   VideoList.PageNum,
+		PageSize:  VideoList.PageSize,
+		ViewerId:  UserId,
+		Cursor:    VideoList.Cursor,
+		UseCursor: VideoList.UseCursor,
 	})
 	if err != nil {
 		SendResponse(c, errno.ConvertErr(err), nil)
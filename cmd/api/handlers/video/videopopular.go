@@ -5,12 +5,32 @@ import (
 
 	"HuaTug.com/cmd/api/rpc"
 	"HuaTug.com/kitex_gen/videos"
+	jwt "HuaTug.com/pkg"
 	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/utils"
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
 )
 
 func VideoPopular(ctx context.Context, c *app.RequestContext) {
-	resp, err := rpc.VideoPopular(ctx, &videos.VideoPopularRequestV2{})
+	var VideoPopular VideoPopularParam
+	if err := c.BindAndValidate(&VideoPopular); err != nil {
+		hlog.Info(err)
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	// 热门榜单可以匿名访问，未登录时不按拉黑关系过滤
+	var viewerId int64
+	if v, err := jwt.ConvertJWTPayloadToString(ctx, c); err == nil {
+		viewerId = utils.Transfer(v)
+	}
+	resp, err := rpc.VideoPopular(ctx, &videos.VideoPopularRequestV2{
+		PageNum:   VideoPopular.PageNum,
+		PageSize:  VideoPopular.PageSize,
+		TimeRange: VideoPopular.TimeRange,
+		Category:  VideoPopular.Category,
+		ViewerId:  viewerId,
+	})
 	if err != nil {
 		SendResponse(c, errno.ConvertErr(err), nil)
 		return
@@ -5,22 +5,33 @@ import (
 
 	"HuaTug.com/cmd/api/rpc"
 	"HuaTug.com/kitex_gen/videos"
+	jwt "HuaTug.com/pkg"
 	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/utils"
 	"github.com/cloudwego/hertz/pkg/app"
 )
 
 func VideoSearch(ctx context.Context, c *app.RequestContext) {
 	var Serach VideoSearchParam
 	var err error
+	var v interface{}
+	var UserId int64
 	if err = c.Bind(&Serach); err != nil {
 		SendResponse(c, errno.ConvertErr(err), nil)
 	}
+	if v, err = jwt.ConvertJWTPayloadToString(ctx, c); err != nil {
+		SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	} else {
+		UserId = utils.Transfer(v)
+	}
 	resp, err := rpc.VideoSearch(ctx, &videos.VideoSearchRequestV2{
 		Keyword:  Serach.Keyword,
 		PageNum:  Serach.PageNum,
 		PageSize: Serach.PageSize,
 		FromDate: Serach.FromDate,
 		ToDate:   Serach.ToDate,
+		ViewerId: UserId,
 	})
 	if err != nil {
 		SendResponse(c, errno.ConvertErr(err), nil)
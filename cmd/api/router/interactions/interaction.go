@@ -27,8 +27,27 @@ func Register(r *server.Hertz) {
 		{
 			_comment := _v1.Group("/comment", _commentMw()...)
 			_comment.DELETE("/delete", append(_deletecommentMw(), interactions.DeleteComment)...)
+			_comment.POST("/edit", append(_editcommentMw(), interactions.EditComment)...)
+			_comment.GET("/edits", append(_listcommenteditsMw(), interactions.ListCommentEdits)...)
 			_comment.GET("/list", append(_listcommentMw(), interactions.ListComment)...)
+			_comment.POST("/pin", append(_pincommentMw(), interactions.PinComment)...)
 			_comment.POST("/publish", append(_createcommentMw(), interactions.CreateComment)...)
+			_comment.GET("/reviews", append(_listcommentreviewsMw(), interactions.ListCommentReviews)...)
+			_comment.GET("/threads", append(_listcommentthreadsMw(), interactions.ListCommentThreads)...)
+			{
+				_review := _comment.Group("/review", _reviewMw()...)
+				_review.POST("/approve", append(_approvecommentreviewMw(), interactions.ApproveCommentReview)...)
+				_review.POST("/reject", append(_rejectcommentreviewMw(), interactions.RejectCommentReview)...)
+			}
+		}
+		_v1.POST("/report", append(_reportMw(), interactions.Report)...)
+		_report := _v1.Group("/report", _report0Mw()...)
+		_report.GET("/cases", append(_listreportcasesMw(), interactions.ListReportCases)...)
+		{
+			_case := _report.Group("/case", _caseMw()...)
+			_case.POST("/assign", append(_assignreportcaseMw(), interactions.AssignReportCase)...)
+			_case.POST("/dismiss", append(_dismissreportcaseMw(), interactions.DismissReportCase)...)
+			_case.POST("/resolve", append(_resolvereportcaseMw(), interactions.ResolveReportCase)...)
 		}
 	}
 }
@@ -51,3 +51,78 @@ func _createcommentMw() []app.HandlerFunc {
 	// your code...
 	return authfunc.Auth()
 }
+
+func _listcommentthreadsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _pincommentMw() []app.HandlerFunc {
+	// your code...
+	return authfunc.Auth()
+}
+
+func _editcommentMw() []app.HandlerFunc {
+	// your code...
+	return authfunc.Auth()
+}
+
+func _listcommenteditsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listcommentreviewsMw() []app.HandlerFunc {
+	// your code...
+	return authfunc.Auth()
+}
+
+func _reviewMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _approvecommentreviewMw() []app.HandlerFunc {
+	// your code...
+	return authfunc.Auth()
+}
+
+func _rejectcommentreviewMw() []app.HandlerFunc {
+	// your code...
+	return authfunc.Auth()
+}
+
+func _reportMw() []app.HandlerFunc {
+	// your code...
+	return authfunc.Auth()
+}
+
+func _report0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listreportcasesMw() []app.HandlerFunc {
+	// your code...
+	return authfunc.Auth()
+}
+
+func _caseMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _assignreportcaseMw() []app.HandlerFunc {
+	// your code...
+	return authfunc.Auth()
+}
+
+func _dismissreportcaseMw() []app.HandlerFunc {
+	// your code...
+	return authfunc.Auth()
+}
+
+func _resolvereportcaseMw() []app.HandlerFunc {
+	// your code...
+	return authfunc.Auth()
+}
@@ -55,3 +55,58 @@ func _relationserviceMw() []app.HandlerFunc {
 	// your code...
 	return authfunc.Auth()
 }
+
+func _followrequestMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _followrequestlistMw() []app.HandlerFunc {
+	// your code...
+	return authfunc.Auth()
+}
+
+func _acceptfollowrequestMw() []app.HandlerFunc {
+	// your code...
+	return authfunc.Auth()
+}
+
+func _rejectfollowrequestMw() []app.HandlerFunc {
+	// your code...
+	return authfunc.Auth()
+}
+
+func _blockMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _blockactionMw() []app.HandlerFunc {
+	// your code...
+	return authfunc.Auth()
+}
+
+func _blocklistMw() []app.HandlerFunc {
+	// your code...
+	return authfunc.Auth()
+}
+
+func _followMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatefollowtypeMw() []app.HandlerFunc {
+	// your code...
+	return authfunc.Auth()
+}
+
+func _setfollowremarkMw() []app.HandlerFunc {
+	// your code...
+	return authfunc.Auth()
+}
+
+func _followsuggestionMw() []app.HandlerFunc {
+	// your code...
+	return authfunc.Auth()
+}
@@ -34,6 +34,23 @@ func Register(r *server.Hertz) {
 		{
 			_relation := _v1.Group("/relation", _relationMw()...)
 			_relation.POST("/action", append(_relationserviceMw(), relations.RelationService)...)
+			_relation.GET("/suggestion", append(_followsuggestionMw(), relations.FollowSuggestion)...)
+			{
+				_request := _relation.Group("/request", _followrequestMw()...)
+				_request.GET("/list", append(_followrequestlistMw(), relations.FollowRequestList)...)
+				_request.POST("/accept", append(_acceptfollowrequestMw(), relations.AcceptFollowRequest)...)
+				_request.POST("/reject", append(_rejectfollowrequestMw(), relations.RejectFollowRequest)...)
+			}
+			{
+				_block := _relation.Group("/block", _blockMw()...)
+				_block.POST("/action", append(_blockactionMw(), relations.BlockAction)...)
+				_block.GET("/list", append(_blocklistMw(), relations.BlockList)...)
+			}
+			{
+				_follow := _relation.Group("/follow", _followMw()...)
+				_follow.POST("/type", append(_updatefollowtypeMw(), relations.UpdateFollowType)...)
+				_follow.POST("/remark", append(_setfollowremarkMw(), relations.SetFollowRemark)...)
+			}
 		}
 	}
 }
@@ -76,3 +76,18 @@ func _queryuserMw() []app.HandlerFunc {
 	// your code...
 	return authfunc.Auth()
 }
+
+func _updateprofileMw() []app.HandlerFunc {
+	// your code...
+	return authfunc.Auth()
+}
+
+func _uploadbackgroundMw() []app.HandlerFunc {
+	// your code...
+	return authfunc.Auth()
+}
+
+func _updateprivacyMw() []app.HandlerFunc {
+	// your code...
+	return authfunc.Auth()
+}
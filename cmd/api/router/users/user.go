@@ -28,6 +28,9 @@ func Register(r *server.Hertz) {
 			_user.POST("/check",append(_checkUserExistsByIdMv(),users.CheckUserExistsById)...)
 			_user.POST("/sendcode", append(_sendcodeMw(), users.SendCode)...)
 			_user.POST("/verifycode", append(_verifycodeMw(), users.VerifyCode)...)
+			_user.POST("/profile/update", append(_updateprofileMw(), users.UpdateProfile)...)
+			_user.POST("/background/upload", append(_uploadbackgroundMw(), users.UploadBackground)...)
+			_user.POST("/privacy/update", append(_updateprivacyMw(), users.UpdatePrivacySettings)...)
 			{
 				_create := _user.Group("/create", _createMw()...)
 				_create.POST("/", append(_createuserMw(), users.CreateUser)...)
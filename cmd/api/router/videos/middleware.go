@@ -141,6 +141,14 @@ func _videopopularMw() []app.HandlerFunc {
 	return authfunc.Auth()
 }
 
+func _relatedvideosMw() []app.HandlerFunc {
+	return authfunc.Auth()
+}
+
+func _followingtimelineMw() []app.HandlerFunc {
+	return authfunc.Auth()
+}
+
 func _videosearchMw() []app.HandlerFunc {
 	// your code...
 	return authfunc.Auth()
@@ -75,9 +75,13 @@ func Register(r *server.Hertz) {
 
 		{
 			_video := _v2.Group("/video", _videoMw()...)
+			// 个性化推荐流，/feed为旧路径
 			_video.GET("/feed", append(_feedserviceMw(), videos.FeedService)...)
+			_video.GET("/foryou", append(_feedserviceMw(), videos.FeedService)...)
+			_video.GET("/following", append(_followingtimelineMw(), videos.FollowingTimeline)...)
 			_video.GET("/list", append(_videofeedlistMw(), videos.VideoFeedList)...)
 			_video.GET("/popular", append(_videopopularMw(), videos.VideoPopular)...)
+			_video.GET("/related", append(_relatedvideosMw(), videos.RelatedVideos)...)
 			_video.POST("/search", append(_videosearchMw(), videos.VideoSearch)...)
 			_video.DELETE("/delete", append(_videodeleteMw(), videos.VideoDelete)...)
 		}
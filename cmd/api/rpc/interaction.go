@@ -59,6 +59,102 @@ func ListComment(ctx context.Context, req *interactions.ListCommentRequest) (res
 	return resp, nil
 }
 
+func ListCommentThreads(ctx context.Context, req *interactions.ListCommentThreadsRequest) (resp *interactions.ListCommentThreadsResponse, err error) {
+	resp, err = InteractionClient.ListCommentThreads(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func PinComment(ctx context.Context, req *interactions.PinCommentRequest) (resp *interactions.PinCommentResponse, err error) {
+	resp, err = InteractionClient.PinComment(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func EditComment(ctx context.Context, req *interactions.EditCommentRequest) (resp *interactions.EditCommentResponse, err error) {
+	resp, err = InteractionClient.EditComment(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func ListCommentEdits(ctx context.Context, req *interactions.ListCommentEditsRequest) (resp *interactions.ListCommentEditsResponse, err error) {
+	resp, err = InteractionClient.ListCommentEdits(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func ListCommentReviews(ctx context.Context, req *interactions.ListCommentReviewsRequest) (resp *interactions.ListCommentReviewsResponse, err error) {
+	resp, err = InteractionClient.ListCommentReviews(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func ApproveCommentReview(ctx context.Context, req *interactions.ApproveCommentReviewRequest) (resp *interactions.ApproveCommentReviewResponse, err error) {
+	resp, err = InteractionClient.ApproveCommentReview(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func RejectCommentReview(ctx context.Context, req *interactions.RejectCommentReviewRequest) (resp *interactions.RejectCommentReviewResponse, err error) {
+	resp, err = InteractionClient.RejectCommentReview(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func Report(ctx context.Context, req *interactions.ReportRequest) (resp *interactions.ReportResponse, err error) {
+	resp, err = InteractionClient.Report(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func ListReportCases(ctx context.Context, req *interactions.ListReportCasesRequest) (resp *interactions.ListReportCasesResponse, err error) {
+	resp, err = InteractionClient.ListReportCases(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func AssignReportCase(ctx context.Context, req *interactions.AssignReportCaseRequest) (resp *interactions.AssignReportCaseResponse, err error) {
+	resp, err = InteractionClient.AssignReportCase(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func ResolveReportCase(ctx context.Context, req *interactions.ResolveReportCaseRequest) (resp *interactions.ResolveReportCaseResponse, err error) {
+	resp, err = InteractionClient.ResolveReportCase(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func DismissReportCase(ctx context.Context, req *interactions.DismissReportCaseRequest) (resp *interactions.DismissReportCaseResponse, err error) {
+	resp, err = InteractionClient.DismissReportCase(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
 func DeleteComment(ctx context.Context, req *interactions.CommentDeleteRequest) (resp *interactions.CommentDeleteResponse, err error) {
 	resp, err = InteractionClient.DeleteComment(ctx, req)
 	if err != nil {
@@ -84,3 +84,67 @@ func FriendList(ctx context.Context, req *relations.FriendListRequest) (resp *re
 	}
 	return resp, nil
 }
+
+func FollowRequestList(ctx context.Context, req *relations.FollowRequestListRequest) (resp *relations.FollowRequestListResponse, err error) {
+	resp, err = relationClient.FollowRequestList(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func AcceptFollowRequest(ctx context.Context, req *relations.AcceptFollowRequestRequest) (resp *relations.AcceptFollowRequestResponse, err error) {
+	resp, err = relationClient.AcceptFollowRequest(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func RejectFollowRequest(ctx context.Context, req *relations.RejectFollowRequestRequest) (resp *relations.RejectFollowRequestResponse, err error) {
+	resp, err = relationClient.RejectFollowRequest(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func BlockAction(ctx context.Context, req *relations.BlockActionRequest) (resp *relations.BlockActionResponse, err error) {
+	resp, err = relationClient.BlockAction(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func BlockList(ctx context.Context, req *relations.BlockListRequest) (resp *relations.BlockListResponse, err error) {
+	resp, err = relationClient.BlockList(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func UpdateFollowType(ctx context.Context, req *relations.UpdateFollowTypeRequest) (resp *relations.UpdateFollowTypeResponse, err error) {
+	resp, err = relationClient.UpdateFollowType(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func SetFollowRemark(ctx context.Context, req *relations.SetFollowRemarkRequest) (resp *relations.SetFollowRemarkResponse, err error) {
+	resp, err = relationClient.SetFollowRemark(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func FollowSuggestion(ctx context.Context, req *relations.FollowSuggestionRequest) (resp *relations.FollowSuggestionResponse, err error) {
+	resp, err = relationClient.FollowSuggestion(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
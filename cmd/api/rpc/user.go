@@ -118,3 +118,27 @@ func SendCode(ctx context.Context, req *users.SendCodeRequest) (resp *users.Send
 	}
 	return resp, nil
 }
+
+func UpdateProfile(ctx context.Context, req *users.UpdateProfileRequest) (resp *users.UpdateProfileResponse, err error) {
+	resp, err = UserClient.UpdateProfile(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func UploadBackground(ctx context.Context, req *users.UploadBackgroundRequest) (resp *users.UploadBackgroundResponse, err error) {
+	resp, err = UserClient.UploadBackground(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func UpdatePrivacySettings(ctx context.Context, req *users.UpdatePrivacySettingsRequest) (resp *users.UpdatePrivacySettingsResponse, err error) {
+	resp, err = UserClient.UpdatePrivacySettings(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
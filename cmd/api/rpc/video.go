@@ -280,6 +280,30 @@ func RecommendVideo(ctx context.Context, req *videos.RecommendVideoRequestV2) (r
 	return resp, err
 }
 
+func RelatedVideos(ctx context.Context, req *videos.RelatedVideosRequest) (resp *videos.RelatedVideosResponse, err error) {
+	resp, err = VideoClient.RelatedVideos(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, err
+}
+
+func ForYouFeed(ctx context.Context, req *videos.ForYouFeedRequest) (resp *videos.ForYouFeedResponse, err error) {
+	resp, err = VideoClient.ForYouFeed(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, err
+}
+
+func FollowingTimeline(ctx context.Context, req *videos.FollowingTimelineRequest) (resp *videos.FollowingTimelineResponse, err error) {
+	resp, err = VideoClient.FollowingTimeline(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, err
+}
+
 // ========== V2版本RPC方法 ==========
 
 func VideoPublishStartV2(ctx context.Context, req *videos.VideoPublishStartRequestV2) (resp *videos.VideoPublishStartResponseV2, err error) {
@@ -0,0 +1,199 @@
+// dlq 消息队列死信管理工具，通过interaction服务的RPC查看、重放和清除处理失败的消息
+//
+// 典型流程：
+//
+//	dlq queues                                          查看各消费队列的死信积压
+//	dlq list -queue like_event_queue -limit 20          列出死信消息及失败原因
+//	dlq show -queue like_event_queue -id <message_id>   查看单条死信消息的完整内容
+//	dlq replay -queue like_event_queue [-ids a,b]       修复问题后把死信重新投递到原队列
+//	dlq purge -queue like_event_queue [-ids a,b]        丢弃确认无需处理的死信
+//
+// replay和purge不指定-ids时作用于队列中的全部死信。
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"HuaTug.com/config"
+	"HuaTug.com/kitex_gen/base"
+	"HuaTug.com/kitex_gen/interactions"
+	"HuaTug.com/kitex_gen/interactions/interactionservice"
+	"github.com/cloudwego/kitex/client"
+	etcd "github.com/kitex-contrib/registry-etcd"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, `Usage: dlq <command> [options]
+
+Commands:
+  queues   show the number of dead-lettered messages of every consumer queue
+  list     list dead-lettered messages of a queue (-queue, optional -limit)
+  show     print one dead-lettered message including its body (-queue, -id)
+  replay   move dead-lettered messages back to their queue (-queue, optional -ids)
+  purge    drop dead-lettered messages (-queue, optional -ids)
+`)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	command := os.Args[1]
+
+	fs := flag.NewFlagSet(command, flag.ExitOnError)
+	queue := fs.String("queue", "", "consumer queue, e.g. like_event_queue")
+	limit := fs.Int64("limit", 20, "list: maximum number of messages, 0 lists all")
+	id := fs.String("id", "", "show: message id")
+	ids := fs.String("ids", "", "replay/purge: comma separated message ids, defaults to all messages")
+	timeout := fs.Duration("timeout", 30*time.Second, "rpc timeout")
+	fs.Parse(os.Args[2:])
+
+	if command != "queues" && *queue == "" {
+		usage()
+		os.Exit(2)
+	}
+
+	config.Init()
+	cli, err := newClient(*timeout)
+	if err != nil {
+		fail(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	switch command {
+	case "queues":
+		err = queues(ctx, cli)
+	case "list":
+		err = list(ctx, cli, *queue, *limit)
+	case "show":
+		err = show(ctx, cli, *queue, *id)
+	case "replay":
+		err = replay(ctx, cli, *queue, splitIDs(*ids))
+	case "purge":
+		err = purge(ctx, cli, *queue, splitIDs(*ids))
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fail(err)
+	}
+}
+
+func newClient(timeout time.Duration) (interactionservice.Client, error) {
+	r, err := etcd.NewEtcdResolver([]string{config.ConfigInfo.Etcd.Addr})
+	if err != nil {
+		return nil, err
+	}
+	// 重放和清除不是幂等操作，不启用失败重试
+	return interactionservice.NewClient(
+		"Interaction",
+		client.WithRPCTimeout(timeout),
+		client.WithResolver(r),
+	)
+}
+
+func queues(ctx context.Context, cli interactionservice.Client) error {
+	resp, err := cli.ListDeadLetterQueues(ctx, &interactions.ListDeadLetterQueuesRequest{})
+	if err := check(resp.GetBase(), err); err != nil {
+		return err
+	}
+	fmt.Printf("%-32s %-36s %s\n", "QUEUE", "DEAD LETTER QUEUE", "MESSAGES")
+	for _, q := range resp.Queues {
+		fmt.Printf("%-32s %-36s %d\n", q.Queue, q.DeadLetterQueue, q.MessageCount)
+	}
+	return nil
+}
+
+func list(ctx context.Context, cli interactionservice.Client, queue string, limit int64) error {
+	resp, err := cli.ListDeadLetters(ctx, &interactions.ListDeadLettersRequest{Queue: queue, Limit: limit})
+	if err := check(resp.GetBase(), err); err != nil {
+		return err
+	}
+	fmt.Printf("%-36s %-8s %-19s %s\n", "MESSAGE ID", "ATTEMPTS", "DEAD LETTERED AT", "LAST ERROR")
+	for _, m := range resp.Messages {
+		fmt.Printf("%-36s %-8d %-19s %s\n", m.MessageId, m.Attempts, formatUnix(m.DeadLetteredAt), m.LastError)
+	}
+	return nil
+}
+
+func show(ctx context.Context, cli interactionservice.Client, queue, id string) error {
+	if id == "" {
+		return fmt.Errorf("-id is required")
+	}
+	resp, err := cli.GetDeadLetter(ctx, &interactions.GetDeadLetterRequest{Queue: queue, MessageId: id})
+	if err := check(resp.GetBase(), err); err != nil {
+		return err
+	}
+	m := resp.Message
+	fmt.Printf("message id:       %s\n", m.MessageId)
+	fmt.Printf("queue:            %s\n", m.Queue)
+	fmt.Printf("attempts:         %d\n", m.Attempts)
+	fmt.Printf("published at:     %s\n", formatUnix(m.PublishedAt))
+	fmt.Printf("dead lettered at: %s\n", formatUnix(m.DeadLetteredAt))
+	fmt.Printf("last error:       %s\n", m.LastError)
+	fmt.Printf("body:\n%s\n", m.Body)
+	return nil
+}
+
+func replay(ctx context.Context, cli interactionservice.Client, queue string, ids []string) error {
+	resp, err := cli.ReplayDeadLetters(ctx, &interactions.ReplayDeadLettersRequest{Queue: queue, MessageIds: ids})
+	if resp != nil {
+		fmt.Printf("replayed %d messages to %s\n", resp.ReplayedCount, queue)
+	}
+	return check(resp.GetBase(), err)
+}
+
+func purge(ctx context.Context, cli interactionservice.Client, queue string, ids []string) error {
+	resp, err := cli.PurgeDeadLetters(ctx, &interactions.PurgeDeadLettersRequest{Queue: queue, MessageIds: ids})
+	if resp != nil {
+		fmt.Printf("purged %d messages from %s\n", resp.PurgedCount, queue)
+	}
+	return check(resp.GetBase(), err)
+}
+
+// check 把RPC错误和响应中的失败状态统一成error
+func check(status *base.Status, err error) error {
+	if err != nil {
+		return err
+	}
+	if status == nil {
+		return fmt.Errorf("empty response")
+	}
+	if status.Code != 200 {
+		return fmt.Errorf("%d: %s", status.Code, status.Msg)
+	}
+	return nil
+}
+
+func splitIDs(ids string) []string {
+	var result []string
+	for _, id := range strings.Split(ids, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+func formatUnix(sec int64) string {
+	if sec == 0 {
+		return "-"
+	}
+	return time.Unix(sec, 0).Format("2006-01-02 15:04:05")
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "dlq: %v\n", err)
+	os.Exit(1)
+}
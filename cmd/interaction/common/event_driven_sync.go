@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"HuaTug.com/cmd/interaction/infras/client"
 	"HuaTug.com/cmd/interaction/infras/redis"
 	"HuaTug.com/cmd/model"
 	"HuaTug.com/pkg/mq"
@@ -20,7 +21,7 @@ import (
 type EventDrivenSyncService struct {
 	ctx          context.Context
 	cancel       context.CancelFunc
-	producer     mq.MessageProducer
+	producer     mq.Publisher
 	db           *gorm.DB
 	retryManager *RetryManager
 	lockManager  *DistributedLockManager
@@ -76,7 +77,7 @@ type SyncMetrics struct {
 }
 
 // NewEventDrivenSyncService 创建事件驱动同步服务
-func NewEventDrivenSyncService(producer mq.MessageProducer, database *gorm.DB) *EventDrivenSyncService {
+func NewEventDrivenSyncService(producer mq.Publisher, database *gorm.DB) *EventDrivenSyncService {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	return &EventDrivenSyncService{
@@ -140,11 +141,11 @@ func (s *EventDrivenSyncService) updateCommentLikeCount(ctx context.Context, tx
 	return nil
 }
 
-// sendCommentLikeNotification 发送评论点赞通知
-func (s *EventDrivenSyncService) sendCommentLikeNotification(ctx context.Context, userID, commentID int64) error {
+// sendCommentLikeNotification 在点赞事务中把评论点赞通知写入发件箱
+func (s *EventDrivenSyncService) sendCommentLikeNotification(ctx context.Context, tx *gorm.DB, userID, commentID int64) error {
 	// 1. 获取评论信息以确定通知接收者
 	var comment model.Comment
-	if err := s.db.Where("comment_id = ?", commentID).First(&comment).Error; err != nil {
+	if err := tx.Where("comment_id = ?", commentID).First(&comment).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			hlog.CtxWarnf(ctx, "Comment %d not found for like notification", commentID)
 			return nil // 评论不存在，不发送通知
@@ -177,15 +178,14 @@ func (s *EventDrivenSyncService) sendCommentLikeNotification(ctx context.Context
 		NotificationType: "like",
 		TargetID:         commentID,
 	}
+	notificationEvent.Priority = client.NotificationPriority(ctx, comment.UserId, userID)
 
-	// 4. 发送通知事件到消息队列
-	if s.producer != nil {
-		if err := s.producer.PublishNotificationEvent(ctx, notificationEvent); err != nil {
-			return fmt.Errorf("failed to publish notification event: %w", err)
-		}
-		hlog.CtxInfof(ctx, "Sent comment like notification: user %d liked comment %d by user %d",
-			userID, commentID, comment.UserId)
+	// 4. 写入发件箱，事务提交后由中继发布到消息队列
+	if err := mq.Publish(ctx, mq.NewOutbox(tx), notificationEvent); err != nil {
+		return fmt.Errorf("failed to enqueue notification event: %w", err)
 	}
+	hlog.CtxInfof(ctx, "Enqueued comment like notification: user %d liked comment %d by user %d",
+		userID, commentID, comment.UserId)
 
 	return nil
 }
@@ -326,12 +326,10 @@ func (s *EventDrivenSyncService) processVideoLike(ctx context.Context, tx *gorm.
 		// 不返回错误，避免影响主流程，但记录日志
 	}
 
-	// 4. 异步发送点赞通知 - 关键缺失功能补充
-	go func() {
-		if err := s.sendVideoLikeNotification(context.Background(), event.UserID, event.ResourceID); err != nil {
-			hlog.Errorf("Failed to send video like notification: %v", err)
-		}
-	}()
+	// 4. 点赞通知写入发件箱，与点赞记录一起提交
+	if err := s.sendVideoLikeNotification(ctx, tx, event.UserID, event.ResourceID); err != nil {
+		return err
+	}
 
 	// 注意：不在这里更新Redis缓存，避免重复计数
 	// Redis缓存已经在EventHandler中更新过了
@@ -386,12 +384,10 @@ func (s *EventDrivenSyncService) processCommentLike(ctx context.Context, tx *gor
 		// 不返回错误，避免影响主流程，但记录日志
 	}
 
-	// 异步发送评论点赞通知 - 关键缺失功能补充
-	go func() {
-		if err := s.sendCommentLikeNotification(context.Background(), event.UserID, event.ResourceID); err != nil {
-			hlog.Errorf("Failed to send comment like notification: %v", err)
-		}
-	}()
+	// 评论点赞通知写入发件箱，与点赞记录一起提交
+	if err := s.sendCommentLikeNotification(ctx, tx, event.UserID, event.ResourceID); err != nil {
+		return err
+	}
 
 	// 注意：不在这里更新Redis缓存，避免重复计数
 	// Redis缓存已经在EventHandler中更新过了
@@ -832,11 +828,11 @@ func (s *EventDrivenSyncService) updateVideoLikeCount(ctx context.Context, tx *g
 	return nil
 }
 
-// sendVideoLikeNotification 发送视频点赞通知
-func (s *EventDrivenSyncService) sendVideoLikeNotification(ctx context.Context, userID, videoID int64) error {
+// sendVideoLikeNotification 在点赞事务中把视频点赞通知写入发件箱
+func (s *EventDrivenSyncService) sendVideoLikeNotification(ctx context.Context, tx *gorm.DB, userID, videoID int64) error {
 	// 1. 获取视频信息以确定通知接收者
 	var video model.Video
-	if err := s.db.Where("video_id = ?", videoID).First(&video).Error; err != nil {
+	if err := tx.Where("video_id = ?", videoID).First(&video).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			hlog.CtxWarnf(ctx, "Video %d not found for like notification", videoID)
 			return nil // 视频不存在，不发送通知
@@ -868,15 +864,14 @@ func (s *EventDrivenSyncService) sendVideoLikeNotification(ctx context.Context,
 		NotificationType: "like",
 		TargetID:         videoID,
 	}
+	notificationEvent.Priority = client.NotificationPriority(ctx, video.UserId, userID)
 
-	// 4. 发送通知事件到消息队列
-	if s.producer != nil {
-		if err := s.producer.PublishNotificationEvent(ctx, notificationEvent); err != nil {
-			return fmt.Errorf("failed to publish notification event: %w", err)
-		}
-		hlog.CtxInfof(ctx, "Sent video like notification: user %d liked video %d by user %d",
-			userID, videoID, video.UserId)
+	// 4. 写入发件箱，事务提交后由中继发布到消息队列
+	if err := mq.Publish(ctx, mq.NewOutbox(tx), notificationEvent); err != nil {
+		return fmt.Errorf("failed to enqueue notification event: %w", err)
 	}
+	hlog.CtxInfof(ctx, "Enqueued video like notification: user %d liked video %d by user %d",
+		userID, videoID, video.UserId)
 
 	return nil
 }
@@ -0,0 +1,51 @@
+package db
+
+import (
+	"context"
+	"fmt"
+)
+
+const userBehaviorTable = "user_behaviors"
+
+// coInteractionBehaviorTypes 计算共同互动使用的行为，浏览和分享信号太弱不参与计算
+var coInteractionBehaviorTypes = []string{"like", "comment"}
+
+// GetRecentInteractedVideoIDs 获取用户最近点赞或评论过的视频ID
+func GetRecentInteractedVideoIDs(ctx context.Context, userID int64, limit int) ([]int64, error) {
+	var videoIDs []int64
+	if err := DB.WithContext(ctx).Table(userBehaviorTable).
+		Select("video_id").
+		Where("user_id = ? AND behavior_type IN ?", userID, coInteractionBehaviorTypes).
+		Group("video_id").
+		Order("MAX(created_at) DESC").
+		Limit(limit).
+		Pluck("video_id", &videoIDs).Error; err != nil {
+		return nil, fmt.Errorf("failed to get interacted videos: %w", err)
+	}
+	return videoIDs, nil
+}
+
+// CoInteractionCount 在同一批视频上有过点赞或评论的用户及其共同互动的视频数
+type CoInteractionCount struct {
+	UserID int64
+	Cnt    int64
+}
+
+// GetCoInteractionCounts 统计在videoIDs上有点赞或评论的其他用户，按共同互动的视频数降序
+func GetCoInteractionCounts(ctx context.Context, userID int64, videoIDs []int64, limit int) ([]CoInteractionCount, error) {
+	if len(videoIDs) == 0 {
+		return nil, nil
+	}
+
+	var rows []CoInteractionCount
+	if err := DB.WithContext(ctx).Table(userBehaviorTable).
+		Select("user_id, COUNT(DISTINCT video_id) AS cnt").
+		Where("video_id IN ? AND behavior_type IN ? AND user_id <> ?", videoIDs, coInteractionBehaviorTypes, userID).
+		Group("user_id").
+		Order("cnt DESC, user_id").
+		Limit(limit).
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to count co-interactions: %w", err)
+	}
+	return rows, nil
+}
@@ -0,0 +1,58 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"HuaTug.com/cmd/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const commentPinTable = "comment_pins"
+
+// PinComment 置顶视频的评论，替换视频原有的置顶评论
+func PinComment(ctx context.Context, videoID, commentID, userID int64) error {
+	pin := &model.CommentPin{
+		VideoId:   videoID,
+		CommentId: commentID,
+		UserId:    userID,
+		CreatedAt: time.Now(),
+	}
+	if err := DB.WithContext(ctx).Table(commentPinTable).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "video_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"comment_id", "user_id", "created_at"}),
+		}).
+		Create(pin).Error; err != nil {
+		return fmt.Errorf("failed to pin comment: %w", err)
+	}
+	return nil
+}
+
+// UnpinComment 取消视频的置顶评论，commentID不为0时只在置顶的正是该评论时取消
+func UnpinComment(ctx context.Context, videoID, commentID int64) error {
+	query := DB.WithContext(ctx).Table(commentPinTable).Where("video_id = ?", videoID)
+	if commentID != 0 {
+		query = query.Where("comment_id = ?", commentID)
+	}
+	if err := query.Delete(&model.CommentPin{}).Error; err != nil {
+		return fmt.Errorf("failed to unpin comment: %w", err)
+	}
+	return nil
+}
+
+// GetPinnedCommentID 获取视频置顶的评论ID，没有置顶评论时返回0
+func GetPinnedCommentID(ctx context.Context, videoID int64) (int64, error) {
+	var pin model.CommentPin
+	err := DB.WithContext(ctx).Table(commentPinTable).Where("video_id = ?", videoID).Take(&pin).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get pinned comment: %w", err)
+	}
+	return pin.CommentId, nil
+}
@@ -0,0 +1,114 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"HuaTug.com/cmd/model"
+	"gorm.io/gorm"
+)
+
+const (
+	commentReviewTable = "comment_reviews"
+	sensitiveWordTable = "sensitive_words"
+	userRoleTable      = "user_roles"
+	adminRole          = "admin"
+)
+
+// ErrCommentReviewHandled 审核记录已经被其他管理员处理
+var ErrCommentReviewHandled = errors.New("comment review has already been handled")
+
+// CreateCommentReview 把需要人工审核的评论加入审核队列
+func CreateCommentReview(ctx context.Context, review *model.CommentReview) error {
+	review.Status = model.CommentReviewPending
+	review.CreatedAt = time.Now()
+	if err := DB.WithContext(ctx).Table(commentReviewTable).Create(review).Error; err != nil {
+		return fmt.Errorf("failed to create comment review: %w", err)
+	}
+	return nil
+}
+
+// GetCommentReview 获取审核记录，不存在时返回nil
+func GetCommentReview(ctx context.Context, reviewID int64) (*model.CommentReview, error) {
+	var review model.CommentReview
+	err := DB.WithContext(ctx).Table(commentReviewTable).Where("review_id = ?", reviewID).Take(&review).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comment review: %w", err)
+	}
+	return &review, nil
+}
+
+// ListCommentReviews 按状态分页列出审核记录，按review_id升序即先进先审，cursor为上一页最后一条的review_id
+func ListCommentReviews(ctx context.Context, status int, cursor int64, limit int) ([]*model.CommentReview, error) {
+	var reviews []*model.CommentReview
+	err := DB.WithContext(ctx).Table(commentReviewTable).
+		Where("status = ? AND review_id > ?", status, cursor).
+		Order("review_id ASC").
+		Limit(limit).
+		Find(&reviews).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comment reviews: %w", err)
+	}
+	return reviews, nil
+}
+
+// ResolveCommentReview 把待审核的记录标记为通过或拒绝，只有仍处于待审核状态时才会更新，
+// 已被处理时返回ErrCommentReviewHandled，保证同一条评论只会被一个管理员处理
+func ResolveCommentReview(ctx context.Context, reviewID int64, status int, reviewerID int64, note string) error {
+	result := DB.WithContext(ctx).Table(commentReviewTable).
+		Where("review_id = ? AND status = ?", reviewID, model.CommentReviewPending).
+		Updates(map[string]interface{}{
+			"status":      status,
+			"reviewer_id": reviewerID,
+			"note":        note,
+			"reviewed_at": time.Now(),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to resolve comment review: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrCommentReviewHandled
+	}
+	return nil
+}
+
+// ReopenCommentReview 通过后发布评论失败时把记录恢复为待审核，以便重新处理
+func ReopenCommentReview(ctx context.Context, reviewID int64) error {
+	err := DB.WithContext(ctx).Table(commentReviewTable).
+		Where("review_id = ?", reviewID).
+		Updates(map[string]interface{}{
+			"status":      model.CommentReviewPending,
+			"reviewer_id": 0,
+			"reviewed_at": nil,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("failed to reopen comment review: %w", err)
+	}
+	return nil
+}
+
+// GetSensitiveWords 获取全部敏感词
+func GetSensitiveWords(ctx context.Context) ([]*model.SensitiveWord, error) {
+	var words []*model.SensitiveWord
+	if err := DB.WithContext(ctx).Table(sensitiveWordTable).Find(&words).Error; err != nil {
+		return nil, fmt.Errorf("failed to get sensitive words: %w", err)
+	}
+	return words, nil
+}
+
+// IsAdmin 判断用户是否拥有管理员角色
+func IsAdmin(ctx context.Context, userID int64) (bool, error) {
+	var count int64
+	err := DB.WithContext(ctx).Table(userRoleTable).
+		Where("user_id = ? AND role = ?", userID, adminRole).
+		Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("failed to check admin role: %w", err)
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,248 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"HuaTug.com/cmd/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	reportTable     = "reports"
+	reportCaseTable = "report_cases"
+)
+
+var (
+	// ErrReportCaseClosed 工单已经处理完毕
+	ErrReportCaseClosed = errors.New("report case has already been closed")
+	// ErrReportCaseAssigned 工单已分配给其他管理员
+	ErrReportCaseAssigned = errors.New("report case is assigned to another admin")
+)
+
+// AddReport 记录一次举报并汇总到被举报对象的工单：同一用户对同一对象的重复举报返回duplicate为true且不计数；
+// 已处理的工单收到新的举报时重新打开，上次处理之后的举报用户数达到hideThreshold时自动隐藏；
+// videoID是被举报评论所属的视频，用于在评论列表中排除被隐藏的评论
+func AddReport(ctx context.Context, report *model.Report, targetUserID, videoID int64, hideThreshold int) (reportCase *model.ReportCase, duplicate bool, err error) {
+	err = DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		// 对象第一次被举报时创建工单，并发创建时只有一个成功
+		newCase := &model.ReportCase{
+			TargetType:   report.TargetType,
+			TargetId:     report.TargetId,
+			TargetUserId: targetUserID,
+			VideoId:      videoID,
+			Status:       model.ReportCaseOpen,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		}
+		if err := tx.Table(reportCaseTable).Clauses(clause.OnConflict{DoNothing: true}).Create(newCase).Error; err != nil {
+			return fmt.Errorf("failed to create report case: %w", err)
+		}
+		var c model.ReportCase
+		if err := tx.Table(reportCaseTable).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("target_type = ? AND target_id = ?", report.TargetType, report.TargetId).
+			Take(&c).Error; err != nil {
+			return fmt.Errorf("failed to lock report case: %w", err)
+		}
+		reportCase = &c
+
+		report.CaseId = c.CaseId
+		report.CreatedAt = now
+		result := tx.Table(reportTable).Clauses(clause.OnConflict{DoNothing: true}).Create(report)
+		if result.Error != nil {
+			return fmt.Errorf("failed to create report: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			duplicate = true
+			return nil
+		}
+
+		c.ReportCount++
+		c.PendingCount++
+		c.UpdatedAt = now
+		if c.Status == model.ReportCaseResolved || c.Status == model.ReportCaseDismissed {
+			c.Status = model.ReportCaseOpen
+			c.AssigneeId = 0
+			c.ResolvedAt = nil
+		}
+		if hideThreshold > 0 && c.PendingCount >= hideThreshold {
+			c.Hidden = true
+		}
+		if err := tx.Table(reportCaseTable).Where("case_id = ?", c.CaseId).Updates(map[string]interface{}{
+			"report_count":  c.ReportCount,
+			"pending_count": c.PendingCount,
+			"hidden":        c.Hidden,
+			"status":        c.Status,
+			"assignee_id":   c.AssigneeId,
+			"resolved_at":   c.ResolvedAt,
+			"updated_at":    c.UpdatedAt,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to update report case: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return reportCase, duplicate, nil
+}
+
+// GetReportCase 获取举报工单，不存在时返回nil
+func GetReportCase(ctx context.Context, caseID int64) (*model.ReportCase, error) {
+	var c model.ReportCase
+	err := DB.WithContext(ctx).Table(reportCaseTable).Where("case_id = ?", caseID).Take(&c).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get report case: %w", err)
+	}
+	return &c, nil
+}
+
+// ListReportCases 按状态分页列出举报工单，按case_id升序即先进先处理；targetType和assigneeID为0时不过滤
+func ListReportCases(ctx context.Context, status, targetType int, assigneeID, cursor int64, limit int) ([]*model.ReportCase, error) {
+	query := DB.WithContext(ctx).Table(reportCaseTable).Where("status = ? AND case_id > ?", status, cursor)
+	if targetType != 0 {
+		query = query.Where("target_type = ?", targetType)
+	}
+	if assigneeID != 0 {
+		query = query.Where("assignee_id = ?", assigneeID)
+	}
+	var cases []*model.ReportCase
+	if err := query.Order("case_id ASC").Limit(limit).Find(&cases).Error; err != nil {
+		return nil, fmt.Errorf("failed to list report cases: %w", err)
+	}
+	return cases, nil
+}
+
+// GetReportReasonCounts 统计各工单按举报原因分组的举报数
+func GetReportReasonCounts(ctx context.Context, caseIDs []int64) (map[int64]map[int64]int64, error) {
+	counts := make(map[int64]map[int64]int64, len(caseIDs))
+	if len(caseIDs) == 0 {
+		return counts, nil
+	}
+	var rows []struct {
+		CaseId int64
+		Reason int64
+		Total  int64
+	}
+	err := DB.WithContext(ctx).Table(reportTable).
+		Select("case_id, reason, COUNT(*) AS total").
+		Where("case_id IN ?", caseIDs).
+		Group("case_id, reason").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to count report reasons: %w", err)
+	}
+	for _, row := range rows {
+		if counts[row.CaseId] == nil {
+			counts[row.CaseId] = make(map[int64]int64)
+		}
+		counts[row.CaseId][row.Reason] = row.Total
+	}
+	return counts, nil
+}
+
+// AssignReportCase 把未处理完的工单分配给管理员，可以重新分配
+func AssignReportCase(ctx context.Context, caseID, assigneeID int64) error {
+	result := DB.WithContext(ctx).Table(reportCaseTable).
+		Where("case_id = ? AND status IN ?", caseID, []int{model.ReportCaseOpen, model.ReportCaseAssigned}).
+		Updates(map[string]interface{}{
+			"status":      model.ReportCaseAssigned,
+			"assignee_id": assigneeID,
+			"updated_at":  time.Now(),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to assign report case: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrReportCaseClosed
+	}
+	return nil
+}
+
+// CloseReportCase 以status结束工单并设置对象是否隐藏，同一事务中对尚未收到结果的举报人调用notify，
+// notify写入的通知与工单状态一起提交；工单已分配给其他管理员时返回ErrReportCaseAssigned
+func CloseReportCase(ctx context.Context, caseID int64, status int, adminID int64, note string, hidden bool,
+	notify func(tx *gorm.DB, c *model.ReportCase, reports []*model.Report) error) error {
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var c model.ReportCase
+		err := tx.Table(reportCaseTable).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("case_id = ?", caseID).Take(&c).Error
+		if err != nil {
+			return fmt.Errorf("failed to lock report case: %w", err)
+		}
+		if c.Status != model.ReportCaseOpen && c.Status != model.ReportCaseAssigned {
+			return ErrReportCaseClosed
+		}
+		if c.AssigneeId != 0 && c.AssigneeId != adminID {
+			return ErrReportCaseAssigned
+		}
+
+		now := time.Now()
+		c.Status, c.AssigneeId, c.Note, c.Hidden, c.PendingCount, c.ResolvedAt = status, adminID, note, hidden, 0, &now
+		if err := tx.Table(reportCaseTable).Where("case_id = ?", caseID).Updates(map[string]interface{}{
+			"status":        c.Status,
+			"assignee_id":   c.AssigneeId,
+			"note":          c.Note,
+			"hidden":        c.Hidden,
+			"pending_count": c.PendingCount,
+			"resolved_at":   c.ResolvedAt,
+			"updated_at":    now,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to close report case: %w", err)
+		}
+
+		var reports []*model.Report
+		if err := tx.Table(reportTable).Where("case_id = ? AND notified = ?", caseID, false).Find(&reports).Error; err != nil {
+			return fmt.Errorf("failed to get reports of case: %w", err)
+		}
+		if len(reports) == 0 {
+			return nil
+		}
+		if err := notify(tx, &c, reports); err != nil {
+			return err
+		}
+		if err := tx.Table(reportTable).Where("case_id = ? AND notified = ?", caseID, false).
+			Update("notified", true).Error; err != nil {
+			return fmt.Errorf("failed to mark reports notified: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetHiddenTargetIDs 返回ids中因举报被隐藏的对象
+func GetHiddenTargetIDs(ctx context.Context, targetType int, ids []int64) (map[int64]bool, error) {
+	hidden := make(map[int64]bool)
+	if len(ids) == 0 {
+		return hidden, nil
+	}
+	var targetIDs []int64
+	err := DB.WithContext(ctx).Table(reportCaseTable).
+		Where("target_type = ? AND target_id IN ? AND hidden = ?", targetType, ids, true).
+		Pluck("target_id", &targetIDs).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hidden targets: %w", err)
+	}
+	for _, id := range targetIDs {
+		hidden[id] = true
+	}
+	return hidden, nil
+}
+
+// GetHiddenCommentIDs 返回视频下因举报被隐藏的评论，评论列表在查询中排除这些评论
+func GetHiddenCommentIDs(ctx context.Context, videoID int64) ([]int64, error) {
+	var ids []int64
+	err := DB.WithContext(ctx).Table(reportCaseTable).
+		Where("video_id = ? AND hidden = ? AND target_type = ?", videoID, true, model.ReportTargetComment).
+		Pluck("target_id", &ids).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hidden comments: %w", err)
+	}
+	return ids, nil
+}
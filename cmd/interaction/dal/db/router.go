@@ -0,0 +1,44 @@
+package db
+
+import (
+	"fmt"
+
+	"HuaTug.com/pkg/sharding"
+)
+
+// commentTablePrefix 评论分表前缀，按video_id分片
+const commentTablePrefix = "comments"
+
+// commentTables 互动服务中的分片逻辑表，评论点赞和编辑历史表与评论表同下标存放
+var commentTables = []sharding.TableRule{
+	{Name: commentTablePrefix, ShardKey: "video_id", CoSharded: []sharding.CoShardedTable{
+		{Name: "comment_likes"},
+		{Name: "comment_edits", SurrogateKey: true},
+	}},
+}
+
+// 全局分片路由实例
+var globalRouter *sharding.Router
+
+// InitRouter 初始化全局分片路由
+func InitRouter(config *sharding.TopologyConfig) error {
+	if config == nil {
+		return fmt.Errorf("sharding config cannot be nil")
+	}
+	config.Name = "comments"
+
+	router, err := sharding.NewRouter(&sharding.RouterConfig{
+		TopologyConfig: *config,
+		Tables:         commentTables,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to initialize sharding router: %w", err)
+	}
+	globalRouter = router
+	return nil
+}
+
+// GetRouter 获取全局分片路由
+func GetRouter() *sharding.Router {
+	return globalRouter
+}
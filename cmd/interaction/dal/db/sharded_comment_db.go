@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"HuaTug.com/cmd/model"
 	"HuaTug.com/pkg/cache"
 	"HuaTug.com/pkg/constants"
+	"HuaTug.com/pkg/sharding"
+	"github.com/go-sql-driver/mysql"
 	"gorm.io/gorm"
 )
 
@@ -17,28 +20,29 @@ const MaxCommentLength = 500
 
 // ShardedCommentDB 分片评论数据访问对象
 type ShardedCommentDB struct {
-	shardingManager *ShardingManager
-	cacheManager    *cache.CommentCacheManager
+	router       *sharding.Router
+	cacheManager *cache.CommentCacheManager
 }
 
 // NewShardedCommentDB 创建新的ShardedCommentDB实例
-func NewShardedCommentDB(shardingManager *ShardingManager, cacheManager *cache.CommentCacheManager) *ShardedCommentDB {
+func NewShardedCommentDB(router *sharding.Router, cacheManager *cache.CommentCacheManager) *ShardedCommentDB {
 	return &ShardedCommentDB{
-		shardingManager: shardingManager,
-		cacheManager:    cacheManager,
+		router:       router,
+		cacheManager: cacheManager,
 	}
 }
 
-// getShardingManager 获取分片管理器实例，避免重复的空值检查
-func (s *ShardedCommentDB) getShardingManager() (*ShardingManager, error) {
-	if s.shardingManager == nil {
-		return nil, errors.New("sharding manager is not initialized")
+// getRouter 获取分片路由实例，避免重复的空值检查
+func (s *ShardedCommentDB) getRouter() (*sharding.Router, error) {
+	if s.router == nil {
+		return nil, errors.New("sharding router is not initialized")
 	}
-	return s.shardingManager, nil
+	return s.router, nil
 }
 
 // CreateCommentWithTransaction 在事务中创建评论
-func (s *ShardedCommentDB) CreateCommentWithTransaction(ctx context.Context, comment *model.Comment) error {
+// inTx在同一个分库事务中执行，用于写入与评论一起提交的发件箱消息
+func (s *ShardedCommentDB) CreateCommentWithTransaction(ctx context.Context, comment *model.Comment, inTx ...func(tx *gorm.DB) error) error {
 	if comment == nil {
 		return errors.New("comment cannot be nil")
 	}
@@ -62,11 +66,16 @@ func (s *ShardedCommentDB) CreateCommentWithTransaction(ctx context.Context, com
 	comment.UpdatedAt = now
 
 	// 使用视频ID作为分片键在事务中创建评论
-	return s.shardingManager.ExecuteInShard(ctx, comment.VideoId, true, func(db *gorm.DB, tableName string) error {
+	return s.router.Execute(ctx, commentTablePrefix, comment.VideoId, true, func(db *gorm.DB, tableName string) error {
 		return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 			if err := tx.Table(tableName).Create(comment).Error; err != nil {
 				return fmt.Errorf("failed to create comment in transaction: %w", err)
 			}
+			for _, fn := range inTx {
+				if err := fn(tx); err != nil {
+					return err
+				}
+			}
 			return nil
 		})
 	})
@@ -90,36 +99,19 @@ func (s *ShardedCommentDB) GetCommentByID(ctx context.Context, commentID int64)
 	// 1. 维护一个commentID到videoID的映射表
 	// 2. 使用全局索引服务
 	// 3. 在评论ID中编码分片信息
-	var comment *model.Comment
-	var foundErr error
-
-	// 遍历所有数据库分片
-	allDatabases := s.shardingManager.GetAllDatabases()
-	for dbKey, db := range allDatabases {
-		// 遍历该数据库中的所有表分片
-		for tableIndex := 0; tableIndex < s.shardingManager.config.TableCount; tableIndex++ {
-			tableName := fmt.Sprintf("comments_%d", tableIndex)
-			var tempComment model.Comment
-
-			err := db.WithContext(ctx).Table(tableName).Where("comment_id = ?", commentID).First(&tempComment).Error
-			if err == nil {
-				comment = &tempComment
-				// TODO: 缓存结果
-				// if s.cacheManager != nil {
-				//     s.cacheManager.SetComment(commentID, comment)
-				// }
-				return comment, nil
-			} else if !errors.Is(err, gorm.ErrRecordNotFound) {
-				foundErr = fmt.Errorf("failed to query comment from %s.%s: %w", dbKey, tableName, err)
-			}
-		}
+	comment, found, err := findComment(ctx, s.router, commentID, "*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query comment: %w", err)
 	}
-
-	if foundErr != nil {
-		return nil, foundErr
+	if !found {
+		return nil, nil // 未找到评论
 	}
 
-	return nil, nil // 未找到评论
+	// TODO: 缓存结果
+	// if s.cacheManager != nil {
+	//     s.cacheManager.SetComment(commentID, comment)
+	// }
+	return comment, nil
 }
 
 // GetVideoComments 获取视频的评论列表
@@ -135,7 +127,7 @@ func (s *ShardedCommentDB) GetVideoComments(ctx context.Context, videoID int64,
 	var comments []*model.Comment
 
 	// 使用视频ID作为分片键查询对应分片
-	err := s.shardingManager.ExecuteInShard(ctx, videoID, false, func(db *gorm.DB, tableName string) error {
+	err := s.router.Execute(ctx, commentTablePrefix, videoID, false, func(db *gorm.DB, tableName string) error {
 		return db.WithContext(ctx).Table(tableName).Where("video_id = ?", videoID).
 			Order("created_at DESC").
 			Limit(limit).
@@ -163,7 +155,7 @@ func (s *ShardedCommentDB) UpdateComment(ctx context.Context, comment *model.Com
 	comment.UpdatedAt = time.Now().Format("2006-01-02 15:04:05")
 
 	// 使用视频ID作为分片键更新对应分片
-	return s.shardingManager.ExecuteInShard(ctx, comment.VideoId, true, func(db *gorm.DB, tableName string) error {
+	return s.router.Execute(ctx, commentTablePrefix, comment.VideoId, true, func(db *gorm.DB, tableName string) error {
 		result := db.WithContext(ctx).Table(tableName).Where("comment_id = ?", comment.CommentId).Save(comment)
 		if result.Error != nil {
 			return fmt.Errorf("failed to update comment: %w", result.Error)
@@ -191,7 +183,7 @@ func (s *ShardedCommentDB) DeleteComment(ctx context.Context, commentID int64) e
 	}
 
 	// 使用视频ID作为分片键删除对应分片中的评论
-	return s.shardingManager.ExecuteInShard(ctx, comment.VideoId, true, func(db *gorm.DB, tableName string) error {
+	return s.router.Execute(ctx, commentTablePrefix, comment.VideoId, true, func(db *gorm.DB, tableName string) error {
 		result := db.WithContext(ctx).Table(tableName).Where("comment_id = ?", commentID).Delete(&model.Comment{})
 		if result.Error != nil {
 			return fmt.Errorf("failed to delete comment: %w", result.Error)
@@ -212,7 +204,7 @@ func (s *ShardedCommentDB) GetCommentCount(ctx context.Context, videoID int64) (
 	var count int64
 
 	// 使用视频ID作为分片键查询对应分片
-	err := s.shardingManager.ExecuteInShard(ctx, videoID, false, func(db *gorm.DB, tableName string) error {
+	err := s.router.Execute(ctx, commentTablePrefix, videoID, false, func(db *gorm.DB, tableName string) error {
 		return db.WithContext(ctx).Table(tableName).Model(&model.Comment{}).Where("video_id = ?", videoID).Count(&count).Error
 	})
 
@@ -229,8 +221,8 @@ func (s *ShardedCommentDB) DeleteCommentWithSharding(ctx context.Context, commen
 	return s.DeleteComment(ctx, commentID)
 }
 
-// CreateCommentLikeWithSharding 创建评论点赞（分片版本）
-func (s *ShardedCommentDB) CreateCommentLikeWithSharding(ctx context.Context, commentID, userID int64) error {
+// CreateCommentLikeWithSharding 创建评论点赞（分片版本），inTx在同一个分库事务中执行
+func (s *ShardedCommentDB) CreateCommentLikeWithSharding(ctx context.Context, commentID, userID int64, inTx ...func(tx *gorm.DB) error) error {
 	if userID == 0 || commentID == 0 {
 		return errors.New("user_id and comment_id are required")
 	}
@@ -251,13 +243,20 @@ func (s *ShardedCommentDB) CreateCommentLikeWithSharding(ctx context.Context, co
 	}
 
 	// 使用评论的视频ID作为分片键
-	return s.shardingManager.ExecuteInShard(ctx, comment.VideoId, true, func(db *gorm.DB, tableName string) error {
+	return s.router.Execute(ctx, commentTablePrefix, comment.VideoId, true, func(db *gorm.DB, tableName string) error {
 		// 评论点赞表名应该与评论表对应
 		likeTableName := fmt.Sprintf("comment_likes_%s", tableName[len("comments_"):])
-		if err := db.WithContext(ctx).Table(likeTableName).Create(like).Error; err != nil {
-			return fmt.Errorf("failed to create comment like: %w", err)
-		}
-		return nil
+		return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Table(likeTableName).Create(like).Error; err != nil {
+				return fmt.Errorf("failed to create comment like: %w", err)
+			}
+			for _, fn := range inTx {
+				if err := fn(tx); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
 	})
 }
 
@@ -277,7 +276,7 @@ func (s *ShardedCommentDB) DeleteCommentLikeWithSharding(ctx context.Context, us
 	}
 
 	// 使用评论的视频ID作为分片键
-	return s.shardingManager.ExecuteInShard(ctx, comment.VideoId, true, func(db *gorm.DB, tableName string) error {
+	return s.router.Execute(ctx, commentTablePrefix, comment.VideoId, true, func(db *gorm.DB, tableName string) error {
 		// 评论点赞表名应该与评论表对应
 		likeTableName := fmt.Sprintf("comment_likes_%s", tableName[len("comments_"):])
 		result := db.WithContext(ctx).Table(likeTableName).Where("user_id = ? AND comment_id = ?", userID, commentID).Delete(&model.CommentLike{})
@@ -298,60 +297,44 @@ func (s *ShardedCommentDB) GetCommentInfoWithSharding(ctx context.Context, comme
 
 // GetParentCommentId 获取父评论ID
 func GetParentCommentId(ctx context.Context, commentID int64) (int64, error) {
-	// 使用全局分片管理器实例
-	shardingManager := GetShardingManager()
-	if shardingManager == nil {
-		return 0, errors.New("sharding manager is not initialized")
+	// 使用全局分片路由实例
+	router := GetRouter()
+	if router == nil {
+		return 0, errors.New("sharding router is not initialized")
 	}
 
 	// 遍历所有分片查找评论
-	allDatabases := shardingManager.GetAllDatabases()
-	for _, db := range allDatabases {
-		for tableIndex := 0; tableIndex < shardingManager.config.TableCount; tableIndex++ {
-			tableName := fmt.Sprintf("comments_%d", tableIndex)
-			var comment model.Comment
-
-			err := db.WithContext(ctx).Table(tableName).Select("parent_id").Where("comment_id = ?", commentID).First(&comment).Error
-			if err == nil {
-				return comment.ParentId, nil
-			} else if !errors.Is(err, gorm.ErrRecordNotFound) {
-				return 0, fmt.Errorf("failed to get parent comment id from %s: %w", tableName, err)
-			}
-		}
+	comment, found, err := findComment(ctx, router, commentID, "parent_id")
+	if err != nil {
+		return 0, fmt.Errorf("failed to get parent comment id: %w", err)
 	}
-
-	return 0, nil // 未找到评论
+	if !found {
+		return 0, nil // 未找到评论
+	}
+	return comment.ParentId, nil
 }
 
 // GetCommentVideoId 获取评论对应的视频ID
 func GetCommentVideoId(ctx context.Context, commentID int64) (int64, error) {
-	// 使用全局分片管理器实例
-	shardingManager := GetShardingManager()
-	if shardingManager == nil {
-		return 0, errors.New("sharding manager is not initialized")
+	// 使用全局分片路由实例
+	router := GetRouter()
+	if router == nil {
+		return 0, errors.New("sharding router is not initialized")
 	}
 
 	// 遍历所有分片查找评论
-	allDatabases := shardingManager.GetAllDatabases()
-	for _, db := range allDatabases {
-		for tableIndex := 0; tableIndex < shardingManager.config.TableCount; tableIndex++ {
-			tableName := fmt.Sprintf("comments_%d", tableIndex)
-			var comment model.Comment
-
-			err := db.WithContext(ctx).Table(tableName).Select("video_id").Where("comment_id = ?", commentID).First(&comment).Error
-			if err == nil {
-				return comment.VideoId, nil
-			} else if !errors.Is(err, gorm.ErrRecordNotFound) {
-				return 0, fmt.Errorf("failed to get comment video id from %s: %w", tableName, err)
-			}
-		}
+	comment, found, err := findComment(ctx, router, commentID, "video_id")
+	if err != nil {
+		return 0, fmt.Errorf("failed to get comment video id: %w", err)
 	}
-
-	return 0, nil // 未找到评论
+	if !found {
+		return 0, nil // 未找到评论
+	}
+	return comment.VideoId, nil
 }
 
-// CreateCommentWithTransaction 创建评论（带事务）
-func CreateCommentWithTransaction(ctx context.Context, comment *model.Comment) error {
+// CreateCommentWithTransaction 创建评论（带事务），inTx在同一事务中执行，用于写入发件箱等需要与评论一起提交的数据
+func CreateCommentWithTransaction(ctx context.Context, comment *model.Comment, inTx ...func(tx *gorm.DB) error) error {
 	if comment == nil {
 		return errors.New("comment cannot be nil")
 	}
@@ -369,10 +352,10 @@ func CreateCommentWithTransaction(ctx context.Context, comment *model.Comment) e
 		return fmt.Errorf("comment content too long, max length is %d", MaxCommentLength)
 	}
 
-	// 使用全局分片管理器实例
-	shardingManager := GetShardingManager()
-	if shardingManager == nil {
-		return errors.New("sharding manager is not initialized")
+	// 使用全局分片路由实例
+	router := GetRouter()
+	if router == nil {
+		return errors.New("sharding router is not initialized")
 	}
 
 	// 设置时间戳
@@ -381,11 +364,16 @@ func CreateCommentWithTransaction(ctx context.Context, comment *model.Comment) e
 	comment.UpdatedAt = now
 
 	// 使用视频ID作为分片键在事务中创建评论
-	return shardingManager.ExecuteInShard(ctx, comment.VideoId, true, func(db *gorm.DB, tableName string) error {
+	return router.Execute(ctx, commentTablePrefix, comment.VideoId, true, func(db *gorm.DB, tableName string) error {
 		return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 			if err := tx.Table(tableName).Create(comment).Error; err != nil {
 				return fmt.Errorf("failed to create comment in transaction: %w", err)
 			}
+			for _, fn := range inTx {
+				if err := fn(tx); err != nil {
+					return err
+				}
+			}
 			return nil
 		})
 	})
@@ -400,97 +388,94 @@ func AddUserCommentBehavior(ctx context.Context, userBehavior interface{}) error
 
 // GetCommentInfo 获取评论信息
 func GetCommentInfo(ctx context.Context, commentID int64) (*model.Comment, error) {
-	shardingManager := GetShardingManager()
-	if shardingManager == nil {
-		return nil, errors.New("sharding manager is not initialized")
+	router := GetRouter()
+	if router == nil {
+		return nil, errors.New("sharding router is not initialized")
 	}
 
 	// 遍历所有分片查找评论
-	allDatabases := shardingManager.GetAllDatabases()
-	for _, db := range allDatabases {
-		for tableIndex := 0; tableIndex < shardingManager.config.TableCount; tableIndex++ {
-			tableName := fmt.Sprintf("comments_%d", tableIndex)
-			var comment model.Comment
-
-			err := db.WithContext(ctx).Table(tableName).Where("comment_id = ?", commentID).First(&comment).Error
-			if err == nil {
-				return &comment, nil
-			} else if !errors.Is(err, gorm.ErrRecordNotFound) {
-				return nil, fmt.Errorf("failed to get comment info from %s: %w", tableName, err)
-			}
-		}
+	comment, found, err := findComment(ctx, router, commentID, "*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comment info: %w", err)
 	}
-
-	return nil, nil // 未找到评论
+	if !found {
+		return nil, nil // 未找到评论
+	}
+	return comment, nil
 }
 
 // GetChildCommentCount 获取子评论数量
 func GetChildCommentCount(ctx context.Context, parentCommentID int64) (int64, error) {
-	// 使用全局分片管理器实例
-	shardingManager := GetShardingManager()
-	if shardingManager == nil {
-		return 0, errors.New("sharding manager is not initialized")
+	// 使用全局分片路由实例
+	router := GetRouter()
+	if router == nil {
+		return 0, errors.New("sharding router is not initialized")
 	}
 
-	var totalCount int64
-
 	// 遍历所有分片统计子评论数量
-	allDatabases := shardingManager.GetAllDatabases()
-	for _, db := range allDatabases {
-		for tableIndex := 0; tableIndex < shardingManager.config.TableCount; tableIndex++ {
-			tableName := fmt.Sprintf("comments_%d", tableIndex)
-			var count int64
-			if err := db.WithContext(ctx).Table(tableName).Where("parent_id = ?", parentCommentID).Count(&count).Error; err != nil {
-				if !errors.Is(err, gorm.ErrRecordNotFound) {
-					return 0, fmt.Errorf("failed to get child comment count from %s: %w", tableName, err)
-				}
-			}
-			totalCount += count
-		}
+	totalCount, err := sharding.ScatterCount(ctx, router, commentTablePrefix, func(db *gorm.DB, tableName string) (int64, error) {
+		var count int64
+		err := db.Table(tableName).Where("parent_id = ?", parentCommentID).Count(&count).Error
+		return count, err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get child comment count: %w", err)
 	}
-
 	return totalCount, nil
 }
 
-// GetVideoCommentListForHotSort 获取视频评论列表（热度排序）
-func GetVideoCommentListForHotSort(ctx context.Context, videoID int64, pageNum, pageSize int64) (*[]int64, error) {
-	shardingManager := GetShardingManager()
-	if shardingManager == nil {
-		return nil, errors.New("sharding manager is not initialized")
+// CommentHotStat 建立评论热度排行所需的评论数据
+type CommentHotStat struct {
+	CommentId int64
+	ParentId  int64
+	LikeCount int64
+	CreatedAt string
+}
+
+// excludeComments 在查询中排除excluded中的评论，用于跳过因举报被隐藏的评论
+func excludeComments(query *gorm.DB, excluded []int64) *gorm.DB {
+	if len(excluded) == 0 {
+		return query
 	}
+	return query.Where("comment_id NOT IN ?", excluded)
+}
 
-	var commentIDs []int64
-	offset := (pageNum - 1) * pageSize
+// GetVideoCommentHotStats 获取视频最新的至多limit条评论的热度数据，不包括excluded中的评论；评论与视频在同一分片，只查询一次
+func GetVideoCommentHotStats(ctx context.Context, videoID int64, limit int, excluded []int64) ([]CommentHotStat, error) {
+	router := GetRouter()
+	if router == nil {
+		return nil, errors.New("sharding router is not initialized")
+	}
 
-	err := shardingManager.ExecuteInShard(ctx, videoID, false, func(db *gorm.DB, tableName string) error {
-		return db.WithContext(ctx).Table(tableName).
-			Select("comment_id").
-			Where("video_id = ?", videoID).
-			Order("like_count DESC, created_at DESC").
-			Limit(int(pageSize)).
-			Offset(int(offset)).
-			Pluck("comment_id", &commentIDs).Error
+	var stats []CommentHotStat
+	err := router.Execute(ctx, commentTablePrefix, videoID, false, func(db *gorm.DB, tableName string) error {
+		query := db.WithContext(ctx).Table(tableName).
+			Select("comment_id, parent_id, like_count, created_at").
+			Where("video_id = ?", videoID)
+		return excludeComments(query, excluded).
+			Order("created_at DESC, comment_id DESC").
+			Limit(limit).
+			Find(&stats).Error
 	})
-
 	if err != nil {
-		return nil, fmt.Errorf("failed to get video comments for hot sort: %w", err)
+		return nil, fmt.Errorf("failed to get video comment hot stats: %w", err)
 	}
-
-	return &commentIDs, nil
+	return stats, nil
 }
 
-// GetVideoCommentListByPartWithSort 获取视频评论列表（分页+排序）
-func GetVideoCommentListByPartWithSort(ctx context.Context, videoID int64, pageNum, pageSize int64, sortType string) (*[]int64, error) {
-	shardingManager := GetShardingManager()
-	if shardingManager == nil {
-		return nil, errors.New("sharding manager is not initialized")
+// GetVideoCommentListByPartWithSort 获取视频的一级评论列表（分页+排序），不包括excluded中的评论
+func GetVideoCommentListByPartWithSort(ctx context.Context, videoID int64, pageNum, pageSize int64, sortType string, excluded []int64) (*[]int64, error) {
+	router := GetRouter()
+	if router == nil {
+		return nil, errors.New("sharding router is not initialized")
 	}
 
 	var commentIDs []int64
 	offset := (pageNum - 1) * pageSize
 
-	err := shardingManager.ExecuteInShard(ctx, videoID, false, func(db *gorm.DB, tableName string) error {
-		query := db.WithContext(ctx).Table(tableName).Select("comment_id").Where("video_id = ?", videoID)
+	err := router.Execute(ctx, commentTablePrefix, videoID, false, func(db *gorm.DB, tableName string) error {
+		query := db.WithContext(ctx).Table(tableName).Select("comment_id").Where("video_id = ? AND parent_id <= 0", videoID)
+		query = excludeComments(query, excluded)
 
 		switch sortType {
 		case "hot":
@@ -511,20 +496,20 @@ func GetVideoCommentListByPartWithSort(ctx context.Context, videoID int64, pageN
 	return &commentIDs, nil
 }
 
-// GetVideoCommentListByPart 获取视频评论列表（分页）
+// GetVideoCommentListByPart 获取视频的一级评论列表（分页）
 func GetVideoCommentListByPart(ctx context.Context, videoID int64, pageNum, pageSize int64) (*[]int64, error) {
-	shardingManager := GetShardingManager()
-	if shardingManager == nil {
-		return nil, errors.New("sharding manager is not initialized")
+	router := GetRouter()
+	if router == nil {
+		return nil, errors.New("sharding router is not initialized")
 	}
 
 	var commentIDs []int64
 	offset := (pageNum - 1) * pageSize
 
-	err := shardingManager.ExecuteInShard(ctx, videoID, false, func(db *gorm.DB, tableName string) error {
+	err := router.Execute(ctx, commentTablePrefix, videoID, false, func(db *gorm.DB, tableName string) error {
 		return db.WithContext(ctx).Table(tableName).
 			Select("comment_id").
-			Where("video_id = ?", videoID).
+			Where("video_id = ? AND parent_id <= 0", videoID).
 			Order("created_at DESC").
 			Limit(int(pageSize)).
 			Offset(int(offset)).
@@ -538,59 +523,333 @@ func GetVideoCommentListByPart(ctx context.Context, videoID int64, pageNum, page
 	return &commentIDs, nil
 }
 
-// GetCommentChildListByPart 获取子评论列表（分页）
-func GetCommentChildListByPart(ctx context.Context, parentCommentID int64, pageNum, pageSize int64) (*[]int64, error) {
-	shardingManager := GetShardingManager()
-	if shardingManager == nil {
-		return nil, errors.New("sharding manager is not initialized")
+// videoCommentKey 视频评论游标分页的排序列
+type videoCommentKey struct {
+	CommentId int64
+	CreatedAt string
+	LikeCount int64
+}
+
+// GetVideoCommentListByCursor 按游标获取视频的一级评论列表，返回下一页游标，没有下一页时游标为空
+// sortType为hot时按(like_count, comment_id)倒序，否则按(created_at, comment_id)倒序，两种排序的游标不能混用；
+// excluded中的评论在查询中排除，不占用分页的条数
+func GetVideoCommentListByCursor(ctx context.Context, videoID int64, sortType, cursor string, limit int, excluded []int64) ([]int64, string, error) {
+	router := GetRouter()
+	if router == nil {
+		return nil, "", errors.New("sharding router is not initialized")
 	}
 
-	// 由于parentCommentID可能来自任何分片，需要遍历所有分片
-	var allCommentIDs []int64
-	offset := (pageNum - 1) * pageSize
-	limit := int(pageSize)
-	currentCount := 0
-
-	allDatabases := shardingManager.GetAllDatabases()
-	for _, db := range allDatabases {
-		for tableIndex := 0; tableIndex < shardingManager.config.TableCount; tableIndex++ {
-			if currentCount >= limit {
-				break
+	scope := "comments:latest"
+	if sortType == "hot" {
+		scope = "comments:hot"
+	}
+	after, err := sharding.DecodeCursor[sharding.KeyCursor](scope, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var rows []videoCommentKey
+	err = router.Execute(ctx, commentTablePrefix, videoID, false, func(db *gorm.DB, tableName string) error {
+		query := db.WithContext(ctx).Table(tableName).
+			Select("comment_id, created_at, like_count").
+			Where("video_id = ? AND parent_id <= 0", videoID)
+		query = excludeComments(query, excluded)
+		if sortType == "hot" {
+			if after != nil {
+				likeCount, err := strconv.ParseInt(after.Key, 10, 64)
+				if err != nil {
+					return sharding.ErrInvalidCursor
+				}
+				query = query.Where("like_count < ? OR (like_count = ? AND comment_id < ?)", likeCount, likeCount, after.ID)
+			}
+			query = query.Order("like_count DESC, comment_id DESC")
+		} else {
+			if after != nil {
+				query = query.Where("created_at < ? OR (created_at = ? AND comment_id < ?)", after.Key, after.Key, after.ID)
 			}
+			query = query.Order("created_at DESC, comment_id DESC")
+		}
+		// 多读一条用于判断是否还有下一页
+		return query.Limit(limit + 1).Find(&rows).Error
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get video comments by cursor: %w", err)
+	}
+
+	var nextCursor string
+	if len(rows) > limit {
+		rows = rows[:limit]
+		last := rows[limit-1]
+		if sortType == "hot" {
+			nextCursor = sharding.KeyCursor{Key: strconv.FormatInt(last.LikeCount, 10), ID: last.CommentId}.Encode(scope)
+		} else {
+			nextCursor = sharding.KeyCursor{Key: last.CreatedAt, ID: last.CommentId}.Encode(scope)
+		}
+	}
+
+	commentIDs := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		commentIDs = append(commentIDs, row.CommentId)
+	}
+	return commentIDs, nextCursor, nil
+}
 
-			tableName := fmt.Sprintf("comments_%d", tableIndex)
-			var commentIDs []int64
+// childCommentKey 子评论跨分片合并时的排序键，也是游标的内容
+type childCommentKey struct {
+	CommentId int64  `json:"id"`
+	CreatedAt string `json:"ts"`
+}
+
+func (a childCommentKey) less(b childCommentKey) bool {
+	if a.CreatedAt != b.CreatedAt {
+		return a.CreatedAt < b.CreatedAt
+	}
+	return a.CommentId < b.CommentId
+}
 
-			err := db.WithContext(ctx).Table(tableName).
-				Select("comment_id").
-				Where("parent_id = ?", parentCommentID).
-				Order("created_at ASC").
-				Limit(limit-currentCount).
-				Offset(int(offset)).
-				Pluck("comment_id", &commentIDs).Error
+// childCommentQuery 父评论parentCommentID的子评论，按创建时间正序，after不为nil时只查询排在after之后的，不包括excluded中的评论
+func childCommentQuery(db *gorm.DB, tableName string, parentCommentID int64, after *childCommentKey, limit int, excluded []int64) ([]childCommentKey, error) {
+	query := db.Table(tableName).
+		Select("comment_id, created_at").
+		Where("parent_id = ?", parentCommentID)
+	query = excludeComments(query, excluded)
+	if after != nil {
+		query = query.Where("created_at > ? OR (created_at = ? AND comment_id > ?)", after.CreatedAt, after.CreatedAt, after.CommentId)
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	var rows []childCommentKey
+	err := query.Order("created_at ASC, comment_id ASC").Find(&rows).Error
+	return rows, err
+}
 
-			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-				return nil, fmt.Errorf("failed to get comment child list from %s: %w", tableName, err)
+// GetCommentChildListByPart 获取子评论列表（分页），不包括excluded中的评论
+func GetCommentChildListByPart(ctx context.Context, parentCommentID int64, pageNum, pageSize int64, excluded []int64) (*[]int64, error) {
+	router := GetRouter()
+	if router == nil {
+		return nil, errors.New("sharding router is not initialized")
+	}
+
+	// 由于parentCommentID可能来自任何分片，需要并发查询所有分片后按创建时间归并
+	children, err := sharding.ScatterGather(ctx, router, sharding.ScatterQuery[childCommentKey]{
+		Table: commentTablePrefix,
+		Fetch: func(db *gorm.DB, tableName string, limit int) ([]childCommentKey, error) {
+			return childCommentQuery(db, tableName, parentCommentID, nil, limit, excluded)
+		},
+		Less:   childCommentKey.less,
+		Offset: int((pageNum - 1) * pageSize),
+		Limit:  int(pageSize),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comment child list: %w", err)
+	}
+
+	allCommentIDs := make([]int64, 0, len(children))
+	for _, child := range children {
+		allCommentIDs = append(allCommentIDs, child.CommentId)
+	}
+	return &allCommentIDs, nil
+}
+
+// GetCommentChildListByCursor 按游标获取子评论列表，返回下一页游标，没有下一页时游标为空
+// 每个分片只读取游标之后的一页数据，翻页深度不影响查询代价；excluded中的评论在查询中排除
+func GetCommentChildListByCursor(ctx context.Context, parentCommentID int64, cursor string, limit int, excluded []int64) ([]int64, string, error) {
+	router := GetRouter()
+	if router == nil {
+		return nil, "", errors.New("sharding router is not initialized")
+	}
+
+	page, err := sharding.ScatterPage(ctx, router, sharding.PageQuery[childCommentKey]{
+		Table: commentTablePrefix,
+		Fetch: func(db *gorm.DB, tableName string, after *childCommentKey, limit int) ([]childCommentKey, error) {
+			return childCommentQuery(db, tableName, parentCommentID, after, limit, excluded)
+		},
+		Less:   childCommentKey.less,
+		Cursor: cursor,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get comment child list: %w", err)
+	}
+
+	commentIDs := make([]int64, 0, len(page.Items))
+	for _, child := range page.Items {
+		commentIDs = append(commentIDs, child.CommentId)
+	}
+	return commentIDs, page.NextCursor, nil
+}
+
+// CommentReplies 一条评论最早的几条直接回复
+type CommentReplies struct {
+	ReplyIDs   []int64
+	Total      int64  // 直接回复总数
+	NextCursor string // 继续加载回复的游标，与GetCommentChildListByCursor通用，没有更多回复时为空
+}
+
+// GetCommentThreadReplies 获取视频下各条评论按创建时间正序的前limit条直接回复及回复总数，
+// 回复与被回复的评论属于同一视频，都在视频所在的分表中，只查询一次；excluded中的回复不返回也不计数
+func GetCommentThreadReplies(ctx context.Context, videoID int64, parentIDs []int64, limit int, excluded []int64) (map[int64]*CommentReplies, error) {
+	router := GetRouter()
+	if router == nil {
+		return nil, errors.New("sharding router is not initialized")
+	}
+
+	result := make(map[int64]*CommentReplies, len(parentIDs))
+	if len(parentIDs) == 0 {
+		return result, nil
+	}
+	err := router.Execute(ctx, commentTablePrefix, videoID, false, func(db *gorm.DB, tableName string) error {
+		var counts []struct {
+			ParentId int64
+			Total    int64
+		}
+		query := db.WithContext(ctx).Table(tableName).
+			Select("parent_id, COUNT(*) AS total").
+			Where("video_id = ? AND parent_id IN ?", videoID, parentIDs)
+		if err := excludeComments(query, excluded).
+			Group("parent_id").
+			Find(&counts).Error; err != nil {
+			return err
+		}
+		for _, c := range counts {
+			result[c.ParentId] = &CommentReplies{Total: c.Total}
+		}
+
+		for parentID, replies := range result {
+			// 多读一条用于判断是否还有更多回复
+			rows, err := childCommentQuery(db.WithContext(ctx), tableName, parentID, nil, limit+1, excluded)
+			if err != nil {
+				return err
 			}
+			if len(rows) > limit {
+				rows = rows[:limit]
+				if limit > 0 {
+					if replies.NextCursor, err = sharding.EncodeCursor(commentTablePrefix, rows[limit-1]); err != nil {
+						return err
+					}
+				}
+			}
+			for _, row := range rows {
+				replies.ReplyIDs = append(replies.ReplyIDs, row.CommentId)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comment thread replies: %w", err)
+	}
+	return result, nil
+}
+
+// GetCommentsLikedByUser 返回视频下的评论中被用户点赞过的，点赞记录与评论在同一分库
+func GetCommentsLikedByUser(ctx context.Context, videoID, userID int64, commentIDs []int64) (map[int64]bool, error) {
+	router := GetRouter()
+	if router == nil {
+		return nil, errors.New("sharding router is not initialized")
+	}
 
-			allCommentIDs = append(allCommentIDs, commentIDs...)
-			currentCount += len(commentIDs)
+	liked := make(map[int64]bool, len(commentIDs))
+	if len(commentIDs) == 0 {
+		return liked, nil
+	}
+	err := router.Execute(ctx, commentTablePrefix, videoID, false, func(db *gorm.DB, tableName string) error {
+		likeTableName := fmt.Sprintf("comment_likes_%s", tableName[len("comments_"):])
+		var ids []int64
+		if err := db.WithContext(ctx).Table(likeTableName).
+			Where("user_id = ? AND comment_id IN ?", userID, commentIDs).
+			Pluck("comment_id", &ids).Error; err != nil {
+			return err
 		}
+		for _, id := range ids {
+			liked[id] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comments liked by user: %w", err)
 	}
+	return liked, nil
+}
 
-	return &allCommentIDs, nil
+// ErrCommentEditConflict 评论在读取后已被修改，编辑需要基于最新内容重试
+var ErrCommentEditConflict = errors.New("comment has been modified concurrently")
+
+// commentEditTable 评论分表对应的编辑历史表
+func commentEditTable(tableName string) string {
+	return fmt.Sprintf("comment_edits_%s", tableName[len("comments_"):])
+}
+
+// EditComment 把评论内容替换为content，被替换的内容在同一个分库事务中写入编辑历史；
+// 只有评论内容仍是comment.Content时才修改，避免并发编辑时丢失历史版本
+func EditComment(ctx context.Context, comment *model.Comment, content string, editedAt time.Time) error {
+	router := GetRouter()
+	if router == nil {
+		return errors.New("sharding router is not initialized")
+	}
+
+	at := editedAt.Format(time.DateTime)
+	return router.Execute(ctx, commentTablePrefix, comment.VideoId, true, func(db *gorm.DB, tableName string) error {
+		return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			result := tx.Table(tableName).
+				Where("comment_id = ? AND content = ?", comment.CommentId, comment.Content).
+				Updates(map[string]interface{}{"content": content, "edited_at": at, "updated_at": at})
+			if result.Error != nil {
+				return fmt.Errorf("failed to edit comment: %w", result.Error)
+			}
+			if result.RowsAffected == 0 {
+				return ErrCommentEditConflict
+			}
+			edit := &model.CommentEdit{
+				CommentId: comment.CommentId,
+				VideoId:   comment.VideoId,
+				UserId:    comment.UserId,
+				Content:   comment.Content,
+				EditedAt:  at,
+			}
+			if err := tx.Table(commentEditTable(tableName)).Create(edit).Error; err != nil {
+				// 编辑历史按(comment_id, edited_at)唯一，同一秒内的另一次编辑已经替换了这条评论
+				var mysqlErr *mysql.MySQLError
+				if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+					return ErrCommentEditConflict
+				}
+				return fmt.Errorf("failed to save comment edit history: %w", err)
+			}
+			return nil
+		})
+	})
+}
+
+// GetCommentEdits 获取评论被编辑前的各个版本，按编辑时间倒序
+func GetCommentEdits(ctx context.Context, videoID, commentID int64) ([]*model.CommentEdit, error) {
+	router := GetRouter()
+	if router == nil {
+		return nil, errors.New("sharding router is not initialized")
+	}
+
+	var edits []*model.CommentEdit
+	err := router.Execute(ctx, commentTablePrefix, videoID, false, func(db *gorm.DB, tableName string) error {
+		return db.WithContext(ctx).Table(commentEditTable(tableName)).
+			Where("comment_id = ?", commentID).
+			Order("edit_id DESC").
+			Find(&edits).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comment edits: %w", err)
+	}
+	return edits, nil
 }
 
 // GetVideoCommentList 获取视频评论列表
 func GetVideoCommentList(ctx context.Context, videoID int64) (*[]int64, error) {
-	shardingManager := GetShardingManager()
-	if shardingManager == nil {
-		return nil, errors.New("sharding manager is not initialized")
+	router := GetRouter()
+	if router == nil {
+		return nil, errors.New("sharding router is not initialized")
 	}
 
 	var commentIDs []int64
 
-	err := shardingManager.ExecuteInShard(ctx, videoID, false, func(db *gorm.DB, tableName string) error {
+	err := router.Execute(ctx, commentTablePrefix, videoID, false, func(db *gorm.DB, tableName string) error {
 		return db.WithContext(ctx).Table(tableName).
 			Select("comment_id").
 			Where("video_id = ?", videoID).
@@ -607,9 +866,9 @@ func GetVideoCommentList(ctx context.Context, videoID int64) (*[]int64, error) {
 
 // DeleteComment 删除评论
 func DeleteComment(ctx context.Context, commentID int64) error {
-	shardingManager := GetShardingManager()
-	if shardingManager == nil {
-		return errors.New("sharding manager is not initialized")
+	router := GetRouter()
+	if router == nil {
+		return errors.New("sharding router is not initialized")
 	}
 
 	// 先获取评论信息以确定分片
@@ -621,16 +880,21 @@ func DeleteComment(ctx context.Context, commentID int64) error {
 		return errors.New("comment not found")
 	}
 
-	// 使用视频ID作为分片键删除对应分片中的评论
-	return shardingManager.ExecuteInShard(ctx, comment.VideoId, true, func(db *gorm.DB, tableName string) error {
-		result := db.WithContext(ctx).Table(tableName).Where("comment_id = ?", commentID).Delete(&model.Comment{})
-		if result.Error != nil {
-			return fmt.Errorf("failed to delete comment: %w", result.Error)
-		}
-		if result.RowsAffected == 0 {
-			return errors.New("comment not found in shard")
-		}
-		return nil
+	// 使用视频ID作为分片键删除对应分片中的评论及其编辑历史
+	return router.Execute(ctx, commentTablePrefix, comment.VideoId, true, func(db *gorm.DB, tableName string) error {
+		return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			result := tx.Table(tableName).Where("comment_id = ?", commentID).Delete(&model.Comment{})
+			if result.Error != nil {
+				return fmt.Errorf("failed to delete comment: %w", result.Error)
+			}
+			if result.RowsAffected == 0 {
+				return errors.New("comment not found in shard")
+			}
+			if err := tx.Table(commentEditTable(tableName)).Where("comment_id = ?", commentID).Delete(&model.CommentEdit{}).Error; err != nil {
+				return fmt.Errorf("failed to delete comment edit history: %w", err)
+			}
+			return nil
+		})
 	})
 }
 
@@ -645,3 +909,18 @@ func GetVideoInfo(ctx context.Context, videoID int64) (interface{}, error) {
 	// TODO: 实现获取视频信息逻辑
 	return nil, nil
 }
+
+// findComment 评论表按video_id分片，只有评论ID时依次在各分表中查找，columns为需要读取的列
+func findComment(ctx context.Context, router *sharding.Router, commentID int64, columns string) (*model.Comment, bool, error) {
+	return sharding.ScatterFirst(ctx, router, commentTablePrefix, false, func(db *gorm.DB, tableName string) (*model.Comment, bool, error) {
+		var comment model.Comment
+		err := db.Table(tableName).Select(columns).Where("comment_id = ?", commentID).First(&comment).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, nil
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		return &comment, true, nil
+	})
+}
@@ -7,6 +7,8 @@ import (
 	"HuaTug.com/cmd/interaction/dal/db"
 	"HuaTug.com/config"
 	"HuaTug.com/pkg/cache"
+	"HuaTug.com/pkg/idgen"
+	"HuaTug.com/pkg/sharding"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 )
 
@@ -15,16 +17,19 @@ var ShardedCommentDBInstance *db.ShardedCommentDB
 
 func Init() {
 	db.Init()
-	// 初始化分片管理器
-	if err := initShardingManager(); err != nil {
-		hlog.Errorf("Failed to initialize sharding manager: %v", err)
-		// 对于分片管理器初始化失败，应该panic，因为系统依赖分片功能
-		panic("Sharding manager initialization failed: " + err.Error())
+	if err := idgen.InitFromConfig(db.DB); err != nil {
+		panic("ID generator initialization failed: " + err.Error())
+	}
+	// 初始化分片路由
+	if err := initShardingRouter(); err != nil {
+		hlog.Errorf("Failed to initialize sharding router: %v", err)
+		// 对于分片路由初始化失败，应该panic，因为系统依赖分片功能
+		panic("Sharding router initialization failed: " + err.Error())
 	}
 }
 
-func initShardingManager() error {
-	hlog.Info("Starting sharding manager initialization...")
+func initShardingRouter() error {
+	hlog.Info("Starting sharding router initialization...")
 
 	// 解析连接超时时间
 	connMaxLifetime, err := time.ParseDuration(config.ConfigInfo.CommentSharding.ConnMaxLifetime)
@@ -34,31 +39,28 @@ func initShardingManager() error {
 	}
 
 	// 从配置中获取分片配置
-	// 处理SlaveDSNs类型转换 - 将[][]string转换为[]string
-	var slaveDSNs []string
-	for _, dsns := range config.ConfigInfo.CommentSharding.SlaveDSNs {
-		slaveDSNs = append(slaveDSNs, dsns...)
-	}
-
-	shardingConfig := &db.ShardingConfig{
-		DatabaseCount:   config.ConfigInfo.CommentSharding.DatabaseCount,
-		TableCount:      config.ConfigInfo.CommentSharding.TableCount,
-		MasterDSNs:      config.ConfigInfo.CommentSharding.MasterDSNs,
-		SlaveDSNs:       slaveDSNs,
-		MaxOpenConns:    config.ConfigInfo.CommentSharding.MaxOpenConns,
-		MaxIdleConns:    config.ConfigInfo.CommentSharding.MaxIdleConns,
-		ConnMaxLifetime: connMaxLifetime,
+	shardingConfig := &sharding.TopologyConfig{
+		DatabaseCount:        config.ConfigInfo.CommentSharding.DatabaseCount,
+		TableCount:           config.ConfigInfo.CommentSharding.TableCount,
+		MasterDSNs:           config.ConfigInfo.CommentSharding.MasterDSNs,
+		SlaveDSNs:            config.ConfigInfo.CommentSharding.SlaveDSNs,
+		MaxOpenConns:         config.ConfigInfo.CommentSharding.MaxOpenConns,
+		MaxIdleConns:         config.ConfigInfo.CommentSharding.MaxIdleConns,
+		ConnMaxLifetime:      connMaxLifetime,
+		ReplicaMaxLag:        parseReplicaDuration("replica_max_lag", config.ConfigInfo.CommentSharding.ReplicaMaxLag),
+		ReplicaCheckInterval: parseReplicaDuration("replica_check_interval", config.ConfigInfo.CommentSharding.ReplicaCheckInterval),
+		MetaDB:               db.DB, // 分片拓扑版本保存在主库
 	}
 
-	// 使用全局的InitShardingManager初始化分片管理器
-	if err := db.InitShardingManager(shardingConfig); err != nil {
-		return fmt.Errorf("failed to initialize sharding manager: %w", err)
+	// 使用全局的InitRouter初始化分片路由
+	if err := db.InitRouter(shardingConfig); err != nil {
+		return err
 	}
 
-	// 验证分片管理器是否成功初始化
-	shardingManager := db.GetShardingManager()
-	if shardingManager == nil {
-		return fmt.Errorf("sharding manager is nil after initialization")
+	// 验证分片路由是否成功初始化
+	router := db.GetRouter()
+	if router == nil {
+		return fmt.Errorf("sharding router is nil after initialization")
 	}
 
 	// 初始化缓存管理器
@@ -66,7 +68,20 @@ func initShardingManager() error {
 	// TODO: 这里应该根据实际情况初始化缓存管理器
 
 	// 创建分片评论数据库实例
-	ShardedCommentDBInstance = db.NewShardedCommentDB(shardingManager, cacheManager)
+	ShardedCommentDBInstance = db.NewShardedCommentDB(router, cacheManager)
 	hlog.Info("ShardedCommentDBInstance created successfully: ", ShardedCommentDBInstance)
 	return nil
 }
+
+// parseReplicaDuration 解析从库相关的时间配置，未配置或格式错误时返回0，由分片路由使用默认值
+func parseReplicaDuration(name, value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		hlog.Errorf("Failed to parse %s '%s': %v, using default", name, value, err)
+		return 0
+	}
+	return d
+}
@@ -6,6 +6,7 @@ import (
 	"HuaTug.com/cmd/interaction/service"
 	"HuaTug.com/kitex_gen/base"
 	"HuaTug.com/kitex_gen/interactions"
+	"HuaTug.com/pkg/errno"
 	"HuaTug.com/pkg/mq"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
@@ -13,25 +14,48 @@ import (
 )
 
 type InteractionServiceImpl struct {
-	producer *mq.Producer
+	bus *mq.Bus
 }
 
-// 全局生产者实例，在main.go中初始化
-var globalProducer *mq.Producer
+// 全局事件总线，在main.go中初始化
+var globalBus *mq.Bus
 
-func SetGlobalProducer(producer *mq.Producer) {
-	globalProducer = producer
+func SetGlobalBus(bus *mq.Bus) {
+	globalBus = bus
 }
 
-func GetGlobalProducer() *mq.Producer {
-	return globalProducer
+func GetGlobalBus() *mq.Bus {
+	return globalBus
+}
+
+// 死信队列管理器，在main.go中初始化
+var globalDLQAdmin *mq.DLQAdmin
+
+func SetGlobalDLQAdmin(admin *mq.DLQAdmin) {
+	globalDLQAdmin = admin
 }
 
 func (s *InteractionServiceImpl) LikeAction(ctx context.Context, req *interactions.LikeActionRequest) (resp *interactions.LikeActionResponse, err error) {
-	// 使用全局producer实例
-	likeService := service.NewLikeActionService(ctx, globalProducer)
+	// 使用全局事件总线
+	likeService := service.NewLikeActionService(ctx, globalBus)
 
 	resp, err = likeService.LikeAction(ctx, req)
+	if errors.Is(err, errno.UserBlockedErr) {
+		return &interactions.LikeActionResponse{
+			Base: &base.Status{
+				Code: consts.StatusForbidden,
+				Msg:  "Unable to interact with this user",
+			},
+		}, nil
+	}
+	if errors.Is(err, errno.ServiceUnavailableErr) {
+		return &interactions.LikeActionResponse{
+			Base: &base.Status{
+				Code: consts.StatusServiceUnavailable,
+				Msg:  "Service is temporarily unavailable, please retry",
+			},
+		}, nil
+	}
 	if err != nil {
 		hlog.CtxErrorf(ctx, "service.LikeAction failed, original error: %v", errors.Cause(err))
 		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
@@ -51,8 +75,8 @@ func (s *InteractionServiceImpl) LikeAction(ctx context.Context, req *interactio
 
 
 func (s *InteractionServiceImpl) LikeList(ctx context.Context, req *interactions.LikeListRequest) (resp *interactions.LikeListResponse, err error) {
-	//likeService := service.NewLikeActionService(ctx, globalProducer)
-	//resp, err = likeService.LikeList(ctx, req)
+	likeService := service.NewLikeActionService(ctx, globalBus)
+	resp, err = likeService.GetLikeList(ctx, req)
 	if err != nil {
 		hlog.CtxErrorf(ctx, "service.LikeList failed, original error: %v", errors.Cause(err))
 		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
@@ -74,7 +98,17 @@ func (s *InteractionServiceImpl) CreateComment(ctx context.Context, req *interac
 	resp.Base = &base.Status{}
 	// TODO: Add your implementation logic here
 	// Example:
-	err = service.NewCommentService(ctx).CreateComment(ctx, req)
+	resp.PendingReview, err = service.NewCommentService(ctx).CreateComment(ctx, req)
+	if errors.Is(err, errno.UserBlockedErr) {
+		resp.Base.Code = consts.StatusForbidden
+		resp.Base.Msg = "Unable to interact with this user"
+		return resp, nil
+	}
+	if errors.Is(err, errno.ServiceUnavailableErr) {
+		resp.Base.Code = consts.StatusServiceUnavailable
+		resp.Base.Msg = "Service is temporarily unavailable, please retry"
+		return resp, nil
+	}
 	if err != nil {
 		hlog.CtxErrorf(ctx, "service.CreateComment failed, original error: %v", errors.Cause(err))
 		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
@@ -84,6 +118,9 @@ func (s *InteractionServiceImpl) CreateComment(ctx context.Context, req *interac
 	}
 	resp.Base.Code = consts.StatusOK
 	resp.Base.Msg = "Create Comment Successfully"
+	if resp.PendingReview {
+		resp.Base.Msg = "Comment is pending review"
+	}
 	return resp, nil
 }
 
@@ -91,6 +128,14 @@ func (s *InteractionServiceImpl) ListComment(ctx context.Context, req *interacti
 	// TODO: Add your implementation logic here
 	// Example:
 	resp, err = service.NewCommentService(ctx).ListComment(ctx, req)
+	if resp == nil {
+		resp = &interactions.ListCommentResponse{Base: &base.Status{}}
+	}
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Invalid cursor"
+		return resp, nil
+	}
 	if err != nil {
 		hlog.CtxErrorf(ctx, "service.ListComment failed, original error: %v", errors.Cause(err))
 		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
@@ -103,6 +148,360 @@ func (s *InteractionServiceImpl) ListComment(ctx context.Context, req *interacti
 	return resp, nil
 }
 
+func (s *InteractionServiceImpl) ListCommentThreads(ctx context.Context, req *interactions.ListCommentThreadsRequest) (resp *interactions.ListCommentThreadsResponse, err error) {
+	resp, err = service.NewCommentService(ctx).ListCommentThreads(ctx, req)
+	if resp == nil {
+		resp = &interactions.ListCommentThreadsResponse{Base: &base.Status{}}
+	}
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = err.Error()
+		return resp, nil
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.ListCommentThreads failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to List Comment Threads!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "ListCommentThreads Successfully"
+	return resp, nil
+}
+
+func (s *InteractionServiceImpl) PinComment(ctx context.Context, req *interactions.PinCommentRequest) (resp *interactions.PinCommentResponse, err error) {
+	resp = &interactions.PinCommentResponse{Base: &base.Status{}}
+	err = service.NewCommentService(ctx).PinComment(ctx, req)
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = err.Error()
+		return resp, nil
+	}
+	if errors.Is(err, errno.AuthorizationFailedErr) {
+		resp.Base.Code = consts.StatusForbidden
+		resp.Base.Msg = "Only the video author can pin comments"
+		return resp, nil
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.PinComment failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Pin Comment!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "PinComment Successfully"
+	return resp, nil
+}
+
+func (s *InteractionServiceImpl) EditComment(ctx context.Context, req *interactions.EditCommentRequest) (resp *interactions.EditCommentResponse, err error) {
+	resp, err = service.NewCommentService(ctx).EditComment(ctx, req)
+	if resp == nil {
+		resp = &interactions.EditCommentResponse{}
+	}
+	resp.Base = &base.Status{}
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = err.Error()
+		return resp, nil
+	}
+	if errors.Is(err, errno.AuthorizationFailedErr) {
+		resp.Base.Code = consts.StatusForbidden
+		resp.Base.Msg = "Only the author can edit the comment"
+		return resp, nil
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.EditComment failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Edit Comment!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Edit Comment Successfully"
+	return resp, nil
+}
+
+func (s *InteractionServiceImpl) ListCommentEdits(ctx context.Context, req *interactions.ListCommentEditsRequest) (resp *interactions.ListCommentEditsResponse, err error) {
+	resp, err = service.NewCommentService(ctx).ListCommentEdits(ctx, req)
+	if resp == nil {
+		resp = &interactions.ListCommentEditsResponse{}
+	}
+	resp.Base = &base.Status{}
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = err.Error()
+		return resp, nil
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.ListCommentEdits failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to List Comment Edits!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "ListCommentEdits Successfully"
+	return resp, nil
+}
+
+func (s *InteractionServiceImpl) ListCommentReviews(ctx context.Context, req *interactions.ListCommentReviewsRequest) (resp *interactions.ListCommentReviewsResponse, err error) {
+	resp, err = service.NewCommentService(ctx).ListCommentReviews(ctx, req)
+	if resp == nil {
+		resp = &interactions.ListCommentReviewsResponse{}
+	}
+	resp.Base = &base.Status{}
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = err.Error()
+		return resp, nil
+	}
+	if errors.Is(err, errno.AuthorizationFailedErr) {
+		resp.Base.Code = consts.StatusForbidden
+		resp.Base.Msg = "Only admins can review comments"
+		return resp, nil
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.ListCommentReviews failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to List Comment Reviews!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "List Comment Reviews Successfully"
+	return resp, nil
+}
+
+func (s *InteractionServiceImpl) ApproveCommentReview(ctx context.Context, req *interactions.ApproveCommentReviewRequest) (resp *interactions.ApproveCommentReviewResponse, err error) {
+	resp, err = service.NewCommentService(ctx).ApproveCommentReview(ctx, req)
+	if resp == nil {
+		resp = &interactions.ApproveCommentReviewResponse{}
+	}
+	resp.Base = &base.Status{}
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = err.Error()
+		return resp, nil
+	}
+	if errors.Is(err, errno.AuthorizationFailedErr) {
+		resp.Base.Code = consts.StatusForbidden
+		resp.Base.Msg = "Only admins can review comments"
+		return resp, nil
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.ApproveCommentReview failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Approve Comment Review!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Approve Comment Review Successfully"
+	return resp, nil
+}
+
+func (s *InteractionServiceImpl) RejectCommentReview(ctx context.Context, req *interactions.RejectCommentReviewRequest) (resp *interactions.RejectCommentReviewResponse, err error) {
+	resp, err = service.NewCommentService(ctx).RejectCommentReview(ctx, req)
+	if resp == nil {
+		resp = &interactions.RejectCommentReviewResponse{}
+	}
+	resp.Base = &base.Status{}
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = err.Error()
+		return resp, nil
+	}
+	if errors.Is(err, errno.AuthorizationFailedErr) {
+		resp.Base.Code = consts.StatusForbidden
+		resp.Base.Msg = "Only admins can review comments"
+		return resp, nil
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.RejectCommentReview failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Reject Comment Review!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Reject Comment Review Successfully"
+	return resp, nil
+}
+
+func (s *InteractionServiceImpl) Report(ctx context.Context, req *interactions.ReportRequest) (resp *interactions.ReportResponse, err error) {
+	resp, err = service.NewReportService(ctx).Report(ctx, req)
+	if resp == nil {
+		resp = &interactions.ReportResponse{}
+	}
+	resp.Base = &base.Status{}
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = err.Error()
+		return resp, nil
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.Report failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Report!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Report Successfully"
+	return resp, nil
+}
+
+func (s *InteractionServiceImpl) ListReportCases(ctx context.Context, req *interactions.ListReportCasesRequest) (resp *interactions.ListReportCasesResponse, err error) {
+	resp, err = service.NewReportService(ctx).ListReportCases(ctx, req)
+	if resp == nil {
+		resp = &interactions.ListReportCasesResponse{}
+	}
+	resp.Base = &base.Status{}
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = err.Error()
+		return resp, nil
+	}
+	if errors.Is(err, errno.AuthorizationFailedErr) {
+		resp.Base.Code = consts.StatusForbidden
+		resp.Base.Msg = "Only admins can handle reports"
+		return resp, nil
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.ListReportCases failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to List Report Cases!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "List Report Cases Successfully"
+	return resp, nil
+}
+
+func (s *InteractionServiceImpl) AssignReportCase(ctx context.Context, req *interactions.AssignReportCaseRequest) (resp *interactions.AssignReportCaseResponse, err error) {
+	resp = &interactions.AssignReportCaseResponse{Base: &base.Status{}}
+	err = service.NewReportService(ctx).AssignReportCase(ctx, req)
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = err.Error()
+		return resp, nil
+	}
+	if errors.Is(err, errno.AuthorizationFailedErr) {
+		resp.Base.Code = consts.StatusForbidden
+		resp.Base.Msg = "Only admins can handle reports"
+		return resp, nil
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.AssignReportCase failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Assign Report Case!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Assign Report Case Successfully"
+	return resp, nil
+}
+
+func (s *InteractionServiceImpl) ResolveReportCase(ctx context.Context, req *interactions.ResolveReportCaseRequest) (resp *interactions.ResolveReportCaseResponse, err error) {
+	resp = &interactions.ResolveReportCaseResponse{Base: &base.Status{}}
+	err = service.NewReportService(ctx).ResolveReportCase(ctx, req)
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = err.Error()
+		return resp, nil
+	}
+	if errors.Is(err, errno.AuthorizationFailedErr) {
+		resp.Base.Code = consts.StatusForbidden
+		resp.Base.Msg = "Only the assigned admin can handle this report case"
+		return resp, nil
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.ResolveReportCase failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Resolve Report Case!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Resolve Report Case Successfully"
+	return resp, nil
+}
+
+func (s *InteractionServiceImpl) DismissReportCase(ctx context.Context, req *interactions.DismissReportCaseRequest) (resp *interactions.DismissReportCaseResponse, err error) {
+	resp = &interactions.DismissReportCaseResponse{Base: &base.Status{}}
+	err = service.NewReportService(ctx).DismissReportCase(ctx, req)
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = err.Error()
+		return resp, nil
+	}
+	if errors.Is(err, errno.AuthorizationFailedErr) {
+		resp.Base.Code = consts.StatusForbidden
+		resp.Base.Msg = "Only the assigned admin can handle this report case"
+		return resp, nil
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.DismissReportCase failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Dismiss Report Case!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Dismiss Report Case Successfully"
+	return resp, nil
+}
+
+func (s *InteractionServiceImpl) GetHiddenTargets(ctx context.Context, req *interactions.GetHiddenTargetsRequest) (resp *interactions.GetHiddenTargetsResponse, err error) {
+	resp, err = service.NewReportService(ctx).GetHiddenTargets(ctx, req)
+	if resp == nil {
+		resp = &interactions.GetHiddenTargetsResponse{}
+	}
+	resp.Base = &base.Status{}
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = err.Error()
+		return resp, nil
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.GetHiddenTargets failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Get Hidden Targets!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Get Hidden Targets Successfully"
+	return resp, nil
+}
+
+func (s *InteractionServiceImpl) GetCoInteractions(ctx context.Context, req *interactions.GetCoInteractionsRequest) (resp *interactions.GetCoInteractionsResponse, err error) {
+	resp, err = service.NewCoInteractionService(ctx).GetCoInteractions(ctx, req)
+	if resp == nil {
+		resp = &interactions.GetCoInteractionsResponse{}
+	}
+	resp.Base = &base.Status{}
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = err.Error()
+		return resp, nil
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.GetCoInteractions failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Get Co-Interactions!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Get Co-Interactions Successfully"
+	return resp, nil
+}
+
 func (s *InteractionServiceImpl) DeleteComment(ctx context.Context, req *interactions.CommentDeleteRequest) (resp *interactions.CommentDeleteResponse, err error) {
 	resp = new(interactions.CommentDeleteResponse)
 	resp.Base = &base.Status{}
@@ -193,3 +592,86 @@ func (s *InteractionServiceImpl) MarkNotificationRead(ctx context.Context, req *
 
 	return resp, nil
 }
+
+// deadLetterStatus 死信管理错误对应的状态码
+func deadLetterStatus(err error) int64 {
+	switch {
+	case errors.Is(err, mq.ErrUnknownQueue):
+		return consts.StatusBadRequest
+	case errors.Is(err, mq.ErrDeadLetterNotFound):
+		return consts.StatusNotFound
+	default:
+		return consts.StatusInternalServerError
+	}
+}
+
+func (s *InteractionServiceImpl) ListDeadLetterQueues(ctx context.Context, req *interactions.ListDeadLetterQueuesRequest) (resp *interactions.ListDeadLetterQueuesResponse, err error) {
+	resp = &interactions.ListDeadLetterQueuesResponse{Base: &base.Status{}}
+	resp.Queues, err = service.NewDeadLetterService(ctx, globalDLQAdmin).ListQueues(ctx, req)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.ListDeadLetterQueues failed, original error: %v", errors.Cause(err))
+		resp.Base.Code = deadLetterStatus(err)
+		resp.Base.Msg = err.Error()
+		return resp, nil
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "List DeadLetterQueues Successfully"
+	return resp, nil
+}
+
+func (s *InteractionServiceImpl) ListDeadLetters(ctx context.Context, req *interactions.ListDeadLettersRequest) (resp *interactions.ListDeadLettersResponse, err error) {
+	resp = &interactions.ListDeadLettersResponse{Base: &base.Status{}}
+	resp.Messages, err = service.NewDeadLetterService(ctx, globalDLQAdmin).ListMessages(ctx, req)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.ListDeadLetters failed, original error: %v", errors.Cause(err))
+		resp.Base.Code = deadLetterStatus(err)
+		resp.Base.Msg = err.Error()
+		return resp, nil
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "List DeadLetters Successfully"
+	return resp, nil
+}
+
+func (s *InteractionServiceImpl) GetDeadLetter(ctx context.Context, req *interactions.GetDeadLetterRequest) (resp *interactions.GetDeadLetterResponse, err error) {
+	resp = &interactions.GetDeadLetterResponse{Base: &base.Status{}}
+	resp.Message, err = service.NewDeadLetterService(ctx, globalDLQAdmin).GetMessage(ctx, req)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.GetDeadLetter failed, original error: %v", errors.Cause(err))
+		resp.Base.Code = deadLetterStatus(err)
+		resp.Base.Msg = err.Error()
+		return resp, nil
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Get DeadLetter Successfully"
+	return resp, nil
+}
+
+func (s *InteractionServiceImpl) ReplayDeadLetters(ctx context.Context, req *interactions.ReplayDeadLettersRequest) (resp *interactions.ReplayDeadLettersResponse, err error) {
+	resp = &interactions.ReplayDeadLettersResponse{Base: &base.Status{}}
+	// 部分消息重放失败时也返回已经重放的条数
+	resp.ReplayedCount, err = service.NewDeadLetterService(ctx, globalDLQAdmin).Replay(ctx, req)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.ReplayDeadLetters failed, original error: %v", errors.Cause(err))
+		resp.Base.Code = deadLetterStatus(err)
+		resp.Base.Msg = err.Error()
+		return resp, nil
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Replay DeadLetters Successfully"
+	return resp, nil
+}
+
+func (s *InteractionServiceImpl) PurgeDeadLetters(ctx context.Context, req *interactions.PurgeDeadLettersRequest) (resp *interactions.PurgeDeadLettersResponse, err error) {
+	resp = &interactions.PurgeDeadLettersResponse{Base: &base.Status{}}
+	resp.PurgedCount, err = service.NewDeadLetterService(ctx, globalDLQAdmin).Purge(ctx, req)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.PurgeDeadLetters failed, original error: %v", errors.Cause(err))
+		resp.Base.Code = deadLetterStatus(err)
+		resp.Base.Msg = err.Error()
+		return resp, nil
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Purge DeadLetters Successfully"
+	return resp, nil
+}
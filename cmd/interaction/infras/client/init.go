@@ -3,4 +3,5 @@ package client
 func Init() {
 	InitVideoRpc()
 	InitUserRpc()
+	InitRelationRpc()
 }
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"HuaTug.com/cmd/model"
+	"HuaTug.com/kitex_gen/relations"
+	"HuaTug.com/kitex_gen/relations/followservice"
+	"HuaTug.com/pkg/mq"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/retry"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	etcd "github.com/kitex-contrib/registry-etcd"
+)
+
+var RelationClient followservice.Client
+
+func InitRelationRpc() {
+	r, err := etcd.NewEtcdResolver([]string{"localhost:2379"})
+	if err != nil {
+		hlog.Info(err)
+	}
+
+	c, err := followservice.NewClient(
+		"Relation",
+		client.WithMuxConnection(1),                       // mux
+		client.WithRPCTimeout(30*time.Second),             // rpc timeout
+		client.WithConnectTimeout(50*time.Second),         // conn timeout
+		client.WithFailureRetry(retry.NewFailurePolicy()), // retry
+		client.WithResolver(r),                            // resolver
+		client.WithClientBasicInfo(&rpcinfo.EndpointBasicInfo{ServiceName: "Interaction"}),
+	)
+	if err != nil {
+		hlog.Info(err)
+	}
+	RelationClient = c
+}
+
+// IsBlocked 检查两个用户之间是否存在任一方向的拉黑关系
+func IsBlocked(ctx context.Context, userID, targetUserID int64) (bool, error) {
+	resp, err := RelationClient.CheckBlock(ctx, &relations.CheckBlockRequest{
+		UserId:        userID,
+		TargetUserIds: []int64{targetUserID},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(resp.BlockedUserIds) > 0, nil
+}
+
+// IsBlockedOrMuted 检查userID是否屏蔽了来自targetUserID的内容（拉黑或静音）
+func IsBlockedOrMuted(ctx context.Context, userID, targetUserID int64) (bool, error) {
+	resp, err := RelationClient.CheckBlock(ctx, &relations.CheckBlockRequest{
+		UserId:        userID,
+		TargetUserIds: []int64{targetUserID},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(resp.BlockedUserIds) > 0 || len(resp.MutedUserIds) > 0, nil
+}
+
+// NotificationPriority 接收者特别关注了发送者时通知以高优先级投递，查询失败按普通优先级处理
+func NotificationPriority(ctx context.Context, receiverID, senderID int64) int {
+	if RelationClient == nil || receiverID == 0 || senderID == 0 {
+		return mq.NotificationPriorityNormal
+	}
+	resp, err := RelationClient.GetFollowType(ctx, &relations.GetFollowTypeRequest{
+		UserId:       receiverID,
+		TargetUserId: senderID,
+	})
+	if err != nil {
+		hlog.CtxWarnf(ctx, "Failed to get follow type of %d to %d: %v", receiverID, senderID, err)
+		return mq.NotificationPriorityNormal
+	}
+	if resp.FollowType == model.FollowStatusSpecial {
+		return mq.NotificationPriorityHigh
+	}
+	return mq.NotificationPriorityNormal
+}
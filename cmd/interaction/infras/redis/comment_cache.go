@@ -18,9 +18,6 @@ const (
 	// 评论内容哈希 Key：comment_hash:{user_id}:{hash}
 	CommentHashKeyTemplate = "comment_hash:%d:%s"
 
-	// 视频热门列表 Key：video:popular:list
-	VideoPopularListKey = "video:popular:list"
-
 	// 评论点赞数 Key：comment:like:{comment_id}
 	CommentLikeKeyTemplate = "comment:like:%d"
 
@@ -140,25 +137,6 @@ func DeleteCommentAndAllAbout(commentId int64) error {
 	return nil
 }
 
-// GetVideoPopularList 获取视频热门列表
-func GetVideoPopularList(pageNum, pageSize int64) (*[]string, error) {
-	start := (pageNum - 1) * pageSize
-	end := start + pageSize - 1
-
-	// 使用ZREVRANGE获取热门视频列表（按分数倒序）
-	result, err := RedisDBInteraction.ZRevRange(VideoPopularListKey, start, end).Result()
-	if err != nil {
-		if err == redis.Nil {
-			// 返回空列表
-			emptyList := make([]string, 0)
-			return &emptyList, nil
-		}
-		return nil, fmt.Errorf("failed to get video popular list: %w", err)
-	}
-
-	return &result, nil
-}
-
 // DeleteAllComment 批量删除评论缓存
 func DeleteAllComment(commentIds []int64) error {
 	if len(commentIds) == 0 {
@@ -200,9 +178,6 @@ func DeleteVideoAndAllAbout(videoId int64) error {
 		return fmt.Errorf("failed to delete video like cache: %w", err)
 	}
 
-	// 从热门列表中移除该视频
-	pipe.ZRem(VideoPopularListKey, strconv.FormatInt(videoId, 10))
-
 	// 删除视频相关的其他缓存键
 	videoDataPattern := fmt.Sprintf("video:*:%d", videoId)
 	keys, err := RedisDBInteraction.Keys(videoDataPattern).Result()
@@ -217,64 +192,3 @@ func DeleteVideoAndAllAbout(videoId int64) error {
 
 	return nil
 }
-
-// AddVideoToPopularList 添加视频到热门列表
-func AddVideoToPopularList(videoId int64, score float64) error {
-	err := RedisDBInteraction.ZAdd(VideoPopularListKey, redis.Z{
-		Score:  score,
-		Member: strconv.FormatInt(videoId, 10),
-	}).Err()
-
-	if err != nil {
-		return fmt.Errorf("failed to add video to popular list: %w", err)
-	}
-
-	return nil
-}
-
-// UpdateVideoPopularScore 更新视频热门分数
-func UpdateVideoPopularScore(videoId int64, score float64) error {
-	err := RedisDBInteraction.ZAdd(VideoPopularListKey, redis.Z{
-		Score:  score,
-		Member: strconv.FormatInt(videoId, 10),
-	}).Err()
-
-	if err != nil {
-		return fmt.Errorf("failed to update video popular score: %w", err)
-	}
-
-	return nil
-}
-
-// RemoveVideoFromPopularList 从热门列表移除视频
-func RemoveVideoFromPopularList(videoId int64) error {
-	err := RedisDBInteraction.ZRem(VideoPopularListKey, strconv.FormatInt(videoId, 10)).Err()
-	if err != nil {
-		return fmt.Errorf("failed to remove video from popular list: %w", err)
-	}
-
-	return nil
-}
-
-// GetVideoPopularScore 获取视频热门分数
-func GetVideoPopularScore(videoId int64) (float64, error) {
-	score, err := RedisDBInteraction.ZScore(VideoPopularListKey, strconv.FormatInt(videoId, 10)).Result()
-	if err != nil {
-		if err == redis.Nil {
-			return 0, nil // 视频不在热门列表中
-		}
-		return 0, fmt.Errorf("failed to get video popular score: %w", err)
-	}
-
-	return score, nil
-}
-
-// GetPopularListSize 获取热门列表大小
-func GetPopularListSize() (int64, error) {
-	size, err := RedisDBInteraction.ZCard(VideoPopularListKey).Result()
-	if err != nil {
-		return 0, fmt.Errorf("failed to get popular list size: %w", err)
-	}
-
-	return size, nil
-}
@@ -0,0 +1,11 @@
+package redis
+
+import "time"
+
+// commentHotRescoreLockKey 多个实例中只有一个重算评论热度
+const commentHotRescoreLockKey = "comment:hot_rescore_lock"
+
+// TryLockCommentHotRescore 获取评论热度重算锁，ttl后自动释放
+func TryLockCommentHotRescore(ttl time.Duration) (bool, error) {
+	return RedisDBInteraction.SetNX(commentHotRescoreLockKey, 1, ttl).Result()
+}
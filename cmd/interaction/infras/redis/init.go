@@ -1,12 +1,16 @@
 package redis
 
 import (
+	"HuaTug.com/pkg/cache"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/go-redis/redis"
+	redisv9 "github.com/redis/go-redis/v9"
 )
 
 var (
 	RedisDBInteraction *redis.Client
+	// CommentCache 评论缓存管理器，维护视频评论的热度排行
+	CommentCache *cache.CommentCacheManager
 )
 
 func Load() {
@@ -20,4 +24,10 @@ func Load() {
 	if _, err := RedisDBInteraction.Ping().Result(); err != nil {
 		hlog.Info("redisDBCommentInfo", err)
 	}
+
+	CommentCache = cache.NewCommentCacheManager(redisv9.NewClient(&redisv9.Options{
+		Addr:     Interaction.Addr,
+		Password: Interaction.PassWord,
+		DB:       Interaction.DB,
+	}))
 }
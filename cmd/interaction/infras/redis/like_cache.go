@@ -349,6 +349,48 @@ func (lcm *LikeCacheManager) GetUserLikeHistory(ctx context.Context, userID, bus
 	return messageIDs, nil
 }
 
+// GetUserLikeHistoryByCursor 按点赞时间倒序获取排在after之后的至多limit条点赞记录，after为nil时从最新开始
+// 同一时间的点赞按成员字典序倒序排列，与ZREVRANGE的顺序一致
+func (lcm *LikeCacheManager) GetUserLikeHistoryByCursor(ctx context.Context, userID, businessID int64, after *redis.Z, limit int64) ([]redis.Z, error) {
+	key := fmt.Sprintf(UserLikesKeyTemplate, userID, businessID)
+
+	max := "+inf"
+	var afterMember string
+	if after != nil {
+		max = strconv.FormatFloat(after.Score, 'f', -1, 64)
+		afterMember = fmt.Sprint(after.Member)
+	}
+
+	result := make([]redis.Z, 0, limit)
+	for offset := int64(0); int64(len(result)) < limit; {
+		batch, err := lcm.client.ZRevRangeByScoreWithScores(key, redis.ZRangeBy{
+			Min:    "-inf",
+			Max:    max,
+			Offset: offset,
+			Count:  limit,
+		}).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user like history: %w", err)
+		}
+		for _, item := range batch {
+			// 与游标同一时间的记录中跳过游标及其之前的成员
+			if after != nil && item.Score == after.Score && fmt.Sprint(item.Member) >= afterMember {
+				continue
+			}
+			result = append(result, item)
+		}
+		if int64(len(batch)) < limit {
+			break
+		}
+		offset += int64(len(batch))
+	}
+
+	if int64(len(result)) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
 // GetContentLikeUsers 获取点赞某内容的用户列表（分页）
 func (lcm *LikeCacheManager) GetContentLikeUsers(ctx context.Context, businessID, messageID int64, offset, limit int64) ([]int64, error) {
 	key := fmt.Sprintf(ContentLikesKeyTemplate, businessID, messageID)
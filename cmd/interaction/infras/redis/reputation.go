@@ -0,0 +1,53 @@
+package redis
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/go-redis/redis"
+)
+
+const (
+	// commentReputationKey 用户的评论信誉，由内容检查和人工审核的结果累积
+	commentReputationKey = "comment:reputation:%d"
+	// 信誉的上下限，避免长期累积后信誉失去调节作用
+	minCommentReputation = -10
+	maxCommentReputation = 10
+)
+
+// adjustReputationScript 原子地调整信誉并限制在上下限内
+var adjustReputationScript = redis.NewScript(`
+local value = redis.call('INCRBY', KEYS[1], ARGV[1])
+local min = tonumber(ARGV[2])
+local max = tonumber(ARGV[3])
+if value < min then
+	value = min
+	redis.call('SET', KEYS[1], value)
+elseif value > max then
+	value = max
+	redis.call('SET', KEYS[1], value)
+end
+return value
+`)
+
+// GetCommentReputation 获取用户的评论信誉，没有记录时为0
+func GetCommentReputation(userId int64) (int, error) {
+	value, err := RedisDBInteraction.Get(fmt.Sprintf(commentReputationKey, userId)).Result()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(value)
+}
+
+// AdjustCommentReputation 调整用户的评论信誉，返回调整后的值
+func AdjustCommentReputation(userId int64, delta int) (int, error) {
+	key := fmt.Sprintf(commentReputationKey, userId)
+	value, err := adjustReputationScript.Run(RedisDBInteraction, []string{key}, delta, minCommentReputation, maxCommentReputation).Int()
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
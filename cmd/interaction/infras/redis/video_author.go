@@ -0,0 +1,53 @@
+package redis
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+const (
+	// videoMetaKey 视频的作者和分类，作者不会变化、分类很少修改，缓存后点赞等操作不必每次查询视频服务
+	videoMetaKey = "video:meta:%d"
+	videoMetaTTL = 24 * time.Hour
+)
+
+// VideoMeta 互动事件需要携带的视频信息
+type VideoMeta struct {
+	AuthorID int64
+	Category string
+}
+
+// GetVideoMeta 获取缓存的视频作者和分类，没有缓存时返回nil
+func GetVideoMeta(videoId int64) (*VideoMeta, error) {
+	values, err := RedisDBInteraction.HGetAll(fmt.Sprintf(videoMetaKey, videoId)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	authorID, err := strconv.ParseInt(values["author_id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &VideoMeta{AuthorID: authorID, Category: values["category"]}, nil
+}
+
+// SetVideoMeta 缓存视频作者和分类
+func SetVideoMeta(videoId int64, meta *VideoMeta) error {
+	key := fmt.Sprintf(videoMetaKey, videoId)
+	pipe := RedisDBInteraction.TxPipeline()
+	pipe.HMSet(key, map[string]interface{}{
+		"author_id": meta.AuthorID,
+		"category":  meta.Category,
+	})
+	pipe.Expire(key, videoMetaTTL)
+	_, err := pipe.Exec()
+	if err == redis.Nil {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,86 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"HuaTug.com/cmd/interaction/infras/redis"
+	"HuaTug.com/cmd/interaction/service"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	commentHotRescoreInterval = 10 * time.Minute // 评论热度重算间隔
+	// 重算锁的持有时间，略短于间隔，保证每个周期只有一个实例重算
+	commentHotRescoreLockTTL = commentHotRescoreInterval - 30*time.Second
+	commentHotRescoreTimeout = commentHotRescoreInterval // 单次重算的最长时间
+)
+
+var cancel context.CancelFunc
+
+// Init 启动评论热度的定时重算和敏感词的定时加载任务，依赖DB和Redis已经初始化
+func Init() {
+	var ctx context.Context
+	ctx, cancel = context.WithCancel(context.Background())
+
+	go runCommentHotRescore(ctx)
+	go runModerationReload(ctx)
+	hlog.Info("Comment hot rescore and moderation reload jobs started")
+}
+
+// Close 停止后台任务
+func Close() {
+	if cancel != nil {
+		cancel()
+	}
+}
+
+func runCommentHotRescore(ctx context.Context) {
+	ticker := time.NewTicker(commentHotRescoreInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			rescoreCommentHot(ctx)
+		}
+	}
+}
+
+func rescoreCommentHot(ctx context.Context) {
+	locked, err := redis.TryLockCommentHotRescore(commentHotRescoreLockTTL)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "Failed to lock comment hot rescore: %v", err)
+		return
+	}
+	if !locked {
+		return // 其他实例正在重算
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, commentHotRescoreTimeout)
+	defer cancel()
+	start := time.Now()
+	rescored, err := service.RescoreCommentHot(ctx, start)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "Comment hot rescore aborted after %d videos: %v", rescored, err)
+		return
+	}
+	hlog.CtxInfof(ctx, "Rescored comment hot ranking of %d videos in %v", rescored, time.Since(start))
+}
+
+// runModerationReload 定期重新加载敏感词，每个实例各自维护词表，不需要加锁
+func runModerationReload(ctx context.Context) {
+	ticker := time.NewTicker(service.ModerationReloadInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := service.ReloadModeration(ctx); err != nil {
+				hlog.CtxErrorf(ctx, "Failed to reload sensitive words: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+
+	"HuaTug.com/cmd/interaction/dal/db"
+	"HuaTug.com/cmd/interaction/infras/client"
+	"HuaTug.com/cmd/interaction/infras/redis"
+	"HuaTug.com/config"
+	"HuaTug.com/kitex_gen/videos"
+	"HuaTug.com/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// checkBlocked 用户与内容作者之间存在拉黑关系时禁止互动
+// relation服务不可用时默认拒绝并返回可重试的错误，配置block.fail_open后改为记录日志并放行
+func checkBlocked(ctx context.Context, userID, authorID int64) error {
+	if authorID == 0 || userID == authorID {
+		return nil
+	}
+	if client.RelationClient == nil {
+		return blockCheckFailed(ctx, userID, authorID, errno.RpcErr)
+	}
+	blocked, err := client.IsBlocked(ctx, userID, authorID)
+	if err != nil {
+		return blockCheckFailed(ctx, userID, authorID, err)
+	}
+	if blocked {
+		return errno.UserBlockedErr
+	}
+	return nil
+}
+
+// blockCheckFailed 按block.fail_open处理无法确认拉黑状态的情况
+func blockCheckFailed(ctx context.Context, userID, authorID int64, err error) error {
+	hlog.CtxWarnf(ctx, "Failed to check block status between %d and %d: %v", userID, authorID, err)
+	if config.ConfigInfo.Block.FailOpen {
+		return nil
+	}
+	return errno.ServiceUnavailableErr
+}
+
+// getVideoAuthorID 获取视频作者ID
+func getVideoAuthorID(ctx context.Context, videoID int64) (int64, error) {
+	meta, err := getVideoMeta(ctx, videoID)
+	if err != nil {
+		return 0, err
+	}
+	return meta.AuthorID, nil
+}
+
+// getVideoMeta 获取视频作者和分类，优先读取缓存，未命中时查询视频服务并写入缓存
+func getVideoMeta(ctx context.Context, videoID int64) (*redis.VideoMeta, error) {
+	if redis.RedisDBInteraction != nil {
+		if meta, err := redis.GetVideoMeta(videoID); err != nil {
+			hlog.CtxWarnf(ctx, "Failed to get cached meta of video %d: %v", videoID, err)
+		} else if meta != nil {
+			return meta, nil
+		}
+	}
+	resp, err := client.VideoClient.VideoInfoV2(ctx, &videos.VideoInfoRequestV2{VideoId: videoID})
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil || resp.Items == nil {
+		return nil, errno.RequestErr
+	}
+	meta := &redis.VideoMeta{AuthorID: resp.Items.UserId, Category: resp.Items.Category}
+	if redis.RedisDBInteraction != nil {
+		if err := redis.SetVideoMeta(videoID, meta); err != nil {
+			hlog.CtxWarnf(ctx, "Failed to cache meta of video %d: %v", videoID, err)
+		}
+	}
+	return meta, nil
+}
+
+// getCommentAuthorID 获取评论作者ID
+func getCommentAuthorID(ctx context.Context, commentID int64) (int64, error) {
+	comment, err := db.GetCommentInfo(ctx, commentID)
+	if err != nil {
+		return 0, err
+	}
+	return comment.UserId, nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+
+	"HuaTug.com/cmd/interaction/dal/db"
+	"HuaTug.com/kitex_gen/interactions"
+	"HuaTug.com/pkg/errno"
+	"github.com/pkg/errors"
+)
+
+// 单次共同互动查询的规模上限
+const (
+	coInteractionMaxVideos = 200
+	coInteractionMaxUsers  = 200
+)
+
+type CoInteractionService struct {
+	ctx context.Context
+}
+
+func NewCoInteractionService(ctx context.Context) *CoInteractionService {
+	return &CoInteractionService{ctx: ctx}
+}
+
+// GetCoInteractions 返回与user_id点赞或评论过相同视频的其他用户，供关系服务计算关注推荐
+func (service *CoInteractionService) GetCoInteractions(ctx context.Context, req *interactions.GetCoInteractionsRequest) (*interactions.GetCoInteractionsResponse, error) {
+	if req.UserId <= 0 {
+		return nil, errors.WithMessage(errno.ParamErr, "invalid user_id")
+	}
+	videoLimit, limit := int(req.VideoLimit), int(req.Limit)
+	if videoLimit <= 0 || videoLimit > coInteractionMaxVideos {
+		videoLimit = coInteractionMaxVideos
+	}
+	if limit <= 0 || limit > coInteractionMaxUsers {
+		limit = coInteractionMaxUsers
+	}
+
+	videoIDs, err := db.GetRecentInteractedVideoIDs(ctx, req.UserId, videoLimit)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Failed to get interacted videos")
+	}
+	rows, err := db.GetCoInteractionCounts(ctx, req.UserId, videoIDs, limit)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Failed to get co-interactions")
+	}
+
+	resp := &interactions.GetCoInteractionsResponse{Items: make([]*interactions.CoInteraction, 0, len(rows))}
+	for _, row := range rows {
+		resp.Items = append(resp.Items, &interactions.CoInteraction{UserId: row.UserID, Count: row.Cnt})
+	}
+	return resp, nil
+}
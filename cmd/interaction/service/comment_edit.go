@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"HuaTug.com/cmd/interaction/dal/db"
+	"HuaTug.com/cmd/interaction/infras/redis"
+	"HuaTug.com/cmd/model"
+	"HuaTug.com/config"
+	"HuaTug.com/kitex_gen/interactions"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/moderation"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/pkg/errors"
+)
+
+// defaultCommentEditWindow 未配置comment.edit_window时评论发布后允许编辑的时长
+const defaultCommentEditWindow = 15 * time.Minute
+
+// commentEditWindow 评论发布后允许作者编辑的时长，未配置或格式错误时使用默认值
+func commentEditWindow() time.Duration {
+	value := config.ConfigInfo.Comment.EditWindow
+	if value == "" {
+		return defaultCommentEditWindow
+	}
+	window, err := time.ParseDuration(value)
+	if err != nil || window <= 0 {
+		hlog.Errorf("Invalid comment edit window '%s', using default", value)
+		return defaultCommentEditWindow
+	}
+	return window
+}
+
+// EditComment 作者在编辑时限内修改评论内容，修改前的内容保存到编辑历史
+func (service *CommentService) EditComment(ctx context.Context, req *interactions.EditCommentRequest) (*interactions.EditCommentResponse, error) {
+	if req.CommentId <= 0 {
+		return nil, errors.WithMessage(errno.ParamErr, "comment_id is required")
+	}
+	if err := service.validateCommentContent(req.Content); err != nil {
+		return nil, errors.WithMessage(errno.ParamErr, errno.ConvertErr(err).ErrMsg)
+	}
+	content := strings.TrimSpace(req.Content)
+
+	comment, err := db.GetCommentInfo(ctx, req.CommentId)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Failed to get comment")
+	}
+	if comment == nil {
+		return nil, errors.WithMessage(errno.ParamErr, "comment not found")
+	}
+	if comment.UserId != req.UserId {
+		return nil, errno.AuthorizationFailedErr
+	}
+	now := time.Now()
+	if now.Sub(parseCommentTime(comment.CreatedAt)) > commentEditWindow() {
+		return nil, errors.WithMessage(errno.ParamErr, "comment can no longer be edited")
+	}
+	// 编辑后的内容同样经过内容检查，已发布的评论不能进入审核队列，需要审核的内容直接拒绝
+	verdict := moderateComment(ctx, req.UserId, content)
+	switch verdict.Action {
+	case moderation.ActionReject:
+		adjustReputation(ctx, req.UserId, reputationRejected)
+		return nil, errors.WithMessage(errno.ParamErr, "comment contains inappropriate content")
+	case moderation.ActionReview:
+		return nil, errors.WithMessage(errno.ParamErr, "comment contains inappropriate content")
+	}
+	content = verdict.Content
+
+	if content != comment.Content {
+		if err := db.EditComment(ctx, comment, content, now); err != nil {
+			if errors.Is(err, db.ErrCommentEditConflict) {
+				return nil, errors.WithMessage(errno.ParamErr, "comment has been modified, please retry")
+			}
+			return nil, errors.WithMessage(err, "Failed to edit comment")
+		}
+		invalidateEditedComment(ctx, comment.VideoId, comment.CommentId)
+	}
+
+	data, err := service.buildCommentData(comment.CommentId)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Failed to build comment data")
+	}
+	return &interactions.EditCommentResponse{Comment: data}, nil
+}
+
+// invalidateEditedComment 清除缓存中的评论详情和视频评论列表，下次读取时从数据库加载编辑后的内容
+func invalidateEditedComment(ctx context.Context, videoID, commentID int64) {
+	if redis.CommentCache == nil {
+		return
+	}
+	if err := redis.CommentCache.InvalidateCommentCache(ctx, commentID); err != nil {
+		hlog.CtxWarnf(ctx, "Failed to invalidate cache of edited comment %d: %v", commentID, err)
+	}
+	if err := redis.CommentCache.InvalidateVideoCommentCache(ctx, videoID); err != nil {
+		hlog.CtxWarnf(ctx, "Failed to invalidate comment cache of video %d: %v", videoID, err)
+	}
+}
+
+// ListCommentEdits 获取评论被编辑前的各个版本，按编辑时间倒序；因举报被隐藏的评论与不存在的评论一样处理
+func (service *CommentService) ListCommentEdits(ctx context.Context, req *interactions.ListCommentEditsRequest) (*interactions.ListCommentEditsResponse, error) {
+	if req.CommentId <= 0 {
+		return nil, errors.WithMessage(errno.ParamErr, "comment_id is required")
+	}
+	comment, err := db.GetCommentInfo(ctx, req.CommentId)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Failed to get comment")
+	}
+	if comment == nil {
+		return nil, errors.WithMessage(errno.ParamErr, "comment not found")
+	}
+	hidden, err := db.GetHiddenTargetIDs(ctx, model.ReportTargetComment, []int64{comment.CommentId})
+	if err != nil {
+		return nil, errors.WithMessage(err, "Failed to check hidden comment")
+	}
+	if hidden[comment.CommentId] {
+		return nil, errors.WithMessage(errno.ParamErr, "comment not found")
+	}
+
+	edits, err := db.GetCommentEdits(ctx, comment.VideoId, comment.CommentId)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Failed to get comment edits")
+	}
+	versions := make([]*interactions.CommentVersion, 0, len(edits))
+	for _, edit := range edits {
+		versions = append(versions, &interactions.CommentVersion{
+			Content:    edit.Content,
+			ReplacedAt: edit.EditedAt,
+		})
+	}
+	return &interactions.ListCommentEditsResponse{Versions: versions}, nil
+}
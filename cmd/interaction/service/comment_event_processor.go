@@ -6,24 +6,24 @@ import (
 	"time"
 
 	"HuaTug.com/cmd/interaction/dal/db"
+	"HuaTug.com/cmd/interaction/infras/client"
 	"HuaTug.com/pkg/cache"
 	"HuaTug.com/pkg/mq"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
 )
 
 // CommentEventProcessor 评论事件处理器
 type CommentEventProcessor struct {
 	shardedDB    *db.ShardedCommentDB
 	cacheManager *cache.CommentCacheManager
-	mqManager    *mq.MQManager
 }
 
-// NewCommentEventProcessor 创建评论事件处理器
-func NewCommentEventProcessor(shardedDB *db.ShardedCommentDB, cacheManager *cache.CommentCacheManager, mqManager *mq.MQManager) *CommentEventProcessor {
+// NewCommentEventProcessor 创建评论事件处理器，回复和点赞通知写入评论所在分库的发件箱
+func NewCommentEventProcessor(shardedDB *db.ShardedCommentDB, cacheManager *cache.CommentCacheManager) *CommentEventProcessor {
 	return &CommentEventProcessor{
 		shardedDB:    shardedDB,
 		cacheManager: cacheManager,
-		mqManager:    mqManager,
 	}
 }
 
@@ -52,8 +52,14 @@ func (cep *CommentEventProcessor) handleCommentCreate(ctx context.Context, event
 		return fmt.Errorf("comment data is nil in create event")
 	}
 
+	// 回复通知与评论在同一个分库事务中写入发件箱
+	var notification *mq.NotificationEvent
+	if event.Comment.ParentId != -1 {
+		notification = cep.buildReplyNotification(ctx, event)
+	}
+
 	// 批量处理：将评论写入数据库
-	if err := cep.shardedDB.CreateCommentWithTransaction(ctx, event.Comment); err != nil {
+	if err := cep.shardedDB.CreateCommentWithTransaction(ctx, event.Comment, enqueueNotification(ctx, notification)); err != nil {
 		hlog.Errorf("Failed to create comment %d: %v", event.Comment.CommentId, err)
 		return err
 	}
@@ -65,11 +71,6 @@ func (cep *CommentEventProcessor) handleCommentCreate(ctx context.Context, event
 			cep.cacheManager.InvalidateVideoCommentCache(context.Background(), event.VideoID)
 			cep.cacheManager.IncrementVideoCommentCount(context.Background(), event.VideoID, 1)
 		}
-
-		// 发送通知事件（如果是回复）
-		if event.Comment.ParentId != -1 {
-			cep.sendReplyNotification(context.Background(), event)
-		}
 	}()
 
 	hlog.Infof("Successfully processed comment create event: comment_id=%d, video_id=%d",
@@ -84,7 +85,10 @@ func (cep *CommentEventProcessor) handleCommentUpdate(ctx context.Context, event
 	}
 
 	// 更新数据库中的评论
-	// 这里需要实现更新逻辑
+	if err := cep.shardedDB.UpdateComment(ctx, event.Comment); err != nil {
+		hlog.Errorf("Failed to update comment %d: %v", event.Comment.CommentId, err)
+		return err
+	}
 
 	// 清除缓存
 	if cep.cacheManager != nil {
@@ -129,8 +133,9 @@ func (cep *CommentEventProcessor) handleCommentLike(ctx context.Context, event *
 		return fmt.Errorf("comment_id not found in like event")
 	}
 
-	// 创建点赞记录
-	if err := cep.shardedDB.CreateCommentLikeWithSharding(ctx, commentID, event.UserID); err != nil {
+	// 创建点赞记录，点赞通知在同一个分库事务中写入发件箱
+	notification := cep.buildLikeNotification(ctx, commentID, event.UserID)
+	if err := cep.shardedDB.CreateCommentLikeWithSharding(ctx, commentID, event.UserID, enqueueNotification(ctx, notification)); err != nil {
 		hlog.Errorf("Failed to create comment like: comment_id=%d, user_id=%d, error=%v",
 			commentID, event.UserID, err)
 		return err
@@ -142,9 +147,6 @@ func (cep *CommentEventProcessor) handleCommentLike(ctx context.Context, event *
 			cep.cacheManager.IncrementCommentLikeCount(context.Background(), commentID, 1)
 			cep.cacheManager.InvalidateCommentCache(context.Background(), commentID)
 		}
-
-		// 发送点赞通知
-		cep.sendLikeNotification(context.Background(), commentID, event.UserID)
 	}()
 
 	return nil
@@ -175,22 +177,28 @@ func (cep *CommentEventProcessor) handleCommentUnlike(ctx context.Context, event
 	return nil
 }
 
-// sendReplyNotification 发送回复通知
-func (cep *CommentEventProcessor) sendReplyNotification(ctx context.Context, event *mq.CommentEvent) {
-	if cep.mqManager == nil {
-		return
+// enqueueNotification 返回在分库事务中写入通知的函数，notification为nil时不写入
+func enqueueNotification(ctx context.Context, notification *mq.NotificationEvent) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		if notification == nil {
+			return nil
+		}
+		return mq.Publish(ctx, mq.NewOutbox(tx), notification)
 	}
+}
 
+// buildReplyNotification 构造回复通知，不需要通知时返回nil
+func (cep *CommentEventProcessor) buildReplyNotification(ctx context.Context, event *mq.CommentEvent) *mq.NotificationEvent {
 	// 获取父评论信息以确定通知接收者
 	parentComment, err := cep.shardedDB.GetCommentInfoWithSharding(ctx, event.Comment.ParentId)
-	if err != nil {
+	if err != nil || parentComment == nil {
 		hlog.Warnf("Failed to get parent comment %d for notification: %v", event.Comment.ParentId, err)
-		return
+		return nil
 	}
 
 	// 不给自己发通知
 	if parentComment.UserId == event.UserID {
-		return
+		return nil
 	}
 
 	notificationEvent := &mq.NotificationEvent{
@@ -205,28 +213,22 @@ func (cep *CommentEventProcessor) sendReplyNotification(ctx context.Context, eve
 		},
 		Timestamp: time.Now().Unix(),
 	}
-
-	if err := cep.mqManager.PublishNotificationEvent(ctx, notificationEvent); err != nil {
-		hlog.Warnf("Failed to send reply notification: %v", err)
-	}
+	notificationEvent.Priority = client.NotificationPriority(ctx, parentComment.UserId, event.UserID)
+	return notificationEvent
 }
 
-// sendLikeNotification 发送点赞通知
-func (cep *CommentEventProcessor) sendLikeNotification(ctx context.Context, commentID, userID int64) {
-	if cep.mqManager == nil {
-		return
-	}
-
+// buildLikeNotification 构造评论点赞通知，不需要通知时返回nil
+func (cep *CommentEventProcessor) buildLikeNotification(ctx context.Context, commentID, userID int64) *mq.NotificationEvent {
 	// 获取评论信息以确定通知接收者
 	comment, err := cep.shardedDB.GetCommentInfoWithSharding(ctx, commentID)
-	if err != nil {
+	if err != nil || comment == nil {
 		hlog.Warnf("Failed to get comment %d for like notification: %v", commentID, err)
-		return
+		return nil
 	}
 
 	// 不给自己发通知
 	if comment.UserId == userID {
-		return
+		return nil
 	}
 
 	notificationEvent := &mq.NotificationEvent{
@@ -240,10 +242,8 @@ func (cep *CommentEventProcessor) sendLikeNotification(ctx context.Context, comm
 		},
 		Timestamp: time.Now().Unix(),
 	}
-
-	if err := cep.mqManager.PublishNotificationEvent(ctx, notificationEvent); err != nil {
-		hlog.Warnf("Failed to send like notification: %v", err)
-	}
+	notificationEvent.Priority = client.NotificationPriority(ctx, comment.UserId, userID)
+	return notificationEvent
 }
 
 // BatchProcessCommentEvents 批量处理评论事件
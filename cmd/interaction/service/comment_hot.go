@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"HuaTug.com/cmd/interaction/dal/db"
+	"HuaTug.com/cmd/interaction/infras/redis"
+	"HuaTug.com/cmd/model"
+	"HuaTug.com/pkg/cache"
+	"HuaTug.com/pkg/constants"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/mq"
+	"HuaTug.com/pkg/sharding"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/pkg/errors"
+)
+
+const (
+	commentHotLikeWeight  = 1 // 一次点赞计入评论的互动分
+	commentHotReplyWeight = 2 // 一条回复计入父评论的互动分
+	// 建立排行时读取的最新评论数，更早的评论不参与热度排序
+	commentHotRebuildLimit = 5000
+	// 只重算这段时间内读取过排行的视频，其余视频的排行过期后在下次读取时重建
+	commentHotActiveWindow = 7 * 24 * time.Hour
+	// 热度在事件去重标记中的消费者名
+	commentHotConsumer = "comment_hot"
+	// hotRankCursorScope 热度排行游标的标识，游标记录已读取的条数
+	hotRankCursorScope = "comments:hot_rank"
+)
+
+// CommentHotEventHandler 根据评论点赞事件增量更新视频的评论热度排行
+type CommentHotEventHandler struct{}
+
+func NewCommentHotEventHandler() *CommentHotEventHandler {
+	return &CommentHotEventHandler{}
+}
+
+// HandleLikeEvent 处理评论点赞和取消点赞，视频点赞与评论热度无关
+func (h *CommentHotEventHandler) HandleLikeEvent(ctx context.Context, event *mq.LikeEvent) error {
+	if event.EventType != "comment_like" {
+		return nil
+	}
+	var delta float64
+	switch event.ActionType {
+	case "like":
+		delta = commentHotLikeWeight
+	case "unlike":
+		delta = -commentHotLikeWeight
+	default:
+		hlog.CtxWarnf(ctx, "Drop comment like event with unknown action: %+v", event)
+		return nil
+	}
+
+	// 评论点赞请求中的视频ID是可选的
+	videoID := event.VideoID
+	if videoID == 0 {
+		var err error
+		if videoID, err = db.GetCommentVideoId(ctx, event.CommentID); err != nil {
+			return err
+		}
+		if videoID == 0 {
+			return nil // 评论已删除
+		}
+	}
+
+	return mq.Dedupe(ctx, redis.RedisDBInteraction, commentHotConsumer, event.EventID, func() error {
+		_, err := redis.CommentCache.CacheCommentHotScore(ctx, videoID, event.CommentID, delta, time.Time{}, time.Now())
+		return err
+	})
+}
+
+// indexNewComment 把新的一级评论加入视频的热度排行，回复只计入父评论的互动分；排行未建立时等读取时重建
+func indexNewComment(ctx context.Context, comment *model.Comment) {
+	if redis.CommentCache == nil {
+		return
+	}
+	now := time.Now()
+	if comment.ParentId <= 0 {
+		if _, err := redis.CommentCache.CacheCommentHotScore(ctx, comment.VideoId, comment.CommentId, 0, now, now); err != nil {
+			hlog.CtxWarnf(ctx, "Failed to index comment %d for hot ranking: %v", comment.CommentId, err)
+		}
+		return
+	}
+	// 父评论本身是回复时不在排行中，计分会被忽略
+	if _, err := redis.CommentCache.CacheCommentHotScore(ctx, comment.VideoId, comment.ParentId, commentHotReplyWeight, time.Time{}, now); err != nil {
+		hlog.CtxWarnf(ctx, "Failed to count reply for comment %d: %v", comment.ParentId, err)
+	}
+}
+
+// unindexComment 从视频的热度排行中移除评论，并撤销其计入父评论的回复
+func unindexComment(ctx context.Context, comment *model.Comment) {
+	if redis.CommentCache == nil {
+		return
+	}
+	if err := redis.CommentCache.RemoveCommentHotScore(ctx, comment.VideoId, comment.CommentId); err != nil {
+		hlog.CtxWarnf(ctx, "Failed to remove comment %d from hot ranking: %v", comment.CommentId, err)
+	}
+	if comment.ParentId > 0 {
+		if _, err := redis.CommentCache.CacheCommentHotScore(ctx, comment.VideoId, comment.ParentId, -commentHotReplyWeight, time.Time{}, time.Now()); err != nil {
+			hlog.CtxWarnf(ctx, "Failed to uncount reply for comment %d: %v", comment.ParentId, err)
+		}
+	}
+}
+
+// listHotCommentIDs 按热度倒序获取视频从offset开始的至多limit条一级评论，排行不存在时先从数据库重建
+func listHotCommentIDs(ctx context.Context, videoID, offset, limit int64) ([]int64, error) {
+	if redis.CommentCache == nil {
+		return nil, errors.New("comment cache is not initialized")
+	}
+	exists, err := redis.CommentCache.CommentHotIndexExists(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		if err := rebuildCommentHotIndex(ctx, videoID); err != nil {
+			return nil, err
+		}
+	}
+	return redis.CommentCache.GetHotCommentIDs(ctx, videoID, offset, limit)
+}
+
+// listHotCommentIDsByCursor 热度排行随互动变化，游标记录已读取的条数，翻页时可能有少量重复或遗漏
+func listHotCommentIDsByCursor(ctx context.Context, videoID int64, cursor string, limit int) ([]int64, string, error) {
+	after, err := sharding.DecodeCursor[sharding.KeyCursor](hotRankCursorScope, cursor)
+	if err != nil {
+		return nil, "", errors.WithMessage(errno.ParamErr, err.Error())
+	}
+	var offset int64
+	if after != nil {
+		if offset, err = strconv.ParseInt(after.Key, 10, 64); err != nil || offset < 0 {
+			return nil, "", errors.WithMessage(errno.ParamErr, "invalid cursor")
+		}
+	}
+
+	// 多读一条用于判断是否还有下一页
+	list, err := listHotCommentIDs(ctx, videoID, offset, int64(limit+1))
+	if err != nil {
+		return nil, "", err
+	}
+	var nextCursor string
+	if len(list) > limit {
+		list = list[:limit]
+		nextCursor = sharding.KeyCursor{Key: strconv.FormatInt(offset+int64(limit), 10), ID: list[limit-1]}.Encode(hotRankCursorScope)
+	}
+	return list, nextCursor, nil
+}
+
+// rebuildCommentHotIndex 用视频最新评论的点赞数和回复数重建一级评论的热度排行，因举报被隐藏的评论不进入排行也不计入回复
+func rebuildCommentHotIndex(ctx context.Context, videoID int64) error {
+	stats, err := db.GetVideoCommentHotStats(ctx, videoID, commentHotRebuildLimit, hiddenCommentIDs(ctx, videoID, 0))
+	if err != nil {
+		return err
+	}
+	replies := make(map[int64]int64, len(stats))
+	for _, s := range stats {
+		if s.ParentId > 0 {
+			replies[s.ParentId]++
+		}
+	}
+	entries := make([]cache.CommentHotEntry, 0, len(stats))
+	for _, s := range stats {
+		if s.ParentId > 0 {
+			continue
+		}
+		entries = append(entries, cache.CommentHotEntry{
+			CommentID:  s.CommentId,
+			Engagement: float64(s.LikeCount*commentHotLikeWeight + replies[s.CommentId]*commentHotReplyWeight),
+			CreatedAt:  parseCommentTime(s.CreatedAt),
+		})
+	}
+	return redis.CommentCache.RebuildCommentHotIndex(ctx, videoID, entries, time.Now())
+}
+
+// RescoreCommentHot 按当前时间重算近期读取过的视频的评论热度，让热度随评论发布时长衰减
+func RescoreCommentHot(ctx context.Context, now time.Time) (int, error) {
+	videoIDs, err := redis.CommentCache.GetActiveCommentHotVideos(ctx, now.Add(-commentHotActiveWindow))
+	if err != nil {
+		return 0, err
+	}
+	for i, videoID := range videoIDs {
+		if err := ctx.Err(); err != nil {
+			return i, err
+		}
+		if err := redis.CommentCache.RescoreCommentHotIndex(ctx, videoID, now); err != nil {
+			return i, fmt.Errorf("failed to rescore comments of video %d: %w", videoID, err)
+		}
+	}
+	return len(videoIDs), nil
+}
+
+// parseCommentTime 评论时间早期只记录到天，无法解析时视为刚发布
+func parseCommentTime(value string) time.Time {
+	for _, layout := range []string{time.DateTime, constants.DataFormate} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t
+		}
+	}
+	return time.Now()
+}
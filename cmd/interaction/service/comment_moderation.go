@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"HuaTug.com/cmd/interaction/dal/db"
+	"HuaTug.com/cmd/interaction/infras/redis"
+	"HuaTug.com/cmd/model"
+	"HuaTug.com/config"
+	"HuaTug.com/kitex_gen/interactions"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/moderation"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultModerationReloadInterval 未配置moderation.reload_interval时重新加载敏感词的间隔
+	defaultModerationReloadInterval = time.Minute
+	defaultReviewPageSize           = 20
+	maxReviewPageSize               = 100
+)
+
+// 内容检查和人工审核结果对作者评论信誉的调整
+const (
+	reputationRejected       = -1 // 评论被内容检查直接拒绝
+	reputationReviewRejected = -2 // 评论被管理员审核拒绝
+	reputationReviewApproved = 1  // 评论被管理员审核通过
+)
+
+// commentModeration 评论内容检查流水线，InitModeration之前只做链接、联系方式和刷屏检查
+var commentModeration = moderation.NewPipeline(nil, moderation.DefaultThresholds)
+
+// dbWordSource 从sensitive_words表加载敏感词
+type dbWordSource struct{}
+
+func (dbWordSource) Load(ctx context.Context) ([]moderation.Word, error) {
+	rows, err := db.GetSensitiveWords(ctx)
+	if err != nil {
+		return nil, err
+	}
+	words := make([]moderation.Word, 0, len(rows))
+	for _, row := range rows {
+		words = append(words, moderation.Word{Text: row.Word, Score: row.Score})
+	}
+	return words, nil
+}
+
+// InitModeration 按配置创建评论内容检查流水线并加载敏感词，词表来源为配置的文件或数据库，依赖DB已经初始化
+func InitModeration() {
+	cfg := config.ConfigInfo.Moderation
+	var source moderation.WordSource = dbWordSource{}
+	if cfg.WordsFile != "" {
+		source = moderation.FileSource{Path: cfg.WordsFile}
+	}
+	commentModeration = moderation.NewPipeline(source, moderation.Thresholds{
+		Review: cfg.ReviewScore,
+		Reject: cfg.RejectScore,
+	})
+	if err := ReloadModeration(context.Background()); err != nil {
+		hlog.Errorf("Failed to load sensitive words, moderation runs without word list: %v", err)
+	}
+}
+
+// ReloadModeration 重新加载敏感词，失败时继续使用原有词表
+func ReloadModeration(ctx context.Context) error {
+	count, err := commentModeration.Reload(ctx)
+	if err != nil {
+		return err
+	}
+	hlog.CtxDebugf(ctx, "Loaded %d sensitive words", count)
+	return nil
+}
+
+// ModerationReloadInterval 重新加载敏感词的间隔，未配置或格式错误时使用默认值
+func ModerationReloadInterval() time.Duration {
+	value := config.ConfigInfo.Moderation.ReloadInterval
+	if value == "" {
+		return defaultModerationReloadInterval
+	}
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval <= 0 {
+		hlog.Errorf("Invalid moderation reload interval '%s', using default", value)
+		return defaultModerationReloadInterval
+	}
+	return interval
+}
+
+// moderateComment 结合作者信誉检查评论内容，信誉读取失败时按中性信誉处理
+func moderateComment(ctx context.Context, userID int64, content string) *moderation.Result {
+	reputation, err := redis.GetCommentReputation(userID)
+	if err != nil {
+		hlog.CtxWarnf(ctx, "Failed to get comment reputation of user %d: %v", userID, err)
+		reputation = 0
+	}
+	result := commentModeration.Check(content, reputation)
+	if result.Action != moderation.ActionAllow {
+		hlog.CtxInfof(ctx, "Comment of user %d moderated: action=%s score=%d reasons=%s",
+			userID, result.Action, result.Score, result.ReasonString())
+	}
+	return result
+}
+
+// adjustReputation 调整作者的评论信誉，失败只记录日志
+func adjustReputation(ctx context.Context, userID int64, delta int) {
+	if _, err := redis.AdjustCommentReputation(userID, delta); err != nil {
+		hlog.CtxWarnf(ctx, "Failed to adjust comment reputation of user %d: %v", userID, err)
+	}
+}
+
+// holdCommentForReview 把需要人工审核的评论加入审核队列，评论ID已经预先分配
+func holdCommentForReview(ctx context.Context, comment *model.Comment, result *moderation.Result) error {
+	review := &model.CommentReview{
+		CommentId:        comment.CommentId,
+		VideoId:          comment.VideoId,
+		UserId:           comment.UserId,
+		ParentId:         comment.ParentId,
+		ReplyToCommentId: comment.ReplyToCommentId,
+		Content:          comment.Content,
+		Score:            result.Score,
+		Reasons:          result.ReasonString(),
+	}
+	return db.CreateCommentReview(ctx, review)
+}
+
+// checkAdmin 只有管理员可以处理评论审核
+func checkAdmin(ctx context.Context, userID int64) error {
+	isAdmin, err := db.IsAdmin(ctx, userID)
+	if err != nil {
+		return errors.WithMessage(err, "Failed to check admin role")
+	}
+	if !isAdmin {
+		return errno.AuthorizationFailedErr
+	}
+	return nil
+}
+
+// ListCommentReviews 管理员按状态分页查看审核队列，默认列出待审核的评论
+func (service *CommentService) ListCommentReviews(ctx context.Context, req *interactions.ListCommentReviewsRequest) (*interactions.ListCommentReviewsResponse, error) {
+	if err := checkAdmin(ctx, req.AdminId); err != nil {
+		return nil, err
+	}
+	status := int(req.Status)
+	if status != model.CommentReviewPending && status != model.CommentReviewApproved && status != model.CommentReviewRejected {
+		return nil, errors.WithMessage(errno.ParamErr, "invalid review status")
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultReviewPageSize
+	} else if pageSize > maxReviewPageSize {
+		pageSize = maxReviewPageSize
+	}
+
+	// 多取一条判断是否还有下一页
+	reviews, err := db.ListCommentReviews(ctx, status, req.Cursor, pageSize+1)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Failed to list comment reviews")
+	}
+	resp := &interactions.ListCommentReviewsResponse{}
+	if len(reviews) > pageSize {
+		reviews = reviews[:pageSize]
+		resp.HasMore = true
+	}
+	resp.Reviews = make([]*interactions.CommentReview, 0, len(reviews))
+	for _, review := range reviews {
+		resp.Reviews = append(resp.Reviews, convertCommentReview(review))
+	}
+	if len(reviews) > 0 {
+		resp.NextCursor = reviews[len(reviews)-1].ReviewId
+	}
+	return resp, nil
+}
+
+// ApproveCommentReview 管理员审核通过后以预先分配的评论ID发布评论；发布失败时审核记录恢复为待审核
+func (service *CommentService) ApproveCommentReview(ctx context.Context, req *interactions.ApproveCommentReviewRequest) (*interactions.ApproveCommentReviewResponse, error) {
+	if err := checkAdmin(ctx, req.AdminId); err != nil {
+		return nil, err
+	}
+	review, err := getPendingCommentReview(ctx, req.ReviewId)
+	if err != nil {
+		return nil, err
+	}
+	if err := resolveCommentReview(ctx, review.ReviewId, model.CommentReviewApproved, req.AdminId, ""); err != nil {
+		return nil, err
+	}
+
+	comment := &model.Comment{
+		CommentId:        review.CommentId,
+		VideoId:          review.VideoId,
+		ParentId:         review.ParentId,
+		UserId:           review.UserId,
+		Content:          review.Content,
+		ReplyToCommentId: review.ReplyToCommentId,
+	}
+	if err := service.publishComment(ctx, comment); err != nil {
+		if reopenErr := db.ReopenCommentReview(ctx, review.ReviewId); reopenErr != nil {
+			hlog.CtxErrorf(ctx, "Failed to reopen comment review %d: %v", review.ReviewId, reopenErr)
+		}
+		return nil, err
+	}
+	adjustReputation(ctx, review.UserId, reputationReviewApproved)
+
+	data, err := service.buildCommentData(comment.CommentId)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Failed to build comment data")
+	}
+	return &interactions.ApproveCommentReviewResponse{Comment: data}, nil
+}
+
+// RejectCommentReview 管理员审核拒绝，评论不会发布，作者的评论信誉降低
+func (service *CommentService) RejectCommentReview(ctx context.Context, req *interactions.RejectCommentReviewRequest) (*interactions.RejectCommentReviewResponse, error) {
+	if err := checkAdmin(ctx, req.AdminId); err != nil {
+		return nil, err
+	}
+	review, err := getPendingCommentReview(ctx, req.ReviewId)
+	if err != nil {
+		return nil, err
+	}
+	if err := resolveCommentReview(ctx, review.ReviewId, model.CommentReviewRejected, req.AdminId, strings.TrimSpace(req.Note)); err != nil {
+		return nil, err
+	}
+	adjustReputation(ctx, review.UserId, reputationReviewRejected)
+	return &interactions.RejectCommentReviewResponse{}, nil
+}
+
+func getPendingCommentReview(ctx context.Context, reviewID int64) (*model.CommentReview, error) {
+	if reviewID <= 0 {
+		return nil, errors.WithMessage(errno.ParamErr, "review_id is required")
+	}
+	review, err := db.GetCommentReview(ctx, reviewID)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Failed to get comment review")
+	}
+	if review == nil {
+		return nil, errors.WithMessage(errno.ParamErr, "comment review not found")
+	}
+	if review.Status != model.CommentReviewPending {
+		return nil, errors.WithMessage(errno.ParamErr, "comment review has already been handled")
+	}
+	return review, nil
+}
+
+// resolveCommentReview 认领待审核记录，并发处理同一条记录时只有一个管理员成功
+func resolveCommentReview(ctx context.Context, reviewID int64, status int, reviewerID int64, note string) error {
+	if err := db.ResolveCommentReview(ctx, reviewID, status, reviewerID, note); err != nil {
+		if errors.Is(err, db.ErrCommentReviewHandled) {
+			return errors.WithMessage(errno.ParamErr, "comment review has already been handled")
+		}
+		return errors.WithMessage(err, "Failed to resolve comment review")
+	}
+	return nil
+}
+
+func convertCommentReview(review *model.CommentReview) *interactions.CommentReview {
+	item := &interactions.CommentReview{
+		ReviewId:   review.ReviewId,
+		CommentId:  review.CommentId,
+		VideoId:    review.VideoId,
+		UserId:     review.UserId,
+		ParentId:   review.ParentId,
+		Content:    review.Content,
+		Score:      int64(review.Score),
+		Status:     int64(review.Status),
+		ReviewerId: review.ReviewerId,
+		Note:       review.Note,
+		CreatedAt:  review.CreatedAt.Format(time.DateTime),
+	}
+	if review.Reasons != "" {
+		item.Reasons = strings.Split(review.Reasons, ",")
+	}
+	if review.ReviewedAt != nil {
+		item.ReviewedAt = review.ReviewedAt.Format(time.DateTime)
+	}
+	return item
+}
@@ -3,8 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
-	"math"
-	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -12,6 +11,7 @@ import (
 
 	"HuaTug.com/cmd/api/rpc"
 	"HuaTug.com/cmd/interaction/dal/db"
+	"HuaTug.com/cmd/interaction/infras/client"
 	"HuaTug.com/cmd/interaction/infras/redis"
 	"HuaTug.com/cmd/model"
 	"HuaTug.com/kitex_gen/base"
@@ -19,9 +19,14 @@ import (
 	"HuaTug.com/kitex_gen/videos"
 	"HuaTug.com/pkg/constants"
 	"HuaTug.com/pkg/errno"
-	"HuaTug.com/pkg/utils"
+	"HuaTug.com/pkg/idgen"
+	"HuaTug.com/pkg/moderation"
+	"HuaTug.com/pkg/mq"
+	"HuaTug.com/pkg/sharding"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"gorm.io/gorm"
 )
 
 // Comment validation constants
@@ -40,7 +45,7 @@ func NewCommentService(ctx context.Context) *CommentService {
 	return &CommentService{ctx: ctx}
 }
 
-// validateCommentContent validates comment content with comprehensive checks
+// validateCommentContent validates comment content length, sensitive content is checked by moderateComment
 func (service *CommentService) validateCommentContent(content string) error {
 
 	// Check if content is empty
@@ -57,59 +62,9 @@ func (service *CommentService) validateCommentContent(content string) error {
 		return errno.RequestErr.WithMessage("Comment too long, maximum 500 characters allowed")
 	}
 
-	// Check for spam patterns (basic implementation)
-	if service.isSpamContent(content) {
-		return errno.RequestErr.WithMessage("Comment contains inappropriate content")
-	}
-
 	return nil
 }
 
-// isSpamContent performs basic spam detection
-func (service *CommentService) isSpamContent(content string) bool {
-	// Convert to lowercase for case-insensitive matching
-	lowerContent := strings.ToLower(content)
-
-	// Check for excessive repetition
-	if service.hasExcessiveRepetition(lowerContent) {
-		return true
-	}
-
-	// Check for spam keywords (basic implementation)
-	spamKeywords := []string{"spam", "advertisement", "promotion"}
-	for _, keyword := range spamKeywords {
-		if strings.Contains(lowerContent, keyword) {
-			return true
-		}
-	}
-
-	return false
-}
-
-// hasExcessiveRepetition checks for repeated characters or patterns
-func (service *CommentService) hasExcessiveRepetition(content string) bool {
-	if len(content) < 10 {
-		return false
-	}
-
-	// Check for repeated characters (more than 5 consecutive same characters)
-	var prevChar rune
-	var count int
-	for _, char := range content {
-		if char == prevChar {
-			count++
-			if count > 5 {
-				return true
-			}
-		} else {
-			count = 1
-			prevChar = char
-		}
-	}
-
-	return false
-}
-
 // checkRateLimit validates user comment frequency
 func (service *CommentService) checkRateLimit(userId int64) error {
 	// Use Redis to implement rate limiting
@@ -142,26 +97,27 @@ func (service *CommentService) checkDuplicateComment(userId int64, content strin
 	return nil
 }
 
-func (service *CommentService) CreateComment(ctx context.Context, req *interactions.CreateCommentRequest) (err error) {
+// CreateComment 发布评论；内容检查要求人工审核时评论进入审核队列，pendingReview为true
+func (service *CommentService) CreateComment(ctx context.Context, req *interactions.CreateCommentRequest) (pendingReview bool, err error) {
 	uid := req.UserId
 
 	// Enhanced input validation
 	if err := service.validateCommentContent(req.Content); err != nil {
-		return err
+		return false, err
 	}
 
 	if req.CommentId == 0 && req.VideoId == 0 {
-		return errno.RequestErr.WithMessage("Either VideoId or CommentId must be provided")
+		return false, errno.RequestErr.WithMessage("Either VideoId or CommentId must be provided")
 	}
 
 	// Anti-spam checks
 	// 通过redis设置key和过期时间来限制用户评论频率 采用管道满足原子执行的一致性
 	if err := service.checkRateLimit(uid); err != nil {
-		return err
+		return false, err
 	}
 
 	if err := service.checkDuplicateComment(uid, req.Content); err != nil {
-		return err
+		return false, err
 	}
 
 	// Enhanced comment hierarchy logic with reply_to_comment_id support
@@ -173,7 +129,7 @@ func (service *CommentService) CreateComment(ctx context.Context, req *interacti
 		// This is a reply to another comment
 		parentCommentId, err := db.GetParentCommentId(service.ctx, req.CommentId)
 		if err != nil {
-			return errors.WithMessage(err, "Failed to get parent comment information")
+			return false, errors.WithMessage(err, "Failed to get parent comment information")
 		}
 
 		// Set the actual reply target
@@ -192,21 +148,49 @@ func (service *CommentService) CreateComment(ctx context.Context, req *interacti
 		if videoId == 0 {
 			videoId, err = db.GetCommentVideoId(service.ctx, req.CommentId)
 			if err != nil {
-				return errors.WithMessage(err, "Failed to get video ID from comment")
+				return false, errors.WithMessage(err, "Failed to get video ID from comment")
 			}
 		}
 	}
 
 	// Verify video exists (optional but recommended)
 	if videoId != 0 {
-		_, err := rpc.VideoClient.VideoInfoV2(ctx, &videos.VideoInfoRequestV2{VideoId: videoId})
+		videoResp, err := rpc.VideoClient.VideoInfoV2(ctx, &videos.VideoInfoRequestV2{VideoId: videoId})
 		if err != nil {
-			return errors.WithMessage(err, "Video not found or inaccessible")
+			return false, errors.WithMessage(err, "Video not found or inaccessible")
+		}
+		// 与视频作者存在拉黑关系时不能评论
+		if videoResp != nil && videoResp.Items != nil {
+			if err := checkBlocked(ctx, uid, videoResp.Items.UserId); err != nil {
+				return false, err
+			}
 		}
 	}
 
-	// 使用雪花算法生成唯一的CommentID
-	commentId := utils.GenerateCommentID()
+	// 与被回复评论的作者存在拉黑关系时不能回复
+	if replyToCommentId != 0 {
+		replyToUserId, _, err := service.getReplyRelationInfo(replyToCommentId)
+		if err != nil {
+			return false, errors.WithMessage(err, "Failed to get reply target information")
+		}
+		if err := checkBlocked(ctx, uid, replyToUserId); err != nil {
+			return false, err
+		}
+	}
+
+	// 内容检查：拒绝的评论不保存，打码的评论以打码后的内容发布，需要审核的评论进入审核队列
+	content := strings.TrimSpace(req.Content)
+	verdict := moderateComment(ctx, uid, content)
+	if verdict.Action == moderation.ActionReject {
+		adjustReputation(ctx, uid, reputationRejected)
+		return false, errno.RequestErr.WithMessage("Comment contains inappropriate content")
+	}
+
+	// 使用分布式ID生成唯一的CommentID
+	commentId, err := idgen.NextID(ctx, idgen.BizComment)
+	if err != nil {
+		return false, errors.WithMessage(err, "Failed to generate CommentId")
+	}
 
 	// Create comment with enhanced structure
 	comment := &model.Comment{
@@ -214,16 +198,20 @@ func (service *CommentService) CreateComment(ctx context.Context, req *interacti
 		VideoId:          videoId,
 		ParentId:         parentId,
 		UserId:           uid,
-		Content:          strings.TrimSpace(req.Content),
-		CreatedAt:        time.Now().Format(constants.DataFormate),
-		UpdatedAt:        time.Now().Format(constants.DataFormate),
+		Content:          verdict.Content,
+		CreatedAt:        time.Now().Format(time.DateTime),
+		UpdatedAt:        time.Now().Format(time.DateTime),
 		DeletedAt:        "",
 		ReplyToCommentId: replyToCommentId, // 记录实际回复目标
 	}
 
-	// Use database transaction for consistency
-	if err = db.CreateCommentWithTransaction(service.ctx, comment); err != nil {
-		return errors.WithMessage(err, "Failed to create comment")
+	if verdict.Action == moderation.ActionReview {
+		if err := holdCommentForReview(ctx, comment, verdict); err != nil {
+			return false, errors.WithMessage(err, "Failed to hold comment for review")
+		}
+		pendingReview = true
+	} else if err := service.publishComment(ctx, comment); err != nil {
+		return false, err
 	}
 
 	// Update rate limit counter
@@ -241,6 +229,36 @@ func (service *CommentService) CreateComment(ctx context.Context, req *interacti
 		}
 	}()
 
+	return pendingReview, nil
+}
+
+// publishComment 保存评论并触发索引、互动事件和行为记录，直接发布和审核通过时共用
+func (service *CommentService) publishComment(ctx context.Context, comment *model.Comment) error {
+	uid, videoId := comment.UserId, comment.VideoId
+	// 评论事件与评论在同一事务中写入评论分库的发件箱，由video服务更新评论者的兴趣画像和视频热度；
+	// 取不到分类时热度只计入全站榜单
+	var category string
+	if meta, err := getVideoMeta(ctx, videoId); err != nil {
+		hlog.CtxWarnf(ctx, "Failed to get meta of video %d: %v", videoId, err)
+	} else {
+		category = meta.Category
+	}
+	engagement := &mq.VideoEngagementEvent{
+		UserID:    uid,
+		VideoID:   videoId,
+		Category:  category,
+		Action:    mq.EngagementComment,
+		Timestamp: time.Now().Unix(),
+		EventID:   uuid.New().String(),
+	}
+	// Use database transaction for consistency
+	if err := db.CreateCommentWithTransaction(service.ctx, comment, func(tx *gorm.DB) error {
+		return mq.Publish(ctx, mq.NewOutbox(tx), engagement)
+	}); err != nil {
+		return errors.WithMessage(err, "Failed to create comment")
+	}
+	go indexNewComment(context.Background(), comment)
+
 	// Record user behavior asynchronously with improved error handling
 	userBehavior := &model.UserBehavior{
 		UserId:       uid,
@@ -279,88 +297,6 @@ func (service *CommentService) getReplyRelationInfo(commentId int64) (replyToUse
 	return replyToComment.UserId, replyToComment.Content, nil
 }
 
-// sortCommentsByHot implements hot comment sorting algorithm
-// Combines like count and time factor for ranking
-func (service *CommentService) sortCommentsByHot(commentIds []int64, pageNum, pageSize int64) ([]int64, error) {
-	type CommentScore struct {
-		CommentId int64
-		Score     float64
-		LikeCount int64
-		CreatedAt string
-	}
-
-	scores := make([]CommentScore, 0, len(commentIds))
-
-	// Calculate scores for each comment
-	for _, commentId := range commentIds {
-		// Get like count from Redis
-		likeCount, err := redis.GetCommentLikeCount(commentId)
-		if err != nil {
-			hlog.Warnf("Failed to get like count for comment %d: %v", commentId, err)
-			likeCount = 0
-		}
-
-		// Get comment info for creation time
-		commentInfo, err := db.GetCommentInfo(service.ctx, commentId)
-		if err != nil {
-			hlog.Warnf("Failed to get comment info for comment %d: %v", commentId, err)
-			continue
-		}
-
-		// Calculate hot score: like_count * 10 + time_factor
-		// Time factor decreases as comment gets older (newer comments get higher scores)
-		timeFactor := service.calculateTimeFactor(commentInfo.CreatedAt)
-		hotScore := float64(likeCount)*10.0 + timeFactor
-
-		scores = append(scores, CommentScore{
-			CommentId: commentId,
-			Score:     hotScore,
-			LikeCount: likeCount,
-			CreatedAt: commentInfo.CreatedAt,
-		})
-	}
-
-	// Sort by score descending
-	sort.Slice(scores, func(i, j int) bool {
-		return scores[i].Score > scores[j].Score
-	})
-
-	// Apply pagination
-	start := int((pageNum - 1) * pageSize)
-	end := int(pageNum * pageSize)
-	if start >= len(scores) {
-		return []int64{}, nil
-	}
-	if end > len(scores) {
-		end = len(scores)
-	}
-
-	result := make([]int64, 0, end-start)
-	for i := start; i < end; i++ {
-		result = append(result, scores[i].CommentId)
-	}
-
-	return result, nil
-}
-
-// calculateTimeFactor calculates time-based factor for hot sorting
-func (service *CommentService) calculateTimeFactor(createdAt string) float64 {
-	// Parse creation time
-	createdTime, err := time.Parse(constants.DataFormate, createdAt)
-	if err != nil {
-		return 0.0
-	}
-
-	// Calculate hours since creation
-	hoursSinceCreation := time.Since(createdTime).Hours()
-
-	// Time factor decreases exponentially: newer comments get higher scores
-	// Max factor is 100, decreases by half every 24 hours
-	timeFactor := 100.0 * math.Pow(0.5, hoursSinceCreation/24.0)
-
-	return timeFactor
-}
-
 // buildCommentData builds a complete comment data structure
 func (service *CommentService) buildCommentData(commentId int64) (*base.Comment, error) {
 	var (
@@ -404,6 +340,9 @@ func (service *CommentService) buildCommentData(commentId int64) (*base.Comment,
 		return nil, err
 	default:
 	}
+	if res == nil {
+		return nil, errors.Errorf("comment %d not found", commentId)
+	}
 
 	return &base.Comment{
 		CommentId:        res.CommentId,
@@ -417,6 +356,8 @@ func (service *CommentService) buildCommentData(commentId int64) (*base.Comment,
 		UpdatedAt:        res.UpdatedAt,
 		DeletedAt:        res.DeletedAt,
 		ReplyToCommentId: res.ReplyToCommentId,
+		Edited:           res.EditedAt != "",
+		EditedAt:         res.EditedAt,
 	}, nil
 }
 
@@ -430,6 +371,15 @@ func (service *CommentService) GetCommentsByReplyTarget(videoId, replyToCommentI
 
 func (service *CommentService) ListComment(ctx context.Context, req *interactions.ListCommentRequest) (resp *interactions.ListCommentResponse, err error) {
 	resp = new(interactions.ListCommentResponse)
+	// Set default sort type to "hot" if not specified
+	if req.SortType == "" {
+		req.SortType = "hot"
+	}
+
+	// 带游标或显式要求时按游标翻页，否则保持原有的页码分页，未指定页码的旧客户端从第一页开始
+	if req.Cursor != "" || req.UseCursor {
+		return service.listCommentByCursor(ctx, req, hiddenCommentIDs(ctx, req.VideoId, req.CommentId))
+	}
 	if req.PageNum <= 0 {
 		req.PageNum = 1
 	}
@@ -437,11 +387,6 @@ func (service *CommentService) ListComment(ctx context.Context, req *interaction
 		req.PageSize = constants.DefaultLimit
 	}
 
-	// Set default sort type to "hot" if not specified
-	if req.SortType == "" {
-		req.SortType = "hot"
-	}
-
 	var (
 		data *[]*base.Comment
 	)
@@ -461,6 +406,60 @@ func (service *CommentService) ListComment(ctx context.Context, req *interaction
 	return resp, nil
 }
 
+// listCommentByCursor 按游标翻页获取视频评论或子评论，视频评论的热门排序按点赞数倒序；
+// hidden是因举报被隐藏的评论，数据库查询中排除，热度排行中不包含
+func (service *CommentService) listCommentByCursor(ctx context.Context, req *interactions.ListCommentRequest, hidden []int64) (*interactions.ListCommentResponse, error) {
+	limit := int(req.PageSize)
+	if limit <= 0 {
+		limit = constants.DefaultLimit
+	} else if limit > constants.MaxLimit {
+		limit = constants.MaxLimit
+	}
+
+	var (
+		list       []int64
+		nextCursor string
+		err        error
+	)
+	switch {
+	case req.VideoId != 0 && req.SortType == "hot":
+		list, nextCursor, err = listHotCommentIDsByCursor(ctx, req.VideoId, req.Cursor, limit)
+		if err != nil && !errors.Is(err, errno.ParamErr) {
+			// Redis不可用时按点赞数排序
+			hlog.Warnf("Failed to list hot comments of video %d, fall back to like count: %v", req.VideoId, err)
+			list, nextCursor, err = db.GetVideoCommentListByCursor(ctx, req.VideoId, req.SortType, req.Cursor, limit, hidden)
+		}
+	case req.VideoId != 0:
+		list, nextCursor, err = db.GetVideoCommentListByCursor(ctx, req.VideoId, req.SortType, req.Cursor, limit, hidden)
+	case req.CommentId != 0:
+		list, nextCursor, err = db.GetCommentChildListByCursor(ctx, req.CommentId, req.Cursor, limit, hidden)
+	default:
+		return nil, errno.RequestErr.WithMessage("Either VideoId or CommentId must be provided")
+	}
+	if errors.Is(err, errno.ParamErr) || errors.Is(err, sharding.ErrInvalidCursor) {
+		return nil, errors.WithMessage(errno.ParamErr, "invalid cursor")
+	}
+	if err != nil {
+		return nil, errors.WithMessage(err, "Failed to list comments by cursor")
+	}
+
+	data := make([]*base.Comment, 0, len(list))
+	for _, commentId := range list {
+		comment, err := service.buildCommentData(commentId)
+		if err != nil {
+			hlog.Warnf("Failed to build comment data for comment %d: %v", commentId, err)
+			continue
+		}
+		data = append(data, comment)
+	}
+	return &interactions.ListCommentResponse{
+		Base:       &base.Status{},
+		Items:      data,
+		HasMore:    nextCursor != "",
+		NextCursor: nextCursor,
+	}, nil
+}
+
 func (service *CommentService) NewDeleteEvent(ctx context.Context, req *interactions.CommentDeleteRequest) error {
 	if req.VideoId != 0 {
 		videoInfo, err := rpc.VideoClient.VideoInfoV2(ctx, &videos.VideoInfoRequestV2{VideoId: req.VideoId})
@@ -489,6 +488,10 @@ func (service *CommentService) NewDeleteEvent(ctx context.Context, req *interact
 		if err := service.DeleteComment(req); err != nil {
 			return err
 		}
+		unindexComment(ctx, commentInfo)
+		if err := db.UnpinComment(ctx, commentInfo.VideoId, commentInfo.CommentId); err != nil {
+			hlog.Warnf("Failed to unpin deleted comment %d: %v", commentInfo.CommentId, err)
+		}
 	} else {
 		return errno.RequestErr
 	}
@@ -502,22 +505,22 @@ func (service *CommentService) GetVideoCommentWithSort(req *interactions.ListCom
 	var list *[]int64
 	var err error
 
+	hidden := hiddenCommentIDs(service.ctx, req.VideoId, 0)
 	if req.SortType == "hot" {
-		// For hot sorting, get more comments and sort by like count + time
-		list, err = db.GetVideoCommentListForHotSort(service.ctx, req.VideoId, req.PageNum, req.PageSize)
-		if err != nil {
-			return nil, errno.ServiceErr
-		}
-
-		// Sort by hot algorithm (like count + time factor)
-		sortedList, err := service.sortCommentsByHot(*list, req.PageNum, req.PageSize)
-		if err != nil {
-			return nil, errno.ServiceErr
+		// 热度排序直接读取预先计算的排行，Redis不可用时按点赞数排序
+		var ids []int64
+		if ids, err = listHotCommentIDs(service.ctx, req.VideoId, (req.PageNum-1)*req.PageSize, req.PageSize); err != nil {
+			hlog.Warnf("Failed to list hot comments of video %d, fall back to like count: %v", req.VideoId, err)
+			list, err = db.GetVideoCommentListByPartWithSort(service.ctx, req.VideoId, req.PageNum, req.PageSize, req.SortType, hidden)
+			if err != nil {
+				return nil, errno.ServiceErr
+			}
+		} else {
+			list = &ids
 		}
-		list = &sortedList
 	} else {
 		// For latest or other sorting, use the standard method
-		list, err = db.GetVideoCommentListByPartWithSort(service.ctx, req.VideoId, req.PageNum, req.PageSize, req.SortType)
+		list, err = db.GetVideoCommentListByPartWithSort(service.ctx, req.VideoId, req.PageNum, req.PageSize, req.SortType, hidden)
 		if err != nil {
 			return nil, errno.ServiceErr
 		}
@@ -590,6 +593,8 @@ func (service *CommentService) GetVideoComment(req *interactions.ListCommentRequ
 			UpdatedAt:        res.UpdatedAt,
 			DeletedAt:        res.DeletedAt,
 			ReplyToCommentId: res.ReplyToCommentId,
+			Edited:           res.EditedAt != "",
+			EditedAt:         res.EditedAt,
 		})
 	}
 	return &data, nil
@@ -597,7 +602,7 @@ func (service *CommentService) GetVideoComment(req *interactions.ListCommentRequ
 
 func (service *CommentService) GetCommentComment(req *interactions.ListCommentRequest) (*[]*base.Comment, error) {
 	data := make([]*base.Comment, 0)
-	list, err := db.GetCommentChildListByPart(service.ctx, req.CommentId, req.PageNum, req.PageSize)
+	list, err := db.GetCommentChildListByPart(service.ctx, req.CommentId, req.PageNum, req.PageSize, hiddenCommentIDs(service.ctx, 0, req.CommentId))
 	if err != nil {
 		return nil, errno.ServiceErr
 	}
@@ -650,6 +655,8 @@ func (service *CommentService) GetCommentComment(req *interactions.ListCommentRe
 			UpdatedAt:        res.UpdatedAt,
 			DeletedAt:        res.DeletedAt,
 			ReplyToCommentId: res.ReplyToCommentId,
+			Edited:           res.EditedAt != "",
+			EditedAt:         res.EditedAt,
 		})
 	}
 	return &data, nil
@@ -663,6 +670,14 @@ func (service *CommentService) DeleteVideo(req *interactions.CommentDeleteReques
 	if _, err := rpc.VideoClient.VideoDeleteV2(service.ctx, &videos.VideoDeleteRequestV2{VideoId: req.VideoId, UserId: req.FromUserId}); err != nil {
 		return errno.ServiceErr
 	}
+	if redis.CommentCache != nil {
+		if err := redis.CommentCache.DeleteCommentHotIndex(service.ctx, req.VideoId); err != nil {
+			hlog.Warnf("Failed to delete comment hot ranking of video %d: %v", req.VideoId, err)
+		}
+	}
+	if err := db.UnpinComment(service.ctx, req.VideoId, 0); err != nil {
+		hlog.Warnf("Failed to unpin comment of video %d: %v", req.VideoId, err)
+	}
 
 	var (
 		wg      sync.WaitGroup
@@ -720,12 +735,20 @@ func (service *CommentService) DeleteComment(req *interactions.CommentDeleteRequ
 	return nil
 }
 
+// NewVideoPopularListEvent 热门榜单由video服务统一维护，这里只返回当天榜单中的视频ID
 func (service *CommentService) NewVideoPopularListEvent(req *interactions.VideoPopularListRequest) (*[]string, error) {
-	list, err := redis.GetVideoPopularList(req.PageNum, req.PageSize)
+	resp, err := client.VideoClient.VideoPopularV2(service.ctx, &videos.VideoPopularRequestV2{
+		PageNum:  req.PageNum,
+		PageSize: req.PageSize,
+	})
 	if err != nil {
-		return nil, errno.RedisErr
+		return nil, errors.WithMessage(err, "rpc.VideoPopularV2 failed")
+	}
+	list := make([]string, 0, len(resp.Popular))
+	for _, v := range resp.Popular {
+		list = append(list, strconv.FormatInt(v.VideoId, 10))
 	}
-	return list, nil
+	return &list, nil
 }
 
 // 删除操作
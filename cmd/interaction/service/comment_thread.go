@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"HuaTug.com/cmd/interaction/dal/db"
+	"HuaTug.com/kitex_gen/base"
+	"HuaTug.com/kitex_gen/interactions"
+	"HuaTug.com/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultThreadReplyCount = 3  // 每条一级评论默认内联的回复数
+	maxThreadReplyCount     = 10 // 每条一级评论最多内联的回复数，更多回复通过游标加载
+)
+
+// ListCommentThreads 按页获取视频的一级评论，每条附带最早的几条回复、回复总数和继续加载回复的游标；
+// 视频作者置顶的评论排在第一页最前面，之后的页不再重复返回
+func (service *CommentService) ListCommentThreads(ctx context.Context, req *interactions.ListCommentThreadsRequest) (*interactions.ListCommentThreadsResponse, error) {
+	if req.VideoId <= 0 {
+		return nil, errors.WithMessage(errno.ParamErr, "video_id is required")
+	}
+	replyCount := int(req.ReplyCount)
+	if replyCount <= 0 {
+		replyCount = defaultThreadReplyCount
+	} else if replyCount > maxThreadReplyCount {
+		replyCount = maxThreadReplyCount
+	}
+	sortType := req.SortType
+	if sortType == "" {
+		sortType = "hot"
+	}
+
+	hidden := hiddenCommentIDs(ctx, req.VideoId, 0)
+	page, err := service.listCommentByCursor(ctx, &interactions.ListCommentRequest{
+		VideoId:  req.VideoId,
+		PageSize: req.PageSize,
+		SortType: sortType,
+		Cursor:   req.Cursor,
+	}, hidden)
+	if err != nil {
+		return nil, err
+	}
+
+	pinnedID, err := db.GetPinnedCommentID(ctx, req.VideoId)
+	if err != nil {
+		hlog.CtxWarnf(ctx, "Failed to get pinned comment of video %d: %v", req.VideoId, err)
+	}
+	roots := make([]*base.Comment, 0, len(page.Items)+1)
+	if slices.Contains(hidden, pinnedID) {
+		pinnedID = 0
+	}
+	if pinnedID != 0 && req.Cursor == "" {
+		if pinned, err := service.buildCommentData(pinnedID); err != nil {
+			hlog.CtxWarnf(ctx, "Failed to build pinned comment %d: %v", pinnedID, err)
+		} else {
+			roots = append(roots, pinned)
+		}
+	}
+	for _, comment := range page.Items {
+		if comment.CommentId != pinnedID {
+			roots = append(roots, comment)
+		}
+	}
+
+	parentIDs := make([]int64, 0, len(roots))
+	for _, root := range roots {
+		parentIDs = append(parentIDs, root.CommentId)
+	}
+	replies, err := db.GetCommentThreadReplies(ctx, req.VideoId, parentIDs, replyCount, hidden)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Failed to get comment replies")
+	}
+
+	threads := make([]*interactions.CommentThread, 0, len(roots))
+	for _, root := range roots {
+		thread := &interactions.CommentThread{
+			Comment: root,
+			Replies: []*base.Comment{},
+			Pinned:  root.CommentId == pinnedID,
+		}
+		if r := replies[root.CommentId]; r != nil {
+			thread.ReplyCount = r.Total
+			thread.RepliesCursor = r.NextCursor
+			for _, replyID := range r.ReplyIDs {
+				reply, err := service.buildCommentData(replyID)
+				if err != nil {
+					hlog.CtxWarnf(ctx, "Failed to build comment data for reply %d: %v", replyID, err)
+					continue
+				}
+				thread.Replies = append(thread.Replies, reply)
+			}
+		}
+		threads = append(threads, thread)
+	}
+	service.markAuthorLiked(ctx, req.VideoId, threads)
+
+	return &interactions.ListCommentThreadsResponse{
+		Base:       &base.Status{},
+		Threads:    threads,
+		HasMore:    page.HasMore,
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+// markAuthorLiked 标记视频作者点赞过的评论和回复，查询失败时只是不显示标记
+func (service *CommentService) markAuthorLiked(ctx context.Context, videoID int64, threads []*interactions.CommentThread) {
+	var comments []*base.Comment
+	for _, thread := range threads {
+		comments = append(comments, thread.Comment)
+		comments = append(comments, thread.Replies...)
+	}
+	if len(comments) == 0 {
+		return
+	}
+	authorID, err := getVideoAuthorID(ctx, videoID)
+	if err != nil {
+		hlog.CtxWarnf(ctx, "Failed to get author of video %d: %v", videoID, err)
+		return
+	}
+
+	commentIDs := make([]int64, 0, len(comments))
+	for _, comment := range comments {
+		commentIDs = append(commentIDs, comment.CommentId)
+	}
+	liked, err := db.GetCommentsLikedByUser(ctx, videoID, authorID, commentIDs)
+	if err != nil {
+		hlog.CtxWarnf(ctx, "Failed to check comments liked by author %d: %v", authorID, err)
+		return
+	}
+	for _, comment := range comments {
+		comment.AuthorLiked = liked[comment.CommentId]
+	}
+}
+
+// PinComment 视频作者置顶或取消置顶视频的一级评论，每个视频至多一条置顶评论，新的置顶替换原有的
+func (service *CommentService) PinComment(ctx context.Context, req *interactions.PinCommentRequest) error {
+	if req.VideoId <= 0 || (req.Pin && req.CommentId <= 0) {
+		return errors.WithMessage(errno.ParamErr, "video_id and comment_id are required")
+	}
+	authorID, err := getVideoAuthorID(ctx, req.VideoId)
+	if err != nil {
+		return errors.WithMessage(err, "Failed to get video author")
+	}
+	if authorID != req.UserId {
+		return errno.AuthorizationFailedErr
+	}
+	if !req.Pin {
+		return db.UnpinComment(ctx, req.VideoId, req.CommentId)
+	}
+
+	comment, err := db.GetCommentInfo(ctx, req.CommentId)
+	if err != nil {
+		return errors.WithMessage(err, "Failed to get comment")
+	}
+	if comment == nil || comment.VideoId != req.VideoId {
+		return errors.WithMessage(errno.ParamErr, "comment does not belong to the video")
+	}
+	if comment.ParentId > 0 {
+		return errors.WithMessage(errno.ParamErr, "only top-level comments can be pinned")
+	}
+	return db.PinComment(ctx, req.VideoId, req.CommentId, req.UserId)
+}
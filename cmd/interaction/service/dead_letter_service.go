@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+
+	"HuaTug.com/kitex_gen/interactions"
+	"HuaTug.com/pkg/mq"
+	"github.com/pkg/errors"
+)
+
+// DeadLetterService 死信队列管理，供运维通过RPC或命令行查看、重放和清除处理失败的消息
+type DeadLetterService struct {
+	ctx   context.Context
+	admin *mq.DLQAdmin
+}
+
+func NewDeadLetterService(ctx context.Context, admin *mq.DLQAdmin) *DeadLetterService {
+	return &DeadLetterService{ctx: ctx, admin: admin}
+}
+
+func (service *DeadLetterService) ListQueues(ctx context.Context, req *interactions.ListDeadLetterQueuesRequest) ([]*interactions.DeadLetterQueueInfo, error) {
+	stats, err := service.admin.Stats(ctx)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to inspect dead letter queues")
+	}
+	queues := make([]*interactions.DeadLetterQueueInfo, 0, len(stats))
+	for _, stat := range stats {
+		queues = append(queues, &interactions.DeadLetterQueueInfo{
+			Queue:           stat.Queue,
+			DeadLetterQueue: stat.DeadLetterQueue,
+			MessageCount:    int64(stat.Messages),
+		})
+	}
+	return queues, nil
+}
+
+func (service *DeadLetterService) ListMessages(ctx context.Context, req *interactions.ListDeadLettersRequest) ([]*interactions.DeadLetterMessage, error) {
+	letters, err := service.admin.List(ctx, req.Queue, int(req.Limit))
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to list dead letters of %s", req.Queue)
+	}
+	messages := make([]*interactions.DeadLetterMessage, 0, len(letters))
+	for _, letter := range letters {
+		messages = append(messages, toDeadLetterMessage(letter))
+	}
+	return messages, nil
+}
+
+func (service *DeadLetterService) GetMessage(ctx context.Context, req *interactions.GetDeadLetterRequest) (*interactions.DeadLetterMessage, error) {
+	letter, err := service.admin.Get(ctx, req.Queue, req.MessageId)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to get dead letter %s of %s", req.MessageId, req.Queue)
+	}
+	return toDeadLetterMessage(letter), nil
+}
+
+func (service *DeadLetterService) Replay(ctx context.Context, req *interactions.ReplayDeadLettersRequest) (int64, error) {
+	replayed, err := service.admin.Replay(ctx, req.Queue, req.MessageIds)
+	if err != nil {
+		return int64(replayed), errors.WithMessagef(err, "failed to replay dead letters of %s", req.Queue)
+	}
+	return int64(replayed), nil
+}
+
+func (service *DeadLetterService) Purge(ctx context.Context, req *interactions.PurgeDeadLettersRequest) (int64, error) {
+	purged, err := service.admin.Purge(ctx, req.Queue, req.MessageIds)
+	if err != nil {
+		return int64(purged), errors.WithMessagef(err, "failed to purge dead letters of %s", req.Queue)
+	}
+	return int64(purged), nil
+}
+
+func toDeadLetterMessage(letter *mq.DeadLetter) *interactions.DeadLetterMessage {
+	message := &interactions.DeadLetterMessage{
+		MessageId: letter.MessageID,
+		Queue:     letter.Queue,
+		Attempts:  int64(letter.Attempts),
+		LastError: letter.LastError,
+		Body:      letter.Body,
+	}
+	if !letter.DeadLetteredAt.IsZero() {
+		message.DeadLetteredAt = letter.DeadLetteredAt.Unix()
+	}
+	if !letter.PublishedAt.IsZero() {
+		message.PublishedAt = letter.PublishedAt.Unix()
+	}
+	return message
+}
@@ -17,7 +17,7 @@ import (
 type EnhancedConsistencyService struct {
 	db           *gorm.DB
 	cacheManager *redis.LikeCacheManager
-	producer     mq.MessageProducer
+	producer     mq.Publisher
 	ctx          context.Context
 	cancel       context.CancelFunc
 	mu           sync.RWMutex
@@ -53,7 +53,7 @@ type ConsistencyReport struct {
 }
 
 // NewEnhancedConsistencyService 创建增强的一致性服务
-func NewEnhancedConsistencyService(db *gorm.DB, cacheManager *redis.LikeCacheManager, producer mq.MessageProducer) *EnhancedConsistencyService {
+func NewEnhancedConsistencyService(db *gorm.DB, cacheManager *redis.LikeCacheManager, producer mq.Publisher) *EnhancedConsistencyService {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	return &EnhancedConsistencyService{
@@ -7,7 +7,9 @@ import (
 
 	"HuaTug.com/cmd/interaction/common"
 	"HuaTug.com/cmd/interaction/dal/db"
+	"HuaTug.com/cmd/interaction/infras/client"
 	"HuaTug.com/cmd/interaction/infras/redis"
+	"HuaTug.com/pkg/idgen"
 	"HuaTug.com/pkg/mq"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/google/uuid" // 添加这一行
@@ -17,6 +19,7 @@ import (
 type LikeEventHandler struct {
 	syncService  *common.EventDrivenSyncService
 	cacheManager *redis.LikeCacheManager
+	producer     mq.Publisher // 用于发布作者获赞数变更事件
 }
 
 func NewLikeEventHandler() *LikeEventHandler {
@@ -24,10 +27,11 @@ func NewLikeEventHandler() *LikeEventHandler {
 }
 
 // NewLikeEventHandlerWithSync 创建带同步服务的事件处理器
-func NewLikeEventHandlerWithSync(syncService *common.EventDrivenSyncService) *LikeEventHandler {
+func NewLikeEventHandlerWithSync(syncService *common.EventDrivenSyncService, producer mq.Publisher) *LikeEventHandler {
 	return &LikeEventHandler{
 		syncService:  syncService,
 		cacheManager: redis.NewLikeCacheManager(redis.RedisDBInteraction),
+		producer:     producer,
 	}
 }
 
@@ -111,6 +115,9 @@ func (h *LikeEventHandler) handleVideoLikeEvent(ctx context.Context, event *mq.L
 		return err
 	}
 
+	// 2. 更新视频作者的获赞总数
+	h.publishAuthorLikeStats(ctx, event)
+
 	// 注意：不再在这里插入user_behaviors表，避免重复插入
 	// 用户行为记录由event_driven_sync.go统一处理
 	hlog.CtxInfof(ctx, "Video like event processed successfully, user_id: %d, video_id: %d, action: %s",
@@ -119,6 +126,46 @@ func (h *LikeEventHandler) handleVideoLikeEvent(ctx context.Context, event *mq.L
 	return nil
 }
 
+// publishAuthorLikeStats 通知user服务更新视频作者的获赞总数
+// 获赞数是冗余计数，失败只记录日志，不让点赞事件重新入队
+func (h *LikeEventHandler) publishAuthorLikeStats(ctx context.Context, event *mq.LikeEvent) {
+	if h.producer == nil {
+		return
+	}
+
+	var delta int64
+	switch event.ActionType {
+	case "like":
+		delta = 1
+	case "unlike":
+		delta = -1
+	default:
+		return
+	}
+
+	// 点赞事件携带作者ID；旧事件或发布时未取到作者的事件再通过缓存或视频服务查询
+	authorID := event.AuthorID
+	if authorID == 0 {
+		var err error
+		if authorID, err = getVideoAuthorID(ctx, event.VideoID); err != nil {
+			hlog.CtxWarnf(ctx, "Failed to get author of video %d: %v", event.VideoID, err)
+			return
+		}
+	}
+
+	statsEvent := &mq.UserStatsEvent{
+		UserID:    authorID,
+		StatType:  mq.StatTypeTotalLikes,
+		Delta:     delta,
+		Source:    "interaction",
+		Timestamp: time.Now().Unix(),
+		EventID:   uuid.New().String(),
+	}
+	if err := mq.Publish(ctx, h.producer, statsEvent); err != nil {
+		hlog.CtxWarnf(ctx, "Failed to publish user stats event: %v", err)
+	}
+}
+
 // 处理评论点赞事件
 func (h *LikeEventHandler) handleCommentLikeEvent(ctx context.Context, event *mq.LikeEvent) error {
 	// 1. 更新Redis中的评论点赞计数器
@@ -149,8 +196,25 @@ func NewNotificationEventHandler() *NotificationEventHandler {
 func (h *NotificationEventHandler) HandleNotificationEvent(ctx context.Context, event *mq.NotificationEvent) error {
 	hlog.CtxInfof(ctx, "Processing notification event: %+v", event)
 
+	// 接收者拉黑或静音了发送者时丢弃通知
+	if event.FromUserID != 0 && event.FromUserID != event.UserID && client.RelationClient != nil {
+		muted, err := client.IsBlockedOrMuted(ctx, event.UserID, event.FromUserID)
+		if err != nil {
+			hlog.CtxWarnf(ctx, "Failed to check block status for notification: %v", err)
+		} else if muted {
+			hlog.CtxInfof(ctx, "Drop notification from %d to %d: sender is blocked or muted", event.FromUserID, event.UserID)
+			return nil
+		}
+	}
+
 	// 1. 将通知保存到数据库
+	notificationID, err := idgen.NextID(ctx, idgen.BizNotification)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "Failed to generate notification id: %v", err)
+		return err
+	}
 	notification := &Notification{
+		NotificationID:   notificationID,
 		UserID:           event.UserID,
 		FromUserID:       event.FromUserID,
 		NotificationType: event.NotificationType,
@@ -181,7 +245,7 @@ func (h *NotificationEventHandler) pushRealTimeNotification(ctx context.Context,
 
 // Notification 通知数据模型
 type Notification struct {
-	NotificationID   int64  `gorm:"column:notification_id;primaryKey;autoIncrement"`
+	NotificationID   int64  `gorm:"column:notification_id;primaryKey"` // 由idgen分配
 	UserID           int64  `gorm:"column:user_id"`
 	FromUserID       int64  `gorm:"column:from_user_id"`
 	NotificationType string `gorm:"column:notification_type"`
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"HuaTug.com/cmd/interaction/dal/db"
@@ -14,20 +15,27 @@ import (
 	"HuaTug.com/kitex_gen/videos"
 
 	"HuaTug.com/pkg/constants"
+	"HuaTug.com/pkg/errno"
 	"HuaTug.com/pkg/mq"
+	"HuaTug.com/pkg/sharding"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	goredis "github.com/go-redis/redis"
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
 )
 
+// likeListCursorScope 点赞列表游标的标识
+const likeListCursorScope = "likes"
+
 // LikeActionService 新版点赞服务，使用优化后的Redis设计
 type LikeActionService struct {
 	ctx          context.Context
 	cacheManager *redis.LikeCacheManager
-	producer     *mq.Producer
+	producer     mq.Publisher
 }
 
 // NewLikeActionService 创建新版点赞服务实例
-func NewLikeActionService(ctx context.Context, producer *mq.Producer) *LikeActionService {
+func NewLikeActionService(ctx context.Context, producer mq.Publisher) *LikeActionService {
 	cacheManager := redis.NewLikeCacheManager(redis.RedisDBInteraction)
 	return &LikeActionService{
 		ctx:          ctx,
@@ -77,9 +85,13 @@ func (service *LikeActionService) handleVideoLike(ctx context.Context, req *inte
 			return true, nil // 已经点赞，直接返回
 		}
 
-		// 添加点赞记录到缓存
-		if err := service.cacheManager.AddUserLike(ctx, req.UserId, redis.BusinessTypeVideo, req.VideoId); err != nil {
-			return false, fmt.Errorf("failed to add like to cache: %w", err)
+		// 与视频作者存在拉黑关系时不能点赞
+		meta, err := getVideoMeta(ctx, req.VideoId)
+		if err != nil {
+			return false, fmt.Errorf("failed to get video author: %w", err)
+		}
+		if err := checkBlocked(ctx, req.UserId, meta.AuthorID); err != nil {
+			return false, err
 		}
 
 		event := &mq.LikeEvent{
@@ -90,8 +102,15 @@ func (service *LikeActionService) handleVideoLike(ctx context.Context, req *inte
 			EventType:  "video_like",
 			Timestamp:  time.Now().Unix(),
 			EventID:    uuid.New().String(),
+			AuthorID:   meta.AuthorID,
+			Category:   meta.Category,
+		}
+		// 点赞事件写入发件箱，提交后添加点赞记录到缓存
+		if err := service.commitLikeChange(ctx, event, func() error {
+			return service.cacheManager.AddUserLike(ctx, req.UserId, redis.BusinessTypeVideo, req.VideoId)
+		}); err != nil {
+			return false, fmt.Errorf("failed to record like: %w", err)
 		}
-		service.producer.PublishLikeEvent(ctx, event)
 
 		return true, nil
 
@@ -105,9 +124,11 @@ func (service *LikeActionService) handleVideoLike(ctx context.Context, req *inte
 			return false, nil // 没有点赞，直接返回
 		}
 
-		// 从缓存移除点赞记录
-		if err := service.cacheManager.RemoveUserLike(ctx, req.UserId, redis.BusinessTypeVideo, req.VideoId); err != nil {
-			return false, fmt.Errorf("failed to remove like from cache: %w", err)
+		// 作者和分类只用于更新获赞数和热度，获取失败时作者由事件消费方补查，热度只计入全站，不影响取消点赞
+		meta, err := getVideoMeta(ctx, req.VideoId)
+		if err != nil {
+			hlog.CtxWarnf(ctx, "Failed to get meta of video %d: %v", req.VideoId, err)
+			meta = &redis.VideoMeta{}
 		}
 
 		event := &mq.LikeEvent{
@@ -118,8 +139,15 @@ func (service *LikeActionService) handleVideoLike(ctx context.Context, req *inte
 			EventType:  "video_like",
 			Timestamp:  time.Now().Unix(),
 			EventID:    uuid.New().String(),
+			AuthorID:   meta.AuthorID,
+			Category:   meta.Category,
+		}
+		// 取消点赞事件写入发件箱，提交后从缓存移除点赞记录
+		if err := service.commitLikeChange(ctx, event, func() error {
+			return service.cacheManager.RemoveUserLike(ctx, req.UserId, redis.BusinessTypeVideo, req.VideoId)
+		}); err != nil {
+			return false, fmt.Errorf("failed to record unlike: %w", err)
 		}
-		service.producer.PublishLikeEvent(ctx, event)
 		return false, nil
 
 	default:
@@ -140,12 +168,15 @@ func (service *LikeActionService) handleCommentLike(ctx context.Context, req *in
 			return true, nil // 已经点赞，直接返回
 		}
 
-		// 添加点赞记录到缓存
-		if err := service.cacheManager.AddUserLike(ctx, req.UserId, redis.BusinessTypeComment, req.CommentId); err != nil {
-			return false, fmt.Errorf("failed to add like to cache: %w", err)
+		// 与评论作者存在拉黑关系时不能点赞
+		authorID, err := getCommentAuthorID(ctx, req.CommentId)
+		if err != nil {
+			return false, fmt.Errorf("failed to get comment author: %w", err)
+		}
+		if err := checkBlocked(ctx, req.UserId, authorID); err != nil {
+			return false, err
 		}
 
-		// 发布评论点赞事件，由事件驱动同步服务处理数据库操作
 		event := &mq.LikeEvent{
 			UserID:     req.UserId,
 			VideoID:    req.VideoId,
@@ -155,7 +186,12 @@ func (service *LikeActionService) handleCommentLike(ctx context.Context, req *in
 			Timestamp:  time.Now().Unix(),
 			EventID:    uuid.New().String(),
 		}
-		service.producer.PublishLikeEvent(ctx, event)
+		// 评论点赞事件写入发件箱，提交后添加点赞记录到缓存，由事件驱动同步服务处理数据库操作
+		if err := service.commitLikeChange(ctx, event, func() error {
+			return service.cacheManager.AddUserLike(ctx, req.UserId, redis.BusinessTypeComment, req.CommentId)
+		}); err != nil {
+			return false, fmt.Errorf("failed to record like: %w", err)
+		}
 
 		return true, nil
 
@@ -169,12 +205,6 @@ func (service *LikeActionService) handleCommentLike(ctx context.Context, req *in
 			return false, nil // 没有点赞，直接返回
 		}
 
-		// 从缓存移除点赞记录
-		if err := service.cacheManager.RemoveUserLike(ctx, req.UserId, redis.BusinessTypeComment, req.CommentId); err != nil {
-			return false, fmt.Errorf("failed to remove like from cache: %w", err)
-		}
-
-		// 发布评论取消点赞事件，由事件驱动同步服务处理数据库操作
 		event := &mq.LikeEvent{
 			UserID:     req.UserId,
 			VideoID:    req.VideoId,
@@ -184,7 +214,12 @@ func (service *LikeActionService) handleCommentLike(ctx context.Context, req *in
 			Timestamp:  time.Now().Unix(),
 			EventID:    uuid.New().String(),
 		}
-		service.producer.PublishLikeEvent(ctx, event)
+		// 评论取消点赞事件写入发件箱，提交后从缓存移除点赞记录，由事件驱动同步服务处理数据库操作
+		if err := service.commitLikeChange(ctx, event, func() error {
+			return service.cacheManager.RemoveUserLike(ctx, req.UserId, redis.BusinessTypeComment, req.CommentId)
+		}); err != nil {
+			return false, fmt.Errorf("failed to record unlike: %w", err)
+		}
 		return true, nil
 
 	default:
@@ -192,22 +227,72 @@ func (service *LikeActionService) handleCommentLike(ctx context.Context, req *in
 	}
 }
 
-// GetLikeList 获取用户点赞的视频列表
+// commitLikeChange 把点赞事件写入发件箱，提交后由发件箱中继发布，进程崩溃也不会丢失；
+// Redis不参与数据库事务，缓存在发件箱记录提交之后更新；更新失败时事件已经提交，只记录日志，
+// 点赞记录仍由事件同步到数据库，缓存中的点赞状态在该键过期重建前落后
+func (service *LikeActionService) commitLikeChange(ctx context.Context, event *mq.LikeEvent, updateCache func() error) error {
+	if err := mq.Publish(ctx, mq.NewOutbox(db.DB.WithContext(ctx)), event); err != nil {
+		return err
+	}
+	if err := updateCache(); err != nil {
+		hlog.CtxWarnf(ctx, "Failed to update like cache after %s %s of user %d: %v", event.EventType, event.ActionType, event.UserID, err)
+	}
+	return nil
+}
+
+// GetLikeList 获取用户点赞的视频列表，用户开启"隐藏点赞"后其他人无权查看
 func (service *LikeActionService) GetLikeList(ctx context.Context, req *interactions.LikeListRequest) (*interactions.LikeListResponse, error) {
-	// 参数校验和默认值设置
-	if req.PageNum <= 0 {
-		req.PageNum = 1
+	if err := checkLikesVisibility(ctx, req.UserId, req.ViewerId); err != nil {
+		if errors.Is(err, errno.AuthorizationFailedErr) {
+			return &interactions.LikeListResponse{
+				Base: &base.Status{
+					Code: 403,
+					Msg:  "该用户隐藏了点赞列表",
+				},
+				Items: []*base.Video{},
+			}, nil
+		}
+		return &interactions.LikeListResponse{
+			Base: &base.Status{
+				Code: 500,
+				Msg:  "获取点赞列表失败",
+			},
+		}, err
 	}
+	// 参数校验和默认值设置
 	if req.PageSize <= 0 {
 		req.PageSize = constants.DefaultLimit
 	}
 
-	// 根据用户ID获取其点赞的视频列表
-	offset := (req.PageNum - 1) * req.PageSize
-	limit := req.PageSize
+	var (
+		videoIDs   []int64
+		nextCursor string
+		hasMore    bool
+		err        error
+	)
+	// 带游标或显式要求时按游标翻页，否则保持原有的页码分页，未指定页码的旧客户端从第一页开始
+	if req.Cursor != "" || req.UseCursor {
+		videoIDs, nextCursor, err = service.getLikeHistoryByCursor(ctx, req)
+		if errors.Is(err, sharding.ErrInvalidCursor) {
+			return &interactions.LikeListResponse{
+				Base: &base.Status{
+					Code: 400,
+					Msg:  "无效的游标",
+				},
+			}, nil
+		}
+		hasMore = nextCursor != ""
+	} else {
+		if req.PageNum <= 0 {
+			req.PageNum = 1
+		}
+		// 根据用户ID获取其点赞的视频列表
+		offset := (req.PageNum - 1) * req.PageSize
+		limit := req.PageSize
 
-	// 获取用户点赞的视频ID列表
-	videoIDs, err := service.cacheManager.GetUserLikeHistory(ctx, req.UserId, redis.BusinessTypeVideo, offset, limit)
+		// 获取用户点赞的视频ID列表
+		videoIDs, err = service.cacheManager.GetUserLikeHistory(ctx, req.UserId, redis.BusinessTypeVideo, offset, limit)
+	}
 	if err != nil {
 		hlog.CtxErrorf(ctx, "Failed to get user like history: %v", err)
 		return &interactions.LikeListResponse{
@@ -252,10 +337,53 @@ func (service *LikeActionService) GetLikeList(ctx context.Context, req *interact
 			Code: 0,
 			Msg:  "success",
 		},
-		Items: videosList,
+		Items:      videosList,
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
 	}, nil
 }
 
+// getLikeHistoryByCursor 按点赞时间倒序以游标翻页获取用户点赞的视频ID，游标的排序键为点赞时间戳，ID为视频ID
+func (service *LikeActionService) getLikeHistoryByCursor(ctx context.Context, req *interactions.LikeListRequest) ([]int64, string, error) {
+	cursor, err := sharding.DecodeCursor[sharding.KeyCursor](likeListCursorScope, req.Cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	var after *goredis.Z
+	if cursor != nil {
+		score, err := strconv.ParseFloat(cursor.Key, 64)
+		if err != nil {
+			return nil, "", sharding.ErrInvalidCursor
+		}
+		after = &goredis.Z{Score: score, Member: strconv.FormatInt(cursor.ID, 10)}
+	}
+
+	// 多读一条用于判断是否还有下一页
+	items, err := service.cacheManager.GetUserLikeHistoryByCursor(ctx, req.UserId, redis.BusinessTypeVideo, after, req.PageSize+1)
+	if err != nil {
+		return nil, "", err
+	}
+	hasMore := int64(len(items)) > req.PageSize
+	if hasMore {
+		items = items[:req.PageSize]
+	}
+
+	videoIDs := make([]int64, 0, len(items))
+	for _, item := range items {
+		if videoID, err := strconv.ParseInt(fmt.Sprint(item.Member), 10, 64); err == nil {
+			videoIDs = append(videoIDs, videoID)
+		}
+	}
+
+	var nextCursor string
+	if hasMore {
+		last := items[len(items)-1]
+		lastID, _ := strconv.ParseInt(fmt.Sprint(last.Member), 10, 64)
+		nextCursor = sharding.KeyCursor{Key: strconv.FormatFloat(last.Score, 'f', -1, 64), ID: lastID}.Encode(likeListCursorScope)
+	}
+	return videoIDs, nextCursor, nil
+}
+
 // GetUserLikeHistory 获取用户点赞历史
 func (service *LikeActionService) GetUserLikeHistory(ctx context.Context, userID, businessID int64, offset, limit int64) ([]int64, error) {
 	return service.cacheManager.GetUserLikeHistory(ctx, userID, businessID, offset, limit)
@@ -290,40 +418,6 @@ func (service *LikeActionService) BatchCheckUserLikes(ctx context.Context, userI
 	return service.cacheManager.BatchCheckUserLikes(ctx, userID, businessID, messageIDs)
 }
 
-// === 异步数据库操作 ===
-
-// saveLikeToDB 异步保存点赞记录到数据库（已弃用 - 由事件驱动同步处理）
-func (service *LikeActionService) saveLikeToDB(userID, videoID int64, behaviorType string) {
-	// 注意：此方法已弃用，现在由EventDrivenSyncService统一处理user_behaviors表的插入
-	// 避免重复插入问题
-	hlog.Infof("saveLikeToDB called but handled by EventDrivenSyncService - user_id: %d, video_id: %d, type: %s",
-		userID, videoID, behaviorType)
-}
-
-// deleteLikeFromDB 异步从数据库删除点赞记录（已弃用 - 由事件驱动同步处理）
-func (service *LikeActionService) deleteLikeFromDB(userID, videoID int64, behaviorType string) {
-	// 注意：此方法已弃用，现在由EventDrivenSyncService统一处理user_behaviors表的删除
-	// 避免重复操作问题
-	hlog.Infof("deleteLikeFromDB called but handled by EventDrivenSyncService - user_id: %d, video_id: %d, type: %s",
-		userID, videoID, behaviorType)
-}
-
-// saveCommentLikeToDB 异步保存评论点赞记录到数据库（已弃用 - 由事件驱动同步处理）
-func (service *LikeActionService) saveCommentLikeToDB(userID, commentID int64, behaviorType string) {
-	// 注意：此方法已弃用，现在由EventDrivenSyncService统一处理user_behaviors表的插入
-	// 避免重复插入问题
-	hlog.Infof("saveCommentLikeToDB called but handled by EventDrivenSyncService - user_id: %d, comment_id: %d, type: %s",
-		userID, commentID, behaviorType)
-}
-
-// deleteCommentLikeFromDB 异步从数据库删除评论点赞记录（已弃用 - 由事件驱动同步处理）
-func (service *LikeActionService) deleteCommentLikeFromDB(userID, commentID int64, behaviorType string) {
-	// 注意：此方法已弃用，现在由EventDrivenSyncService统一处理user_behaviors表的删除
-	// 避免重复操作问题
-	hlog.Infof("deleteCommentLikeFromDB called but handled by EventDrivenSyncService - user_id: %d, comment_id: %d, type: %s",
-		userID, commentID, behaviorType)
-}
-
 // SyncCacheWithDB 同步缓存与数据库数据
 func (service *LikeActionService) SyncCacheWithDB(ctx context.Context, businessID, messageID int64) error {
 	// 从数据库获取真实的点赞数据
@@ -388,9 +482,10 @@ func (service *LikeActionService) SendLikeNotification(ctx context.Context, from
 		Content:          content,
 		Timestamp:        time.Now().Unix(),
 		EventID:          uuid.New().String(),
+		Priority:         client.NotificationPriority(ctx, toUserID, fromUserID),
 	}
 
-	if err := service.producer.PublishNotificationEvent(ctx, notificationEvent); err != nil {
+	if err := mq.Publish(ctx, service.producer, notificationEvent); err != nil {
 		hlog.Errorf("Failed to publish notification event: %v", err)
 	}
 }
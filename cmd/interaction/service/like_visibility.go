@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"HuaTug.com/cmd/interaction/infras/client"
+	"HuaTug.com/kitex_gen/base"
+	"HuaTug.com/kitex_gen/users"
+	"HuaTug.com/pkg/errno"
+)
+
+// loadUserPrivacy 获取用户的隐私设置，测试中替换
+var loadUserPrivacy = func(ctx context.Context, userID int64) (*base.User, error) {
+	resp, err := client.GetUserInfo(ctx, &users.GetUserInfoRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	if resp.User == nil {
+		return nil, errno.UserNotExistErr
+	}
+	return resp.User, nil
+}
+
+// checkLikesVisibility 检查viewerID能否查看ownerID的点赞列表，owner开启"隐藏点赞"后只有本人可见
+func checkLikesVisibility(ctx context.Context, ownerID, viewerID int64) error {
+	if viewerID == 0 || viewerID == ownerID {
+		return nil
+	}
+	owner, err := loadUserPrivacy(ctx, ownerID)
+	if err != nil {
+		return fmt.Errorf("failed to get user info: %w", errno.ServiceErr)
+	}
+	if owner.HideLikes {
+		return errno.AuthorizationFailedErr
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"HuaTug.com/kitex_gen/base"
+	"HuaTug.com/kitex_gen/interactions"
+	"HuaTug.com/pkg/errno"
+)
+
+// stubUserPrivacy 用固定的隐私设置替换loadUserPrivacy，返回记录查询次数的指针
+func stubUserPrivacy(t *testing.T, users map[int64]*base.User, err error) *int {
+	t.Helper()
+	calls := 0
+	orig := loadUserPrivacy
+	loadUserPrivacy = func(_ context.Context, userID int64) (*base.User, error) {
+		calls++
+		if err != nil {
+			return nil, err
+		}
+		return users[userID], nil
+	}
+	t.Cleanup(func() { loadUserPrivacy = orig })
+	return &calls
+}
+
+func TestCheckLikesVisibility(t *testing.T) {
+	users := map[int64]*base.User{
+		1: {UserId: 1, HideLikes: true},
+		2: {UserId: 2},
+	}
+	tests := []struct {
+		name      string
+		owner     int64
+		viewer    int64
+		loadErr   error
+		wantErr   error
+		wantCalls int
+	}{
+		{name: "owner sees own hidden likes", owner: 1, viewer: 1},
+		{name: "no viewer means own list", owner: 1, viewer: 0},
+		{name: "other viewer denied", owner: 1, viewer: 2, wantErr: errno.AuthorizationFailedErr, wantCalls: 1},
+		{name: "other viewer allowed", owner: 2, viewer: 1, wantCalls: 1},
+		{name: "lookup failure denies", owner: 2, viewer: 1, loadErr: errors.New("rpc timeout"), wantErr: errno.ServiceErr, wantCalls: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := stubUserPrivacy(t, users, tt.loadErr)
+			err := checkLikesVisibility(context.Background(), tt.owner, tt.viewer)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("checkLikesVisibility() error = %v, want %v", err, tt.wantErr)
+			}
+			if *calls != tt.wantCalls {
+				t.Errorf("loadUserPrivacy calls = %d, want %d", *calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestGetLikeListHiddenFromOthers(t *testing.T) {
+	stubUserPrivacy(t, map[int64]*base.User{1: {UserId: 1, HideLikes: true}}, nil)
+	service := &LikeActionService{ctx: context.Background()}
+	resp, err := service.GetLikeList(context.Background(), &interactions.LikeListRequest{UserId: 1, ViewerId: 2})
+	if err != nil {
+		t.Fatalf("GetLikeList() error = %v", err)
+	}
+	if resp.Base.Code != 403 || len(resp.Items) != 0 {
+		t.Errorf("GetLikeList() = code %d with %d items, want 403 with no items", resp.Base.Code, len(resp.Items))
+	}
+}
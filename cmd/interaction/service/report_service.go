@@ -0,0 +1,372 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"HuaTug.com/cmd/interaction/dal/db"
+	"HuaTug.com/cmd/interaction/infras/client"
+	"HuaTug.com/cmd/interaction/infras/redis"
+	"HuaTug.com/cmd/model"
+	"HuaTug.com/config"
+	"HuaTug.com/kitex_gen/interactions"
+	"HuaTug.com/kitex_gen/users"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/mq"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	// defaultReportHideThreshold 未配置report.hide_threshold时自动隐藏所需的举报用户数
+	defaultReportHideThreshold = 5
+	maxReportDetailLength      = 500
+	defaultReportCasePageSize  = 20
+	maxReportCasePageSize      = 100
+	// reportResultNotification 举报处理结果通知的类型
+	reportResultNotification = "report_result"
+)
+
+// reportTargetNames 可以举报的对象及通知中展示的名称，举报和工单查询都按它校验对象类型；
+// 私信目前只有收发队列，没有可按ID查询发送者的存储，不在其中
+var reportTargetNames = map[int]string{
+	model.ReportTargetVideo:   "视频",
+	model.ReportTargetComment: "评论",
+	model.ReportTargetUser:    "用户",
+}
+
+type ReportService struct {
+	ctx context.Context
+}
+
+func NewReportService(ctx context.Context) *ReportService {
+	return &ReportService{ctx: ctx}
+}
+
+// reportHideThreshold 自动隐藏所需的举报用户数，未配置时使用默认值
+func reportHideThreshold() int {
+	if threshold := config.ConfigInfo.Report.HideThreshold; threshold > 0 {
+		return threshold
+	}
+	return defaultReportHideThreshold
+}
+
+// Report 举报视频、评论或用户；同一用户重复举报同一对象时不重复计数，
+// 上次处理之后的举报用户数达到阈值时对象被自动隐藏，等待管理员处理
+func (service *ReportService) Report(ctx context.Context, req *interactions.ReportRequest) (*interactions.ReportResponse, error) {
+	targetType := int(req.TargetType)
+	if _, ok := reportTargetNames[targetType]; !ok {
+		return nil, errors.WithMessage(errno.ParamErr, "invalid target_type")
+	}
+	if req.TargetId <= 0 {
+		return nil, errors.WithMessage(errno.ParamErr, "target_id is required")
+	}
+	if req.Reason < model.ReportReasonSpam || req.Reason > model.ReportReasonOther {
+		return nil, errors.WithMessage(errno.ParamErr, "invalid reason")
+	}
+	detail := strings.TrimSpace(req.Detail)
+	if utf8.RuneCountInString(detail) > maxReportDetailLength {
+		return nil, errors.WithMessage(errno.ParamErr, "detail is too long")
+	}
+
+	targetUserID, videoID, err := getReportTargetOwner(ctx, targetType, req.TargetId)
+	if err != nil {
+		return nil, err
+	}
+	if targetUserID == req.ReporterId {
+		return nil, errors.WithMessage(errno.ParamErr, "cannot report your own content")
+	}
+
+	report := &model.Report{
+		TargetType: targetType,
+		TargetId:   req.TargetId,
+		ReporterId: req.ReporterId,
+		Reason:     int(req.Reason),
+		Detail:     detail,
+	}
+	reportCase, duplicate, err := db.AddReport(ctx, report, targetUserID, videoID, reportHideThreshold())
+	if err != nil {
+		return nil, errors.WithMessage(err, "Failed to add report")
+	}
+	if !duplicate && reportCase.Hidden {
+		hlog.CtxInfof(ctx, "Report target %d/%d hidden after %d reports",
+			targetType, req.TargetId, reportCase.PendingCount)
+		invalidateHiddenTarget(ctx, targetType, req.TargetId)
+	}
+	return &interactions.ReportResponse{CaseId: reportCase.CaseId, Duplicate: duplicate}, nil
+}
+
+// getReportTargetOwner 确认被举报对象存在并返回其作者，被举报评论还返回所属的视频
+func getReportTargetOwner(ctx context.Context, targetType int, targetID int64) (ownerID, videoID int64, err error) {
+	switch targetType {
+	case model.ReportTargetVideo:
+		authorID, err := getVideoAuthorID(ctx, targetID)
+		if errors.Is(err, errno.RequestErr) {
+			return 0, 0, errors.WithMessage(errno.ParamErr, "video not found")
+		}
+		if err != nil {
+			return 0, 0, errors.WithMessage(err, "Failed to get video info")
+		}
+		return authorID, 0, nil
+	case model.ReportTargetComment:
+		comment, err := db.GetCommentInfo(ctx, targetID)
+		if err != nil {
+			return 0, 0, errors.WithMessage(err, "Failed to get comment")
+		}
+		if comment == nil {
+			return 0, 0, errors.WithMessage(errno.ParamErr, "comment not found")
+		}
+		return comment.UserId, comment.VideoId, nil
+	case model.ReportTargetUser:
+		resp, err := client.CheckUserExistsById(ctx, &users.CheckUserExistsByIdRequst{UserId: targetID})
+		if err != nil {
+			return 0, 0, errors.WithMessage(err, "Failed to check user")
+		}
+		if resp == nil || !resp.Exists {
+			return 0, 0, errors.WithMessage(errno.ParamErr, "user not found")
+		}
+		return targetID, 0, nil
+	default:
+		return 0, 0, errors.WithMessage(errno.ParamErr, "target_type is not supported")
+	}
+}
+
+// invalidateHiddenTarget 隐藏状态变化后清除被举报评论的缓存和所属视频的热度排行，排行在下次读取时按新的隐藏状态重建
+func invalidateHiddenTarget(ctx context.Context, targetType int, targetID int64) {
+	if targetType != model.ReportTargetComment {
+		return
+	}
+	comment, err := db.GetCommentInfo(ctx, targetID)
+	if err != nil || comment == nil {
+		hlog.CtxWarnf(ctx, "Failed to get hidden comment %d: %v", targetID, err)
+		return
+	}
+	invalidateEditedComment(ctx, comment.VideoId, comment.CommentId)
+	if redis.CommentCache == nil {
+		return
+	}
+	if err := redis.CommentCache.DeleteCommentHotIndex(ctx, comment.VideoId); err != nil {
+		hlog.CtxWarnf(ctx, "Failed to delete comment hot index of video %d: %v", comment.VideoId, err)
+	}
+}
+
+// hiddenCommentIDs 返回评论列表查询中要排除的因举报被隐藏的评论：videoID为0时列出的是子评论，
+// 按父评论commentID确定所属视频；查询失败时不排除
+func hiddenCommentIDs(ctx context.Context, videoID, commentID int64) []int64 {
+	if videoID == 0 && commentID != 0 {
+		var err error
+		if videoID, err = db.GetCommentVideoId(ctx, commentID); err != nil {
+			hlog.CtxWarnf(ctx, "Failed to get video of comment %d: %v", commentID, err)
+			return nil
+		}
+	}
+	if videoID == 0 {
+		return nil
+	}
+	ids, err := db.GetHiddenCommentIDs(ctx, videoID)
+	if err != nil {
+		hlog.CtxWarnf(ctx, "Failed to get hidden comments of video %d: %v", videoID, err)
+		return nil
+	}
+	return ids
+}
+
+// GetHiddenTargets 返回target_ids中因举报被隐藏的对象，供视频和用户服务过滤
+func (service *ReportService) GetHiddenTargets(ctx context.Context, req *interactions.GetHiddenTargetsRequest) (*interactions.GetHiddenTargetsResponse, error) {
+	if _, ok := reportTargetNames[int(req.TargetType)]; !ok {
+		return nil, errors.WithMessage(errno.ParamErr, "invalid target_type")
+	}
+	hidden, err := db.GetHiddenTargetIDs(ctx, int(req.TargetType), req.TargetIds)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Failed to get hidden targets")
+	}
+	resp := &interactions.GetHiddenTargetsResponse{HiddenIds: make([]int64, 0, len(hidden))}
+	for id := range hidden {
+		resp.HiddenIds = append(resp.HiddenIds, id)
+	}
+	return resp, nil
+}
+
+// ListReportCases 管理员按状态分页查看举报工单，默认列出待处理的工单
+func (service *ReportService) ListReportCases(ctx context.Context, req *interactions.ListReportCasesRequest) (*interactions.ListReportCasesResponse, error) {
+	if err := checkAdmin(ctx, req.AdminId); err != nil {
+		return nil, err
+	}
+	status := int(req.Status)
+	if status < model.ReportCaseOpen || status > model.ReportCaseDismissed {
+		return nil, errors.WithMessage(errno.ParamErr, "invalid case status")
+	}
+	if _, ok := reportTargetNames[int(req.TargetType)]; req.TargetType != 0 && !ok {
+		return nil, errors.WithMessage(errno.ParamErr, "invalid target_type")
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultReportCasePageSize
+	} else if pageSize > maxReportCasePageSize {
+		pageSize = maxReportCasePageSize
+	}
+
+	// 多取一条判断是否还有下一页
+	cases, err := db.ListReportCases(ctx, status, int(req.TargetType), req.AssigneeId, req.Cursor, pageSize+1)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Failed to list report cases")
+	}
+	resp := &interactions.ListReportCasesResponse{}
+	if len(cases) > pageSize {
+		cases = cases[:pageSize]
+		resp.HasMore = true
+	}
+	caseIDs := make([]int64, 0, len(cases))
+	for _, c := range cases {
+		caseIDs = append(caseIDs, c.CaseId)
+	}
+	reasonCounts, err := db.GetReportReasonCounts(ctx, caseIDs)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Failed to count report reasons")
+	}
+	resp.Cases = make([]*interactions.ReportCase, 0, len(cases))
+	for _, c := range cases {
+		resp.Cases = append(resp.Cases, convertReportCase(c, reasonCounts[c.CaseId]))
+	}
+	if len(cases) > 0 {
+		resp.NextCursor = cases[len(cases)-1].CaseId
+	}
+	return resp, nil
+}
+
+// AssignReportCase 把工单分配给管理员，分配后只有该管理员可以处理
+func (service *ReportService) AssignReportCase(ctx context.Context, req *interactions.AssignReportCaseRequest) error {
+	if err := checkAdmin(ctx, req.AdminId); err != nil {
+		return err
+	}
+	if req.CaseId <= 0 {
+		return errors.WithMessage(errno.ParamErr, "case_id is required")
+	}
+	assigneeID := req.AssigneeId
+	if assigneeID == 0 {
+		assigneeID = req.AdminId
+	} else if assigneeID != req.AdminId {
+		isAdmin, err := db.IsAdmin(ctx, assigneeID)
+		if err != nil {
+			return errors.WithMessage(err, "Failed to check admin role")
+		}
+		if !isAdmin {
+			return errors.WithMessage(errno.ParamErr, "assignee is not an admin")
+		}
+	}
+	if err := db.AssignReportCase(ctx, req.CaseId, assigneeID); err != nil {
+		if errors.Is(err, db.ErrReportCaseClosed) {
+			return errors.WithMessage(errno.ParamErr, "report case not found or already closed")
+		}
+		return errors.WithMessage(err, "Failed to assign report case")
+	}
+	return nil
+}
+
+// ResolveReportCase 确认违规：对象保持隐藏，被举报评论的作者评论信誉降低，并通知举报人
+func (service *ReportService) ResolveReportCase(ctx context.Context, req *interactions.ResolveReportCaseRequest) error {
+	c, err := service.closeReportCase(ctx, req.AdminId, req.CaseId, model.ReportCaseResolved, req.Note)
+	if err != nil {
+		return err
+	}
+	if c.TargetType == model.ReportTargetComment && c.TargetUserId != 0 {
+		adjustReputation(ctx, c.TargetUserId, reputationReviewRejected)
+	}
+	return nil
+}
+
+// DismissReportCase 未发现违规：取消自动隐藏，并通知举报人
+func (service *ReportService) DismissReportCase(ctx context.Context, req *interactions.DismissReportCaseRequest) error {
+	_, err := service.closeReportCase(ctx, req.AdminId, req.CaseId, model.ReportCaseDismissed, req.Note)
+	return err
+}
+
+// closeReportCase 结束工单，处理结果通知与工单状态在同一事务中写入发件箱
+func (service *ReportService) closeReportCase(ctx context.Context, adminID, caseID int64, status int, note string) (*model.ReportCase, error) {
+	if err := checkAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+	if caseID <= 0 {
+		return nil, errors.WithMessage(errno.ParamErr, "case_id is required")
+	}
+	c, err := db.GetReportCase(ctx, caseID)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Failed to get report case")
+	}
+	if c == nil {
+		return nil, errors.WithMessage(errno.ParamErr, "report case not found")
+	}
+
+	hidden := status == model.ReportCaseResolved
+	err = db.CloseReportCase(ctx, caseID, status, adminID, strings.TrimSpace(note), hidden,
+		func(tx *gorm.DB, c *model.ReportCase, reports []*model.Report) error {
+			outbox := mq.NewOutbox(tx)
+			for _, report := range reports {
+				if err := mq.Publish(ctx, outbox, buildReportResultNotification(c, report)); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	switch {
+	case errors.Is(err, db.ErrReportCaseClosed):
+		return nil, errors.WithMessage(errno.ParamErr, "report case has already been closed")
+	case errors.Is(err, db.ErrReportCaseAssigned):
+		return nil, errno.AuthorizationFailedErr
+	case err != nil:
+		return nil, errors.WithMessage(err, "Failed to close report case")
+	}
+	if c.Hidden != hidden {
+		invalidateHiddenTarget(ctx, c.TargetType, c.TargetId)
+	}
+	return c, nil
+}
+
+// buildReportResultNotification 构造发给举报人的处理结果通知
+func buildReportResultNotification(c *model.ReportCase, report *model.Report) *mq.NotificationEvent {
+	content := "你举报的" + reportTargetNames[c.TargetType] + "经审核未发现违规"
+	if c.Status == model.ReportCaseResolved {
+		content = "你举报的" + reportTargetNames[c.TargetType] + "经审核确认违规，已被处理，感谢你的反馈"
+	}
+	return &mq.NotificationEvent{
+		UserID:           report.ReporterId,
+		ReceiverID:       report.ReporterId,
+		Type:             reportResultNotification,
+		NotificationType: reportResultNotification,
+		TargetID:         c.TargetId,
+		Content:          content,
+		Extra: map[string]interface{}{
+			"case_id":     c.CaseId,
+			"report_id":   report.ReportId,
+			"target_type": c.TargetType,
+			"status":      c.Status,
+		},
+		Timestamp: time.Now().Unix(),
+		EventID:   uuid.New().String(),
+	}
+}
+
+func convertReportCase(c *model.ReportCase, reasonCounts map[int64]int64) *interactions.ReportCase {
+	item := &interactions.ReportCase{
+		CaseId:       c.CaseId,
+		TargetType:   int64(c.TargetType),
+		TargetId:     c.TargetId,
+		TargetUserId: c.TargetUserId,
+		ReportCount:  int64(c.ReportCount),
+		PendingCount: int64(c.PendingCount),
+		ReasonCounts: reasonCounts,
+		Hidden:       c.Hidden,
+		Status:       int64(c.Status),
+		AssigneeId:   c.AssigneeId,
+		Note:         c.Note,
+		CreatedAt:    c.CreatedAt.Format(time.DateTime),
+	}
+	if c.ResolvedAt != nil {
+		item.ResolvedAt = c.ResolvedAt.Format(time.DateTime)
+	}
+	return item
+}
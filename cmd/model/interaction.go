@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 type Comment struct {
 	CommentId        int64
 	UserId           int64
@@ -11,7 +13,59 @@ type Comment struct {
 	CreatedAt        string
 	UpdatedAt        string
 	DeletedAt        string
-	ReplyToCommentId int64 // 实际回复目标评论ID，用于记录互动关系
+	ReplyToCommentId int64  // 实际回复目标评论ID，用于记录互动关系
+	EditedAt         string // 最近一次编辑的时间，未编辑过为空
+}
+
+// CommentEdit 评论被编辑前的内容，与评论在同一分库
+type CommentEdit struct {
+	EditId    int64 `gorm:"primaryKey"`
+	CommentId int64
+	VideoId   int64
+	UserId    int64
+	Content   string // 被替换的内容
+	EditedAt  string // 被替换的时间
+}
+
+// CommentPin 视频作者置顶的评论，每个视频至多一条
+type CommentPin struct {
+	VideoId   int64 `gorm:"primaryKey"`
+	CommentId int64 // 置顶的一级评论ID
+	UserId    int64 // 置顶操作人，即视频作者
+	CreatedAt time.Time
+}
+
+// 评论审核状态
+const (
+	CommentReviewPending  = 0
+	CommentReviewApproved = 1
+	CommentReviewRejected = 2
+)
+
+// CommentReview 内容检查要求人工审核的评论，审核通过后以预先分配的CommentId发布
+type CommentReview struct {
+	ReviewId         int64 `gorm:"primaryKey"`
+	CommentId        int64
+	VideoId          int64
+	UserId           int64
+	ParentId         int64
+	ReplyToCommentId int64
+	Content          string
+	Score            int    // 内容检查的风险分
+	Reasons          string // 命中的风险项，逗号分隔
+	Status           int
+	ReviewerId       int64
+	Note             string // 拒绝原因
+	CreatedAt        time.Time
+	ReviewedAt       *time.Time
+}
+
+// SensitiveWord 评论内容检查使用的敏感词
+type SensitiveWord struct {
+	Id        int64 `gorm:"primaryKey"`
+	Word      string
+	Score     int
+	CreatedAt time.Time
 }
 
 type CommentLike struct {
@@ -2,14 +2,78 @@ package model
 
 import "time"
 
+// 关注关系状态
+const (
+	FollowStatusNormal  = 1 // 正常关注
+	FollowStatusSpecial = 2 // 特别关注
+	FollowStatusSilent  = 3 // 悄悄关注
+	FollowStatusPending = 4 // 关注请求，等待私密账号通过
+)
+
 // FollowRelation 关注关系实体
 type FollowRelation struct {
 	ID         int64      `json:"id"`
 	UserID     int64      `json:"user_id"`     // 被关注者ID
 	FollowerID int64      `json:"follower_id"` // 关注者ID
-	Status     int        `json:"status"`      // 1:正常关注 2:特别关注 3:悄悄关注
+	Status     int        `json:"status"`      // 1:正常关注 2:特别关注 3:悄悄关注 4:待通过的关注请求
 	Remark     string     `json:"remark"`
+	Version    int64      `json:"-"` // 每次修改加一，粉丝表同步据此丢弃旧状态
 	CreatedAt  time.Time  `json:"created_at"`
 	UpdatedAt  time.Time  `json:"updated_at"`
 	DeletedAt  *time.Time `json:"deleted_at" gorm:"index"`
 }
+
+// IsPending 是否为尚未通过的关注请求
+func (r *FollowRelation) IsPending() bool {
+	return r.Status == FollowStatusPending
+}
+
+// IsSilent 是否为悄悄关注，悄悄关注不计入对方的粉丝数
+func (r *FollowRelation) IsSilent() bool {
+	return r.Status == FollowStatusSilent
+}
+
+// FollowType 关注类型，历史数据中status为0的记录视为普通关注，待通过的关注请求返回0
+func (r *FollowRelation) FollowType() int {
+	switch r.Status {
+	case 0:
+		return FollowStatusNormal
+	case FollowStatusPending:
+		return 0
+	default:
+		return r.Status
+	}
+}
+
+// IsValidFollowType 是否为用户可设置的关注类型
+func IsValidFollowType(followType int) bool {
+	return followType == FollowStatusNormal || followType == FollowStatusSpecial || followType == FollowStatusSilent
+}
+
+// FollowTypeStatuses 关注类型对应的status取值
+func FollowTypeStatuses(followType int) []int {
+	if followType == FollowStatusNormal {
+		return []int{0, FollowStatusNormal}
+	}
+	return []int{followType}
+}
+
+// PublicFollowStatuses 对他人可见的关注类型，悄悄关注只有本人可见
+func PublicFollowStatuses() []int {
+	return append(FollowTypeStatuses(FollowStatusNormal), FollowStatusSpecial)
+}
+
+// 屏蔽类型
+const (
+	BlockTypeBlock = 1 // 拉黑：双方互相不可见，不能关注、评论、点赞和私信
+	BlockTypeMute  = 2 // 静音：只在自己的信息流和通知中隐藏对方
+)
+
+// UserBlock 拉黑/静音关系实体
+type UserBlock struct {
+	ID           int64     `json:"id"`
+	UserID       int64     `json:"user_id"`        // 发起拉黑/静音的用户ID
+	TargetUserID int64     `json:"target_user_id"` // 被拉黑/静音的用户ID
+	BlockType    int       `json:"block_type"`     // 1:拉黑 2:静音
+	CreatedAt    time.Time `json:"created_at"`
+}
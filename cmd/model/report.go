@@ -0,0 +1,62 @@
+package model
+
+import "time"
+
+// 被举报对象的类型
+const (
+	ReportTargetVideo   = 1
+	ReportTargetComment = 2
+	ReportTargetUser    = 3
+	ReportTargetMessage = 4 // 保留，私信有可查询发送者的存储后再开放举报
+)
+
+// 举报原因
+const (
+	ReportReasonSpam           = 1 // 垃圾广告
+	ReportReasonHarassment     = 2 // 骚扰辱骂
+	ReportReasonHate           = 3 // 仇恨言论
+	ReportReasonViolence       = 4 // 暴力血腥
+	ReportReasonSexual         = 5 // 色情低俗
+	ReportReasonMisinformation = 6 // 虚假信息
+	ReportReasonIllegal        = 7 // 违法违规
+	ReportReasonOther          = 8 // 其他
+)
+
+// 举报工单状态
+const (
+	ReportCaseOpen      = 0 // 待处理
+	ReportCaseAssigned  = 1 // 已分配给管理员
+	ReportCaseResolved  = 2 // 确认违规
+	ReportCaseDismissed = 3 // 未发现违规
+)
+
+// Report 用户对一个对象的一次举报，同一用户对同一对象只保留一条
+type Report struct {
+	ReportId   int64 `gorm:"primaryKey"`
+	CaseId     int64
+	TargetType int
+	TargetId   int64
+	ReporterId int64
+	Reason     int
+	Detail     string
+	Notified   bool // 是否已把工单的处理结果通知举报人
+	CreatedAt  time.Time
+}
+
+// ReportCase 同一对象的举报汇总成的工单，由管理员处理
+type ReportCase struct {
+	CaseId       int64 `gorm:"primaryKey"`
+	TargetType   int
+	TargetId     int64
+	TargetUserId int64 // 被举报内容的作者
+	VideoId      int64 // 被举报评论所属的视频，其他对象为0
+	ReportCount  int   // 举报过该对象的用户数
+	PendingCount int   // 上次处理之后新增的举报用户数，达到阈值时自动隐藏
+	Hidden       bool
+	Status       int
+	AssigneeId   int64
+	Note         string // 处理说明
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	ResolvedAt   *time.Time
+}
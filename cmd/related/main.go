@@ -0,0 +1,105 @@
+// related 离线计算视频的相似视频（"看了又看"），供video服务的RelatedVideos接口使用
+//
+// 从点赞、收藏、分享和观看历史构建用户-视频互动，按物品协同过滤计算每个视频的前K个相似视频，
+// 整体替换写入Redis的related:<video_id>有序集合。建议用cron定期运行，间隔小于-ttl：
+//
+//	related -window 90 -topk 50 -ttl 72h
+//
+// 结果过期或视频没有足够的共同互动时，服务端回退到同标签和搜索引擎的相似视频。
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"HuaTug.com/cmd/video/dal/db"
+	"HuaTug.com/cmd/video/infras/redis"
+	"HuaTug.com/config"
+	"HuaTug.com/pkg/itemcf"
+)
+
+// sourceWeights 各种互动代表的兴趣强度，同一用户对同一视频的多种互动累加
+var sourceWeights = []struct {
+	source db.InteractionSource
+	weight float64
+}{
+	{db.WatchInteractions, 1},
+	{db.LikeInteractions, 2},
+	{db.FavoriteInteractions, 3},
+	{db.ShareInteractions, 3},
+}
+
+func main() {
+	window := flag.Int("window", 90, "only use interactions of the last N days, 0 uses all")
+	topK := flag.Int("topk", itemcf.DefaultOptions.TopK, "related videos kept per video")
+	minSupport := flag.Int("min-support", itemcf.DefaultOptions.MinSupport, "minimum number of users who interacted with both videos")
+	maxItems := flag.Int("max-items", itemcf.DefaultOptions.MaxItemsPerUser, "maximum videos per user taken into account")
+	ttl := flag.Duration("ttl", 72*time.Hour, "expiry of the written related video lists")
+	batch := flag.Int("batch", 1000, "rows per batch when reading interactions")
+	flag.Parse()
+
+	config.Init()
+	db.Init()
+	redis.Load()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, *window, itemcf.Options{TopK: *topK, MinSupport: *minSupport, MaxItemsPerUser: *maxItems}, *ttl, *batch); err != nil {
+		fmt.Fprintf(os.Stderr, "related: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, window int, opts itemcf.Options, ttl time.Duration, batch int) error {
+	start := time.Now()
+	since := ""
+	if window > 0 {
+		// 各表的时间列格式不同，但都以日期开头，按字符串比较即可
+		since = start.AddDate(0, 0, -window).Format("2006-01-02")
+	}
+
+	builder := itemcf.NewBuilder()
+	for _, sw := range sourceWeights {
+		rows := 0
+		err := db.ScanInteractions(ctx, sw.source, since, batch, func(interactions []db.UserVideo) error {
+			for _, i := range interactions {
+				builder.Add(i.UserID, i.VideoID, sw.weight)
+			}
+			rows += len(interactions)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("read %d %s interactions\n", rows, sw.source.Name)
+	}
+
+	neighbors := builder.Build(opts)
+	fmt.Printf("computed related videos of %d videos from %d users in %v\n", len(neighbors), builder.Users(), time.Since(start).Round(time.Millisecond))
+
+	written := 0
+	for vid, list := range neighbors {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		related := make([]redis.RelatedVideo, 0, len(list))
+		for _, n := range list {
+			related = append(related, redis.RelatedVideo{VideoID: n.ItemID, Similarity: n.Similarity})
+		}
+		if err := redis.SaveRelatedVideos(vid, related, ttl); err != nil {
+			return fmt.Errorf("failed to save related videos of %d: %w", vid, err)
+		}
+		written++
+	}
+	if err := redis.SaveRelatedVideosMeta(start, written); err != nil {
+		return fmt.Errorf("failed to save build metadata: %w", err)
+	}
+	fmt.Printf("wrote related videos of %d videos in %v\n", written, time.Since(start).Round(time.Millisecond))
+	return nil
+}
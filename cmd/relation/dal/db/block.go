@@ -0,0 +1,123 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"HuaTug.com/cmd/model"
+	"gorm.io/gorm/clause"
+)
+
+const userBlockTable = "user_blocks"
+
+// CreateBlock 拉黑或静音用户，重复操作视为成功
+func CreateBlock(ctx context.Context, userID, targetUserID int64, blockType int) error {
+	block := &model.UserBlock{
+		UserID:       userID,
+		TargetUserID: targetUserID,
+		BlockType:    blockType,
+		CreatedAt:    time.Now(),
+	}
+	if err := DB.WithContext(ctx).Table(userBlockTable).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(block).Error; err != nil {
+		return fmt.Errorf("failed to create user block: %w", err)
+	}
+	return nil
+}
+
+// DeleteBlock 取消拉黑或静音
+func DeleteBlock(ctx context.Context, userID, targetUserID int64, blockType int) error {
+	if err := DB.WithContext(ctx).Table(userBlockTable).
+		Where("user_id = ? AND target_user_id = ? AND block_type = ?", userID, targetUserID, blockType).
+		Delete(&model.UserBlock{}).Error; err != nil {
+		return fmt.Errorf("failed to delete user block: %w", err)
+	}
+	return nil
+}
+
+// GetBlockList 分页获取用户拉黑/静音的用户列表，按操作时间倒序
+func GetBlockList(ctx context.Context, userID int64, blockType int, offset, limit int) ([]*model.UserBlock, int64, error) {
+	var total int64
+	query := DB.WithContext(ctx).Table(userBlockTable).
+		Where("user_id = ? AND block_type = ?", userID, blockType)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count user blocks: %w", err)
+	}
+
+	var blocks []*model.UserBlock
+	if err := query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&blocks).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to get user blocks: %w", err)
+	}
+	return blocks, total, nil
+}
+
+// GetBlocksSince 按ID顺序分批获取since之后创建的拉黑记录（不包括静音），用于核对拉黑后关注关系是否已解除
+func GetBlocksSince(ctx context.Context, since time.Time, afterID int64, limit int) ([]*model.UserBlock, error) {
+	var blocks []*model.UserBlock
+	if err := DB.WithContext(ctx).Table(userBlockTable).
+		Where("block_type = ? AND created_at >= ? AND id > ?", model.BlockTypeBlock, since, afterID).
+		Order("id ASC").Limit(limit).
+		Find(&blocks).Error; err != nil {
+		return nil, fmt.Errorf("failed to get recent user blocks: %w", err)
+	}
+	return blocks, nil
+}
+
+// IsBlocked 检查两个用户之间是否存在任一方向的拉黑关系
+func IsBlocked(ctx context.Context, userID, targetUserID int64) (bool, error) {
+	var count int64
+	if err := DB.WithContext(ctx).Table(userBlockTable).
+		Where("block_type = ? AND ((user_id = ? AND target_user_id = ?) OR (user_id = ? AND target_user_id = ?))",
+			model.BlockTypeBlock, userID, targetUserID, targetUserID, userID).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check user block: %w", err)
+	}
+	return count > 0, nil
+}
+
+// GetBlockedUserIDs 在targetIDs中找出与userID存在任一方向拉黑关系的用户
+func GetBlockedUserIDs(ctx context.Context, userID int64, targetIDs []int64) ([]int64, error) {
+	if len(targetIDs) == 0 {
+		return nil, nil
+	}
+
+	var blocks []*model.UserBlock
+	if err := DB.WithContext(ctx).Table(userBlockTable).
+		Where("block_type = ? AND ((user_id = ? AND target_user_id IN ?) OR (target_user_id = ? AND user_id IN ?))",
+			model.BlockTypeBlock, userID, targetIDs, userID, targetIDs).
+		Find(&blocks).Error; err != nil {
+		return nil, fmt.Errorf("failed to get blocked users: %w", err)
+	}
+
+	seen := make(map[int64]struct{}, len(blocks))
+	result := make([]int64, 0, len(blocks))
+	for _, b := range blocks {
+		other := b.TargetUserID
+		if other == userID {
+			other = b.UserID
+		}
+		if _, ok := seen[other]; ok {
+			continue
+		}
+		seen[other] = struct{}{}
+		result = append(result, other)
+	}
+	return result, nil
+}
+
+// GetMutedUserIDs 在targetIDs中找出被userID静音的用户
+func GetMutedUserIDs(ctx context.Context, userID int64, targetIDs []int64) ([]int64, error) {
+	if len(targetIDs) == 0 {
+		return nil, nil
+	}
+
+	var result []int64
+	if err := DB.WithContext(ctx).Table(userBlockTable).
+		Where("user_id = ? AND block_type = ? AND target_user_id IN ?", userID, model.BlockTypeMute, targetIDs).
+		Pluck("target_user_id", &result).Error; err != nil {
+		return nil, fmt.Errorf("failed to get muted users: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,328 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"HuaTug.com/cmd/model"
+	"HuaTug.com/pkg/sharding"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 反向粉丝表（fans_N）按被关注者分片，由正向关注表（follows_N）派生。
+// 每次写follows都在同一个本地事务里写一条follow_outbox记录，提交后立即尝试同步fans；
+// 同步失败的记录由outbox中继重试，修复任务定期全量比对两侧数据兜底。
+// 同步不锁正向记录：从主库读取正向记录后按其version条件写入fans，只有不低于fans当前版本的状态才会写入，
+// 同一关注关系的多次同步（提交后的立即同步、outbox中继、修复任务）乱序完成时旧状态不会覆盖新状态，
+// 重复执行只是把fans再写成同样的状态，是安全的。
+
+const (
+	followOutboxTable = "follow_outbox"
+
+	// MaxFollowOutboxRetry 超过重试次数的outbox记录不再处理，留给修复任务和人工排查
+	MaxFollowOutboxRetry = 10
+)
+
+// followOutbox 待同步到粉丝表的关注关系变更
+type followOutbox struct {
+	ID         int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID     int64     `gorm:"column:user_id"`
+	FollowerID int64     `gorm:"column:follower_id"`
+	RetryCount int       `gorm:"column:retry_count"`
+	LastError  string    `gorm:"column:last_error"`
+	CreatedAt  time.Time `gorm:"column:created_at"`
+}
+
+// fanRecord 粉丝表记录，不包含只对关注者可见的备注
+type fanRecord struct {
+	ID         int64      `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID     int64      `gorm:"column:user_id"`
+	FollowerID int64      `gorm:"column:follower_id"`
+	Status     int        `gorm:"column:status"`
+	Version    int64      `gorm:"column:version"`
+	CreatedAt  time.Time  `gorm:"column:created_at"`
+	UpdatedAt  time.Time  `gorm:"column:updated_at"`
+	DeletedAt  *time.Time `gorm:"column:deleted_at"`
+}
+
+// FanRepairStats 一次修复任务的统计
+type FanRepairStats struct {
+	Scanned  int64 // 检查过的记录数（两侧之和）
+	Repaired int64 // 修复的记录数
+	Failed   int64 // 修复失败的记录数
+}
+
+// writeFollowOutbox 在关注关系的写事务中记录一条待同步变更
+func writeFollowOutbox(tx *gorm.DB, userID, followerID int64) (int64, error) {
+	record := &followOutbox{
+		UserID:     userID,
+		FollowerID: followerID,
+		CreatedAt:  time.Now(),
+	}
+	if err := tx.Table(followOutboxTable).Create(record).Error; err != nil {
+		return 0, fmt.Errorf("failed to write follow outbox: %w", err)
+	}
+	return record.ID, nil
+}
+
+// afterFollowWrite 事务提交后立即同步粉丝表，成功则删除outbox记录，失败留给中继重试
+func (s *ShardedFollowDB) afterFollowWrite(ctx context.Context, userID, followerID, outboxID int64) {
+	if err := s.SyncFanRecord(ctx, userID, followerID); err != nil {
+		hlog.CtxWarnf(ctx, "Failed to sync fan record %d<-%d, left for outbox relay: %v", userID, followerID, err)
+		return
+	}
+	err := s.router.Execute(ctx, followTablePrefix, followerID, true, func(db *gorm.DB, _ string) error {
+		return db.WithContext(ctx).Table(followOutboxTable).Where("id = ?", outboxID).Delete(&followOutbox{}).Error
+	})
+	if err != nil {
+		hlog.CtxWarnf(ctx, "Failed to delete follow outbox %d: %v", outboxID, err)
+	}
+}
+
+// SyncFanRecord 将followerID对userID的关注关系从正向表同步到粉丝表
+// 正向记录从主库读取但不加锁，写粉丝表期间不阻塞关注关系的写入；并发同步的先后由version决定
+func (s *ShardedFollowDB) SyncFanRecord(ctx context.Context, userID, followerID int64) error {
+	if userID == 0 || followerID == 0 {
+		return errors.New("user_id and follower_id cannot be zero")
+	}
+
+	primary, err := s.readPrimaryRecord(ctx, userID, followerID)
+	if err != nil {
+		return err
+	}
+	if primary != nil {
+		return s.writeFanRecord(ctx, primary)
+	}
+
+	// 正向记录不存在（只有修复任务会遇到）：删除多余的粉丝记录后再读一次，
+	// 期间新建的关注关系按新状态重写，不会被这次删除抹掉
+	if err := s.router.Execute(ctx, fanTablePrefix, userID, true, func(db *gorm.DB, tableName string) error {
+		return db.WithContext(ctx).Table(tableName).
+			Where("user_id = ? AND follower_id = ?", userID, followerID).
+			Delete(&fanRecord{}).Error
+	}); err != nil {
+		return err
+	}
+	if primary, err = s.readPrimaryRecord(ctx, userID, followerID); err != nil || primary == nil {
+		return err
+	}
+	return s.writeFanRecord(ctx, primary)
+}
+
+// readPrimaryRecord 从主库读取正向表中的记录（包括已软删除的），不存在时返回nil
+func (s *ShardedFollowDB) readPrimaryRecord(ctx context.Context, userID, followerID int64) (*model.FollowRelation, error) {
+	var relations []*model.FollowRelation
+	err := s.router.Execute(ctx, followTablePrefix, followerID, true, func(db *gorm.DB, tableName string) error {
+		return db.WithContext(ctx).Table(tableName).
+			Where("user_id = ? AND follower_id = ?", userID, followerID).
+			Limit(1).Find(&relations).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read follow relation: %w", err)
+	}
+	if len(relations) == 0 {
+		return nil, nil
+	}
+	return relations[0], nil
+}
+
+// writeFanRecord 把粉丝表记录写成正向记录的状态，粉丝表中已有更高版本时保持不变
+func (s *ShardedFollowDB) writeFanRecord(ctx context.Context, primary *model.FollowRelation) error {
+	fan := &fanRecord{
+		UserID:     primary.UserID,
+		FollowerID: primary.FollowerID,
+		Status:     primary.Status,
+		Version:    primary.Version,
+		CreatedAt:  primary.CreatedAt,
+		UpdatedAt:  primary.UpdatedAt,
+		DeletedAt:  primary.DeletedAt,
+	}
+	// version放在最后赋值，前面的条件比较的都是更新前的版本
+	columns := []string{"status", "created_at", "updated_at", "deleted_at", "version"}
+	assignments := make(clause.Set, 0, len(columns))
+	for _, column := range columns {
+		assignments = append(assignments, clause.Assignment{
+			Column: clause.Column{Name: column},
+			Value:  gorm.Expr(fmt.Sprintf("IF(VALUES(version) >= version, VALUES(%s), %s)", column, column)),
+		})
+	}
+	return s.router.Execute(ctx, fanTablePrefix, primary.UserID, true, func(db *gorm.DB, tableName string) error {
+		return db.WithContext(ctx).Table(tableName).
+			Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "user_id"}, {Name: "follower_id"}},
+				DoUpdates: assignments,
+			}).
+			Create(fan).Error
+	})
+}
+
+// ProcessFollowOutbox 处理各分库中待同步的outbox记录，返回成功同步的条数
+// 多个实例同时处理同一条记录是安全的：同步是幂等的，乱序完成时由version丢弃旧状态
+func (s *ShardedFollowDB) ProcessFollowOutbox(ctx context.Context, batchSize int) (int, error) {
+	processed := 0
+	err := s.router.ForEachDatabase(ctx, true, func(dbKey string, shardDB *gorm.DB) error {
+		var records []*followOutbox
+		if err := shardDB.WithContext(ctx).Table(followOutboxTable).
+			Where("retry_count < ?", MaxFollowOutboxRetry).
+			Order("id ASC").Limit(batchSize).
+			Find(&records).Error; err != nil {
+			return fmt.Errorf("failed to load follow outbox from %s: %w", dbKey, err)
+		}
+
+		for _, record := range records {
+			if err := s.SyncFanRecord(ctx, record.UserID, record.FollowerID); err != nil {
+				hlog.CtxWarnf(ctx, "Failed to sync follow outbox %d in %s: %v", record.ID, dbKey, err)
+				if err := shardDB.WithContext(ctx).Table(followOutboxTable).Where("id = ?", record.ID).
+					Updates(map[string]interface{}{
+						"retry_count": gorm.Expr("retry_count + 1"),
+						"last_error":  truncateError(err),
+					}).Error; err != nil {
+					hlog.CtxErrorf(ctx, "Failed to record retry of follow outbox %d in %s: %v", record.ID, dbKey, err)
+				}
+				continue
+			}
+			if err := shardDB.WithContext(ctx).Table(followOutboxTable).Where("id = ?", record.ID).
+				Delete(&followOutbox{}).Error; err != nil {
+				hlog.CtxWarnf(ctx, "Failed to delete follow outbox %d in %s: %v", record.ID, dbKey, err)
+			}
+			processed++
+		}
+		return nil
+	})
+	return processed, err
+}
+
+// RepairFans 全量比对正向关注表与粉丝表并修复差异
+// 正向表是唯一的数据来源：正向记录缺失或状态不一致的粉丝记录按正向记录重写，多余的粉丝记录被删除
+func (s *ShardedFollowDB) RepairFans(ctx context.Context, batchSize int) (*FanRepairStats, error) {
+	stats := &FanRepairStats{}
+	err := s.router.ForEachShard(ctx, followTablePrefix, true, func(shardDB *gorm.DB, shard sharding.Shard) error {
+		return s.repairFromTable(ctx, shardDB, shard.TableName, batchSize, stats, s.checkFanSide)
+	})
+	if err != nil {
+		return stats, fmt.Errorf("failed to repair follows: %w", err)
+	}
+	err = s.router.ForEachShard(ctx, fanTablePrefix, true, func(shardDB *gorm.DB, shard sharding.Shard) error {
+		return s.repairFromTable(ctx, shardDB, shard.TableName, batchSize, stats, s.checkPrimarySide)
+	})
+	if err != nil {
+		return stats, fmt.Errorf("failed to repair fans: %w", err)
+	}
+	return stats, nil
+}
+
+// repairFromTable 按主键分批扫描表，对每批记录调用check找出需要修复的关注关系
+func (s *ShardedFollowDB) repairFromTable(ctx context.Context, shardDB *gorm.DB, tableName string, batchSize int, stats *FanRepairStats,
+	check func(ctx context.Context, rows []*fanRecord) ([]*fanRecord, error)) error {
+	var lastID int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var rows []*fanRecord
+		if err := shardDB.WithContext(ctx).Table(tableName).
+			Where("id > ?", lastID).Order("id ASC").Limit(batchSize).
+			Find(&rows).Error; err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		lastID = rows[len(rows)-1].ID
+		stats.Scanned += int64(len(rows))
+
+		mismatched, err := check(ctx, rows)
+		if err != nil {
+			return err
+		}
+		for _, row := range mismatched {
+			if err := s.SyncFanRecord(ctx, row.UserID, row.FollowerID); err != nil {
+				hlog.CtxWarnf(ctx, "Failed to repair fan record %d<-%d: %v", row.UserID, row.FollowerID, err)
+				stats.Failed++
+				continue
+			}
+			stats.Repaired++
+		}
+	}
+}
+
+// checkFanSide 找出粉丝表中缺失或状态不一致的正向记录
+func (s *ShardedFollowDB) checkFanSide(ctx context.Context, rows []*fanRecord) ([]*fanRecord, error) {
+	return s.diffAgainst(ctx, rows, fanTablePrefix, func(r *fanRecord) int64 { return r.UserID })
+}
+
+// checkPrimarySide 找出正向表中缺失或状态不一致的粉丝记录
+func (s *ShardedFollowDB) checkPrimarySide(ctx context.Context, rows []*fanRecord) ([]*fanRecord, error) {
+	return s.diffAgainst(ctx, rows, followTablePrefix, func(r *fanRecord) int64 { return r.FollowerID })
+}
+
+// diffAgainst 按shardKey把rows分组到另一侧的分片中查询，返回另一侧缺失或不一致的记录
+func (s *ShardedFollowDB) diffAgainst(ctx context.Context, rows []*fanRecord, otherPrefix string, shardKey func(*fanRecord) int64) ([]*fanRecord, error) {
+	type pair struct{ userID, followerID int64 }
+
+	groups := make(map[string][]*fanRecord)
+	keys := make(map[string]int64)
+	for _, row := range rows {
+		key := shardKey(row)
+		shard, err := s.router.Locate(otherPrefix, key)
+		if err != nil {
+			return nil, err
+		}
+		group := shard.String()
+		groups[group] = append(groups[group], row)
+		keys[group] = key
+	}
+
+	var mismatched []*fanRecord
+	for group, groupRows := range groups {
+		userIDs := make([]int64, 0, len(groupRows))
+		followerIDs := make([]int64, 0, len(groupRows))
+		for _, row := range groupRows {
+			userIDs = append(userIDs, row.UserID)
+			followerIDs = append(followerIDs, row.FollowerID)
+		}
+
+		var others []*fanRecord
+		err := s.router.Execute(ctx, otherPrefix, keys[group], false, func(db *gorm.DB, tableName string) error {
+			return db.WithContext(ctx).Table(tableName).
+				Where("user_id IN ? AND follower_id IN ?", userIDs, followerIDs).
+				Find(&others).Error
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		byPair := make(map[pair]*fanRecord, len(others))
+		for _, other := range others {
+			byPair[pair{other.UserID, other.FollowerID}] = other
+		}
+		for _, row := range groupRows {
+			other, ok := byPair[pair{row.UserID, row.FollowerID}]
+			if !ok || !sameFollowState(row, other) {
+				mismatched = append(mismatched, row)
+			}
+		}
+	}
+	return mismatched, nil
+}
+
+// sameFollowState 两侧记录的关注状态是否一致
+func sameFollowState(a, b *fanRecord) bool {
+	if a.Status != b.Status {
+		return false
+	}
+	return (a.DeletedAt == nil) == (b.DeletedAt == nil)
+}
+
+func truncateError(err error) string {
+	msg := err.Error()
+	if len(msg) > 255 {
+		msg = msg[:255]
+	}
+	return msg
+}
@@ -0,0 +1,45 @@
+package db
+
+import (
+	"fmt"
+
+	"HuaTug.com/pkg/sharding"
+)
+
+// 关注关系的两份存储使用相同的分库和拓扑，只是分片键不同
+const (
+	followTablePrefix = "follows" // 正向关注表，按follower_id分片
+	fanTablePrefix    = "fans"    // 反向粉丝表，按user_id（被关注者）分片
+)
+
+// followTables 关注服务中的分片逻辑表
+var followTables = []sharding.TableRule{
+	{Name: followTablePrefix, ShardKey: "follower_id", SurrogateKey: true},
+	{Name: fanTablePrefix, ShardKey: "user_id", SurrogateKey: true},
+}
+
+// 全局分片路由实例
+var globalRouter *sharding.Router
+
+// InitRouter 初始化全局分片路由
+func InitRouter(config *sharding.TopologyConfig) error {
+	if config == nil {
+		return fmt.Errorf("sharding config cannot be nil")
+	}
+	config.Name = "follows"
+
+	router, err := sharding.NewRouter(&sharding.RouterConfig{
+		TopologyConfig: *config,
+		Tables:         followTables,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to initialize sharding router: %w", err)
+	}
+	globalRouter = router
+	return nil
+}
+
+// GetRouter 获取全局分片路由
+func GetRouter() *sharding.Router {
+	return globalRouter
+}
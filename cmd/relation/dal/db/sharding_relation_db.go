@@ -7,29 +7,54 @@ import (
 	"time"
 
 	"HuaTug.com/cmd/model"
+	"HuaTug.com/pkg/mq"
+	"HuaTug.com/pkg/sharding"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// activeFollowCondition 生效中的关注关系：未删除且不是待通过的关注请求
+const activeFollowCondition = "deleted_at IS NULL AND status <> ?"
+
+// FollowEvents 在关注关系的写事务中发布事件，outbox是关注者所在分库的发件箱，事件与关注关系一起提交或回滚；
+// before为写入前的记录，只有RemoveFollow提供，其他写入为nil。返回错误时整个写入回滚
+type FollowEvents func(outbox mq.Publisher, before *model.FollowRelation) error
+
+// publishIn 在事务tx中调用events，events为nil时什么都不做
+func (events FollowEvents) publishIn(tx *gorm.DB, before *model.FollowRelation) error {
+	if events == nil {
+		return nil
+	}
+	return events(mq.NewOutbox(tx), before)
+}
+
 // ShardedFollowDB 分片关注关系DB
 type ShardedFollowDB struct {
-	shardingManager *ShardingManager
+	router *sharding.Router
 }
 
-func NewShardedFollowDB(shardingManager *ShardingManager) *ShardedFollowDB {
+func NewShardedFollowDB(router *sharding.Router) *ShardedFollowDB {
 	return &ShardedFollowDB{
-		shardingManager: shardingManager,
+		router: router,
 	}
 }
 
-func (s *ShardedFollowDB) getShardingManager() (*ShardingManager, error) {
-	if s.shardingManager == nil {
-		return nil, errors.New("sharding manager is nil")
+// OutboxSource 各关系分库的发件箱，交给mq.OutboxRelay发布
+func (s *ShardedFollowDB) OutboxSource() mq.OutboxSource {
+	return func(ctx context.Context, fn func(name string, db *gorm.DB) error) error {
+		return s.router.ForEachDatabase(ctx, true, fn)
 	}
-	return s.shardingManager, nil
 }
 
-// InsertFollow 插入关注关系
-func (s *ShardedFollowDB) InsertFollowWithTransaction(ctx context.Context, relation *model.FollowRelation) error {
+func (s *ShardedFollowDB) getRouter() (*sharding.Router, error) {
+	if s.router == nil {
+		return nil, errors.New("sharding router is nil")
+	}
+	return s.router, nil
+}
+
+// InsertFollow 插入关注关系，events在同一事务中发布
+func (s *ShardedFollowDB) InsertFollowWithTransaction(ctx context.Context, relation *model.FollowRelation, events FollowEvents) error {
 	if relation == nil {
 		return errors.New("relation cannot be nil")
 	}
@@ -42,15 +67,45 @@ func (s *ShardedFollowDB) InsertFollowWithTransaction(ctx context.Context, relat
 	relation.CreatedAt = now
 	relation.UpdatedAt = now
 	relation.DeletedAt = nil // 确保新记录的删除时间为 nil
+	if relation.Status == 0 {
+		relation.Status = model.FollowStatusNormal
+	}
 
-	return s.shardingManager.ExecuteInShard(ctx, relation.FollowerID, true, func(db *gorm.DB, tableName string) error {
+	var outboxID int64
+	err := s.router.Execute(ctx, followTablePrefix, relation.FollowerID, true, func(db *gorm.DB, tableName string) error {
 		return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-			if err := tx.Table(tableName).Create(relation).Error; err != nil {
-				return fmt.Errorf("failed to create follow relation in transaction: %w", err)
+			// (user_id, follower_id) 上有唯一索引，取关后再次关注时复用被软删除的记录
+			result := tx.Table(tableName).
+				Where("user_id = ? AND follower_id = ? AND deleted_at IS NOT NULL", relation.UserID, relation.FollowerID).
+				Updates(map[string]interface{}{
+					"status":     relation.Status,
+					"remark":     relation.Remark,
+					"version":    gorm.Expr("version + 1"),
+					"created_at": now,
+					"updated_at": now,
+					"deleted_at": nil,
+				})
+			if result.Error != nil {
+				return fmt.Errorf("failed to restore follow relation in transaction: %w", result.Error)
 			}
-			return nil
+			if result.RowsAffected == 0 {
+				if err := tx.Table(tableName).Create(relation).Error; err != nil {
+					return fmt.Errorf("failed to create follow relation in transaction: %w", err)
+				}
+			}
+
+			var err error
+			if outboxID, err = writeFollowOutbox(tx, relation.UserID, relation.FollowerID); err != nil {
+				return err
+			}
+			return events.publishIn(tx, nil)
 		})
 	})
+	if err != nil {
+		return err
+	}
+	s.afterFollowWrite(ctx, relation.UserID, relation.FollowerID, outboxID)
+	return nil
 }
 
 // DeleteFollow 删除关注关系（软删除）
@@ -59,33 +114,223 @@ func (s *ShardedFollowDB) DeleteFollow(ctx context.Context, userID, followerID i
 		return errors.New("user_id and follower_id cannot be zero")
 	}
 
-	return s.shardingManager.ExecuteInShard(ctx, followerID, true, func(db *gorm.DB, tableName string) error {
+	var outboxID int64
+	err := s.router.Execute(ctx, followTablePrefix, followerID, true, func(db *gorm.DB, tableName string) error {
 		return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 			now := time.Now()
+			result := tx.Table(tableName).Where("user_id = ? AND follower_id = ? AND deleted_at IS NULL", userID, followerID).
+				Updates(map[string]interface{}{"deleted_at": now, "version": gorm.Expr("version + 1")})
+			if result.Error != nil {
+				return fmt.Errorf("failed to soft delete follow relation: %w", result.Error)
+			}
+			if result.RowsAffected == 0 {
+				return nil
+			}
+
+			var err error
+			outboxID, err = writeFollowOutbox(tx, userID, followerID)
+			return err
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if outboxID != 0 {
+		s.afterFollowWrite(ctx, userID, followerID, outboxID)
+	}
+	return nil
+}
+
+// RemoveFollow 在主库上锁住并软删除followerID对userID的关注关系（包括待通过的关注请求），
+// 返回删除前的记录，没有生效中的记录时返回nil；并发删除同一关系时只有一次会返回记录，
+// events只在确实删除了记录时随删除在同一事务中发布
+func (s *ShardedFollowDB) RemoveFollow(ctx context.Context, userID, followerID int64, events FollowEvents) (*model.FollowRelation, error) {
+	if userID == 0 || followerID == 0 {
+		return nil, errors.New("user_id and follower_id cannot be zero")
+	}
+
+	var removed *model.FollowRelation
+	var outboxID int64
+	err := s.router.Execute(ctx, followTablePrefix, followerID, true, func(db *gorm.DB, tableName string) error {
+		return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			var relations []*model.FollowRelation
+			if err := tx.Table(tableName).Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("user_id = ? AND follower_id = ? AND deleted_at IS NULL", userID, followerID).
+				Limit(1).Find(&relations).Error; err != nil {
+				return fmt.Errorf("failed to read follow relation: %w", err)
+			}
+			if len(relations) == 0 {
+				return nil
+			}
+
+			// 按(user_id, follower_id)而不是代理主键更新，重分片双写时目标表中的主键与原位置不同
 			if err := tx.Table(tableName).Where("user_id = ? AND follower_id = ? AND deleted_at IS NULL", userID, followerID).
-				Update("deleted_at", now).Error; err != nil {
+				Updates(map[string]interface{}{"deleted_at": time.Now(), "version": gorm.Expr("version + 1")}).Error; err != nil {
 				return fmt.Errorf("failed to soft delete follow relation: %w", err)
 			}
+			var err error
+			if outboxID, err = writeFollowOutbox(tx, userID, followerID); err != nil {
+				return err
+			}
+			if err := events.publishIn(tx, relations[0]); err != nil {
+				return err
+			}
+			removed = relations[0]
 			return nil
 		})
 	})
+	if err != nil {
+		return nil, err
+	}
+	if outboxID != 0 {
+		s.afterFollowWrite(ctx, userID, followerID, outboxID)
+	}
+	return removed, nil
 }
 
-// GetFollowRelation 获取关注关系
-// func (s *ShardedFollowDB) GetFollowRelation(ctx context.Context, followerID int64, limit, offset int) ([]*model.FollowRelation, error) {
+// GetFollowRelation 获取followerID对userID的关注关系（包括待通过的关注请求），不存在时返回nil
+func (s *ShardedFollowDB) GetFollowRelation(ctx context.Context, userID, followerID int64) (*model.FollowRelation, error) {
+	return s.getFollowRelation(ctx, userID, followerID, false)
+}
+
+// GetPrimaryFollowRelation 从主库获取followerID对userID的关注关系，用于随后按读到的状态做条件更新
+func (s *ShardedFollowDB) GetPrimaryFollowRelation(ctx context.Context, userID, followerID int64) (*model.FollowRelation, error) {
+	return s.getFollowRelation(ctx, userID, followerID, true)
+}
 
-// }
+func (s *ShardedFollowDB) getFollowRelation(ctx context.Context, userID, followerID int64, write bool) (*model.FollowRelation, error) {
+	if userID == 0 || followerID == 0 {
+		return nil, errors.New("user_id and follower_id cannot be zero")
+	}
 
-// GetFollowingList 获取关注列表
-func (s *ShardedFollowDB) GetFollowingList(ctx context.Context, followerID int64, offset, limit int) ([]*model.FollowRelation, error) {
+	var relations []*model.FollowRelation
+	err := s.router.Execute(ctx, followTablePrefix, followerID, write, func(db *gorm.DB, tableName string) error {
+		return db.WithContext(ctx).Table(tableName).
+			Where("user_id = ? AND follower_id = ? AND deleted_at IS NULL", userID, followerID).
+			Limit(1).Find(&relations).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get follow relation: %w", err)
+	}
+	if len(relations) == 0 {
+		return nil, nil
+	}
+	return relations[0], nil
+}
+
+// UpdateFollowStatus 将关注关系从fromStatus更新为toStatus，返回是否有记录被更新，
+// events只在确实更新了记录时在同一事务中发布
+func (s *ShardedFollowDB) UpdateFollowStatus(ctx context.Context, userID, followerID int64, fromStatus, toStatus int, events FollowEvents) (bool, error) {
+	if userID == 0 || followerID == 0 {
+		return false, errors.New("user_id and follower_id cannot be zero")
+	}
+
+	var outboxID int64
+	err := s.router.Execute(ctx, followTablePrefix, followerID, true, func(db *gorm.DB, tableName string) error {
+		return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			result := tx.Table(tableName).
+				Where("user_id = ? AND follower_id = ? AND status = ? AND deleted_at IS NULL", userID, followerID, fromStatus).
+				Updates(map[string]interface{}{
+					"status":     toStatus,
+					"version":    gorm.Expr("version + 1"),
+					"updated_at": time.Now(),
+				})
+			if result.Error != nil || result.RowsAffected == 0 {
+				return result.Error
+			}
+
+			var err error
+			if outboxID, err = writeFollowOutbox(tx, userID, followerID); err != nil {
+				return err
+			}
+			return events.publishIn(tx, nil)
+		})
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to update follow status: %w", err)
+	}
+	if outboxID == 0 {
+		return false, nil
+	}
+	s.afterFollowWrite(ctx, userID, followerID, outboxID)
+	return true, nil
+}
+
+// UpdateActiveFollow 更新生效中的关注关系（不包括待通过的关注请求），返回是否有记录被更新
+func (s *ShardedFollowDB) UpdateActiveFollow(ctx context.Context, userID, followerID int64, updates map[string]interface{}) (bool, error) {
+	if userID == 0 || followerID == 0 {
+		return false, errors.New("user_id and follower_id cannot be zero")
+	}
+
+	updates["updated_at"] = time.Now()
+	updates["version"] = gorm.Expr("version + 1")
+	var outboxID int64
+	err := s.router.Execute(ctx, followTablePrefix, followerID, true, func(db *gorm.DB, tableName string) error {
+		return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			result := tx.Table(tableName).
+				Where("user_id = ? AND follower_id = ?", userID, followerID).
+				Where(activeFollowCondition, model.FollowStatusPending).
+				Updates(updates)
+			if result.Error != nil || result.RowsAffected == 0 {
+				return result.Error
+			}
+
+			var err error
+			outboxID, err = writeFollowOutbox(tx, userID, followerID)
+			return err
+		})
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to update follow relation: %w", err)
+	}
+	if outboxID == 0 {
+		return false, nil
+	}
+	s.afterFollowWrite(ctx, userID, followerID, outboxID)
+	return true, nil
+}
+
+// GetPendingFollowRequests 获取userID收到的待处理关注请求，从按被关注者分片的粉丝表单分片查询
+func (s *ShardedFollowDB) GetPendingFollowRequests(ctx context.Context, userID int64, offset, limit int) ([]*model.FollowRelation, int64, error) {
+	if userID == 0 {
+		return nil, 0, errors.New("user_id cannot be zero")
+	}
+
+	var total int64
+	var requests []*model.FollowRelation
+	err := s.router.Execute(ctx, fanTablePrefix, userID, false, func(db *gorm.DB, tableName string) error {
+		query := db.WithContext(ctx).Table(tableName).
+			Where("user_id = ? AND status = ? AND deleted_at IS NULL", userID, model.FollowStatusPending)
+		if err := query.Count(&total).Error; err != nil {
+			return err
+		}
+		// 最新的请求排在前面
+		query = query.Order("created_at DESC").Offset(offset)
+		if limit > 0 {
+			query = query.Limit(limit)
+		}
+		return query.Find(&requests).Error
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get follow requests: %w", err)
+	}
+	return requests, total, nil
+}
+
+// GetFollowingList 获取关注列表，statuses不为空时只返回对应关注类型的记录
+func (s *ShardedFollowDB) GetFollowingList(ctx context.Context, followerID int64, statuses []int, offset, limit int) ([]*model.FollowRelation, error) {
 	if followerID == 0 {
 		return nil, errors.New("follower_id cannot be zero")
 	}
 
 	var users []*model.FollowRelation
 
-	err := s.shardingManager.ExecuteInShard(ctx, followerID, false, func(db *gorm.DB, tableName string) error {
-		return db.WithContext(ctx).Table(tableName).Where("follower_id = ? AND deleted_at IS NULL", followerID).Limit(limit).Offset(offset).Find(&users).Error
+	err := s.router.Execute(ctx, followTablePrefix, followerID, false, func(db *gorm.DB, tableName string) error {
+		query := db.WithContext(ctx).Table(tableName).Where("follower_id = ?", followerID).Where(activeFollowCondition, model.FollowStatusPending)
+		if len(statuses) > 0 {
+			query = query.Where("status IN ?", statuses)
+		}
+		return query.Order("created_at DESC, id DESC").Limit(limit).Offset(offset).Find(&users).Error
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get follow relation: %w", err)
@@ -93,7 +338,46 @@ func (s *ShardedFollowDB) GetFollowingList(ctx context.Context, followerID int64
 	return users, nil
 }
 
-// GetFollowerList 获取粉丝列表
+// GetFollowingListByCursor 按关注时间倒序获取关注列表中排在after之后的至多limit条，after只需CreatedAt和ID，为nil时从最新开始
+func (s *ShardedFollowDB) GetFollowingListByCursor(ctx context.Context, followerID int64, statuses []int, after *model.FollowRelation, limit int) ([]*model.FollowRelation, error) {
+	if followerID == 0 {
+		return nil, errors.New("follower_id cannot be zero")
+	}
+
+	var users []*model.FollowRelation
+	err := s.router.Execute(ctx, followTablePrefix, followerID, false, func(db *gorm.DB, tableName string) error {
+		query := db.WithContext(ctx).Table(tableName).Where("follower_id = ?", followerID).Where(activeFollowCondition, model.FollowStatusPending)
+		if len(statuses) > 0 {
+			query = query.Where("status IN ?", statuses)
+		}
+		if after != nil {
+			query = query.Where("created_at < ? OR (created_at = ? AND id < ?)", after.CreatedAt, after.CreatedAt, after.ID)
+		}
+		return query.Order("created_at DESC, id DESC").Limit(limit).Find(&users).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get following list by cursor: %w", err)
+	}
+	return users, nil
+}
+
+// GetFollowingUserIDs 获取followerID关注的全部用户ID，包括待通过的关注请求
+func (s *ShardedFollowDB) GetFollowingUserIDs(ctx context.Context, followerID int64) ([]int64, error) {
+	if followerID == 0 {
+		return nil, errors.New("follower_id cannot be zero")
+	}
+
+	var userIDs []int64
+	err := s.router.Execute(ctx, followTablePrefix, followerID, false, func(db *gorm.DB, tableName string) error {
+		return db.WithContext(ctx).Table(tableName).Where("follower_id = ? AND deleted_at IS NULL", followerID).Pluck("user_id", &userIDs).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get following user ids: %w", err)
+	}
+	return userIDs, nil
+}
+
+// GetFollowerList 获取粉丝列表，悄悄关注对被关注者不可见
 func (s *ShardedFollowDB) GetFollowerList(ctx context.Context, userID int64, offset, limit int) ([]*model.FollowRelation, error) {
 	if userID == 0 {
 		return nil, errors.New("user_id cannot be zero")
@@ -101,8 +385,9 @@ func (s *ShardedFollowDB) GetFollowerList(ctx context.Context, userID int64, off
 
 	var users []*model.FollowRelation
 
-	err := s.shardingManager.ExecuteInShard(ctx, userID, false, func(db *gorm.DB, tableName string) error {
-		return db.WithContext(ctx).Table(tableName).Where("user_id = ? AND deleted_at IS NULL", userID).Limit(limit).Offset(offset).Find(&users).Error
+	err := s.router.Execute(ctx, fanTablePrefix, userID, false, func(db *gorm.DB, tableName string) error {
+		return db.WithContext(ctx).Table(tableName).Where("user_id = ?", userID).Where(activeFollowCondition, model.FollowStatusPending).
+			Where("status <> ?", model.FollowStatusSilent).Order("created_at DESC, id DESC").Limit(limit).Offset(offset).Find(&users).Error
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get follower list: %w", err)
@@ -110,15 +395,40 @@ func (s *ShardedFollowDB) GetFollowerList(ctx context.Context, userID int64, off
 	return users, nil
 }
 
-// GetFollowingCount 获取关注数量
-func (s *ShardedFollowDB) GetFollowingCount(ctx context.Context, followerID int64) (int64, error) {
+// GetFollowerListByCursor 按关注时间倒序获取粉丝列表中排在after之后的至多limit条，after只需CreatedAt和ID，为nil时从最新开始
+func (s *ShardedFollowDB) GetFollowerListByCursor(ctx context.Context, userID int64, after *model.FollowRelation, limit int) ([]*model.FollowRelation, error) {
+	if userID == 0 {
+		return nil, errors.New("user_id cannot be zero")
+	}
+
+	var users []*model.FollowRelation
+	err := s.router.Execute(ctx, fanTablePrefix, userID, false, func(db *gorm.DB, tableName string) error {
+		query := db.WithContext(ctx).Table(tableName).Where("user_id = ?", userID).Where(activeFollowCondition, model.FollowStatusPending).
+			Where("status <> ?", model.FollowStatusSilent)
+		if after != nil {
+			query = query.Where("created_at < ? OR (created_at = ? AND id < ?)", after.CreatedAt, after.CreatedAt, after.ID)
+		}
+		return query.Order("created_at DESC, id DESC").Limit(limit).Find(&users).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get follower list by cursor: %w", err)
+	}
+	return users, nil
+}
+
+// GetFollowingCount 获取关注数量，statuses不为空时只统计对应关注类型的记录
+func (s *ShardedFollowDB) GetFollowingCount(ctx context.Context, followerID int64, statuses []int) (int64, error) {
 	if followerID == 0 {
 		return 0, errors.New("follower_id cannot be zero")
 	}
 
 	var count int64
-	err := s.shardingManager.ExecuteInShard(ctx, followerID, false, func(db *gorm.DB, tableName string) error {
-		return db.WithContext(ctx).Table(tableName).Where("follower_id = ? AND deleted_at IS NULL", followerID).Count(&count).Error
+	err := s.router.Execute(ctx, followTablePrefix, followerID, false, func(db *gorm.DB, tableName string) error {
+		query := db.WithContext(ctx).Table(tableName).Where("follower_id = ?", followerID).Where(activeFollowCondition, model.FollowStatusPending)
+		if len(statuses) > 0 {
+			query = query.Where("status IN ?", statuses)
+		}
+		return query.Count(&count).Error
 	})
 	if err != nil {
 		return 0, fmt.Errorf("failed to get following count: %w", err)
@@ -126,15 +436,15 @@ func (s *ShardedFollowDB) GetFollowingCount(ctx context.Context, followerID int6
 	return count, nil
 }
 
-// IsFollowing 检查是否已关注
+// IsFollowing 检查followerId是否已关注userID，待通过的关注请求不算已关注
 func (s *ShardedFollowDB) IsFollowing(ctx context.Context, userID, followerId int64) (bool, error) {
 	if userID == 0 || followerId == 0 {
 		return false, errors.New("user_id and follower_id cannot be zero")
 	}
 
 	var count int64
-	err := s.shardingManager.ExecuteInShard(ctx, userID, false, func(db *gorm.DB, tableName string) error {
-		return db.WithContext(ctx).Table(tableName).Where("user_id = ? AND follower_id = ? AND deleted_at IS NULL", userID, followerId).Count(&count).Error
+	err := s.router.Execute(ctx, followTablePrefix, followerId, false, func(db *gorm.DB, tableName string) error {
+		return db.WithContext(ctx).Table(tableName).Where("user_id = ? AND follower_id = ?", userID, followerId).Where(activeFollowCondition, model.FollowStatusPending).Count(&count).Error
 	})
 	if err != nil {
 		return false, fmt.Errorf("failed to check following status: %w", err)
@@ -142,96 +452,124 @@ func (s *ShardedFollowDB) IsFollowing(ctx context.Context, userID, followerId in
 	return count > 0, nil
 }
 
-// GetMutualFollowList 获取互关列表
+// mutualFollowBatchSize 查找互关时每批扫描的粉丝数
+const mutualFollowBatchSize = 200
+
+// GetMutualFollowList 获取互关列表：按关注时间倒序分批扫描我的粉丝，
+// 每批到我的关注分片中过滤出我也关注的人，凑够offset+limit条即停止，不再一次性加载全部关注列表
 func (s *ShardedFollowDB) GetMutualFollowList(ctx context.Context, userID int64, offset, limit int) ([]*model.FollowRelation, error) {
 	if userID == 0 {
 		return nil, errors.New("user_id cannot be zero")
 	}
 
-	// 首先获取我关注的所有人（我是关注者）
-	followingList, err := s.GetFollowingList(ctx, userID, 0, -1) // 获取所有我关注的人
-	if err != nil {
-		return nil, fmt.Errorf("failed to get following list: %w", err)
-	}
-
-	if len(followingList) == 0 {
-		return []*model.FollowRelation{}, nil
-	}
-
-	// 收集我关注的所有用户的ID（这些用户的user_id）
-	followingUserIDs := make([]int64, 0, len(followingList))
-	for _, follow := range followingList {
-		followingUserIDs = append(followingUserIDs, follow.UserID)
-	}
-
-	// 在所有分片中查找这些用户是否也关注了我
-	// 即：在这些用户中，查找follower_id等于这些用户ID，且user_id等于我的ID的记录
-	var mutualFollows []*model.FollowRelation
-
-	allDatabases := s.shardingManager.GetAllDatabases()
-
-	for _, db := range allDatabases {
-		for tableIndex := 0; tableIndex < s.shardingManager.config.TableCount; tableIndex++ {
-			tableName := fmt.Sprintf("follows_%d", tableIndex)
-
-			var follows []*model.FollowRelation
-			err := db.WithContext(ctx).Table(tableName).
-				Where("user_id = ? AND follower_id IN ? AND deleted_at IS NULL", userID, followingUserIDs).
-				Find(&follows).Error
-
-			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-				return nil, fmt.Errorf("failed to query mutual follows in table %s: %w", tableName, err)
+	mutualFollows := make([]*model.FollowRelation, 0)
+	var last *model.FollowRelation
+	for limit <= 0 || len(mutualFollows) < offset+limit {
+		// 粉丝表按被关注者分片，我的全部粉丝都在同一个分片中，按(created_at, id)游标分批读取
+		var fans []*model.FollowRelation
+		err := s.router.Execute(ctx, fanTablePrefix, userID, false, func(db *gorm.DB, tableName string) error {
+			query := db.WithContext(ctx).Table(tableName).
+				Where("user_id = ?", userID).
+				Where(activeFollowCondition, model.FollowStatusPending)
+			if last != nil {
+				query = query.Where("created_at < ? OR (created_at = ? AND id < ?)", last.CreatedAt, last.CreatedAt, last.ID)
 			}
+			return query.Order("created_at DESC, id DESC").Limit(mutualFollowBatchSize).Find(&fans).Error
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query mutual follows: %w", err)
+		}
+		if len(fans) == 0 {
+			break
+		}
+		last = fans[len(fans)-1]
 
-			if len(follows) > 0 {
-				mutualFollows = append(mutualFollows, follows...)
+		followerIDs := make([]int64, 0, len(fans))
+		for _, fan := range fans {
+			followerIDs = append(followerIDs, fan.FollowerID)
+		}
+		// 正向关注表按关注者分片，我关注的人同样都在一个分片中
+		var followingIDs []int64
+		err = s.router.Execute(ctx, followTablePrefix, userID, false, func(db *gorm.DB, tableName string) error {
+			return db.WithContext(ctx).Table(tableName).
+				Where("follower_id = ? AND user_id IN ?", userID, followerIDs).
+				Where(activeFollowCondition, model.FollowStatusPending).
+				Pluck("user_id", &followingIDs).Error
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get following list: %w", err)
+		}
+		following := make(map[int64]struct{}, len(followingIDs))
+		for _, id := range followingIDs {
+			following[id] = struct{}{}
+		}
+		for _, fan := range fans {
+			if _, ok := following[fan.FollowerID]; ok {
+				mutualFollows = append(mutualFollows, fan)
 			}
 		}
+
+		if len(fans) < mutualFollowBatchSize {
+			break
+		}
 	}
 
-	// 应用分页
-	start := offset
-	if start >= len(mutualFollows) {
+	if offset >= len(mutualFollows) {
 		return []*model.FollowRelation{}, nil
 	}
-
-	end := start + limit
-	if end > len(mutualFollows) {
-		end = len(mutualFollows)
+	mutualFollows = mutualFollows[offset:]
+	if limit > 0 && len(mutualFollows) > limit {
+		mutualFollows = mutualFollows[:limit]
 	}
+	return mutualFollows, nil
+}
 
-	if limit <= 0 { // 如果limit为0或负数，返回所有结果
-		return mutualFollows, nil
+// GetFollowerIDsAfter 按粉丝ID升序获取大于afterID的至多limit个粉丝，包括悄悄关注
+func (s *ShardedFollowDB) GetFollowerIDsAfter(ctx context.Context, userID, afterID int64, limit int) ([]int64, error) {
+	if userID == 0 {
+		return nil, errors.New("user_id cannot be zero")
 	}
 
-	return mutualFollows[start:end], nil
+	var followerIDs []int64
+	err := s.router.Execute(ctx, fanTablePrefix, userID, false, func(db *gorm.DB, tableName string) error {
+		return db.WithContext(ctx).Table(tableName).Where("user_id = ? AND follower_id > ?", userID, afterID).
+			Where(activeFollowCondition, model.FollowStatusPending).Order("follower_id").Limit(limit).Pluck("follower_id", &followerIDs).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get follower ids: %w", err)
+	}
+	return followerIDs, nil
 }
 
-// GetFollowerCount 获取粉丝数量
-func GetFollowerCount(ctx context.Context, userID int64) (int64, error) {
+// CountFollowerIDs 统计GetFollowerIDsAfter会返回的粉丝总数，包括悄悄关注
+func (s *ShardedFollowDB) CountFollowerIDs(ctx context.Context, userID int64) (int64, error) {
 	if userID == 0 {
 		return 0, errors.New("user_id cannot be zero")
 	}
 
-	shardingManager := GetShardingManager()
-	if shardingManager == nil {
-		return 0, errors.New("sharding manager is not initialized")
+	var count int64
+	err := s.router.Execute(ctx, fanTablePrefix, userID, false, func(db *gorm.DB, tableName string) error {
+		return db.WithContext(ctx).Table(tableName).Where("user_id = ?", userID).Where(activeFollowCondition, model.FollowStatusPending).Count(&count).Error
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count follower ids: %w", err)
 	}
+	return count, nil
+}
 
-	var totalCount int64
+// GetFollowerCount 获取粉丝数量，与粉丝列表一致不统计悄悄关注
+func (s *ShardedFollowDB) GetFollowerCount(ctx context.Context, userID int64) (int64, error) {
+	if userID == 0 {
+		return 0, errors.New("user_id cannot be zero")
+	}
 
-	allDatabases := shardingManager.GetAllDatabases()
-	for _, db := range allDatabases {
-		for tableIndex := 0; tableIndex < shardingManager.config.TableCount; tableIndex++ {
-			tableName := fmt.Sprintf("follows_%d", tableIndex)
-			var count int64
-			if err := db.WithContext(ctx).Table(tableName).Where("user_id = ? AND deleted_at IS NULL", userID).Count(&count).Error; err != nil {
-				if !errors.Is(err, gorm.ErrRecordNotFound) {
-					return 0, fmt.Errorf("failed to count followers in table %s: %w", tableName, err)
-				}
-			}
-			totalCount += count
-		}
+	var count int64
+	err := s.router.Execute(ctx, fanTablePrefix, userID, false, func(db *gorm.DB, tableName string) error {
+		return db.WithContext(ctx).Table(tableName).Where("user_id = ?", userID).Where(activeFollowCondition, model.FollowStatusPending).
+			Where("status <> ?", model.FollowStatusSilent).Count(&count).Error
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get follower count: %w", err)
 	}
-	return totalCount, nil
+	return count, nil
 }
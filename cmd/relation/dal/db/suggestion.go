@@ -0,0 +1,84 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"HuaTug.com/cmd/model"
+	"HuaTug.com/pkg/sharding"
+	"gorm.io/gorm"
+)
+
+// followerCountRow 粉丝表中单个用户的粉丝数
+type followerCountRow struct {
+	UserID int64
+	Cnt    int64
+}
+
+// followerCountQuery 粉丝数的统计口径与GetFollowerCount一致：生效中的关注，不包括悄悄关注
+func followerCountQuery(db *gorm.DB, tableName string) *gorm.DB {
+	return db.Table(tableName).Select("user_id, COUNT(*) AS cnt").
+		Where(activeFollowCondition, model.FollowStatusPending).
+		Where("status <> ?", model.FollowStatusSilent).
+		Group("user_id")
+}
+
+// GetFollowerCounts 从粉丝表批量统计粉丝数，按粉丝表分片分组后每个分表查询一次，没有粉丝的用户不出现在结果中
+func (s *ShardedFollowDB) GetFollowerCounts(ctx context.Context, userIDs []int64) (map[int64]int64, error) {
+	result := make(map[int64]int64, len(userIDs))
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	groups := make(map[string][]int64)
+	for _, id := range userIDs {
+		shard, err := s.router.Locate(fanTablePrefix, id)
+		if err != nil {
+			return nil, err
+		}
+		groups[shard.String()] = append(groups[shard.String()], id)
+	}
+
+	for _, ids := range groups {
+		var rows []followerCountRow
+		err := s.router.Execute(ctx, fanTablePrefix, ids[0], false, func(db *gorm.DB, tableName string) error {
+			return followerCountQuery(db.WithContext(ctx), tableName).Where("user_id IN ?", ids).Scan(&rows).Error
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get follower counts: %w", err)
+		}
+		for _, row := range rows {
+			result[row.UserID] = row.Cnt
+		}
+	}
+	return result, nil
+}
+
+// GetPopularUserIDs 按粉丝数获取热门用户，用于社交关系不足时补充推荐
+// 粉丝表按被关注者分片，同一用户的粉丝都在一个分表中，各分表的前limit名归并后就是全局的前limit名
+func (s *ShardedFollowDB) GetPopularUserIDs(ctx context.Context, limit int) ([]int64, error) {
+	rows, err := sharding.ScatterGather(ctx, s.router, sharding.ScatterQuery[followerCountRow]{
+		Table: fanTablePrefix,
+		Fetch: func(db *gorm.DB, tableName string, limit int) ([]followerCountRow, error) {
+			var rows []followerCountRow
+			err := followerCountQuery(db.WithContext(ctx), tableName).Order("cnt DESC, user_id").Limit(limit).Scan(&rows).Error
+			return rows, err
+		},
+		Less: func(a, b followerCountRow) bool {
+			if a.Cnt != b.Cnt {
+				return a.Cnt > b.Cnt
+			}
+			return a.UserID < b.UserID
+		},
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get popular users: %w", err)
+	}
+
+	userIDs := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		userIDs = append(userIDs, row.UserID)
+	}
+	return userIDs, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"HuaTug.com/cmd/relation/dal/db"
 	"HuaTug.com/config"
+	"HuaTug.com/pkg/sharding"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 )
 
@@ -13,15 +14,15 @@ var ShardedFollowDBInstance *db.ShardedFollowDB
 
 func Init() {
 	db.Init() // mysql init
-	if err := initShardingManager(); err != nil {
-		hlog.Errorf("Failed to initialize sharding manager: %v", err)
-		panic("Sharding manager initialization failed: " + err.Error())
+	if err := initShardingRouter(); err != nil {
+		hlog.Errorf("Failed to initialize sharding router: %v", err)
+		panic("Sharding router initialization failed: " + err.Error())
 	}
-	hlog.Info("Sharding manager initialized successfully")
+	hlog.Info("Sharding router initialized successfully")
 }
 
-func initShardingManager() error {
-	hlog.Info("Starting sharding manager initialization...")
+func initShardingRouter() error {
+	hlog.Info("Starting sharding router initialization...")
 
 	// 解析连接超时时间
 	connMaxLifetime := time.Hour // 默认1小时
@@ -52,35 +53,45 @@ func initShardingManager() error {
 	}
 
 	// 从配置中获取分片配置
-	// 处理SlaveDSNs类型转换 - 将[][]string转换为[]string
-	var slaveDSNs []string
-	for _, dsns := range config.ConfigInfo.FollowsSharding.SlaveDSNs {
-		slaveDSNs = append(slaveDSNs, dsns...)
+	shardingConfig := &sharding.TopologyConfig{
+		DatabaseCount:        config.ConfigInfo.FollowsSharding.DatabaseCount,
+		TableCount:           config.ConfigInfo.FollowsSharding.TableCount,
+		MasterDSNs:           config.ConfigInfo.FollowsSharding.MasterDSNs,
+		SlaveDSNs:            config.ConfigInfo.FollowsSharding.SlaveDSNs,
+		MaxOpenConns:         config.ConfigInfo.FollowsSharding.MaxOpenConns,
+		MaxIdleConns:         config.ConfigInfo.FollowsSharding.MaxIdleConns,
+		ConnMaxLifetime:      connMaxLifetime,
+		ReplicaMaxLag:        parseReplicaDuration("replica_max_lag", config.ConfigInfo.FollowsSharding.ReplicaMaxLag),
+		ReplicaCheckInterval: parseReplicaDuration("replica_check_interval", config.ConfigInfo.FollowsSharding.ReplicaCheckInterval),
+		MetaDB:               db.DB, // 分片拓扑版本保存在主库
 	}
 
-	shardingConfig := &db.ShardingConfig{
-		DatabaseCount:   config.ConfigInfo.FollowsSharding.DatabaseCount,
-		TableCount:      config.ConfigInfo.FollowsSharding.TableCount,
-		MasterDSNs:      config.ConfigInfo.FollowsSharding.MasterDSNs,
-		SlaveDSNs:       slaveDSNs,
-		MaxOpenConns:    config.ConfigInfo.FollowsSharding.MaxOpenConns,
-		MaxIdleConns:    config.ConfigInfo.FollowsSharding.MaxIdleConns,
-		ConnMaxLifetime: connMaxLifetime,
+	// 使用全局的InitRouter初始化分片路由
+	if err := db.InitRouter(shardingConfig); err != nil {
+		return err
 	}
 
-	// 使用全局的InitShardingManager初始化分片管理器
-	if err := db.InitShardingManager(shardingConfig); err != nil {
-		return fmt.Errorf("failed to initialize sharding manager: %w", err)
-	}
-
-	// 验证分片管理器是否成功初始化
-	shardingManager := db.GetShardingManager()
-	if shardingManager == nil {
-		return fmt.Errorf("sharding manager is nil after initialization")
+	// 验证分片路由是否成功初始化
+	router := db.GetRouter()
+	if router == nil {
+		return fmt.Errorf("sharding router is nil after initialization")
 	}
 
 	// 创建分片评论数据库实例
-	ShardedFollowDBInstance = db.NewShardedFollowDB(shardingManager)
+	ShardedFollowDBInstance = db.NewShardedFollowDB(router)
 	hlog.Info("ShardedFollowDBInstance created successfully")
 	return nil
 }
+
+// parseReplicaDuration 解析从库相关的时间配置，未配置或格式错误时返回0，由分片路由使用默认值
+func parseReplicaDuration(name, value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		hlog.Errorf("Failed to parse %s '%s': %v, using default", name, value, err)
+		return 0
+	}
+	return d
+}
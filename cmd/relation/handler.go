@@ -7,6 +7,7 @@ import (
 	"HuaTug.com/cmd/relation/service"
 	"HuaTug.com/kitex_gen/base"
 	"HuaTug.com/kitex_gen/relations"
+	"HuaTug.com/pkg/errno"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 	"github.com/pkg/errors"
@@ -18,7 +19,12 @@ func (v *RelationServiceImpl) RelationService(ctx context.Context, req *relation
 	resp = new(relations.RelationServiceResponse)
 	resp.Base = &base.Status{}
 
-	err = service.NewRelationService(ctx, dal.ShardedFollowDBInstance).RelationService(ctx, req)
+	resp.Pending, err = service.NewRelationService(ctx, dal.ShardedFollowDBInstance).RelationService(ctx, req)
+	if errors.Is(err, errno.UserBlockedErr) {
+		resp.Base.Code = consts.StatusForbidden
+		resp.Base.Msg = "Unable to follow this user"
+		return resp, nil
+	}
 	if err != nil {
 		hlog.CtxErrorf(ctx, "service.RelationService failed, original error: %v", errors.Cause(err))
 		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
@@ -28,13 +34,29 @@ func (v *RelationServiceImpl) RelationService(ctx context.Context, req *relation
 	}
 	resp.Base.Code = consts.StatusOK
 	resp.Base.Msg = "RelationAction Successfully"
+	if resp.Pending {
+		resp.Base.Msg = "Follow request sent"
+	}
 	return resp, nil
 }
 
 func (v *RelationServiceImpl) FollowingList(ctx context.Context, req *relations.FollowingListRequest) (resp *relations.FollowingListResponse, err error) {
 	resp = new(relations.FollowingListResponse)
 	resp, err = service.NewFollowingListService(ctx, dal.ShardedFollowDBInstance).FollowingList(ctx, req)
+	if resp == nil {
+		resp = new(relations.FollowingListResponse)
+	}
 	resp.Base = &base.Status{}
+	if errors.Is(err, errno.AuthorizationFailedErr) {
+		resp.Base.Code = consts.StatusForbidden
+		resp.Base.Msg = "No permission to view the list"
+		return resp, nil
+	}
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Invalid parameter"
+		return resp, nil
+	}
 	if err != nil {
 		hlog.CtxErrorf(ctx, "service.FollowingList failed, original error: %v", errors.Cause(err))
 		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
@@ -50,7 +72,20 @@ func (v *RelationServiceImpl) FollowingList(ctx context.Context, req *relations.
 func (v *RelationServiceImpl) FollowerList(ctx context.Context, req *relations.FollowerListRequest) (resp *relations.FollowerListResponse, err error) {
 	resp = new(relations.FollowerListResponse)
 	resp, err = service.NewFollowerListService(ctx, dal.ShardedFollowDBInstance).FollowerList(ctx, req)
+	if resp == nil {
+		resp = new(relations.FollowerListResponse)
+	}
 	resp.Base = &base.Status{}
+	if errors.Is(err, errno.AuthorizationFailedErr) {
+		resp.Base.Code = consts.StatusForbidden
+		resp.Base.Msg = "No permission to view the list"
+		return resp, nil
+	}
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Invalid parameter"
+		return resp, nil
+	}
 	if err != nil {
 		hlog.CtxErrorf(ctx, "service.FollowerList failed, original error: %v", errors.Cause(err))
 		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
@@ -78,3 +113,222 @@ func (v *RelationServiceImpl) FriendList(ctx context.Context, req *relations.Fri
 	resp.Base.Msg = "List Friend Successfully"
 	return resp, nil
 }
+
+func (v *RelationServiceImpl) FollowRequestList(ctx context.Context, req *relations.FollowRequestListRequest) (resp *relations.FollowRequestListResponse, err error) {
+	resp, err = service.NewFollowRequestService(ctx, dal.ShardedFollowDBInstance).FollowRequestList(ctx, req)
+	if resp == nil {
+		resp = new(relations.FollowRequestListResponse)
+	}
+	resp.Base = &base.Status{}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.FollowRequestList failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to List Follow Requests!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "List Follow Requests Successfully"
+	return resp, nil
+}
+
+func (v *RelationServiceImpl) AcceptFollowRequest(ctx context.Context, req *relations.AcceptFollowRequestRequest) (resp *relations.AcceptFollowRequestResponse, err error) {
+	resp = new(relations.AcceptFollowRequestResponse)
+	resp.Base = &base.Status{}
+
+	err = service.NewFollowRequestService(ctx, dal.ShardedFollowDBInstance).AcceptFollowRequest(ctx, req)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.AcceptFollowRequest failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Accept Follow Request!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Accept Follow Request Successfully"
+	return resp, nil
+}
+
+func (v *RelationServiceImpl) RejectFollowRequest(ctx context.Context, req *relations.RejectFollowRequestRequest) (resp *relations.RejectFollowRequestResponse, err error) {
+	resp = new(relations.RejectFollowRequestResponse)
+	resp.Base = &base.Status{}
+
+	err = service.NewFollowRequestService(ctx, dal.ShardedFollowDBInstance).RejectFollowRequest(ctx, req)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.RejectFollowRequest failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Reject Follow Request!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Reject Follow Request Successfully"
+	return resp, nil
+}
+
+func (v *RelationServiceImpl) BlockAction(ctx context.Context, req *relations.BlockActionRequest) (resp *relations.BlockActionResponse, err error) {
+	resp = new(relations.BlockActionResponse)
+	resp.Base = &base.Status{}
+
+	err = service.NewBlockService(ctx, dal.ShardedFollowDBInstance).BlockAction(ctx, req)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.BlockAction failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Block Action!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Block Action Successfully"
+	return resp, nil
+}
+
+func (v *RelationServiceImpl) BlockList(ctx context.Context, req *relations.BlockListRequest) (resp *relations.BlockListResponse, err error) {
+	resp, err = service.NewBlockService(ctx, dal.ShardedFollowDBInstance).BlockList(ctx, req)
+	if resp == nil {
+		resp = new(relations.BlockListResponse)
+	}
+	resp.Base = &base.Status{}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.BlockList failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to List Blocked Users!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "List Blocked Users Successfully"
+	return resp, nil
+}
+
+func (v *RelationServiceImpl) CheckBlock(ctx context.Context, req *relations.CheckBlockRequest) (resp *relations.CheckBlockResponse, err error) {
+	resp, err = service.NewBlockService(ctx, dal.ShardedFollowDBInstance).CheckBlock(ctx, req)
+	if resp == nil {
+		resp = new(relations.CheckBlockResponse)
+	}
+	resp.Base = &base.Status{}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.CheckBlock failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Check Block!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Check Block Successfully"
+	return resp, nil
+}
+
+func (v *RelationServiceImpl) UpdateFollowType(ctx context.Context, req *relations.UpdateFollowTypeRequest) (resp *relations.UpdateFollowTypeResponse, err error) {
+	resp = new(relations.UpdateFollowTypeResponse)
+	resp.Base = &base.Status{}
+
+	err = service.NewFollowTypeService(ctx, dal.ShardedFollowDBInstance).UpdateFollowType(ctx, req)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.UpdateFollowType failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Update Follow Type!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Update Follow Type Successfully"
+	return resp, nil
+}
+
+func (v *RelationServiceImpl) SetFollowRemark(ctx context.Context, req *relations.SetFollowRemarkRequest) (resp *relations.SetFollowRemarkResponse, err error) {
+	resp = new(relations.SetFollowRemarkResponse)
+	resp.Base = &base.Status{}
+
+	err = service.NewFollowTypeService(ctx, dal.ShardedFollowDBInstance).SetFollowRemark(ctx, req)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.SetFollowRemark failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Set Follow Remark!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Set Follow Remark Successfully"
+	return resp, nil
+}
+
+func (v *RelationServiceImpl) GetFollowType(ctx context.Context, req *relations.GetFollowTypeRequest) (resp *relations.GetFollowTypeResponse, err error) {
+	resp = new(relations.GetFollowTypeResponse)
+	resp.Base = &base.Status{}
+
+	resp.FollowType, err = service.NewFollowTypeService(ctx, dal.ShardedFollowDBInstance).GetFollowType(ctx, req)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.GetFollowType failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Get Follow Type!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Get Follow Type Successfully"
+	return resp, nil
+}
+
+func (v *RelationServiceImpl) GetFollowingIDs(ctx context.Context, req *relations.GetFollowingIDsRequest) (resp *relations.GetFollowingIDsResponse, err error) {
+	resp = new(relations.GetFollowingIDsResponse)
+	resp.Base = &base.Status{}
+
+	resp.UserIds, err = service.NewFollowingListService(ctx, dal.ShardedFollowDBInstance).FollowingIDs(ctx, req)
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Invalid parameter"
+		return resp, nil
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.GetFollowingIDs failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Get Following IDs!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Get Following IDs Successfully"
+	return resp, nil
+}
+
+func (v *RelationServiceImpl) GetFollowerIDs(ctx context.Context, req *relations.GetFollowerIDsRequest) (resp *relations.GetFollowerIDsResponse, err error) {
+	resp, err = service.NewFollowerListService(ctx, dal.ShardedFollowDBInstance).FollowerIDs(ctx, req)
+	if resp == nil {
+		resp = new(relations.GetFollowerIDsResponse)
+	}
+	resp.Base = &base.Status{}
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Invalid parameter"
+		return resp, nil
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.GetFollowerIDs failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Get Follower IDs!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Get Follower IDs Successfully"
+	return resp, nil
+}
+
+func (v *RelationServiceImpl) FollowSuggestion(ctx context.Context, req *relations.FollowSuggestionRequest) (resp *relations.FollowSuggestionResponse, err error) {
+	resp, err = service.NewSuggestionService(ctx, dal.ShardedFollowDBInstance).FollowSuggestion(ctx, req)
+	if resp == nil {
+		resp = new(relations.FollowSuggestionResponse)
+	}
+	resp.Base = &base.Status{}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.FollowSuggestion failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Get Follow Suggestions!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Get Follow Suggestions Successfully"
+	return resp, nil
+}
@@ -2,4 +2,6 @@ package infras
 
 func Init() {
 	InitUserRpc()
+	InitInteractionRpc()
+	InitProducer()
 }
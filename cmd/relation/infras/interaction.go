@@ -0,0 +1,35 @@
+package infras
+
+import (
+	"time"
+
+	"HuaTug.com/config"
+	"HuaTug.com/kitex_gen/interactions/interactionservice"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/retry"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	etcd "github.com/kitex-contrib/registry-etcd"
+)
+
+var InteractionClient interactionservice.Client
+
+func InitInteractionRpc() {
+	r, err := etcd.NewEtcdResolver([]string{config.ConfigInfo.Etcd.Addr})
+	if err != nil {
+		hlog.Info(err)
+	}
+	c, err := interactionservice.NewClient(
+		"Interaction",
+		client.WithMuxConnection(1),                       // mux
+		client.WithRPCTimeout(3*time.Second),              // 共同互动只是推荐信号之一，超时要短
+		client.WithConnectTimeout(10*time.Second),         // conn timeout
+		client.WithFailureRetry(retry.NewFailurePolicy()), // retry
+		client.WithResolver(r),                            // resolver
+		client.WithClientBasicInfo(&rpcinfo.EndpointBasicInfo{ServiceName: "Relation"}),
+	)
+	if err != nil {
+		hlog.Info(err)
+	}
+	InteractionClient = c
+}
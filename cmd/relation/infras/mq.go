@@ -0,0 +1,22 @@
+package infras
+
+import (
+	"HuaTug.com/pkg/mq"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+var (
+	Bus      *mq.Bus
+	Producer mq.Publisher // 直接发布，用于不需要与关注关系一起提交的通知
+)
+
+func InitProducer() {
+	// 连接地址来自配置文件或环境变量RABBITMQ_URL
+	bus, err := mq.NewBusFromConfig()
+	if err != nil {
+		hlog.Fatalf("Failed to initialize event bus: %v", err)
+	}
+	hlog.Info("Success init event bus")
+	Bus = bus
+	Producer = bus
+}
@@ -0,0 +1,188 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"HuaTug.com/cmd/relation/dal"
+	"HuaTug.com/cmd/relation/dal/db"
+	"HuaTug.com/cmd/relation/infras"
+	"HuaTug.com/cmd/relation/service"
+	"HuaTug.com/config/cache"
+	"HuaTug.com/pkg/mq"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	outboxRelayInterval = time.Second   // 粉丝表同步重试间隔
+	outboxRelayBatch    = 100           // 每个分库每次处理的outbox记录数
+	fanRepairInterval   = 6 * time.Hour // 粉丝表全量修复间隔
+	fanRepairBatch      = 500           // 修复时每批扫描的记录数
+	fanRepairTimeout    = 2 * time.Hour // 单次修复的最长时间
+	// 修复锁的持有时间，略短于间隔：每个周期全集群只修复一次，周期内重新部署的实例不会再次全量扫描
+	fanRepairLockTTL = fanRepairInterval - 5*time.Second
+
+	popularRefreshInterval = 10 * time.Minute // 关注推荐热门用户的重算间隔
+	// 重算锁的持有时间，略短于间隔，保证每个周期只有一个实例重算
+	popularRefreshLockTTL = popularRefreshInterval - 5*time.Second
+	popularRefreshTimeout = time.Minute
+
+	blockReconcileInterval = 10 * time.Minute // 拉黑后关注关系核对间隔
+	blockReconcileLockTTL  = blockReconcileInterval - 5*time.Second
+	blockReconcileWindow   = 24 * time.Hour // 只核对这段时间内创建的拉黑记录
+	blockReconcileBatch    = 200
+	blockReconcileTimeout  = 5 * time.Minute
+)
+
+var (
+	cancel context.CancelFunc
+	// eventRelay 发布各关系分库发件箱中的计数变更和关注事件
+	eventRelay *mq.OutboxRelay
+)
+
+// Init 启动粉丝表的outbox中继、事件发件箱中继、定期修复、热门用户重算与拉黑核对任务，
+// 依赖分片DB、缓存和事件总线已经初始化；
+// 启动时尝试一次修复，用于为已有的关注关系回填粉丝表；本周期内已有实例修复过时跳过
+func Init() {
+	var ctx context.Context
+	ctx, cancel = context.WithCancel(context.Background())
+
+	eventRelay = mq.NewOutboxRelay(infras.Bus, nil, dal.ShardedFollowDBInstance.OutboxSource())
+	eventRelay.Start()
+
+	go runOutboxRelay(ctx, dal.ShardedFollowDBInstance)
+	go runFanRepair(ctx, dal.ShardedFollowDBInstance)
+	go runPopularRefresh(ctx, dal.ShardedFollowDBInstance)
+	go runBlockReconcile(ctx, dal.ShardedFollowDBInstance)
+	hlog.Info("Relation background jobs started")
+}
+
+// Close 停止后台任务
+func Close() {
+	if cancel != nil {
+		cancel()
+	}
+	if eventRelay != nil {
+		eventRelay.Stop()
+	}
+}
+
+func runOutboxRelay(ctx context.Context, shardeDB *db.ShardedFollowDB) {
+	ticker := time.NewTicker(outboxRelayInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			processed, err := shardeDB.ProcessFollowOutbox(ctx, outboxRelayBatch)
+			if err != nil {
+				hlog.CtxErrorf(ctx, "Failed to process follow outbox: %v", err)
+			}
+			if processed > 0 {
+				hlog.CtxInfof(ctx, "Synced %d follow changes to fans table", processed)
+			}
+		}
+	}
+}
+
+func runFanRepair(ctx context.Context, shardeDB *db.ShardedFollowDB) {
+	ticker := time.NewTicker(fanRepairInterval)
+	defer ticker.Stop()
+	for {
+		repairFans(ctx, shardeDB)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func repairFans(ctx context.Context, shardeDB *db.ShardedFollowDB) {
+	locked, err := cache.TryLockFanRepair(fanRepairLockTTL)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "Failed to lock fans repair: %v", err)
+		return
+	}
+	if !locked {
+		return // 本周期内其他实例已经修复或正在修复
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, fanRepairTimeout)
+	defer cancel()
+
+	start := time.Now()
+	stats, err := shardeDB.RepairFans(ctx, fanRepairBatch)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "Fans repair aborted: %v", err)
+	}
+	hlog.CtxInfof(ctx, "Fans repair finished in %v: scanned=%d repaired=%d failed=%d",
+		time.Since(start), stats.Scanned, stats.Repaired, stats.Failed)
+}
+
+func runPopularRefresh(ctx context.Context, shardeDB *db.ShardedFollowDB) {
+	ticker := time.NewTicker(popularRefreshInterval)
+	defer ticker.Stop()
+	for {
+		refreshPopularUsers(ctx, shardeDB)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func refreshPopularUsers(ctx context.Context, shardeDB *db.ShardedFollowDB) {
+	locked, err := cache.TryLockPopularRefresh(popularRefreshLockTTL)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "Failed to lock popular users refresh: %v", err)
+		return
+	}
+	if !locked {
+		return // 其他实例正在重算
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, popularRefreshTimeout)
+	defer cancel()
+	start := time.Now()
+	if err := service.RefreshPopularUsers(ctx, shardeDB); err != nil {
+		hlog.CtxErrorf(ctx, "Popular users refresh aborted: %v", err)
+		return
+	}
+	hlog.CtxInfof(ctx, "Popular users refreshed in %v", time.Since(start))
+}
+
+func runBlockReconcile(ctx context.Context, shardeDB *db.ShardedFollowDB) {
+	ticker := time.NewTicker(blockReconcileInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reconcileBlocks(ctx, shardeDB)
+		}
+	}
+}
+
+func reconcileBlocks(ctx context.Context, shardeDB *db.ShardedFollowDB) {
+	locked, err := cache.TryLockBlockReconcile(blockReconcileLockTTL)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "Failed to lock block reconcile: %v", err)
+		return
+	}
+	if !locked {
+		return // 其他实例正在核对
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, blockReconcileTimeout)
+	defer cancel()
+	start := time.Now()
+	checked, failed, err := service.ReconcileBlocks(ctx, shardeDB, start.Add(-blockReconcileWindow), blockReconcileBatch)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "Block reconcile aborted: %v", err)
+	}
+	hlog.CtxInfof(ctx, "Block reconcile finished in %v: checked=%d failed=%d", time.Since(start), checked, failed)
+}
@@ -5,6 +5,7 @@ import (
 
 	"HuaTug.com/cmd/relation/dal"
 	"HuaTug.com/cmd/relation/infras"
+	"HuaTug.com/cmd/relation/job"
 	"HuaTug.com/config"
 	"HuaTug.com/config/cache"
 	"HuaTug.com/config/jaeger"
@@ -24,6 +25,8 @@ func Init() {
 	//tracer2.InitJaeger(constants.UserServiceName)
 	infras.Init()
 	dal.Init()
+	cache.Init() // 后台任务依赖缓存
+	job.Init()
 }
 
 func main() {
@@ -44,13 +47,14 @@ func main() {
 		panic(err)
 	}
 	Init()
-	cache.Init()
+	defer job.Close()
 	//当出现了UserServiceImpl报错时 说明当前该接口的方法没有被完全实现
 
 	svr := relation.NewServer(new(RelationServiceImpl),
 		server.WithServerBasicInfo(&rpcinfo.EndpointBasicInfo{ServiceName: "Relation"}), // server name
 		server.WithMiddleware(middleware.CommonMiddleware),                              // middleware
 		server.WithMiddleware(middleware.ServerMiddleware),
+		server.WithMiddleware(middleware.ReadYourWritesMiddleware),         // 请求内写后读走主库
 		server.WithServiceAddr(addr),                                       // address
 		server.WithLimit(&limit.Option{MaxConnections: 1000, MaxQPS: 100}), // limit
 		server.WithMuxTransport(),                                          // Multiplex
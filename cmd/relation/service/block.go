@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"HuaTug.com/cmd/model"
+	"HuaTug.com/cmd/relation/dal/db"
+	"HuaTug.com/kitex_gen/base"
+	"HuaTug.com/kitex_gen/relations"
+	"HuaTug.com/pkg/constants"
+	"HuaTug.com/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// 拉黑/静音操作类型
+const (
+	BlockActionBlock   = 1
+	BlockActionUnblock = 2
+	BlockActionMute    = 3
+	BlockActionUnmute  = 4
+)
+
+type BlockService struct {
+	ctx      context.Context
+	shardeDB *db.ShardedFollowDB
+}
+
+func NewBlockService(ctx context.Context, shardeDB *db.ShardedFollowDB) *BlockService {
+	return &BlockService{
+		ctx:      ctx,
+		shardeDB: shardeDB,
+	}
+}
+
+// BlockAction 拉黑/取消拉黑/静音/取消静音
+func (s *BlockService) BlockAction(ctx context.Context, req *relations.BlockActionRequest) error {
+	if req.UserId == 0 || req.TargetUserId == 0 || req.UserId == req.TargetUserId {
+		return errno.ParamErr
+	}
+
+	var err error
+	switch req.ActionType {
+	case BlockActionBlock:
+		return s.block(ctx, req.UserId, req.TargetUserId)
+	case BlockActionUnblock:
+		err = db.DeleteBlock(ctx, req.UserId, req.TargetUserId, model.BlockTypeBlock)
+	case BlockActionMute:
+		err = db.CreateBlock(ctx, req.UserId, req.TargetUserId, model.BlockTypeMute)
+	case BlockActionUnmute:
+		err = db.DeleteBlock(ctx, req.UserId, req.TargetUserId, model.BlockTypeMute)
+	default:
+		return errno.ParamErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update block relation: %w", errno.ServiceErr)
+	}
+	return nil
+}
+
+// block 拉黑后双方之间的关注关系（包括待通过的关注请求）全部解除
+// 拉黑记录先写入，解除关注中途失败时由客户端重试或ReconcileBlocks补做，两者都是幂等的
+func (s *BlockService) block(ctx context.Context, userID, targetUserID int64) error {
+	if err := db.CreateBlock(ctx, userID, targetUserID, model.BlockTypeBlock); err != nil {
+		return fmt.Errorf("failed to block user: %w", errno.ServiceErr)
+	}
+	if err := removeFollowsBetween(ctx, s.shardeDB, userID, targetUserID); err != nil {
+		return fmt.Errorf("failed to remove follow relation: %w", errno.ServiceErr)
+	}
+	return nil
+}
+
+// removeFollowsBetween 解除两个用户之间双向的关注关系，关注关系在主库上加锁读取和删除，
+// 不会漏掉刚写入尚未同步到从库的关注，也不会与并发的取关重复发布计数变更
+func removeFollowsBetween(ctx context.Context, shardeDB *db.ShardedFollowDB, userID, targetUserID int64) error {
+	pairs := [][2]int64{
+		{targetUserID, userID}, // 我关注了对方
+		{userID, targetUserID}, // 对方关注了我
+	}
+	for _, pair := range pairs {
+		followeeID, followerID := pair[0], pair[1]
+		if _, err := shardeDB.RemoveFollow(ctx, followeeID, followerID, unfollowEvents(ctx, followerID, followeeID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReconcileBlocks 检查since之后创建的拉黑记录，解除拉黑时未能删除的关注关系，返回检查的拉黑记录数和失败数
+func ReconcileBlocks(ctx context.Context, shardeDB *db.ShardedFollowDB, since time.Time, batchSize int) (checked, failed int, err error) {
+	var lastID int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return checked, failed, err
+		}
+		blocks, err := db.GetBlocksSince(ctx, since, lastID, batchSize)
+		if err != nil {
+			return checked, failed, err
+		}
+		if len(blocks) == 0 {
+			return checked, failed, nil
+		}
+		lastID = blocks[len(blocks)-1].ID
+		for _, block := range blocks {
+			checked++
+			if err := removeFollowsBetween(ctx, shardeDB, block.UserID, block.TargetUserID); err != nil {
+				hlog.CtxWarnf(ctx, "Failed to remove follows between blocked users %d and %d: %v", block.UserID, block.TargetUserID, err)
+				failed++
+			}
+		}
+	}
+}
+
+// BlockList 获取拉黑/静音列表
+func (s *BlockService) BlockList(ctx context.Context, req *relations.BlockListRequest) (*relations.BlockListResponse, error) {
+	resp := &relations.BlockListResponse{
+		Items: make([]*base.UserLite, 0),
+	}
+
+	blockType := int(req.BlockType)
+	if blockType == 0 {
+		blockType = model.BlockTypeBlock
+	}
+	if blockType != model.BlockTypeBlock && blockType != model.BlockTypeMute {
+		return nil, errno.ParamErr
+	}
+
+	// 参数验证
+	if req.PageNum <= 0 {
+		req.PageNum = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = constants.DefaultLimit
+	}
+
+	offset := int((req.PageNum - 1) * req.PageSize)
+	limit := int(req.PageSize)
+
+	blocks, total, err := db.GetBlockList(ctx, req.UserId, blockType, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block list: %w", errno.ServiceErr)
+	}
+
+	for _, v := range blocks {
+		userInfo, err := getUserInfo(ctx, v.TargetUserID)
+		if err != nil {
+			continue // 跳过失败的用户
+		}
+		resp.Items = append(resp.Items, &base.UserLite{
+			Uid:       userInfo.UserId,
+			UserName:  userInfo.UserName,
+			AvatarUrl: userInfo.AvatarUrl,
+		})
+	}
+	resp.Total = total
+	return resp, nil
+}
+
+// CheckBlock 批量检查user与targets之间的拉黑/静音关系，供其他服务过滤内容
+func (s *BlockService) CheckBlock(ctx context.Context, req *relations.CheckBlockRequest) (*relations.CheckBlockResponse, error) {
+	resp := &relations.CheckBlockResponse{
+		BlockedUserIds: make([]int64, 0),
+		MutedUserIds:   make([]int64, 0),
+	}
+	if req.UserId == 0 || len(req.TargetUserIds) == 0 {
+		return resp, nil
+	}
+
+	blocked, err := db.GetBlockedUserIDs(ctx, req.UserId, req.TargetUserIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check blocked users: %w", errno.ServiceErr)
+	}
+	muted, err := db.GetMutedUserIDs(ctx, req.UserId, req.TargetUserIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check muted users: %w", errno.ServiceErr)
+	}
+
+	resp.BlockedUserIds = append(resp.BlockedUserIds, blocked...)
+	resp.MutedUserIds = append(resp.MutedUserIds, muted...)
+	return resp, nil
+}
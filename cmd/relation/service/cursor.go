@@ -0,0 +1,42 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"HuaTug.com/cmd/model"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/sharding"
+)
+
+// 关注/粉丝列表游标的标识，排序键为关注时间，ID为关注记录的主键
+const (
+	followingCursorScope = "following"
+	followerCursorScope  = "followers"
+)
+
+// decodeFollowCursor 把游标还原为只有CreatedAt和ID的关注记录，cursor为空时返回nil
+func decodeFollowCursor(scope, cursor string) (*model.FollowRelation, error) {
+	c, err := sharding.DecodeCursor[sharding.KeyCursor](scope, cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, errno.ParamErr)
+	}
+	if c == nil {
+		return nil, nil
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, c.Key)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", sharding.ErrInvalidCursor, errno.ParamErr)
+	}
+	return &model.FollowRelation{ID: c.ID, CreatedAt: createdAt}, nil
+}
+
+// pageFollowRelations 从多读一条的查询结果中截取一页，还有下一页时返回最后一条的游标
+func pageFollowRelations(scope string, rows []*model.FollowRelation, limit int) ([]*model.FollowRelation, string) {
+	if len(rows) <= limit {
+		return rows, ""
+	}
+	rows = rows[:limit]
+	last := rows[limit-1]
+	return rows, sharding.KeyCursor{Key: last.CreatedAt.Format(time.RFC3339Nano), ID: last.ID}.Encode(scope)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"HuaTug.com/cmd/model"
 	"HuaTug.com/cmd/relation/dal/db"
 	"HuaTug.com/cmd/relation/infras"
 	"HuaTug.com/kitex_gen/base"
@@ -31,20 +32,39 @@ func (s *FollowerListService) FollowerList(ctx context.Context, req *relations.F
 	}
 
 	// 参数验证
-	if req.PageNum <= 0 {
-		req.PageNum = 1
-	}
 	if req.PageSize <= 0 || req.PageSize > 100 {
 		req.PageSize = constants.DefaultLimit
 	}
 
-	// 获取粉丝列表
-	offset := int((req.PageNum - 1) * req.PageSize)
-	limit := int(req.PageSize)
+	if err := checkListVisibility(ctx, s.shardeDB, req.UserId, req.ViewerId, false); err != nil {
+		return nil, err
+	}
 
-	userlist, err := s.shardeDB.GetFollowerList(ctx, req.UserId, offset, limit)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get follower list: %w", errno.ServiceErr)
+	// 获取粉丝列表，带游标或显式要求时按游标翻页，否则保持原有的页码分页，未指定页码的旧客户端从第一页开始
+	var userlist []*model.FollowRelation
+	limit := int(req.PageSize)
+	useCursor := req.Cursor != "" || req.UseCursor
+	if !useCursor && req.PageNum <= 0 {
+		req.PageNum = 1
+	}
+	if useCursor {
+		after, err := decodeFollowCursor(followerCursorScope, req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		// 多读一条用于判断是否还有下一页
+		rows, err := s.shardeDB.GetFollowerListByCursor(ctx, req.UserId, after, limit+1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get follower list: %w", errno.ServiceErr)
+		}
+		userlist, resp.NextCursor = pageFollowRelations(followerCursorScope, rows, limit)
+		resp.HasMore = resp.NextCursor != ""
+	} else {
+		offset := int((req.PageNum - 1) * req.PageSize)
+		var err error
+		if userlist, err = s.shardeDB.GetFollowerList(ctx, req.UserId, offset, limit); err != nil {
+			return nil, fmt.Errorf("failed to get follower list: %w", errno.ServiceErr)
+		}
 	}
 	var userIds []int64
 	for _, v := range userlist {
@@ -61,11 +81,14 @@ func (s *FollowerListService) FollowerList(ctx context.Context, req *relations.F
 	}
 
 	// 获取总数
-	total, err := db.GetFollowerCount(ctx, req.UserId)
+	total, err := s.shardeDB.GetFollowerCount(ctx, req.UserId)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get follower count: %w", errno.ServiceErr)
 	}
 	resp.Total = total
+	if !useCursor {
+		resp.HasMore = req.PageNum*req.PageSize < total
+	}
 
 	return resp, nil
 }
@@ -94,3 +117,35 @@ func (s *FollowerListService) batchGetUserInfo(ctx context.Context, userIds []in
 
 	return result, nil
 }
+
+const followerIDsMaxLimit = 1000
+
+// FollowerIDs 按粉丝ID升序分批返回粉丝ID，供其他服务使用，不做可见性检查也不查询用户信息
+func (s *FollowerListService) FollowerIDs(ctx context.Context, req *relations.GetFollowerIDsRequest) (*relations.GetFollowerIDsResponse, error) {
+	if req.UserId == 0 || req.AfterId < 0 {
+		return nil, errno.ParamErr
+	}
+	limit := int(req.Limit)
+	if limit <= 0 || limit > followerIDsMaxLimit {
+		limit = followerIDsMaxLimit
+	}
+
+	resp := &relations.GetFollowerIDsResponse{}
+	// 多读一条用于判断是否还有下一批
+	ids, err := s.shardeDB.GetFollowerIDsAfter(ctx, req.UserId, req.AfterId, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get follower ids: %w", errno.ServiceErr)
+	}
+	if len(ids) > limit {
+		ids, resp.HasMore = ids[:limit], true
+	}
+	resp.UserIds = ids
+
+	// Total与UserIds同一口径（包括悄悄关注），调用方可以用它判断实际要遍历的粉丝规模
+	if req.AfterId == 0 {
+		if resp.Total, err = s.shardeDB.CountFollowerIDs(ctx, req.UserId); err != nil {
+			return nil, fmt.Errorf("failed to get follower count: %w", errno.ServiceErr)
+		}
+	}
+	return resp, nil
+}
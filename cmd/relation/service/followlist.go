@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"HuaTug.com/cmd/model"
 	"HuaTug.com/cmd/relation/dal/db"
 	"HuaTug.com/cmd/relation/infras"
 	"HuaTug.com/kitex_gen/base"
@@ -32,20 +33,53 @@ func (s *FollowingListService) FollowingList(ctx context.Context, req *relations
 	}
 
 	// 参数验证
-	if req.PageNum <= 0 {
-		req.PageNum = 1
-	}
 	if req.PageSize <= 0 || req.PageSize > 100 {
 		req.PageSize = constants.DefaultLimit
 	}
 
-	// 获取关注列表
-	offset := int((req.PageNum - 1) * req.PageSize)
-	limit := int(req.PageSize)
+	if err := checkListVisibility(ctx, s.shardeDB, req.UserId, req.ViewerId, true); err != nil {
+		return nil, err
+	}
 
-	userlist, err := s.shardeDB.GetFollowingList(ctx, req.UserId, offset, limit)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get following list: %w", errno.ServiceErr)
+	// 悄悄关注只有本人可见，关注类型过滤也只对本人生效
+	isOwner := req.ViewerId == 0 || req.ViewerId == req.UserId
+	var statuses []int
+	if isOwner {
+		if req.FollowType != 0 {
+			if !model.IsValidFollowType(int(req.FollowType)) {
+				return nil, errno.ParamErr
+			}
+			statuses = model.FollowTypeStatuses(int(req.FollowType))
+		}
+	} else {
+		statuses = model.PublicFollowStatuses()
+	}
+
+	// 获取关注列表，带游标或显式要求时按游标翻页，否则保持原有的页码分页，未指定页码的旧客户端从第一页开始
+	var userlist []*model.FollowRelation
+	limit := int(req.PageSize)
+	useCursor := req.Cursor != "" || req.UseCursor
+	if !useCursor && req.PageNum <= 0 {
+		req.PageNum = 1
+	}
+	if useCursor {
+		after, err := decodeFollowCursor(followingCursorScope, req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		// 多读一条用于判断是否还有下一页
+		rows, err := s.shardeDB.GetFollowingListByCursor(ctx, req.UserId, statuses, after, limit+1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get following list: %w", errno.ServiceErr)
+		}
+		userlist, resp.NextCursor = pageFollowRelations(followingCursorScope, rows, limit)
+		resp.HasMore = resp.NextCursor != ""
+	} else {
+		offset := int((req.PageNum - 1) * req.PageSize)
+		var err error
+		if userlist, err = s.shardeDB.GetFollowingList(ctx, req.UserId, statuses, offset, limit); err != nil {
+			return nil, fmt.Errorf("failed to get following list: %w", errno.ServiceErr)
+		}
 	}
 
 	var userIds []int64
@@ -60,20 +94,64 @@ func (s *FollowingListService) FollowingList(ctx context.Context, req *relations
 		if err != nil {
 			return nil, fmt.Errorf("failed to get user info: %w", errno.ServiceErr)
 		}
+		if isOwner {
+			fillFollowDetail(userInfos, userlist)
+		}
 		resp.Items = userInfos
 	}
 
 	hlog.Info("userInfos:", resp.Items)
 
-	// // 获取总数
-	// total, err := s.shardeDB.GetFollowingCount(ctx, req.UserId)
-	// if err != nil {
-	// 	return nil, fmt.Errorf("failed to get following count: %w", errno.ServiceErr)
-	// }
-	resp.Total = int64(len(userlist))
+	// 获取总数
+	total, err := s.shardeDB.GetFollowingCount(ctx, req.UserId, statuses)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get following count: %w", errno.ServiceErr)
+	}
+	resp.Total = total
+	if !useCursor {
+		resp.HasMore = req.PageNum*req.PageSize < total
+	}
 	return resp, nil
 }
 
+// followingIDsMaxLimit 其他服务一次最多获取的关注数
+const followingIDsMaxLimit = 1000
+
+// FollowingIDs 按关注时间倒序返回用户关注的用户ID，供其他服务使用，不做可见性检查也不查询用户信息
+func (s *FollowingListService) FollowingIDs(ctx context.Context, req *relations.GetFollowingIDsRequest) ([]int64, error) {
+	if req.UserId == 0 {
+		return nil, errno.ParamErr
+	}
+	limit := int(req.Limit)
+	if limit <= 0 || limit > followingIDsMaxLimit {
+		limit = followingIDsMaxLimit
+	}
+
+	follows, err := s.shardeDB.GetFollowingList(ctx, req.UserId, nil, 0, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get following list: %w", errno.ServiceErr)
+	}
+	userIDs := make([]int64, 0, len(follows))
+	for _, r := range follows {
+		userIDs = append(userIDs, r.UserID)
+	}
+	return userIDs, nil
+}
+
+// fillFollowDetail 为本人的关注列表补充备注和关注类型
+func fillFollowDetail(items []*base.UserLite, follows []*model.FollowRelation) {
+	byUser := make(map[int64]*model.FollowRelation, len(follows))
+	for _, r := range follows {
+		byUser[r.UserID] = r
+	}
+	for _, item := range items {
+		if r, ok := byUser[item.Uid]; ok {
+			item.Remark = r.Remark
+			item.FollowType = int64(r.FollowType())
+		}
+	}
+}
+
 // batchGetUserInfo 批量获取用户信息
 func (s *FollowingListService) batchGetUserInfo(ctx context.Context, userIds []int64) ([]*base.UserLite, error) {
 	if len(userIds) == 0 {
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"HuaTug.com/cmd/model"
+	"HuaTug.com/cmd/relation/dal/db"
+	"HuaTug.com/kitex_gen/base"
+	"HuaTug.com/kitex_gen/relations"
+	"HuaTug.com/pkg/constants"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/mq"
+)
+
+type FollowRequestService struct {
+	ctx      context.Context
+	shardeDB *db.ShardedFollowDB
+}
+
+func NewFollowRequestService(ctx context.Context, shardeDB *db.ShardedFollowDB) *FollowRequestService {
+	return &FollowRequestService{
+		ctx:      ctx,
+		shardeDB: shardeDB,
+	}
+}
+
+// FollowRequestList 获取收到的待处理关注请求
+func (s *FollowRequestService) FollowRequestList(ctx context.Context, req *relations.FollowRequestListRequest) (*relations.FollowRequestListResponse, error) {
+	resp := &relations.FollowRequestListResponse{
+		Items: make([]*base.UserLite, 0),
+	}
+
+	// 参数验证
+	if req.PageNum <= 0 {
+		req.PageNum = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = constants.DefaultLimit
+	}
+
+	offset := int((req.PageNum - 1) * req.PageSize)
+	limit := int(req.PageSize)
+
+	requests, total, err := s.shardeDB.GetPendingFollowRequests(ctx, req.UserId, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get follow requests: %w", errno.ServiceErr)
+	}
+
+	for _, v := range requests {
+		userInfo, err := getUserInfo(ctx, v.FollowerID)
+		if err != nil {
+			continue // 跳过失败的用户
+		}
+		resp.Items = append(resp.Items, &base.UserLite{
+			Uid:       userInfo.UserId,
+			UserName:  userInfo.UserName,
+			AvatarUrl: userInfo.AvatarUrl,
+		})
+	}
+	resp.Total = total
+	return resp, nil
+}
+
+// AcceptFollowRequest 通过关注请求
+func (s *FollowRequestService) AcceptFollowRequest(ctx context.Context, req *relations.AcceptFollowRequestRequest) error {
+	if req.UserId == 0 || req.FromUserId == 0 || req.UserId == req.FromUserId {
+		return errno.ParamErr
+	}
+
+	updated, err := s.shardeDB.UpdateFollowStatus(ctx, req.UserId, req.FromUserId, model.FollowStatusPending, model.FollowStatusNormal,
+		func(outbox mq.Publisher, _ *model.FollowRelation) error {
+			return publishFollowChange(ctx, outbox, req.FromUserId, req.UserId, 1, false)
+		})
+	if err != nil {
+		return fmt.Errorf("failed to accept follow request: %w", errno.ServiceErr)
+	}
+	if !updated {
+		return errno.RequestErr // 没有待处理的请求
+	}
+
+	publishFollowNotification(ctx, s.shardeDB, req.FromUserId, req.UserId, NotificationTypeFollowAccept, "通过了你的关注请求")
+	return nil
+}
+
+// RejectFollowRequest 拒绝关注请求，请求被删除后对方可以重新申请
+func (s *FollowRequestService) RejectFollowRequest(ctx context.Context, req *relations.RejectFollowRequestRequest) error {
+	if req.UserId == 0 || req.FromUserId == 0 || req.UserId == req.FromUserId {
+		return errno.ParamErr
+	}
+
+	relation, err := s.shardeDB.GetFollowRelation(ctx, req.UserId, req.FromUserId)
+	if err != nil {
+		return fmt.Errorf("failed to get follow request: %w", errno.ServiceErr)
+	}
+	if relation == nil || !relation.IsPending() {
+		return errno.RequestErr
+	}
+
+	if err := s.shardeDB.DeleteFollow(ctx, req.UserId, req.FromUserId); err != nil {
+		return fmt.Errorf("failed to reject follow request: %w", errno.ServiceErr)
+	}
+	return nil
+}
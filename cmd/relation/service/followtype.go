@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"HuaTug.com/cmd/model"
+	"HuaTug.com/cmd/relation/dal/db"
+	"HuaTug.com/kitex_gen/relations"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/mq"
+)
+
+// MaxRemarkLength 关注备注的最大长度（字符数）
+const MaxRemarkLength = 30
+
+// maxFollowTypeAttempts 切换关注类型时读到的状态被并发修改后的重试次数
+const maxFollowTypeAttempts = 3
+
+type FollowTypeService struct {
+	ctx      context.Context
+	shardeDB *db.ShardedFollowDB
+}
+
+func NewFollowTypeService(ctx context.Context, shardeDB *db.ShardedFollowDB) *FollowTypeService {
+	return &FollowTypeService{
+		ctx:      ctx,
+		shardeDB: shardeDB,
+	}
+}
+
+// UpdateFollowType 切换普通关注/特别关注/悄悄关注，只能修改已生效的关注
+func (s *FollowTypeService) UpdateFollowType(ctx context.Context, req *relations.UpdateFollowTypeRequest) error {
+	if req.UserId == 0 || req.TargetUserId == 0 || req.UserId == req.TargetUserId {
+		return errno.ParamErr
+	}
+	if !model.IsValidFollowType(int(req.FollowType)) {
+		return errno.ParamErr
+	}
+
+	// 按主库读到的状态做条件更新，只有确实从该状态切换过来的一次请求修正粉丝数
+	for attempt := 0; ; attempt++ {
+		relation, err := s.shardeDB.GetPrimaryFollowRelation(ctx, req.TargetUserId, req.UserId)
+		if err != nil {
+			return fmt.Errorf("failed to get follow relation: %w", errno.ServiceErr)
+		}
+		if relation == nil || relation.IsPending() {
+			return errno.RequestErr // 未关注或关注请求尚未通过
+		}
+		if relation.Status == int(req.FollowType) {
+			return nil
+		}
+
+		// 悄悄关注不计入粉丝数，在悄悄关注与其他类型之间切换时随状态一起修正对方的粉丝数
+		var events db.FollowEvents
+		if wasSilent, isSilent := relation.IsSilent(), req.FollowType == model.FollowStatusSilent; wasSilent != isSilent {
+			delta := int64(1)
+			if isSilent {
+				delta = -1
+			}
+			events = func(outbox mq.Publisher, _ *model.FollowRelation) error {
+				return publishUserStats(ctx, outbox, &mq.UserStatsEvent{UserID: req.TargetUserId, StatType: mq.StatTypeFollowerCount, Delta: delta})
+			}
+		}
+		updated, err := s.shardeDB.UpdateFollowStatus(ctx, req.TargetUserId, req.UserId, relation.Status, int(req.FollowType), events)
+		if err != nil {
+			return fmt.Errorf("failed to update follow type: %w", errno.ServiceErr)
+		}
+		if updated {
+			return nil
+		}
+		if attempt+1 == maxFollowTypeAttempts {
+			return errno.RequestErr // 关注关系被并发修改，重试后仍未成功
+		}
+	}
+}
+
+// SetFollowRemark 设置关注备注，备注只对自己可见
+func (s *FollowTypeService) SetFollowRemark(ctx context.Context, req *relations.SetFollowRemarkRequest) error {
+	if req.UserId == 0 || req.TargetUserId == 0 || req.UserId == req.TargetUserId {
+		return errno.ParamErr
+	}
+	remark := strings.TrimSpace(req.Remark)
+	if utf8.RuneCountInString(remark) > MaxRemarkLength {
+		return errno.ParamErr.WithMessage(fmt.Sprintf("remark must be at most %d characters", MaxRemarkLength))
+	}
+
+	updated, err := s.shardeDB.UpdateActiveFollow(ctx, req.TargetUserId, req.UserId, map[string]interface{}{
+		"remark": remark,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set follow remark: %w", errno.ServiceErr)
+	}
+	if !updated {
+		return errno.RequestErr
+	}
+	return nil
+}
+
+// GetFollowType 查询user对target的关注类型，未关注时返回0
+func (s *FollowTypeService) GetFollowType(ctx context.Context, req *relations.GetFollowTypeRequest) (int64, error) {
+	if req.UserId == 0 || req.TargetUserId == 0 {
+		return 0, errno.ParamErr
+	}
+
+	relation, err := s.shardeDB.GetFollowRelation(ctx, req.TargetUserId, req.UserId)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get follow relation: %w", errno.ServiceErr)
+	}
+	if relation == nil {
+		return 0, nil
+	}
+	return int64(relation.FollowType()), nil
+}
@@ -3,11 +3,24 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"HuaTug.com/cmd/model"
 	"HuaTug.com/cmd/relation/dal/db"
+	"HuaTug.com/cmd/relation/infras"
+	"HuaTug.com/kitex_gen/base"
 	"HuaTug.com/kitex_gen/relations"
+	"HuaTug.com/kitex_gen/users"
 	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/mq"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/google/uuid"
+)
+
+// 关注相关的通知类型
+const (
+	NotificationTypeFollowRequest = "follow_request" // 收到关注请求
+	NotificationTypeFollowAccept  = "follow_accept"  // 关注请求被通过
 )
 
 type RelationService struct {
@@ -22,52 +35,173 @@ func NewRelationService(ctx context.Context, shardeDB *db.ShardedFollowDB) *Rela
 	}
 }
 
-func (s *RelationService) RelationService(ctx context.Context, req *relations.RelationServiceRequest) error {
+// RelationService 关注/取消关注，pending表示本次关注变成了待对方通过的关注请求
+func (s *RelationService) RelationService(ctx context.Context, req *relations.RelationServiceRequest) (pending bool, err error) {
 	if req.FromUserId == req.ToUserId {
-		return errno.RequestErr
+		return false, errno.RequestErr
 	}
 
 	switch req.ActionType {
 	case 1: // 关注
 		return s.CreateFollow(ctx, req)
 	case 2: // 取消关注
-		return s.CancelFollow(ctx, req)
+		return false, s.CancelFollow(ctx, req)
 	default:
-		return errno.ParamErr
+		return false, errno.ParamErr
 	}
 }
 
-func (s *RelationService) CreateFollow(ctx context.Context, req *relations.RelationServiceRequest) error {
-	// 检查是否已关注
-	exists, err := s.shardeDB.IsFollowing(ctx, req.FromUserId, req.ToUserId)
+func (s *RelationService) CreateFollow(ctx context.Context, req *relations.RelationServiceRequest) (bool, error) {
+	// 任一方拉黑了对方时不允许关注
+	blocked, err := db.IsBlocked(ctx, req.FromUserId, req.ToUserId)
+	if err != nil {
+		return false, fmt.Errorf("failed to check block status: %w", errno.ServiceErr)
+	}
+	if blocked {
+		return false, errno.UserBlockedErr
+	}
+
+	// 检查是否已关注或已发送过关注请求
+	relation, err := s.shardeDB.GetFollowRelation(ctx, req.ToUserId, req.FromUserId)
 	if err != nil {
-		return fmt.Errorf("failed to check following status: %w", errno.ServiceErr)
+		return false, fmt.Errorf("failed to check following status: %w", errno.ServiceErr)
 	}
-	if exists {
-		return errno.RequestErr // 已关注
+	if relation != nil {
+		return relation.IsPending(), errno.RequestErr // 已关注
+	}
+
+	target, err := getUserInfo(ctx, req.ToUserId)
+	if err != nil {
+		return false, fmt.Errorf("failed to get target user: %w", errno.ServiceErr)
+	}
+
+	// 私密账号的关注需要对方通过
+	status := model.FollowStatusNormal
+	if target.IsPrivate {
+		status = model.FollowStatusPending
 	}
 
+	// 关注请求通过后才计入双方的计数
+	var events db.FollowEvents
+	if status != model.FollowStatusPending {
+		events = func(outbox mq.Publisher, _ *model.FollowRelation) error {
+			return publishFollowChange(ctx, outbox, req.FromUserId, req.ToUserId, 1, false)
+		}
+	}
 	if err := s.shardeDB.InsertFollowWithTransaction(ctx, &model.FollowRelation{
 		UserID:     req.ToUserId,
 		FollowerID: req.FromUserId,
-	}); err != nil {
-		return fmt.Errorf("failed to create follow: %w", errno.ServiceErr)
+		Status:     status,
+	}, events); err != nil {
+		return false, fmt.Errorf("failed to create follow: %w", errno.ServiceErr)
 	}
-	return nil
+
+	if status == model.FollowStatusPending {
+		publishFollowNotification(ctx, s.shardeDB, req.ToUserId, req.FromUserId, NotificationTypeFollowRequest, "请求关注你")
+		return true, nil
+	}
+	return false, nil
 }
 
 func (s *RelationService) CancelFollow(ctx context.Context, req *relations.RelationServiceRequest) error {
-	// 检查是否已关注
-	exists, err := s.shardeDB.IsFollowing(ctx, req.FromUserId, req.ToUserId)
+	// 待通过的关注请求也可以撤回，撤回请求不影响计数
+	relation, err := s.shardeDB.RemoveFollow(ctx, req.ToUserId, req.FromUserId, unfollowEvents(ctx, req.FromUserId, req.ToUserId))
 	if err != nil {
-		return fmt.Errorf("failed to check following status: %w", errno.ServiceErr)
+		return fmt.Errorf("failed to delete follow: %w", errno.ServiceErr)
 	}
-	if !exists {
+	if relation == nil {
 		return errno.RequestErr // 未关注
 	}
+	return nil
+}
 
-	if err := s.shardeDB.DeleteFollow(ctx, req.FromUserId, req.ToUserId); err != nil {
-		return fmt.Errorf("failed to delete follow: %w", errno.ServiceErr)
+// getUserInfo 通过user服务获取用户信息
+func getUserInfo(ctx context.Context, userID int64) (*base.User, error) {
+	resp, err := infras.UserClient.GetUserInfo(ctx, &users.GetUserInfoRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	if resp.User == nil {
+		return nil, errno.UserNotExistErr
+	}
+	return resp.User, nil
+}
+
+// unfollowEvents 解除生效中的关注时发布的事件，撤回或拒绝待通过的关注请求不发布
+func unfollowEvents(ctx context.Context, followerID, followeeID int64) db.FollowEvents {
+	return func(outbox mq.Publisher, removed *model.FollowRelation) error {
+		if removed.IsPending() {
+			return nil
+		}
+		return publishFollowChange(ctx, outbox, followerID, followeeID, -1, removed.IsSilent())
+	}
+}
+
+// publishFollowChange 在关注关系的写事务中发布双方的计数变更和关注事件，delta为1表示关注生效，-1表示解除；
+// 写入事务的发件箱，与关注关系一起提交，由发件箱中继发布，user服务的计数和video服务的关注流不会因发布失败而漏更新。
+// 悄悄关注不计入粉丝数（与GetFollowerCount一致），silent为true时只更新关注者的关注数
+func publishFollowChange(ctx context.Context, outbox mq.Publisher, followerID, followeeID, delta int64, silent bool) error {
+	events := []*mq.UserStatsEvent{
+		{UserID: followerID, StatType: mq.StatTypeFollowingCount, Delta: delta},
+	}
+	if !silent {
+		events = append(events, &mq.UserStatsEvent{UserID: followeeID, StatType: mq.StatTypeFollowerCount, Delta: delta})
+	}
+	if err := publishUserStats(ctx, outbox, events...); err != nil {
+		return err
+	}
+
+	action := mq.FollowActionFollow
+	if delta < 0 {
+		action = mq.FollowActionUnfollow
+	}
+	return mq.Publish(ctx, outbox, &mq.FollowEvent{
+		FollowerID: followerID,
+		FolloweeID: followeeID,
+		Action:     action,
+		Timestamp:  time.Now().Unix(),
+		EventID:    uuid.New().String(),
+	})
+}
+
+// publishUserStats 把用户计数变更写入关注关系写事务的发件箱
+func publishUserStats(ctx context.Context, outbox mq.Publisher, events ...*mq.UserStatsEvent) error {
+	for _, event := range events {
+		event.Source = "relation"
+		event.Timestamp = time.Now().Unix()
+		event.EventID = uuid.New().String()
+		if err := mq.Publish(ctx, outbox, event); err != nil {
+			return err
+		}
 	}
 	return nil
 }
+
+// publishFollowNotification 向toUserID发送关注相关的通知，action为通知内容中用户名之后的部分
+// toUserID特别关注了fromUserID时通知以高优先级投递
+func publishFollowNotification(ctx context.Context, shardeDB *db.ShardedFollowDB, toUserID, fromUserID int64, notificationType, action string) {
+	userName := fmt.Sprint(fromUserID)
+	if fromUser, err := getUserInfo(ctx, fromUserID); err == nil {
+		userName = fromUser.UserName
+	}
+
+	event := &mq.NotificationEvent{
+		UserID:           toUserID,
+		FromUserID:       fromUserID,
+		ReceiverID:       toUserID,
+		SenderID:         fromUserID,
+		Type:             notificationType,
+		NotificationType: notificationType,
+		TargetID:         fromUserID,
+		Content:          fmt.Sprintf("%v %s", userName, action),
+		Timestamp:        time.Now().Unix(),
+		EventID:          uuid.New().String(),
+	}
+	if relation, err := shardeDB.GetFollowRelation(ctx, fromUserID, toUserID); err == nil && relation != nil &&
+		relation.FollowType() == model.FollowStatusSpecial {
+		event.Priority = mq.NotificationPriorityHigh
+	}
+	if err := mq.Publish(ctx, infras.Producer, event); err != nil {
+		hlog.CtxWarnf(ctx, "Failed to publish notification event: %v", err)
+	}
+}
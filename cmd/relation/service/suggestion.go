@@ -0,0 +1,331 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"HuaTug.com/cmd/model"
+	"HuaTug.com/cmd/relation/dal/db"
+	"HuaTug.com/cmd/relation/infras"
+	"HuaTug.com/config/cache"
+	"HuaTug.com/kitex_gen/base"
+	"HuaTug.com/kitex_gen/interactions"
+	"HuaTug.com/kitex_gen/relations"
+	"HuaTug.com/pkg/constants"
+	"HuaTug.com/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// 关注推荐的候选集规模与打分权重
+const (
+	suggestionMaxFollowees      = 200 // 参与二度关系计算的关注数上限
+	suggestionMaxPerFollowee    = 200 // 每个关注对象最多取多少条二度关系
+	suggestionMaxVideos         = 200 // 参与共同互动计算的最近视频数
+	suggestionMaxCoInteractions = 200 // 共同互动候选人上限
+	suggestionMinCandidates     = 50  // 候选人不足时用热门用户补齐
+	suggestionPopularLimit      = 100
+
+	// 二度关系按用户缓存，热门用户由后台任务定期重算，请求时不再实时扫描分表
+	suggestionSecondDegreeTTL = 10 * time.Minute
+	suggestionPopularTTL      = 30 * time.Minute // 热门用户缓存的过期时间，长于重算间隔，重算失败一次不影响推荐
+
+	suggestionMutualWeight      = 3.0 // 每个共同关注
+	suggestionInteractionWeight = 2.0 // 每个共同互动的视频
+	suggestionPopularityWeight  = 0.5 // log(1+粉丝数)
+)
+
+// suggestionCandidate 推荐候选人及其各项信号
+type suggestionCandidate struct {
+	userID        int64
+	mutualCount   int64   // 我关注的人中关注了TA的人数
+	viaUserIDs    []int64 // 用于生成推荐理由的共同关注（最多两个）
+	coInteraction int64
+	followerCount int64
+	score         float64
+}
+
+type SuggestionService struct {
+	ctx      context.Context
+	shardeDB *db.ShardedFollowDB
+}
+
+func NewSuggestionService(ctx context.Context, shardeDB *db.ShardedFollowDB) *SuggestionService {
+	return &SuggestionService{
+		ctx:      ctx,
+		shardeDB: shardeDB,
+	}
+}
+
+// FollowSuggestion 根据二度关系、共同互动和热度推荐可能认识的人
+func (s *SuggestionService) FollowSuggestion(ctx context.Context, req *relations.FollowSuggestionRequest) (*relations.FollowSuggestionResponse, error) {
+	resp := &relations.FollowSuggestionResponse{
+		Items: make([]*relations.FollowSuggestion, 0),
+	}
+	if req.UserId == 0 {
+		return nil, errno.ParamErr
+	}
+
+	// 参数验证
+	if req.PageNum <= 0 {
+		req.PageNum = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = constants.DefaultLimit
+	}
+
+	candidates, err := s.rankCandidates(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	resp.Total = int64(len(candidates))
+
+	offset := int((req.PageNum - 1) * req.PageSize)
+	if offset >= len(candidates) {
+		return resp, nil
+	}
+	end := offset + int(req.PageSize)
+	if end > len(candidates) {
+		end = len(candidates)
+	}
+
+	names := make(map[int64]string)
+	for _, c := range candidates[offset:end] {
+		userInfo, err := getUserInfo(ctx, c.userID)
+		if err != nil {
+			continue // 跳过失败的用户
+		}
+		resp.Items = append(resp.Items, &relations.FollowSuggestion{
+			User: &base.UserLite{
+				Uid:       userInfo.UserId,
+				UserName:  userInfo.UserName,
+				AvatarUrl: userInfo.AvatarUrl,
+			},
+			Reason:             s.buildReason(ctx, c, names),
+			MutualCount:        c.mutualCount,
+			CoInteractionCount: c.coInteraction,
+		})
+	}
+	return resp, nil
+}
+
+// rankCandidates 收集候选人、排除已关注和拉黑/静音的用户后按得分排序
+func (s *SuggestionService) rankCandidates(ctx context.Context, userID int64) ([]*suggestionCandidate, error) {
+	// 已关注（包括待通过的关注请求）的用户不再推荐
+	followingIDs, err := s.shardeDB.GetFollowingUserIDs(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get following: %w", errno.ServiceErr)
+	}
+	excluded := make(map[int64]struct{}, len(followingIDs)+1)
+	excluded[userID] = struct{}{}
+	for _, id := range followingIDs {
+		excluded[id] = struct{}{}
+	}
+
+	candidates := make(map[int64]*suggestionCandidate)
+	get := func(id int64) *suggestionCandidate {
+		c, ok := candidates[id]
+		if !ok {
+			c = &suggestionCandidate{userID: id}
+			candidates[id] = c
+		}
+		return c
+	}
+
+	// 1. 二度关系：我关注的人公开关注的人
+	secondDegree, err := s.getSecondDegreeCandidates(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, sd := range secondDegree {
+		if _, ok := excluded[sd.UserID]; ok {
+			continue
+		}
+		c := get(sd.UserID)
+		c.mutualCount = sd.MutualCount
+		c.viaUserIDs = sd.ViaUserIDs
+	}
+
+	// 2. 共同互动：点赞或评论过相同视频的用户，互动数据属于interaction服务
+	coCounts, err := getCoInteractionCounts(ctx, userID)
+	if err != nil {
+		hlog.CtxWarnf(ctx, "Failed to get co-interactions of %d: %v", userID, err)
+	}
+	for id, cnt := range coCounts {
+		if _, ok := excluded[id]; ok {
+			continue
+		}
+		get(id).coInteraction = cnt
+	}
+
+	// 3. 社交信号不足时用热门用户补齐
+	if len(candidates) < suggestionMinCandidates {
+		popular, err := cache.CacheGetPopularUsers()
+		if err != nil {
+			hlog.CtxWarnf(ctx, "Failed to get cached popular users: %v", err)
+		}
+		for _, id := range popular {
+			if _, ok := excluded[id]; ok {
+				continue
+			}
+			get(id)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int64, 0, len(candidates))
+	for id := range candidates {
+		ids = append(ids, id)
+	}
+
+	// 排除与我存在拉黑关系或被我静音的用户
+	blocked, err := db.GetBlockedUserIDs(ctx, userID, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check blocked users: %w", errno.ServiceErr)
+	}
+	muted, err := db.GetMutedUserIDs(ctx, userID, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check muted users: %w", errno.ServiceErr)
+	}
+	for _, id := range append(blocked, muted...) {
+		delete(candidates, id)
+	}
+
+	ids = ids[:0]
+	for id := range candidates {
+		ids = append(ids, id)
+	}
+	followerCounts, err := s.shardeDB.GetFollowerCounts(ctx, ids)
+	if err != nil {
+		hlog.CtxWarnf(ctx, "Failed to get follower counts: %v", err)
+	}
+
+	result := make([]*suggestionCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		c.followerCount = followerCounts[c.userID]
+		c.score = suggestionMutualWeight*float64(c.mutualCount) +
+			suggestionInteractionWeight*float64(c.coInteraction) +
+			suggestionPopularityWeight*math.Log1p(float64(c.followerCount))
+		result = append(result, c)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].score != result[j].score {
+			return result[i].score > result[j].score
+		}
+		return result[i].userID < result[j].userID
+	})
+	return result, nil
+}
+
+// getSecondDegreeCandidates 返回userID的二度关系候选人，优先读缓存，未命中时计算后缓存
+// 只统计userID能看到其关注列表的关注对象，隐藏关注列表或私密账号的关注不会出现在推荐和推荐理由中
+func (s *SuggestionService) getSecondDegreeCandidates(ctx context.Context, userID int64) ([]cache.SecondDegreeCandidate, error) {
+	if cached, err := cache.CacheGetSecondDegree(userID); err == nil {
+		return cached, nil
+	} else if err != cache.ErrMissCache {
+		hlog.CtxWarnf(ctx, "Failed to get cached second-degree candidates of %d: %v", userID, err)
+	}
+
+	followees, err := s.shardeDB.GetFollowingList(ctx, userID, nil, 0, suggestionMaxFollowees)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get following list: %w", errno.ServiceErr)
+	}
+
+	index := make(map[int64]int)
+	result := make([]cache.SecondDegreeCandidate, 0)
+	for _, followee := range followees {
+		if err := checkListVisibility(ctx, s.shardeDB, followee.UserID, userID, true); err != nil {
+			continue
+		}
+		following, err := s.shardeDB.GetFollowingList(ctx, followee.UserID, model.PublicFollowStatuses(), 0, suggestionMaxPerFollowee)
+		if err != nil {
+			hlog.CtxWarnf(ctx, "Failed to get following list of %d: %v", followee.UserID, err)
+			continue
+		}
+		for _, r := range following {
+			if r.UserID == userID {
+				continue
+			}
+			i, ok := index[r.UserID]
+			if !ok {
+				i = len(result)
+				index[r.UserID] = i
+				result = append(result, cache.SecondDegreeCandidate{UserID: r.UserID})
+			}
+			c := &result[i]
+			c.MutualCount++
+			if len(c.ViaUserIDs) < 2 {
+				c.ViaUserIDs = append(c.ViaUserIDs, followee.UserID)
+			}
+		}
+	}
+
+	if err := cache.CacheSetSecondDegree(userID, result, suggestionSecondDegreeTTL); err != nil {
+		hlog.CtxWarnf(ctx, "Failed to cache second-degree candidates of %d: %v", userID, err)
+	}
+	return result, nil
+}
+
+// RefreshPopularUsers 按粉丝数重算热门用户并写入缓存，由后台任务定期调用
+func RefreshPopularUsers(ctx context.Context, shardeDB *db.ShardedFollowDB) error {
+	popular, err := shardeDB.GetPopularUserIDs(ctx, suggestionPopularLimit)
+	if err != nil {
+		return err
+	}
+	return cache.CacheSetPopularUsers(popular, suggestionPopularTTL)
+}
+
+// getCoInteractionCounts 通过interaction服务查询与userID点赞或评论过相同视频的用户及共同互动的视频数
+func getCoInteractionCounts(ctx context.Context, userID int64) (map[int64]int64, error) {
+	if infras.InteractionClient == nil {
+		return nil, nil
+	}
+	resp, err := infras.InteractionClient.GetCoInteractions(ctx, &interactions.GetCoInteractionsRequest{
+		UserId:     userID,
+		VideoLimit: suggestionMaxVideos,
+		Limit:      suggestionMaxCoInteractions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[int64]int64, len(resp.Items))
+	for _, item := range resp.Items {
+		counts[item.UserId] = item.Count
+	}
+	return counts, nil
+}
+
+// buildReason 生成推荐理由，names缓存同一页内已查询过的用户名
+func (s *SuggestionService) buildReason(ctx context.Context, c *suggestionCandidate, names map[int64]string) string {
+	if c.mutualCount > 0 && len(c.viaUserIDs) > 0 {
+		via := make([]string, 0, len(c.viaUserIDs))
+		for _, id := range c.viaUserIDs {
+			name, ok := names[id]
+			if !ok {
+				name = fmt.Sprint(id)
+				if userInfo, err := getUserInfo(ctx, id); err == nil {
+					name = userInfo.UserName
+				}
+				names[id] = name
+			}
+			via = append(via, name)
+		}
+		switch {
+		case c.mutualCount == 1:
+			return fmt.Sprintf("Followed by %s", via[0])
+		case c.mutualCount == 2 && len(via) == 2:
+			return fmt.Sprintf("Followed by %s and %s", via[0], via[1])
+		default:
+			return fmt.Sprintf("Followed by %s and %d others", via[0], c.mutualCount-1)
+		}
+	}
+	if c.coInteraction > 0 {
+		return fmt.Sprintf("Interacted with %d of the same videos as you", c.coInteraction)
+	}
+	return "Popular creator"
+}
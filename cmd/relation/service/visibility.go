@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"HuaTug.com/cmd/relation/dal/db"
+	"HuaTug.com/pkg/errno"
+)
+
+// checkListVisibility 检查viewerID能否查看ownerID的关注/粉丝列表
+// 私密账号的列表只对已通过的粉丝可见；isFollowingList为true时还需遵守"隐藏关注列表"设置
+func checkListVisibility(ctx context.Context, shardeDB *db.ShardedFollowDB, ownerID, viewerID int64, isFollowingList bool) error {
+	if viewerID == 0 || viewerID == ownerID {
+		return nil
+	}
+
+	owner, err := getUserInfo(ctx, ownerID)
+	if err != nil {
+		return fmt.Errorf("failed to get user info: %w", errno.ServiceErr)
+	}
+	if isFollowingList && owner.HideFollowing {
+		return errno.AuthorizationFailedErr
+	}
+	if !owner.IsPrivate {
+		return nil
+	}
+
+	// 待通过的关注请求不能查看私密账号的列表
+	following, err := shardeDB.IsFollowing(ctx, ownerID, viewerID)
+	if err != nil {
+		return fmt.Errorf("failed to check following status: %w", errno.ServiceErr)
+	}
+	if !following {
+		return errno.AuthorizationFailedErr
+	}
+	return nil
+}
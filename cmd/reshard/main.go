@@ -0,0 +1,393 @@
+// reshard 关注和评论分库分表的在线重分片工具
+//
+// 典型流程：
+//
+//	reshard plan -target follows -databases 4 -tables 8   创建目标拓扑和任务
+//	reshard copy -target follows                          开启双写，复制并校验存量数据
+//	reshard verify -target follows                        重新校验，不一致行数为0时任务进入ready
+//	reshard cutover -target follows                       切换到目标拓扑
+//	reshard cleanup -target follows                       删除原位置中已迁走的行
+//
+// 任意时刻可以用status查看进度，切换前可以用abort放弃任务。
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"HuaTug.com/config"
+	"HuaTug.com/pkg/sharding"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// tableSpecs 各逻辑库中参与重分片的表，follow_outbox等不按分片键分布的表不迁移；
+// 未指定Strategy即取模分片，与relation和interaction服务中的分片规则一致。
+// 评论点赞和编辑历史与评论同分库同下标存放，排在comments之后随评论一起迁移
+var tableSpecs = map[string][]sharding.TableSpec{
+	"follows": {
+		{Prefix: "follows", ShardKey: "follower_id", CursorKey: "id", UniqueKeys: []string{"user_id", "follower_id"}, Surrogate: true},
+		{Prefix: "fans", ShardKey: "user_id", CursorKey: "id", UniqueKeys: []string{"user_id", "follower_id"}, Surrogate: true},
+	},
+	"comments": {
+		{Prefix: "comments", ShardKey: "video_id", CursorKey: "comment_id", UniqueKeys: []string{"comment_id"}},
+		{Prefix: "comment_likes", ShardKey: "video_id", CursorKey: "comment_likes_id", UniqueKeys: []string{"user_id", "comment_id"},
+			Parent: &sharding.ParentSpec{Prefix: "comments", Column: "comment_id", ParentKey: "comment_id"}},
+		{Prefix: "comment_edits", ShardKey: "video_id", CursorKey: "edit_id", UniqueKeys: []string{"comment_id", "edited_at"}, Surrogate: true},
+	},
+}
+
+// propagationDelay 修改任务状态后等待所有服务刷新路由的时间
+const propagationDelay = 2*sharding.ShardMapRefreshInterval + time.Second
+
+func usage() {
+	fmt.Fprintf(os.Stderr, `Usage: reshard <command> -target follows|comments [options]
+
+Commands:
+  plan     create the target shard map (-databases, -tables, optional -dsns)
+  copy     enable dual write, copy rows to their new location and verify them
+  verify   re-run checksum verification, marks the task ready when nothing differs
+  cutover  activate the target shard map once the task is ready
+  cleanup  remove rows left in locations they no longer belong to (after cutover or abort)
+  abort    give up a task before cutover
+  status   show the active shard map and task progress
+`)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	command := os.Args[1]
+
+	fs := flag.NewFlagSet(command, flag.ExitOnError)
+	target := fs.String("target", "", "logical database: follows or comments")
+	databases := fs.Int("databases", 0, "plan: number of databases in the target shard map")
+	tables := fs.Int("tables", 0, "plan: number of tables per database in the target shard map")
+	dsns := fs.String("dsns", "", "plan: comma separated master dsns of the target databases, defaults to the current ones")
+	batch := fs.Int("batch", 500, "rows per batch when copying and verifying")
+	fs.Parse(os.Args[2:])
+
+	specs, ok := tableSpecs[*target]
+	if !ok {
+		usage()
+		os.Exit(2)
+	}
+
+	config.Init()
+	metaDB, err := openMetaDB()
+	if err != nil {
+		fail(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	switch command {
+	case "plan":
+		err = plan(ctx, metaDB, *target, *databases, *tables, *dsns)
+	case "copy":
+		err = copyAndVerify(ctx, metaDB, *target, specs, *batch)
+	case "verify":
+		err = verify(ctx, metaDB, *target, specs, *batch)
+	case "cutover":
+		err = cutover(ctx, metaDB, *target)
+	case "cleanup":
+		err = cleanup(ctx, metaDB, *target, specs, *batch)
+	case "abort":
+		err = abort(ctx, metaDB, *target)
+	case "status":
+		err = status(ctx, metaDB, *target)
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fail(err)
+	}
+}
+
+func openMetaDB() (*gorm.DB, error) {
+	dsn := config.ConfigInfo.Mysql.Username + ":" + config.ConfigInfo.Mysql.Password + "@tcp(" + config.ConfigInfo.Mysql.Addr + ")/" + config.ConfigInfo.Mysql.Database + "?charset=utf8mb4&parseTime=True&loc=Local"
+	return gorm.Open(mysql.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Warn)})
+}
+
+func plan(ctx context.Context, metaDB *gorm.DB, name string, databases, tables int, dsnList string) error {
+	current, err := sharding.GetActiveShardMap(ctx, metaDB, name)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return fmt.Errorf("no active shard map for %s, start the service once to bootstrap it", name)
+	}
+
+	var dsns []string
+	if dsnList != "" {
+		dsns = strings.Split(dsnList, ",")
+	} else if databases == current.DatabaseCount {
+		dsns = current.MasterDSNs()
+	} else {
+		return fmt.Errorf("-dsns is required when changing the number of databases")
+	}
+	if databases == current.DatabaseCount && tables == current.TableCount && dsnList == "" {
+		return fmt.Errorf("target shard map is identical to v%d", current.Version)
+	}
+
+	task, err := sharding.PlanReshard(ctx, metaDB, name, databases, tables, dsns)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("planned task %d: %s v%d (%d dbs x %d tables) -> v%d (%d dbs x %d tables)\n",
+		task.ID, name, task.FromVersion, current.DatabaseCount, current.TableCount, task.ToVersion, databases, tables)
+	return nil
+}
+
+func copyAndVerify(ctx context.Context, metaDB *gorm.DB, name string, specs []sharding.TableSpec, batch int) error {
+	task, err := requireTask(ctx, metaDB, name, sharding.ReshardStatePlanned, sharding.ReshardStateCopying)
+	if err != nil {
+		return err
+	}
+	if task.State == sharding.ReshardStatePlanned {
+		if err := sharding.UpdateReshardTask(ctx, metaDB, task.ID, []string{sharding.ReshardStatePlanned},
+			map[string]interface{}{"state": sharding.ReshardStateCopying}); err != nil {
+			return err
+		}
+		fmt.Printf("dual write enabled, waiting %v for services to pick it up\n", propagationDelay)
+		if err := sleep(ctx, propagationDelay); err != nil {
+			return err
+		}
+	} else {
+		fmt.Printf("resuming copy of task %d, %d rows copied so far\n", task.ID, task.CopiedRows)
+	}
+
+	migrator, err := newMigrator(ctx, metaDB, task, specs, batch)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	if err := migrator.EnsureTables(ctx); err != nil {
+		return recordError(ctx, metaDB, task, err)
+	}
+	copied, err := migrator.Copy(ctx)
+	if err != nil {
+		return recordError(ctx, metaDB, task, err)
+	}
+	fmt.Printf("copy finished, %d rows copied in this run\n", copied)
+
+	if err := sharding.UpdateReshardTask(ctx, metaDB, task.ID, []string{sharding.ReshardStateCopying},
+		map[string]interface{}{"state": sharding.ReshardStateVerifying}); err != nil {
+		return err
+	}
+	return runVerify(ctx, metaDB, task, migrator)
+}
+
+func verify(ctx context.Context, metaDB *gorm.DB, name string, specs []sharding.TableSpec, batch int) error {
+	task, err := requireTask(ctx, metaDB, name, sharding.ReshardStateVerifying, sharding.ReshardStateReady)
+	if err != nil {
+		return err
+	}
+	migrator, err := newMigrator(ctx, metaDB, task, specs, batch)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+	return runVerify(ctx, metaDB, task, migrator)
+}
+
+// runVerify 校验一遍，没有发现不一致时任务进入ready，否则留在verifying等待再次校验
+func runVerify(ctx context.Context, metaDB *gorm.DB, task *sharding.ReshardTask, migrator *sharding.Migrator) error {
+	mismatched, err := migrator.Verify(ctx)
+	if err != nil {
+		return recordError(ctx, metaDB, task, err)
+	}
+
+	state := sharding.ReshardStateVerifying
+	if mismatched == 0 {
+		state = sharding.ReshardStateReady
+	}
+	if err := sharding.UpdateReshardTask(ctx, metaDB, task.ID,
+		[]string{sharding.ReshardStateVerifying, sharding.ReshardStateReady},
+		map[string]interface{}{"state": state, "mismatched_rows": mismatched, "last_error": ""}); err != nil {
+		return err
+	}
+
+	if mismatched > 0 {
+		fmt.Printf("verification repaired %d mismatched rows, run verify again until it reports none\n", mismatched)
+	} else {
+		fmt.Println("verification passed, task is ready for cutover")
+	}
+	return nil
+}
+
+func cutover(ctx context.Context, metaDB *gorm.DB, name string) error {
+	task, err := requireTask(ctx, metaDB, name, sharding.ReshardStateReady)
+	if err != nil {
+		return err
+	}
+	if err := sharding.CutoverReshard(ctx, metaDB, task); err != nil {
+		return err
+	}
+	fmt.Printf("v%d is active, writes are mirrored to v%d; waiting %v for services to switch\n",
+		task.ToVersion, task.FromVersion, propagationDelay)
+	if err := sleep(ctx, propagationDelay); err != nil {
+		return err
+	}
+	if err := sharding.UpdateReshardTask(ctx, metaDB, task.ID, []string{sharding.ReshardStateCutover},
+		map[string]interface{}{"state": sharding.ReshardStateDone}); err != nil {
+		return err
+	}
+	fmt.Printf("cutover of task %d done, run cleanup to remove rows left in v%d locations\n", task.ID, task.FromVersion)
+	return nil
+}
+
+// cleanup 清理最近一个已结束任务的残留数据：切换完成时清理原拓扑，放弃时清理目标拓扑
+func cleanup(ctx context.Context, metaDB *gorm.DB, name string, specs []sharding.TableSpec, batch int) error {
+	var task sharding.ReshardTask
+	if err := metaDB.WithContext(ctx).Table("reshard_tasks").Where("name = ?", name).
+		Order("id DESC").Take(&task).Error; err != nil {
+		return fmt.Errorf("no reshard task for %s: %w", name, err)
+	}
+
+	var activeVersion, staleVersion int
+	switch task.State {
+	case sharding.ReshardStateDone:
+		activeVersion, staleVersion = task.ToVersion, task.FromVersion
+	case sharding.ReshardStateAborted:
+		activeVersion, staleVersion = task.FromVersion, task.ToVersion
+	default:
+		return fmt.Errorf("task %d is %s, cleanup is only possible after cutover or abort", task.ID, task.State)
+	}
+
+	migrator, err := newMigrator(ctx, metaDB, &task, specs, batch)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	active, err := sharding.GetShardMap(ctx, metaDB, name, activeVersion)
+	if err != nil {
+		return err
+	}
+	stale, err := sharding.GetShardMap(ctx, metaDB, name, staleVersion)
+	if err != nil {
+		return err
+	}
+	removed, err := migrator.Cleanup(ctx, active, stale)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("cleanup finished, %d rows removed from v%d locations\n", removed, staleVersion)
+	return nil
+}
+
+func abort(ctx context.Context, metaDB *gorm.DB, name string) error {
+	task, err := requireTask(ctx, metaDB, name,
+		sharding.ReshardStatePlanned, sharding.ReshardStateCopying, sharding.ReshardStateVerifying, sharding.ReshardStateReady)
+	if err != nil {
+		return err
+	}
+	if err := sharding.AbortReshard(ctx, metaDB, task); err != nil {
+		return err
+	}
+	fmt.Printf("task %d aborted, run cleanup to remove rows copied into v%d locations\n", task.ID, task.ToVersion)
+	return nil
+}
+
+func status(ctx context.Context, metaDB *gorm.DB, name string) error {
+	current, err := sharding.GetActiveShardMap(ctx, metaDB, name)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		fmt.Printf("%s: no shard map yet\n", name)
+		return nil
+	}
+	fmt.Printf("%s: v%d active, %d databases x %d tables\n", name, current.Version, current.DatabaseCount, current.TableCount)
+
+	task, err := sharding.GetRunningReshardTask(ctx, metaDB, name)
+	if err != nil {
+		return err
+	}
+	if task == nil {
+		fmt.Println("no running reshard task")
+		return nil
+	}
+	fmt.Printf("task %d: v%d -> v%d, state %s, %d rows copied, %d mismatched in last verification, updated %s\n",
+		task.ID, task.FromVersion, task.ToVersion, task.State, task.CopiedRows, task.MismatchedRows,
+		task.UpdatedAt.Format(time.DateTime))
+	if task.Progress != "" && task.Progress != "{}" {
+		fmt.Printf("copy cursors: %s\n", task.Progress)
+	}
+	if task.LastError != "" {
+		fmt.Printf("last error: %s\n", task.LastError)
+	}
+	return nil
+}
+
+func requireTask(ctx context.Context, metaDB *gorm.DB, name string, states ...string) (*sharding.ReshardTask, error) {
+	task, err := sharding.GetRunningReshardTask(ctx, metaDB, name)
+	if err != nil {
+		return nil, err
+	}
+	if task == nil {
+		return nil, fmt.Errorf("no running reshard task for %s", name)
+	}
+	for _, state := range states {
+		if task.State == state {
+			return task, nil
+		}
+	}
+	return nil, fmt.Errorf("task %d is %s, expected one of %v", task.ID, task.State, states)
+}
+
+func newMigrator(ctx context.Context, metaDB *gorm.DB, task *sharding.ReshardTask, specs []sharding.TableSpec, batch int) (*sharding.Migrator, error) {
+	from, err := sharding.GetShardMap(ctx, metaDB, task.Name, task.FromVersion)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sharding.GetShardMap(ctx, metaDB, task.Name, task.ToVersion)
+	if err != nil {
+		return nil, err
+	}
+	migrator, err := sharding.NewMigrator(metaDB, task, from, to, specs, batch)
+	if err != nil {
+		return nil, err
+	}
+	migrator.Logf = func(format string, args ...interface{}) {
+		fmt.Printf(format+"\n", args...)
+	}
+	return migrator, nil
+}
+
+func recordError(ctx context.Context, metaDB *gorm.DB, task *sharding.ReshardTask, err error) error {
+	msg := err.Error()
+	if len(msg) > 255 {
+		msg = msg[:255]
+	}
+	// 使用新的context，中断信号导致的失败也能记录下来
+	_ = sharding.UpdateReshardTask(context.Background(), metaDB, task.ID, nil, map[string]interface{}{"last_error": msg})
+	return err
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "reshard: %v\n", err)
+	os.Exit(1)
+}
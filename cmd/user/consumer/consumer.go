@@ -0,0 +1,31 @@
+package consumer
+
+import (
+	"HuaTug.com/cmd/user/service"
+	"HuaTug.com/pkg/mq"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+var bus *mq.Bus
+
+// Init 启动用户计数事件消费者，依赖DB与Redis已经初始化
+func Init() {
+	// 创建事件总线，连接地址来自配置文件或环境变量RABBITMQ_URL
+	var err error
+	bus, err = mq.NewBusFromConfig()
+	if err != nil {
+		hlog.Fatalf("Failed to create event bus: %v", err)
+	}
+
+	if err := mq.Subscribe(bus, nil, service.NewUserStatsEventHandler().HandleUserStatsEvent); err != nil {
+		hlog.Fatalf("Failed to start user stats event consumer: %v", err)
+	}
+	hlog.Info("User stats event consumer started")
+}
+
+// Close 停止消费并等待正在处理的事件完成
+func Close() {
+	if bus != nil {
+		bus.Close()
+	}
+}
@@ -23,6 +23,15 @@ type UserWithPassword struct {
 	CreatedAt string `gorm:"column:created_at" json:"created_at"`
 	UpdatedAt string `gorm:"column:updated_at" json:"updated_at"`
 	DeletedAt string `gorm:"column:deleted_at" json:"deleted_at"`
+
+	// 个人资料与隐私设置
+	Bio           string `gorm:"column:bio" json:"bio"`
+	Website       string `gorm:"column:website" json:"website"`
+	Birthday      string `gorm:"column:birthday" json:"birthday"`
+	BackgroundUrl string `gorm:"column:background_url" json:"background_url"`
+	IsPrivate     bool   `gorm:"column:is_private" json:"is_private"`
+	HideLikes     bool   `gorm:"column:hide_likes" json:"hide_likes"`
+	HideFollowing bool   `gorm:"column:hide_following" json:"hide_following"`
 }
 
 func (u *UserWithPassword) TableName() string {
@@ -50,12 +59,21 @@ func (u *UserWithPassword) convertToBaseUser() *base.User {
 		CreatedAt: u.CreatedAt,
 		UpdatedAt: u.UpdatedAt,
 		DeletedAt: u.DeletedAt,
+
+		Bio:           u.Bio,
+		Website:       u.Website,
+		Birthday:      u.Birthday,
+		BackgroundUrl: u.BackgroundUrl,
+		IsPrivate:     u.IsPrivate,
+		HideLikes:     u.HideLikes,
+		HideFollowing: u.HideFollowing,
 	}
 }
 
 
 func CreateUser(ctx context.Context, user *base.User) error {
 	userWithPassword := &UserWithPassword{
+		UserId:    user.UserId,
 		UserName:  user.UserName,
 		Password:  user.Password,
 		Email:     user.Email,
@@ -194,12 +212,51 @@ func UploadAvatarUrl(ctx context.Context, uid, avatarUrl string) error {
 	return nil
 }
 
-func GetMaxId(ctx context.Context) (int64, error) {
-	var maxId int64
-	if err := DB.WithContext(ctx).Model(&UserWithPassword{}).Select("max(user_id)").Scan(&maxId).Error; err != nil {
-		return 0, err
+// UpdateUserProfile 更新个人简介、网站与生日，为nil的字段保持不变
+func UpdateUserProfile(ctx context.Context, userId int64, bio, website, birthday *string, updatedAt string) error {
+	updates := map[string]interface{}{}
+	setIfPresent(updates, "bio", bio)
+	setIfPresent(updates, "website", website)
+	setIfPresent(updates, "birthday", birthday)
+	if len(updates) == 0 {
+		return nil
+	}
+	updates["updated_at"] = updatedAt
+	if err := DB.WithContext(ctx).Model(&UserWithPassword{}).Where("user_id=?", userId).Updates(updates).Error; err != nil {
+		return errors.Wrapf(err, "UpdateUserProfile failed,err: %v", err)
+	}
+	return nil
+}
+
+// UpdatePrivacySettings 更新隐私设置，为nil的设置保持不变
+func UpdatePrivacySettings(ctx context.Context, userId int64, isPrivate, hideLikes, hideFollowing *bool, updatedAt string) error {
+	updates := map[string]interface{}{}
+	setIfPresent(updates, "is_private", isPrivate)
+	setIfPresent(updates, "hide_likes", hideLikes)
+	setIfPresent(updates, "hide_following", hideFollowing)
+	if len(updates) == 0 {
+		return nil
+	}
+	updates["updated_at"] = updatedAt
+	if err := DB.WithContext(ctx).Model(&UserWithPassword{}).Where("user_id=?", userId).Updates(updates).Error; err != nil {
+		return errors.Wrapf(err, "UpdatePrivacySettings failed,err: %v", err)
+	}
+	return nil
+}
+
+// setIfPresent 请求中设置了的字段才写入updates，未设置的列保持原值
+func setIfPresent[T any](updates map[string]interface{}, column string, value *T) {
+	if value != nil {
+		updates[column] = *value
 	}
-	return maxId, nil
+}
+
+// UpdateBackgroundUrl 更新主页背景图
+func UpdateBackgroundUrl(ctx context.Context, userId int64, backgroundUrl string) error {
+	if err := DB.WithContext(ctx).Model(&UserWithPassword{}).Where("user_id=?", userId).Update("background_url", backgroundUrl).Error; err != nil {
+		return errors.Wrapf(err, "UpdateBackgroundUrl failed,err: %v", err)
+	}
+	return nil
 }
 
 // CheckEmailExists 检查邮箱是否存在
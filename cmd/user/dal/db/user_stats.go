@@ -0,0 +1,87 @@
+package db
+
+import (
+	"context"
+
+	"HuaTug.com/kitex_gen/base"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 冗余计数字段，与user_stats表的列名一一对应
+const (
+	StatFollowerCount  = "follower_count"
+	StatFollowingCount = "following_count"
+	StatTotalLikes     = "total_likes"
+	StatVideoCount     = "video_count"
+)
+
+// UserStats 用户维度的冗余计数，由其他服务的事件异步维护
+type UserStats struct {
+	UserId         int64 `gorm:"column:user_id;primaryKey" json:"user_id"`
+	FollowerCount  int64 `gorm:"column:follower_count" json:"follower_count"`
+	FollowingCount int64 `gorm:"column:following_count" json:"following_count"`
+	TotalLikes     int64 `gorm:"column:total_likes" json:"total_likes"`
+	VideoCount     int64 `gorm:"column:video_count" json:"video_count"`
+}
+
+func (s *UserStats) TableName() string {
+	return "user_stats"
+}
+
+// IsValidStatField 校验计数字段，防止事件中的字段名被拼接进SQL
+func IsValidStatField(field string) bool {
+	switch field {
+	case StatFollowerCount, StatFollowingCount, StatTotalLikes, StatVideoCount:
+		return true
+	}
+	return false
+}
+
+// GetUserStats 获取用户计数，没有记录时返回全0
+func GetUserStats(ctx context.Context, userId int64) (*UserStats, error) {
+	stats := &UserStats{UserId: userId}
+	if err := DB.WithContext(ctx).Where("user_id = ?", userId).Limit(1).Find(stats).Error; err != nil {
+		return nil, errors.Wrapf(err, "GetUserStats failed,err:%v", err)
+	}
+	return stats, nil
+}
+
+// IncrUserStat 原子地调整某个计数字段，计数不会被减到负数
+func IncrUserStat(ctx context.Context, userId int64, field string, delta int64) error {
+	if !IsValidStatField(field) {
+		return errors.Errorf("invalid user stat field: %s", field)
+	}
+
+	initial := delta
+	if initial < 0 {
+		initial = 0
+	}
+
+	if err := DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			field: gorm.Expr("GREATEST(? + ?, 0)", clause.Column{Name: field}, delta),
+		}),
+	}).Model(&UserStats{}).Create(map[string]interface{}{
+		"user_id": userId,
+		field:     initial,
+	}).Error; err != nil {
+		return errors.Wrapf(err, "IncrUserStat failed,err:%v", err)
+	}
+	return nil
+}
+
+// FillUserStats 将计数填充到base.User中
+func FillUserStats(ctx context.Context, user *base.User) error {
+	stats, err := GetUserStats(ctx, user.UserId)
+	if err != nil {
+		return err
+	}
+	user.FollowerCount = stats.FollowerCount
+	user.FollowingCount = stats.FollowingCount
+	user.TotalLikes = stats.TotalLikes
+	user.VideoCount = stats.VideoCount
+	return nil
+}
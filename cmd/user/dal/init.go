@@ -1,7 +1,13 @@
 package dal
 
-import "HuaTug.com/cmd/user/dal/db"
+import (
+	"HuaTug.com/cmd/user/dal/db"
+	"HuaTug.com/pkg/idgen"
+)
 
 func Init() {
 	db.Init() // mysql init
+	if err := idgen.InitFromConfig(db.DB); err != nil {
+		panic("ID generator initialization failed: " + err.Error())
+	}
 }
@@ -23,6 +23,7 @@ import (
 	"HuaTug.com/cmd/user/service"
 	"HuaTug.com/kitex_gen/base"
 	"HuaTug.com/kitex_gen/users"
+	"HuaTug.com/pkg/errno"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 	"github.com/pkg/errors"
@@ -172,7 +173,13 @@ func (s *UserServiceImpl) GetUserInfo(ctx context.Context, req *users.GetUserInf
 	resp = new(users.GetUserInfoResponse)
 	resp.Base = &base.Status{}
 	var user *base.User
-	if user, err = service.NewGetUserInfoService(ctx).GetUserInfo(req.UserId); err != nil {
+	user, err = service.NewGetUserInfoService(ctx).GetPublicUserInfo(req.UserId)
+	if errors.Is(err, errno.UserNotExistErr) {
+		resp.Base.Code = consts.StatusNotFound
+		resp.Base.Msg = "User Not Found!"
+		return resp, nil
+	}
+	if err != nil {
 		hlog.CtxErrorf(ctx, "service.GetUserInfo failed,original error:%v", errors.Cause(err))
 		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
 		resp.Base.Code = consts.StatusBadRequest
@@ -428,3 +435,78 @@ func (s *UserServiceImpl) ChangePassword(ctx context.Context, req *users.ChangeP
 	resp.Base.Msg = "密码修改成功"
 	return resp, nil
 }
+
+func (s *UserServiceImpl) UpdateProfile(ctx context.Context, req *users.UpdateProfileRequest) (resp *users.UpdateProfileResponse, err error) {
+	resp = new(users.UpdateProfileResponse)
+	resp.Base = &base.Status{}
+
+	if req.UserId <= 0 {
+		resp.Base.Code = consts.StatusUnauthorized
+		resp.Base.Msg = "用户未登录"
+		return resp, nil
+	}
+
+	user, err := service.NewUpdateProfileService(ctx).UpdateProfile(req)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.UpdateProfile failed,original error:%v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = err.Error()
+		return resp, nil
+	}
+
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "更新个人资料成功"
+	resp.User = user
+	return resp, nil
+}
+
+func (s *UserServiceImpl) UploadBackground(ctx context.Context, req *users.UploadBackgroundRequest) (resp *users.UploadBackgroundResponse, err error) {
+	resp = new(users.UploadBackgroundResponse)
+	resp.Base = &base.Status{}
+
+	if req.UserId <= 0 {
+		resp.Base.Code = consts.StatusUnauthorized
+		resp.Base.Msg = "用户未登录"
+		return resp, nil
+	}
+
+	backgroundUrl, err := service.NewUploadBackgroundService(ctx).UploadBackground(req)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.UploadBackground failed,original error:%v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusInternalServerError
+		resp.Base.Msg = "上传背景图失败"
+		return resp, err
+	}
+
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "上传背景图成功"
+	resp.BackgroundUrl = backgroundUrl
+	return resp, nil
+}
+
+func (s *UserServiceImpl) UpdatePrivacySettings(ctx context.Context, req *users.UpdatePrivacySettingsRequest) (resp *users.UpdatePrivacySettingsResponse, err error) {
+	resp = new(users.UpdatePrivacySettingsResponse)
+	resp.Base = &base.Status{}
+
+	if req.UserId <= 0 {
+		resp.Base.Code = consts.StatusUnauthorized
+		resp.Base.Msg = "用户未登录"
+		return resp, nil
+	}
+
+	user, err := service.NewUpdatePrivacySettingsService(ctx).UpdatePrivacySettings(req)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.UpdatePrivacySettings failed,original error:%v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusInternalServerError
+		resp.Base.Msg = "更新隐私设置失败"
+		return resp, err
+	}
+
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "更新隐私设置成功"
+	resp.User = user
+	return resp, nil
+}
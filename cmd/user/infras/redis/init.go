@@ -7,6 +7,11 @@ import (
 
 var redisDB *redis.Client
 
+// Client 用户服务的Redis，也保存计数事件的去重标记
+func Client() *redis.Client {
+	return redisDB
+}
+
 func Init() {
 	redisDB = redis.NewClient(&redis.Options{
 		Addr: "localhost:6379",
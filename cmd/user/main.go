@@ -18,13 +18,16 @@ package main
 import (
 	"net"
 
+	"HuaTug.com/cmd/user/consumer"
 	"HuaTug.com/cmd/user/dal"
+	"HuaTug.com/cmd/user/infras/client"
 	"HuaTug.com/cmd/user/infras/redis"
 	"HuaTug.com/config"
 	"HuaTug.com/config/jaeger"
 	user "HuaTug.com/kitex_gen/users/userservice"
 	"HuaTug.com/pkg/bound"
 	"HuaTug.com/pkg/constants"
+	"HuaTug.com/pkg/idgen"
 	"HuaTug.com/pkg/middleware"
 	"HuaTug.com/pkg/oss"
 
@@ -51,6 +54,7 @@ func main() {
 	}
 	suite, closer := jaeger.NewServerSuite().Init("User")
 	defer closer.Close()
+	defer idgen.Close()
 	r, err := etcd.NewEtcdRegistry([]string{config.ConfigInfo.Etcd.Addr})
 	//r, err := etcd.NewEtcdRegistry([]string{"localhost:2379"})
 	if err != nil {
@@ -66,6 +70,9 @@ func main() {
 	}
 	Init()
 	redis.Init()
+	client.InitInteractionRpc()
+	consumer.Init()
+	defer consumer.Close()
 	//cache.Init()
 	//当出现了UserServiceImpl报错时 说明当前该接口的方法没有被完全实现
 
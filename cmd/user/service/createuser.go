@@ -9,6 +9,7 @@ import (
 	"HuaTug.com/kitex_gen/base"
 	"HuaTug.com/kitex_gen/users"
 	"HuaTug.com/pkg/constants"
+	"HuaTug.com/pkg/idgen"
 	"HuaTug.com/pkg/utils"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/pkg/errors"
@@ -34,7 +35,12 @@ func (v *CreateUserService) CreateUser(req *users.CreateUserRequest) error {
 		return errors.WithMessage(err, "Password fail to crypt")
 	}
 	hlog.Info("username:", req.UserName, "password:", req.Password, "email:", req.Email)
+	userId, err := idgen.NextID(v.ctx, idgen.BizUser)
+	if err != nil {
+		return errors.WithMessage(err, "idgen.NextID failed")
+	}
 	err = db.CreateUser(v.ctx, &base.User{
+		UserId:    userId,
 		UserName:  req.UserName,
 		Email:     req.Email,
 		Sex:       req.Sex,
@@ -64,10 +70,6 @@ func (v *CreateUserService) CreateUser(req *users.CreateUserRequest) error {
 		}
 	}
 
-	userId, err := db.GetMaxId(v.ctx)
-	if err != nil {
-		return errors.WithMessage(err, "dao.GetMaxId failed")
-	}
 	if err = db.Permession_assignment(v.ctx, &model.User_Role{
 		Role_Id: role_id,
 		User_Id: userId,
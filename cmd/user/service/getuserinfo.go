@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"HuaTug.com/cmd/model"
 	"HuaTug.com/cmd/user/dal/db"
+	"HuaTug.com/cmd/user/infras/client"
 	"HuaTug.com/kitex_gen/base"
+	"HuaTug.com/pkg/errno"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/pkg/errors"
 )
@@ -23,8 +26,25 @@ func (v *GetUserInfoService) GetUserInfo(userId int64) (user *base.User, err err
 	if user, err = db.GetUser(v.ctx, fmt.Sprint(userId)); err != nil {
 		hlog.Info(err)
 		return user, errors.WithMessage(err, "dao.GetUserInfo failed")
-	} else {
-		//go cache.CacheSetUser(user)
-		return user, nil
 	}
+	// 计数只是展示数据，读取失败时不影响用户信息的返回
+	if err := db.FillUserStats(v.ctx, user); err != nil {
+		hlog.CtxWarnf(v.ctx, "FillUserStats failed, user_id: %d, err: %v", userId, err)
+	}
+	//go cache.CacheSetUser(user)
+	return user, nil
+}
+
+// GetPublicUserInfo 获取对其他用户展示的用户信息，因举报被隐藏的用户按不存在处理；
+// interaction服务不可用时降级为不检查
+func (v *GetUserInfoService) GetPublicUserInfo(userId int64) (*base.User, error) {
+	if client.InteractionClient != nil {
+		hidden, err := client.GetHiddenTargetIDs(v.ctx, model.ReportTargetUser, []int64{userId})
+		if err != nil {
+			hlog.CtxWarnf(v.ctx, "Failed to check hidden user %d: %v", userId, err)
+		} else if _, ok := hidden[userId]; ok {
+			return nil, errors.WithMessage(errno.UserNotExistErr, "user is hidden")
+		}
+	}
+	return v.GetUserInfo(userId)
 }
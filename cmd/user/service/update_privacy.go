@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"HuaTug.com/cmd/user/dal/db"
+	"HuaTug.com/kitex_gen/base"
+	"HuaTug.com/kitex_gen/users"
+	"HuaTug.com/pkg/constants"
+	"github.com/pkg/errors"
+)
+
+type UpdatePrivacySettingsService struct {
+	ctx context.Context
+}
+
+func NewUpdatePrivacySettingsService(ctx context.Context) *UpdatePrivacySettingsService {
+	return &UpdatePrivacySettingsService{ctx: ctx}
+}
+
+func (s *UpdatePrivacySettingsService) UpdatePrivacySettings(req *users.UpdatePrivacySettingsRequest) (*base.User, error) {
+	if err := db.UpdatePrivacySettings(s.ctx, req.UserId, req.IsPrivate, req.HideLikes, req.HideFollowing, time.Now().Format(constants.DataFormate)); err != nil {
+		return nil, errors.WithMessage(err, "dao.UpdatePrivacySettings failed")
+	}
+
+	return NewGetUserInfoService(s.ctx).GetUserInfo(req.UserId)
+}
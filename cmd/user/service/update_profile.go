@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"time"
+	"unicode/utf8"
+
+	"HuaTug.com/cmd/user/dal/db"
+	"HuaTug.com/kitex_gen/base"
+	"HuaTug.com/kitex_gen/users"
+	"HuaTug.com/pkg/constants"
+	"github.com/pkg/errors"
+)
+
+const (
+	maxBioLength     = 200
+	maxWebsiteLength = 255
+	birthdayLayout   = "2006-01-02"
+)
+
+type UpdateProfileService struct {
+	ctx context.Context
+}
+
+func NewUpdateProfileService(ctx context.Context) *UpdateProfileService {
+	return &UpdateProfileService{ctx: ctx}
+}
+
+func (s *UpdateProfileService) UpdateProfile(req *users.UpdateProfileRequest) (*base.User, error) {
+	if err := s.validateProfile(req); err != nil {
+		return nil, err
+	}
+
+	if err := db.UpdateUserProfile(s.ctx, req.UserId, req.Bio, req.Website, req.Birthday, time.Now().Format(constants.DataFormate)); err != nil {
+		return nil, errors.WithMessage(err, "dao.UpdateUserProfile failed")
+	}
+
+	return NewGetUserInfoService(s.ctx).GetUserInfo(req.UserId)
+}
+
+// validateProfile 只校验请求中设置了的字段，网站和生日设为空字符串表示清除
+func (s *UpdateProfileService) validateProfile(req *users.UpdateProfileRequest) error {
+	if utf8.RuneCountInString(req.GetBio()) > maxBioLength {
+		return errors.Errorf("个人简介不能超过%d个字符", maxBioLength)
+	}
+
+	if website := req.GetWebsite(); website != "" {
+		if len(website) > maxWebsiteLength {
+			return errors.New("个人网站地址过长")
+		}
+		u, err := url.Parse(website)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.New("个人网站地址格式不正确")
+		}
+	}
+
+	if req.GetBirthday() != "" {
+		birthday, err := time.Parse(birthdayLayout, req.GetBirthday())
+		if err != nil {
+			return errors.New("生日格式应为YYYY-MM-DD")
+		}
+		if birthday.After(time.Now()) {
+			return errors.New("生日不能晚于当前日期")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"HuaTug.com/cmd/user/dal/db"
+	"HuaTug.com/kitex_gen/users"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/oss"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/pkg/errors"
+)
+
+// 背景图大小上限 5MB
+const maxBackgroundSize = 5 << 20
+
+type UploadBackgroundService struct {
+	ctx context.Context
+}
+
+func NewUploadBackgroundService(ctx context.Context) *UploadBackgroundService {
+	return &UploadBackgroundService{ctx: ctx}
+}
+
+func (s *UploadBackgroundService) UploadBackground(req *users.UploadBackgroundRequest) (string, error) {
+	if len(req.Data) == 0 || len(req.Data) > maxBackgroundSize {
+		return "", errno.ParamErr
+	}
+
+	fileType := http.DetectContentType(req.Data)
+	switch fileType {
+	case "image/png", "image/jpg", "image/jpeg":
+	default:
+		return "", errno.DataProcessErr
+	}
+
+	backgroundUrl, err := oss.NewTikTokStorage().UploadUserBackground(s.ctx, req.UserId, req.Data, fileType)
+	if err != nil {
+		return "", errors.WithMessage(err, "上传背景图失败")
+	}
+
+	if err := db.UpdateBackgroundUrl(s.ctx, req.UserId, backgroundUrl); err != nil {
+		return "", errors.WithMessage(err, "dao.UpdateBackgroundUrl failed")
+	}
+
+	hlog.Infof("用户 %d 背景图更新成功: %s", req.UserId, backgroundUrl)
+	return backgroundUrl, nil
+}
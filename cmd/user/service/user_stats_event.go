@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+
+	"HuaTug.com/cmd/user/dal/db"
+	"HuaTug.com/cmd/user/infras/redis"
+	"HuaTug.com/pkg/mq"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// 用户计数在事件去重标记中的消费者名
+const userStatsConsumer = "user_stats"
+
+// UserStatsEventHandler 处理relation/interaction/video服务发布的计数变更事件
+type UserStatsEventHandler struct{}
+
+func NewUserStatsEventHandler() *UserStatsEventHandler {
+	return &UserStatsEventHandler{}
+}
+
+// HandleUserStatsEvent 处理用户计数变更事件，由消费者通过mq.Subscribe注册
+func (h *UserStatsEventHandler) HandleUserStatsEvent(ctx context.Context, event *mq.UserStatsEvent) error {
+	if event.UserID <= 0 || event.Delta == 0 || !db.IsValidStatField(event.StatType) {
+		// 非法事件重新入队也无法处理，直接丢弃
+		hlog.CtxWarnf(ctx, "Drop invalid user stats event: %+v", event)
+		return nil
+	}
+
+	return mq.Dedupe(ctx, redis.Client(), userStatsConsumer, event.EventID, func() error {
+		return db.IncrUserStat(ctx, event.UserID, event.StatType, event.Delta)
+	})
+}
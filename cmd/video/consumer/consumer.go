@@ -0,0 +1,45 @@
+package consumer
+
+import (
+	"HuaTug.com/cmd/video/infras/rabbitmq"
+	"HuaTug.com/cmd/video/service"
+	"HuaTug.com/pkg/mq"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// interestGroup 兴趣画像订阅点赞事件使用的消费组，与interaction服务的点赞消费者各自收到全部事件
+const interestGroup = "video_interest"
+
+// trendingGroup 热门榜单订阅点赞和互动事件使用的消费组
+const trendingGroup = "video_trending"
+
+// Init 启动兴趣画像、关注流和热门榜单的事件消费者，依赖DB、Redis、RPC客户端和事件总线已经初始化
+func Init() {
+	handler := service.NewInterestEventHandler()
+	if err := mq.Subscribe(rabbitmq.Bus, &mq.SubscribeOptions{Group: interestGroup}, handler.HandleLikeEvent); err != nil {
+		hlog.Errorf("Failed to start like event consumer for interest profiles: %v", err)
+	}
+	if err := mq.Subscribe(rabbitmq.Bus, nil, handler.HandleEngagementEvent); err != nil {
+		hlog.Errorf("Failed to start engagement event consumer: %v", err)
+	}
+	hlog.Info("Interest profile consumers started")
+
+	timeline := service.NewTimelineEventHandler()
+	if err := mq.Subscribe(rabbitmq.Bus, nil, timeline.HandleVideoPublished); err != nil {
+		hlog.Errorf("Failed to start video published event consumer: %v", err)
+	}
+	if err := mq.Subscribe(rabbitmq.Bus, nil, timeline.HandleFollowEvent); err != nil {
+		hlog.Errorf("Failed to start follow event consumer: %v", err)
+	}
+	hlog.Info("Timeline consumers started")
+
+	trending := service.NewTrendingEventHandler()
+	opts := &mq.SubscribeOptions{Group: trendingGroup}
+	if err := mq.Subscribe(rabbitmq.Bus, opts, trending.HandleLikeEvent); err != nil {
+		hlog.Errorf("Failed to start like event consumer for trending: %v", err)
+	}
+	if err := mq.Subscribe(rabbitmq.Bus, opts, trending.HandleEngagementEvent); err != nil {
+		hlog.Errorf("Failed to start engagement event consumer for trending: %v", err)
+	}
+	hlog.Info("Trending consumers started")
+}
@@ -0,0 +1,100 @@
+package db
+
+import (
+	"context"
+	"strings"
+
+	"HuaTug.com/kitex_gen/base"
+	"github.com/pkg/errors"
+)
+
+// 推荐把点赞作为用户兴趣的正反馈
+const likeBehavior = "like"
+
+// GetRecentLikedVideoIDs 获取用户最近点赞的视频ID
+func GetRecentLikedVideoIDs(ctx context.Context, userID int64, limit int) ([]int64, error) {
+	var videoIDs []int64
+	if err := DB.WithContext(ctx).Table("user_behaviors").
+		Where("user_id = ? AND behavior_type = ?", userID, likeBehavior).
+		Group("video_id").
+		Order("MAX(created_at) DESC").
+		Limit(limit).
+		Pluck("video_id", &videoIDs).Error; err != nil {
+		return nil, errors.Wrapf(err, "GetRecentLikedVideoIDs failed")
+	}
+	return videoIDs, nil
+}
+
+// GetRecentVideosByAuthors 获取authorIDs在since之后发布的视频，按发布时间倒序
+func GetRecentVideosByAuthors(ctx context.Context, authorIDs []int64, since string, limit int) ([]*base.Video, error) {
+	var video []*base.Video
+	if len(authorIDs) == 0 {
+		return video, nil
+	}
+	if err := DB.WithContext(ctx).Model(&base.Video{}).
+		Where("user_id IN ? AND created_at >= ?", authorIDs, since).
+		Order("created_at DESC, video_id DESC").
+		Limit(limit).
+		Find(&video).Error; err != nil {
+		return nil, errors.Wrapf(err, "GetRecentVideosByAuthors failed")
+	}
+	return video, nil
+}
+
+// GetRecentVideosByTags 获取since之后发布、分类属于categories或标签包含labels中任一个的视频，按点赞数倒序
+func GetRecentVideosByTags(ctx context.Context, categories, labels []string, since string, limit int) ([]*base.Video, error) {
+	var video []*base.Video
+	var conds []string
+	var args []interface{}
+	if len(categories) > 0 {
+		conds = append(conds, "category IN ?")
+		args = append(args, categories)
+	}
+	// label_names是逗号分隔的标签列表
+	for _, label := range labels {
+		conds = append(conds, "FIND_IN_SET(?, label_names) > 0")
+		args = append(args, label)
+	}
+	if len(conds) == 0 {
+		return video, nil
+	}
+	if err := DB.WithContext(ctx).Model(&base.Video{}).
+		Where("created_at >= ?", since).
+		Where("("+strings.Join(conds, " OR ")+")", args...).
+		Order("CAST(likes_count AS UNSIGNED) DESC, video_id DESC").
+		Limit(limit).
+		Find(&video).Error; err != nil {
+		return nil, errors.Wrapf(err, "GetRecentVideosByTags failed")
+	}
+	return video, nil
+}
+
+// GetTrendingVideos 获取since之后发布的视频中点赞最多的，categories不为空时只取这些分类
+func GetTrendingVideos(ctx context.Context, categories []string, since string, limit int) ([]*base.Video, error) {
+	var video []*base.Video
+	query := DB.WithContext(ctx).Model(&base.Video{}).Where("created_at >= ?", since)
+	if len(categories) > 0 {
+		query = query.Where("category IN ?", categories)
+	}
+	if err := query.Order("CAST(likes_count AS UNSIGNED) DESC, video_id DESC").
+		Limit(limit).
+		Find(&video).Error; err != nil {
+		return nil, errors.Wrapf(err, "GetTrendingVideos failed")
+	}
+	return video, nil
+}
+
+// GetWatchedVideoIDs 返回videoIDs中userID看过且未从观看历史中删除的视频
+func GetWatchedVideoIDs(ctx context.Context, userID int64, videoIDs []int64) ([]int64, error) {
+	var watched []int64
+	if len(videoIDs) == 0 {
+		return watched, nil
+	}
+	if err := DB.WithContext(ctx).Table("user_video_watch_histories").
+		Where("user_id = ? AND video_id IN ?", userID, videoIDs).
+		Where("deleted_at IS NULL OR deleted_at = ''").
+		Pluck("video_id", &watched).Error; err != nil {
+		return nil, errors.Wrapf(err, "GetWatchedVideoIDs failed")
+	}
+	return watched, nil
+}
@@ -0,0 +1,67 @@
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// InteractionSource 计算视频相似度使用的一张互动表
+type InteractionSource struct {
+	Name       string
+	Table      string
+	KeyColumn  string // 主键，按它分批扫描
+	TimeColumn string // 为空时不按时间过滤
+	SoftDelete bool   // deleted_at为NULL或空字符串的记录才有效
+}
+
+var (
+	LikeInteractions     = InteractionSource{Name: "like", Table: "video_likes", KeyColumn: "video_likes_id", TimeColumn: "created_at", SoftDelete: true}
+	FavoriteInteractions = InteractionSource{Name: "favorite", Table: "favorites_videos", KeyColumn: "favorite_video_id"}
+	ShareInteractions    = InteractionSource{Name: "share", Table: "video_shares", KeyColumn: "video_share_id", TimeColumn: "created_at", SoftDelete: true}
+	WatchInteractions    = InteractionSource{Name: "watch", Table: "user_video_watch_histories", KeyColumn: "user_video_watch_history_id", TimeColumn: "watch_time", SoftDelete: true}
+)
+
+// UserVideo 一条用户与视频的互动
+type UserVideo struct {
+	Key     int64
+	UserID  int64
+	VideoID int64
+}
+
+// ScanInteractions 按主键顺序每次读取batch条src中since之后的互动交给visit，since为空时读取全部
+func ScanInteractions(ctx context.Context, src InteractionSource, since string, batch int, visit func([]UserVideo) error) error {
+	var last int64
+	first := true
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		query := DB.WithContext(ctx).Table(src.Table).
+			Select(src.KeyColumn + " AS `key`, user_id, video_id")
+		if !first {
+			query = query.Where(src.KeyColumn+" > ?", last)
+		}
+		if src.TimeColumn != "" && since != "" {
+			query = query.Where(src.TimeColumn+" >= ?", since)
+		}
+		if src.SoftDelete {
+			query = query.Where("deleted_at IS NULL OR deleted_at = ''")
+		}
+		var rows []UserVideo
+		if err := query.Order(src.KeyColumn).Limit(batch).Scan(&rows).Error; err != nil {
+			return errors.Wrapf(err, "ScanInteractions %s failed", src.Table)
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		if err := visit(rows); err != nil {
+			return err
+		}
+		if len(rows) < batch {
+			return nil
+		}
+		last, first = rows[len(rows)-1].Key, false
+	}
+}
@@ -0,0 +1,25 @@
+package db
+
+import (
+	"context"
+
+	"HuaTug.com/kitex_gen/base"
+	"HuaTug.com/pkg/sharding"
+	"github.com/pkg/errors"
+)
+
+// GetVideosByAuthorsByCursor 按(created_at, video_id)倒序获取authorIDs发布的视频中排在after之后的至多limit条，after为nil时从最新开始
+func GetVideosByAuthorsByCursor(ctx context.Context, authorIDs []int64, after *sharding.KeyCursor, limit int) ([]*base.Video, error) {
+	var video []*base.Video
+	if len(authorIDs) == 0 {
+		return video, nil
+	}
+	query := DB.WithContext(ctx).Model(&base.Video{}).Where("user_id IN ?", authorIDs)
+	if after != nil {
+		query = query.Where("created_at < ? OR (created_at = ? AND video_id < ?)", after.Key, after.Key, after.ID)
+	}
+	if err := query.Order("created_at DESC, video_id DESC").Limit(limit).Find(&video).Error; err != nil {
+		return nil, errors.Wrapf(err, "GetVideosByAuthorsByCursor failed")
+	}
+	return video, nil
+}
@@ -2,16 +2,17 @@ package db
 
 import (
 	"context"
-	"fmt"
 
 	"sync"
 
 	"HuaTug.com/cmd/model"
 	"HuaTug.com/kitex_gen/base"
 	"HuaTug.com/kitex_gen/videos"
+	"HuaTug.com/pkg/sharding"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"gorm.io/gorm/clause"
 )
 
 func Feedlist(ctx context.Context, req *videos.VideoFeedListRequestV2) ([]*base.Video, error) {
@@ -35,27 +36,34 @@ func Feedlist(ctx context.Context, req *videos.VideoFeedListRequestV2) ([]*base.
 	return video, nil
 }
 
-func GetAllFeedList(ctx context.Context, req *videos.VideoFeedListRequestV2) ([]*base.Video, error) {
-	var video []*base.Video
-	if err := DB.WithContext(ctx).Model(&base.Video{}).Find(&video); err != nil {
-		return video, errors.Wrapf(err.Error, "GetAllFeedList failed,err:%v", err)
-	}
-	return video, nil
-}
-
 // 获取用户发布的视频
-func Videolist(ctx context.Context, req *videos.VideoFeedListRequestV2) ([]*base.Video, int64, error) {
+// Videolist 获取用户发布的视频中第offset条起的至多limit条，以及用户发布的视频总数
+func Videolist(ctx context.Context, userId int64, offset, limit int) ([]*base.Video, int64, error) {
 	var video []*base.Video
 	var count int64
-	if err := DB.WithContext(ctx).Model(&base.Video{}).Where("user_id=?", req.UserId).Count(&count).Limit(int(req.PageSize)).
-		Offset(int((req.PageNum - 1) * req.PageSize)).Find(&video); err != nil {
+	if err := DB.WithContext(ctx).Model(&base.Video{}).Where("user_id=?", userId).Count(&count).Limit(limit).
+		Offset(offset).Find(&video).Error; err != nil {
 		logrus.Info(err)
-		return video, count, errors.Wrapf(err.Error, "VideoList failed,err:%v", err)
+		return video, count, errors.Wrapf(err, "VideoList failed,err:%v", err)
 	}
 	return video, count, nil
 }
 
-func Videosearch(ctx context.Context, req *videos.VideoSearchRequestV2) ([]*base.Video, int64, error) {
+// VideolistByCursor 按(created_at, video_id)倒序获取用户发布的视频中排在after之后的至多limit条，after为nil时从最新开始
+func VideolistByCursor(ctx context.Context, userId int64, after *sharding.KeyCursor, limit int) ([]*base.Video, error) {
+	var video []*base.Video
+	query := DB.WithContext(ctx).Model(&base.Video{}).Where("user_id = ?", userId)
+	if after != nil {
+		query = query.Where("created_at < ? OR (created_at = ? AND video_id < ?)", after.Key, after.Key, after.ID)
+	}
+	if err := query.Order("created_at DESC, video_id DESC").Limit(limit).Find(&video).Error; err != nil {
+		return video, errors.Wrapf(err, "VideolistByCursor failed")
+	}
+	return video, nil
+}
+
+// Videosearch 按关键词、日期和分类搜索视频，返回第offset条起的至多limit条以及匹配总数
+func Videosearch(ctx context.Context, req *videos.VideoSearchRequestV2, offset, limit int) ([]*base.Video, int64, error) {
 	var wg sync.WaitGroup
 	var video2 []*base.Video
 	var count int64
@@ -81,7 +89,7 @@ func Videosearch(ctx context.Context, req *videos.VideoSearchRequestV2) ([]*base
 			}
 
 			err = query.Count(&count).
-				Limit(int(req.PageSize)).Offset(int((req.PageNum - 1) * req.PageSize)).
+				Limit(limit).Offset(offset).
 				Find(&video2).Error
 		}()
 		if err != nil {
@@ -105,17 +113,6 @@ func InsertVideo(ctx context.Context, video *base.Video) error {
 	}
 	return nil
 }
-func GetMaxVideoId(ctx context.Context) (string, error) {
-	var maxId *int64
-	if err := DB.WithContext(ctx).Model(&base.Video{}).Select("MAX(video_id)").Scan(&maxId).Error; err != nil {
-		return "", err
-	}
-	if maxId == nil {
-		return "1", nil
-	}
-
-	return fmt.Sprint(*maxId), nil
-}
 func GetVideo(ctx context.Context, vid int64) (*base.Video, error) {
 	var data base.Video
 	if err := DB.WithContext(ctx).Model(&base.Video{}).Where("video_id = ?", vid).Find(&data).Error; err != nil {
@@ -290,6 +287,49 @@ func GetFavoriteVideoList(ctx context.Context, req *videos.GetFavoriteVideoListR
 	return video, nil
 }
 
+// GetFavoriteVideoListByCursor 按加入收藏夹的先后倒序获取收藏夹中排在after之后的至多limit个视频，游标的ID为favorites_videos的主键
+// 还有下一页时next为本页最后一条收藏记录的ID，否则为0
+func GetFavoriteVideoListByCursor(ctx context.Context, userId, favoriteId int64, after *sharding.KeyCursor, limit int) (video []*base.Video, next int64, err error) {
+	var items []*model.FavoritesVideos
+	query := DB.WithContext(ctx).Model(&model.FavoritesVideos{}).Where("user_id = ? and favorite_id = ?", userId, favoriteId)
+	if after != nil {
+		query = query.Where("favorite_video_id < ?", after.ID)
+	}
+	// 多读一条用于判断是否还有下一页
+	if err := query.Order("favorite_video_id DESC").Limit(limit + 1).Find(&items).Error; err != nil {
+		return nil, 0, errors.WithMessage(err, "Failed to get FavoriteVideoListByCursor")
+	}
+	if len(items) > limit {
+		items = items[:limit]
+		next = items[limit-1].FavoriteVideoId
+	}
+	if len(items) == 0 {
+		return []*base.Video{}, 0, nil
+	}
+
+	videoIds := make([]int64, 0, len(items))
+	for _, item := range items {
+		videoIds = append(videoIds, item.VideoId)
+	}
+	var found []*base.Video
+	if err := DB.WithContext(ctx).Model(&base.Video{}).Where("video_id in ?", videoIds).Find(&found).Error; err != nil {
+		return nil, 0, errors.WithMessage(err, "Failed to get FavoriteVideoListByCursor")
+	}
+	videoMap := make(map[int64]*base.Video, len(found))
+	for _, v := range found {
+		videoMap[v.VideoId] = v
+	}
+
+	// 按收藏顺序返回，已删除的视频直接跳过
+	video = make([]*base.Video, 0, len(items))
+	for _, item := range items {
+		if v, ok := videoMap[item.VideoId]; ok {
+			video = append(video, v)
+		}
+	}
+	return video, next, nil
+}
+
 func GetVideoFromFavorite(ctx context.Context, userId, videoId int64) (*base.Video, error) {
 	var video *base.Video
 	if err := DB.WithContext(ctx).Model(&base.Video{}).Where("user_id = ? and video_id = ?", userId, videoId).Find(&video).Error; err != nil {
@@ -327,8 +367,14 @@ func SharedVideo(ctx context.Context, share *model.VideoShare) error {
 	return nil
 }
 
+// AddUserVideoWatchHistory 记录观看历史，重复观看时更新观看时间并恢复已删除的记录
 func AddUserVideoWatchHistory(ctx context.Context, watch *model.UserVideoWatchHistory) error {
-	if err := DB.WithContext(ctx).Model(&model.UserVideoWatchHistory{}).Create(watch).Error; err != nil {
+	if err := DB.WithContext(ctx).Model(&model.UserVideoWatchHistory{}).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "video_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"watch_time", "deleted_at"}),
+		}).
+		Create(watch).Error; err != nil {
 		return errors.WithMessage(err, "Failed to add UserVideoWatchHistory")
 	}
 	return nil
@@ -1,8 +1,12 @@
 package dal
 
 import ("HuaTug.com/cmd/video/dal/db"
+	"HuaTug.com/pkg/idgen"
 )
 
 func Init() {
 	db.Init() // mysql init
+	if err := idgen.InitFromConfig(db.DB); err != nil {
+		panic("ID generator initialization failed: " + err.Error())
+	}
 }
@@ -2,7 +2,6 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
 	"HuaTug.com/cmd/video/service"
@@ -20,8 +19,24 @@ func (s *VideoServiceImpl) VideoFeedListV2(ctx context.Context, req *videos.Vide
 	resp = new(videos.VideoFeedListResponseV2)
 	resp.Base = &base.Status{}
 	var video []*base.Video
-	var count int64
-	if video, count, err = service.NewVideoListService(ctx).VideoList(req); err != nil {
+	// 带游标或显式要求时按游标翻页，否则保持原有的页码分页，未指定页码的旧客户端从第一页开始
+	if req.Cursor != "" || req.UseCursor {
+		video, resp.NextCursor, resp.HasMore, err = service.NewVideoListService(ctx).VideoListByCursor(req)
+		if errors.Is(err, errno.ParamErr) {
+			resp.Base.Code = consts.StatusBadRequest
+			resp.Base.Msg = "Invalid cursor"
+			return resp, nil
+		}
+	} else {
+		if req.PageNum <= 0 {
+			req.PageNum = 1
+		}
+		var count int64
+		video, count, err = service.NewVideoListService(ctx).VideoList(req)
+		resp.Total = count
+		resp.HasMore = req.PageNum*req.PageSize < count
+	}
+	if err != nil {
 		hlog.CtxErrorf(ctx, "service.VideoFeedList failed,original error:%v", errors.Cause(err))
 		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
 		resp.Base.Code = consts.StatusBadRequest
@@ -29,8 +44,6 @@ func (s *VideoServiceImpl) VideoFeedListV2(ctx context.Context, req *videos.Vide
 		resp.VideoList = video
 		return resp, err
 	}
-	//todo
-	fmt.Print(count)
 
 	resp.Base.Code = consts.StatusOK
 	resp.Base.Msg = "Get VideoList Success"
@@ -64,20 +77,25 @@ func (s *VideoServiceImpl) VideoSearchV2(ctx context.Context, req *videos.VideoS
 }
 
 func (s *VideoServiceImpl) VideoPopularV2(ctx context.Context, req *videos.VideoPopularRequestV2) (resp *videos.VideoPopularResponseV2, err error) {
-	resp = new(videos.VideoPopularResponseV2)
+	resp, err = service.NewVideoPopularService(ctx).VideoPopular(req)
+	if resp == nil {
+		resp = new(videos.VideoPopularResponseV2)
+	}
 	resp.Base = &base.Status{}
-	var video []*base.Video
-	if video, err = service.NewVideoPopularService(ctx).VideoPopular(req); err != nil {
-		hlog.CtxErrorf(ctx, "service.VideoPopular failed,original error:%v", errors.Cause(err))
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Invalid time range or page"
+		return resp, nil
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.VideoPopular failed, original error: %v", errors.Cause(err))
 		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
 		resp.Base.Code = consts.StatusBadRequest
 		resp.Base.Msg = "Fail to Get VideoFeed!"
-		resp.Popular = video
 		return resp, err
 	}
 	resp.Base.Code = consts.StatusOK
 	resp.Base.Msg = "Get VideoFeed Success"
-	resp.Popular = video
 	return resp, nil
 }
 
@@ -206,6 +224,24 @@ func (s *VideoServiceImpl) VideoPublishCancelV2(ctx context.Context, req *videos
 }
 
 func (s *VideoServiceImpl) VideoVisitV2(ctx context.Context, req *videos.VideoVisitRequestV2) (resp *videos.VideoVisitResponseV2, err error) {
+	resp = new(videos.VideoVisitResponseV2)
+	resp.Base = &base.Status{}
+	resp.Item, err = service.NewVideoVisitService(ctx).VideoVisit(req)
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Invalid video"
+		return resp, nil
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.VideoVisit failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Visit Video!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Visit Video Success"
+	resp.ViewCounted = true
 	return resp, nil
 }
 
@@ -329,13 +365,13 @@ func (s *VideoServiceImpl) SharedVideoV2(ctx context.Context, req *videos.Shared
 	resp = new(videos.SharedVideoResponseV2)
 	resp.Base = &base.Status{}
 
-	// TODO: Implement shared video logic
-	// err = service.NewVideoShareService(ctx).ShareVideo(req)
-	// if err != nil {
-	// 	resp.Base.Code = errno.ServiceErrCode
-	// 	resp.Base.Msg = "Failed to share video"
-	// 	return resp, err
-	// }
+	err = service.NewSharedVideoService(ctx).SharedVideo(req)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.SharedVideo failed, original error: %v", errors.Cause(err))
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Failed to share video"
+		return resp, err
+	}
 
 	resp.Base.Code = consts.StatusOK
 	resp.Base.Msg = "Successfully shared video"
@@ -344,20 +380,88 @@ func (s *VideoServiceImpl) SharedVideoV2(ctx context.Context, req *videos.Shared
 
 // RecommendVideoV2 implements the VideoServiceImpl interface.
 func (s *VideoServiceImpl) RecommendVideoV2(ctx context.Context, req *videos.RecommendVideoRequestV2) (resp *videos.RecommendVideoResponseV2, err error) {
-	resp = new(videos.RecommendVideoResponseV2)
+	resp, err = service.NewRecommendVideoService(ctx).RecommendVideo(req)
+	if resp == nil {
+		resp = new(videos.RecommendVideoResponseV2)
+	}
 	resp.Base = &base.Status{}
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Invalid algorithm type"
+		return resp, nil
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.RecommendVideo failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Recommend Videos!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Successfully retrieved recommended videos"
+	return resp, nil
+}
 
-	// TODO: Implement video recommendation logic
-	// videos, err := service.NewVideoRecommendService(ctx).GetRecommendedVideos(req)
-	// if err != nil {
-	// 	resp.Base.Code = errno.ServiceErrCode
-	// 	resp.Base.Msg = "Failed to get recommended videos"
-	// 	return resp, err
-	// }
+// RelatedVideos implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) RelatedVideos(ctx context.Context, req *videos.RelatedVideosRequest) (resp *videos.RelatedVideosResponse, err error) {
+	resp, err = service.NewRelatedVideosService(ctx).RelatedVideos(req)
+	if resp == nil {
+		resp = new(videos.RelatedVideosResponse)
+	}
+	resp.Base = &base.Status{}
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Invalid video"
+		return resp, nil
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.RelatedVideos failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Get Related Videos!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Get Related Videos Success"
+	return resp, nil
+}
 
-	// resp.RecommendedVideos = videos
+// FollowingTimeline implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) FollowingTimeline(ctx context.Context, req *videos.FollowingTimelineRequest) (resp *videos.FollowingTimelineResponse, err error) {
+	resp = new(videos.FollowingTimelineResponse)
+	resp.Base = &base.Status{}
+	resp.VideoList, resp.NextCursor, resp.HasMore, err = service.NewFollowingTimelineService(ctx).FollowingTimeline(req)
+	if errors.Is(err, errno.ParamErr) {
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Invalid cursor"
+		return resp, nil
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.FollowingTimeline failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Get Following Timeline!"
+		return resp, err
+	}
 	resp.Base.Code = consts.StatusOK
-	resp.Base.Msg = "Successfully retrieved recommended videos"
+	resp.Base.Msg = "Get Following Timeline Success"
+	return resp, nil
+}
+
+// ForYouFeed implements the VideoServiceImpl interface.
+func (s *VideoServiceImpl) ForYouFeed(ctx context.Context, req *videos.ForYouFeedRequest) (resp *videos.ForYouFeedResponse, err error) {
+	resp = new(videos.ForYouFeedResponse)
+	resp.Base = &base.Status{}
+	resp.VideoList, resp.ExploreCount, err = service.NewForYouFeedService(ctx).ForYouFeed(req)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.ForYouFeed failed, original error: %v", errors.Cause(err))
+		hlog.CtxErrorf(ctx, "stack trace: \n%+v\n", err)
+		resp.Base.Code = consts.StatusBadRequest
+		resp.Base.Msg = "Fail to Get Feed!"
+		return resp, err
+	}
+	resp.Base.Code = consts.StatusOK
+	resp.Base.Msg = "Get Feed Success"
 	return resp, nil
 }
 
@@ -438,15 +542,41 @@ func (s *VideoServiceImpl) GetFavoriteVideoListV2(ctx context.Context, req *vide
 	resp = new(videos.GetFavoriteVideoListResponseV2)
 	resp.Base = &base.Status{}
 
-	// TODO: Implement get favorite video list logic
-	// videos, err := service.NewVideoFavoritesService(ctx).GetFavoriteVideoList(req)
-	// if err != nil {
-	// 	resp.Base.Code = errno.ServiceErrCode
-	// 	resp.Base.Msg = "Failed to get favorite video list"
-	// 	return resp, err
-	// }
+	if err = service.NewVideoFavoritesService(ctx).CheckFavoriteVisibility(req); err != nil {
+		if errors.Is(err, errno.AuthorizationFailedErr) {
+			resp.Base.Code = consts.StatusForbidden
+			resp.Base.Msg = "The user has hidden their likes"
+			return resp, nil
+		}
+		hlog.CtxErrorf(ctx, "service.CheckFavoriteVisibility failed,original error:%v", errors.Cause(err))
+		resp.Base.Code = errno.ServiceErrCode
+		resp.Base.Msg = "Failed to get favorite video list"
+		return resp, err
+	}
 
-	// resp.VideoList = videos
+	var video []*base.Video
+	// 带游标或显式要求时按游标翻页，否则保持原有的页码分页，未指定页码的旧客户端从第一页开始
+	if req.Cursor != "" || req.UseCursor {
+		video, resp.NextCursor, resp.HasMore, err = service.NewVideoFavoritesService(ctx).GetFavoriteVideoListByCursor(req)
+		if errors.Is(err, errno.ParamErr) {
+			resp.Base.Code = consts.StatusBadRequest
+			resp.Base.Msg = "Invalid cursor"
+			return resp, nil
+		}
+	} else {
+		if req.PageNum <= 0 {
+			req.PageNum = 1
+		}
+		video, err = service.NewVideoFavoritesService(ctx).GetFavoriteVideoList(req)
+	}
+	if err != nil {
+		hlog.CtxErrorf(ctx, "service.GetFavoriteVideoList failed,original error:%v", errors.Cause(err))
+		resp.Base.Code = errno.ServiceErrCode
+		resp.Base.Msg = "Failed to get favorite video list"
+		return resp, err
+	}
+
+	resp.VideoList = video
 	resp.Base.Code = consts.StatusOK
 	resp.Base.Msg = "Successfully retrieved favorite video list"
 	return resp, nil
@@ -2,4 +2,6 @@ package client
 
 func Init() {
 	InitUserRpc()
+	InitRelationRpc()
+	InitInteractionRpc()
 }
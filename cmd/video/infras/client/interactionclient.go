@@ -1,2 +1,53 @@
 package client
 
+import (
+	"context"
+	"time"
+
+	"HuaTug.com/config"
+	"HuaTug.com/kitex_gen/interactions"
+	"HuaTug.com/kitex_gen/interactions/interactionservice"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/retry"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	etcd "github.com/kitex-contrib/registry-etcd"
+)
+
+var InteractionClient interactionservice.Client
+
+func InitInteractionRpc() {
+	r, err := etcd.NewEtcdResolver([]string{config.ConfigInfo.Etcd.Addr})
+	if err != nil {
+		hlog.Info(err)
+	}
+	c, err := interactionservice.NewClient(
+		"Interaction",
+		client.WithMuxConnection(1),                       // mux
+		client.WithRPCTimeout(3*time.Second),              // 过滤是附加逻辑，超时要短
+		client.WithConnectTimeout(10*time.Second),         // conn timeout
+		client.WithFailureRetry(retry.NewFailurePolicy()), // retry
+		client.WithResolver(r),                            // resolver
+		client.WithClientBasicInfo(&rpcinfo.EndpointBasicInfo{ServiceName: "Video"}),
+	)
+	if err != nil {
+		hlog.Info(err)
+	}
+	InteractionClient = c
+}
+
+// GetHiddenTargetIDs 返回ids中因举报被隐藏的对象，targetType与举报的对象类型一致
+func GetHiddenTargetIDs(ctx context.Context, targetType int64, ids []int64) (map[int64]struct{}, error) {
+	resp, err := InteractionClient.GetHiddenTargets(ctx, &interactions.GetHiddenTargetsRequest{
+		TargetType: targetType,
+		TargetIds:  ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+	hidden := make(map[int64]struct{}, len(resp.HiddenIds))
+	for _, id := range resp.HiddenIds {
+		hidden[id] = struct{}{}
+	}
+	return hidden, nil
+}
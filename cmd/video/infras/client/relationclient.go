@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"HuaTug.com/config"
+	"HuaTug.com/kitex_gen/relations"
+	"HuaTug.com/kitex_gen/relations/followservice"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/retry"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	etcd "github.com/kitex-contrib/registry-etcd"
+)
+
+var RelationClient followservice.Client
+
+func InitRelationRpc() {
+	r, err := etcd.NewEtcdResolver([]string{config.ConfigInfo.Etcd.Addr})
+	if err != nil {
+		hlog.Info(err)
+	}
+	c, err := followservice.NewClient(
+		"Relation",
+		client.WithMuxConnection(1),                       // mux
+		client.WithRPCTimeout(3*time.Second),              // 过滤是附加逻辑，超时要短
+		client.WithConnectTimeout(10*time.Second),         // conn timeout
+		client.WithFailureRetry(retry.NewFailurePolicy()), // retry
+		client.WithResolver(r),                            // resolver
+		client.WithClientBasicInfo(&rpcinfo.EndpointBasicInfo{ServiceName: "Video"}),
+	)
+	if err != nil {
+		hlog.Info(err)
+	}
+	RelationClient = c
+}
+
+// GetHiddenUserIDs 返回authorIDs中对viewerID不可见的用户（存在拉黑关系或被viewerID静音）
+func GetHiddenUserIDs(ctx context.Context, viewerID int64, authorIDs []int64) (map[int64]struct{}, error) {
+	resp, err := RelationClient.CheckBlock(ctx, &relations.CheckBlockRequest{
+		UserId:        viewerID,
+		TargetUserIds: authorIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	hidden := make(map[int64]struct{}, len(resp.BlockedUserIds)+len(resp.MutedUserIds))
+	for _, id := range resp.BlockedUserIds {
+		hidden[id] = struct{}{}
+	}
+	for _, id := range resp.MutedUserIds {
+		hidden[id] = struct{}{}
+	}
+	return hidden, nil
+}
+
+// GetFollowingIDs 返回userID最近关注的至多limit个用户
+func GetFollowingIDs(ctx context.Context, userID int64, limit int64) ([]int64, error) {
+	resp, err := RelationClient.GetFollowingIDs(ctx, &relations.GetFollowingIDsRequest{
+		UserId: userID,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.UserIds, nil
+}
+
+// GetFollowerIDs 返回userID的粉丝中ID大于afterID的一批，total仅在afterID为0时返回
+func GetFollowerIDs(ctx context.Context, userID, afterID, limit int64) (ids []int64, hasMore bool, total int64, err error) {
+	resp, err := RelationClient.GetFollowerIDs(ctx, &relations.GetFollowerIDsRequest{
+		UserId:  userID,
+		AfterId: afterID,
+		Limit:   limit,
+	})
+	if err != nil {
+		return nil, false, 0, err
+	}
+	return resp.UserIds, resp.HasMore, resp.Total, nil
+}
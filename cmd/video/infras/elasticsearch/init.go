@@ -11,20 +11,24 @@ var (
 	elasticClient *elastic.Client
 )
 
-func Load() {
-	var err error
-	elasticClient, err = elastic.NewClient(
+// Load 连接Elasticsearch并确保video索引存在，失败时搜索相关的功能不可用
+func Load() error {
+	client, err := elastic.NewClient(
 		elastic.SetURL(ElasticAddr),
 		elastic.SetSniff(false),
 		elastic.SetInfoLog(log.New(ioutil.Discard, "", log.LstdFlags|log.Lshortfile|log.Lmicroseconds)),  //debug as os.stdout
 		elastic.SetErrorLog(log.New(ioutil.Discard, "", log.LstdFlags|log.Lshortfile|log.Lmicroseconds)), //debug as os.stderr
 		elastic.SetTraceLog(log.New(ioutil.Discard, "", log.LstdFlags|log.Lshortfile|log.Lmicroseconds)),
 	)
-
-	newVideoIndex()
-
 	if err != nil {
-		panic(err)
+		return err
 	}
+	elasticClient = client
+
+	return newVideoIndex()
+}
 
+// Loaded Elasticsearch是否可用
+func Loaded() bool {
+	return elasticClient != nil
 }
@@ -182,6 +182,27 @@ func SearchVideoDoc(keywords, username string, pageSize, pageNum, fromDate, toDa
 	return data, hits, nil
 }
 
+// SearchSimilarVideoDoc 查找标题和描述与text相似的视频，不包括excludeVid本身
+func SearchSimilarVideoDoc(ctx context.Context, text, excludeVid string, size int) ([]*base.Video, error) {
+	resp, err := elasticClient.Search().
+		Index("video").
+		Query(elastic.NewBoolQuery().
+			Must(elastic.NewMoreLikeThisQuery().
+				Field("title", "description").
+				LikeText(text).
+				MinTermFreq(1).
+				MinDocFreq(1)).
+			MustNot(elastic.NewIdsQuery().Ids(excludeVid)),
+		).
+		Size(size).
+		Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data, _ := searchRespCovert(resp)
+	return data, nil
+}
+
 func SearchVideoDocByUserId(uid string, pageNum, pageSize int64) ([]*base.Video, int64, error) {
 	var (
 		mustQuery    = make([]elastic.Query, 0)
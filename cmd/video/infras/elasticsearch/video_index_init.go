@@ -65,20 +65,21 @@ const videoMapping = `{
 	}
   }`
 
-func newVideoIndex() {
+func newVideoIndex() error {
 	exist, err := elasticClient.IndexExists("video").Do(context.Background())
 	if err != nil {
-		panic(err)
+		return err
 	}
 
 	if !exist {
 		create, err := elasticClient.CreateIndex("video").BodyString(videoMapping).Do(context.Background())
 		if err != nil {
-			panic(err)
+			return err
 		}
 
 		if create.Acknowledged {
 			hlog.Info("Elasticsearch index[video] initialized")
 		}
 	}
+	return nil
 }
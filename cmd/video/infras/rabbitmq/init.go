@@ -0,0 +1,24 @@
+package rabbitmq
+
+import (
+	"HuaTug.com/pkg/mq"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+var Bus *mq.Bus
+
+func Init() {
+	// 连接地址来自配置文件或环境变量RABBITMQ_URL
+	bus, err := mq.NewBusFromConfig()
+	if err != nil {
+		// 作品数、关注流和兴趣画像都依赖事件总线，与其他服务一样连接失败时不启动
+		hlog.Fatalf("Failed to initialize event bus: %v", err)
+	}
+	hlog.Info("Event bus initialized successfully")
+	Bus = bus
+}
+
+// Close 停止消费并等待正在处理的事件完成
+func Close() {
+	Bus.Close()
+}
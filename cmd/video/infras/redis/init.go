@@ -11,6 +11,11 @@ var (
 	redisDBVideoInfo   *redis.Client
 )
 
+// VideoInfoClient 视频信息所在的Redis，也保存消费者的事件去重标记
+func VideoInfoClient() *redis.Client {
+	return redisDBVideoInfo
+}
+
 func Load() {
 
 	redisDBVideoUpload = redis.NewClient(&redis.Options{
@@ -0,0 +1,163 @@
+package redis
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+const (
+	interestKeyPrefix = "interest:"    // interest:<user_id> 兴趣画像哈希，字段为c:<分类>或t:<标签>
+	servedKeyPrefix   = "feed:served:" // feed:served:<user_id> 近期下发过的视频，分数为下发时间
+
+	interestCategoryField = "c:"
+	interestLabelField    = "t:"
+	interestUpdatedField  = "_ts" // 上次衰减的时间
+
+	// 兴趣分每interestHalfLife减半，长期不活跃的用户画像过期后按新用户处理
+	interestHalfLife = 7 * 24 * time.Hour
+	interestTTL      = 30 * 24 * time.Hour
+	// 衰减到此值以下的兴趣视为消失，避免画像无限增长
+	interestMinScore = 0.05
+
+	// 下发过的视频在servedWindow内不再下发，每个用户最多记录servedMaxSize个
+	servedWindow  = 3 * 24 * time.Hour
+	servedMaxSize = 1000
+)
+
+// updateInterestScript 先把已有兴趣分按距上次更新的时间衰减，再累加本次的变化量（早于上次更新的事件同样先衰减），
+// 删除过小的分数并续期；整个过程在Redis中原子执行，同一用户的并发事件不会互相覆盖
+//
+// KEYS[1] 画像key；ARGV: 事件时间(秒)、半衰期(秒)、过期时间(秒)、最小分数，之后为字段和变化量交替
+var updateInterestScript = redis.NewScript(`
+local at = tonumber(ARGV[1])
+local ts = tonumber(redis.call('HGET', KEYS[1], '_ts') or at)
+local now = math.max(at, ts)
+local factor = math.pow(0.5, (now - ts) / tonumber(ARGV[2]))
+local weight = math.pow(0.5, (now - at) / tonumber(ARGV[2]))
+local scores = {}
+local current = redis.call('HGETALL', KEYS[1])
+for i = 1, #current, 2 do
+	if current[i] ~= '_ts' then
+		scores[current[i]] = tonumber(current[i + 1]) * factor
+	end
+end
+for i = 5, #ARGV, 2 do
+	scores[ARGV[i]] = (scores[ARGV[i]] or 0) + tonumber(ARGV[i + 1]) * weight
+end
+local fields = {'_ts', now}
+local min = tonumber(ARGV[4])
+for field, score in pairs(scores) do
+	if score >= min then
+		table.insert(fields, field)
+		table.insert(fields, string.format('%.4f', score))
+	end
+end
+redis.call('DEL', KEYS[1])
+if #fields > 2 then
+	redis.call('HMSET', KEYS[1], unpack(fields))
+	redis.call('EXPIRE', KEYS[1], ARGV[3])
+end
+return #fields / 2 - 1
+`)
+
+func interestKey(uid int64) string {
+	return interestKeyPrefix + strconv.FormatInt(uid, 10)
+}
+
+func servedKey(uid int64) string {
+	return servedKeyPrefix + strconv.FormatInt(uid, 10)
+}
+
+// InterestProfile 用户对分类和标签的兴趣分，越近的互动权重越高
+type InterestProfile struct {
+	Categories map[string]float64
+	Labels     map[string]float64
+}
+
+// Empty 用户没有任何有效兴趣
+func (p *InterestProfile) Empty() bool {
+	return len(p.Categories) == 0 && len(p.Labels) == 0
+}
+
+// UpdateInterestProfile 把at时发生的互动delta累加到uid的兴趣画像上，delta中的分数可以为负（如取消点赞）
+func UpdateInterestProfile(uid int64, delta *InterestProfile, at time.Time) error {
+	args := []interface{}{at.Unix(), int64(interestHalfLife / time.Second), int64(interestTTL / time.Second), interestMinScore}
+	for category, score := range delta.Categories {
+		args = append(args, interestCategoryField+category, score)
+	}
+	for label, score := range delta.Labels {
+		args = append(args, interestLabelField+label, score)
+	}
+	if len(args) == 4 {
+		return nil
+	}
+	return updateInterestScript.Run(redisDBVideoInfo, []string{interestKey(uid)}, args...).Err()
+}
+
+// GetInterestProfile 获取uid衰减到now的兴趣画像，没有画像时返回空画像
+func GetInterestProfile(uid int64, now time.Time) (*InterestProfile, error) {
+	profile := &InterestProfile{Categories: map[string]float64{}, Labels: map[string]float64{}}
+	fields, err := redisDBVideoInfo.HGetAll(interestKey(uid)).Result()
+	if err != nil {
+		return profile, err
+	}
+	factor := 1.0
+	if ts, err := strconv.ParseInt(fields[interestUpdatedField], 10, 64); err == nil && now.Unix() > ts {
+		factor = math.Pow(0.5, float64(now.Unix()-ts)/interestHalfLife.Seconds())
+	}
+	for field, value := range fields {
+		score, err := strconv.ParseFloat(value, 64)
+		if err != nil || score*factor < interestMinScore {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(field, interestCategoryField):
+			profile.Categories[strings.TrimPrefix(field, interestCategoryField)] = score * factor
+		case strings.HasPrefix(field, interestLabelField):
+			profile.Labels[strings.TrimPrefix(field, interestLabelField)] = score * factor
+		}
+	}
+	return profile, nil
+}
+
+// AddServedVideos 记录本次下发给uid的视频，同时清理超出时间窗口和数量上限的旧记录
+func AddServedVideos(uid int64, vids []int64, now time.Time) error {
+	if len(vids) == 0 {
+		return nil
+	}
+	key := servedKey(uid)
+	members := make([]redis.Z, 0, len(vids))
+	for _, vid := range vids {
+		members = append(members, redis.Z{Score: float64(now.Unix()), Member: vid})
+	}
+
+	pipe := redisDBVideoInfo.TxPipeline()
+	pipe.ZAdd(key, members...)
+	pipe.ZRemRangeByScore(key, "-inf", strconv.FormatInt(now.Add(-servedWindow).Unix(), 10))
+	pipe.ZRemRangeByRank(key, 0, -servedMaxSize-1)
+	pipe.Expire(key, servedWindow)
+	_, err := pipe.Exec()
+	return err
+}
+
+// GetServedVideoIDs 获取servedWindow内下发给uid的视频
+func GetServedVideoIDs(uid int64, now time.Time) (map[int64]struct{}, error) {
+	served := make(map[int64]struct{})
+	members, err := redisDBVideoInfo.ZRangeByScore(servedKey(uid), redis.ZRangeBy{
+		Min: strconv.FormatInt(now.Add(-servedWindow).Unix(), 10),
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		return served, err
+	}
+	for _, m := range members {
+		if id, err := strconv.ParseInt(m, 10, 64); err == nil {
+			served[id] = struct{}{}
+		}
+	}
+	return served, nil
+}
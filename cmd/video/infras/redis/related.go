@@ -0,0 +1,94 @@
+package redis
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+const (
+	relatedVideosKeyPrefix = "related:"     // related:<video_id> 相似视频有序集合，分数为相似度
+	relatedVideosMetaKey   = "related:meta" // 最近一次离线计算的时间和覆盖的视频数
+)
+
+func relatedVideosKey(vid int64) string {
+	return relatedVideosKeyPrefix + strconv.FormatInt(vid, 10)
+}
+
+// RelatedVideo 一个相似视频
+type RelatedVideo struct {
+	VideoID    int64
+	Similarity float64
+}
+
+// SaveRelatedVideos 用事务整体替换vid的相似视频列表，ttl后过期，离线任务停止运行时回退到冷启动逻辑
+func SaveRelatedVideos(vid int64, related []RelatedVideo, ttl time.Duration) error {
+	key := relatedVideosKey(vid)
+	members := make([]redis.Z, 0, len(related))
+	for _, r := range related {
+		members = append(members, redis.Z{Score: r.Similarity, Member: r.VideoID})
+	}
+
+	pipe := redisDBVideoInfo.TxPipeline()
+	pipe.Del(key)
+	if len(members) > 0 {
+		pipe.ZAdd(key, members...)
+		pipe.Expire(key, ttl)
+	}
+	_, err := pipe.Exec()
+	return err
+}
+
+// GetRelatedVideoIDs 按相似度倒序获取vid的至多limit个相似视频，没有计算结果时返回空
+func GetRelatedVideoIDs(vid int64, limit int64) ([]int64, error) {
+	members, err := redisDBVideoInfo.ZRevRange(relatedVideosKey(vid), 0, limit-1).Result()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(members))
+	for _, m := range members {
+		id, err := strconv.ParseInt(m, 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// GetRelatedVideosOf 在一次往返中读取vids各自相似度最高的至多limit个相似视频，没有计算结果的视频不出现在返回值中
+func GetRelatedVideosOf(vids []int64, limit int64) (map[int64][]RelatedVideo, error) {
+	result := make(map[int64][]RelatedVideo, len(vids))
+	if len(vids) == 0 {
+		return result, nil
+	}
+
+	pipe := redisDBVideoInfo.Pipeline()
+	cmds := make([]*redis.ZSliceCmd, len(vids))
+	for i, vid := range vids {
+		cmds[i] = pipe.ZRevRangeWithScores(relatedVideosKey(vid), 0, limit-1)
+	}
+	if _, err := pipe.Exec(); err != nil && err != redis.Nil {
+		return nil, err
+	}
+	for i, cmd := range cmds {
+		for _, z := range cmd.Val() {
+			member, _ := z.Member.(string)
+			id, err := strconv.ParseInt(member, 10, 64)
+			if err != nil {
+				continue
+			}
+			result[vids[i]] = append(result[vids[i]], RelatedVideo{VideoID: id, Similarity: z.Score})
+		}
+	}
+	return result, nil
+}
+
+// SaveRelatedVideosMeta 记录离线计算完成的时间和写入的视频数，便于排查结果是否过期
+func SaveRelatedVideosMeta(builtAt time.Time, videos int) error {
+	return redisDBVideoInfo.HMSet(relatedVideosMetaKey, map[string]interface{}{
+		"built_at": builtAt.Unix(),
+		"videos":   videos,
+	}).Err()
+}
@@ -0,0 +1,181 @@
+package redis
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+const (
+	// timeline:inbox:<user_id> 关注流收件箱，成员为"<发布日期>:<补零的视频ID>"、分数都为0，
+	// 按字典序即可按(发布日期, 视频ID)排序并精确地从游标处翻页
+	timelineInboxKeyPrefix = "timeline:inbox:"
+	// 粉丝数超过阈值的作者，发布时不写粉丝收件箱，由读取方按关注关系拉取
+	timelineCelebritiesKey = "timeline:celebrities"
+
+	timelineInboxMaxSize = 500
+	// 长期不读关注流的用户收件箱过期，下次读取时重建
+	timelineInboxTTL = 30 * 24 * time.Hour
+)
+
+// fanOutScript 只写入已存在的收件箱：不存在的收件箱在用户读取时整体重建，避免只含新视频的残缺收件箱
+//
+// KEYS 粉丝的收件箱；ARGV: 成员、收件箱上限
+var fanOutScript = redis.NewScript(`
+local written = 0
+for _, key in ipairs(KEYS) do
+	if redis.call('EXISTS', key) == 1 then
+		redis.call('ZADD', key, 0, ARGV[1])
+		redis.call('ZREMRANGEBYRANK', key, 0, -tonumber(ARGV[2]) - 1)
+		written = written + 1
+	end
+end
+return written
+`)
+
+func timelineInboxKey(uid int64) string {
+	return timelineInboxKeyPrefix + strconv.FormatInt(uid, 10)
+}
+
+// TimelineEntry 关注流中的一个视频
+type TimelineEntry struct {
+	CreatedAt string
+	VideoID   int64
+}
+
+func (e TimelineEntry) member() string {
+	return fmt.Sprintf("%s:%019d", e.CreatedAt, e.VideoID)
+}
+
+func parseTimelineEntry(member string) (TimelineEntry, bool) {
+	i := strings.LastIndexByte(member, ':')
+	if i < 0 {
+		return TimelineEntry{}, false
+	}
+	vid, err := strconv.ParseInt(member[i+1:], 10, 64)
+	if err != nil {
+		return TimelineEntry{}, false
+	}
+	return TimelineEntry{CreatedAt: member[:i], VideoID: vid}, true
+}
+
+// FanOutToInboxes 把视频写入followerIDs中已存在的收件箱，返回写入的数量
+func FanOutToInboxes(followerIDs []int64, entry TimelineEntry) (int64, error) {
+	if len(followerIDs) == 0 {
+		return 0, nil
+	}
+	keys := make([]string, 0, len(followerIDs))
+	for _, uid := range followerIDs {
+		keys = append(keys, timelineInboxKey(uid))
+	}
+	return fanOutScript.Run(redisDBVideoInfo, keys, entry.member(), timelineInboxMaxSize).Int64()
+}
+
+// InboxExists uid的收件箱是否已建立
+func InboxExists(uid int64) (bool, error) {
+	n, err := redisDBVideoInfo.Exists(timelineInboxKey(uid)).Result()
+	return n > 0, err
+}
+
+// AddToInbox 向uid已存在的收件箱补充视频，用于新关注作者时补齐其近期视频
+func AddToInbox(uid int64, entries []TimelineEntry) error {
+	for _, e := range entries {
+		if _, err := fanOutScript.Run(redisDBVideoInfo, []string{timelineInboxKey(uid)}, e.member(), timelineInboxMaxSize).Result(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RebuildInbox 用entries整体替换uid的收件箱并续期
+func RebuildInbox(uid int64, entries []TimelineEntry) error {
+	key := timelineInboxKey(uid)
+	members := make([]redis.Z, 0, len(entries))
+	for _, e := range entries {
+		members = append(members, redis.Z{Score: 0, Member: e.member()})
+	}
+
+	pipe := redisDBVideoInfo.TxPipeline()
+	pipe.Del(key)
+	if len(members) > 0 {
+		pipe.ZAdd(key, members...)
+		pipe.ZRemRangeByRank(key, 0, -timelineInboxMaxSize-1)
+		pipe.Expire(key, timelineInboxTTL)
+	}
+	_, err := pipe.Exec()
+	return err
+}
+
+// RemoveFromInbox 从uid的收件箱移除视频，用于取消关注
+func RemoveFromInbox(uid int64, entries []TimelineEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	members := make([]interface{}, 0, len(entries))
+	for _, e := range entries {
+		members = append(members, e.member())
+	}
+	return redisDBVideoInfo.ZRem(timelineInboxKey(uid), members...).Err()
+}
+
+// GetInbox 按(发布日期, 视频ID)倒序获取uid收件箱中排在after之后的至多limit个视频，after为nil时从最新开始；读取时续期
+func GetInbox(uid int64, after *TimelineEntry, limit int64) ([]TimelineEntry, error) {
+	key := timelineInboxKey(uid)
+	max := "+"
+	if after != nil {
+		max = "(" + after.member()
+	}
+	members, err := redisDBVideoInfo.ZRevRangeByLex(key, redis.ZRangeBy{Max: max, Min: "-", Count: limit}).Result()
+	if err != nil {
+		return nil, err
+	}
+	redisDBVideoInfo.Expire(key, timelineInboxTTL)
+
+	entries := make([]TimelineEntry, 0, len(members))
+	for _, m := range members {
+		if e, ok := parseTimelineEntry(m); ok {
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+// SetCelebrity 记录作者是否按拉取方式分发
+func SetCelebrity(authorID int64, celebrity bool) error {
+	if celebrity {
+		return redisDBVideoInfo.SAdd(timelineCelebritiesKey, authorID).Err()
+	}
+	return redisDBVideoInfo.SRem(timelineCelebritiesKey, authorID).Err()
+}
+
+// IsCelebrity 作者是否按拉取方式分发
+func IsCelebrity(authorID int64) (bool, error) {
+	return redisDBVideoInfo.SIsMember(timelineCelebritiesKey, authorID).Result()
+}
+
+// FilterCelebrities 返回authorIDs中按拉取方式分发的作者
+func FilterCelebrities(authorIDs []int64) ([]int64, error) {
+	if len(authorIDs) == 0 {
+		return nil, nil
+	}
+	members, err := redisDBVideoInfo.SMembers(timelineCelebritiesKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	celebrities := make(map[int64]struct{}, len(members))
+	for _, m := range members {
+		if id, err := strconv.ParseInt(m, 10, 64); err == nil {
+			celebrities[id] = struct{}{}
+		}
+	}
+	var result []int64
+	for _, id := range authorIDs {
+		if _, ok := celebrities[id]; ok {
+			result = append(result, id)
+		}
+	}
+	return result, nil
+}
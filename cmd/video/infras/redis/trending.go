@@ -0,0 +1,188 @@
+package redis
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+const (
+	// 互动分数按小时和天分桶累加：trending:h:<2006010215>:<范围>、trending:d:<20060102>:<范围>，
+	// 范围为all（全站）或c:<分类>
+	trendingHourBucketPrefix = "trending:h:"
+	trendingDayBucketPrefix  = "trending:d:"
+	// trending:board:<时间窗口>:<范围> 按重力衰减后的热度排好序的榜单，由后台定时重算
+	trendingBoardPrefix   = "trending:board:"
+	trendingCategoriesKey = "trending:categories" // 出现过互动的分类，重算时逐个生成分类榜单
+	trendingMetaKey       = "trending:meta"       // 最近一次重算的时间
+	trendingLockKey       = "trending:refresh_lock"
+
+	trendingGlobalScope   = "all"
+	trendingHourBucketTTL = 26 * time.Hour
+	trendingDayBucketTTL  = 8 * 24 * time.Hour
+)
+
+// TrendingWindow 榜单统计互动的时间窗口
+type TrendingWindow string
+
+const (
+	TrendingHour TrendingWindow = "hour" // 当前和上一个小时
+	TrendingDay  TrendingWindow = "day"  // 最近24个小时
+	TrendingWeek TrendingWindow = "week" // 最近7天
+)
+
+// TrendingWindows 所有时间窗口
+var TrendingWindows = []TrendingWindow{TrendingHour, TrendingDay, TrendingWeek}
+
+// buckets 窗口在now时覆盖的计数桶
+func (w TrendingWindow) buckets(scope string, now time.Time) []string {
+	var keys []string
+	switch w {
+	case TrendingHour:
+		for i := 0; i < 2; i++ {
+			keys = append(keys, trendingHourBucketPrefix+now.Add(-time.Duration(i)*time.Hour).Format("2006010215")+":"+scope)
+		}
+	case TrendingDay:
+		for i := 0; i < 24; i++ {
+			keys = append(keys, trendingHourBucketPrefix+now.Add(-time.Duration(i)*time.Hour).Format("2006010215")+":"+scope)
+		}
+	case TrendingWeek:
+		for i := 0; i < 7; i++ {
+			keys = append(keys, trendingDayBucketPrefix+now.AddDate(0, 0, -i).Format("20060102")+":"+scope)
+		}
+	}
+	return keys
+}
+
+func trendingScope(category string) string {
+	if category == "" {
+		return trendingGlobalScope
+	}
+	return "c:" + category
+}
+
+func trendingBoardKey(window TrendingWindow, category string) string {
+	return trendingBoardPrefix + string(window) + ":" + trendingScope(category)
+}
+
+// TrendingVideo 榜单中的一个视频
+type TrendingVideo struct {
+	VideoID int64
+	Score   float64
+}
+
+// AddTrendingPoints 把一次互动的分数计入视频在at所在小时和天的全站及分类计数桶
+func AddTrendingPoints(vid int64, category string, points float64, at time.Time) error {
+	scopes := []string{trendingGlobalScope}
+	if category != "" {
+		scopes = append(scopes, trendingScope(category))
+	}
+	member := strconv.FormatInt(vid, 10)
+
+	pipe := redisDBVideoInfo.Pipeline()
+	for _, scope := range scopes {
+		hourKey := trendingHourBucketPrefix + at.Format("2006010215") + ":" + scope
+		dayKey := trendingDayBucketPrefix + at.Format("20060102") + ":" + scope
+		pipe.ZIncrBy(hourKey, points, member)
+		pipe.Expire(hourKey, trendingHourBucketTTL)
+		pipe.ZIncrBy(dayKey, points, member)
+		pipe.Expire(dayKey, trendingDayBucketTTL)
+	}
+	if category != "" {
+		pipe.SAdd(trendingCategoriesKey, category)
+	}
+	_, err := pipe.Exec()
+	return err
+}
+
+// TopTrendingPoints 合并窗口内的计数桶，返回分数最高的至多limit个视频
+func TopTrendingPoints(window TrendingWindow, category string, now time.Time, limit int64) ([]TrendingVideo, error) {
+	tmp := "trending:tmp:" + string(window) + ":" + trendingScope(category)
+	pipe := redisDBVideoInfo.TxPipeline()
+	pipe.ZUnionStore(tmp, redis.ZStore{Aggregate: "SUM"}, window.buckets(trendingScope(category), now)...)
+	top := pipe.ZRevRangeWithScores(tmp, 0, limit-1)
+	pipe.Del(tmp)
+	if _, err := pipe.Exec(); err != nil {
+		return nil, err
+	}
+
+	result := make([]TrendingVideo, 0, len(top.Val()))
+	for _, z := range top.Val() {
+		member, _ := z.Member.(string)
+		vid, err := strconv.ParseInt(member, 10, 64)
+		if err != nil || z.Score <= 0 {
+			continue
+		}
+		result = append(result, TrendingVideo{VideoID: vid, Score: z.Score})
+	}
+	return result, nil
+}
+
+// SaveTrendingBoard 用事务整体替换榜单，ttl后过期，重算停止时读取方回退到按点赞数排序
+func SaveTrendingBoard(window TrendingWindow, category string, board []TrendingVideo, ttl time.Duration) error {
+	key := trendingBoardKey(window, category)
+	members := make([]redis.Z, 0, len(board))
+	for _, v := range board {
+		members = append(members, redis.Z{Score: v.Score, Member: v.VideoID})
+	}
+
+	pipe := redisDBVideoInfo.TxPipeline()
+	pipe.Del(key)
+	if len(members) > 0 {
+		pipe.ZAdd(key, members...)
+		pipe.Expire(key, ttl)
+	}
+	_, err := pipe.Exec()
+	return err
+}
+
+// GetTrendingBoard 按热度倒序获取榜单中从offset开始的至多limit个视频，total为0表示榜单尚未生成或已过期
+func GetTrendingBoard(window TrendingWindow, category string, offset, limit int64) (ids []int64, total int64, err error) {
+	key := trendingBoardKey(window, category)
+	pipe := redisDBVideoInfo.Pipeline()
+	members := pipe.ZRevRange(key, offset, offset+limit-1)
+	card := pipe.ZCard(key)
+	if _, err := pipe.Exec(); err != nil {
+		return nil, 0, err
+	}
+	ids = make([]int64, 0, len(members.Val()))
+	for _, m := range members.Val() {
+		if id, err := strconv.ParseInt(m, 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids, card.Val(), nil
+}
+
+// GetTrendingBoardSize 榜单中的视频数，为0表示榜单尚未生成或已过期
+func GetTrendingBoardSize(window TrendingWindow, category string) (int64, error) {
+	return redisDBVideoInfo.ZCard(trendingBoardKey(window, category)).Result()
+}
+
+// GetTrendingCategories 出现过互动的分类
+func GetTrendingCategories() ([]string, error) {
+	return redisDBVideoInfo.SMembers(trendingCategoriesKey).Result()
+}
+
+// TryLockTrendingRefresh 多个实例中只有一个在ttl内重算榜单
+func TryLockTrendingRefresh(ttl time.Duration) (bool, error) {
+	return redisDBVideoInfo.SetNX(trendingLockKey, 1, ttl).Result()
+}
+
+// SaveTrendingUpdatedAt 记录榜单重算完成的时间
+func SaveTrendingUpdatedAt(t time.Time) error {
+	return redisDBVideoInfo.Set(trendingMetaKey, t.Unix(), 0).Err()
+}
+
+// GetTrendingUpdatedAt 榜单最近一次重算完成的时间，从未重算过时返回零值
+func GetTrendingUpdatedAt() (time.Time, error) {
+	ts, err := redisDBVideoInfo.Get(trendingMetaKey).Int64()
+	if err == redis.Nil {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(ts, 0), nil
+}
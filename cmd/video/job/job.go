@@ -0,0 +1,68 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"HuaTug.com/cmd/video/infras/redis"
+	"HuaTug.com/cmd/video/service"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	trendingRefreshInterval = time.Minute // 热门榜单重算间隔
+	// 重算锁的持有时间，略短于间隔，保证每个周期只有一个实例重算
+	trendingRefreshLockTTL = trendingRefreshInterval - 5*time.Second
+	trendingRefreshTimeout = trendingRefreshInterval // 单次重算的最长时间
+)
+
+var cancel context.CancelFunc
+
+// Init 启动热门榜单的定时重算任务，依赖DB和Redis已经初始化
+func Init() {
+	var ctx context.Context
+	ctx, cancel = context.WithCancel(context.Background())
+
+	go runTrendingRefresh(ctx)
+	hlog.Info("Trending refresh job started")
+}
+
+// Close 停止后台任务
+func Close() {
+	if cancel != nil {
+		cancel()
+	}
+}
+
+func runTrendingRefresh(ctx context.Context) {
+	ticker := time.NewTicker(trendingRefreshInterval)
+	defer ticker.Stop()
+	for {
+		refreshTrending(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func refreshTrending(ctx context.Context) {
+	locked, err := redis.TryLockTrendingRefresh(trendingRefreshLockTTL)
+	if err != nil {
+		hlog.CtxErrorf(ctx, "Failed to lock trending refresh: %v", err)
+		return
+	}
+	if !locked {
+		return // 其他实例正在重算
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, trendingRefreshTimeout)
+	defer cancel()
+	start := time.Now()
+	if err := service.RefreshTrending(ctx, start); err != nil {
+		hlog.CtxErrorf(ctx, "Trending refresh aborted: %v", err)
+		return
+	}
+	hlog.CtxInfof(ctx, "Trending boards refreshed in %v", time.Since(start))
+}
@@ -7,13 +7,18 @@ import (
 	"HuaTug.com/config/jaeger"
 	"HuaTug.com/kitex_gen/videos/videoservice"
 
+	"HuaTug.com/cmd/video/consumer"
 	"HuaTug.com/cmd/video/dal"
 	"HuaTug.com/cmd/video/infras/client"
+	"HuaTug.com/cmd/video/infras/elasticsearch"
+	"HuaTug.com/cmd/video/infras/rabbitmq"
 	"HuaTug.com/cmd/video/infras/redis"
+	"HuaTug.com/cmd/video/job"
 
 	"HuaTug.com/config"
 	"HuaTug.com/pkg/bound"
 	"HuaTug.com/pkg/constants"
+	"HuaTug.com/pkg/idgen"
 	"HuaTug.com/pkg/middleware"
 	"HuaTug.com/pkg/oss"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
@@ -30,6 +35,12 @@ func Init() {
 	redis.Load()
 	oss.InitMinio()
 	client.Init()
+	rabbitmq.Init()
+	consumer.Init()
+	job.Init()
+	if err := elasticsearch.Load(); err != nil {
+		hlog.Warnf("Elasticsearch unavailable, related videos fall back to tags only: %v", err)
+	}
 	// common.NewSyncSerivce().Run()
 }
 
@@ -57,6 +68,9 @@ func main() {
 
 	suite, closer := jaeger.NewServerSuite().Init("Video")
 	defer closer.Close()
+	defer idgen.Close()
+	defer rabbitmq.Close()
+	defer job.Close()
 	ip, err := constants.GetOutBoundIP()
 	if err != nil {
 		panic(err)
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+
+	"HuaTug.com/cmd/video/infras/client"
+	"HuaTug.com/kitex_gen/base"
+	"HuaTug.com/pkg/errno"
+)
+
+// filterBlockedVideos 过滤掉与viewer存在拉黑关系或被viewer静音的作者的视频，viewer为0（未登录）时不过滤
+func filterBlockedVideos(ctx context.Context, viewerID int64, list []*base.Video) ([]*base.Video, error) {
+	if viewerID == 0 || len(list) == 0 {
+		return list, nil
+	}
+
+	seen := make(map[int64]struct{}, len(list))
+	authorIDs := make([]int64, 0, len(list))
+	for _, v := range list {
+		if v == nil || v.UserId == viewerID {
+			continue
+		}
+		if _, ok := seen[v.UserId]; ok {
+			continue
+		}
+		seen[v.UserId] = struct{}{}
+		authorIDs = append(authorIDs, v.UserId)
+	}
+	if len(authorIDs) == 0 {
+		return list, nil
+	}
+
+	hidden, err := getHiddenUserIDs(ctx, viewerID, authorIDs)
+	if err != nil {
+		return list, visibilityCheckFailed(ctx, err, "Failed to check blocked authors for viewer %d", viewerID)
+	}
+	if len(hidden) == 0 {
+		return list, nil
+	}
+
+	result := make([]*base.Video, 0, len(list))
+	for _, v := range list {
+		if v == nil {
+			continue
+		}
+		if _, ok := hidden[v.UserId]; ok {
+			continue
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+// isAuthorHidden 作者与viewer存在拉黑关系或被viewer静音时返回true，用于只含一个作者的列表在查询前整体排除
+func isAuthorHidden(ctx context.Context, viewerID, authorID int64) (bool, error) {
+	if viewerID == 0 || viewerID == authorID {
+		return false, nil
+	}
+	hidden, err := getHiddenUserIDs(ctx, viewerID, []int64{authorID})
+	if err != nil {
+		return false, visibilityCheckFailed(ctx, err, "Failed to check blocked author %d for viewer %d", authorID, viewerID)
+	}
+	_, ok := hidden[authorID]
+	return ok, nil
+}
+
+// getHiddenUserIDs 查询authorIDs中对viewerID不可见的用户，relation客户端未初始化时按查询失败处理
+func getHiddenUserIDs(ctx context.Context, viewerID int64, authorIDs []int64) (map[int64]struct{}, error) {
+	if client.RelationClient == nil {
+		return nil, errno.RpcErr
+	}
+	return client.GetHiddenUserIDs(ctx, viewerID, authorIDs)
+}
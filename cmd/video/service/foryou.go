@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"HuaTug.com/cmd/video/dal/db"
+	"HuaTug.com/cmd/video/infras/redis"
+	"HuaTug.com/kitex_gen/base"
+	"HuaTug.com/kitex_gen/videos"
+	"HuaTug.com/pkg/constants"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	forYouDefaultCount = 10
+	forYouMaxCount     = 50
+	forYouTopTags      = 8   // 参与召回的兴趣分最高的分类数和标签数
+	forYouSourceLimit  = 200 // 个性化召回的候选数上限
+	forYouWindowDays   = 30  // 只召回最近发布的视频
+	// 每forYouExploreEvery个位置放一个探索视频，让用户接触画像之外的内容，画像为空时全部为探索
+	forYouExploreEvery = 5
+)
+
+type ForYouFeedService struct {
+	ctx context.Context
+}
+
+func NewForYouFeedService(ctx context.Context) *ForYouFeedService {
+	return &ForYouFeedService{ctx: ctx}
+}
+
+// ForYouFeed 按兴趣画像召回视频并穿插热门中的随机探索内容，跳过近期已下发、已看过和拉黑作者的视频
+func (s *ForYouFeedService) ForYouFeed(req *videos.ForYouFeedRequest) ([]*base.Video, int32, error) {
+	count := int(req.Count)
+	if count <= 0 {
+		count = forYouDefaultCount
+	}
+	if count > forYouMaxCount {
+		count = forYouMaxCount
+	}
+	now := time.Now()
+	since := now.AddDate(0, 0, -forYouWindowDays).Format(constants.DataFormate)
+
+	profile := &redis.InterestProfile{}
+	served := map[int64]struct{}{}
+	if req.UserId > 0 {
+		var err error
+		if profile, err = redis.GetInterestProfile(req.UserId, now); err != nil {
+			hlog.CtxWarnf(s.ctx, "Failed to get interest profile of user %d: %v", req.UserId, err)
+		}
+		if served, err = redis.GetServedVideoIDs(req.UserId, now); err != nil {
+			hlog.CtxWarnf(s.ctx, "Failed to get served videos of user %d: %v", req.UserId, err)
+		}
+	}
+
+	var personalized []*base.Video
+	if !profile.Empty() {
+		categories := topScored(profile.Categories, forYouTopTags)
+		labels := topScored(profile.Labels, forYouTopTags)
+		list, err := db.GetRecentVideosByTags(s.ctx, categories, labels, since, forYouSourceLimit)
+		if err != nil {
+			hlog.CtxWarnf(s.ctx, "Failed to get personalized candidates of user %d: %v", req.UserId, err)
+		}
+		personalized = rankByInterest(list, profile, now)
+	}
+	trending, err := getTrendingVideos(s.ctx, nil, since)
+	if err != nil {
+		hlog.CtxWarnf(s.ctx, "Failed to get trending videos: %v", err)
+	}
+	// 探索内容从热门视频中随机抽取，每次刷新都不同
+	explore := make([]*base.Video, len(trending))
+	copy(explore, trending)
+	rand.Shuffle(len(explore), func(i, j int) { explore[i], explore[j] = explore[j], explore[i] })
+
+	if personalized, err = s.filter(req.UserId, personalized, served); err != nil {
+		return nil, 0, err
+	}
+	if explore, err = s.filter(req.UserId, explore, served); err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]*base.Video, 0, count)
+	seen := make(map[int64]struct{}, count)
+	var explored int32
+	take := func(list *[]*base.Video) bool {
+		for len(*list) > 0 {
+			v := (*list)[0]
+			*list = (*list)[1:]
+			if _, ok := seen[v.VideoId]; ok {
+				continue
+			}
+			seen[v.VideoId] = struct{}{}
+			result = append(result, v)
+			return true
+		}
+		return false
+	}
+	for len(result) < count {
+		if (len(result)+1)%forYouExploreEvery == 0 || len(personalized) == 0 {
+			if take(&explore) {
+				explored++
+				continue
+			}
+		}
+		if !take(&personalized) {
+			if !take(&explore) {
+				break
+			}
+			explored++
+		}
+	}
+
+	if req.UserId > 0 {
+		ids := make([]int64, 0, len(result))
+		for _, v := range result {
+			ids = append(ids, v.VideoId)
+		}
+		if err := redis.AddServedVideos(req.UserId, ids, now); err != nil {
+			hlog.CtxWarnf(s.ctx, "Failed to record served videos of user %d: %v", req.UserId, err)
+		}
+	}
+	return result, explored, nil
+}
+
+// filter 去掉近期已下发、已看过和拉黑作者的视频
+func (s *ForYouFeedService) filter(userID int64, list []*base.Video, served map[int64]struct{}) ([]*base.Video, error) {
+	fresh := make([]*base.Video, 0, len(list))
+	ids := make([]int64, 0, len(list))
+	for _, v := range list {
+		if _, ok := served[v.VideoId]; ok {
+			continue
+		}
+		fresh = append(fresh, v)
+		ids = append(ids, v.VideoId)
+	}
+	if userID > 0 && len(ids) > 0 {
+		watchedIDs, err := db.GetWatchedVideoIDs(s.ctx, userID, ids)
+		if err != nil {
+			hlog.CtxWarnf(s.ctx, "Failed to get watched videos of user %d: %v", userID, err)
+		}
+		watched := make(map[int64]struct{}, len(watchedIDs))
+		for _, id := range watchedIDs {
+			watched[id] = struct{}{}
+		}
+		unwatched := fresh[:0]
+		for _, v := range fresh {
+			if _, ok := watched[v.VideoId]; !ok {
+				unwatched = append(unwatched, v)
+			}
+		}
+		fresh = unwatched
+	}
+	return filterVisibleVideos(s.ctx, userID, fresh)
+}
+
+// rankByInterest 按视频分类和标签的兴趣分之和排序，乘以新鲜度，同分时点赞多的在前
+func rankByInterest(list []*base.Video, profile *redis.InterestProfile, now time.Time) []*base.Video {
+	scores := make(map[int64]float64, len(list))
+	for _, v := range list {
+		score := profile.Categories[v.Category]
+		for _, label := range splitLabels(v.LabelNames) {
+			score += profile.Labels[label]
+		}
+		scores[v.VideoId] = score*(0.5+0.5*freshness(v.CreatedAt, now)) + recommendPopularWeight*math.Log1p(float64(v.LikesCount))
+	}
+	ranked := make([]*base.Video, len(list))
+	copy(ranked, list)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return scores[ranked[i].VideoId] > scores[ranked[j].VideoId]
+	})
+	return ranked
+}
+
+// topScored 取分数最高的k个键
+func topScored(scores map[string]float64, k int) []string {
+	result := make([]string, 0, len(scores))
+	for key := range scores {
+		result = append(result, key)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if scores[result[i]] != scores[result[j]] {
+			return scores[result[i]] > scores[result[j]]
+		}
+		return result[i] < result[j]
+	})
+	if len(result) > k {
+		result = result[:k]
+	}
+	return result
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"HuaTug.com/cmd/video/dal/db"
+	"HuaTug.com/cmd/video/infras/rabbitmq"
+	"HuaTug.com/cmd/video/infras/redis"
+	"HuaTug.com/kitex_gen/base"
+	"HuaTug.com/pkg/mq"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/google/uuid"
+)
+
+// 各种互动对兴趣画像的贡献，取消点赞抵消之前的点赞
+var interestWeights = map[string]float64{
+	"like":               3,
+	"unlike":             -3,
+	mq.EngagementComment: 2,
+	mq.EngagementShare:   4,
+	mq.EngagementWatch:   1,
+}
+
+// 兴趣画像在事件去重标记中的消费者名
+const interestConsumer = "interest"
+
+// InterestEventHandler 根据点赞、评论、分享和观看事件更新用户兴趣画像
+type InterestEventHandler struct{}
+
+func NewInterestEventHandler() *InterestEventHandler {
+	return &InterestEventHandler{}
+}
+
+// HandleLikeEvent 处理interaction服务发布的点赞事件，评论点赞与视频兴趣无关
+func (h *InterestEventHandler) HandleLikeEvent(ctx context.Context, event *mq.LikeEvent) error {
+	if event.EventType != "video_like" {
+		return nil
+	}
+	return h.apply(ctx, event.EventID, event.UserID, event.VideoID, event.ActionType, event.Timestamp)
+}
+
+// HandleEngagementEvent 处理评论、分享和观看事件
+func (h *InterestEventHandler) HandleEngagementEvent(ctx context.Context, event *mq.VideoEngagementEvent) error {
+	return h.apply(ctx, event.EventID, event.UserID, event.VideoID, event.Action, event.Timestamp)
+}
+
+// apply 把视频的分类和标签按互动权重累加到用户画像上
+func (h *InterestEventHandler) apply(ctx context.Context, eventID string, userID, videoID int64, action string, timestamp int64) error {
+	if userID <= 0 {
+		return nil // 未登录用户的播放只计入热度
+	}
+	weight, ok := interestWeights[action]
+	if !ok || videoID <= 0 {
+		// 非法事件重新入队也无法处理，直接丢弃
+		hlog.CtxWarnf(ctx, "Drop invalid interest event: user %d, video %d, action %q", userID, videoID, action)
+		return nil
+	}
+
+	video, err := db.GetVideo(ctx, videoID)
+	if err != nil {
+		return err
+	}
+	if video.VideoId == 0 {
+		return nil
+	}
+	delta := &redis.InterestProfile{Categories: map[string]float64{}, Labels: map[string]float64{}}
+	if video.Category != "" {
+		delta.Categories[video.Category] = weight
+	}
+	for _, label := range splitLabels(video.LabelNames) {
+		delta.Labels[label] = weight
+	}
+	if delta.Empty() {
+		return nil
+	}
+
+	// 按事件发生的时间衰减，消费积压时也不会高估旧互动
+	at := time.Now()
+	if timestamp > 0 && timestamp < at.Unix() {
+		at = time.Unix(timestamp, 0)
+	}
+	return mq.Dedupe(ctx, redis.VideoInfoClient(), interestConsumer, eventID, func() error {
+		if err := redis.UpdateInterestProfile(userID, delta, at); err != nil {
+			return fmt.Errorf("failed to update interest profile of user %d: %w", userID, err)
+		}
+		return nil
+	})
+}
+
+// publishEngagementEvent 发布用户对视频的互动事件，未登录用户的播放userID为0，失败不影响互动本身
+func publishEngagementEvent(ctx context.Context, userID int64, video *base.Video, action string) {
+	event := &mq.VideoEngagementEvent{
+		UserID:    userID,
+		VideoID:   video.VideoId,
+		Category:  video.Category,
+		Action:    action,
+		Timestamp: time.Now().Unix(),
+		EventID:   uuid.New().String(),
+	}
+	if err := mq.Publish(ctx, rabbitmq.Bus, event); err != nil {
+		hlog.CtxWarnf(ctx, "Failed to publish %s event of video %d: %v", action, video.VideoId, err)
+	}
+}
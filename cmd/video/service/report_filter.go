@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+
+	"HuaTug.com/cmd/model"
+	"HuaTug.com/cmd/video/infras/client"
+	"HuaTug.com/kitex_gen/base"
+	"HuaTug.com/pkg/errno"
+)
+
+// filterHiddenVideos 过滤掉因举报被隐藏的视频，作者本人仍能看到自己被隐藏的视频
+func filterHiddenVideos(ctx context.Context, viewerID int64, list []*base.Video) ([]*base.Video, error) {
+	if len(list) == 0 {
+		return list, nil
+	}
+
+	videoIDs := make([]int64, 0, len(list))
+	for _, v := range list {
+		if v == nil || (viewerID != 0 && v.UserId == viewerID) {
+			continue
+		}
+		videoIDs = append(videoIDs, v.VideoId)
+	}
+	if len(videoIDs) == 0 {
+		return list, nil
+	}
+	if client.InteractionClient == nil {
+		return list, visibilityCheckFailed(ctx, errno.RpcErr, "Failed to check hidden videos")
+	}
+
+	hidden, err := client.GetHiddenTargetIDs(ctx, model.ReportTargetVideo, videoIDs)
+	if err != nil {
+		return list, visibilityCheckFailed(ctx, err, "Failed to check hidden videos")
+	}
+	if len(hidden) == 0 {
+		return list, nil
+	}
+
+	result := make([]*base.Video, 0, len(list))
+	for _, v := range list {
+		if v == nil {
+			continue
+		}
+		if _, ok := hidden[v.VideoId]; ok && v.UserId != viewerID {
+			continue
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
@@ -0,0 +1,304 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"HuaTug.com/cmd/video/dal/db"
+	"HuaTug.com/cmd/video/infras/client"
+	"HuaTug.com/cmd/video/infras/redis"
+	"HuaTug.com/kitex_gen/base"
+	"HuaTug.com/kitex_gen/videos"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/mq"
+	"HuaTug.com/pkg/sharding"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/pkg/errors"
+)
+
+// timelineCursorScope 关注流游标的标识
+const timelineCursorScope = "timeline"
+
+const (
+	// 粉丝数达到此值的作者发布时不写粉丝收件箱（推），由粉丝读取时拉取（拉）
+	timelineCelebrityFollowers = 10000
+	timelineFanOutBatch        = 1000 // 每次获取并写入的粉丝数
+	timelineMaxFollowees       = 1000 // 读取关注流时参与合并的关注数上限
+	timelineInboxSize          = 500  // 重建收件箱时读取的视频数，与收件箱上限一致
+	timelineBackfillCount      = 20   // 新关注一个作者时补入收件箱的近期视频数
+)
+
+type FollowingTimelineService struct {
+	ctx context.Context
+}
+
+func NewFollowingTimelineService(ctx context.Context) *FollowingTimelineService {
+	return &FollowingTimelineService{ctx: ctx}
+}
+
+// FollowingTimeline 合并收件箱中推送的视频和按关注关系拉取的大V视频，按(发布日期, 视频ID)倒序以游标翻页
+func (s *FollowingTimelineService) FollowingTimeline(req *videos.FollowingTimelineRequest) (video []*base.Video, nextCursor string, hasMore bool, err error) {
+	if req.UserId <= 0 {
+		return nil, "", false, errno.ParamErr
+	}
+	after, err := sharding.DecodeCursor[sharding.KeyCursor](timelineCursorScope, req.Cursor)
+	if err != nil {
+		return nil, "", false, errors.WithMessage(errno.ParamErr, err.Error())
+	}
+	limit := pageLimit(int64(req.Count))
+
+	followees, err := client.GetFollowingIDs(s.ctx, req.UserId, timelineMaxFollowees)
+	if err != nil {
+		return nil, "", false, errors.WithMessage(err, "client.GetFollowingIDs failed")
+	}
+	followees, err = s.excludeHiddenAuthors(req.UserId, followees)
+	if err != nil {
+		return nil, "", false, err
+	}
+	if len(followees) == 0 {
+		return []*base.Video{}, "", false, nil
+	}
+	following := make(map[int64]struct{}, len(followees))
+	for _, id := range followees {
+		following[id] = struct{}{}
+	}
+
+	celebrities, err := redis.FilterCelebrities(followees)
+	if err != nil {
+		hlog.CtxWarnf(s.ctx, "Failed to get celebrities: %v", err)
+	}
+	// 只在第一页重建，翻页过程中收件箱不会变化
+	if after == nil {
+		if err := s.ensureInbox(req.UserId, followees, celebrities); err != nil {
+			hlog.CtxWarnf(s.ctx, "Failed to rebuild timeline inbox of user %d: %v", req.UserId, err)
+		}
+	}
+
+	video, next, hasMore, err := visibleCursorPage(s.ctx, req.UserId, after, limit,
+		func(after *sharding.KeyCursor, count int) ([]*base.Video, *sharding.KeyCursor, bool, error) {
+			return s.readTimeline(req.UserId, followees, celebrities, following, after, count)
+		})
+	if err != nil {
+		return nil, "", false, err
+	}
+	if hasMore {
+		nextCursor = next.Encode(timelineCursorScope)
+	}
+	return video, nextCursor, hasMore, nil
+}
+
+// excludeHiddenAuthors 从关注列表中去掉与userID存在拉黑关系或被userID静音的作者，在读取收件箱和拉取前排除
+func (s *FollowingTimelineService) excludeHiddenAuthors(userID int64, followees []int64) ([]int64, error) {
+	if len(followees) == 0 {
+		return followees, nil
+	}
+	hidden, err := getHiddenUserIDs(s.ctx, userID, followees)
+	if err != nil {
+		return followees, visibilityCheckFailed(s.ctx, err, "Failed to check blocked followees of user %d", userID)
+	}
+	if len(hidden) == 0 {
+		return followees, nil
+	}
+	visible := make([]int64, 0, len(followees))
+	for _, id := range followees {
+		if _, ok := hidden[id]; !ok {
+			visible = append(visible, id)
+		}
+	}
+	return visible, nil
+}
+
+// readTimeline 读取after之后的至多count条关注流，返回视频、最后读取的位置以及之后是否还有更多
+func (s *FollowingTimelineService) readTimeline(userID int64, followees, celebrities []int64, following map[int64]struct{},
+	after *sharding.KeyCursor, count int) ([]*base.Video, *sharding.KeyCursor, bool, error) {
+	var afterEntry *redis.TimelineEntry
+	if after != nil {
+		afterEntry = &redis.TimelineEntry{CreatedAt: after.Key, VideoID: after.ID}
+	}
+	// 多读一条用于判断是否还有下一页
+	pullAuthors := celebrities
+	entries, err := redis.GetInbox(userID, afterEntry, int64(count+1))
+	if err != nil {
+		// 收件箱不可用时全部从数据库拉取
+		hlog.CtxWarnf(s.ctx, "Failed to read timeline inbox of user %d: %v", userID, err)
+		pullAuthors = followees
+	}
+	pulled, err := db.GetVideosByAuthorsByCursor(s.ctx, pullAuthors, after, count+1)
+	if err != nil {
+		return nil, nil, false, errors.WithMessage(err, "dao.GetVideosByAuthorsByCursor failed")
+	}
+
+	byID := make(map[int64]*base.Video, len(pulled))
+	for _, v := range pulled {
+		if _, ok := byID[v.VideoId]; !ok {
+			byID[v.VideoId] = v
+			entries = append(entries, redis.TimelineEntry{CreatedAt: v.CreatedAt, VideoID: v.VideoId})
+		}
+	}
+	entries = mergeTimelineEntries(entries)
+	var hasMore bool
+	if len(entries) > count {
+		entries, hasMore = entries[:count], true
+	}
+	var last *sharding.KeyCursor
+	if len(entries) > 0 {
+		e := entries[len(entries)-1]
+		last = &sharding.KeyCursor{Key: e.CreatedAt, ID: e.VideoID}
+	}
+
+	var missing []int64
+	for _, e := range entries {
+		if _, ok := byID[e.VideoID]; !ok {
+			missing = append(missing, e.VideoID)
+		}
+	}
+	if len(missing) > 0 {
+		found, err := db.GetVideoByVideoId(s.ctx, missing)
+		if err != nil {
+			return nil, nil, false, errors.WithMessage(err, "dao.GetVideoByVideoId failed")
+		}
+		for _, v := range found {
+			byID[v.VideoId] = v
+		}
+	}
+
+	video := make([]*base.Video, 0, len(entries))
+	for _, e := range entries {
+		v, ok := byID[e.VideoID]
+		if !ok {
+			continue // 视频已删除
+		}
+		// 取消关注的事件尚未处理时收件箱中可能还有该作者的视频
+		if _, ok := following[v.UserId]; !ok {
+			continue
+		}
+		video = append(video, v)
+	}
+	return video, last, hasMore, nil
+}
+
+// ensureInbox 收件箱不存在（新用户或长期未读过期）时用非大V关注者的近期视频重建
+func (s *FollowingTimelineService) ensureInbox(userID int64, followees, celebrities []int64) error {
+	exists, err := redis.InboxExists(userID)
+	if err != nil || exists {
+		return err
+	}
+	isCelebrity := make(map[int64]struct{}, len(celebrities))
+	for _, id := range celebrities {
+		isCelebrity[id] = struct{}{}
+	}
+	authors := make([]int64, 0, len(followees))
+	for _, id := range followees {
+		if _, ok := isCelebrity[id]; !ok {
+			authors = append(authors, id)
+		}
+	}
+	list, err := db.GetVideosByAuthorsByCursor(s.ctx, authors, nil, timelineInboxSize)
+	if err != nil {
+		return err
+	}
+	return redis.RebuildInbox(userID, timelineEntries(list))
+}
+
+// mergeTimelineEntries 去重并按(发布日期, 视频ID)倒序排列
+func mergeTimelineEntries(entries []redis.TimelineEntry) []redis.TimelineEntry {
+	seen := make(map[int64]struct{}, len(entries))
+	result := make([]redis.TimelineEntry, 0, len(entries))
+	for _, e := range entries {
+		if _, ok := seen[e.VideoID]; ok {
+			continue
+		}
+		seen[e.VideoID] = struct{}{}
+		result = append(result, e)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].CreatedAt != result[j].CreatedAt {
+			return result[i].CreatedAt > result[j].CreatedAt
+		}
+		return result[i].VideoID > result[j].VideoID
+	})
+	return result
+}
+
+func timelineEntries(list []*base.Video) []redis.TimelineEntry {
+	entries := make([]redis.TimelineEntry, 0, len(list))
+	for _, v := range list {
+		entries = append(entries, redis.TimelineEntry{CreatedAt: v.CreatedAt, VideoID: v.VideoId})
+	}
+	return entries
+}
+
+// TimelineEventHandler 处理视频发布和关注关系变更，维护粉丝的关注流收件箱
+type TimelineEventHandler struct{}
+
+func NewTimelineEventHandler() *TimelineEventHandler {
+	return &TimelineEventHandler{}
+}
+
+// HandleVideoPublished 把新视频写入作者粉丝的收件箱，粉丝数达到阈值的作者改为读取时拉取；重复处理是幂等的
+func (h *TimelineEventHandler) HandleVideoPublished(ctx context.Context, event *mq.VideoPublishedEvent) error {
+	if event.VideoID <= 0 || event.AuthorID <= 0 || event.CreatedAt == "" {
+		hlog.CtxWarnf(ctx, "Drop invalid video published event: %+v", event)
+		return nil
+	}
+
+	followers, hasMore, total, err := client.GetFollowerIDs(ctx, event.AuthorID, 0, timelineFanOutBatch)
+	if err != nil {
+		return fmt.Errorf("failed to get followers of %d: %w", event.AuthorID, err)
+	}
+	// total与分批推送的粉丝集合同一口径，阈值判断的就是实际的推送规模
+	celebrity := total >= timelineCelebrityFollowers
+	if err := redis.SetCelebrity(event.AuthorID, celebrity); err != nil {
+		return err
+	}
+	if celebrity {
+		return nil
+	}
+
+	entry := redis.TimelineEntry{CreatedAt: event.CreatedAt, VideoID: event.VideoID}
+	for len(followers) > 0 {
+		if _, err := redis.FanOutToInboxes(followers, entry); err != nil {
+			return fmt.Errorf("failed to fan out video %d: %w", event.VideoID, err)
+		}
+		if !hasMore {
+			break
+		}
+		if followers, hasMore, _, err = client.GetFollowerIDs(ctx, event.AuthorID, followers[len(followers)-1], timelineFanOutBatch); err != nil {
+			return fmt.Errorf("failed to get followers of %d: %w", event.AuthorID, err)
+		}
+	}
+	return nil
+}
+
+// HandleFollowEvent 关注时把作者的近期视频补入收件箱，取消关注时移除；收件箱不存在时等读取时重建
+func (h *TimelineEventHandler) HandleFollowEvent(ctx context.Context, event *mq.FollowEvent) error {
+	exists, err := redis.InboxExists(event.FollowerID)
+	if err != nil || !exists {
+		return err
+	}
+
+	switch event.Action {
+	case mq.FollowActionFollow:
+		// 大V的视频在读取时拉取
+		celebrity, err := redis.IsCelebrity(event.FolloweeID)
+		if err != nil || celebrity {
+			return err
+		}
+		list, err := db.GetVideosByAuthorsByCursor(ctx, []int64{event.FolloweeID}, nil, timelineBackfillCount)
+		if err != nil {
+			return err
+		}
+		return redis.AddToInbox(event.FollowerID, timelineEntries(list))
+	case mq.FollowActionUnfollow:
+		// 作者成为大V之前推送的视频也需要移除
+		list, err := db.GetVideosByAuthorsByCursor(ctx, []int64{event.FolloweeID}, nil, timelineInboxSize)
+		if err != nil {
+			return err
+		}
+		return redis.RemoveFromInbox(event.FollowerID, timelineEntries(list))
+	default:
+		hlog.CtxWarnf(ctx, "Drop follow event with unknown action: %+v", event)
+		return nil
+	}
+}
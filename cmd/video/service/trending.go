@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"HuaTug.com/cmd/video/dal/db"
+	"HuaTug.com/cmd/video/infras/redis"
+	"HuaTug.com/kitex_gen/base"
+	"HuaTug.com/pkg/constants"
+	"HuaTug.com/pkg/mq"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// 各种互动计入热度的分数，取消点赞抵消之前的点赞；播放量远大于其他互动，单次分数较低
+var trendingPoints = map[string]float64{
+	"like":               1,
+	"unlike":             -1,
+	mq.EngagementComment: 2,
+	mq.EngagementShare:   3,
+	mq.EngagementWatch:   0.2,
+}
+
+const (
+	// 热度在事件去重标记中的消费者名
+	trendingConsumer = "trending"
+	// 重力衰减：热度 = 窗口内互动分数 / (发布小时数 + trendingAgeOffset) ^ trendingGravity
+	trendingGravity   = 1.8
+	trendingAgeOffset = 2
+	// 每个榜单参与衰减排序的候选数和保留的视频数
+	trendingCandidateLimit = 2000
+	trendingBoardSize      = 1000
+	// 榜单在重算停止后的保留时间，过期后读取方回退到按点赞数排序
+	trendingBoardTTL = 10 * time.Minute
+)
+
+// TrendingEventHandler 把点赞、评论、分享和播放事件累加到视频所在小时和天的热度计数中
+type TrendingEventHandler struct{}
+
+func NewTrendingEventHandler() *TrendingEventHandler {
+	return &TrendingEventHandler{}
+}
+
+// HandleLikeEvent 处理interaction服务发布的点赞事件，评论点赞不计入视频热度
+func (h *TrendingEventHandler) HandleLikeEvent(ctx context.Context, event *mq.LikeEvent) error {
+	if event.EventType != "video_like" {
+		return nil
+	}
+	return h.apply(ctx, event.EventID, event.VideoID, event.Category, event.ActionType, event.Timestamp)
+}
+
+// HandleEngagementEvent 处理评论、分享和播放事件，包括未登录用户的播放
+func (h *TrendingEventHandler) HandleEngagementEvent(ctx context.Context, event *mq.VideoEngagementEvent) error {
+	return h.apply(ctx, event.EventID, event.VideoID, event.Category, event.Action, event.Timestamp)
+}
+
+// apply 按事件携带的分类计入热度，不再逐条查询视频；没有分类的事件只计入全站榜单，
+// 已删除视频的分数在重算榜单时被丢弃
+func (h *TrendingEventHandler) apply(ctx context.Context, eventID string, videoID int64, category, action string, timestamp int64) error {
+	points, ok := trendingPoints[action]
+	if !ok || videoID <= 0 {
+		hlog.CtxWarnf(ctx, "Drop invalid trending event: video %d, action %q", videoID, action)
+		return nil
+	}
+
+	// 计入事件发生时所在的时间桶，消费积压时不会把旧互动算作最近的
+	at := time.Now()
+	if timestamp > 0 && timestamp < at.Unix() {
+		at = time.Unix(timestamp, 0)
+	}
+	return mq.Dedupe(ctx, redis.VideoInfoClient(), trendingConsumer, eventID, func() error {
+		if err := redis.AddTrendingPoints(videoID, category, points, at); err != nil {
+			return fmt.Errorf("failed to add trending points of video %d: %w", videoID, err)
+		}
+		return nil
+	})
+}
+
+// RefreshTrending 按重力衰减重算全站和各分类在每个时间窗口的榜单
+func RefreshTrending(ctx context.Context, now time.Time) error {
+	categories, err := redis.GetTrendingCategories()
+	if err != nil {
+		return fmt.Errorf("failed to get trending categories: %w", err)
+	}
+	scopes := append([]string{""}, categories...)
+	for _, window := range redis.TrendingWindows {
+		for _, category := range scopes {
+			if err := refreshTrendingBoard(ctx, window, category, now); err != nil {
+				return fmt.Errorf("failed to refresh %s trending board of %q: %w", window, category, err)
+			}
+		}
+	}
+	return redis.SaveTrendingUpdatedAt(now)
+}
+
+func refreshTrendingBoard(ctx context.Context, window redis.TrendingWindow, category string, now time.Time) error {
+	candidates, err := redis.TopTrendingPoints(window, category, now, trendingCandidateLimit)
+	if err != nil {
+		return err
+	}
+	ids := make([]int64, 0, len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.VideoID)
+	}
+	var list []*base.Video
+	if len(ids) > 0 {
+		if list, err = db.GetVideoByVideoId(ctx, ids); err != nil {
+			return err
+		}
+	}
+	createdAt := make(map[int64]string, len(list))
+	for _, v := range list {
+		createdAt[v.VideoId] = v.CreatedAt
+	}
+
+	// 已删除的视频不再上榜
+	board := make([]redis.TrendingVideo, 0, len(candidates))
+	for _, c := range candidates {
+		created, ok := createdAt[c.VideoID]
+		if !ok {
+			continue
+		}
+		board = append(board, redis.TrendingVideo{VideoID: c.VideoID, Score: gravityScore(c.Score, created, now)})
+	}
+	sort.SliceStable(board, func(i, j int) bool {
+		return board[i].Score > board[j].Score
+	})
+	if len(board) > trendingBoardSize {
+		board = board[:trendingBoardSize]
+	}
+	return redis.SaveTrendingBoard(window, category, board, trendingBoardTTL)
+}
+
+// gravityScore 互动分数随视频发布时长按幂次衰减，新视频少量互动即可超过互动多但发布已久的视频；
+// 发布时间只精确到天，按当天零点计算
+func gravityScore(points float64, createdAt string, now time.Time) float64 {
+	hours := 0.0
+	if created, err := time.ParseInLocation(constants.DataFormate, createdAt, time.Local); err == nil {
+		hours = math.Max(now.Sub(created).Hours(), 0)
+	}
+	return points / math.Pow(hours+trendingAgeOffset, trendingGravity)
+}
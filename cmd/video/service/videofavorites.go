@@ -9,9 +9,15 @@ import (
 	"HuaTug.com/kitex_gen/base"
 	"HuaTug.com/kitex_gen/videos"
 	"HuaTug.com/pkg/constants"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/idgen"
+	"HuaTug.com/pkg/sharding"
 	"github.com/pkg/errors"
 )
 
+// favoriteVideoCursorScope 收藏夹视频列表游标的标识
+const favoriteVideoCursorScope = "favorite_videos"
+
 type VideoFavoritesService struct {
 	ctx context.Context
 }
@@ -23,7 +29,12 @@ func NewVideoFavoritesService(ctx context.Context) *VideoFavoritesService {
 }
 
 func (s *VideoFavoritesService) CreateFavorite(req *videos.CreateFavoriteRequestV2) error {
+	favoriteId, err := idgen.NextID(s.ctx, idgen.BizFavorite)
+	if err != nil {
+		return errors.WithMessage(err, "Failed to generate FavoriteId")
+	}
 	if err := db.CreateFavorite(s.ctx, &base.Favorite{
+		FavoriteId:  favoriteId,
 		UserId:      req.UserId,
 		Name:        req.Name,
 		Description: req.Description,
@@ -45,6 +56,11 @@ func (s *VideoFavoritesService) GetFavoriteList(req *videos.GetFavoriteListReque
 	return favList, nil
 }
 
+// CheckFavoriteVisibility 检查查看者能否查看用户的收藏夹，用户开启"隐藏点赞"后其他人无权查看
+func (s *VideoFavoritesService) CheckFavoriteVisibility(req *videos.GetFavoriteVideoListRequestV2) error {
+	return checkLikesVisibility(s.ctx, req.UserId, req.ViewerId)
+}
+
 func (s *VideoFavoritesService) GetFavoriteVideoList(req *videos.GetFavoriteVideoListRequestV2) ([]*base.Video, error) {
 	var video []*base.Video
 	video, err := db.GetFavoriteVideoList(s.ctx, req)
@@ -54,6 +70,22 @@ func (s *VideoFavoritesService) GetFavoriteVideoList(req *videos.GetFavoriteVide
 	return video, nil
 }
 
+// GetFavoriteVideoListByCursor 按加入收藏夹的先后倒序以游标翻页获取收藏夹中的视频
+func (s *VideoFavoritesService) GetFavoriteVideoListByCursor(req *videos.GetFavoriteVideoListRequestV2) (video []*base.Video, nextCursor string, hasMore bool, err error) {
+	after, err := sharding.DecodeCursor[sharding.KeyCursor](favoriteVideoCursorScope, req.Cursor)
+	if err != nil {
+		return nil, "", false, errors.WithMessage(errno.ParamErr, err.Error())
+	}
+	video, next, err := db.GetFavoriteVideoListByCursor(s.ctx, req.UserId, req.FavoriteId, after, pageLimit(req.PageSize))
+	if err != nil {
+		return video, "", false, errors.WithMessage(err, "Failed to get FavoriteVideoList")
+	}
+	if next > 0 {
+		nextCursor, hasMore = sharding.KeyCursor{ID: next}.Encode(favoriteVideoCursorScope), true
+	}
+	return video, nextCursor, hasMore, nil
+}
+
 // NOTE: This function might not be needed in V2 API as there's no specific request type for getting a single video from favorites
 // Consider using GetFavoriteVideoListRequestV2 to get the list and filter on the client side
 // func (s *VideoFavoritesService) GetVideoFromFavorite(req *videos.GetFavoriteVideoListRequestV2) (*base.Video, error) {
@@ -66,10 +98,15 @@ func (s *VideoFavoritesService) GetFavoriteVideoList(req *videos.GetFavoriteVide
 // }
 
 func (s *VideoFavoritesService) AddFavoriteVideo(req *videos.AddFavoriteVideoRequestV2) error {
+	favoriteVideoId, err := idgen.NextID(s.ctx, idgen.BizFavoriteItem)
+	if err != nil {
+		return errors.WithMessage(err, "Failed to generate FavoriteVideoId")
+	}
 	if err := db.AddVideoToFavorite(s.ctx, &model.FavoritesVideos{
-		UserId:     req.UserId,
-		FavoriteId: req.FavoriteId,
-		VideoId:    req.VideoId,
+		FavoriteVideoId: favoriteVideoId,
+		UserId:          req.UserId,
+		FavoriteId:      req.FavoriteId,
+		VideoId:         req.VideoId,
 	}); err != nil {
 		return errors.WithMessage(err, "Failed to AddFavoriteVideo")
 	}
@@ -6,9 +6,15 @@ import (
 	"HuaTug.com/cmd/video/dal/db"
 	"HuaTug.com/kitex_gen/base"
 	"HuaTug.com/kitex_gen/videos"
+	"HuaTug.com/pkg/constants"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/sharding"
 	"github.com/pkg/errors"
 )
 
+// videoListCursorScope 用户视频列表游标的标识
+const videoListCursorScope = "videos"
+
 type VideoListService struct {
 	ctx context.Context
 }
@@ -17,11 +23,73 @@ func NewVideoListService(ctx context.Context) *VideoListService {
 	return &VideoListService{ctx: ctx}
 }
 
+// VideoList 按页获取用户发布的视频，先过滤被隐藏的视频再分页，count为过滤后的总数
 func (v *VideoListService) VideoList(req *videos.VideoFeedListRequestV2) (video []*base.Video, count int64, err error) {
-	if video, count, err = db.Videolist(v.ctx, req); err != nil {
-		return video, count, errors.WithMessage(err, "dao.VideoList failed")
+	if hidden, err := isAuthorHidden(v.ctx, req.ViewerId, req.UserId); err != nil || hidden {
+		return []*base.Video{}, 0, err
+	}
+	pageNum := req.PageNum
+	if pageNum <= 0 {
+		pageNum = 1
+	}
+	limit := int64(pageLimit(req.PageSize))
+
+	var total, skipped int64
+	video, skipped, err = visiblePage(v.ctx, req.ViewerId, (pageNum-1)*limit, limit, func(start, count int64) ([]*base.Video, int64, error) {
+		list, n, err := db.Videolist(v.ctx, req.UserId, int(start), int(count))
+		if err != nil {
+			return nil, 0, errors.WithMessage(err, "dao.VideoList failed")
+		}
+		total = n
+		return list, int64(len(list)), nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return video, total - skipped, nil
+}
+
+// VideoListByCursor 按(created_at, video_id)倒序以游标翻页获取用户发布的视频，翻页代价与页码无关
+func (v *VideoListService) VideoListByCursor(req *videos.VideoFeedListRequestV2) (video []*base.Video, nextCursor string, hasMore bool, err error) {
+	after, err := sharding.DecodeCursor[sharding.KeyCursor](videoListCursorScope, req.Cursor)
+	if err != nil {
+		return nil, "", false, errors.WithMessage(errno.ParamErr, err.Error())
+	}
+	if hidden, err := isAuthorHidden(v.ctx, req.ViewerId, req.UserId); err != nil || hidden {
+		return []*base.Video{}, "", false, err
+	}
+
+	video, next, hasMore, err := visibleCursorPage(v.ctx, req.ViewerId, after, pageLimit(req.PageSize),
+		func(after *sharding.KeyCursor, count int) ([]*base.Video, *sharding.KeyCursor, bool, error) {
+			// 多读一条用于判断是否还有下一页
+			list, err := db.VideolistByCursor(v.ctx, req.UserId, after, count+1)
+			if err != nil {
+				return nil, nil, false, errors.WithMessage(err, "dao.VideolistByCursor failed")
+			}
+			more := len(list) > count
+			if more {
+				list = list[:count]
+			}
+			return list, lastVideoCursor(list), more, nil
+		})
+	if err != nil {
+		return nil, "", false, err
+	}
+	if hasMore {
+		nextCursor = next.Encode(videoListCursorScope)
+	}
+	return video, nextCursor, hasMore, nil
+}
+
+// pageLimit 游标分页的每页条数，未指定时使用默认值并限制上限
+func pageLimit(pageSize int64) int {
+	if pageSize <= 0 {
+		return constants.DefaultLimit
+	}
+	if pageSize > constants.MaxLimit {
+		return constants.MaxLimit
 	}
-	return video, count, err
+	return int(pageSize)
 }
 
 func (v *VideoListService) VideoInfo(req *videos.VideoInfoRequestV2) (data *base.Video, err error) {
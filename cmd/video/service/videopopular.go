@@ -2,16 +2,31 @@ package service
 
 import (
 	"context"
-	"strconv"
+	"fmt"
+	"time"
 
 	"HuaTug.com/cmd/video/dal/db"
-	"HuaTug.com/config/cache"
+	"HuaTug.com/cmd/video/infras/redis"
 	"HuaTug.com/kitex_gen/base"
 	"HuaTug.com/kitex_gen/videos"
+	"HuaTug.com/pkg/constants"
+	"HuaTug.com/pkg/errno"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/pkg/errors"
 )
 
+const (
+	rankingGravity = "gravity" // 按重力衰减后的互动热度排序
+	rankingLikes   = "likes"   // 榜单不可用时按窗口内发布视频的点赞数排序
+)
+
+// 回退排序时各时间窗口只取此后发布的视频
+var popularFallbackDays = map[redis.TrendingWindow]int{
+	redis.TrendingHour: 1,
+	redis.TrendingDay:  1,
+	redis.TrendingWeek: 7,
+}
+
 type VideoPopularService struct {
 	ctx context.Context
 }
@@ -20,23 +35,77 @@ func NewVideoPopularService(ctx context.Context) *VideoPopularService {
 	return &VideoPopularService{ctx: ctx}
 }
 
-func (v *VideoPopularService) VideoPopular(req *videos.VideoPopularRequestV2) (video []*base.Video, err error) {
-	res, err := cache.RangeList("Rank")
+// VideoPopular 按页获取全站或某个分类在时间窗口(hour/day/week，默认day)内的热门视频
+func (v *VideoPopularService) VideoPopular(req *videos.VideoPopularRequestV2) (*videos.VideoPopularResponseV2, error) {
+	window := redis.TrendingDay
+	if req.TimeRange != "" {
+		window = redis.TrendingWindow(req.TimeRange)
+		if _, ok := popularFallbackDays[window]; !ok {
+			return nil, fmt.Errorf("unknown time range %q: %w", req.TimeRange, errno.ParamErr)
+		}
+	}
+	if req.PageNum < 0 {
+		return nil, fmt.Errorf("invalid page num %d: %w", req.PageNum, errno.ParamErr)
+	}
+	pageNum := req.PageNum
+	if pageNum == 0 {
+		pageNum = 1
+	}
+	limit := int64(pageLimit(req.PageSize))
+	offset := (pageNum - 1) * limit
+
+	total, err := redis.GetTrendingBoardSize(window, req.Category)
 	if err != nil {
-		hlog.Info(err)
-		return
+		hlog.CtxWarnf(v.ctx, "Failed to read %s trending board: %v", window, err)
+	}
+	if err != nil || total == 0 {
+		return v.popularByLikes(window, req.Category, req.ViewerId, offset, limit)
 	}
-	var temp *base.Video
-	for i := 0; i < len(res); i++ {
-		s, err := strconv.Atoi(res[i])
+
+	resp := &videos.VideoPopularResponseV2{RankingAlgorithm: rankingGravity}
+	if updatedAt, err := redis.GetTrendingUpdatedAt(); err == nil && !updatedAt.IsZero() {
+		resp.UpdatedAt = updatedAt.Format(time.DateTime)
+	}
+	resp.Popular, _, err = visiblePage(v.ctx, req.ViewerId, offset, limit, func(start, count int64) ([]*base.Video, int64, error) {
+		ids, _, err := redis.GetTrendingBoard(window, req.Category, start, count)
+		if err != nil || len(ids) == 0 {
+			return nil, 0, err
+		}
+		list, err := db.GetVideoByVideoId(v.ctx, ids)
 		if err != nil {
-			return video, errors.WithMessage(err, "Convert failed")
+			return nil, 0, errors.WithMessage(err, "dao.GetVideoByVideoId failed")
 		}
-		temp, err = db.FindVideo(v.ctx, int64(s))
+		return orderByIDs(list, ids), int64(len(ids)), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// popularByLikes 榜单尚未生成或Redis不可用时，按窗口内发布视频的点赞数排序
+func (v *VideoPopularService) popularByLikes(window redis.TrendingWindow, category string, viewerID, offset, limit int64) (*videos.VideoPopularResponseV2, error) {
+	var categories []string
+	if category != "" {
+		categories = []string{category}
+	}
+	since := time.Now().AddDate(0, 0, -popularFallbackDays[window]).Format(constants.DataFormate)
+	popular, _, err := visiblePage(v.ctx, viewerID, offset, limit, func(start, count int64) ([]*base.Video, int64, error) {
+		list, err := db.GetTrendingVideos(v.ctx, categories, since, int(start+count))
 		if err != nil {
-			return nil, errors.WithMessage(err, "dao.FindVideo failed")
+			return nil, 0, errors.WithMessage(err, "dao.GetTrendingVideos failed")
 		}
-		video = append(video, temp)
+		if start >= int64(len(list)) {
+			return nil, 0, nil
+		}
+		return list[start:], int64(len(list)) - start, nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	return video, nil
+	return &videos.VideoPopularResponseV2{
+		Popular:          popular,
+		RankingAlgorithm: rankingLikes,
+		UpdatedAt:        time.Now().Format(time.DateTime),
+	}, nil
 }
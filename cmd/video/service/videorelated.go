@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"HuaTug.com/cmd/video/dal/db"
+	"HuaTug.com/cmd/video/infras/elasticsearch"
+	"HuaTug.com/cmd/video/infras/redis"
+	"HuaTug.com/kitex_gen/base"
+	"HuaTug.com/kitex_gen/videos"
+	"HuaTug.com/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/pkg/errors"
+)
+
+// 相似视频的来源
+const (
+	RelatedSourceItemCF = "item_cf" // 离线任务按共同互动计算的相似视频
+	RelatedSourceTags   = "tags"    // 同分类或同标签的热门视频
+	RelatedSourceSearch = "search"  // 标题和描述相似的视频
+)
+
+const (
+	relatedDefaultCount = 10
+	relatedMaxCount     = 50
+	// 每个来源多取一些，留出过滤拉黑作者和重复视频的余量
+	relatedOverfetch = 2
+)
+
+type RelatedVideosService struct {
+	ctx context.Context
+}
+
+func NewRelatedVideosService(ctx context.Context) *RelatedVideosService {
+	return &RelatedVideosService{ctx: ctx}
+}
+
+// RelatedVideos 优先返回离线协同过滤的相似视频，不足时依次用同标签和搜索引擎的结果补齐
+func (s *RelatedVideosService) RelatedVideos(req *videos.RelatedVideosRequest) (*videos.RelatedVideosResponse, error) {
+	if req.VideoId <= 0 {
+		return nil, errno.ParamErr
+	}
+	count := int(req.Count)
+	if count <= 0 {
+		count = relatedDefaultCount
+	}
+	if count > relatedMaxCount {
+		count = relatedMaxCount
+	}
+
+	video, err := db.GetVideo(s.ctx, req.VideoId)
+	if err != nil {
+		return nil, errors.WithMessage(err, "dao.GetVideo failed")
+	}
+	if video.VideoId == 0 {
+		return nil, fmt.Errorf("video %d not found: %w", req.VideoId, errno.ParamErr)
+	}
+
+	result := make([]*base.Video, 0, count)
+	seen := map[int64]struct{}{req.VideoId: {}}
+	var sources []string
+	add := func(source string, list []*base.Video) error {
+		list, err := filterVisibleVideos(s.ctx, req.UserId, list)
+		if err != nil {
+			return err
+		}
+		added := false
+		for _, v := range list {
+			if len(result) == count {
+				break
+			}
+			if _, ok := seen[v.VideoId]; ok {
+				continue
+			}
+			seen[v.VideoId] = struct{}{}
+			result = append(result, v)
+			added = true
+		}
+		if added {
+			sources = append(sources, source)
+		}
+		return nil
+	}
+	limit := count * relatedOverfetch
+
+	ids, err := redis.GetRelatedVideoIDs(req.VideoId, int64(limit))
+	if err != nil {
+		hlog.CtxWarnf(s.ctx, "Failed to get related videos of %d: %v", req.VideoId, err)
+	}
+	if len(ids) > 0 {
+		found, err := db.GetVideoByVideoId(s.ctx, ids)
+		if err != nil {
+			hlog.CtxWarnf(s.ctx, "Failed to get related videos of %d: %v", req.VideoId, err)
+		}
+		if err := add(RelatedSourceItemCF, orderByIDs(found, ids)); err != nil {
+			return nil, err
+		}
+	}
+
+	// 冷启动视频没有足够的共同互动
+	if len(result) < count {
+		var categories []string
+		if video.Category != "" {
+			categories = []string{video.Category}
+		}
+		tagged, err := db.GetRecentVideosByTags(s.ctx, categories, splitLabels(video.LabelNames), "", limit)
+		if err != nil {
+			hlog.CtxWarnf(s.ctx, "Failed to get videos with the same tags as %d: %v", req.VideoId, err)
+		}
+		if err := add(RelatedSourceTags, tagged); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(result) < count && elasticsearch.Loaded() {
+		similar, err := elasticsearch.SearchSimilarVideoDoc(s.ctx, video.Title+" "+video.Description, strconv.FormatInt(req.VideoId, 10), limit)
+		if err != nil {
+			hlog.CtxWarnf(s.ctx, "Failed to search videos similar to %d: %v", req.VideoId, err)
+		}
+		if err := add(RelatedSourceSearch, similar); err != nil {
+			return nil, err
+		}
+	}
+
+	return &videos.RelatedVideosResponse{
+		VideoList: result,
+		Source:    strings.Join(sources, "+"),
+	}, nil
+}
+
+// orderByIDs 按ids的顺序排列list，丢弃ids中已不存在的视频
+func orderByIDs(list []*base.Video, ids []int64) []*base.Video {
+	byID := make(map[int64]*base.Video, len(list))
+	for _, v := range list {
+		byID[v.VideoId] = v
+	}
+	result := make([]*base.Video, 0, len(list))
+	for _, id := range ids {
+		if v, ok := byID[id]; ok {
+			result = append(result, v)
+		}
+	}
+	return result
+}
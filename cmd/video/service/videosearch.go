@@ -18,10 +18,26 @@ func NewVideoSearchService(ctx context.Context) *VideoSearchService {
 	return &VideoSearchService{ctx: ctx}
 }
 
+// VideoSearch 按页搜索视频，先过滤被隐藏和拉黑作者的视频再分页，count为匹配总数减去已读部分中被过滤的条数
 func (v *VideoSearchService) VideoSearch(req *videos.VideoSearchRequestV2) (video []*base.Video, count int64, err error) {
-	if video, count, err = db.Videosearch(v.ctx, req); err != nil {
-		hlog.Info(err)
-		return video, count, errors.WithMessage(err, "dao.VideoSearch failed")
+	pageNum := req.PageNum
+	if pageNum <= 0 {
+		pageNum = 1
 	}
-	return video, count, err
+	limit := int64(pageLimit(req.PageSize))
+
+	var total, skipped int64
+	video, skipped, err = visiblePage(v.ctx, req.ViewerId, (pageNum-1)*limit, limit, func(start, count int64) ([]*base.Video, int64, error) {
+		list, n, err := db.Videosearch(v.ctx, req, int(start), int(count))
+		if err != nil {
+			hlog.Info(err)
+			return nil, 0, errors.WithMessage(err, "dao.VideoSearch failed")
+		}
+		total = n
+		return list, int64(len(list)), nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return video, total - skipped, nil
 }
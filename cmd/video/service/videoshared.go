@@ -11,9 +11,11 @@ import (
 	"HuaTug.com/cmd/video/infras/client"
 	"HuaTug.com/cmd/video/infras/redis"
 
+	"HuaTug.com/kitex_gen/base"
 	"HuaTug.com/kitex_gen/users"
 	"HuaTug.com/kitex_gen/videos"
 	"HuaTug.com/pkg/constants"
+	"HuaTug.com/pkg/mq"
 	"github.com/pkg/errors"
 )
 
@@ -41,6 +43,7 @@ func (s *SharedVideoService) SharedVideo(req *videos.SharedVideoRequestV2) error
 		userExistsCh <- true
 	}()
 
+	var video *base.Video
 	go func() {
 		// 检查视频是否存在
 		temp, err := db.GetVideo(s.ctx, req.VideoId)
@@ -48,6 +51,7 @@ func (s *SharedVideoService) SharedVideo(req *videos.SharedVideoRequestV2) error
 			videoExistsCh <- false
 			return
 		}
+		video = temp
 		videoExistsCh <- (temp != nil)
 	}()
 	// 等待两个 goroutine 完成  用于阻塞操作
@@ -78,5 +82,6 @@ func (s *SharedVideoService) SharedVideo(req *videos.SharedVideoRequestV2) error
 	}
 	go redis.IncrVideoShareInfo(fmt.Sprint(req.VideoId))
 	go db.AddUserShareBehavior(s.ctx, share)
+	publishEngagementEvent(s.ctx, req.UserId, video, mq.EngagementShare)
 	return nil
 }
@@ -9,14 +9,12 @@ import (
 
 	"strconv"
 
-	"HuaTug.com/kitex_gen/base"
+	"HuaTug.com/cmd/video/dal/db"
 	"HuaTug.com/kitex_gen/videos"
 	"HuaTug.com/pkg/oss"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 )
 
-var VideoFiles []*base.Video
-
 type StreamVideoService struct {
 	ctx context.Context
 }
@@ -24,43 +22,33 @@ type StreamVideoService struct {
 func NewStreamVideoService(ctx context.Context) *StreamVideoService {
 	return &StreamVideoService{ctx: ctx}
 }
-func (s *StreamVideoService) VideoStream(req *videos.StreamVideoRequestV2) (string, error) {
 
-	//db.UpdateVideoUrl(s.ctx, url, "", fmt.Sprint(VideoFiles[index].VideoId))
+// VideoStream 返回视频文件的本地路径，req.VideoId为视频ID
+func (s *StreamVideoService) VideoStream(req *videos.StreamVideoRequestV2) (string, error) {
 	if req.VideoId == "" {
 		return "", fmt.Errorf("Missing video ID")
 	}
-
-	// Convert video ID to index for now - this may need to be updated based on your logic
-	index, err := strconv.Atoi(req.VideoId)
+	vid, err := strconv.ParseInt(req.VideoId, 10, 64)
 	if err != nil {
-		hlog.Info(err)
+		return "", fmt.Errorf("invalid video ID %q", req.VideoId)
 	}
-
-	if err != nil || index < 0 || index > len(VideoFiles) {
-		return "", fmt.Errorf("invalid video index")
-	}
-	VideoFiles, err = NewFeedListService(s.ctx).FeedList(&videos.VideoFeedListRequestV2{
-		PageNum:  1,
-		PageSize: 10,
-	})
-	hlog.Info(VideoFiles)
+	video, err := db.GetVideo(s.ctx, vid)
 	if err != nil {
-		hlog.Info(err)
+		return "", err
+	}
+	if video.VideoId == 0 {
+		return "", fmt.Errorf("video %d not found", vid)
 	}
 
-	hlog.Info(index)
-	hlog.Info(VideoFiles)
 	//通过这个预签名的url，可以来访问minio中的视频文件
-	url, err := oss.GeneratePreUrl("video", "video/"+fmt.Sprint(VideoFiles[index].VideoId)+"/video.mp4", fmt.Sprint(VideoFiles[index].VideoId))
+	url, err := oss.GeneratePreUrl("video", "video/"+fmt.Sprint(video.VideoId)+"/video.mp4", fmt.Sprint(video.VideoId))
 	if err != nil {
 		hlog.Info(err)
 	}
 
 	hlog.Info(url)
 
-	videoFilePath := "../../Download_video/videos" + fmt.Sprint(VideoFiles[index].VideoId) + ".mp4"
-	//videoFile, err := os.Open(VideoFiles[index].VideoUrl)
+	videoFilePath := "../../Download_video/videos" + fmt.Sprint(video.VideoId) + ".mp4"
 	videoFile, err := os.Open(videoFilePath)
 	if err != nil {
 		return "", fmt.Errorf("failed to open video file: %v", err)
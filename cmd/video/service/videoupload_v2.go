@@ -13,13 +13,17 @@ import (
 	"time"
 
 	"HuaTug.com/cmd/video/dal/db"
+	"HuaTug.com/cmd/video/infras/rabbitmq"
 	"HuaTug.com/cmd/video/infras/redis"
 	"HuaTug.com/kitex_gen/base"
 	"HuaTug.com/kitex_gen/videos"
 	"HuaTug.com/pkg/constants"
 	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/idgen"
+	"HuaTug.com/pkg/mq"
 	"HuaTug.com/pkg/oss"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 )
 
@@ -79,7 +83,7 @@ func (s *VideoUploadServiceV2) StartUpload(req *videos.VideoPublishStartRequestV
 	}
 
 	// 3. 生成video_id
-	videoID, err := db.GetMaxVideoId(s.ctx)
+	videoID, err := idgen.NextID(s.ctx, idgen.BizVideo)
 	if err != nil {
 		hlog.Errorf("Failed to generate video_id for user %d: %v", req.UserId, err)
 		return nil, fmt.Errorf("failed to generate video_id: %w", err)
@@ -91,7 +95,7 @@ func (s *VideoUploadServiceV2) StartUpload(req *videos.VideoPublishStartRequestV
 
 	// 5. 初始化MinIO分片上传
 	bucketName := oss.BUCKET_USER_CONTENT
-	objectName := s.tikTokStorage.GenerateVideoObjectName(req.UserId, parseVideoID(videoID))
+	objectName := s.tikTokStorage.GenerateVideoObjectName(req.UserId, videoID)
 	contentType := "video/mp4"
 
 	hlog.Infof("Initializing MinIO multipart upload: bucket=%s, object=%s", bucketName, objectName)
@@ -107,7 +111,7 @@ func (s *VideoUploadServiceV2) StartUpload(req *videos.VideoPublishStartRequestV
 	session := &UploadSession{
 		UUID:           genUUID,
 		UserID:         req.UserId,
-		VideoID:        parseVideoID(videoID),
+		VideoID:        videoID,
 		Title:          req.Title,
 		Description:    req.Description,
 		Category:       req.Category,
@@ -422,6 +426,10 @@ func (s *VideoUploadServiceV2) CompleteUpload(req *videos.VideoPublishCompleteRe
 		hlog.Warnf("Failed to update user storage usage for session %s: %v", session.UUID, err)
 	}
 
+	// 通知user服务更新作品数，并写入粉丝的关注流
+	s.publishVideoCountEvent(session.UserID, 1)
+	s.publishVideoPublishedEvent(video)
+
 	// 8. 清理临时文件和会话
 	session.Status = "completed"
 	s.saveUploadSession(session)
@@ -435,6 +443,35 @@ func (s *VideoUploadServiceV2) CompleteUpload(req *videos.VideoPublishCompleteRe
 	return nil
 }
 
+// publishVideoCountEvent 发布作者作品数变更事件，失败不影响上传结果
+func (s *VideoUploadServiceV2) publishVideoCountEvent(userID, delta int64) {
+	event := &mq.UserStatsEvent{
+		UserID:    userID,
+		StatType:  mq.StatTypeVideoCount,
+		Delta:     delta,
+		Source:    "video",
+		Timestamp: time.Now().Unix(),
+		EventID:   uuid.New().String(),
+	}
+	if err := mq.Publish(s.ctx, rabbitmq.Bus, event); err != nil {
+		hlog.Warnf("Failed to publish video count event for user %d: %v", userID, err)
+	}
+}
+
+// publishVideoPublishedEvent 发布视频发布事件，失败时粉丝只能在收件箱重建后看到该视频
+func (s *VideoUploadServiceV2) publishVideoPublishedEvent(video *base.Video) {
+	event := &mq.VideoPublishedEvent{
+		VideoID:   video.VideoId,
+		AuthorID:  video.UserId,
+		CreatedAt: video.CreatedAt,
+		Timestamp: time.Now().Unix(),
+		EventID:   uuid.New().String(),
+	}
+	if err := mq.Publish(s.ctx, rabbitmq.Bus, event); err != nil {
+		hlog.Warnf("Failed to publish video published event for video %d: %v", video.VideoId, err)
+	}
+}
+
 // CancelUpload 取消上传
 func (s *VideoUploadServiceV2) CancelUpload(req *videos.VideoPublishCancelRequestV2) error {
 	session, err := s.getUploadSession(req.UploadSessionUuid, req.UserId)
@@ -679,11 +716,6 @@ func (s *VideoUploadServiceV2) cleanupTempFiles(tempDir string) {
 	}
 }
 
-func parseVideoID(vid string) int64 {
-	id, _ := strconv.ParseInt(vid, 10, 64)
-	return id
-}
-
 // getUploadedPartsKeys 获取已上传分片的编号列表
 func getUploadedPartsKeys(parts map[int]oss.MinIOObjectPart) []int {
 	keys := make([]int, 0, len(parts))
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"HuaTug.com/cmd/model"
+	"HuaTug.com/cmd/video/dal/db"
+	"HuaTug.com/cmd/video/infras/redis"
+	"HuaTug.com/kitex_gen/base"
+	"HuaTug.com/kitex_gen/videos"
+	"HuaTug.com/pkg/constants"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/mq"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/pkg/errors"
+)
+
+type VideoVisitService struct {
+	ctx context.Context
+}
+
+func NewVideoVisitService(ctx context.Context) *VideoVisitService {
+	return &VideoVisitService{ctx: ctx}
+}
+
+// VideoVisit 记录一次播放：累加播放数并发布观看事件，登录用户同时写入观看历史
+func (s *VideoVisitService) VideoVisit(req *videos.VideoVisitRequestV2) (*base.Video, error) {
+	if req.VideoId <= 0 {
+		return nil, errno.ParamErr
+	}
+	video, err := db.GetVideo(s.ctx, req.VideoId)
+	if err != nil {
+		return nil, errors.WithMessage(err, "dao.GetVideo failed")
+	}
+	if video.VideoId == 0 {
+		return nil, fmt.Errorf("video %d not found: %w", req.VideoId, errno.ParamErr)
+	}
+
+	if err := redis.IncrVideoVisitInfo(strconv.FormatInt(req.VideoId, 10)); err != nil {
+		hlog.CtxWarnf(s.ctx, "Failed to count visit of video %d: %v", req.VideoId, err)
+	}
+	if req.FromId > 0 {
+		if err := db.AddUserVideoWatchHistory(s.ctx, &model.UserVideoWatchHistory{
+			UserId:    req.FromId,
+			VideoId:   req.VideoId,
+			WatchTime: time.Now().Format(constants.DataFormate),
+		}); err != nil {
+			hlog.CtxWarnf(s.ctx, "Failed to record watch history of user %d: %v", req.FromId, err)
+		}
+	}
+	publishEngagementEvent(s.ctx, req.FromId, video, mq.EngagementWatch)
+	return video, nil
+}
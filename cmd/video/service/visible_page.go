@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"HuaTug.com/config"
+	"HuaTug.com/kitex_gen/base"
+	"HuaTug.com/pkg/errno"
+	"HuaTug.com/pkg/sharding"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// 过滤后不足一页时继续往后读取的最多轮数
+const maxVisibleFetchRounds = 5
+
+// filterVisibleVideos 过滤掉因举报被隐藏或与viewer存在拉黑关系的视频
+func filterVisibleVideos(ctx context.Context, viewerID int64, list []*base.Video) ([]*base.Video, error) {
+	list, err := filterHiddenVideos(ctx, viewerID, list)
+	if err != nil {
+		return nil, err
+	}
+	return filterBlockedVideos(ctx, viewerID, list)
+}
+
+// visibilityCheckFailed 处理relation或interaction服务不可用、无法确认视频是否可见的情况。
+// 与互动侧的拉黑检查共用block.fail_open：默认返回可重试的错误，不展示可能应被隐藏的视频；
+// 配置为true时记录日志并按未隐藏处理，返回nil
+func visibilityCheckFailed(ctx context.Context, err error, format string, args ...interface{}) error {
+	hlog.CtxWarnf(ctx, "%s: %v", fmt.Sprintf(format, args...), err)
+	if config.ConfigInfo.Block.FailOpen {
+		return nil
+	}
+	return errno.ServiceUnavailableErr
+}
+
+// lastVideoCursor 返回list最后一条的游标位置，list为空时返回nil
+func lastVideoCursor(list []*base.Video) *sharding.KeyCursor {
+	if len(list) == 0 {
+		return nil
+	}
+	last := list[len(list)-1]
+	return &sharding.KeyCursor{Key: last.CreatedAt, ID: last.VideoId}
+}
+
+// visiblePage 从第一条开始按批读取并过滤，返回过滤后第offset条起的至多limit条；
+// 先过滤再分页，每页仍有limit条，翻页时也不会重复或遗漏。
+// fetch返回从第start条起至多count个位置上的视频及实际读取的位置数，位置数小于count表示已读到末尾。
+// skipped为已读取部分中被过滤掉的条数，调用方用它修正总数
+func visiblePage(ctx context.Context, viewerID, offset, limit int64,
+	fetch func(start, count int64) ([]*base.Video, int64, error)) (page []*base.Video, skipped int64, err error) {
+	want := offset + limit
+	visible := make([]*base.Video, 0, want)
+	var start int64
+	for round := 0; round < maxVisibleFetchRounds && int64(len(visible)) < want; round++ {
+		count := want - int64(len(visible))
+		batch, read, err := fetch(start, count)
+		if err != nil {
+			return nil, 0, err
+		}
+		start += read
+		kept, err := filterVisibleVideos(ctx, viewerID, batch)
+		if err != nil {
+			return nil, 0, err
+		}
+		skipped += int64(len(batch) - len(kept))
+		visible = append(visible, kept...)
+		if read < count {
+			break
+		}
+	}
+	if offset >= int64(len(visible)) {
+		return []*base.Video{}, skipped, nil
+	}
+	return visible[offset:min(want, int64(len(visible)))], skipped, nil
+}
+
+// visibleCursorPage 游标分页的过滤与补齐：过滤后不足limit条且还有更多时从已读位置继续读取。
+// fetch返回after之后按(created_at, video_id)倒序的至多count条、最后读取的位置以及之后是否还有更多；
+// 返回的游标位置是本页最后一条，下一页从它之后继续
+func visibleCursorPage(ctx context.Context, viewerID int64, after *sharding.KeyCursor, limit int,
+	fetch func(after *sharding.KeyCursor, count int) ([]*base.Video, *sharding.KeyCursor, bool, error)) ([]*base.Video, *sharding.KeyCursor, bool, error) {
+	visible := make([]*base.Video, 0, limit)
+	for round := 0; round < maxVisibleFetchRounds; round++ {
+		batch, last, more, err := fetch(after, limit-len(visible))
+		if err != nil {
+			return nil, nil, false, err
+		}
+		kept, err := filterVisibleVideos(ctx, viewerID, batch)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		if len(visible)+len(kept) >= limit {
+			// 本批还有没放进本页的可见视频，或之后还有更多
+			rest := len(visible) + len(kept) - limit
+			visible = append(visible, kept[:limit-len(visible)]...)
+			return visible, lastVideoCursor(visible), more || rest > 0, nil
+		}
+		visible = append(visible, kept...)
+		if !more || last == nil {
+			return visible, nil, false, nil
+		}
+		after = last
+	}
+	// 轮数用完仍不足一页，从已读位置继续翻页
+	return visible, after, true, nil
+}
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"time"
 
-	"github.com/cloudwego/hertz/pkg/common/hlog"
 	redsyncs "github.com/go-redsync/redsync/v4"
 	"github.com/go-redsync/redsync/v4/redis/redigo"
 	"github.com/gomodule/redigo/redis"
@@ -178,43 +177,6 @@ func CacheGet(key string) ([]byte, error) { //用于获取键
 	return reply, nil
 }
 
-// ToDO 为实现排行功能完成
-func RangeAdd(value, id int64) error {
-	conn := redisClient.Get()
-	defer func(conn redis.Conn) {
-		err := conn.Close()
-		if err != nil {
-			logrus.Info(err)
-		}
-	}(conn)
-	// ZADD racer_scores 10 "Norem"
-	//其中ZADD命令中 第一个为这个事务的名称 第二个是值(分数) 第三个是键(分数对应的对象)
-	_, err := conn.Do("ZADD", "Rank", value, id)
-	if err != nil {
-		hlog.Info(err)
-		return err
-	}
-	return nil
-}
-
-// To Get the RankList
-func RangeList(key string) ([]string, error) {
-	conn := redisClient.Get()
-	defer func(conn redis.Conn) {
-		err := conn.Close()
-		if err != nil {
-			logrus.Info(err)
-		}
-	}(conn)
-	//ZRANGE命令的排序顺序是从低到高的，而ZREVRANGE命令的顺序是从高到低的。	0和-1表示从元素索引为0到最后一个元素
-	//ZRANGE racer_scores 0 -1 withscores 这个命令代表的是不但会返回排序后的键 还会返回这个键也就是对象对应的值也就是分数
-	res, err := redis.Strings(conn.Do("ZRevRange", key, 0, -1))
-	if err != nil {
-		hlog.Info(err)
-	}
-	return res, nil
-}
-
 ///////////////////////////List类型接口////////////////////////////////////////
 
 func CacheLPush(key string, value ...interface{}) error {
@@ -0,0 +1,118 @@
+package cache
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	popularUsersKey       = "relation:popular_users"
+	secondDegreeKeyPrefix = "relation:second_degree:"
+	popularRefreshLockKey = "relation:popular_refresh_lock"
+	blockReconcileLockKey = "relation:block_reconcile_lock"
+	fanRepairLockKey      = "relation:fan_repair_lock"
+)
+
+// SecondDegreeCandidate 二度关系候选人：我关注的人中关注了TA的人数，以及可以公开展示的共同关注
+type SecondDegreeCandidate struct {
+	UserID      int64   `json:"user_id"`
+	MutualCount int64   `json:"mutual_count"`
+	ViaUserIDs  []int64 `json:"via_user_ids"`
+}
+
+// CacheSetEx 序列化后写入缓存，ttl后过期
+func CacheSetEx(key string, data interface{}, ttl time.Duration) error {
+	conn := redisClient.Get()
+	defer func(conn redis.Conn) {
+		err := conn.Close()
+		if err != nil {
+			logrus.Info(err)
+		}
+	}(conn)
+	value, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do("SET", key, value, "PX", ttl.Milliseconds())
+	return err
+}
+
+// TryLock 获取ttl后自动释放的锁，多个实例中只有一个能在ttl内拿到
+func TryLock(key string, ttl time.Duration) (bool, error) {
+	conn := redisClient.Get()
+	defer func(conn redis.Conn) {
+		err := conn.Close()
+		if err != nil {
+			logrus.Info(err)
+		}
+	}(conn)
+	_, err := redis.String(conn.Do("SET", key, 1, "NX", "PX", ttl.Milliseconds()))
+	if err == redis.ErrNil {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// TryLockPopularRefresh 热门用户重算锁
+func TryLockPopularRefresh(ttl time.Duration) (bool, error) {
+	return TryLock(popularRefreshLockKey, ttl)
+}
+
+// TryLockBlockReconcile 拉黑核对锁
+func TryLockBlockReconcile(ttl time.Duration) (bool, error) {
+	return TryLock(blockReconcileLockKey, ttl)
+}
+
+// TryLockFanRepair 粉丝表全量修复锁
+func TryLockFanRepair(ttl time.Duration) (bool, error) {
+	return TryLock(fanRepairLockKey, ttl)
+}
+
+// CacheSetPopularUsers 保存按粉丝数排序的热门用户
+func CacheSetPopularUsers(userIDs []int64, ttl time.Duration) error {
+	return CacheSetEx(popularUsersKey, userIDs, ttl)
+}
+
+// CacheGetPopularUsers 读取热门用户，未计算或已过期时返回ErrMissCache
+func CacheGetPopularUsers() ([]int64, error) {
+	data, err := CacheGet(popularUsersKey)
+	if err == redis.ErrNil {
+		return nil, ErrMissCache
+	}
+	if err != nil {
+		return nil, err
+	}
+	var userIDs []int64
+	if err := json.Unmarshal(data, &userIDs); err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
+
+// CacheSetSecondDegree 保存userID的二度关系候选人
+func CacheSetSecondDegree(userID int64, candidates []SecondDegreeCandidate, ttl time.Duration) error {
+	return CacheSetEx(secondDegreeKeyPrefix+strconv.FormatInt(userID, 10), candidates, ttl)
+}
+
+// CacheGetSecondDegree 读取userID的二度关系候选人，未缓存时返回ErrMissCache
+func CacheGetSecondDegree(userID int64) ([]SecondDegreeCandidate, error) {
+	data, err := CacheGet(secondDegreeKeyPrefix + strconv.FormatInt(userID, 10))
+	if err == redis.ErrNil {
+		return nil, ErrMissCache
+	}
+	if err != nil {
+		return nil, err
+	}
+	var candidates []SecondDegreeCandidate
+	if err := json.Unmarshal(data, &candidates); err != nil {
+		return nil, err
+	}
+	return candidates, nil
+}
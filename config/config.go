@@ -57,6 +57,8 @@ func Init() {
 	ConfigInfo.CommentSharding.MaxIdleConns = viper.GetInt("comment_sharding.max_idle_conns")
 	ConfigInfo.CommentSharding.ConnMaxLifetime = viper.GetString("comment_sharding.conn_max_lifetime")
 	ConfigInfo.CommentSharding.MasterDSNs = viper.GetStringSlice("comment_sharding.master_dsns")
+	ConfigInfo.CommentSharding.ReplicaMaxLag = viper.GetString("comment_sharding.replica_max_lag")
+	ConfigInfo.CommentSharding.ReplicaCheckInterval = viper.GetString("comment_sharding.replica_check_interval")
 
 	// 获取slave_dsns (二维数组)
 	if slaveDSNsInterface := viper.Get("comment_sharding.slave_dsns"); slaveDSNsInterface != nil {
@@ -82,6 +84,8 @@ func Init() {
 	ConfigInfo.FollowsSharding.MaxIdleConns = viper.GetInt("follows_sharding.max_idle_conns")
 	ConfigInfo.FollowsSharding.ConnMaxLifetime = viper.GetString("follows_sharding.conn_max_lifetime")
 	ConfigInfo.FollowsSharding.MasterDSNs = viper.GetStringSlice("follows_sharding.master_dsns")
+	ConfigInfo.FollowsSharding.ReplicaMaxLag = viper.GetString("follows_sharding.replica_max_lag")
+	ConfigInfo.FollowsSharding.ReplicaCheckInterval = viper.GetString("follows_sharding.replica_check_interval")
 
 	// 获取follows_sharding的slave_dsns (二维数组)
 	if slaveDSNsInterface := viper.Get("follows_sharding.slave_dsns"); slaveDSNsInterface != nil {
@@ -108,6 +112,25 @@ func Init() {
 	ConfigInfo.RabbitMq.Addr = viper.GetString("rabbitmq.addr")
 	ConfigInfo.RabbitMq.Username = viper.GetString("rabbitmq.username")
 	ConfigInfo.RabbitMq.Password = viper.GetString("rabbitmq.password")
+	ConfigInfo.RabbitMq.Prefetch = viper.GetInt("rabbitmq.prefetch")
+	ConfigInfo.RabbitMq.Concurrency = viper.GetInt("rabbitmq.concurrency")
+	ConfigInfo.RabbitMq.ShutdownTimeout = viper.GetString("rabbitmq.shutdown_timeout")
+
+	ConfigInfo.IDGen.Mode = viper.GetString("idgen.mode")
+	ConfigInfo.IDGen.LeaseTTL = viper.GetString("idgen.lease_ttl")
+	ConfigInfo.IDGen.MaxClockBackward = viper.GetString("idgen.max_clock_backward")
+	ConfigInfo.IDGen.SegmentStep = viper.GetInt64("idgen.segment_step")
+
+	ConfigInfo.Comment.EditWindow = viper.GetString("comment.edit_window")
+
+	ConfigInfo.Moderation.WordsFile = viper.GetString("moderation.words_file")
+	ConfigInfo.Moderation.ReloadInterval = viper.GetString("moderation.reload_interval")
+	ConfigInfo.Moderation.ReviewScore = viper.GetInt("moderation.review_score")
+	ConfigInfo.Moderation.RejectScore = viper.GetInt("moderation.reject_score")
+
+	ConfigInfo.Report.HideThreshold = viper.GetInt("report.hide_threshold")
+
+	ConfigInfo.Block.FailOpen = viper.GetBool("block.fail_open")
 
 	// 打印配置信息用于调试
 	logrus.Infof("Config loaded - MySQL: %s:%s@%s/%s",
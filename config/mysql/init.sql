@@ -42,6 +42,13 @@ create table   `users`(
     `email` varchar(30) not null,
     `sex` tinyint(1) not null, -- 0:female 1:male
     `avatar_url` varchar(255) ,
+    `bio` varchar(255) not null default '',
+    `website` varchar(255) not null default '',
+    `birthday` varchar(20) not null default '',
+    `background_url` varchar(255) not null default '',
+    `is_private` tinyint(1) not null default '0' comment '0:public 1:private',
+    `hide_likes` tinyint(1) not null default '0' comment '0:show 1:hide',
+    `hide_following` tinyint(1) not null default '0' comment '0:show 1:hide',
     `created_at` varchar(255) not null,
     `updated_at` varchar(255) not null,
     `deleted_at` varchar(255) ,
@@ -49,6 +56,19 @@ create table   `users`(
     key `username_password_index` (user_name,password) using btree
 ) engine = InnoDB  auto_increment=1 default  charset = utf8mb4;
 
+-- Table structure of user_stats --
+-- 用户维度的冗余计数，由relation/interaction/video服务通过user_stats_events异步维护
+drop table if exists `user_stats`;
+create table `user_stats`(
+    `user_id` bigint not null,
+    `follower_count` bigint not null default '0',
+    `following_count` bigint not null default '0',
+    `total_likes` bigint not null default '0',
+    `video_count` bigint not null default '0',
+    `updated_at` timestamp not null default current_timestamp on update current_timestamp,
+    primary key (user_id)
+) engine = InnoDB default charset = utf8mb4;
+
 -- -- 创建其他分表 users_1, users_2, users_3
 -- CREATE TABLE `users_1` LIKE `users_0`;
 -- CREATE TABLE `users_2` LIKE `users_0`;
@@ -75,6 +95,7 @@ create table `user_behaviors`(
     -- unique key(user_id,video_id,behavior_type),
     primary key (user_behavior_id),
     key `idx_user_video_behavior` (user_id, video_id, behavior_type),
+    key `idx_video_behavior` (video_id, behavior_type),
     key `idx_behavior_time` (behavior_time)
 )engine InnoDB auto_increment=1  default  charset=utf8mb4;
 
@@ -102,7 +123,8 @@ create table `videos`(
     `deleted_at` varchar(255) ,
     primary key (video_id),
     key `time` (created_at) using btree ,
-    key `author` (user_id) using btree
+    key `author` (user_id) using btree ,
+    key `author_created` (user_id,created_at,video_id) using btree -- 用户视频列表的游标分页
 )engine InnoDB auto_increment=1  default  charset=utf8mb4;
 
 
@@ -178,6 +200,18 @@ create table `user_perferences`(
 );
 
 
+-- Table structure of id_segments --
+-- 号段模式的ID分配表，每个业务一行，max_id为已经分配出去的最大ID
+-- 从自增ID切换到号段模式前，需要把各业务的max_id初始化为对应表中现有的最大ID
+CREATE TABLE IF NOT EXISTS `id_segments` (
+    `biz_tag` varchar(64) NOT NULL,
+    `max_id` bigint NOT NULL DEFAULT 0,
+    `step` int NOT NULL DEFAULT 1000,
+    `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`biz_tag`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+
 -- Table structure of system_logs --
 DROP TABLE IF EXISTS `system_logs`;
 CREATE TABLE `system_logs` (
@@ -213,8 +247,9 @@ CREATE TABLE IF NOT EXISTS `follows_0` (
     `id` bigint NOT NULL AUTO_INCREMENT COMMENT '自增主键',
     `user_id` bigint NOT NULL COMMENT '被关注者ID',
     `follower_id` bigint NOT NULL COMMENT '关注者ID',
-    `status` tinyint DEFAULT 1 COMMENT '1:正常关注 2:特别关注 3:悄悄关注',
+    `status` tinyint DEFAULT 1 COMMENT '1:正常关注 2:特别关注 3:悄悄关注 4:待通过的关注请求',
     `remark` varchar(100) DEFAULT '' COMMENT '备注信息',
+    `version` bigint NOT NULL DEFAULT 0 COMMENT '每次修改加一，粉丝表同步据此丢弃旧状态',
     `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     `deleted_at` TIMESTAMP NULL DEFAULT NULL,
@@ -224,6 +259,7 @@ CREATE TABLE IF NOT EXISTS `follows_0` (
     KEY `idx_follower_id` (`follower_id`),
     KEY `idx_status` (`status`),
     KEY `idx_created_at` (`created_at`),
+    KEY `idx_follower_created` (`follower_id`, `created_at`, `id`) COMMENT '关注列表的游标分页',
     KEY `idx_deleted_at` (`deleted_at`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='关注关系表';
 
@@ -236,6 +272,37 @@ CREATE TABLE IF NOT EXISTS `follows_2` LIKE `follows_0`;
 -- 创建关注关系表 follows_3
 CREATE TABLE IF NOT EXISTS `follows_3` LIKE `follows_0`;
 
+-- 创建粉丝表 fans_0（按被关注者分片，由follows同步，用于粉丝列表、粉丝数和互关查询）
+CREATE TABLE IF NOT EXISTS `fans_0` (
+    `id` bigint NOT NULL AUTO_INCREMENT COMMENT '自增主键',
+    `user_id` bigint NOT NULL COMMENT '被关注者ID',
+    `follower_id` bigint NOT NULL COMMENT '关注者ID',
+    `status` tinyint DEFAULT 1 COMMENT '与follows中的状态一致',
+    `version` bigint NOT NULL DEFAULT 0 COMMENT '同步自follows的版本，只接受不低于当前版本的状态',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `deleted_at` TIMESTAMP NULL DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_user_follower` (`user_id`, `follower_id`),
+    KEY `idx_user_status_created` (`user_id`, `status`, `created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='粉丝表';
+
+CREATE TABLE IF NOT EXISTS `fans_1` LIKE `fans_0`;
+CREATE TABLE IF NOT EXISTS `fans_2` LIKE `fans_0`;
+CREATE TABLE IF NOT EXISTS `fans_3` LIKE `fans_0`;
+
+-- 创建关注变更outbox表，与follows在同一个本地事务中写入，保证粉丝表最终一致
+CREATE TABLE IF NOT EXISTS `follow_outbox` (
+    `id` bigint NOT NULL AUTO_INCREMENT COMMENT '自增主键',
+    `user_id` bigint NOT NULL COMMENT '被关注者ID',
+    `follower_id` bigint NOT NULL COMMENT '关注者ID',
+    `retry_count` int NOT NULL DEFAULT 0 COMMENT '同步失败次数',
+    `last_error` varchar(255) DEFAULT '' COMMENT '最近一次同步失败原因',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    KEY `idx_retry_count` (`retry_count`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='关注变更outbox表';
+
 -- 创建用户关系统计表（每个分库都有）
 CREATE TABLE IF NOT EXISTS `user_relation_stats` (
     `user_id` bigint NOT NULL COMMENT '用户ID',
@@ -263,6 +330,13 @@ CREATE TABLE IF NOT EXISTS `follows_1` LIKE relation_db_0.follows_0;
 CREATE TABLE IF NOT EXISTS `follows_2` LIKE relation_db_0.follows_0;
 CREATE TABLE IF NOT EXISTS `follows_3` LIKE relation_db_0.follows_0;
 
+-- 创建粉丝表和outbox表
+CREATE TABLE IF NOT EXISTS `fans_0` LIKE relation_db_0.fans_0;
+CREATE TABLE IF NOT EXISTS `fans_1` LIKE relation_db_0.fans_0;
+CREATE TABLE IF NOT EXISTS `fans_2` LIKE relation_db_0.fans_0;
+CREATE TABLE IF NOT EXISTS `fans_3` LIKE relation_db_0.fans_0;
+CREATE TABLE IF NOT EXISTS `follow_outbox` LIKE relation_db_0.follow_outbox;
+
 -- 创建用户关系统计表
 CREATE TABLE IF NOT EXISTS `user_relation_stats` LIKE relation_db_0.user_relation_stats;
 
@@ -278,6 +352,13 @@ CREATE TABLE IF NOT EXISTS `follows_1` LIKE relation_db_0.follows_0;
 CREATE TABLE IF NOT EXISTS `follows_2` LIKE relation_db_0.follows_0;
 CREATE TABLE IF NOT EXISTS `follows_3` LIKE relation_db_0.follows_0;
 
+-- 创建粉丝表和outbox表
+CREATE TABLE IF NOT EXISTS `fans_0` LIKE relation_db_0.fans_0;
+CREATE TABLE IF NOT EXISTS `fans_1` LIKE relation_db_0.fans_0;
+CREATE TABLE IF NOT EXISTS `fans_2` LIKE relation_db_0.fans_0;
+CREATE TABLE IF NOT EXISTS `fans_3` LIKE relation_db_0.fans_0;
+CREATE TABLE IF NOT EXISTS `follow_outbox` LIKE relation_db_0.follow_outbox;
+
 -- 创建用户关系统计表
 CREATE TABLE IF NOT EXISTS `user_relation_stats` LIKE relation_db_0.user_relation_stats;
 
@@ -293,6 +374,13 @@ CREATE TABLE IF NOT EXISTS `follows_1` LIKE relation_db_0.follows_0;
 CREATE TABLE IF NOT EXISTS `follows_2` LIKE relation_db_0.follows_0;
 CREATE TABLE IF NOT EXISTS `follows_3` LIKE relation_db_0.follows_0;
 
+-- 创建粉丝表和outbox表
+CREATE TABLE IF NOT EXISTS `fans_0` LIKE relation_db_0.fans_0;
+CREATE TABLE IF NOT EXISTS `fans_1` LIKE relation_db_0.fans_0;
+CREATE TABLE IF NOT EXISTS `fans_2` LIKE relation_db_0.fans_0;
+CREATE TABLE IF NOT EXISTS `fans_3` LIKE relation_db_0.fans_0;
+CREATE TABLE IF NOT EXISTS `follow_outbox` LIKE relation_db_0.follow_outbox;
+
 -- 创建用户关系统计表
 CREATE TABLE IF NOT EXISTS `user_relation_stats` LIKE relation_db_0.user_relation_stats;
 
@@ -374,6 +462,52 @@ CREATE TABLE IF NOT EXISTS `global_user_relation_index` (
     KEY `idx_db_table_index` (`db_index`, `table_index`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='全局用户关系索引表';
 
+-- 创建用户拉黑/静音表（按发起方查询，按被屏蔽方建索引用于双向检查）
+CREATE TABLE IF NOT EXISTS `user_blocks` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `user_id` bigint NOT NULL COMMENT '发起拉黑/静音的用户ID',
+    `target_user_id` bigint NOT NULL COMMENT '被拉黑/静音的用户ID',
+    `block_type` tinyint NOT NULL DEFAULT 1 COMMENT '1:拉黑 2:静音',
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_user_target_type` (`user_id`, `target_user_id`, `block_type`),
+    KEY `idx_target_user_id` (`target_user_id`, `block_type`),
+    KEY `idx_user_type_created` (`user_id`, `block_type`, `created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户拉黑/静音表';
+
+-- 创建分片拓扑版本表（关注和评论分库分表的当前拓扑及重分片目标拓扑，服务启动时若不存在则按配置文件写入第一个版本）
+CREATE TABLE IF NOT EXISTS `shard_maps` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `name` varchar(50) NOT NULL COMMENT '逻辑库名称：follows/comments',
+    `version` int NOT NULL COMMENT '拓扑版本',
+    `database_count` int NOT NULL COMMENT '分库数量',
+    `table_count` int NOT NULL COMMENT '每个分库的分表数量',
+    `master_dsns` text NOT NULL COMMENT '各分库主库DSN（JSON数组）',
+    `state` varchar(20) NOT NULL COMMENT 'pending/active/retired',
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_name_version` (`name`, `version`),
+    KEY `idx_name_state` (`name`, `state`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='分片拓扑版本表';
+
+-- 创建重分片任务表
+CREATE TABLE IF NOT EXISTS `reshard_tasks` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `name` varchar(50) NOT NULL COMMENT '逻辑库名称',
+    `from_version` int NOT NULL COMMENT '原拓扑版本',
+    `to_version` int NOT NULL COMMENT '目标拓扑版本',
+    `state` varchar(20) NOT NULL COMMENT 'planned/copying/verifying/ready/cutover/done/aborted',
+    `copied_rows` bigint NOT NULL DEFAULT 0 COMMENT '已复制行数',
+    `mismatched_rows` bigint NOT NULL DEFAULT 0 COMMENT '最近一次校验修复的不一致行数',
+    `progress` text COMMENT '各源表复制游标（JSON）',
+    `last_error` varchar(255) DEFAULT '' COMMENT '最近一次失败原因',
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    KEY `idx_name_state` (`name`, `state`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='重分片任务表';
+
 
 -- 创建初始化完成日志
 INSERT INTO system_logs (log_type, message, level, created_at) 
@@ -548,6 +682,33 @@ INSERT INTO `storage_bucket_config` (`bucket_name`, `bucket_type`, `lifecycle_co
 ('tiktok-analytics', 'analytics', '{"hot_days": 30, "warm_days": 90, "cold_days": 365, "archive_days": 2190}', 30, 90, 365, 2190)
 ON DUPLICATE KEY UPDATE `updated_at` = CURRENT_TIMESTAMP;
 
+-- 事务性发件箱，事件与业务变更在同一个本地事务中写入，由OutboxRelay发布后删除
+CREATE TABLE IF NOT EXISTS `mq_outbox` (
+    `id` bigint NOT NULL AUTO_INCREMENT COMMENT '自增主键，决定同一聚合键内的发布顺序',
+    `message_id` varchar(36) NOT NULL COMMENT '发布时的AMQP消息ID',
+    `event_name` varchar(64) NOT NULL DEFAULT '' COMMENT '事件名，发布时写入消息头',
+    `event_version` int NOT NULL DEFAULT 0 COMMENT '事件结构版本',
+    `exchange` varchar(64) NOT NULL COMMENT '目标交换机',
+    `routing_key` varchar(64) NOT NULL DEFAULT '' COMMENT '路由键',
+    `aggregate_key` varchar(128) NOT NULL COMMENT '聚合键',
+    `payload` text NOT NULL COMMENT '消息体JSON',
+    `status` varchar(16) NOT NULL DEFAULT 'pending' COMMENT 'pending或dead',
+    `attempts` int NOT NULL DEFAULT 0 COMMENT '发布失败次数',
+    `last_error` varchar(255) DEFAULT '' COMMENT '最近一次发布失败原因',
+    `next_attempt_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '下次可以发布的时间',
+    `locked_until` TIMESTAMP NULL DEFAULT NULL COMMENT '中继实例认领后的租约到期时间，到期前其他实例不会认领',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    KEY `idx_status_next_attempt` (`status`, `next_attempt_at`, `id`),
+    KEY `idx_aggregate_key` (`aggregate_key`, `status`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='事务性发件箱';
+
+-- 关系分库的发件箱，计数变更和关注事件与关注关系在同一个分库事务中提交
+CREATE TABLE IF NOT EXISTS relation_db_0.mq_outbox LIKE TikTok.mq_outbox;
+CREATE TABLE IF NOT EXISTS relation_db_1.mq_outbox LIKE TikTok.mq_outbox;
+CREATE TABLE IF NOT EXISTS relation_db_2.mq_outbox LIKE TikTok.mq_outbox;
+CREATE TABLE IF NOT EXISTS relation_db_3.mq_outbox LIKE TikTok.mq_outbox;
+
 CREATE TABLE IF NOT EXISTS `sync_events` (
     `id` VARCHAR(36) NOT NULL PRIMARY KEY,
     `event_type` VARCHAR(50) NOT NULL,
@@ -616,6 +777,9 @@ CREATE TABLE IF NOT EXISTS `idempotency_records` (
 CREATE DATABASE IF NOT EXISTS comment_db_0 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 USE comment_db_0;
 
+-- 发件箱，评论写入与通知事件在同一个分库事务中提交
+CREATE TABLE IF NOT EXISTS `mq_outbox` LIKE TikTok.mq_outbox;
+
 -- 创建分表 comments_0 到 comments_3
 CREATE TABLE IF NOT EXISTS `comments_0` (
     `comment_id` bigint NOT NULL,
@@ -629,6 +793,7 @@ CREATE TABLE IF NOT EXISTS `comments_0` (
     `updated_at` varchar(255) NOT NULL,
     `deleted_at` varchar(255) DEFAULT '',
     `reply_to_comment_id` bigint NOT NULL DEFAULT 0,
+    `edited_at` varchar(255) NOT NULL DEFAULT '' COMMENT '最近一次编辑的时间，未编辑过为空',
     PRIMARY KEY (`comment_id`),
     KEY `idx_video_id` (`video_id`) USING BTREE,
     KEY `idx_user_id` (`user_id`) USING BTREE,
@@ -644,6 +809,23 @@ CREATE TABLE IF NOT EXISTS `comments_1` LIKE `comments_0`;
 CREATE TABLE IF NOT EXISTS `comments_2` LIKE `comments_0`;
 CREATE TABLE IF NOT EXISTS `comments_3` LIKE `comments_0`;
 
+-- 创建评论编辑历史分表，与comments_N一一对应
+CREATE TABLE IF NOT EXISTS `comment_edits_0` (
+    `edit_id` bigint NOT NULL AUTO_INCREMENT,
+    `comment_id` bigint NOT NULL,
+    `video_id` bigint NOT NULL,
+    `user_id` bigint NOT NULL,
+    `content` text NOT NULL COMMENT '被替换的内容',
+    `edited_at` varchar(255) NOT NULL COMMENT '被替换的时间',
+    PRIMARY KEY (`edit_id`),
+    UNIQUE KEY `uk_comment_edited` (`comment_id`, `edited_at`),
+    KEY `idx_comment_id` (`comment_id`, `edit_id`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `comment_edits_1` LIKE `comment_edits_0`;
+CREATE TABLE IF NOT EXISTS `comment_edits_2` LIKE `comment_edits_0`;
+CREATE TABLE IF NOT EXISTS `comment_edits_3` LIKE `comment_edits_0`;
+
 -- 创建评论点赞表（每个分库都有）
 CREATE TABLE IF NOT EXISTS `comment_likes` (
     `comment_likes_id` bigint NOT NULL,
@@ -664,6 +846,9 @@ CREATE TABLE IF NOT EXISTS `comment_likes` (
 CREATE DATABASE IF NOT EXISTS comment_db_1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 USE comment_db_1;
 
+-- 发件箱，评论写入与通知事件在同一个分库事务中提交
+CREATE TABLE IF NOT EXISTS `mq_outbox` LIKE TikTok.mq_outbox;
+
 -- 创建分表 comments_0 到 comments_3
 CREATE TABLE IF NOT EXISTS `comments_0` (
     `comment_id` bigint NOT NULL,
@@ -677,6 +862,7 @@ CREATE TABLE IF NOT EXISTS `comments_0` (
     `updated_at` varchar(255) NOT NULL,
     `deleted_at` varchar(255) DEFAULT '',
     `reply_to_comment_id` bigint NOT NULL DEFAULT 0,
+    `edited_at` varchar(255) NOT NULL DEFAULT '' COMMENT '最近一次编辑的时间，未编辑过为空',
     PRIMARY KEY (`comment_id`),
     KEY `idx_video_id` (`video_id`) USING BTREE,
     KEY `idx_user_id` (`user_id`) USING BTREE,
@@ -692,6 +878,23 @@ CREATE TABLE IF NOT EXISTS `comments_1` LIKE `comments_0`;
 CREATE TABLE IF NOT EXISTS `comments_2` LIKE `comments_0`;
 CREATE TABLE IF NOT EXISTS `comments_3` LIKE `comments_0`;
 
+-- 创建评论编辑历史分表，与comments_N一一对应
+CREATE TABLE IF NOT EXISTS `comment_edits_0` (
+    `edit_id` bigint NOT NULL AUTO_INCREMENT,
+    `comment_id` bigint NOT NULL,
+    `video_id` bigint NOT NULL,
+    `user_id` bigint NOT NULL,
+    `content` text NOT NULL COMMENT '被替换的内容',
+    `edited_at` varchar(255) NOT NULL COMMENT '被替换的时间',
+    PRIMARY KEY (`edit_id`),
+    UNIQUE KEY `uk_comment_edited` (`comment_id`, `edited_at`),
+    KEY `idx_comment_id` (`comment_id`, `edit_id`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `comment_edits_1` LIKE `comment_edits_0`;
+CREATE TABLE IF NOT EXISTS `comment_edits_2` LIKE `comment_edits_0`;
+CREATE TABLE IF NOT EXISTS `comment_edits_3` LIKE `comment_edits_0`;
+
 -- 创建评论点赞表
 CREATE TABLE IF NOT EXISTS `comment_likes` (
     `comment_likes_id` bigint NOT NULL,
@@ -712,6 +915,9 @@ CREATE TABLE IF NOT EXISTS `comment_likes` (
 CREATE DATABASE IF NOT EXISTS comment_db_2 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 USE comment_db_2;
 
+-- 发件箱，评论写入与通知事件在同一个分库事务中提交
+CREATE TABLE IF NOT EXISTS `mq_outbox` LIKE TikTok.mq_outbox;
+
 -- 创建分表 comments_0 到 comments_3
 CREATE TABLE IF NOT EXISTS `comments_0` (
     `comment_id` bigint NOT NULL,
@@ -725,6 +931,7 @@ CREATE TABLE IF NOT EXISTS `comments_0` (
     `updated_at` varchar(255) NOT NULL,
     `deleted_at` varchar(255) DEFAULT '',
     `reply_to_comment_id` bigint NOT NULL DEFAULT 0,
+    `edited_at` varchar(255) NOT NULL DEFAULT '' COMMENT '最近一次编辑的时间，未编辑过为空',
     PRIMARY KEY (`comment_id`),
     KEY `idx_video_id` (`video_id`) USING BTREE,
     KEY `idx_user_id` (`user_id`) USING BTREE,
@@ -740,6 +947,23 @@ CREATE TABLE IF NOT EXISTS `comments_1` LIKE `comments_0`;
 CREATE TABLE IF NOT EXISTS `comments_2` LIKE `comments_0`;
 CREATE TABLE IF NOT EXISTS `comments_3` LIKE `comments_0`;
 
+-- 创建评论编辑历史分表，与comments_N一一对应
+CREATE TABLE IF NOT EXISTS `comment_edits_0` (
+    `edit_id` bigint NOT NULL AUTO_INCREMENT,
+    `comment_id` bigint NOT NULL,
+    `video_id` bigint NOT NULL,
+    `user_id` bigint NOT NULL,
+    `content` text NOT NULL COMMENT '被替换的内容',
+    `edited_at` varchar(255) NOT NULL COMMENT '被替换的时间',
+    PRIMARY KEY (`edit_id`),
+    UNIQUE KEY `uk_comment_edited` (`comment_id`, `edited_at`),
+    KEY `idx_comment_id` (`comment_id`, `edit_id`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `comment_edits_1` LIKE `comment_edits_0`;
+CREATE TABLE IF NOT EXISTS `comment_edits_2` LIKE `comment_edits_0`;
+CREATE TABLE IF NOT EXISTS `comment_edits_3` LIKE `comment_edits_0`;
+
 -- 创建评论点赞表
 CREATE TABLE IF NOT EXISTS `comment_likes` (
     `comment_likes_id` bigint NOT NULL,
@@ -760,6 +984,9 @@ CREATE TABLE IF NOT EXISTS `comment_likes` (
 CREATE DATABASE IF NOT EXISTS comment_db_3 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 USE comment_db_3;
 
+-- 发件箱，评论写入与通知事件在同一个分库事务中提交
+CREATE TABLE IF NOT EXISTS `mq_outbox` LIKE TikTok.mq_outbox;
+
 -- 创建分表 comments_0 到 comments_3
 CREATE TABLE IF NOT EXISTS `comments_0` (
     `comment_id` bigint NOT NULL,
@@ -773,6 +1000,7 @@ CREATE TABLE IF NOT EXISTS `comments_0` (
     `updated_at` varchar(255) NOT NULL,
     `deleted_at` varchar(255) DEFAULT '',
     `reply_to_comment_id` bigint NOT NULL DEFAULT 0,
+    `edited_at` varchar(255) NOT NULL DEFAULT '' COMMENT '最近一次编辑的时间，未编辑过为空',
     PRIMARY KEY (`comment_id`),
     KEY `idx_video_id` (`video_id`) USING BTREE,
     KEY `idx_user_id` (`user_id`) USING BTREE,
@@ -788,6 +1016,23 @@ CREATE TABLE IF NOT EXISTS `comments_1` LIKE `comments_0`;
 CREATE TABLE IF NOT EXISTS `comments_2` LIKE `comments_0`;
 CREATE TABLE IF NOT EXISTS `comments_3` LIKE `comments_0`;
 
+-- 创建评论编辑历史分表，与comments_N一一对应
+CREATE TABLE IF NOT EXISTS `comment_edits_0` (
+    `edit_id` bigint NOT NULL AUTO_INCREMENT,
+    `comment_id` bigint NOT NULL,
+    `video_id` bigint NOT NULL,
+    `user_id` bigint NOT NULL,
+    `content` text NOT NULL COMMENT '被替换的内容',
+    `edited_at` varchar(255) NOT NULL COMMENT '被替换的时间',
+    PRIMARY KEY (`edit_id`),
+    UNIQUE KEY `uk_comment_edited` (`comment_id`, `edited_at`),
+    KEY `idx_comment_id` (`comment_id`, `edit_id`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `comment_edits_1` LIKE `comment_edits_0`;
+CREATE TABLE IF NOT EXISTS `comment_edits_2` LIKE `comment_edits_0`;
+CREATE TABLE IF NOT EXISTS `comment_edits_3` LIKE `comment_edits_0`;
+
 -- 创建评论点赞表
 CREATE TABLE IF NOT EXISTS `comment_likes` (
     `comment_likes_id` bigint NOT NULL,
@@ -834,4 +1079,82 @@ CREATE TABLE IF NOT EXISTS `global_comment_stats` (
     `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     PRIMARY KEY (`id`),
     UNIQUE KEY `uk_stats_date` (`stats_date`)
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='全局评论统计表';
\ No newline at end of file
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='全局评论统计表';
+
+-- 创建评论置顶表（每个视频至多一条置顶评论，只有视频作者可以置顶）
+CREATE TABLE IF NOT EXISTS `comment_pins` (
+    `video_id` bigint NOT NULL,
+    `comment_id` bigint NOT NULL COMMENT '置顶的一级评论ID',
+    `user_id` bigint NOT NULL COMMENT '置顶操作人，即视频作者',
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`video_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='评论置顶表';
+
+-- 创建敏感词表（moderation.words_file未配置时评论内容检查从该表加载词表，修改后定时生效）
+CREATE TABLE IF NOT EXISTS `sensitive_words` (
+    `id` bigint NOT NULL AUTO_INCREMENT,
+    `word` varchar(64) NOT NULL,
+    `score` int NOT NULL DEFAULT 40 COMMENT '命中时计入的风险分',
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_word` (`word`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='敏感词表';
+
+-- 创建评论审核队列表（风险分达到审核阈值的评论在管理员通过后才发布）
+CREATE TABLE IF NOT EXISTS `comment_reviews` (
+    `review_id` bigint NOT NULL AUTO_INCREMENT,
+    `comment_id` bigint NOT NULL COMMENT '预先分配的评论ID，审核通过后以该ID发布',
+    `video_id` bigint NOT NULL,
+    `user_id` bigint NOT NULL,
+    `parent_id` bigint NOT NULL DEFAULT -1,
+    `reply_to_comment_id` bigint NOT NULL DEFAULT 0,
+    `content` text NOT NULL,
+    `score` int NOT NULL DEFAULT 0 COMMENT '内容检查的风险分',
+    `reasons` varchar(512) NOT NULL DEFAULT '' COMMENT '命中的风险项',
+    `status` tinyint NOT NULL DEFAULT 0 COMMENT '0待审核 1已通过 2已拒绝',
+    `reviewer_id` bigint NOT NULL DEFAULT 0,
+    `note` varchar(255) NOT NULL DEFAULT '' COMMENT '拒绝原因',
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `reviewed_at` datetime NULL DEFAULT NULL,
+    PRIMARY KEY (`review_id`),
+    UNIQUE KEY `uk_comment_id` (`comment_id`),
+    KEY `idx_status_review` (`status`, `review_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='评论审核队列表';
+
+-- 创建举报工单表（同一对象的举报汇总为一个工单，新的举报用户数达到阈值时自动隐藏）
+CREATE TABLE IF NOT EXISTS `report_cases` (
+    `case_id` bigint NOT NULL AUTO_INCREMENT,
+    `target_type` tinyint NOT NULL COMMENT '1视频 2评论 3用户',
+    `target_id` bigint NOT NULL,
+    `target_user_id` bigint NOT NULL DEFAULT 0 COMMENT '被举报内容的作者',
+    `video_id` bigint NOT NULL DEFAULT 0 COMMENT '被举报评论所属的视频，其他对象为0',
+    `report_count` int NOT NULL DEFAULT 0 COMMENT '举报过该对象的用户数',
+    `pending_count` int NOT NULL DEFAULT 0 COMMENT '上次处理之后新增的举报用户数',
+    `hidden` tinyint(1) NOT NULL DEFAULT 0,
+    `status` tinyint NOT NULL DEFAULT 0 COMMENT '0待处理 1已分配 2确认违规 3未发现违规',
+    `assignee_id` bigint NOT NULL DEFAULT 0,
+    `note` varchar(255) NOT NULL DEFAULT '',
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `resolved_at` datetime NULL DEFAULT NULL,
+    PRIMARY KEY (`case_id`),
+    UNIQUE KEY `uk_target` (`target_type`, `target_id`),
+    KEY `idx_status_case` (`status`, `case_id`),
+    KEY `idx_video_hidden` (`video_id`, `hidden`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='举报工单表';
+
+-- 创建举报表（同一用户对同一对象只保留一条举报）
+CREATE TABLE IF NOT EXISTS `reports` (
+    `report_id` bigint NOT NULL AUTO_INCREMENT,
+    `case_id` bigint NOT NULL,
+    `target_type` tinyint NOT NULL,
+    `target_id` bigint NOT NULL,
+    `reporter_id` bigint NOT NULL,
+    `reason` tinyint NOT NULL COMMENT '1垃圾广告 2骚扰辱骂 3仇恨言论 4暴力血腥 5色情低俗 6虚假信息 7违法违规 8其他',
+    `detail` varchar(512) NOT NULL DEFAULT '',
+    `notified` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否已通知举报人处理结果',
+    `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`report_id`),
+    UNIQUE KEY `uk_reporter_target` (`target_type`, `target_id`, `reporter_id`),
+    KEY `idx_case` (`case_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='举报表';
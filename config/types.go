@@ -7,6 +7,11 @@ type config struct {
 	Redis           redis           `yaml:"redis" mapstructure:"redis"`
 	Etcd            etcd            `yaml:"etcd" mapstructure:"etcd"`
 	RabbitMq        rabbitmq        `yaml:"rabbitmq" mapstructure:"rabbitmq"`
+	IDGen           idgen           `yaml:"idgen" mapstructure:"idgen"`
+	Comment         comment         `yaml:"comment" mapstructure:"comment"`
+	Moderation      moderation      `yaml:"moderation" mapstructure:"moderation"`
+	Report          report          `yaml:"report" mapstructure:"report"`
+	Block           block           `yaml:"block" mapstructure:"block"`
 }
 
 type mysql struct {
@@ -26,6 +31,9 @@ type commentSharding struct {
 	ConnMaxLifetime string     `yaml:"conn_max_lifetime" mapstructure:"conn_max_lifetime"`
 	MasterDSNs      []string   `yaml:"master_dsns" mapstructure:"master_dsns"`
 	SlaveDSNs       [][]string `yaml:"slave_dsns" mapstructure:"slave_dsns"`
+	// 从库复制延迟上限和健康检查间隔，如"5s"，为空时使用默认值
+	ReplicaMaxLag        string `yaml:"replica_max_lag" mapstructure:"replica_max_lag"`
+	ReplicaCheckInterval string `yaml:"replica_check_interval" mapstructure:"replica_check_interval"`
 }
 
 type FollowsSharding struct {
@@ -36,6 +44,9 @@ type FollowsSharding struct {
 	ConnMaxLifetime string     `yaml:"conn_max_lifetime" mapstructure:"conn_max_lifetime"`
 	MasterDSNs      []string   `yaml:"master_dsns" mapstructure:"master_dsns"`
 	SlaveDSNs       [][]string `yaml:"slave_dsns" mapstructure:"slave_dsns"`
+	// 从库复制延迟上限和健康检查间隔，如"5s"，为空时使用默认值
+	ReplicaMaxLag        string `yaml:"replica_max_lag" mapstructure:"replica_max_lag"`
+	ReplicaCheckInterval string `yaml:"replica_check_interval" mapstructure:"replica_check_interval"`
 }
 
 type redis struct {
@@ -45,8 +56,47 @@ type redis struct {
 type etcd struct {
 	Addr string `yaml:"addr"`
 }
+type idgen struct {
+	Mode string `yaml:"mode"` // snowflake或segment
+	// 雪花模式下节点ID租约的有效期和允许等待的最大时钟回拨，如"10s"，为空时使用默认值
+	LeaseTTL         string `yaml:"lease_ttl" mapstructure:"lease_ttl"`
+	MaxClockBackward string `yaml:"max_clock_backward" mapstructure:"max_clock_backward"`
+	// 号段模式下新业务的默认步长
+	SegmentStep int64 `yaml:"segment_step" mapstructure:"segment_step"`
+}
+
+type comment struct {
+	// 评论发布后允许作者编辑的时长，如"15m"，为空时使用默认值
+	EditWindow string `yaml:"edit_window" mapstructure:"edit_window"`
+}
+
+type moderation struct {
+	// 敏感词文件路径，为空时从数据库的sensitive_words表加载
+	WordsFile string `yaml:"words_file" mapstructure:"words_file"`
+	// 重新加载敏感词的间隔，如"1m"，为空时使用默认值
+	ReloadInterval string `yaml:"reload_interval" mapstructure:"reload_interval"`
+	// 风险分达到ReviewScore进入人工审核，达到RejectScore直接拒绝，为0时使用默认值
+	ReviewScore int `yaml:"review_score" mapstructure:"review_score"`
+	RejectScore int `yaml:"reject_score" mapstructure:"reject_score"`
+}
+
+type report struct {
+	// 不同用户的举报数达到该值时自动隐藏被举报的内容，为0时使用默认值
+	HideThreshold int `yaml:"hide_threshold" mapstructure:"hide_threshold"`
+}
+
+type block struct {
+	// 关系或互动服务不可用、无法确认拉黑状态或视频是否可见时是否放行互动和展示视频，默认拒绝并让客户端重试
+	FailOpen bool `yaml:"fail_open" mapstructure:"fail_open"`
+}
+
 type rabbitmq struct {
 	Addr     string `yaml:"addr"`
 	Username string `yaml:"username"`
 	Password string `yaml:"password"`
+	// 订阅的默认预取数和并发处理数，为0时使用默认值
+	Prefetch    int `yaml:"prefetch"`
+	Concurrency int `yaml:"concurrency"`
+	// 关闭时等待正在处理的消息完成的最长时间，如"10s"
+	ShutdownTimeout string `yaml:"shutdown_timeout" mapstructure:"shutdown_timeout"`
 }
@@ -15,12 +15,25 @@ struct User {
     7: string created_at
     8: string updated_at
     9: string deleted_at
+    10: string bio                // 个人简介
+    11: string website            // 个人网站
+    12: string birthday           // 生日，格式 YYYY-MM-DD
+    13: string background_url     // 主页背景图
+    14: bool is_private           // 私密账号
+    15: bool hide_likes           // 隐藏点赞列表
+    16: bool hide_following       // 隐藏关注列表
+    17: i64 follower_count        // 粉丝数（冗余计数）
+    18: i64 following_count       // 关注数（冗余计数）
+    19: i64 total_likes           // 获赞总数（冗余计数）
+    20: i64 video_count           // 作品数（冗余计数）
 }
 
 struct UserLite {
     1: i64 uid
     2: string user_name
     3: string avatar_url
+    4: string remark        // 关注备注，仅本人查看自己的关注列表时返回
+    5: i64 follow_type      // 关注类型 1:普通关注 2:特别关注 3:悄悄关注，仅本人查看自己的关注列表时返回
 }
 
 struct Video{
@@ -57,6 +70,9 @@ struct Comment{
     9: string updated_at
     10: string deleted_at
     11: i64 reply_to_comment_id  // 实际回复目标评论ID，用于记录互动关系
+    12: bool author_liked        // 视频作者是否点赞了该评论，只在评论串列表中填充
+    13: bool edited              // 评论发布后是否被编辑过
+    14: string edited_at         // 最近一次编辑的时间，未编辑过为空
 }
 
 struct Favorite{
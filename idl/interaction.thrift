@@ -19,10 +19,15 @@ struct LikeListRequest {
     1: i64 user_id
     2: i64 page_num
     3: i64 page_size
+    4: string cursor    // 上一页返回的next_cursor，非空时按游标翻页并忽略page_num
+    5: bool use_cursor  // 为true时按游标翻页，用于请求游标分页的第一页；否则按page_num分页
+    6: i64 viewer_id    // 查看者ID，为0或与user_id相同时表示查看自己的列表
 }
 struct LikeListResponse {
     1: base.Status base
     2: list<base.Video> items
+    3: bool has_more
+    4: string next_cursor
 }
 
 struct CreateCommentRequest {
@@ -36,6 +41,7 @@ struct CreateCommentRequest {
 
 struct CreateCommentResponse {
     1: base.Status base
+    2: bool pending_review   // 评论需要人工审核，审核通过后才会发布
 }
 struct ListCommentRequest {
     1: i64 video_id
@@ -43,10 +49,212 @@ struct ListCommentRequest {
     3: i64 page_num
     4: i64 page_size
     5: string sort_type  // "hot" for popular comments (default), "latest" for newest comments
+    6: string cursor     // 上一页返回的next_cursor，非空时按游标翻页并忽略page_num
+    7: bool use_cursor   // 为true时按游标翻页，用于请求游标分页的第一页；否则按page_num分页
 }
 struct ListCommentResponse {
     1: base.Status base
     2: list<base.Comment> items
+    3: bool has_more
+    4: string next_cursor
+}
+
+// 一级评论及其最早的几条回复
+struct CommentThread {
+    1: base.Comment comment
+    2: list<base.Comment> replies
+    3: i64 reply_count          // 直接回复总数
+    4: string replies_cursor    // 继续加载回复时作为ListComment的cursor（同时传comment_id），为空表示回复已全部返回
+    5: bool pinned              // 是否为视频作者置顶的评论
+}
+struct ListCommentThreadsRequest {
+    1: i64 video_id
+    2: string sort_type    // 一级评论的排序，同ListComment
+    3: string cursor       // 上一页返回的next_cursor
+    4: i64 page_size
+    5: i64 reply_count     // 每条一级评论内联的回复数，默认3
+}
+struct ListCommentThreadsResponse {
+    1: base.Status base
+    2: list<CommentThread> threads  // 第一页时置顶评论排在最前
+    3: bool has_more
+    4: string next_cursor
+}
+
+struct PinCommentRequest {
+    1: i64 video_id
+    2: i64 comment_id
+    3: i64 user_id
+    4: bool pin            // false时取消视频的置顶评论
+}
+struct PinCommentResponse {
+    1: base.Status base
+}
+
+struct EditCommentRequest {
+    1: i64 comment_id
+    2: i64 user_id
+    3: string content
+}
+struct EditCommentResponse {
+    1: base.Status base
+    2: base.Comment comment   // 编辑后的评论
+}
+
+// 评论被编辑前的一个版本
+struct CommentVersion {
+    1: string content
+    2: string replaced_at    // 该版本被替换的时间
+}
+struct ListCommentEditsRequest {
+    1: i64 comment_id
+}
+struct ListCommentEditsResponse {
+    1: base.Status base
+    2: list<CommentVersion> versions   // 按编辑时间倒序
+}
+
+struct CommentReview {
+    1: i64 review_id
+    2: i64 comment_id
+    3: i64 video_id
+    4: i64 user_id
+    5: i64 parent_id
+    6: string content
+    7: i64 score           // 内容检查的风险分
+    8: list<string> reasons // 命中的风险项
+    9: i64 status           // 0待审核 1已通过 2已拒绝
+    10: i64 reviewer_id
+    11: string note
+    12: string created_at
+    13: string reviewed_at
+}
+
+struct ListCommentReviewsRequest {
+    1: i64 admin_id
+    2: i64 status      // 默认列出待审核的评论
+    3: i64 cursor      // 上一页返回的next_cursor，首页为0
+    4: i64 page_size
+}
+struct ListCommentReviewsResponse {
+    1: base.Status base
+    2: list<CommentReview> reviews
+    3: bool has_more
+    4: i64 next_cursor
+}
+
+struct ApproveCommentReviewRequest {
+    1: i64 admin_id
+    2: i64 review_id
+}
+struct ApproveCommentReviewResponse {
+    1: base.Status base
+    2: base.Comment comment   // 审核通过后发布的评论
+}
+
+struct RejectCommentReviewRequest {
+    1: i64 admin_id
+    2: i64 review_id
+    3: string note
+}
+struct RejectCommentReviewResponse {
+    1: base.Status base
+}
+
+struct ReportRequest {
+    1: i64 reporter_id
+    2: i64 target_type   // 1视频 2评论 3用户
+    3: i64 target_id
+    4: i64 reason        // 1垃圾广告 2骚扰辱骂 3仇恨言论 4暴力血腥 5色情低俗 6虚假信息 7违法违规 8其他
+    5: string detail
+}
+struct ReportResponse {
+    1: base.Status base
+    2: i64 case_id
+    3: bool duplicate    // 之前已经举报过该对象，本次不重复计数
+}
+
+struct ReportCase {
+    1: i64 case_id
+    2: i64 target_type
+    3: i64 target_id
+    4: i64 target_user_id
+    5: i64 report_count          // 举报过该对象的用户数
+    6: i64 pending_count         // 上次处理之后新增的举报用户数
+    7: map<i64, i64> reason_counts // 举报原因 -> 举报数
+    8: bool hidden
+    9: i64 status                // 0待处理 1已分配 2确认违规 3未发现违规
+    10: i64 assignee_id
+    11: string note
+    12: string created_at
+    13: string resolved_at
+}
+
+struct ListReportCasesRequest {
+    1: i64 admin_id
+    2: i64 status        // 默认列出待处理的工单
+    3: i64 target_type   // 为0时不过滤
+    4: i64 assignee_id   // 为0时不过滤
+    5: i64 cursor        // 上一页返回的next_cursor，首页为0
+    6: i64 page_size
+}
+struct ListReportCasesResponse {
+    1: base.Status base
+    2: list<ReportCase> cases
+    3: bool has_more
+    4: i64 next_cursor
+}
+
+struct AssignReportCaseRequest {
+    1: i64 admin_id
+    2: i64 case_id
+    3: i64 assignee_id   // 为0时分配给自己
+}
+struct AssignReportCaseResponse {
+    1: base.Status base
+}
+
+struct ResolveReportCaseRequest {
+    1: i64 admin_id
+    2: i64 case_id
+    3: string note
+}
+struct ResolveReportCaseResponse {
+    1: base.Status base
+}
+
+struct DismissReportCaseRequest {
+    1: i64 admin_id
+    2: i64 case_id
+    3: string note
+}
+struct DismissReportCaseResponse {
+    1: base.Status base
+}
+
+// 供视频和用户服务批量检查因举报被隐藏的对象
+struct GetHiddenTargetsRequest {
+    1: i64 target_type   // 1视频 2评论 3用户
+    2: list<i64> target_ids
+}
+struct GetHiddenTargetsResponse {
+    1: base.Status base
+    2: list<i64> hidden_ids  // target_ids中被隐藏的对象
+}
+
+// 供关系服务计算关注推荐：与user_id点赞或评论过相同视频的其他用户
+struct GetCoInteractionsRequest {
+    1: i64 user_id
+    2: i64 video_limit   // 参与计算的最近互动视频数
+    3: i64 limit         // 返回的用户数上限
+}
+struct CoInteraction {
+    1: i64 user_id
+    2: i64 count         // 共同互动的视频数
+}
+struct GetCoInteractionsResponse {
+    1: base.Status base
+    2: list<CoInteraction> items  // 按共同互动的视频数降序
 }
 
 struct CommentDeleteRequest {
@@ -133,15 +341,100 @@ struct MarkNotificationReadResponse {
     2: i64 marked_count
 }
 
+// ========== 死信队列管理 ==========
+struct DeadLetterQueueInfo {
+    1: string queue               // 消费队列
+    2: string dead_letter_queue   // 对应的死信队列
+    3: i64 message_count
+}
+
+struct DeadLetterMessage {
+    1: string message_id
+    2: string queue
+    3: i64 attempts               // 进入死信队列前失败的次数
+    4: string last_error
+    5: i64 dead_lettered_at       // unix秒
+    6: i64 published_at           // unix秒
+    7: binary body
+}
+
+struct ListDeadLetterQueuesRequest {
+}
+
+struct ListDeadLetterQueuesResponse {
+    1: base.Status base
+    2: list<DeadLetterQueueInfo> queues
+}
+
+struct ListDeadLettersRequest {
+    1: string queue
+    2: i64 limit                  // 不大于0时列出全部
+}
+
+struct ListDeadLettersResponse {
+    1: base.Status base
+    2: list<DeadLetterMessage> messages
+}
+
+struct GetDeadLetterRequest {
+    1: string queue
+    2: string message_id
+}
+
+struct GetDeadLetterResponse {
+    1: base.Status base
+    2: DeadLetterMessage message
+}
+
+struct ReplayDeadLettersRequest {
+    1: string queue
+    2: list<string> message_ids   // 为空时重放全部
+}
+
+struct ReplayDeadLettersResponse {
+    1: base.Status base
+    2: i64 replayed_count
+}
+
+struct PurgeDeadLettersRequest {
+    1: string queue
+    2: list<string> message_ids   // 为空时清空死信队列
+}
+
+struct PurgeDeadLettersResponse {
+    1: base.Status base
+    2: i64 purged_count
+}
+
 service InteractionService {
     LikeActionResponse LikeAction(1: LikeActionRequest req)(api.post="/v1/action/like")
     LikeListResponse LikeList(1: LikeListRequest req)(api.get="/v1/action/list")
     CreateCommentResponse CreateComment(1:CreateCommentRequest req)(api.post="/v1/comment/publish")
     ListCommentResponse ListComment(1:ListCommentRequest req)(api.get="/v1/comment/list")
+    ListCommentThreadsResponse ListCommentThreads(1:ListCommentThreadsRequest req)(api.get="/v1/comment/threads")
+    PinCommentResponse PinComment(1:PinCommentRequest req)(api.post="/v1/comment/pin")
+    EditCommentResponse EditComment(1:EditCommentRequest req)(api.post="/v1/comment/edit")
+    ListCommentEditsResponse ListCommentEdits(1:ListCommentEditsRequest req)(api.get="/v1/comment/edits")
+    ListCommentReviewsResponse ListCommentReviews(1:ListCommentReviewsRequest req)(api.get="/v1/comment/reviews")
+    ApproveCommentReviewResponse ApproveCommentReview(1:ApproveCommentReviewRequest req)(api.post="/v1/comment/review/approve")
+    RejectCommentReviewResponse RejectCommentReview(1:RejectCommentReviewRequest req)(api.post="/v1/comment/review/reject")
+    ReportResponse Report(1:ReportRequest req)(api.post="/v1/report")
+    ListReportCasesResponse ListReportCases(1:ListReportCasesRequest req)(api.get="/v1/report/cases")
+    AssignReportCaseResponse AssignReportCase(1:AssignReportCaseRequest req)(api.post="/v1/report/case/assign")
+    ResolveReportCaseResponse ResolveReportCase(1:ResolveReportCaseRequest req)(api.post="/v1/report/case/resolve")
+    DismissReportCaseResponse DismissReportCase(1:DismissReportCaseRequest req)(api.post="/v1/report/case/dismiss")
+    GetHiddenTargetsResponse GetHiddenTargets(1:GetHiddenTargetsRequest req)
+    GetCoInteractionsResponse GetCoInteractions(1:GetCoInteractionsRequest req)
     CommentDeleteResponse DeleteComment(1:CommentDeleteRequest req)(api.delete="/v1/comment/delete")
     VideoPopularListResponse VideoPopularList(1: VideoPopularListRequest req)
     DeleteVideoInfoResponse DeleteVideoInfo(1: DeleteVideoInfoRequest req)
     // 消息队列事件处理
     GetNotificationsResponse GetNotifications(1: GetNotificationsRequest req)(api.get="/v1/notifications")
     MarkNotificationReadResponse MarkNotificationRead(1: MarkNotificationReadRequest req)(api.post="/v1/notifications/read")
+    // 死信队列管理
+    ListDeadLetterQueuesResponse ListDeadLetterQueues(1: ListDeadLetterQueuesRequest req)
+    ListDeadLettersResponse ListDeadLetters(1: ListDeadLettersRequest req)
+    GetDeadLetterResponse GetDeadLetter(1: GetDeadLetterRequest req)
+    ReplayDeadLettersResponse ReplayDeadLetters(1: ReplayDeadLettersRequest req)
+    PurgeDeadLettersResponse PurgeDeadLetters(1: PurgeDeadLettersRequest req)
 }    
\ No newline at end of file
@@ -9,28 +9,40 @@ struct RelationServiceRequest {
 }
 struct RelationServiceResponse {
     1: base.Status base
+    2: bool pending     // 对方是私密账号时，关注操作会变为待通过的关注请求
 }
 
 struct FollowingListRequest {
     1: i64 user_id 
     2: i64 page_num (vt.ge="0")
     3: i64 page_size (vt.gt="0")
+    4: i64 viewer_id    // 查看者ID，为0或与user_id相同时表示查看自己的列表
+    5: i64 follow_type  // 按关注类型过滤 0:全部 1:普通关注 2:特别关注 3:悄悄关注，仅查看自己的列表时生效
+    6: string cursor    // 上一页返回的next_cursor，非空时按游标翻页并忽略page_num
+    7: bool use_cursor  // 为true时按游标翻页，用于请求游标分页的第一页；否则按page_num分页
 }
 struct FollowingListResponse {
     1: base.Status base
     2: list<base.UserLite> items
     3: i64 total
+    4: bool has_more
+    5: string next_cursor
 }
 
 struct FollowerListRequest {
     1: i64 user_id 
     2: i64 page_num (vt.ge="0")
     3: i64 page_size (vt.gt="0")    
+    4: i64 viewer_id    // 查看者ID，为0或与user_id相同时表示查看自己的列表
+    5: string cursor    // 上一页返回的next_cursor，非空时按游标翻页并忽略page_num
+    6: bool use_cursor  // 为true时按游标翻页，用于请求游标分页的第一页；否则按page_num分页
 }
 struct FollowerListResponse {
     1: base.Status base
     2: list<base.UserLite> items
     3: i64 total
+    4: bool has_more
+    5: string next_cursor
 }
 
 struct FriendListRequest {
@@ -44,9 +56,152 @@ struct FriendListResponse {
     3: i64 total    
 }
 
+// 私密账号收到的关注请求
+struct FollowRequestListRequest {
+    1: i64 user_id
+    2: i64 page_num (vt.ge="0")
+    3: i64 page_size (vt.gt="0")
+}
+struct FollowRequestListResponse {
+    1: base.Status base
+    2: list<base.UserLite> items
+    3: i64 total
+}
+
+struct AcceptFollowRequestRequest {
+    1: i64 user_id          // 处理请求的用户（被关注者）
+    2: i64 from_user_id     // 发起关注请求的用户
+}
+struct AcceptFollowRequestResponse {
+    1: base.Status base
+}
+
+struct RejectFollowRequestRequest {
+    1: i64 user_id
+    2: i64 from_user_id
+}
+struct RejectFollowRequestResponse {
+    1: base.Status base
+}
+
+// 拉黑/静音
+struct BlockActionRequest {
+    1: i64 user_id
+    2: i64 target_user_id
+    3: i64 action_type      // 1:拉黑 2:取消拉黑 3:静音 4:取消静音
+}
+struct BlockActionResponse {
+    1: base.Status base
+}
+
+struct BlockListRequest {
+    1: i64 user_id
+    2: i64 block_type       // 1:拉黑列表 2:静音列表
+    3: i64 page_num (vt.ge="0")
+    4: i64 page_size (vt.gt="0")
+}
+struct BlockListResponse {
+    1: base.Status base
+    2: list<base.UserLite> items
+    3: i64 total
+}
+
+// 供其他服务批量检查屏蔽关系
+struct CheckBlockRequest {
+    1: i64 user_id
+    2: list<i64> target_user_ids
+}
+struct CheckBlockResponse {
+    1: base.Status base
+    2: list<i64> blocked_user_ids  // 与user_id存在任一方向拉黑关系的用户
+    3: list<i64> muted_user_ids    // 被user_id静音的用户
+}
+
+// 特别关注/悄悄关注与备注
+struct UpdateFollowTypeRequest {
+    1: i64 user_id          // 关注者
+    2: i64 target_user_id   // 被关注者
+    3: i64 follow_type      // 1:普通关注 2:特别关注 3:悄悄关注
+}
+struct UpdateFollowTypeResponse {
+    1: base.Status base
+}
+
+struct SetFollowRemarkRequest {
+    1: i64 user_id
+    2: i64 target_user_id
+    3: string remark        // 为空表示清除备注
+}
+struct SetFollowRemarkResponse {
+    1: base.Status base
+}
+
+// 供其他服务查询关注类型，如通知服务判断是否为特别关注
+struct GetFollowTypeRequest {
+    1: i64 user_id          // 关注者
+    2: i64 target_user_id   // 被关注者
+}
+struct GetFollowTypeResponse {
+    1: base.Status base
+    2: i64 follow_type      // 0表示未关注（包括待通过的关注请求）
+}
+
+// 供其他服务获取关注的用户ID，如视频推荐，不包括待通过的关注请求
+struct GetFollowingIDsRequest {
+    1: i64 user_id
+    2: i64 limit            // 按关注时间倒序最多返回的数量，不大于0时使用默认上限
+}
+struct GetFollowingIDsResponse {
+    1: base.Status base
+    2: list<i64> user_ids
+}
+
+// 供其他服务分批获取粉丝ID，如发布视频时写入粉丝的关注流，包括悄悄关注，不包括待通过的关注请求
+struct GetFollowerIDsRequest {
+    1: i64 user_id
+    2: i64 after_id         // 按粉丝ID升序返回大于after_id的粉丝，第一批为0
+    3: i64 limit            // 不大于0时使用默认上限
+}
+struct GetFollowerIDsResponse {
+    1: base.Status base
+    2: list<i64> user_ids
+    3: bool has_more
+    4: i64 total            // user_ids的总数（包括悄悄关注），仅第一批返回
+}
+
+// 关注推荐（可能认识的人）
+struct FollowSuggestion {
+    1: base.UserLite user
+    2: string reason                // 推荐理由，如 "Followed by A and 3 others"
+    3: i64 mutual_count             // 我关注的人中有多少人关注了TA
+    4: i64 co_interaction_count     // 与我点赞/评论过相同视频的数量
+}
+struct FollowSuggestionRequest {
+    1: i64 user_id
+    2: i64 page_num (vt.ge="0")
+    3: i64 page_size (vt.gt="0")
+}
+struct FollowSuggestionResponse {
+    1: base.Status base
+    2: list<FollowSuggestion> items
+    3: i64 total
+}
+
 service FollowService {
     RelationServiceResponse RelationService (1: RelationServiceRequest req)(api.post="/v1/relation/action")
     FollowingListResponse FollowingList (1: FollowingListRequest req)(api.get="/v1/following/list")
     FollowerListResponse FollowerList (1: FollowerListRequest req)(api.get="/v1/follower/list")
     FriendListResponse FriendList (1: FriendListRequest req)(api.get="/v1/friend/list")
+    FollowRequestListResponse FollowRequestList (1: FollowRequestListRequest req)(api.get="/v1/relation/request/list")
+    AcceptFollowRequestResponse AcceptFollowRequest (1: AcceptFollowRequestRequest req)(api.post="/v1/relation/request/accept")
+    RejectFollowRequestResponse RejectFollowRequest (1: RejectFollowRequestRequest req)(api.post="/v1/relation/request/reject")
+    BlockActionResponse BlockAction (1: BlockActionRequest req)(api.post="/v1/relation/block/action")
+    BlockListResponse BlockList (1: BlockListRequest req)(api.get="/v1/relation/block/list")
+    CheckBlockResponse CheckBlock (1: CheckBlockRequest req)
+    UpdateFollowTypeResponse UpdateFollowType (1: UpdateFollowTypeRequest req)(api.post="/v1/relation/follow/type")
+    SetFollowRemarkResponse SetFollowRemark (1: SetFollowRemarkRequest req)(api.post="/v1/relation/follow/remark")
+    GetFollowTypeResponse GetFollowType (1: GetFollowTypeRequest req)
+    GetFollowingIDsResponse GetFollowingIDs (1: GetFollowingIDsRequest req)
+    GetFollowerIDsResponse GetFollowerIDs (1: GetFollowerIDsRequest req)
+    FollowSuggestionResponse FollowSuggestion (1: FollowSuggestionRequest req)(api.get="/v1/relation/suggestion")
 }
\ No newline at end of file
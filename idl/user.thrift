@@ -148,6 +148,41 @@ struct ChangePasswordResponse{
     1: base.Status base
 }
 
+// 更新个人资料，只修改请求中设置了的字段
+struct UpdateProfileRequest{
+    1: i64 user_id
+    2: optional string bio             (api.body="bio", api.form="bio", api.vd="len($)<=200")
+    3: optional string website         (api.body="website", api.form="website", api.vd="len($)<=255")
+    4: optional string birthday        (api.body="birthday", api.form="birthday")  // 格式 YYYY-MM-DD，为空字符串表示清除
+}
+struct UpdateProfileResponse{
+    1: base.Status base
+    2: base.User user
+}
+
+// 上传主页背景图
+struct UploadBackgroundRequest{
+    1: i64 user_id
+    2: binary data
+    3: i64 filesize
+}
+struct UploadBackgroundResponse{
+    1: base.Status base
+    2: string background_url
+}
+
+// 更新隐私设置，只修改请求中设置了的字段
+struct UpdatePrivacySettingsRequest{
+    1: i64 user_id
+    2: optional bool is_private        (api.body="is_private", api.form="is_private")
+    3: optional bool hide_likes        (api.body="hide_likes", api.form="hide_likes")
+    4: optional bool hide_following    (api.body="hide_following", api.form="hide_following")
+}
+struct UpdatePrivacySettingsResponse{
+    1: base.Status base
+    2: base.User user
+}
+
 service UserService {
    // ========== V1版本API（现有功能） ==========
    UpdateUserResponse UpdateUser(1: UpdateUserRequest req)(api.post="/v1/user/update")
@@ -167,4 +202,9 @@ service UserService {
    GetAvatarUploadUrlResponse GetAvatarUploadUrl(1: GetAvatarUploadUrlRequest req)(api.post="/v1/user/avatar/upload-url")
    UpdateAvatarResponse UpdateAvatar(1: UpdateAvatarRequest req)(api.post="/v1/user/avatar/update")
    ChangePasswordResponse ChangePassword(1: ChangePasswordRequest req)(api.post="/v1/user/change-password")
+
+   // ========== 个人资料与隐私设置 ==========
+   UpdateProfileResponse UpdateProfile(1: UpdateProfileRequest req)(api.post="/v1/user/profile/update")
+   UploadBackgroundResponse UploadBackground(1: UploadBackgroundRequest req)(api.post="/v1/user/background/upload")
+   UpdatePrivacySettingsResponse UpdatePrivacySettings(1: UpdatePrivacySettingsRequest req)(api.post="/v1/user/privacy/update")
 }
\ No newline at end of file
@@ -147,6 +147,9 @@ struct VideoFeedListRequestV2 {
     4: string category_filter
     5: string privacy_filter
     6: list<string> tag_filters
+    7: i64 viewer_id    // 查看者ID，用于过滤被拉黑/静音用户的视频
+    8: string cursor    // 上一页返回的next_cursor，非空时按游标翻页并忽略page_num
+    9: bool use_cursor  // 为true时按游标翻页，用于请求游标分页的第一页；否则按page_num分页
 }
 
 struct VideoFeedListResponseV2 {
@@ -166,6 +169,7 @@ struct VideoSearchRequestV2 {
     6: list<string> categories
     7: list<string> tags
     8: string sort_by
+    9: i64 viewer_id    // 查看者ID，用于过滤被拉黑/静音用户的视频
 }
 
 struct VideoSearchResponseV2 {
@@ -181,6 +185,7 @@ struct VideoPopularRequestV2 {
     2: i64 page_size
     3: string time_range
     4: string category
+    5: i64 viewer_id    // 查看者ID，用于过滤被拉黑/静音用户的视频
 }
 
 struct VideoPopularResponseV2 {
@@ -340,12 +345,17 @@ struct GetFavoriteVideoListRequestV2 {
     3: i64 page_num
     4: i64 page_size
     5: string sort_by
+    6: string cursor    // 上一页返回的next_cursor，非空时按游标翻页并忽略page_num
+    7: bool use_cursor  // 为true时按游标翻页，用于请求游标分页的第一页；否则按page_num分页
+    8: i64 viewer_id    // 查看者ID，为0或与user_id相同时表示查看自己的列表
 }
 
 struct GetFavoriteVideoListResponseV2 {
     1: base.Status base
     2: list<base.Video> video_list
     3: i64 total_count
+    4: bool has_more
+    5: string next_cursor
 }
 
 struct DeleteFavoriteRequestV2 {
@@ -399,6 +409,45 @@ struct RecommendVideoResponseV2 {
     4: string algorithm_used
 }
 
+// 相似视频（"看了又看"），由离线协同过滤任务计算，冷启动视频回退到同标签和搜索引擎
+struct RelatedVideosRequest {
+    1: i64 video_id
+    2: i64 user_id      // 查看者，用于过滤拉黑/静音的作者，未登录为0
+    3: i32 count
+}
+
+struct RelatedVideosResponse {
+    1: base.Status base
+    2: list<base.Video> video_list
+    3: string source    // 结果来源：item_cf、tags、search，多个来源用"+"连接
+}
+
+// 个性化"推荐"流：按用户兴趣画像召回并混入探索内容，近期已下发过的视频不再重复
+struct ForYouFeedRequest {
+    1: i64 user_id      // 未登录为0，只返回探索内容
+    2: i32 count
+}
+
+struct ForYouFeedResponse {
+    1: base.Status base
+    2: list<base.Video> video_list
+    3: i32 explore_count  // 其中探索内容的数量
+}
+
+// 关注流：只包含关注的作者发布的视频，按发布时间倒序，游标分页
+struct FollowingTimelineRequest {
+    1: i64 user_id
+    2: string cursor    // 上一页返回的next_cursor，第一页为空
+    3: i32 count
+}
+
+struct FollowingTimelineResponse {
+    1: base.Status base
+    2: list<base.Video> video_list
+    3: string next_cursor
+    4: bool has_more
+}
+
 // ========== V2扩展功能：存储管理 ==========
 struct VideoStorageInfo {
     1: i64 user_id
@@ -533,6 +582,9 @@ service VideoService {
     // 分享功能
     SharedVideoResponseV2 SharedVideoV2(1: SharedVideoRequestV2 req)(api.post="/v2/video/share")
     RecommendVideoResponseV2 RecommendVideoV2(1: RecommendVideoRequestV2 req)(api.get="/v2/video/recommend")
+    RelatedVideosResponse RelatedVideos(1: RelatedVideosRequest req)(api.get="/v2/video/related")
+    ForYouFeedResponse ForYouFeed(1: ForYouFeedRequest req)(api.get="/v2/video/foryou")
+    FollowingTimelineResponse FollowingTimeline(1: FollowingTimelineRequest req)(api.get="/v2/video/following")
     
     // 存储管理
     VideoHeatManagementResponse ManageVideoHeatV2(1: VideoHeatManagementRequest req)(api.post="/v2/storage/heat/manage")
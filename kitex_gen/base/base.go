@@ -45,15 +45,26 @@ var fieldIDToName_Status = map[int16]string{
 }
 
 type User struct {
-	UserId    int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
-	UserName  string `thrift:"user_name,2" frugal:"2,default,string" json:"user_name"`
-	Email     string `thrift:"email,3" frugal:"3,default,string" json:"email"`
-	Password  string `thrift:"password,4" frugal:"4,default,string" json:"password"`
-	Sex       int64  `thrift:"sex,5" frugal:"5,default,i64" json:"sex"`
-	AvatarUrl string `thrift:"avatar_url,6" frugal:"6,default,string" json:"avatar_url"`
-	CreatedAt string `thrift:"created_at,7" frugal:"7,default,string" json:"created_at"`
-	UpdatedAt string `thrift:"updated_at,8" frugal:"8,default,string" json:"updated_at"`
-	DeletedAt string `thrift:"deleted_at,9" frugal:"9,default,string" json:"deleted_at"`
+	UserId         int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	UserName       string `thrift:"user_name,2" frugal:"2,default,string" json:"user_name"`
+	Email          string `thrift:"email,3" frugal:"3,default,string" json:"email"`
+	Password       string `thrift:"password,4" frugal:"4,default,string" json:"password"`
+	Sex            int64  `thrift:"sex,5" frugal:"5,default,i64" json:"sex"`
+	AvatarUrl      string `thrift:"avatar_url,6" frugal:"6,default,string" json:"avatar_url"`
+	CreatedAt      string `thrift:"created_at,7" frugal:"7,default,string" json:"created_at"`
+	UpdatedAt      string `thrift:"updated_at,8" frugal:"8,default,string" json:"updated_at"`
+	DeletedAt      string `thrift:"deleted_at,9" frugal:"9,default,string" json:"deleted_at"`
+	Bio            string `thrift:"bio,10" frugal:"10,default,string" json:"bio"`
+	Website        string `thrift:"website,11" frugal:"11,default,string" json:"website"`
+	Birthday       string `thrift:"birthday,12" frugal:"12,default,string" json:"birthday"`
+	BackgroundUrl  string `thrift:"background_url,13" frugal:"13,default,string" json:"background_url"`
+	IsPrivate      bool   `thrift:"is_private,14" frugal:"14,default,bool" json:"is_private"`
+	HideLikes      bool   `thrift:"hide_likes,15" frugal:"15,default,bool" json:"hide_likes"`
+	HideFollowing  bool   `thrift:"hide_following,16" frugal:"16,default,bool" json:"hide_following"`
+	FollowerCount  int64  `thrift:"follower_count,17" frugal:"17,default,i64" json:"follower_count"`
+	FollowingCount int64  `thrift:"following_count,18" frugal:"18,default,i64" json:"following_count"`
+	TotalLikes     int64  `thrift:"total_likes,19" frugal:"19,default,i64" json:"total_likes"`
+	VideoCount     int64  `thrift:"video_count,20" frugal:"20,default,i64" json:"video_count"`
 }
 
 func NewUser() *User {
@@ -98,6 +109,50 @@ func (p *User) GetUpdatedAt() (v string) {
 func (p *User) GetDeletedAt() (v string) {
 	return p.DeletedAt
 }
+
+func (p *User) GetBio() (v string) {
+	return p.Bio
+}
+
+func (p *User) GetWebsite() (v string) {
+	return p.Website
+}
+
+func (p *User) GetBirthday() (v string) {
+	return p.Birthday
+}
+
+func (p *User) GetBackgroundUrl() (v string) {
+	return p.BackgroundUrl
+}
+
+func (p *User) GetIsPrivate() (v bool) {
+	return p.IsPrivate
+}
+
+func (p *User) GetHideLikes() (v bool) {
+	return p.HideLikes
+}
+
+func (p *User) GetHideFollowing() (v bool) {
+	return p.HideFollowing
+}
+
+func (p *User) GetFollowerCount() (v int64) {
+	return p.FollowerCount
+}
+
+func (p *User) GetFollowingCount() (v int64) {
+	return p.FollowingCount
+}
+
+func (p *User) GetTotalLikes() (v int64) {
+	return p.TotalLikes
+}
+
+func (p *User) GetVideoCount() (v int64) {
+	return p.VideoCount
+}
 func (p *User) SetUserId(val int64) {
 	p.UserId = val
 }
@@ -125,6 +180,39 @@ func (p *User) SetUpdatedAt(val string) {
 func (p *User) SetDeletedAt(val string) {
 	p.DeletedAt = val
 }
+func (p *User) SetBio(val string) {
+	p.Bio = val
+}
+func (p *User) SetWebsite(val string) {
+	p.Website = val
+}
+func (p *User) SetBirthday(val string) {
+	p.Birthday = val
+}
+func (p *User) SetBackgroundUrl(val string) {
+	p.BackgroundUrl = val
+}
+func (p *User) SetIsPrivate(val bool) {
+	p.IsPrivate = val
+}
+func (p *User) SetHideLikes(val bool) {
+	p.HideLikes = val
+}
+func (p *User) SetHideFollowing(val bool) {
+	p.HideFollowing = val
+}
+func (p *User) SetFollowerCount(val int64) {
+	p.FollowerCount = val
+}
+func (p *User) SetFollowingCount(val int64) {
+	p.FollowingCount = val
+}
+func (p *User) SetTotalLikes(val int64) {
+	p.TotalLikes = val
+}
+func (p *User) SetVideoCount(val int64) {
+	p.VideoCount = val
+}
 
 func (p *User) String() string {
 	if p == nil {
@@ -134,21 +222,34 @@ func (p *User) String() string {
 }
 
 var fieldIDToName_User = map[int16]string{
-	1: "user_id",
-	2: "user_name",
-	3: "email",
-	4: "password",
-	5: "sex",
-	6: "avatar_url",
-	7: "created_at",
-	8: "updated_at",
-	9: "deleted_at",
+	1:  "user_id",
+	2:  "user_name",
+	3:  "email",
+	4:  "password",
+	5:  "sex",
+	6:  "avatar_url",
+	7:  "created_at",
+	8:  "updated_at",
+	9:  "deleted_at",
+	10: "bio",
+	11: "website",
+	12: "birthday",
+	13: "background_url",
+	14: "is_private",
+	15: "hide_likes",
+	16: "hide_following",
+	17: "follower_count",
+	18: "following_count",
+	19: "total_likes",
+	20: "video_count",
 }
 
 type UserLite struct {
-	Uid       int64  `thrift:"uid,1" frugal:"1,default,i64" json:"uid"`
-	UserName  string `thrift:"user_name,2" frugal:"2,default,string" json:"user_name"`
-	AvatarUrl string `thrift:"avatar_url,3" frugal:"3,default,string" json:"avatar_url"`
+	Uid        int64  `thrift:"uid,1" frugal:"1,default,i64" json:"uid"`
+	UserName   string `thrift:"user_name,2" frugal:"2,default,string" json:"user_name"`
+	AvatarUrl  string `thrift:"avatar_url,3" frugal:"3,default,string" json:"avatar_url"`
+	Remark     string `thrift:"remark,4" frugal:"4,default,string" json:"remark"`
+	FollowType int64  `thrift:"follow_type,5" frugal:"5,default,i64" json:"follow_type"`
 }
 
 func NewUserLite() *UserLite {
@@ -169,6 +270,14 @@ func (p *UserLite) GetUserName() (v string) {
 func (p *UserLite) GetAvatarUrl() (v string) {
 	return p.AvatarUrl
 }
+
+func (p *UserLite) GetRemark() (v string) {
+	return p.Remark
+}
+
+func (p *UserLite) GetFollowType() (v int64) {
+	return p.FollowType
+}
 func (p *UserLite) SetUid(val int64) {
 	p.Uid = val
 }
@@ -178,6 +287,12 @@ func (p *UserLite) SetUserName(val string) {
 func (p *UserLite) SetAvatarUrl(val string) {
 	p.AvatarUrl = val
 }
+func (p *UserLite) SetRemark(val string) {
+	p.Remark = val
+}
+func (p *UserLite) SetFollowType(val int64) {
+	p.FollowType = val
+}
 
 func (p *UserLite) String() string {
 	if p == nil {
@@ -190,6 +305,8 @@ var fieldIDToName_UserLite = map[int16]string{
 	1: "uid",
 	2: "user_name",
 	3: "avatar_url",
+	4: "remark",
+	5: "follow_type",
 }
 
 type Video struct {
@@ -395,6 +512,9 @@ type Comment struct {
 	UpdatedAt        string `thrift:"updated_at,9" frugal:"9,default,string" json:"updated_at"`
 	DeletedAt        string `thrift:"deleted_at,10" frugal:"10,default,string" json:"deleted_at"`
 	ReplyToCommentId int64  `thrift:"reply_to_comment_id,11" frugal:"11,default,i64" json:"reply_to_comment_id"`
+	AuthorLiked      bool   `thrift:"author_liked,12" frugal:"12,default,bool" json:"author_liked"`
+	Edited           bool   `thrift:"edited,13" frugal:"13,default,bool" json:"edited"`
+	EditedAt         string `thrift:"edited_at,14" frugal:"14,default,string" json:"edited_at"`
 }
 
 func NewComment() *Comment {
@@ -447,6 +567,18 @@ func (p *Comment) GetDeletedAt() (v string) {
 func (p *Comment) GetReplyToCommentId() (v int64) {
 	return p.ReplyToCommentId
 }
+
+func (p *Comment) GetAuthorLiked() (v bool) {
+	return p.AuthorLiked
+}
+
+func (p *Comment) GetEdited() (v bool) {
+	return p.Edited
+}
+
+func (p *Comment) GetEditedAt() (v string) {
+	return p.EditedAt
+}
 func (p *Comment) SetCommentId(val int64) {
 	p.CommentId = val
 }
@@ -480,6 +612,15 @@ func (p *Comment) SetDeletedAt(val string) {
 func (p *Comment) SetReplyToCommentId(val int64) {
 	p.ReplyToCommentId = val
 }
+func (p *Comment) SetAuthorLiked(val bool) {
+	p.AuthorLiked = val
+}
+func (p *Comment) SetEdited(val bool) {
+	p.Edited = val
+}
+func (p *Comment) SetEditedAt(val string) {
+	p.EditedAt = val
+}
 
 func (p *Comment) String() string {
 	if p == nil {
@@ -500,6 +641,9 @@ var fieldIDToName_Comment = map[int16]string{
 	9:  "updated_at",
 	10: "deleted_at",
 	11: "reply_to_comment_id",
+	12: "author_liked",
+	13: "edited",
+	14: "edited_at",
 }
 
 type Favorite struct {
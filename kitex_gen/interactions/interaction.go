@@ -121,9 +121,12 @@ var fieldIDToName_LikeActionResponse = map[int16]string{
 }
 
 type LikeListRequest struct {
-	UserId   int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
-	PageNum  int64 `thrift:"page_num,2" frugal:"2,default,i64" json:"page_num"`
-	PageSize int64 `thrift:"page_size,3" frugal:"3,default,i64" json:"page_size"`
+	UserId    int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	PageNum   int64  `thrift:"page_num,2" frugal:"2,default,i64" json:"page_num"`
+	PageSize  int64  `thrift:"page_size,3" frugal:"3,default,i64" json:"page_size"`
+	Cursor    string `thrift:"cursor,4" frugal:"4,default,string" json:"cursor"`
+	UseCursor bool   `thrift:"use_cursor,5" frugal:"5,default,bool" json:"use_cursor"`
+	ViewerId  int64  `thrift:"viewer_id,6" frugal:"6,default,i64" json:"viewer_id"`
 }
 
 func NewLikeListRequest() *LikeListRequest {
@@ -144,6 +147,18 @@ func (p *LikeListRequest) GetPageNum() (v int64) {
 func (p *LikeListRequest) GetPageSize() (v int64) {
 	return p.PageSize
 }
+
+func (p *LikeListRequest) GetCursor() (v string) {
+	return p.Cursor
+}
+
+func (p *LikeListRequest) GetUseCursor() (v bool) {
+	return p.UseCursor
+}
+
+func (p *LikeListRequest) GetViewerId() (v int64) {
+	return p.ViewerId
+}
 func (p *LikeListRequest) SetUserId(val int64) {
 	p.UserId = val
 }
@@ -153,6 +168,15 @@ func (p *LikeListRequest) SetPageNum(val int64) {
 func (p *LikeListRequest) SetPageSize(val int64) {
 	p.PageSize = val
 }
+func (p *LikeListRequest) SetCursor(val string) {
+	p.Cursor = val
+}
+func (p *LikeListRequest) SetUseCursor(val bool) {
+	p.UseCursor = val
+}
+func (p *LikeListRequest) SetViewerId(val int64) {
+	p.ViewerId = val
+}
 
 func (p *LikeListRequest) String() string {
 	if p == nil {
@@ -165,11 +189,16 @@ var fieldIDToName_LikeListRequest = map[int16]string{
 	1: "user_id",
 	2: "page_num",
 	3: "page_size",
+	4: "cursor",
+	5: "use_cursor",
+	6: "viewer_id",
 }
 
 type LikeListResponse struct {
-	Base  *base.Status  `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
-	Items []*base.Video `thrift:"items,2" frugal:"2,default,list<base.Video>" json:"items"`
+	Base       *base.Status  `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Items      []*base.Video `thrift:"items,2" frugal:"2,default,list<base.Video>" json:"items"`
+	HasMore    bool          `thrift:"has_more,3" frugal:"3,default,bool" json:"has_more"`
+	NextCursor string        `thrift:"next_cursor,4" frugal:"4,default,string" json:"next_cursor"`
 }
 
 func NewLikeListResponse() *LikeListResponse {
@@ -191,12 +220,26 @@ func (p *LikeListResponse) GetBase() (v *base.Status) {
 func (p *LikeListResponse) GetItems() (v []*base.Video) {
 	return p.Items
 }
+
+func (p *LikeListResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
+
+func (p *LikeListResponse) GetNextCursor() (v string) {
+	return p.NextCursor
+}
 func (p *LikeListResponse) SetBase(val *base.Status) {
 	p.Base = val
 }
 func (p *LikeListResponse) SetItems(val []*base.Video) {
 	p.Items = val
 }
+func (p *LikeListResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
+func (p *LikeListResponse) SetNextCursor(val string) {
+	p.NextCursor = val
+}
 
 func (p *LikeListResponse) IsSetBase() bool {
 	return p.Base != nil
@@ -212,6 +255,8 @@ func (p *LikeListResponse) String() string {
 var fieldIDToName_LikeListResponse = map[int16]string{
 	1: "base",
 	2: "items",
+	3: "has_more",
+	4: "next_cursor",
 }
 
 type CreateCommentRequest struct {
@@ -289,7 +334,8 @@ var fieldIDToName_CreateCommentRequest = map[int16]string{
 }
 
 type CreateCommentResponse struct {
-	Base *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Base          *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	PendingReview bool         `thrift:"pending_review,2" frugal:"2,default,bool" json:"pending_review"`
 }
 
 func NewCreateCommentResponse() *CreateCommentResponse {
@@ -307,9 +353,16 @@ func (p *CreateCommentResponse) GetBase() (v *base.Status) {
 	}
 	return p.Base
 }
+
+func (p *CreateCommentResponse) GetPendingReview() (v bool) {
+	return p.PendingReview
+}
 func (p *CreateCommentResponse) SetBase(val *base.Status) {
 	p.Base = val
 }
+func (p *CreateCommentResponse) SetPendingReview(val bool) {
+	p.PendingReview = val
+}
 
 func (p *CreateCommentResponse) IsSetBase() bool {
 	return p.Base != nil
@@ -324,6 +377,7 @@ func (p *CreateCommentResponse) String() string {
 
 var fieldIDToName_CreateCommentResponse = map[int16]string{
 	1: "base",
+	2: "pending_review",
 }
 
 type ListCommentRequest struct {
@@ -332,6 +386,8 @@ type ListCommentRequest struct {
 	PageNum   int64  `thrift:"page_num,3" frugal:"3,default,i64" json:"page_num"`
 	PageSize  int64  `thrift:"page_size,4" frugal:"4,default,i64" json:"page_size"`
 	SortType  string `thrift:"sort_type,5" frugal:"5,default,string" json:"sort_type"`
+	Cursor    string `thrift:"cursor,6" frugal:"6,default,string" json:"cursor"`
+	UseCursor bool   `thrift:"use_cursor,7" frugal:"7,default,bool" json:"use_cursor"`
 }
 
 func NewListCommentRequest() *ListCommentRequest {
@@ -360,6 +416,14 @@ func (p *ListCommentRequest) GetPageSize() (v int64) {
 func (p *ListCommentRequest) GetSortType() (v string) {
 	return p.SortType
 }
+
+func (p *ListCommentRequest) GetCursor() (v string) {
+	return p.Cursor
+}
+
+func (p *ListCommentRequest) GetUseCursor() (v bool) {
+	return p.UseCursor
+}
 func (p *ListCommentRequest) SetVideoId(val int64) {
 	p.VideoId = val
 }
@@ -375,6 +439,12 @@ func (p *ListCommentRequest) SetPageSize(val int64) {
 func (p *ListCommentRequest) SetSortType(val string) {
 	p.SortType = val
 }
+func (p *ListCommentRequest) SetCursor(val string) {
+	p.Cursor = val
+}
+func (p *ListCommentRequest) SetUseCursor(val bool) {
+	p.UseCursor = val
+}
 
 func (p *ListCommentRequest) String() string {
 	if p == nil {
@@ -389,11 +459,15 @@ var fieldIDToName_ListCommentRequest = map[int16]string{
 	3: "page_num",
 	4: "page_size",
 	5: "sort_type",
+	6: "cursor",
+	7: "use_cursor",
 }
 
 type ListCommentResponse struct {
-	Base  *base.Status    `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
-	Items []*base.Comment `thrift:"items,2" frugal:"2,default,list<base.Comment>" json:"items"`
+	Base       *base.Status    `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Items      []*base.Comment `thrift:"items,2" frugal:"2,default,list<base.Comment>" json:"items"`
+	HasMore    bool            `thrift:"has_more,3" frugal:"3,default,bool" json:"has_more"`
+	NextCursor string          `thrift:"next_cursor,4" frugal:"4,default,string" json:"next_cursor"`
 }
 
 func NewListCommentResponse() *ListCommentResponse {
@@ -415,12 +489,26 @@ func (p *ListCommentResponse) GetBase() (v *base.Status) {
 func (p *ListCommentResponse) GetItems() (v []*base.Comment) {
 	return p.Items
 }
+
+func (p *ListCommentResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
+
+func (p *ListCommentResponse) GetNextCursor() (v string) {
+	return p.NextCursor
+}
 func (p *ListCommentResponse) SetBase(val *base.Status) {
 	p.Base = val
 }
 func (p *ListCommentResponse) SetItems(val []*base.Comment) {
 	p.Items = val
 }
+func (p *ListCommentResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
+func (p *ListCommentResponse) SetNextCursor(val string) {
+	p.NextCursor = val
+}
 
 func (p *ListCommentResponse) IsSetBase() bool {
 	return p.Base != nil
@@ -436,1039 +524,4509 @@ func (p *ListCommentResponse) String() string {
 var fieldIDToName_ListCommentResponse = map[int16]string{
 	1: "base",
 	2: "items",
+	3: "has_more",
+	4: "next_cursor",
 }
 
-type CommentDeleteRequest struct {
-	VideoId    int64 `thrift:"video_id,1" frugal:"1,default,i64" json:"video_id"`
-	CommentId  int64 `thrift:"comment_id,2" frugal:"2,default,i64" json:"comment_id"`
-	FromUserId int64 `thrift:"from_user_id,3" frugal:"3,default,i64" json:"from_user_id"`
+type CommentThread struct {
+	Comment       *base.Comment   `thrift:"comment,1" frugal:"1,default,base.Comment" json:"comment"`
+	Replies       []*base.Comment `thrift:"replies,2" frugal:"2,default,list<base.Comment>" json:"replies"`
+	ReplyCount    int64           `thrift:"reply_count,3" frugal:"3,default,i64" json:"reply_count"`
+	RepliesCursor string          `thrift:"replies_cursor,4" frugal:"4,default,string" json:"replies_cursor"`
+	Pinned        bool            `thrift:"pinned,5" frugal:"5,default,bool" json:"pinned"`
 }
 
-func NewCommentDeleteRequest() *CommentDeleteRequest {
-	return &CommentDeleteRequest{}
+func NewCommentThread() *CommentThread {
+	return &CommentThread{}
 }
 
-func (p *CommentDeleteRequest) InitDefault() {
+func (p *CommentThread) InitDefault() {
 }
 
-func (p *CommentDeleteRequest) GetVideoId() (v int64) {
-	return p.VideoId
-}
+var CommentThread_Comment_DEFAULT *base.Comment
 
-func (p *CommentDeleteRequest) GetCommentId() (v int64) {
-	return p.CommentId
+func (p *CommentThread) GetComment() (v *base.Comment) {
+	if !p.IsSetComment() {
+		return CommentThread_Comment_DEFAULT
+	}
+	return p.Comment
 }
 
-func (p *CommentDeleteRequest) GetFromUserId() (v int64) {
-	return p.FromUserId
-}
-func (p *CommentDeleteRequest) SetVideoId(val int64) {
-	p.VideoId = val
-}
-func (p *CommentDeleteRequest) SetCommentId(val int64) {
-	p.CommentId = val
-}
-func (p *CommentDeleteRequest) SetFromUserId(val int64) {
-	p.FromUserId = val
+func (p *CommentThread) GetReplies() (v []*base.Comment) {
+	return p.Replies
 }
 
-func (p *CommentDeleteRequest) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("CommentDeleteRequest(%+v)", *p)
+func (p *CommentThread) GetReplyCount() (v int64) {
+	return p.ReplyCount
 }
 
-var fieldIDToName_CommentDeleteRequest = map[int16]string{
-	1: "video_id",
-	2: "comment_id",
-	3: "from_user_id",
+func (p *CommentThread) GetRepliesCursor() (v string) {
+	return p.RepliesCursor
 }
 
-type CommentDeleteResponse struct {
-	Base *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+func (p *CommentThread) GetPinned() (v bool) {
+	return p.Pinned
 }
-
-func NewCommentDeleteResponse() *CommentDeleteResponse {
-	return &CommentDeleteResponse{}
+func (p *CommentThread) SetComment(val *base.Comment) {
+	p.Comment = val
 }
-
-func (p *CommentDeleteResponse) InitDefault() {
+func (p *CommentThread) SetReplies(val []*base.Comment) {
+	p.Replies = val
 }
-
-var CommentDeleteResponse_Base_DEFAULT *base.Status
-
-func (p *CommentDeleteResponse) GetBase() (v *base.Status) {
-	if !p.IsSetBase() {
-		return CommentDeleteResponse_Base_DEFAULT
-	}
-	return p.Base
+func (p *CommentThread) SetReplyCount(val int64) {
+	p.ReplyCount = val
 }
-func (p *CommentDeleteResponse) SetBase(val *base.Status) {
-	p.Base = val
+func (p *CommentThread) SetRepliesCursor(val string) {
+	p.RepliesCursor = val
+}
+func (p *CommentThread) SetPinned(val bool) {
+	p.Pinned = val
 }
 
-func (p *CommentDeleteResponse) IsSetBase() bool {
-	return p.Base != nil
+func (p *CommentThread) IsSetComment() bool {
+	return p.Comment != nil
 }
 
-func (p *CommentDeleteResponse) String() string {
+func (p *CommentThread) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CommentDeleteResponse(%+v)", *p)
+	return fmt.Sprintf("CommentThread(%+v)", *p)
 }
 
-var fieldIDToName_CommentDeleteResponse = map[int16]string{
-	1: "base",
+var fieldIDToName_CommentThread = map[int16]string{
+	1: "comment",
+	2: "replies",
+	3: "reply_count",
+	4: "replies_cursor",
+	5: "pinned",
 }
 
-type VideoPopularListRequest struct {
-	PageNum  int64 `thrift:"page_num,1" frugal:"1,default,i64" json:"page_num"`
-	PageSize int64 `thrift:"page_size,2" frugal:"2,default,i64" json:"page_size"`
+type ListCommentThreadsRequest struct {
+	VideoId    int64  `thrift:"video_id,1" frugal:"1,default,i64" json:"video_id"`
+	SortType   string `thrift:"sort_type,2" frugal:"2,default,string" json:"sort_type"`
+	Cursor     string `thrift:"cursor,3" frugal:"3,default,string" json:"cursor"`
+	PageSize   int64  `thrift:"page_size,4" frugal:"4,default,i64" json:"page_size"`
+	ReplyCount int64  `thrift:"reply_count,5" frugal:"5,default,i64" json:"reply_count"`
 }
 
-func NewVideoPopularListRequest() *VideoPopularListRequest {
-	return &VideoPopularListRequest{}
+func NewListCommentThreadsRequest() *ListCommentThreadsRequest {
+	return &ListCommentThreadsRequest{}
 }
 
-func (p *VideoPopularListRequest) InitDefault() {
+func (p *ListCommentThreadsRequest) InitDefault() {
 }
 
-func (p *VideoPopularListRequest) GetPageNum() (v int64) {
-	return p.PageNum
+func (p *ListCommentThreadsRequest) GetVideoId() (v int64) {
+	return p.VideoId
 }
 
-func (p *VideoPopularListRequest) GetPageSize() (v int64) {
+func (p *ListCommentThreadsRequest) GetSortType() (v string) {
+	return p.SortType
+}
+
+func (p *ListCommentThreadsRequest) GetCursor() (v string) {
+	return p.Cursor
+}
+
+func (p *ListCommentThreadsRequest) GetPageSize() (v int64) {
 	return p.PageSize
 }
-func (p *VideoPopularListRequest) SetPageNum(val int64) {
-	p.PageNum = val
+
+func (p *ListCommentThreadsRequest) GetReplyCount() (v int64) {
+	return p.ReplyCount
 }
-func (p *VideoPopularListRequest) SetPageSize(val int64) {
+func (p *ListCommentThreadsRequest) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *ListCommentThreadsRequest) SetSortType(val string) {
+	p.SortType = val
+}
+func (p *ListCommentThreadsRequest) SetCursor(val string) {
+	p.Cursor = val
+}
+func (p *ListCommentThreadsRequest) SetPageSize(val int64) {
 	p.PageSize = val
 }
+func (p *ListCommentThreadsRequest) SetReplyCount(val int64) {
+	p.ReplyCount = val
+}
 
-func (p *VideoPopularListRequest) String() string {
+func (p *ListCommentThreadsRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoPopularListRequest(%+v)", *p)
+	return fmt.Sprintf("ListCommentThreadsRequest(%+v)", *p)
 }
 
-var fieldIDToName_VideoPopularListRequest = map[int16]string{
-	1: "page_num",
-	2: "page_size",
+var fieldIDToName_ListCommentThreadsRequest = map[int16]string{
+	1: "video_id",
+	2: "sort_type",
+	3: "cursor",
+	4: "page_size",
+	5: "reply_count",
 }
 
-type VideoPopularListResponse struct {
-	Base *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
-	Data []string     `thrift:"data,2" frugal:"2,default,list<string>" json:"data"`
+type ListCommentThreadsResponse struct {
+	Base       *base.Status     `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Threads    []*CommentThread `thrift:"threads,2" frugal:"2,default,list<CommentThread>" json:"threads"`
+	HasMore    bool             `thrift:"has_more,3" frugal:"3,default,bool" json:"has_more"`
+	NextCursor string           `thrift:"next_cursor,4" frugal:"4,default,string" json:"next_cursor"`
 }
 
-func NewVideoPopularListResponse() *VideoPopularListResponse {
-	return &VideoPopularListResponse{}
+func NewListCommentThreadsResponse() *ListCommentThreadsResponse {
+	return &ListCommentThreadsResponse{}
 }
 
-func (p *VideoPopularListResponse) InitDefault() {
+func (p *ListCommentThreadsResponse) InitDefault() {
 }
 
-var VideoPopularListResponse_Base_DEFAULT *base.Status
+var ListCommentThreadsResponse_Base_DEFAULT *base.Status
 
-func (p *VideoPopularListResponse) GetBase() (v *base.Status) {
+func (p *ListCommentThreadsResponse) GetBase() (v *base.Status) {
 	if !p.IsSetBase() {
-		return VideoPopularListResponse_Base_DEFAULT
+		return ListCommentThreadsResponse_Base_DEFAULT
 	}
 	return p.Base
 }
 
-func (p *VideoPopularListResponse) GetData() (v []string) {
-	return p.Data
+func (p *ListCommentThreadsResponse) GetThreads() (v []*CommentThread) {
+	return p.Threads
 }
-func (p *VideoPopularListResponse) SetBase(val *base.Status) {
+
+func (p *ListCommentThreadsResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
+
+func (p *ListCommentThreadsResponse) GetNextCursor() (v string) {
+	return p.NextCursor
+}
+func (p *ListCommentThreadsResponse) SetBase(val *base.Status) {
 	p.Base = val
 }
-func (p *VideoPopularListResponse) SetData(val []string) {
-	p.Data = val
+func (p *ListCommentThreadsResponse) SetThreads(val []*CommentThread) {
+	p.Threads = val
+}
+func (p *ListCommentThreadsResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
+func (p *ListCommentThreadsResponse) SetNextCursor(val string) {
+	p.NextCursor = val
 }
 
-func (p *VideoPopularListResponse) IsSetBase() bool {
+func (p *ListCommentThreadsResponse) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *VideoPopularListResponse) String() string {
+func (p *ListCommentThreadsResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoPopularListResponse(%+v)", *p)
+	return fmt.Sprintf("ListCommentThreadsResponse(%+v)", *p)
 }
 
-var fieldIDToName_VideoPopularListResponse = map[int16]string{
+var fieldIDToName_ListCommentThreadsResponse = map[int16]string{
 	1: "base",
-	2: "data",
+	2: "threads",
+	3: "has_more",
+	4: "next_cursor",
 }
 
-type DeleteVideoInfoRequest struct {
-	VideoId int64 `thrift:"video_id,1" frugal:"1,default,i64" json:"video_id"`
+type PinCommentRequest struct {
+	VideoId   int64 `thrift:"video_id,1" frugal:"1,default,i64" json:"video_id"`
+	CommentId int64 `thrift:"comment_id,2" frugal:"2,default,i64" json:"comment_id"`
+	UserId    int64 `thrift:"user_id,3" frugal:"3,default,i64" json:"user_id"`
+	Pin       bool  `thrift:"pin,4" frugal:"4,default,bool" json:"pin"`
 }
 
-func NewDeleteVideoInfoRequest() *DeleteVideoInfoRequest {
-	return &DeleteVideoInfoRequest{}
+func NewPinCommentRequest() *PinCommentRequest {
+	return &PinCommentRequest{}
 }
 
-func (p *DeleteVideoInfoRequest) InitDefault() {
+func (p *PinCommentRequest) InitDefault() {
 }
 
-func (p *DeleteVideoInfoRequest) GetVideoId() (v int64) {
+func (p *PinCommentRequest) GetVideoId() (v int64) {
 	return p.VideoId
 }
-func (p *DeleteVideoInfoRequest) SetVideoId(val int64) {
+
+func (p *PinCommentRequest) GetCommentId() (v int64) {
+	return p.CommentId
+}
+
+func (p *PinCommentRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *PinCommentRequest) GetPin() (v bool) {
+	return p.Pin
+}
+func (p *PinCommentRequest) SetVideoId(val int64) {
 	p.VideoId = val
 }
+func (p *PinCommentRequest) SetCommentId(val int64) {
+	p.CommentId = val
+}
+func (p *PinCommentRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *PinCommentRequest) SetPin(val bool) {
+	p.Pin = val
+}
 
-func (p *DeleteVideoInfoRequest) String() string {
+func (p *PinCommentRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteVideoInfoRequest(%+v)", *p)
+	return fmt.Sprintf("PinCommentRequest(%+v)", *p)
 }
 
-var fieldIDToName_DeleteVideoInfoRequest = map[int16]string{
+var fieldIDToName_PinCommentRequest = map[int16]string{
 	1: "video_id",
+	2: "comment_id",
+	3: "user_id",
+	4: "pin",
 }
 
-type DeleteVideoInfoResponse struct {
+type PinCommentResponse struct {
 	Base *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
 }
 
-func NewDeleteVideoInfoResponse() *DeleteVideoInfoResponse {
-	return &DeleteVideoInfoResponse{}
+func NewPinCommentResponse() *PinCommentResponse {
+	return &PinCommentResponse{}
 }
 
-func (p *DeleteVideoInfoResponse) InitDefault() {
+func (p *PinCommentResponse) InitDefault() {
 }
 
-var DeleteVideoInfoResponse_Base_DEFAULT *base.Status
+var PinCommentResponse_Base_DEFAULT *base.Status
 
-func (p *DeleteVideoInfoResponse) GetBase() (v *base.Status) {
+func (p *PinCommentResponse) GetBase() (v *base.Status) {
 	if !p.IsSetBase() {
-		return DeleteVideoInfoResponse_Base_DEFAULT
+		return PinCommentResponse_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *DeleteVideoInfoResponse) SetBase(val *base.Status) {
+func (p *PinCommentResponse) SetBase(val *base.Status) {
 	p.Base = val
 }
 
-func (p *DeleteVideoInfoResponse) IsSetBase() bool {
+func (p *PinCommentResponse) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *DeleteVideoInfoResponse) String() string {
+func (p *PinCommentResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteVideoInfoResponse(%+v)", *p)
+	return fmt.Sprintf("PinCommentResponse(%+v)", *p)
 }
 
-var fieldIDToName_DeleteVideoInfoResponse = map[int16]string{
+var fieldIDToName_PinCommentResponse = map[int16]string{
 	1: "base",
 }
 
-type LikeEvent struct {
-	UserId     int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
-	VideoId    int64  `thrift:"video_id,2" frugal:"2,default,i64" json:"video_id"`
-	CommentId  int64  `thrift:"comment_id,3" frugal:"3,default,i64" json:"comment_id"`
-	ActionType string `thrift:"action_type,4" frugal:"4,default,string" json:"action_type"`
-	EventType  string `thrift:"event_type,5" frugal:"5,default,string" json:"event_type"`
-	Timestamp  int64  `thrift:"timestamp,6" frugal:"6,default,i64" json:"timestamp"`
-	EventId    string `thrift:"event_id,7" frugal:"7,default,string" json:"event_id"`
+type EditCommentRequest struct {
+	CommentId int64  `thrift:"comment_id,1" frugal:"1,default,i64" json:"comment_id"`
+	UserId    int64  `thrift:"user_id,2" frugal:"2,default,i64" json:"user_id"`
+	Content   string `thrift:"content,3" frugal:"3,default,string" json:"content"`
 }
 
-func NewLikeEvent() *LikeEvent {
-	return &LikeEvent{}
+func NewEditCommentRequest() *EditCommentRequest {
+	return &EditCommentRequest{}
 }
 
-func (p *LikeEvent) InitDefault() {
+func (p *EditCommentRequest) InitDefault() {
 }
 
-func (p *LikeEvent) GetUserId() (v int64) {
-	return p.UserId
+func (p *EditCommentRequest) GetCommentId() (v int64) {
+	return p.CommentId
 }
 
-func (p *LikeEvent) GetVideoId() (v int64) {
-	return p.VideoId
+func (p *EditCommentRequest) GetUserId() (v int64) {
+	return p.UserId
 }
 
-func (p *LikeEvent) GetCommentId() (v int64) {
-	return p.CommentId
+func (p *EditCommentRequest) GetContent() (v string) {
+	return p.Content
+}
+func (p *EditCommentRequest) SetCommentId(val int64) {
+	p.CommentId = val
+}
+func (p *EditCommentRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *EditCommentRequest) SetContent(val string) {
+	p.Content = val
 }
 
-func (p *LikeEvent) GetActionType() (v string) {
-	return p.ActionType
+func (p *EditCommentRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EditCommentRequest(%+v)", *p)
 }
 
-func (p *LikeEvent) GetEventType() (v string) {
-	return p.EventType
+var fieldIDToName_EditCommentRequest = map[int16]string{
+	1: "comment_id",
+	2: "user_id",
+	3: "content",
 }
 
-func (p *LikeEvent) GetTimestamp() (v int64) {
-	return p.Timestamp
+type EditCommentResponse struct {
+	Base    *base.Status  `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Comment *base.Comment `thrift:"comment,2" frugal:"2,default,base.Comment" json:"comment"`
 }
 
-func (p *LikeEvent) GetEventId() (v string) {
-	return p.EventId
+func NewEditCommentResponse() *EditCommentResponse {
+	return &EditCommentResponse{}
 }
-func (p *LikeEvent) SetUserId(val int64) {
-	p.UserId = val
+
+func (p *EditCommentResponse) InitDefault() {
 }
-func (p *LikeEvent) SetVideoId(val int64) {
-	p.VideoId = val
+
+var EditCommentResponse_Base_DEFAULT *base.Status
+
+func (p *EditCommentResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return EditCommentResponse_Base_DEFAULT
+	}
+	return p.Base
 }
-func (p *LikeEvent) SetCommentId(val int64) {
-	p.CommentId = val
+
+var EditCommentResponse_Comment_DEFAULT *base.Comment
+
+func (p *EditCommentResponse) GetComment() (v *base.Comment) {
+	if !p.IsSetComment() {
+		return EditCommentResponse_Comment_DEFAULT
+	}
+	return p.Comment
 }
-func (p *LikeEvent) SetActionType(val string) {
-	p.ActionType = val
+func (p *EditCommentResponse) SetBase(val *base.Status) {
+	p.Base = val
 }
-func (p *LikeEvent) SetEventType(val string) {
-	p.EventType = val
+func (p *EditCommentResponse) SetComment(val *base.Comment) {
+	p.Comment = val
 }
-func (p *LikeEvent) SetTimestamp(val int64) {
-	p.Timestamp = val
+
+func (p *EditCommentResponse) IsSetBase() bool {
+	return p.Base != nil
 }
-func (p *LikeEvent) SetEventId(val string) {
-	p.EventId = val
+
+func (p *EditCommentResponse) IsSetComment() bool {
+	return p.Comment != nil
 }
 
-func (p *LikeEvent) String() string {
+func (p *EditCommentResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("LikeEvent(%+v)", *p)
+	return fmt.Sprintf("EditCommentResponse(%+v)", *p)
 }
 
-var fieldIDToName_LikeEvent = map[int16]string{
-	1: "user_id",
-	2: "video_id",
-	3: "comment_id",
-	4: "action_type",
-	5: "event_type",
-	6: "timestamp",
-	7: "event_id",
+var fieldIDToName_EditCommentResponse = map[int16]string{
+	1: "base",
+	2: "comment",
 }
 
-type NotificationEvent struct {
-	UserId           int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
-	FromUserId       int64  `thrift:"from_user_id,2" frugal:"2,default,i64" json:"from_user_id"`
-	NotificationType string `thrift:"notification_type,3" frugal:"3,default,string" json:"notification_type"`
-	TargetId         int64  `thrift:"target_id,4" frugal:"4,default,i64" json:"target_id"`
-	Content          string `thrift:"content,5" frugal:"5,default,string" json:"content"`
-	Timestamp        int64  `thrift:"timestamp,6" frugal:"6,default,i64" json:"timestamp"`
-	EventId          string `thrift:"event_id,7" frugal:"7,default,string" json:"event_id"`
+type CommentVersion struct {
+	Content    string `thrift:"content,1" frugal:"1,default,string" json:"content"`
+	ReplacedAt string `thrift:"replaced_at,2" frugal:"2,default,string" json:"replaced_at"`
 }
 
-func NewNotificationEvent() *NotificationEvent {
-	return &NotificationEvent{}
+func NewCommentVersion() *CommentVersion {
+	return &CommentVersion{}
 }
 
-func (p *NotificationEvent) InitDefault() {
+func (p *CommentVersion) InitDefault() {
 }
 
-func (p *NotificationEvent) GetUserId() (v int64) {
-	return p.UserId
+func (p *CommentVersion) GetContent() (v string) {
+	return p.Content
 }
 
-func (p *NotificationEvent) GetFromUserId() (v int64) {
-	return p.FromUserId
+func (p *CommentVersion) GetReplacedAt() (v string) {
+	return p.ReplacedAt
+}
+func (p *CommentVersion) SetContent(val string) {
+	p.Content = val
+}
+func (p *CommentVersion) SetReplacedAt(val string) {
+	p.ReplacedAt = val
 }
 
-func (p *NotificationEvent) GetNotificationType() (v string) {
-	return p.NotificationType
+func (p *CommentVersion) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentVersion(%+v)", *p)
 }
 
-func (p *NotificationEvent) GetTargetId() (v int64) {
-	return p.TargetId
+var fieldIDToName_CommentVersion = map[int16]string{
+	1: "content",
+	2: "replaced_at",
 }
 
-func (p *NotificationEvent) GetContent() (v string) {
-	return p.Content
+type ListCommentEditsRequest struct {
+	CommentId int64 `thrift:"comment_id,1" frugal:"1,default,i64" json:"comment_id"`
 }
 
-func (p *NotificationEvent) GetTimestamp() (v int64) {
-	return p.Timestamp
+func NewListCommentEditsRequest() *ListCommentEditsRequest {
+	return &ListCommentEditsRequest{}
 }
 
-func (p *NotificationEvent) GetEventId() (v string) {
-	return p.EventId
+func (p *ListCommentEditsRequest) InitDefault() {
 }
-func (p *NotificationEvent) SetUserId(val int64) {
-	p.UserId = val
+
+func (p *ListCommentEditsRequest) GetCommentId() (v int64) {
+	return p.CommentId
 }
-func (p *NotificationEvent) SetFromUserId(val int64) {
-	p.FromUserId = val
+func (p *ListCommentEditsRequest) SetCommentId(val int64) {
+	p.CommentId = val
 }
-func (p *NotificationEvent) SetNotificationType(val string) {
-	p.NotificationType = val
+
+func (p *ListCommentEditsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListCommentEditsRequest(%+v)", *p)
 }
-func (p *NotificationEvent) SetTargetId(val int64) {
-	p.TargetId = val
+
+var fieldIDToName_ListCommentEditsRequest = map[int16]string{
+	1: "comment_id",
 }
-func (p *NotificationEvent) SetContent(val string) {
-	p.Content = val
+
+type ListCommentEditsResponse struct {
+	Base     *base.Status      `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Versions []*CommentVersion `thrift:"versions,2" frugal:"2,default,list<CommentVersion>" json:"versions"`
 }
-func (p *NotificationEvent) SetTimestamp(val int64) {
-	p.Timestamp = val
+
+func NewListCommentEditsResponse() *ListCommentEditsResponse {
+	return &ListCommentEditsResponse{}
 }
-func (p *NotificationEvent) SetEventId(val string) {
-	p.EventId = val
+
+func (p *ListCommentEditsResponse) InitDefault() {
 }
 
-func (p *NotificationEvent) String() string {
+var ListCommentEditsResponse_Base_DEFAULT *base.Status
+
+func (p *ListCommentEditsResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return ListCommentEditsResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ListCommentEditsResponse) GetVersions() (v []*CommentVersion) {
+	return p.Versions
+}
+func (p *ListCommentEditsResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *ListCommentEditsResponse) SetVersions(val []*CommentVersion) {
+	p.Versions = val
+}
+
+func (p *ListCommentEditsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListCommentEditsResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NotificationEvent(%+v)", *p)
+	return fmt.Sprintf("ListCommentEditsResponse(%+v)", *p)
 }
 
-var fieldIDToName_NotificationEvent = map[int16]string{
-	1: "user_id",
-	2: "from_user_id",
-	3: "notification_type",
-	4: "target_id",
-	5: "content",
-	6: "timestamp",
-	7: "event_id",
+var fieldIDToName_ListCommentEditsResponse = map[int16]string{
+	1: "base",
+	2: "versions",
 }
 
-type GetNotificationsRequest struct {
-	UserId           int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
-	PageNum          int64  `thrift:"page_num,2" frugal:"2,default,i64" json:"page_num"`
-	PageSize         int64  `thrift:"page_size,3" frugal:"3,default,i64" json:"page_size"`
-	NotificationType string `thrift:"notification_type,4" frugal:"4,default,string" json:"notification_type"`
+type CommentReview struct {
+	ReviewId   int64    `thrift:"review_id,1" frugal:"1,default,i64" json:"review_id"`
+	CommentId  int64    `thrift:"comment_id,2" frugal:"2,default,i64" json:"comment_id"`
+	VideoId    int64    `thrift:"video_id,3" frugal:"3,default,i64" json:"video_id"`
+	UserId     int64    `thrift:"user_id,4" frugal:"4,default,i64" json:"user_id"`
+	ParentId   int64    `thrift:"parent_id,5" frugal:"5,default,i64" json:"parent_id"`
+	Content    string   `thrift:"content,6" frugal:"6,default,string" json:"content"`
+	Score      int64    `thrift:"score,7" frugal:"7,default,i64" json:"score"`
+	Reasons    []string `thrift:"reasons,8" frugal:"8,default,list<string>" json:"reasons"`
+	Status     int64    `thrift:"status,9" frugal:"9,default,i64" json:"status"`
+	ReviewerId int64    `thrift:"reviewer_id,10" frugal:"10,default,i64" json:"reviewer_id"`
+	Note       string   `thrift:"note,11" frugal:"11,default,string" json:"note"`
+	CreatedAt  string   `thrift:"created_at,12" frugal:"12,default,string" json:"created_at"`
+	ReviewedAt string   `thrift:"reviewed_at,13" frugal:"13,default,string" json:"reviewed_at"`
 }
 
-func NewGetNotificationsRequest() *GetNotificationsRequest {
-	return &GetNotificationsRequest{}
+func NewCommentReview() *CommentReview {
+	return &CommentReview{}
 }
 
-func (p *GetNotificationsRequest) InitDefault() {
+func (p *CommentReview) InitDefault() {
 }
 
-func (p *GetNotificationsRequest) GetUserId() (v int64) {
+func (p *CommentReview) GetReviewId() (v int64) {
+	return p.ReviewId
+}
+
+func (p *CommentReview) GetCommentId() (v int64) {
+	return p.CommentId
+}
+
+func (p *CommentReview) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+func (p *CommentReview) GetUserId() (v int64) {
 	return p.UserId
 }
 
-func (p *GetNotificationsRequest) GetPageNum() (v int64) {
-	return p.PageNum
+func (p *CommentReview) GetParentId() (v int64) {
+	return p.ParentId
 }
 
-func (p *GetNotificationsRequest) GetPageSize() (v int64) {
-	return p.PageSize
+func (p *CommentReview) GetContent() (v string) {
+	return p.Content
 }
 
-func (p *GetNotificationsRequest) GetNotificationType() (v string) {
-	return p.NotificationType
+func (p *CommentReview) GetScore() (v int64) {
+	return p.Score
 }
-func (p *GetNotificationsRequest) SetUserId(val int64) {
+
+func (p *CommentReview) GetReasons() (v []string) {
+	return p.Reasons
+}
+
+func (p *CommentReview) GetStatus() (v int64) {
+	return p.Status
+}
+
+func (p *CommentReview) GetReviewerId() (v int64) {
+	return p.ReviewerId
+}
+
+func (p *CommentReview) GetNote() (v string) {
+	return p.Note
+}
+
+func (p *CommentReview) GetCreatedAt() (v string) {
+	return p.CreatedAt
+}
+
+func (p *CommentReview) GetReviewedAt() (v string) {
+	return p.ReviewedAt
+}
+func (p *CommentReview) SetReviewId(val int64) {
+	p.ReviewId = val
+}
+func (p *CommentReview) SetCommentId(val int64) {
+	p.CommentId = val
+}
+func (p *CommentReview) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *CommentReview) SetUserId(val int64) {
 	p.UserId = val
 }
-func (p *GetNotificationsRequest) SetPageNum(val int64) {
-	p.PageNum = val
+func (p *CommentReview) SetParentId(val int64) {
+	p.ParentId = val
 }
-func (p *GetNotificationsRequest) SetPageSize(val int64) {
-	p.PageSize = val
+func (p *CommentReview) SetContent(val string) {
+	p.Content = val
 }
-func (p *GetNotificationsRequest) SetNotificationType(val string) {
-	p.NotificationType = val
+func (p *CommentReview) SetScore(val int64) {
+	p.Score = val
+}
+func (p *CommentReview) SetReasons(val []string) {
+	p.Reasons = val
+}
+func (p *CommentReview) SetStatus(val int64) {
+	p.Status = val
+}
+func (p *CommentReview) SetReviewerId(val int64) {
+	p.ReviewerId = val
+}
+func (p *CommentReview) SetNote(val string) {
+	p.Note = val
+}
+func (p *CommentReview) SetCreatedAt(val string) {
+	p.CreatedAt = val
+}
+func (p *CommentReview) SetReviewedAt(val string) {
+	p.ReviewedAt = val
 }
 
-func (p *GetNotificationsRequest) String() string {
+func (p *CommentReview) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetNotificationsRequest(%+v)", *p)
+	return fmt.Sprintf("CommentReview(%+v)", *p)
 }
 
-var fieldIDToName_GetNotificationsRequest = map[int16]string{
-	1: "user_id",
-	2: "page_num",
-	3: "page_size",
-	4: "notification_type",
+var fieldIDToName_CommentReview = map[int16]string{
+	1:  "review_id",
+	2:  "comment_id",
+	3:  "video_id",
+	4:  "user_id",
+	5:  "parent_id",
+	6:  "content",
+	7:  "score",
+	8:  "reasons",
+	9:  "status",
+	10: "reviewer_id",
+	11: "note",
+	12: "created_at",
+	13: "reviewed_at",
 }
 
-type GetNotificationsResponse struct {
-	Base          *base.Status        `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
-	Notifications []*NotificationInfo `thrift:"notifications,2" frugal:"2,default,list<NotificationInfo>" json:"notifications"`
-	TotalCount    int64               `thrift:"total_count,3" frugal:"3,default,i64" json:"total_count"`
-	UnreadCount   int64               `thrift:"unread_count,4" frugal:"4,default,i64" json:"unread_count"`
+type ListCommentReviewsRequest struct {
+	AdminId  int64 `thrift:"admin_id,1" frugal:"1,default,i64" json:"admin_id"`
+	Status   int64 `thrift:"status,2" frugal:"2,default,i64" json:"status"`
+	Cursor   int64 `thrift:"cursor,3" frugal:"3,default,i64" json:"cursor"`
+	PageSize int64 `thrift:"page_size,4" frugal:"4,default,i64" json:"page_size"`
 }
 
-func NewGetNotificationsResponse() *GetNotificationsResponse {
-	return &GetNotificationsResponse{}
+func NewListCommentReviewsRequest() *ListCommentReviewsRequest {
+	return &ListCommentReviewsRequest{}
 }
 
-func (p *GetNotificationsResponse) InitDefault() {
+func (p *ListCommentReviewsRequest) InitDefault() {
 }
 
-var GetNotificationsResponse_Base_DEFAULT *base.Status
+func (p *ListCommentReviewsRequest) GetAdminId() (v int64) {
+	return p.AdminId
+}
 
-func (p *GetNotificationsResponse) GetBase() (v *base.Status) {
+func (p *ListCommentReviewsRequest) GetStatus() (v int64) {
+	return p.Status
+}
+
+func (p *ListCommentReviewsRequest) GetCursor() (v int64) {
+	return p.Cursor
+}
+
+func (p *ListCommentReviewsRequest) GetPageSize() (v int64) {
+	return p.PageSize
+}
+func (p *ListCommentReviewsRequest) SetAdminId(val int64) {
+	p.AdminId = val
+}
+func (p *ListCommentReviewsRequest) SetStatus(val int64) {
+	p.Status = val
+}
+func (p *ListCommentReviewsRequest) SetCursor(val int64) {
+	p.Cursor = val
+}
+func (p *ListCommentReviewsRequest) SetPageSize(val int64) {
+	p.PageSize = val
+}
+
+func (p *ListCommentReviewsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListCommentReviewsRequest(%+v)", *p)
+}
+
+var fieldIDToName_ListCommentReviewsRequest = map[int16]string{
+	1: "admin_id",
+	2: "status",
+	3: "cursor",
+	4: "page_size",
+}
+
+type ListCommentReviewsResponse struct {
+	Base       *base.Status     `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Reviews    []*CommentReview `thrift:"reviews,2" frugal:"2,default,list<CommentReview>" json:"reviews"`
+	HasMore    bool             `thrift:"has_more,3" frugal:"3,default,bool" json:"has_more"`
+	NextCursor int64            `thrift:"next_cursor,4" frugal:"4,default,i64" json:"next_cursor"`
+}
+
+func NewListCommentReviewsResponse() *ListCommentReviewsResponse {
+	return &ListCommentReviewsResponse{}
+}
+
+func (p *ListCommentReviewsResponse) InitDefault() {
+}
+
+var ListCommentReviewsResponse_Base_DEFAULT *base.Status
+
+func (p *ListCommentReviewsResponse) GetBase() (v *base.Status) {
 	if !p.IsSetBase() {
-		return GetNotificationsResponse_Base_DEFAULT
+		return ListCommentReviewsResponse_Base_DEFAULT
 	}
 	return p.Base
 }
 
-func (p *GetNotificationsResponse) GetNotifications() (v []*NotificationInfo) {
-	return p.Notifications
+func (p *ListCommentReviewsResponse) GetReviews() (v []*CommentReview) {
+	return p.Reviews
 }
 
-func (p *GetNotificationsResponse) GetTotalCount() (v int64) {
-	return p.TotalCount
+func (p *ListCommentReviewsResponse) GetHasMore() (v bool) {
+	return p.HasMore
 }
 
-func (p *GetNotificationsResponse) GetUnreadCount() (v int64) {
-	return p.UnreadCount
+func (p *ListCommentReviewsResponse) GetNextCursor() (v int64) {
+	return p.NextCursor
 }
-func (p *GetNotificationsResponse) SetBase(val *base.Status) {
+func (p *ListCommentReviewsResponse) SetBase(val *base.Status) {
 	p.Base = val
 }
-func (p *GetNotificationsResponse) SetNotifications(val []*NotificationInfo) {
-	p.Notifications = val
+func (p *ListCommentReviewsResponse) SetReviews(val []*CommentReview) {
+	p.Reviews = val
 }
-func (p *GetNotificationsResponse) SetTotalCount(val int64) {
-	p.TotalCount = val
+func (p *ListCommentReviewsResponse) SetHasMore(val bool) {
+	p.HasMore = val
 }
-func (p *GetNotificationsResponse) SetUnreadCount(val int64) {
-	p.UnreadCount = val
+func (p *ListCommentReviewsResponse) SetNextCursor(val int64) {
+	p.NextCursor = val
 }
 
-func (p *GetNotificationsResponse) IsSetBase() bool {
+func (p *ListCommentReviewsResponse) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *GetNotificationsResponse) String() string {
+func (p *ListCommentReviewsResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetNotificationsResponse(%+v)", *p)
+	return fmt.Sprintf("ListCommentReviewsResponse(%+v)", *p)
 }
 
-var fieldIDToName_GetNotificationsResponse = map[int16]string{
+var fieldIDToName_ListCommentReviewsResponse = map[int16]string{
 	1: "base",
-	2: "notifications",
-	3: "total_count",
-	4: "unread_count",
+	2: "reviews",
+	3: "has_more",
+	4: "next_cursor",
 }
 
-type NotificationInfo struct {
-	NotificationId   int64  `thrift:"notification_id,1" frugal:"1,default,i64" json:"notification_id"`
-	FromUserId       int64  `thrift:"from_user_id,2" frugal:"2,default,i64" json:"from_user_id"`
-	FromUserName     string `thrift:"from_user_name,3" frugal:"3,default,string" json:"from_user_name"`
-	FromUserAvatar   string `thrift:"from_user_avatar,4" frugal:"4,default,string" json:"from_user_avatar"`
-	NotificationType string `thrift:"notification_type,5" frugal:"5,default,string" json:"notification_type"`
-	Content          string `thrift:"content,6" frugal:"6,default,string" json:"content"`
-	TargetId         int64  `thrift:"target_id,7" frugal:"7,default,i64" json:"target_id"`
-	IsRead           bool   `thrift:"is_read,8" frugal:"8,default,bool" json:"is_read"`
-	CreatedAt        string `thrift:"created_at,9" frugal:"9,default,string" json:"created_at"`
+type ApproveCommentReviewRequest struct {
+	AdminId  int64 `thrift:"admin_id,1" frugal:"1,default,i64" json:"admin_id"`
+	ReviewId int64 `thrift:"review_id,2" frugal:"2,default,i64" json:"review_id"`
 }
 
-func NewNotificationInfo() *NotificationInfo {
-	return &NotificationInfo{}
+func NewApproveCommentReviewRequest() *ApproveCommentReviewRequest {
+	return &ApproveCommentReviewRequest{}
 }
 
-func (p *NotificationInfo) InitDefault() {
+func (p *ApproveCommentReviewRequest) InitDefault() {
 }
 
-func (p *NotificationInfo) GetNotificationId() (v int64) {
-	return p.NotificationId
+func (p *ApproveCommentReviewRequest) GetAdminId() (v int64) {
+	return p.AdminId
 }
 
-func (p *NotificationInfo) GetFromUserId() (v int64) {
-	return p.FromUserId
+func (p *ApproveCommentReviewRequest) GetReviewId() (v int64) {
+	return p.ReviewId
+}
+func (p *ApproveCommentReviewRequest) SetAdminId(val int64) {
+	p.AdminId = val
+}
+func (p *ApproveCommentReviewRequest) SetReviewId(val int64) {
+	p.ReviewId = val
+}
+
+func (p *ApproveCommentReviewRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApproveCommentReviewRequest(%+v)", *p)
+}
+
+var fieldIDToName_ApproveCommentReviewRequest = map[int16]string{
+	1: "admin_id",
+	2: "review_id",
+}
+
+type ApproveCommentReviewResponse struct {
+	Base    *base.Status  `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Comment *base.Comment `thrift:"comment,2" frugal:"2,default,base.Comment" json:"comment"`
+}
+
+func NewApproveCommentReviewResponse() *ApproveCommentReviewResponse {
+	return &ApproveCommentReviewResponse{}
+}
+
+func (p *ApproveCommentReviewResponse) InitDefault() {
+}
+
+var ApproveCommentReviewResponse_Base_DEFAULT *base.Status
+
+func (p *ApproveCommentReviewResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return ApproveCommentReviewResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var ApproveCommentReviewResponse_Comment_DEFAULT *base.Comment
+
+func (p *ApproveCommentReviewResponse) GetComment() (v *base.Comment) {
+	if !p.IsSetComment() {
+		return ApproveCommentReviewResponse_Comment_DEFAULT
+	}
+	return p.Comment
+}
+func (p *ApproveCommentReviewResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *ApproveCommentReviewResponse) SetComment(val *base.Comment) {
+	p.Comment = val
+}
+
+func (p *ApproveCommentReviewResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ApproveCommentReviewResponse) IsSetComment() bool {
+	return p.Comment != nil
+}
+
+func (p *ApproveCommentReviewResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApproveCommentReviewResponse(%+v)", *p)
+}
+
+var fieldIDToName_ApproveCommentReviewResponse = map[int16]string{
+	1: "base",
+	2: "comment",
+}
+
+type RejectCommentReviewRequest struct {
+	AdminId  int64  `thrift:"admin_id,1" frugal:"1,default,i64" json:"admin_id"`
+	ReviewId int64  `thrift:"review_id,2" frugal:"2,default,i64" json:"review_id"`
+	Note     string `thrift:"note,3" frugal:"3,default,string" json:"note"`
+}
+
+func NewRejectCommentReviewRequest() *RejectCommentReviewRequest {
+	return &RejectCommentReviewRequest{}
+}
+
+func (p *RejectCommentReviewRequest) InitDefault() {
+}
+
+func (p *RejectCommentReviewRequest) GetAdminId() (v int64) {
+	return p.AdminId
+}
+
+func (p *RejectCommentReviewRequest) GetReviewId() (v int64) {
+	return p.ReviewId
+}
+
+func (p *RejectCommentReviewRequest) GetNote() (v string) {
+	return p.Note
+}
+func (p *RejectCommentReviewRequest) SetAdminId(val int64) {
+	p.AdminId = val
+}
+func (p *RejectCommentReviewRequest) SetReviewId(val int64) {
+	p.ReviewId = val
+}
+func (p *RejectCommentReviewRequest) SetNote(val string) {
+	p.Note = val
+}
+
+func (p *RejectCommentReviewRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RejectCommentReviewRequest(%+v)", *p)
+}
+
+var fieldIDToName_RejectCommentReviewRequest = map[int16]string{
+	1: "admin_id",
+	2: "review_id",
+	3: "note",
+}
+
+type RejectCommentReviewResponse struct {
+	Base *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+}
+
+func NewRejectCommentReviewResponse() *RejectCommentReviewResponse {
+	return &RejectCommentReviewResponse{}
+}
+
+func (p *RejectCommentReviewResponse) InitDefault() {
+}
+
+var RejectCommentReviewResponse_Base_DEFAULT *base.Status
+
+func (p *RejectCommentReviewResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return RejectCommentReviewResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *RejectCommentReviewResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+
+func (p *RejectCommentReviewResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RejectCommentReviewResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RejectCommentReviewResponse(%+v)", *p)
+}
+
+var fieldIDToName_RejectCommentReviewResponse = map[int16]string{
+	1: "base",
+}
+
+type ReportRequest struct {
+	ReporterId int64  `thrift:"reporter_id,1" frugal:"1,default,i64" json:"reporter_id"`
+	TargetType int64  `thrift:"target_type,2" frugal:"2,default,i64" json:"target_type"`
+	TargetId   int64  `thrift:"target_id,3" frugal:"3,default,i64" json:"target_id"`
+	Reason     int64  `thrift:"reason,4" frugal:"4,default,i64" json:"reason"`
+	Detail     string `thrift:"detail,5" frugal:"5,default,string" json:"detail"`
+}
+
+func NewReportRequest() *ReportRequest {
+	return &ReportRequest{}
+}
+
+func (p *ReportRequest) InitDefault() {
+}
+
+func (p *ReportRequest) GetReporterId() (v int64) {
+	return p.ReporterId
+}
+
+func (p *ReportRequest) GetTargetType() (v int64) {
+	return p.TargetType
+}
+
+func (p *ReportRequest) GetTargetId() (v int64) {
+	return p.TargetId
+}
+
+func (p *ReportRequest) GetReason() (v int64) {
+	return p.Reason
+}
+
+func (p *ReportRequest) GetDetail() (v string) {
+	return p.Detail
+}
+func (p *ReportRequest) SetReporterId(val int64) {
+	p.ReporterId = val
+}
+func (p *ReportRequest) SetTargetType(val int64) {
+	p.TargetType = val
+}
+func (p *ReportRequest) SetTargetId(val int64) {
+	p.TargetId = val
+}
+func (p *ReportRequest) SetReason(val int64) {
+	p.Reason = val
+}
+func (p *ReportRequest) SetDetail(val string) {
+	p.Detail = val
+}
+
+func (p *ReportRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReportRequest(%+v)", *p)
+}
+
+var fieldIDToName_ReportRequest = map[int16]string{
+	1: "reporter_id",
+	2: "target_type",
+	3: "target_id",
+	4: "reason",
+	5: "detail",
+}
+
+type ReportResponse struct {
+	Base      *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	CaseId    int64        `thrift:"case_id,2" frugal:"2,default,i64" json:"case_id"`
+	Duplicate bool         `thrift:"duplicate,3" frugal:"3,default,bool" json:"duplicate"`
+}
+
+func NewReportResponse() *ReportResponse {
+	return &ReportResponse{}
+}
+
+func (p *ReportResponse) InitDefault() {
+}
+
+var ReportResponse_Base_DEFAULT *base.Status
+
+func (p *ReportResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return ReportResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ReportResponse) GetCaseId() (v int64) {
+	return p.CaseId
+}
+
+func (p *ReportResponse) GetDuplicate() (v bool) {
+	return p.Duplicate
+}
+func (p *ReportResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *ReportResponse) SetCaseId(val int64) {
+	p.CaseId = val
+}
+func (p *ReportResponse) SetDuplicate(val bool) {
+	p.Duplicate = val
+}
+
+func (p *ReportResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ReportResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReportResponse(%+v)", *p)
+}
+
+var fieldIDToName_ReportResponse = map[int16]string{
+	1: "base",
+	2: "case_id",
+	3: "duplicate",
+}
+
+type ReportCase struct {
+	CaseId       int64           `thrift:"case_id,1" frugal:"1,default,i64" json:"case_id"`
+	TargetType   int64           `thrift:"target_type,2" frugal:"2,default,i64" json:"target_type"`
+	TargetId     int64           `thrift:"target_id,3" frugal:"3,default,i64" json:"target_id"`
+	TargetUserId int64           `thrift:"target_user_id,4" frugal:"4,default,i64" json:"target_user_id"`
+	ReportCount  int64           `thrift:"report_count,5" frugal:"5,default,i64" json:"report_count"`
+	PendingCount int64           `thrift:"pending_count,6" frugal:"6,default,i64" json:"pending_count"`
+	ReasonCounts map[int64]int64 `thrift:"reason_counts,7" frugal:"7,default,map<i64:i64>" json:"reason_counts"`
+	Hidden       bool            `thrift:"hidden,8" frugal:"8,default,bool" json:"hidden"`
+	Status       int64           `thrift:"status,9" frugal:"9,default,i64" json:"status"`
+	AssigneeId   int64           `thrift:"assignee_id,10" frugal:"10,default,i64" json:"assignee_id"`
+	Note         string          `thrift:"note,11" frugal:"11,default,string" json:"note"`
+	CreatedAt    string          `thrift:"created_at,12" frugal:"12,default,string" json:"created_at"`
+	ResolvedAt   string          `thrift:"resolved_at,13" frugal:"13,default,string" json:"resolved_at"`
+}
+
+func NewReportCase() *ReportCase {
+	return &ReportCase{}
+}
+
+func (p *ReportCase) InitDefault() {
+}
+
+func (p *ReportCase) GetCaseId() (v int64) {
+	return p.CaseId
+}
+
+func (p *ReportCase) GetTargetType() (v int64) {
+	return p.TargetType
+}
+
+func (p *ReportCase) GetTargetId() (v int64) {
+	return p.TargetId
+}
+
+func (p *ReportCase) GetTargetUserId() (v int64) {
+	return p.TargetUserId
+}
+
+func (p *ReportCase) GetReportCount() (v int64) {
+	return p.ReportCount
+}
+
+func (p *ReportCase) GetPendingCount() (v int64) {
+	return p.PendingCount
+}
+
+func (p *ReportCase) GetReasonCounts() (v map[int64]int64) {
+	return p.ReasonCounts
+}
+
+func (p *ReportCase) GetHidden() (v bool) {
+	return p.Hidden
+}
+
+func (p *ReportCase) GetStatus() (v int64) {
+	return p.Status
+}
+
+func (p *ReportCase) GetAssigneeId() (v int64) {
+	return p.AssigneeId
+}
+
+func (p *ReportCase) GetNote() (v string) {
+	return p.Note
+}
+
+func (p *ReportCase) GetCreatedAt() (v string) {
+	return p.CreatedAt
+}
+
+func (p *ReportCase) GetResolvedAt() (v string) {
+	return p.ResolvedAt
+}
+func (p *ReportCase) SetCaseId(val int64) {
+	p.CaseId = val
+}
+func (p *ReportCase) SetTargetType(val int64) {
+	p.TargetType = val
+}
+func (p *ReportCase) SetTargetId(val int64) {
+	p.TargetId = val
+}
+func (p *ReportCase) SetTargetUserId(val int64) {
+	p.TargetUserId = val
+}
+func (p *ReportCase) SetReportCount(val int64) {
+	p.ReportCount = val
+}
+func (p *ReportCase) SetPendingCount(val int64) {
+	p.PendingCount = val
+}
+func (p *ReportCase) SetReasonCounts(val map[int64]int64) {
+	p.ReasonCounts = val
+}
+func (p *ReportCase) SetHidden(val bool) {
+	p.Hidden = val
+}
+func (p *ReportCase) SetStatus(val int64) {
+	p.Status = val
+}
+func (p *ReportCase) SetAssigneeId(val int64) {
+	p.AssigneeId = val
+}
+func (p *ReportCase) SetNote(val string) {
+	p.Note = val
+}
+func (p *ReportCase) SetCreatedAt(val string) {
+	p.CreatedAt = val
+}
+func (p *ReportCase) SetResolvedAt(val string) {
+	p.ResolvedAt = val
+}
+
+func (p *ReportCase) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReportCase(%+v)", *p)
+}
+
+var fieldIDToName_ReportCase = map[int16]string{
+	1:  "case_id",
+	2:  "target_type",
+	3:  "target_id",
+	4:  "target_user_id",
+	5:  "report_count",
+	6:  "pending_count",
+	7:  "reason_counts",
+	8:  "hidden",
+	9:  "status",
+	10: "assignee_id",
+	11: "note",
+	12: "created_at",
+	13: "resolved_at",
+}
+
+type ListReportCasesRequest struct {
+	AdminId    int64 `thrift:"admin_id,1" frugal:"1,default,i64" json:"admin_id"`
+	Status     int64 `thrift:"status,2" frugal:"2,default,i64" json:"status"`
+	TargetType int64 `thrift:"target_type,3" frugal:"3,default,i64" json:"target_type"`
+	AssigneeId int64 `thrift:"assignee_id,4" frugal:"4,default,i64" json:"assignee_id"`
+	Cursor     int64 `thrift:"cursor,5" frugal:"5,default,i64" json:"cursor"`
+	PageSize   int64 `thrift:"page_size,6" frugal:"6,default,i64" json:"page_size"`
+}
+
+func NewListReportCasesRequest() *ListReportCasesRequest {
+	return &ListReportCasesRequest{}
+}
+
+func (p *ListReportCasesRequest) InitDefault() {
+}
+
+func (p *ListReportCasesRequest) GetAdminId() (v int64) {
+	return p.AdminId
+}
+
+func (p *ListReportCasesRequest) GetStatus() (v int64) {
+	return p.Status
+}
+
+func (p *ListReportCasesRequest) GetTargetType() (v int64) {
+	return p.TargetType
+}
+
+func (p *ListReportCasesRequest) GetAssigneeId() (v int64) {
+	return p.AssigneeId
+}
+
+func (p *ListReportCasesRequest) GetCursor() (v int64) {
+	return p.Cursor
+}
+
+func (p *ListReportCasesRequest) GetPageSize() (v int64) {
+	return p.PageSize
+}
+func (p *ListReportCasesRequest) SetAdminId(val int64) {
+	p.AdminId = val
+}
+func (p *ListReportCasesRequest) SetStatus(val int64) {
+	p.Status = val
+}
+func (p *ListReportCasesRequest) SetTargetType(val int64) {
+	p.TargetType = val
+}
+func (p *ListReportCasesRequest) SetAssigneeId(val int64) {
+	p.AssigneeId = val
+}
+func (p *ListReportCasesRequest) SetCursor(val int64) {
+	p.Cursor = val
+}
+func (p *ListReportCasesRequest) SetPageSize(val int64) {
+	p.PageSize = val
+}
+
+func (p *ListReportCasesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListReportCasesRequest(%+v)", *p)
+}
+
+var fieldIDToName_ListReportCasesRequest = map[int16]string{
+	1: "admin_id",
+	2: "status",
+	3: "target_type",
+	4: "assignee_id",
+	5: "cursor",
+	6: "page_size",
+}
+
+type ListReportCasesResponse struct {
+	Base       *base.Status  `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Cases      []*ReportCase `thrift:"cases,2" frugal:"2,default,list<ReportCase>" json:"cases"`
+	HasMore    bool          `thrift:"has_more,3" frugal:"3,default,bool" json:"has_more"`
+	NextCursor int64         `thrift:"next_cursor,4" frugal:"4,default,i64" json:"next_cursor"`
+}
+
+func NewListReportCasesResponse() *ListReportCasesResponse {
+	return &ListReportCasesResponse{}
+}
+
+func (p *ListReportCasesResponse) InitDefault() {
+}
+
+var ListReportCasesResponse_Base_DEFAULT *base.Status
+
+func (p *ListReportCasesResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return ListReportCasesResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ListReportCasesResponse) GetCases() (v []*ReportCase) {
+	return p.Cases
+}
+
+func (p *ListReportCasesResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
+
+func (p *ListReportCasesResponse) GetNextCursor() (v int64) {
+	return p.NextCursor
+}
+func (p *ListReportCasesResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *ListReportCasesResponse) SetCases(val []*ReportCase) {
+	p.Cases = val
+}
+func (p *ListReportCasesResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
+func (p *ListReportCasesResponse) SetNextCursor(val int64) {
+	p.NextCursor = val
+}
+
+func (p *ListReportCasesResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListReportCasesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListReportCasesResponse(%+v)", *p)
+}
+
+var fieldIDToName_ListReportCasesResponse = map[int16]string{
+	1: "base",
+	2: "cases",
+	3: "has_more",
+	4: "next_cursor",
+}
+
+type AssignReportCaseRequest struct {
+	AdminId    int64 `thrift:"admin_id,1" frugal:"1,default,i64" json:"admin_id"`
+	CaseId     int64 `thrift:"case_id,2" frugal:"2,default,i64" json:"case_id"`
+	AssigneeId int64 `thrift:"assignee_id,3" frugal:"3,default,i64" json:"assignee_id"`
+}
+
+func NewAssignReportCaseRequest() *AssignReportCaseRequest {
+	return &AssignReportCaseRequest{}
+}
+
+func (p *AssignReportCaseRequest) InitDefault() {
+}
+
+func (p *AssignReportCaseRequest) GetAdminId() (v int64) {
+	return p.AdminId
+}
+
+func (p *AssignReportCaseRequest) GetCaseId() (v int64) {
+	return p.CaseId
+}
+
+func (p *AssignReportCaseRequest) GetAssigneeId() (v int64) {
+	return p.AssigneeId
+}
+func (p *AssignReportCaseRequest) SetAdminId(val int64) {
+	p.AdminId = val
+}
+func (p *AssignReportCaseRequest) SetCaseId(val int64) {
+	p.CaseId = val
+}
+func (p *AssignReportCaseRequest) SetAssigneeId(val int64) {
+	p.AssigneeId = val
+}
+
+func (p *AssignReportCaseRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AssignReportCaseRequest(%+v)", *p)
+}
+
+var fieldIDToName_AssignReportCaseRequest = map[int16]string{
+	1: "admin_id",
+	2: "case_id",
+	3: "assignee_id",
+}
+
+type AssignReportCaseResponse struct {
+	Base *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+}
+
+func NewAssignReportCaseResponse() *AssignReportCaseResponse {
+	return &AssignReportCaseResponse{}
+}
+
+func (p *AssignReportCaseResponse) InitDefault() {
+}
+
+var AssignReportCaseResponse_Base_DEFAULT *base.Status
+
+func (p *AssignReportCaseResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return AssignReportCaseResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *AssignReportCaseResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+
+func (p *AssignReportCaseResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *AssignReportCaseResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AssignReportCaseResponse(%+v)", *p)
+}
+
+var fieldIDToName_AssignReportCaseResponse = map[int16]string{
+	1: "base",
+}
+
+type ResolveReportCaseRequest struct {
+	AdminId int64  `thrift:"admin_id,1" frugal:"1,default,i64" json:"admin_id"`
+	CaseId  int64  `thrift:"case_id,2" frugal:"2,default,i64" json:"case_id"`
+	Note    string `thrift:"note,3" frugal:"3,default,string" json:"note"`
+}
+
+func NewResolveReportCaseRequest() *ResolveReportCaseRequest {
+	return &ResolveReportCaseRequest{}
+}
+
+func (p *ResolveReportCaseRequest) InitDefault() {
+}
+
+func (p *ResolveReportCaseRequest) GetAdminId() (v int64) {
+	return p.AdminId
+}
+
+func (p *ResolveReportCaseRequest) GetCaseId() (v int64) {
+	return p.CaseId
+}
+
+func (p *ResolveReportCaseRequest) GetNote() (v string) {
+	return p.Note
+}
+func (p *ResolveReportCaseRequest) SetAdminId(val int64) {
+	p.AdminId = val
+}
+func (p *ResolveReportCaseRequest) SetCaseId(val int64) {
+	p.CaseId = val
+}
+func (p *ResolveReportCaseRequest) SetNote(val string) {
+	p.Note = val
+}
+
+func (p *ResolveReportCaseRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResolveReportCaseRequest(%+v)", *p)
+}
+
+var fieldIDToName_ResolveReportCaseRequest = map[int16]string{
+	1: "admin_id",
+	2: "case_id",
+	3: "note",
+}
+
+type ResolveReportCaseResponse struct {
+	Base *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+}
+
+func NewResolveReportCaseResponse() *ResolveReportCaseResponse {
+	return &ResolveReportCaseResponse{}
+}
+
+func (p *ResolveReportCaseResponse) InitDefault() {
+}
+
+var ResolveReportCaseResponse_Base_DEFAULT *base.Status
+
+func (p *ResolveReportCaseResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return ResolveReportCaseResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *ResolveReportCaseResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+
+func (p *ResolveReportCaseResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ResolveReportCaseResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResolveReportCaseResponse(%+v)", *p)
+}
+
+var fieldIDToName_ResolveReportCaseResponse = map[int16]string{
+	1: "base",
+}
+
+type DismissReportCaseRequest struct {
+	AdminId int64  `thrift:"admin_id,1" frugal:"1,default,i64" json:"admin_id"`
+	CaseId  int64  `thrift:"case_id,2" frugal:"2,default,i64" json:"case_id"`
+	Note    string `thrift:"note,3" frugal:"3,default,string" json:"note"`
+}
+
+func NewDismissReportCaseRequest() *DismissReportCaseRequest {
+	return &DismissReportCaseRequest{}
+}
+
+func (p *DismissReportCaseRequest) InitDefault() {
+}
+
+func (p *DismissReportCaseRequest) GetAdminId() (v int64) {
+	return p.AdminId
+}
+
+func (p *DismissReportCaseRequest) GetCaseId() (v int64) {
+	return p.CaseId
+}
+
+func (p *DismissReportCaseRequest) GetNote() (v string) {
+	return p.Note
+}
+func (p *DismissReportCaseRequest) SetAdminId(val int64) {
+	p.AdminId = val
+}
+func (p *DismissReportCaseRequest) SetCaseId(val int64) {
+	p.CaseId = val
+}
+func (p *DismissReportCaseRequest) SetNote(val string) {
+	p.Note = val
+}
+
+func (p *DismissReportCaseRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DismissReportCaseRequest(%+v)", *p)
+}
+
+var fieldIDToName_DismissReportCaseRequest = map[int16]string{
+	1: "admin_id",
+	2: "case_id",
+	3: "note",
+}
+
+type DismissReportCaseResponse struct {
+	Base *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+}
+
+func NewDismissReportCaseResponse() *DismissReportCaseResponse {
+	return &DismissReportCaseResponse{}
+}
+
+func (p *DismissReportCaseResponse) InitDefault() {
+}
+
+var DismissReportCaseResponse_Base_DEFAULT *base.Status
+
+func (p *DismissReportCaseResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return DismissReportCaseResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *DismissReportCaseResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+
+func (p *DismissReportCaseResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *DismissReportCaseResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DismissReportCaseResponse(%+v)", *p)
+}
+
+var fieldIDToName_DismissReportCaseResponse = map[int16]string{
+	1: "base",
+}
+
+type GetHiddenTargetsRequest struct {
+	TargetType int64   `thrift:"target_type,1" frugal:"1,default,i64" json:"target_type"`
+	TargetIds  []int64 `thrift:"target_ids,2" frugal:"2,default,list<i64>" json:"target_ids"`
+}
+
+func NewGetHiddenTargetsRequest() *GetHiddenTargetsRequest {
+	return &GetHiddenTargetsRequest{}
+}
+
+func (p *GetHiddenTargetsRequest) InitDefault() {
+}
+
+func (p *GetHiddenTargetsRequest) GetTargetType() (v int64) {
+	return p.TargetType
+}
+
+func (p *GetHiddenTargetsRequest) GetTargetIds() (v []int64) {
+	return p.TargetIds
+}
+func (p *GetHiddenTargetsRequest) SetTargetType(val int64) {
+	p.TargetType = val
+}
+func (p *GetHiddenTargetsRequest) SetTargetIds(val []int64) {
+	p.TargetIds = val
+}
+
+func (p *GetHiddenTargetsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetHiddenTargetsRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetHiddenTargetsRequest = map[int16]string{
+	1: "target_type",
+	2: "target_ids",
+}
+
+type GetHiddenTargetsResponse struct {
+	Base      *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	HiddenIds []int64      `thrift:"hidden_ids,2" frugal:"2,default,list<i64>" json:"hidden_ids"`
+}
+
+func NewGetHiddenTargetsResponse() *GetHiddenTargetsResponse {
+	return &GetHiddenTargetsResponse{}
+}
+
+func (p *GetHiddenTargetsResponse) InitDefault() {
+}
+
+var GetHiddenTargetsResponse_Base_DEFAULT *base.Status
+
+func (p *GetHiddenTargetsResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return GetHiddenTargetsResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetHiddenTargetsResponse) GetHiddenIds() (v []int64) {
+	return p.HiddenIds
+}
+func (p *GetHiddenTargetsResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *GetHiddenTargetsResponse) SetHiddenIds(val []int64) {
+	p.HiddenIds = val
+}
+
+func (p *GetHiddenTargetsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetHiddenTargetsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetHiddenTargetsResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetHiddenTargetsResponse = map[int16]string{
+	1: "base",
+	2: "hidden_ids",
+}
+
+type GetCoInteractionsRequest struct {
+	UserId     int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	VideoLimit int64 `thrift:"video_limit,2" frugal:"2,default,i64" json:"video_limit"`
+	Limit      int64 `thrift:"limit,3" frugal:"3,default,i64" json:"limit"`
+}
+
+func NewGetCoInteractionsRequest() *GetCoInteractionsRequest {
+	return &GetCoInteractionsRequest{}
+}
+
+func (p *GetCoInteractionsRequest) InitDefault() {
+}
+
+func (p *GetCoInteractionsRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *GetCoInteractionsRequest) GetVideoLimit() (v int64) {
+	return p.VideoLimit
+}
+
+func (p *GetCoInteractionsRequest) GetLimit() (v int64) {
+	return p.Limit
+}
+func (p *GetCoInteractionsRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *GetCoInteractionsRequest) SetVideoLimit(val int64) {
+	p.VideoLimit = val
+}
+func (p *GetCoInteractionsRequest) SetLimit(val int64) {
+	p.Limit = val
+}
+
+func (p *GetCoInteractionsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCoInteractionsRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetCoInteractionsRequest = map[int16]string{
+	1: "user_id",
+	2: "video_limit",
+	3: "limit",
+}
+
+type CoInteraction struct {
+	UserId int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	Count  int64 `thrift:"count,2" frugal:"2,default,i64" json:"count"`
+}
+
+func NewCoInteraction() *CoInteraction {
+	return &CoInteraction{}
+}
+
+func (p *CoInteraction) InitDefault() {
+}
+
+func (p *CoInteraction) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *CoInteraction) GetCount() (v int64) {
+	return p.Count
+}
+func (p *CoInteraction) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *CoInteraction) SetCount(val int64) {
+	p.Count = val
+}
+
+func (p *CoInteraction) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CoInteraction(%+v)", *p)
+}
+
+var fieldIDToName_CoInteraction = map[int16]string{
+	1: "user_id",
+	2: "count",
+}
+
+type GetCoInteractionsResponse struct {
+	Base  *base.Status     `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Items []*CoInteraction `thrift:"items,2" frugal:"2,default,list<CoInteraction>" json:"items"`
+}
+
+func NewGetCoInteractionsResponse() *GetCoInteractionsResponse {
+	return &GetCoInteractionsResponse{}
+}
+
+func (p *GetCoInteractionsResponse) InitDefault() {
+}
+
+var GetCoInteractionsResponse_Base_DEFAULT *base.Status
+
+func (p *GetCoInteractionsResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return GetCoInteractionsResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetCoInteractionsResponse) GetItems() (v []*CoInteraction) {
+	return p.Items
+}
+func (p *GetCoInteractionsResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *GetCoInteractionsResponse) SetItems(val []*CoInteraction) {
+	p.Items = val
+}
+
+func (p *GetCoInteractionsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetCoInteractionsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCoInteractionsResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetCoInteractionsResponse = map[int16]string{
+	1: "base",
+	2: "items",
+}
+
+type CommentDeleteRequest struct {
+	VideoId    int64 `thrift:"video_id,1" frugal:"1,default,i64" json:"video_id"`
+	CommentId  int64 `thrift:"comment_id,2" frugal:"2,default,i64" json:"comment_id"`
+	FromUserId int64 `thrift:"from_user_id,3" frugal:"3,default,i64" json:"from_user_id"`
+}
+
+func NewCommentDeleteRequest() *CommentDeleteRequest {
+	return &CommentDeleteRequest{}
+}
+
+func (p *CommentDeleteRequest) InitDefault() {
+}
+
+func (p *CommentDeleteRequest) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+func (p *CommentDeleteRequest) GetCommentId() (v int64) {
+	return p.CommentId
+}
+
+func (p *CommentDeleteRequest) GetFromUserId() (v int64) {
+	return p.FromUserId
+}
+func (p *CommentDeleteRequest) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *CommentDeleteRequest) SetCommentId(val int64) {
+	p.CommentId = val
+}
+func (p *CommentDeleteRequest) SetFromUserId(val int64) {
+	p.FromUserId = val
+}
+
+func (p *CommentDeleteRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentDeleteRequest(%+v)", *p)
+}
+
+var fieldIDToName_CommentDeleteRequest = map[int16]string{
+	1: "video_id",
+	2: "comment_id",
+	3: "from_user_id",
+}
+
+type CommentDeleteResponse struct {
+	Base *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+}
+
+func NewCommentDeleteResponse() *CommentDeleteResponse {
+	return &CommentDeleteResponse{}
+}
+
+func (p *CommentDeleteResponse) InitDefault() {
+}
+
+var CommentDeleteResponse_Base_DEFAULT *base.Status
+
+func (p *CommentDeleteResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return CommentDeleteResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *CommentDeleteResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+
+func (p *CommentDeleteResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CommentDeleteResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentDeleteResponse(%+v)", *p)
+}
+
+var fieldIDToName_CommentDeleteResponse = map[int16]string{
+	1: "base",
+}
+
+type VideoPopularListRequest struct {
+	PageNum  int64 `thrift:"page_num,1" frugal:"1,default,i64" json:"page_num"`
+	PageSize int64 `thrift:"page_size,2" frugal:"2,default,i64" json:"page_size"`
+}
+
+func NewVideoPopularListRequest() *VideoPopularListRequest {
+	return &VideoPopularListRequest{}
+}
+
+func (p *VideoPopularListRequest) InitDefault() {
+}
+
+func (p *VideoPopularListRequest) GetPageNum() (v int64) {
+	return p.PageNum
+}
+
+func (p *VideoPopularListRequest) GetPageSize() (v int64) {
+	return p.PageSize
+}
+func (p *VideoPopularListRequest) SetPageNum(val int64) {
+	p.PageNum = val
+}
+func (p *VideoPopularListRequest) SetPageSize(val int64) {
+	p.PageSize = val
+}
+
+func (p *VideoPopularListRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoPopularListRequest(%+v)", *p)
+}
+
+var fieldIDToName_VideoPopularListRequest = map[int16]string{
+	1: "page_num",
+	2: "page_size",
+}
+
+type VideoPopularListResponse struct {
+	Base *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Data []string     `thrift:"data,2" frugal:"2,default,list<string>" json:"data"`
+}
+
+func NewVideoPopularListResponse() *VideoPopularListResponse {
+	return &VideoPopularListResponse{}
+}
+
+func (p *VideoPopularListResponse) InitDefault() {
+}
+
+var VideoPopularListResponse_Base_DEFAULT *base.Status
+
+func (p *VideoPopularListResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return VideoPopularListResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *VideoPopularListResponse) GetData() (v []string) {
+	return p.Data
+}
+func (p *VideoPopularListResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *VideoPopularListResponse) SetData(val []string) {
+	p.Data = val
+}
+
+func (p *VideoPopularListResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *VideoPopularListResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoPopularListResponse(%+v)", *p)
+}
+
+var fieldIDToName_VideoPopularListResponse = map[int16]string{
+	1: "base",
+	2: "data",
+}
+
+type DeleteVideoInfoRequest struct {
+	VideoId int64 `thrift:"video_id,1" frugal:"1,default,i64" json:"video_id"`
+}
+
+func NewDeleteVideoInfoRequest() *DeleteVideoInfoRequest {
+	return &DeleteVideoInfoRequest{}
+}
+
+func (p *DeleteVideoInfoRequest) InitDefault() {
+}
+
+func (p *DeleteVideoInfoRequest) GetVideoId() (v int64) {
+	return p.VideoId
+}
+func (p *DeleteVideoInfoRequest) SetVideoId(val int64) {
+	p.VideoId = val
+}
+
+func (p *DeleteVideoInfoRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteVideoInfoRequest(%+v)", *p)
+}
+
+var fieldIDToName_DeleteVideoInfoRequest = map[int16]string{
+	1: "video_id",
+}
+
+type DeleteVideoInfoResponse struct {
+	Base *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+}
+
+func NewDeleteVideoInfoResponse() *DeleteVideoInfoResponse {
+	return &DeleteVideoInfoResponse{}
+}
+
+func (p *DeleteVideoInfoResponse) InitDefault() {
+}
+
+var DeleteVideoInfoResponse_Base_DEFAULT *base.Status
+
+func (p *DeleteVideoInfoResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return DeleteVideoInfoResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *DeleteVideoInfoResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+
+func (p *DeleteVideoInfoResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *DeleteVideoInfoResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteVideoInfoResponse(%+v)", *p)
+}
+
+var fieldIDToName_DeleteVideoInfoResponse = map[int16]string{
+	1: "base",
+}
+
+type LikeEvent struct {
+	UserId     int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	VideoId    int64  `thrift:"video_id,2" frugal:"2,default,i64" json:"video_id"`
+	CommentId  int64  `thrift:"comment_id,3" frugal:"3,default,i64" json:"comment_id"`
+	ActionType string `thrift:"action_type,4" frugal:"4,default,string" json:"action_type"`
+	EventType  string `thrift:"event_type,5" frugal:"5,default,string" json:"event_type"`
+	Timestamp  int64  `thrift:"timestamp,6" frugal:"6,default,i64" json:"timestamp"`
+	EventId    string `thrift:"event_id,7" frugal:"7,default,string" json:"event_id"`
+}
+
+func NewLikeEvent() *LikeEvent {
+	return &LikeEvent{}
+}
+
+func (p *LikeEvent) InitDefault() {
+}
+
+func (p *LikeEvent) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *LikeEvent) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+func (p *LikeEvent) GetCommentId() (v int64) {
+	return p.CommentId
+}
+
+func (p *LikeEvent) GetActionType() (v string) {
+	return p.ActionType
+}
+
+func (p *LikeEvent) GetEventType() (v string) {
+	return p.EventType
+}
+
+func (p *LikeEvent) GetTimestamp() (v int64) {
+	return p.Timestamp
+}
+
+func (p *LikeEvent) GetEventId() (v string) {
+	return p.EventId
+}
+func (p *LikeEvent) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *LikeEvent) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *LikeEvent) SetCommentId(val int64) {
+	p.CommentId = val
+}
+func (p *LikeEvent) SetActionType(val string) {
+	p.ActionType = val
+}
+func (p *LikeEvent) SetEventType(val string) {
+	p.EventType = val
+}
+func (p *LikeEvent) SetTimestamp(val int64) {
+	p.Timestamp = val
+}
+func (p *LikeEvent) SetEventId(val string) {
+	p.EventId = val
+}
+
+func (p *LikeEvent) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LikeEvent(%+v)", *p)
+}
+
+var fieldIDToName_LikeEvent = map[int16]string{
+	1: "user_id",
+	2: "video_id",
+	3: "comment_id",
+	4: "action_type",
+	5: "event_type",
+	6: "timestamp",
+	7: "event_id",
+}
+
+type NotificationEvent struct {
+	UserId           int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	FromUserId       int64  `thrift:"from_user_id,2" frugal:"2,default,i64" json:"from_user_id"`
+	NotificationType string `thrift:"notification_type,3" frugal:"3,default,string" json:"notification_type"`
+	TargetId         int64  `thrift:"target_id,4" frugal:"4,default,i64" json:"target_id"`
+	Content          string `thrift:"content,5" frugal:"5,default,string" json:"content"`
+	Timestamp        int64  `thrift:"timestamp,6" frugal:"6,default,i64" json:"timestamp"`
+	EventId          string `thrift:"event_id,7" frugal:"7,default,string" json:"event_id"`
+}
+
+func NewNotificationEvent() *NotificationEvent {
+	return &NotificationEvent{}
+}
+
+func (p *NotificationEvent) InitDefault() {
+}
+
+func (p *NotificationEvent) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *NotificationEvent) GetFromUserId() (v int64) {
+	return p.FromUserId
+}
+
+func (p *NotificationEvent) GetNotificationType() (v string) {
+	return p.NotificationType
+}
+
+func (p *NotificationEvent) GetTargetId() (v int64) {
+	return p.TargetId
+}
+
+func (p *NotificationEvent) GetContent() (v string) {
+	return p.Content
+}
+
+func (p *NotificationEvent) GetTimestamp() (v int64) {
+	return p.Timestamp
+}
+
+func (p *NotificationEvent) GetEventId() (v string) {
+	return p.EventId
+}
+func (p *NotificationEvent) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *NotificationEvent) SetFromUserId(val int64) {
+	p.FromUserId = val
+}
+func (p *NotificationEvent) SetNotificationType(val string) {
+	p.NotificationType = val
+}
+func (p *NotificationEvent) SetTargetId(val int64) {
+	p.TargetId = val
+}
+func (p *NotificationEvent) SetContent(val string) {
+	p.Content = val
+}
+func (p *NotificationEvent) SetTimestamp(val int64) {
+	p.Timestamp = val
+}
+func (p *NotificationEvent) SetEventId(val string) {
+	p.EventId = val
+}
+
+func (p *NotificationEvent) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NotificationEvent(%+v)", *p)
+}
+
+var fieldIDToName_NotificationEvent = map[int16]string{
+	1: "user_id",
+	2: "from_user_id",
+	3: "notification_type",
+	4: "target_id",
+	5: "content",
+	6: "timestamp",
+	7: "event_id",
+}
+
+type GetNotificationsRequest struct {
+	UserId           int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	PageNum          int64  `thrift:"page_num,2" frugal:"2,default,i64" json:"page_num"`
+	PageSize         int64  `thrift:"page_size,3" frugal:"3,default,i64" json:"page_size"`
+	NotificationType string `thrift:"notification_type,4" frugal:"4,default,string" json:"notification_type"`
+}
+
+func NewGetNotificationsRequest() *GetNotificationsRequest {
+	return &GetNotificationsRequest{}
+}
+
+func (p *GetNotificationsRequest) InitDefault() {
+}
+
+func (p *GetNotificationsRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *GetNotificationsRequest) GetPageNum() (v int64) {
+	return p.PageNum
+}
+
+func (p *GetNotificationsRequest) GetPageSize() (v int64) {
+	return p.PageSize
+}
+
+func (p *GetNotificationsRequest) GetNotificationType() (v string) {
+	return p.NotificationType
+}
+func (p *GetNotificationsRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *GetNotificationsRequest) SetPageNum(val int64) {
+	p.PageNum = val
+}
+func (p *GetNotificationsRequest) SetPageSize(val int64) {
+	p.PageSize = val
+}
+func (p *GetNotificationsRequest) SetNotificationType(val string) {
+	p.NotificationType = val
+}
+
+func (p *GetNotificationsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetNotificationsRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetNotificationsRequest = map[int16]string{
+	1: "user_id",
+	2: "page_num",
+	3: "page_size",
+	4: "notification_type",
+}
+
+type GetNotificationsResponse struct {
+	Base          *base.Status        `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Notifications []*NotificationInfo `thrift:"notifications,2" frugal:"2,default,list<NotificationInfo>" json:"notifications"`
+	TotalCount    int64               `thrift:"total_count,3" frugal:"3,default,i64" json:"total_count"`
+	UnreadCount   int64               `thrift:"unread_count,4" frugal:"4,default,i64" json:"unread_count"`
+}
+
+func NewGetNotificationsResponse() *GetNotificationsResponse {
+	return &GetNotificationsResponse{}
+}
+
+func (p *GetNotificationsResponse) InitDefault() {
+}
+
+var GetNotificationsResponse_Base_DEFAULT *base.Status
+
+func (p *GetNotificationsResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return GetNotificationsResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetNotificationsResponse) GetNotifications() (v []*NotificationInfo) {
+	return p.Notifications
+}
+
+func (p *GetNotificationsResponse) GetTotalCount() (v int64) {
+	return p.TotalCount
+}
+
+func (p *GetNotificationsResponse) GetUnreadCount() (v int64) {
+	return p.UnreadCount
+}
+func (p *GetNotificationsResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *GetNotificationsResponse) SetNotifications(val []*NotificationInfo) {
+	p.Notifications = val
+}
+func (p *GetNotificationsResponse) SetTotalCount(val int64) {
+	p.TotalCount = val
+}
+func (p *GetNotificationsResponse) SetUnreadCount(val int64) {
+	p.UnreadCount = val
+}
+
+func (p *GetNotificationsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetNotificationsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetNotificationsResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetNotificationsResponse = map[int16]string{
+	1: "base",
+	2: "notifications",
+	3: "total_count",
+	4: "unread_count",
+}
+
+type NotificationInfo struct {
+	NotificationId   int64  `thrift:"notification_id,1" frugal:"1,default,i64" json:"notification_id"`
+	FromUserId       int64  `thrift:"from_user_id,2" frugal:"2,default,i64" json:"from_user_id"`
+	FromUserName     string `thrift:"from_user_name,3" frugal:"3,default,string" json:"from_user_name"`
+	FromUserAvatar   string `thrift:"from_user_avatar,4" frugal:"4,default,string" json:"from_user_avatar"`
+	NotificationType string `thrift:"notification_type,5" frugal:"5,default,string" json:"notification_type"`
+	Content          string `thrift:"content,6" frugal:"6,default,string" json:"content"`
+	TargetId         int64  `thrift:"target_id,7" frugal:"7,default,i64" json:"target_id"`
+	IsRead           bool   `thrift:"is_read,8" frugal:"8,default,bool" json:"is_read"`
+	CreatedAt        string `thrift:"created_at,9" frugal:"9,default,string" json:"created_at"`
+}
+
+func NewNotificationInfo() *NotificationInfo {
+	return &NotificationInfo{}
+}
+
+func (p *NotificationInfo) InitDefault() {
+}
+
+func (p *NotificationInfo) GetNotificationId() (v int64) {
+	return p.NotificationId
+}
+
+func (p *NotificationInfo) GetFromUserId() (v int64) {
+	return p.FromUserId
+}
+
+func (p *NotificationInfo) GetFromUserName() (v string) {
+	return p.FromUserName
+}
+
+func (p *NotificationInfo) GetFromUserAvatar() (v string) {
+	return p.FromUserAvatar
+}
+
+func (p *NotificationInfo) GetNotificationType() (v string) {
+	return p.NotificationType
+}
+
+func (p *NotificationInfo) GetContent() (v string) {
+	return p.Content
+}
+
+func (p *NotificationInfo) GetTargetId() (v int64) {
+	return p.TargetId
+}
+
+func (p *NotificationInfo) GetIsRead() (v bool) {
+	return p.IsRead
+}
+
+func (p *NotificationInfo) GetCreatedAt() (v string) {
+	return p.CreatedAt
+}
+func (p *NotificationInfo) SetNotificationId(val int64) {
+	p.NotificationId = val
+}
+func (p *NotificationInfo) SetFromUserId(val int64) {
+	p.FromUserId = val
+}
+func (p *NotificationInfo) SetFromUserName(val string) {
+	p.FromUserName = val
+}
+func (p *NotificationInfo) SetFromUserAvatar(val string) {
+	p.FromUserAvatar = val
+}
+func (p *NotificationInfo) SetNotificationType(val string) {
+	p.NotificationType = val
+}
+func (p *NotificationInfo) SetContent(val string) {
+	p.Content = val
+}
+func (p *NotificationInfo) SetTargetId(val int64) {
+	p.TargetId = val
+}
+func (p *NotificationInfo) SetIsRead(val bool) {
+	p.IsRead = val
+}
+func (p *NotificationInfo) SetCreatedAt(val string) {
+	p.CreatedAt = val
+}
+
+func (p *NotificationInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NotificationInfo(%+v)", *p)
+}
+
+var fieldIDToName_NotificationInfo = map[int16]string{
+	1: "notification_id",
+	2: "from_user_id",
+	3: "from_user_name",
+	4: "from_user_avatar",
+	5: "notification_type",
+	6: "content",
+	7: "target_id",
+	8: "is_read",
+	9: "created_at",
+}
+
+type MarkNotificationReadRequest struct {
+	UserId          int64   `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	NotificationIds []int64 `thrift:"notification_ids,2" frugal:"2,default,list<i64>" json:"notification_ids"`
+}
+
+func NewMarkNotificationReadRequest() *MarkNotificationReadRequest {
+	return &MarkNotificationReadRequest{}
+}
+
+func (p *MarkNotificationReadRequest) InitDefault() {
+}
+
+func (p *MarkNotificationReadRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *MarkNotificationReadRequest) GetNotificationIds() (v []int64) {
+	return p.NotificationIds
+}
+func (p *MarkNotificationReadRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *MarkNotificationReadRequest) SetNotificationIds(val []int64) {
+	p.NotificationIds = val
+}
+
+func (p *MarkNotificationReadRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MarkNotificationReadRequest(%+v)", *p)
+}
+
+var fieldIDToName_MarkNotificationReadRequest = map[int16]string{
+	1: "user_id",
+	2: "notification_ids",
+}
+
+type MarkNotificationReadResponse struct {
+	Base        *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	MarkedCount int64        `thrift:"marked_count,2" frugal:"2,default,i64" json:"marked_count"`
+}
+
+func NewMarkNotificationReadResponse() *MarkNotificationReadResponse {
+	return &MarkNotificationReadResponse{}
+}
+
+func (p *MarkNotificationReadResponse) InitDefault() {
+}
+
+var MarkNotificationReadResponse_Base_DEFAULT *base.Status
+
+func (p *MarkNotificationReadResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return MarkNotificationReadResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *MarkNotificationReadResponse) GetMarkedCount() (v int64) {
+	return p.MarkedCount
+}
+func (p *MarkNotificationReadResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *MarkNotificationReadResponse) SetMarkedCount(val int64) {
+	p.MarkedCount = val
+}
+
+func (p *MarkNotificationReadResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *MarkNotificationReadResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MarkNotificationReadResponse(%+v)", *p)
+}
+
+var fieldIDToName_MarkNotificationReadResponse = map[int16]string{
+	1: "base",
+	2: "marked_count",
+}
+
+type DeadLetterQueueInfo struct {
+	Queue           string `thrift:"queue,1" frugal:"1,default,string" json:"queue"`
+	DeadLetterQueue string `thrift:"dead_letter_queue,2" frugal:"2,default,string" json:"dead_letter_queue"`
+	MessageCount    int64  `thrift:"message_count,3" frugal:"3,default,i64" json:"message_count"`
+}
+
+func NewDeadLetterQueueInfo() *DeadLetterQueueInfo {
+	return &DeadLetterQueueInfo{}
+}
+
+func (p *DeadLetterQueueInfo) InitDefault() {
+}
+
+func (p *DeadLetterQueueInfo) GetQueue() (v string) {
+	return p.Queue
+}
+
+func (p *DeadLetterQueueInfo) GetDeadLetterQueue() (v string) {
+	return p.DeadLetterQueue
+}
+
+func (p *DeadLetterQueueInfo) GetMessageCount() (v int64) {
+	return p.MessageCount
+}
+func (p *DeadLetterQueueInfo) SetQueue(val string) {
+	p.Queue = val
+}
+func (p *DeadLetterQueueInfo) SetDeadLetterQueue(val string) {
+	p.DeadLetterQueue = val
+}
+func (p *DeadLetterQueueInfo) SetMessageCount(val int64) {
+	p.MessageCount = val
+}
+
+func (p *DeadLetterQueueInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeadLetterQueueInfo(%+v)", *p)
+}
+
+var fieldIDToName_DeadLetterQueueInfo = map[int16]string{
+	1: "queue",
+	2: "dead_letter_queue",
+	3: "message_count",
+}
+
+type DeadLetterMessage struct {
+	MessageId      string `thrift:"message_id,1" frugal:"1,default,string" json:"message_id"`
+	Queue          string `thrift:"queue,2" frugal:"2,default,string" json:"queue"`
+	Attempts       int64  `thrift:"attempts,3" frugal:"3,default,i64" json:"attempts"`
+	LastError      string `thrift:"last_error,4" frugal:"4,default,string" json:"last_error"`
+	DeadLetteredAt int64  `thrift:"dead_lettered_at,5" frugal:"5,default,i64" json:"dead_lettered_at"`
+	PublishedAt    int64  `thrift:"published_at,6" frugal:"6,default,i64" json:"published_at"`
+	Body           []byte `thrift:"body,7" frugal:"7,default,binary" json:"body"`
+}
+
+func NewDeadLetterMessage() *DeadLetterMessage {
+	return &DeadLetterMessage{}
+}
+
+func (p *DeadLetterMessage) InitDefault() {
+}
+
+func (p *DeadLetterMessage) GetMessageId() (v string) {
+	return p.MessageId
+}
+
+func (p *DeadLetterMessage) GetQueue() (v string) {
+	return p.Queue
+}
+
+func (p *DeadLetterMessage) GetAttempts() (v int64) {
+	return p.Attempts
+}
+
+func (p *DeadLetterMessage) GetLastError() (v string) {
+	return p.LastError
+}
+
+func (p *DeadLetterMessage) GetDeadLetteredAt() (v int64) {
+	return p.DeadLetteredAt
+}
+
+func (p *DeadLetterMessage) GetPublishedAt() (v int64) {
+	return p.PublishedAt
+}
+
+func (p *DeadLetterMessage) GetBody() (v []byte) {
+	return p.Body
+}
+func (p *DeadLetterMessage) SetMessageId(val string) {
+	p.MessageId = val
+}
+func (p *DeadLetterMessage) SetQueue(val string) {
+	p.Queue = val
+}
+func (p *DeadLetterMessage) SetAttempts(val int64) {
+	p.Attempts = val
+}
+func (p *DeadLetterMessage) SetLastError(val string) {
+	p.LastError = val
+}
+func (p *DeadLetterMessage) SetDeadLetteredAt(val int64) {
+	p.DeadLetteredAt = val
+}
+func (p *DeadLetterMessage) SetPublishedAt(val int64) {
+	p.PublishedAt = val
+}
+func (p *DeadLetterMessage) SetBody(val []byte) {
+	p.Body = val
+}
+
+func (p *DeadLetterMessage) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeadLetterMessage(%+v)", *p)
+}
+
+var fieldIDToName_DeadLetterMessage = map[int16]string{
+	1: "message_id",
+	2: "queue",
+	3: "attempts",
+	4: "last_error",
+	5: "dead_lettered_at",
+	6: "published_at",
+	7: "body",
+}
+
+type ListDeadLetterQueuesRequest struct {
+}
+
+func NewListDeadLetterQueuesRequest() *ListDeadLetterQueuesRequest {
+	return &ListDeadLetterQueuesRequest{}
+}
+
+func (p *ListDeadLetterQueuesRequest) InitDefault() {
+}
+
+func (p *ListDeadLetterQueuesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListDeadLetterQueuesRequest(%+v)", *p)
+}
+
+var fieldIDToName_ListDeadLetterQueuesRequest = map[int16]string{}
+
+type ListDeadLetterQueuesResponse struct {
+	Base   *base.Status           `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Queues []*DeadLetterQueueInfo `thrift:"queues,2" frugal:"2,default,list<DeadLetterQueueInfo>" json:"queues"`
+}
+
+func NewListDeadLetterQueuesResponse() *ListDeadLetterQueuesResponse {
+	return &ListDeadLetterQueuesResponse{}
+}
+
+func (p *ListDeadLetterQueuesResponse) InitDefault() {
+}
+
+var ListDeadLetterQueuesResponse_Base_DEFAULT *base.Status
+
+func (p *ListDeadLetterQueuesResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return ListDeadLetterQueuesResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ListDeadLetterQueuesResponse) GetQueues() (v []*DeadLetterQueueInfo) {
+	return p.Queues
+}
+func (p *ListDeadLetterQueuesResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *ListDeadLetterQueuesResponse) SetQueues(val []*DeadLetterQueueInfo) {
+	p.Queues = val
+}
+
+func (p *ListDeadLetterQueuesResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListDeadLetterQueuesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListDeadLetterQueuesResponse(%+v)", *p)
+}
+
+var fieldIDToName_ListDeadLetterQueuesResponse = map[int16]string{
+	1: "base",
+	2: "queues",
+}
+
+type ListDeadLettersRequest struct {
+	Queue string `thrift:"queue,1" frugal:"1,default,string" json:"queue"`
+	Limit int64  `thrift:"limit,2" frugal:"2,default,i64" json:"limit"`
+}
+
+func NewListDeadLettersRequest() *ListDeadLettersRequest {
+	return &ListDeadLettersRequest{}
+}
+
+func (p *ListDeadLettersRequest) InitDefault() {
+}
+
+func (p *ListDeadLettersRequest) GetQueue() (v string) {
+	return p.Queue
+}
+
+func (p *ListDeadLettersRequest) GetLimit() (v int64) {
+	return p.Limit
+}
+func (p *ListDeadLettersRequest) SetQueue(val string) {
+	p.Queue = val
+}
+func (p *ListDeadLettersRequest) SetLimit(val int64) {
+	p.Limit = val
+}
+
+func (p *ListDeadLettersRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListDeadLettersRequest(%+v)", *p)
+}
+
+var fieldIDToName_ListDeadLettersRequest = map[int16]string{
+	1: "queue",
+	2: "limit",
+}
+
+type ListDeadLettersResponse struct {
+	Base     *base.Status         `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Messages []*DeadLetterMessage `thrift:"messages,2" frugal:"2,default,list<DeadLetterMessage>" json:"messages"`
+}
+
+func NewListDeadLettersResponse() *ListDeadLettersResponse {
+	return &ListDeadLettersResponse{}
+}
+
+func (p *ListDeadLettersResponse) InitDefault() {
+}
+
+var ListDeadLettersResponse_Base_DEFAULT *base.Status
+
+func (p *ListDeadLettersResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return ListDeadLettersResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ListDeadLettersResponse) GetMessages() (v []*DeadLetterMessage) {
+	return p.Messages
+}
+func (p *ListDeadLettersResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *ListDeadLettersResponse) SetMessages(val []*DeadLetterMessage) {
+	p.Messages = val
+}
+
+func (p *ListDeadLettersResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListDeadLettersResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListDeadLettersResponse(%+v)", *p)
+}
+
+var fieldIDToName_ListDeadLettersResponse = map[int16]string{
+	1: "base",
+	2: "messages",
+}
+
+type GetDeadLetterRequest struct {
+	Queue     string `thrift:"queue,1" frugal:"1,default,string" json:"queue"`
+	MessageId string `thrift:"message_id,2" frugal:"2,default,string" json:"message_id"`
+}
+
+func NewGetDeadLetterRequest() *GetDeadLetterRequest {
+	return &GetDeadLetterRequest{}
+}
+
+func (p *GetDeadLetterRequest) InitDefault() {
+}
+
+func (p *GetDeadLetterRequest) GetQueue() (v string) {
+	return p.Queue
+}
+
+func (p *GetDeadLetterRequest) GetMessageId() (v string) {
+	return p.MessageId
+}
+func (p *GetDeadLetterRequest) SetQueue(val string) {
+	p.Queue = val
+}
+func (p *GetDeadLetterRequest) SetMessageId(val string) {
+	p.MessageId = val
+}
+
+func (p *GetDeadLetterRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetDeadLetterRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetDeadLetterRequest = map[int16]string{
+	1: "queue",
+	2: "message_id",
+}
+
+type GetDeadLetterResponse struct {
+	Base    *base.Status       `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Message *DeadLetterMessage `thrift:"message,2" frugal:"2,default,DeadLetterMessage" json:"message"`
+}
+
+func NewGetDeadLetterResponse() *GetDeadLetterResponse {
+	return &GetDeadLetterResponse{}
+}
+
+func (p *GetDeadLetterResponse) InitDefault() {
+}
+
+var GetDeadLetterResponse_Base_DEFAULT *base.Status
+
+func (p *GetDeadLetterResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return GetDeadLetterResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var GetDeadLetterResponse_Message_DEFAULT *DeadLetterMessage
+
+func (p *GetDeadLetterResponse) GetMessage() (v *DeadLetterMessage) {
+	if !p.IsSetMessage() {
+		return GetDeadLetterResponse_Message_DEFAULT
+	}
+	return p.Message
+}
+func (p *GetDeadLetterResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *GetDeadLetterResponse) SetMessage(val *DeadLetterMessage) {
+	p.Message = val
+}
+
+func (p *GetDeadLetterResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetDeadLetterResponse) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *GetDeadLetterResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetDeadLetterResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetDeadLetterResponse = map[int16]string{
+	1: "base",
+	2: "message",
+}
+
+type ReplayDeadLettersRequest struct {
+	Queue      string   `thrift:"queue,1" frugal:"1,default,string" json:"queue"`
+	MessageIds []string `thrift:"message_ids,2" frugal:"2,default,list<string>" json:"message_ids"`
+}
+
+func NewReplayDeadLettersRequest() *ReplayDeadLettersRequest {
+	return &ReplayDeadLettersRequest{}
+}
+
+func (p *ReplayDeadLettersRequest) InitDefault() {
+}
+
+func (p *ReplayDeadLettersRequest) GetQueue() (v string) {
+	return p.Queue
+}
+
+func (p *ReplayDeadLettersRequest) GetMessageIds() (v []string) {
+	return p.MessageIds
+}
+func (p *ReplayDeadLettersRequest) SetQueue(val string) {
+	p.Queue = val
+}
+func (p *ReplayDeadLettersRequest) SetMessageIds(val []string) {
+	p.MessageIds = val
+}
+
+func (p *ReplayDeadLettersRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplayDeadLettersRequest(%+v)", *p)
+}
+
+var fieldIDToName_ReplayDeadLettersRequest = map[int16]string{
+	1: "queue",
+	2: "message_ids",
+}
+
+type ReplayDeadLettersResponse struct {
+	Base          *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	ReplayedCount int64        `thrift:"replayed_count,2" frugal:"2,default,i64" json:"replayed_count"`
+}
+
+func NewReplayDeadLettersResponse() *ReplayDeadLettersResponse {
+	return &ReplayDeadLettersResponse{}
+}
+
+func (p *ReplayDeadLettersResponse) InitDefault() {
+}
+
+var ReplayDeadLettersResponse_Base_DEFAULT *base.Status
+
+func (p *ReplayDeadLettersResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return ReplayDeadLettersResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ReplayDeadLettersResponse) GetReplayedCount() (v int64) {
+	return p.ReplayedCount
+}
+func (p *ReplayDeadLettersResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *ReplayDeadLettersResponse) SetReplayedCount(val int64) {
+	p.ReplayedCount = val
+}
+
+func (p *ReplayDeadLettersResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ReplayDeadLettersResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReplayDeadLettersResponse(%+v)", *p)
+}
+
+var fieldIDToName_ReplayDeadLettersResponse = map[int16]string{
+	1: "base",
+	2: "replayed_count",
+}
+
+type PurgeDeadLettersRequest struct {
+	Queue      string   `thrift:"queue,1" frugal:"1,default,string" json:"queue"`
+	MessageIds []string `thrift:"message_ids,2" frugal:"2,default,list<string>" json:"message_ids"`
+}
+
+func NewPurgeDeadLettersRequest() *PurgeDeadLettersRequest {
+	return &PurgeDeadLettersRequest{}
+}
+
+func (p *PurgeDeadLettersRequest) InitDefault() {
+}
+
+func (p *PurgeDeadLettersRequest) GetQueue() (v string) {
+	return p.Queue
+}
+
+func (p *PurgeDeadLettersRequest) GetMessageIds() (v []string) {
+	return p.MessageIds
+}
+func (p *PurgeDeadLettersRequest) SetQueue(val string) {
+	p.Queue = val
+}
+func (p *PurgeDeadLettersRequest) SetMessageIds(val []string) {
+	p.MessageIds = val
+}
+
+func (p *PurgeDeadLettersRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PurgeDeadLettersRequest(%+v)", *p)
+}
+
+var fieldIDToName_PurgeDeadLettersRequest = map[int16]string{
+	1: "queue",
+	2: "message_ids",
+}
+
+type PurgeDeadLettersResponse struct {
+	Base        *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	PurgedCount int64        `thrift:"purged_count,2" frugal:"2,default,i64" json:"purged_count"`
+}
+
+func NewPurgeDeadLettersResponse() *PurgeDeadLettersResponse {
+	return &PurgeDeadLettersResponse{}
+}
+
+func (p *PurgeDeadLettersResponse) InitDefault() {
+}
+
+var PurgeDeadLettersResponse_Base_DEFAULT *base.Status
+
+func (p *PurgeDeadLettersResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return PurgeDeadLettersResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *PurgeDeadLettersResponse) GetPurgedCount() (v int64) {
+	return p.PurgedCount
+}
+func (p *PurgeDeadLettersResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *PurgeDeadLettersResponse) SetPurgedCount(val int64) {
+	p.PurgedCount = val
+}
+
+func (p *PurgeDeadLettersResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *PurgeDeadLettersResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PurgeDeadLettersResponse(%+v)", *p)
+}
+
+var fieldIDToName_PurgeDeadLettersResponse = map[int16]string{
+	1: "base",
+	2: "purged_count",
+}
+
+type InteractionService interface {
+	LikeAction(ctx context.Context, req *LikeActionRequest) (r *LikeActionResponse, err error)
+
+	LikeList(ctx context.Context, req *LikeListRequest) (r *LikeListResponse, err error)
+
+	CreateComment(ctx context.Context, req *CreateCommentRequest) (r *CreateCommentResponse, err error)
+
+	ListComment(ctx context.Context, req *ListCommentRequest) (r *ListCommentResponse, err error)
+
+	ListCommentThreads(ctx context.Context, req *ListCommentThreadsRequest) (r *ListCommentThreadsResponse, err error)
+
+	PinComment(ctx context.Context, req *PinCommentRequest) (r *PinCommentResponse, err error)
+
+	EditComment(ctx context.Context, req *EditCommentRequest) (r *EditCommentResponse, err error)
+
+	ListCommentEdits(ctx context.Context, req *ListCommentEditsRequest) (r *ListCommentEditsResponse, err error)
+
+	ListCommentReviews(ctx context.Context, req *ListCommentReviewsRequest) (r *ListCommentReviewsResponse, err error)
+
+	ApproveCommentReview(ctx context.Context, req *ApproveCommentReviewRequest) (r *ApproveCommentReviewResponse, err error)
+
+	RejectCommentReview(ctx context.Context, req *RejectCommentReviewRequest) (r *RejectCommentReviewResponse, err error)
+
+	Report(ctx context.Context, req *ReportRequest) (r *ReportResponse, err error)
+
+	ListReportCases(ctx context.Context, req *ListReportCasesRequest) (r *ListReportCasesResponse, err error)
+
+	AssignReportCase(ctx context.Context, req *AssignReportCaseRequest) (r *AssignReportCaseResponse, err error)
+
+	ResolveReportCase(ctx context.Context, req *ResolveReportCaseRequest) (r *ResolveReportCaseResponse, err error)
+
+	DismissReportCase(ctx context.Context, req *DismissReportCaseRequest) (r *DismissReportCaseResponse, err error)
+
+	GetHiddenTargets(ctx context.Context, req *GetHiddenTargetsRequest) (r *GetHiddenTargetsResponse, err error)
+
+	GetCoInteractions(ctx context.Context, req *GetCoInteractionsRequest) (r *GetCoInteractionsResponse, err error)
+
+	DeleteComment(ctx context.Context, req *CommentDeleteRequest) (r *CommentDeleteResponse, err error)
+
+	VideoPopularList(ctx context.Context, req *VideoPopularListRequest) (r *VideoPopularListResponse, err error)
+
+	DeleteVideoInfo(ctx context.Context, req *DeleteVideoInfoRequest) (r *DeleteVideoInfoResponse, err error)
+
+	GetNotifications(ctx context.Context, req *GetNotificationsRequest) (r *GetNotificationsResponse, err error)
+
+	MarkNotificationRead(ctx context.Context, req *MarkNotificationReadRequest) (r *MarkNotificationReadResponse, err error)
+
+	ListDeadLetterQueues(ctx context.Context, req *ListDeadLetterQueuesRequest) (r *ListDeadLetterQueuesResponse, err error)
+
+	ListDeadLetters(ctx context.Context, req *ListDeadLettersRequest) (r *ListDeadLettersResponse, err error)
+
+	GetDeadLetter(ctx context.Context, req *GetDeadLetterRequest) (r *GetDeadLetterResponse, err error)
+
+	ReplayDeadLetters(ctx context.Context, req *ReplayDeadLettersRequest) (r *ReplayDeadLettersResponse, err error)
+
+	PurgeDeadLetters(ctx context.Context, req *PurgeDeadLettersRequest) (r *PurgeDeadLettersResponse, err error)
+}
+
+type InteractionServiceLikeActionArgs struct {
+	Req *LikeActionRequest `thrift:"req,1" frugal:"1,default,LikeActionRequest" json:"req"`
+}
+
+func NewInteractionServiceLikeActionArgs() *InteractionServiceLikeActionArgs {
+	return &InteractionServiceLikeActionArgs{}
+}
+
+func (p *InteractionServiceLikeActionArgs) InitDefault() {
+}
+
+var InteractionServiceLikeActionArgs_Req_DEFAULT *LikeActionRequest
+
+func (p *InteractionServiceLikeActionArgs) GetReq() (v *LikeActionRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceLikeActionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceLikeActionArgs) SetReq(val *LikeActionRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceLikeActionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceLikeActionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceLikeActionArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceLikeActionArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceLikeActionResult struct {
+	Success *LikeActionResponse `thrift:"success,0,optional" frugal:"0,optional,LikeActionResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceLikeActionResult() *InteractionServiceLikeActionResult {
+	return &InteractionServiceLikeActionResult{}
+}
+
+func (p *InteractionServiceLikeActionResult) InitDefault() {
+}
+
+var InteractionServiceLikeActionResult_Success_DEFAULT *LikeActionResponse
+
+func (p *InteractionServiceLikeActionResult) GetSuccess() (v *LikeActionResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceLikeActionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceLikeActionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LikeActionResponse)
+}
+
+func (p *InteractionServiceLikeActionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceLikeActionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceLikeActionResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceLikeActionResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceLikeListArgs struct {
+	Req *LikeListRequest `thrift:"req,1" frugal:"1,default,LikeListRequest" json:"req"`
+}
+
+func NewInteractionServiceLikeListArgs() *InteractionServiceLikeListArgs {
+	return &InteractionServiceLikeListArgs{}
+}
+
+func (p *InteractionServiceLikeListArgs) InitDefault() {
+}
+
+var InteractionServiceLikeListArgs_Req_DEFAULT *LikeListRequest
+
+func (p *InteractionServiceLikeListArgs) GetReq() (v *LikeListRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceLikeListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceLikeListArgs) SetReq(val *LikeListRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceLikeListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceLikeListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceLikeListArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceLikeListArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceLikeListResult struct {
+	Success *LikeListResponse `thrift:"success,0,optional" frugal:"0,optional,LikeListResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceLikeListResult() *InteractionServiceLikeListResult {
+	return &InteractionServiceLikeListResult{}
+}
+
+func (p *InteractionServiceLikeListResult) InitDefault() {
+}
+
+var InteractionServiceLikeListResult_Success_DEFAULT *LikeListResponse
+
+func (p *InteractionServiceLikeListResult) GetSuccess() (v *LikeListResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceLikeListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceLikeListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LikeListResponse)
+}
+
+func (p *InteractionServiceLikeListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceLikeListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceLikeListResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceLikeListResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceCreateCommentArgs struct {
+	Req *CreateCommentRequest `thrift:"req,1" frugal:"1,default,CreateCommentRequest" json:"req"`
+}
+
+func NewInteractionServiceCreateCommentArgs() *InteractionServiceCreateCommentArgs {
+	return &InteractionServiceCreateCommentArgs{}
+}
+
+func (p *InteractionServiceCreateCommentArgs) InitDefault() {
+}
+
+var InteractionServiceCreateCommentArgs_Req_DEFAULT *CreateCommentRequest
+
+func (p *InteractionServiceCreateCommentArgs) GetReq() (v *CreateCommentRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceCreateCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceCreateCommentArgs) SetReq(val *CreateCommentRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceCreateCommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceCreateCommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceCreateCommentArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceCreateCommentArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceCreateCommentResult struct {
+	Success *CreateCommentResponse `thrift:"success,0,optional" frugal:"0,optional,CreateCommentResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceCreateCommentResult() *InteractionServiceCreateCommentResult {
+	return &InteractionServiceCreateCommentResult{}
+}
+
+func (p *InteractionServiceCreateCommentResult) InitDefault() {
+}
+
+var InteractionServiceCreateCommentResult_Success_DEFAULT *CreateCommentResponse
+
+func (p *InteractionServiceCreateCommentResult) GetSuccess() (v *CreateCommentResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceCreateCommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceCreateCommentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateCommentResponse)
+}
+
+func (p *InteractionServiceCreateCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceCreateCommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceCreateCommentResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceCreateCommentResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceListCommentArgs struct {
+	Req *ListCommentRequest `thrift:"req,1" frugal:"1,default,ListCommentRequest" json:"req"`
+}
+
+func NewInteractionServiceListCommentArgs() *InteractionServiceListCommentArgs {
+	return &InteractionServiceListCommentArgs{}
+}
+
+func (p *InteractionServiceListCommentArgs) InitDefault() {
+}
+
+var InteractionServiceListCommentArgs_Req_DEFAULT *ListCommentRequest
+
+func (p *InteractionServiceListCommentArgs) GetReq() (v *ListCommentRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceListCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceListCommentArgs) SetReq(val *ListCommentRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceListCommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceListCommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceListCommentArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceListCommentArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceListCommentResult struct {
+	Success *ListCommentResponse `thrift:"success,0,optional" frugal:"0,optional,ListCommentResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceListCommentResult() *InteractionServiceListCommentResult {
+	return &InteractionServiceListCommentResult{}
+}
+
+func (p *InteractionServiceListCommentResult) InitDefault() {
+}
+
+var InteractionServiceListCommentResult_Success_DEFAULT *ListCommentResponse
+
+func (p *InteractionServiceListCommentResult) GetSuccess() (v *ListCommentResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceListCommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceListCommentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListCommentResponse)
+}
+
+func (p *InteractionServiceListCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceListCommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceListCommentResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceListCommentResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceListCommentThreadsArgs struct {
+	Req *ListCommentThreadsRequest `thrift:"req,1" frugal:"1,default,ListCommentThreadsRequest" json:"req"`
+}
+
+func NewInteractionServiceListCommentThreadsArgs() *InteractionServiceListCommentThreadsArgs {
+	return &InteractionServiceListCommentThreadsArgs{}
+}
+
+func (p *InteractionServiceListCommentThreadsArgs) InitDefault() {
+}
+
+var InteractionServiceListCommentThreadsArgs_Req_DEFAULT *ListCommentThreadsRequest
+
+func (p *InteractionServiceListCommentThreadsArgs) GetReq() (v *ListCommentThreadsRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceListCommentThreadsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceListCommentThreadsArgs) SetReq(val *ListCommentThreadsRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceListCommentThreadsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceListCommentThreadsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceListCommentThreadsArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceListCommentThreadsArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceListCommentThreadsResult struct {
+	Success *ListCommentThreadsResponse `thrift:"success,0,optional" frugal:"0,optional,ListCommentThreadsResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceListCommentThreadsResult() *InteractionServiceListCommentThreadsResult {
+	return &InteractionServiceListCommentThreadsResult{}
+}
+
+func (p *InteractionServiceListCommentThreadsResult) InitDefault() {
+}
+
+var InteractionServiceListCommentThreadsResult_Success_DEFAULT *ListCommentThreadsResponse
+
+func (p *InteractionServiceListCommentThreadsResult) GetSuccess() (v *ListCommentThreadsResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceListCommentThreadsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceListCommentThreadsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListCommentThreadsResponse)
+}
+
+func (p *InteractionServiceListCommentThreadsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceListCommentThreadsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceListCommentThreadsResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceListCommentThreadsResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServicePinCommentArgs struct {
+	Req *PinCommentRequest `thrift:"req,1" frugal:"1,default,PinCommentRequest" json:"req"`
+}
+
+func NewInteractionServicePinCommentArgs() *InteractionServicePinCommentArgs {
+	return &InteractionServicePinCommentArgs{}
+}
+
+func (p *InteractionServicePinCommentArgs) InitDefault() {
+}
+
+var InteractionServicePinCommentArgs_Req_DEFAULT *PinCommentRequest
+
+func (p *InteractionServicePinCommentArgs) GetReq() (v *PinCommentRequest) {
+	if !p.IsSetReq() {
+		return InteractionServicePinCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServicePinCommentArgs) SetReq(val *PinCommentRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServicePinCommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServicePinCommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServicePinCommentArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServicePinCommentArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServicePinCommentResult struct {
+	Success *PinCommentResponse `thrift:"success,0,optional" frugal:"0,optional,PinCommentResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServicePinCommentResult() *InteractionServicePinCommentResult {
+	return &InteractionServicePinCommentResult{}
+}
+
+func (p *InteractionServicePinCommentResult) InitDefault() {
+}
+
+var InteractionServicePinCommentResult_Success_DEFAULT *PinCommentResponse
+
+func (p *InteractionServicePinCommentResult) GetSuccess() (v *PinCommentResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServicePinCommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServicePinCommentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*PinCommentResponse)
+}
+
+func (p *InteractionServicePinCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServicePinCommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServicePinCommentResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServicePinCommentResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceEditCommentArgs struct {
+	Req *EditCommentRequest `thrift:"req,1" frugal:"1,default,EditCommentRequest" json:"req"`
+}
+
+func NewInteractionServiceEditCommentArgs() *InteractionServiceEditCommentArgs {
+	return &InteractionServiceEditCommentArgs{}
+}
+
+func (p *InteractionServiceEditCommentArgs) InitDefault() {
+}
+
+var InteractionServiceEditCommentArgs_Req_DEFAULT *EditCommentRequest
+
+func (p *InteractionServiceEditCommentArgs) GetReq() (v *EditCommentRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceEditCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceEditCommentArgs) SetReq(val *EditCommentRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceEditCommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceEditCommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceEditCommentArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceEditCommentArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceEditCommentResult struct {
+	Success *EditCommentResponse `thrift:"success,0,optional" frugal:"0,optional,EditCommentResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceEditCommentResult() *InteractionServiceEditCommentResult {
+	return &InteractionServiceEditCommentResult{}
+}
+
+func (p *InteractionServiceEditCommentResult) InitDefault() {
+}
+
+var InteractionServiceEditCommentResult_Success_DEFAULT *EditCommentResponse
+
+func (p *InteractionServiceEditCommentResult) GetSuccess() (v *EditCommentResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceEditCommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceEditCommentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*EditCommentResponse)
+}
+
+func (p *InteractionServiceEditCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceEditCommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceEditCommentResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceEditCommentResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceListCommentEditsArgs struct {
+	Req *ListCommentEditsRequest `thrift:"req,1" frugal:"1,default,ListCommentEditsRequest" json:"req"`
+}
+
+func NewInteractionServiceListCommentEditsArgs() *InteractionServiceListCommentEditsArgs {
+	return &InteractionServiceListCommentEditsArgs{}
+}
+
+func (p *InteractionServiceListCommentEditsArgs) InitDefault() {
+}
+
+var InteractionServiceListCommentEditsArgs_Req_DEFAULT *ListCommentEditsRequest
+
+func (p *InteractionServiceListCommentEditsArgs) GetReq() (v *ListCommentEditsRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceListCommentEditsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceListCommentEditsArgs) SetReq(val *ListCommentEditsRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceListCommentEditsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceListCommentEditsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceListCommentEditsArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceListCommentEditsArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceListCommentEditsResult struct {
+	Success *ListCommentEditsResponse `thrift:"success,0,optional" frugal:"0,optional,ListCommentEditsResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceListCommentEditsResult() *InteractionServiceListCommentEditsResult {
+	return &InteractionServiceListCommentEditsResult{}
+}
+
+func (p *InteractionServiceListCommentEditsResult) InitDefault() {
+}
+
+var InteractionServiceListCommentEditsResult_Success_DEFAULT *ListCommentEditsResponse
+
+func (p *InteractionServiceListCommentEditsResult) GetSuccess() (v *ListCommentEditsResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceListCommentEditsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceListCommentEditsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListCommentEditsResponse)
+}
+
+func (p *InteractionServiceListCommentEditsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceListCommentEditsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceListCommentEditsResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceListCommentEditsResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceListCommentReviewsArgs struct {
+	Req *ListCommentReviewsRequest `thrift:"req,1" frugal:"1,default,ListCommentReviewsRequest" json:"req"`
+}
+
+func NewInteractionServiceListCommentReviewsArgs() *InteractionServiceListCommentReviewsArgs {
+	return &InteractionServiceListCommentReviewsArgs{}
+}
+
+func (p *InteractionServiceListCommentReviewsArgs) InitDefault() {
+}
+
+var InteractionServiceListCommentReviewsArgs_Req_DEFAULT *ListCommentReviewsRequest
+
+func (p *InteractionServiceListCommentReviewsArgs) GetReq() (v *ListCommentReviewsRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceListCommentReviewsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceListCommentReviewsArgs) SetReq(val *ListCommentReviewsRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceListCommentReviewsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceListCommentReviewsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceListCommentReviewsArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceListCommentReviewsArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceListCommentReviewsResult struct {
+	Success *ListCommentReviewsResponse `thrift:"success,0,optional" frugal:"0,optional,ListCommentReviewsResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceListCommentReviewsResult() *InteractionServiceListCommentReviewsResult {
+	return &InteractionServiceListCommentReviewsResult{}
+}
+
+func (p *InteractionServiceListCommentReviewsResult) InitDefault() {
+}
+
+var InteractionServiceListCommentReviewsResult_Success_DEFAULT *ListCommentReviewsResponse
+
+func (p *InteractionServiceListCommentReviewsResult) GetSuccess() (v *ListCommentReviewsResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceListCommentReviewsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceListCommentReviewsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListCommentReviewsResponse)
+}
+
+func (p *InteractionServiceListCommentReviewsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceListCommentReviewsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceListCommentReviewsResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceListCommentReviewsResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceApproveCommentReviewArgs struct {
+	Req *ApproveCommentReviewRequest `thrift:"req,1" frugal:"1,default,ApproveCommentReviewRequest" json:"req"`
+}
+
+func NewInteractionServiceApproveCommentReviewArgs() *InteractionServiceApproveCommentReviewArgs {
+	return &InteractionServiceApproveCommentReviewArgs{}
+}
+
+func (p *InteractionServiceApproveCommentReviewArgs) InitDefault() {
+}
+
+var InteractionServiceApproveCommentReviewArgs_Req_DEFAULT *ApproveCommentReviewRequest
+
+func (p *InteractionServiceApproveCommentReviewArgs) GetReq() (v *ApproveCommentReviewRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceApproveCommentReviewArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceApproveCommentReviewArgs) SetReq(val *ApproveCommentReviewRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceApproveCommentReviewArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceApproveCommentReviewArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceApproveCommentReviewArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceApproveCommentReviewArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceApproveCommentReviewResult struct {
+	Success *ApproveCommentReviewResponse `thrift:"success,0,optional" frugal:"0,optional,ApproveCommentReviewResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceApproveCommentReviewResult() *InteractionServiceApproveCommentReviewResult {
+	return &InteractionServiceApproveCommentReviewResult{}
+}
+
+func (p *InteractionServiceApproveCommentReviewResult) InitDefault() {
+}
+
+var InteractionServiceApproveCommentReviewResult_Success_DEFAULT *ApproveCommentReviewResponse
+
+func (p *InteractionServiceApproveCommentReviewResult) GetSuccess() (v *ApproveCommentReviewResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceApproveCommentReviewResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceApproveCommentReviewResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ApproveCommentReviewResponse)
+}
+
+func (p *InteractionServiceApproveCommentReviewResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceApproveCommentReviewResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceApproveCommentReviewResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceApproveCommentReviewResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceRejectCommentReviewArgs struct {
+	Req *RejectCommentReviewRequest `thrift:"req,1" frugal:"1,default,RejectCommentReviewRequest" json:"req"`
+}
+
+func NewInteractionServiceRejectCommentReviewArgs() *InteractionServiceRejectCommentReviewArgs {
+	return &InteractionServiceRejectCommentReviewArgs{}
+}
+
+func (p *InteractionServiceRejectCommentReviewArgs) InitDefault() {
+}
+
+var InteractionServiceRejectCommentReviewArgs_Req_DEFAULT *RejectCommentReviewRequest
+
+func (p *InteractionServiceRejectCommentReviewArgs) GetReq() (v *RejectCommentReviewRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceRejectCommentReviewArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceRejectCommentReviewArgs) SetReq(val *RejectCommentReviewRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceRejectCommentReviewArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceRejectCommentReviewArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceRejectCommentReviewArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceRejectCommentReviewArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceRejectCommentReviewResult struct {
+	Success *RejectCommentReviewResponse `thrift:"success,0,optional" frugal:"0,optional,RejectCommentReviewResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceRejectCommentReviewResult() *InteractionServiceRejectCommentReviewResult {
+	return &InteractionServiceRejectCommentReviewResult{}
+}
+
+func (p *InteractionServiceRejectCommentReviewResult) InitDefault() {
+}
+
+var InteractionServiceRejectCommentReviewResult_Success_DEFAULT *RejectCommentReviewResponse
+
+func (p *InteractionServiceRejectCommentReviewResult) GetSuccess() (v *RejectCommentReviewResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceRejectCommentReviewResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceRejectCommentReviewResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RejectCommentReviewResponse)
+}
+
+func (p *InteractionServiceRejectCommentReviewResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceRejectCommentReviewResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceRejectCommentReviewResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceRejectCommentReviewResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceReportArgs struct {
+	Req *ReportRequest `thrift:"req,1" frugal:"1,default,ReportRequest" json:"req"`
+}
+
+func NewInteractionServiceReportArgs() *InteractionServiceReportArgs {
+	return &InteractionServiceReportArgs{}
+}
+
+func (p *InteractionServiceReportArgs) InitDefault() {
+}
+
+var InteractionServiceReportArgs_Req_DEFAULT *ReportRequest
+
+func (p *InteractionServiceReportArgs) GetReq() (v *ReportRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceReportArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceReportArgs) SetReq(val *ReportRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceReportArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *NotificationInfo) GetFromUserName() (v string) {
-	return p.FromUserName
+func (p *InteractionServiceReportArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceReportArgs(%+v)", *p)
 }
 
-func (p *NotificationInfo) GetFromUserAvatar() (v string) {
-	return p.FromUserAvatar
+var fieldIDToName_InteractionServiceReportArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *NotificationInfo) GetNotificationType() (v string) {
-	return p.NotificationType
+type InteractionServiceReportResult struct {
+	Success *ReportResponse `thrift:"success,0,optional" frugal:"0,optional,ReportResponse" json:"success,omitempty"`
 }
 
-func (p *NotificationInfo) GetContent() (v string) {
-	return p.Content
+func NewInteractionServiceReportResult() *InteractionServiceReportResult {
+	return &InteractionServiceReportResult{}
 }
 
-func (p *NotificationInfo) GetTargetId() (v int64) {
-	return p.TargetId
+func (p *InteractionServiceReportResult) InitDefault() {
 }
 
-func (p *NotificationInfo) GetIsRead() (v bool) {
-	return p.IsRead
-}
+var InteractionServiceReportResult_Success_DEFAULT *ReportResponse
 
-func (p *NotificationInfo) GetCreatedAt() (v string) {
-	return p.CreatedAt
+func (p *InteractionServiceReportResult) GetSuccess() (v *ReportResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceReportResult_Success_DEFAULT
+	}
+	return p.Success
 }
-func (p *NotificationInfo) SetNotificationId(val int64) {
-	p.NotificationId = val
+func (p *InteractionServiceReportResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReportResponse)
 }
-func (p *NotificationInfo) SetFromUserId(val int64) {
-	p.FromUserId = val
+
+func (p *InteractionServiceReportResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
-func (p *NotificationInfo) SetFromUserName(val string) {
-	p.FromUserName = val
+
+func (p *InteractionServiceReportResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceReportResult(%+v)", *p)
 }
-func (p *NotificationInfo) SetFromUserAvatar(val string) {
-	p.FromUserAvatar = val
+
+var fieldIDToName_InteractionServiceReportResult = map[int16]string{
+	0: "success",
 }
-func (p *NotificationInfo) SetNotificationType(val string) {
-	p.NotificationType = val
+
+type InteractionServiceListReportCasesArgs struct {
+	Req *ListReportCasesRequest `thrift:"req,1" frugal:"1,default,ListReportCasesRequest" json:"req"`
 }
-func (p *NotificationInfo) SetContent(val string) {
-	p.Content = val
+
+func NewInteractionServiceListReportCasesArgs() *InteractionServiceListReportCasesArgs {
+	return &InteractionServiceListReportCasesArgs{}
 }
-func (p *NotificationInfo) SetTargetId(val int64) {
-	p.TargetId = val
+
+func (p *InteractionServiceListReportCasesArgs) InitDefault() {
 }
-func (p *NotificationInfo) SetIsRead(val bool) {
-	p.IsRead = val
+
+var InteractionServiceListReportCasesArgs_Req_DEFAULT *ListReportCasesRequest
+
+func (p *InteractionServiceListReportCasesArgs) GetReq() (v *ListReportCasesRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceListReportCasesArgs_Req_DEFAULT
+	}
+	return p.Req
 }
-func (p *NotificationInfo) SetCreatedAt(val string) {
-	p.CreatedAt = val
+func (p *InteractionServiceListReportCasesArgs) SetReq(val *ListReportCasesRequest) {
+	p.Req = val
 }
 
-func (p *NotificationInfo) String() string {
+func (p *InteractionServiceListReportCasesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceListReportCasesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NotificationInfo(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceListReportCasesArgs(%+v)", *p)
 }
 
-var fieldIDToName_NotificationInfo = map[int16]string{
-	1: "notification_id",
-	2: "from_user_id",
-	3: "from_user_name",
-	4: "from_user_avatar",
-	5: "notification_type",
-	6: "content",
-	7: "target_id",
-	8: "is_read",
-	9: "created_at",
+var fieldIDToName_InteractionServiceListReportCasesArgs = map[int16]string{
+	1: "req",
 }
 
-type MarkNotificationReadRequest struct {
-	UserId          int64   `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
-	NotificationIds []int64 `thrift:"notification_ids,2" frugal:"2,default,list<i64>" json:"notification_ids"`
+type InteractionServiceListReportCasesResult struct {
+	Success *ListReportCasesResponse `thrift:"success,0,optional" frugal:"0,optional,ListReportCasesResponse" json:"success,omitempty"`
 }
 
-func NewMarkNotificationReadRequest() *MarkNotificationReadRequest {
-	return &MarkNotificationReadRequest{}
+func NewInteractionServiceListReportCasesResult() *InteractionServiceListReportCasesResult {
+	return &InteractionServiceListReportCasesResult{}
 }
 
-func (p *MarkNotificationReadRequest) InitDefault() {
+func (p *InteractionServiceListReportCasesResult) InitDefault() {
 }
 
-func (p *MarkNotificationReadRequest) GetUserId() (v int64) {
-	return p.UserId
-}
+var InteractionServiceListReportCasesResult_Success_DEFAULT *ListReportCasesResponse
 
-func (p *MarkNotificationReadRequest) GetNotificationIds() (v []int64) {
-	return p.NotificationIds
+func (p *InteractionServiceListReportCasesResult) GetSuccess() (v *ListReportCasesResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceListReportCasesResult_Success_DEFAULT
+	}
+	return p.Success
 }
-func (p *MarkNotificationReadRequest) SetUserId(val int64) {
-	p.UserId = val
+func (p *InteractionServiceListReportCasesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListReportCasesResponse)
 }
-func (p *MarkNotificationReadRequest) SetNotificationIds(val []int64) {
-	p.NotificationIds = val
+
+func (p *InteractionServiceListReportCasesResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func (p *MarkNotificationReadRequest) String() string {
+func (p *InteractionServiceListReportCasesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("MarkNotificationReadRequest(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceListReportCasesResult(%+v)", *p)
 }
 
-var fieldIDToName_MarkNotificationReadRequest = map[int16]string{
-	1: "user_id",
-	2: "notification_ids",
+var fieldIDToName_InteractionServiceListReportCasesResult = map[int16]string{
+	0: "success",
 }
 
-type MarkNotificationReadResponse struct {
-	Base        *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
-	MarkedCount int64        `thrift:"marked_count,2" frugal:"2,default,i64" json:"marked_count"`
+type InteractionServiceAssignReportCaseArgs struct {
+	Req *AssignReportCaseRequest `thrift:"req,1" frugal:"1,default,AssignReportCaseRequest" json:"req"`
 }
 
-func NewMarkNotificationReadResponse() *MarkNotificationReadResponse {
-	return &MarkNotificationReadResponse{}
+func NewInteractionServiceAssignReportCaseArgs() *InteractionServiceAssignReportCaseArgs {
+	return &InteractionServiceAssignReportCaseArgs{}
 }
 
-func (p *MarkNotificationReadResponse) InitDefault() {
+func (p *InteractionServiceAssignReportCaseArgs) InitDefault() {
 }
 
-var MarkNotificationReadResponse_Base_DEFAULT *base.Status
+var InteractionServiceAssignReportCaseArgs_Req_DEFAULT *AssignReportCaseRequest
 
-func (p *MarkNotificationReadResponse) GetBase() (v *base.Status) {
-	if !p.IsSetBase() {
-		return MarkNotificationReadResponse_Base_DEFAULT
+func (p *InteractionServiceAssignReportCaseArgs) GetReq() (v *AssignReportCaseRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceAssignReportCaseArgs_Req_DEFAULT
 	}
-	return p.Base
-}
-
-func (p *MarkNotificationReadResponse) GetMarkedCount() (v int64) {
-	return p.MarkedCount
-}
-func (p *MarkNotificationReadResponse) SetBase(val *base.Status) {
-	p.Base = val
+	return p.Req
 }
-func (p *MarkNotificationReadResponse) SetMarkedCount(val int64) {
-	p.MarkedCount = val
+func (p *InteractionServiceAssignReportCaseArgs) SetReq(val *AssignReportCaseRequest) {
+	p.Req = val
 }
 
-func (p *MarkNotificationReadResponse) IsSetBase() bool {
-	return p.Base != nil
+func (p *InteractionServiceAssignReportCaseArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *MarkNotificationReadResponse) String() string {
+func (p *InteractionServiceAssignReportCaseArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("MarkNotificationReadResponse(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceAssignReportCaseArgs(%+v)", *p)
 }
 
-var fieldIDToName_MarkNotificationReadResponse = map[int16]string{
-	1: "base",
-	2: "marked_count",
+var fieldIDToName_InteractionServiceAssignReportCaseArgs = map[int16]string{
+	1: "req",
 }
 
-type InteractionService interface {
-	LikeAction(ctx context.Context, req *LikeActionRequest) (r *LikeActionResponse, err error)
-
-	LikeList(ctx context.Context, req *LikeListRequest) (r *LikeListResponse, err error)
+type InteractionServiceAssignReportCaseResult struct {
+	Success *AssignReportCaseResponse `thrift:"success,0,optional" frugal:"0,optional,AssignReportCaseResponse" json:"success,omitempty"`
+}
 
-	CreateComment(ctx context.Context, req *CreateCommentRequest) (r *CreateCommentResponse, err error)
+func NewInteractionServiceAssignReportCaseResult() *InteractionServiceAssignReportCaseResult {
+	return &InteractionServiceAssignReportCaseResult{}
+}
 
-	ListComment(ctx context.Context, req *ListCommentRequest) (r *ListCommentResponse, err error)
+func (p *InteractionServiceAssignReportCaseResult) InitDefault() {
+}
 
-	DeleteComment(ctx context.Context, req *CommentDeleteRequest) (r *CommentDeleteResponse, err error)
+var InteractionServiceAssignReportCaseResult_Success_DEFAULT *AssignReportCaseResponse
 
-	VideoPopularList(ctx context.Context, req *VideoPopularListRequest) (r *VideoPopularListResponse, err error)
+func (p *InteractionServiceAssignReportCaseResult) GetSuccess() (v *AssignReportCaseResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceAssignReportCaseResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceAssignReportCaseResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AssignReportCaseResponse)
+}
 
-	DeleteVideoInfo(ctx context.Context, req *DeleteVideoInfoRequest) (r *DeleteVideoInfoResponse, err error)
+func (p *InteractionServiceAssignReportCaseResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	GetNotifications(ctx context.Context, req *GetNotificationsRequest) (r *GetNotificationsResponse, err error)
+func (p *InteractionServiceAssignReportCaseResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceAssignReportCaseResult(%+v)", *p)
+}
 
-	MarkNotificationRead(ctx context.Context, req *MarkNotificationReadRequest) (r *MarkNotificationReadResponse, err error)
+var fieldIDToName_InteractionServiceAssignReportCaseResult = map[int16]string{
+	0: "success",
 }
 
-type InteractionServiceLikeActionArgs struct {
-	Req *LikeActionRequest `thrift:"req,1" frugal:"1,default,LikeActionRequest" json:"req"`
+type InteractionServiceResolveReportCaseArgs struct {
+	Req *ResolveReportCaseRequest `thrift:"req,1" frugal:"1,default,ResolveReportCaseRequest" json:"req"`
 }
 
-func NewInteractionServiceLikeActionArgs() *InteractionServiceLikeActionArgs {
-	return &InteractionServiceLikeActionArgs{}
+func NewInteractionServiceResolveReportCaseArgs() *InteractionServiceResolveReportCaseArgs {
+	return &InteractionServiceResolveReportCaseArgs{}
 }
 
-func (p *InteractionServiceLikeActionArgs) InitDefault() {
+func (p *InteractionServiceResolveReportCaseArgs) InitDefault() {
 }
 
-var InteractionServiceLikeActionArgs_Req_DEFAULT *LikeActionRequest
+var InteractionServiceResolveReportCaseArgs_Req_DEFAULT *ResolveReportCaseRequest
 
-func (p *InteractionServiceLikeActionArgs) GetReq() (v *LikeActionRequest) {
+func (p *InteractionServiceResolveReportCaseArgs) GetReq() (v *ResolveReportCaseRequest) {
 	if !p.IsSetReq() {
-		return InteractionServiceLikeActionArgs_Req_DEFAULT
+		return InteractionServiceResolveReportCaseArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *InteractionServiceLikeActionArgs) SetReq(val *LikeActionRequest) {
+func (p *InteractionServiceResolveReportCaseArgs) SetReq(val *ResolveReportCaseRequest) {
 	p.Req = val
 }
 
-func (p *InteractionServiceLikeActionArgs) IsSetReq() bool {
+func (p *InteractionServiceResolveReportCaseArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionServiceLikeActionArgs) String() string {
+func (p *InteractionServiceResolveReportCaseArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceLikeActionArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceResolveReportCaseArgs(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceLikeActionArgs = map[int16]string{
+var fieldIDToName_InteractionServiceResolveReportCaseArgs = map[int16]string{
 	1: "req",
 }
 
-type InteractionServiceLikeActionResult struct {
-	Success *LikeActionResponse `thrift:"success,0,optional" frugal:"0,optional,LikeActionResponse" json:"success,omitempty"`
+type InteractionServiceResolveReportCaseResult struct {
+	Success *ResolveReportCaseResponse `thrift:"success,0,optional" frugal:"0,optional,ResolveReportCaseResponse" json:"success,omitempty"`
 }
 
-func NewInteractionServiceLikeActionResult() *InteractionServiceLikeActionResult {
-	return &InteractionServiceLikeActionResult{}
+func NewInteractionServiceResolveReportCaseResult() *InteractionServiceResolveReportCaseResult {
+	return &InteractionServiceResolveReportCaseResult{}
 }
 
-func (p *InteractionServiceLikeActionResult) InitDefault() {
+func (p *InteractionServiceResolveReportCaseResult) InitDefault() {
 }
 
-var InteractionServiceLikeActionResult_Success_DEFAULT *LikeActionResponse
+var InteractionServiceResolveReportCaseResult_Success_DEFAULT *ResolveReportCaseResponse
 
-func (p *InteractionServiceLikeActionResult) GetSuccess() (v *LikeActionResponse) {
+func (p *InteractionServiceResolveReportCaseResult) GetSuccess() (v *ResolveReportCaseResponse) {
 	if !p.IsSetSuccess() {
-		return InteractionServiceLikeActionResult_Success_DEFAULT
+		return InteractionServiceResolveReportCaseResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *InteractionServiceLikeActionResult) SetSuccess(x interface{}) {
-	p.Success = x.(*LikeActionResponse)
+func (p *InteractionServiceResolveReportCaseResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ResolveReportCaseResponse)
 }
 
-func (p *InteractionServiceLikeActionResult) IsSetSuccess() bool {
+func (p *InteractionServiceResolveReportCaseResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionServiceLikeActionResult) String() string {
+func (p *InteractionServiceResolveReportCaseResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceLikeActionResult(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceResolveReportCaseResult(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceLikeActionResult = map[int16]string{
+var fieldIDToName_InteractionServiceResolveReportCaseResult = map[int16]string{
 	0: "success",
 }
 
-type InteractionServiceLikeListArgs struct {
-	Req *LikeListRequest `thrift:"req,1" frugal:"1,default,LikeListRequest" json:"req"`
+type InteractionServiceDismissReportCaseArgs struct {
+	Req *DismissReportCaseRequest `thrift:"req,1" frugal:"1,default,DismissReportCaseRequest" json:"req"`
 }
 
-func NewInteractionServiceLikeListArgs() *InteractionServiceLikeListArgs {
-	return &InteractionServiceLikeListArgs{}
+func NewInteractionServiceDismissReportCaseArgs() *InteractionServiceDismissReportCaseArgs {
+	return &InteractionServiceDismissReportCaseArgs{}
 }
 
-func (p *InteractionServiceLikeListArgs) InitDefault() {
+func (p *InteractionServiceDismissReportCaseArgs) InitDefault() {
 }
 
-var InteractionServiceLikeListArgs_Req_DEFAULT *LikeListRequest
+var InteractionServiceDismissReportCaseArgs_Req_DEFAULT *DismissReportCaseRequest
 
-func (p *InteractionServiceLikeListArgs) GetReq() (v *LikeListRequest) {
+func (p *InteractionServiceDismissReportCaseArgs) GetReq() (v *DismissReportCaseRequest) {
 	if !p.IsSetReq() {
-		return InteractionServiceLikeListArgs_Req_DEFAULT
+		return InteractionServiceDismissReportCaseArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *InteractionServiceLikeListArgs) SetReq(val *LikeListRequest) {
+func (p *InteractionServiceDismissReportCaseArgs) SetReq(val *DismissReportCaseRequest) {
 	p.Req = val
 }
 
-func (p *InteractionServiceLikeListArgs) IsSetReq() bool {
+func (p *InteractionServiceDismissReportCaseArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionServiceLikeListArgs) String() string {
+func (p *InteractionServiceDismissReportCaseArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceLikeListArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceDismissReportCaseArgs(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceLikeListArgs = map[int16]string{
+var fieldIDToName_InteractionServiceDismissReportCaseArgs = map[int16]string{
 	1: "req",
 }
 
-type InteractionServiceLikeListResult struct {
-	Success *LikeListResponse `thrift:"success,0,optional" frugal:"0,optional,LikeListResponse" json:"success,omitempty"`
+type InteractionServiceDismissReportCaseResult struct {
+	Success *DismissReportCaseResponse `thrift:"success,0,optional" frugal:"0,optional,DismissReportCaseResponse" json:"success,omitempty"`
 }
 
-func NewInteractionServiceLikeListResult() *InteractionServiceLikeListResult {
-	return &InteractionServiceLikeListResult{}
+func NewInteractionServiceDismissReportCaseResult() *InteractionServiceDismissReportCaseResult {
+	return &InteractionServiceDismissReportCaseResult{}
 }
 
-func (p *InteractionServiceLikeListResult) InitDefault() {
+func (p *InteractionServiceDismissReportCaseResult) InitDefault() {
 }
 
-var InteractionServiceLikeListResult_Success_DEFAULT *LikeListResponse
+var InteractionServiceDismissReportCaseResult_Success_DEFAULT *DismissReportCaseResponse
 
-func (p *InteractionServiceLikeListResult) GetSuccess() (v *LikeListResponse) {
+func (p *InteractionServiceDismissReportCaseResult) GetSuccess() (v *DismissReportCaseResponse) {
 	if !p.IsSetSuccess() {
-		return InteractionServiceLikeListResult_Success_DEFAULT
+		return InteractionServiceDismissReportCaseResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *InteractionServiceLikeListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*LikeListResponse)
+func (p *InteractionServiceDismissReportCaseResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DismissReportCaseResponse)
 }
 
-func (p *InteractionServiceLikeListResult) IsSetSuccess() bool {
+func (p *InteractionServiceDismissReportCaseResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionServiceLikeListResult) String() string {
+func (p *InteractionServiceDismissReportCaseResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceLikeListResult(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceDismissReportCaseResult(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceLikeListResult = map[int16]string{
+var fieldIDToName_InteractionServiceDismissReportCaseResult = map[int16]string{
 	0: "success",
 }
 
-type InteractionServiceCreateCommentArgs struct {
-	Req *CreateCommentRequest `thrift:"req,1" frugal:"1,default,CreateCommentRequest" json:"req"`
+type InteractionServiceGetHiddenTargetsArgs struct {
+	Req *GetHiddenTargetsRequest `thrift:"req,1" frugal:"1,default,GetHiddenTargetsRequest" json:"req"`
 }
 
-func NewInteractionServiceCreateCommentArgs() *InteractionServiceCreateCommentArgs {
-	return &InteractionServiceCreateCommentArgs{}
+func NewInteractionServiceGetHiddenTargetsArgs() *InteractionServiceGetHiddenTargetsArgs {
+	return &InteractionServiceGetHiddenTargetsArgs{}
 }
 
-func (p *InteractionServiceCreateCommentArgs) InitDefault() {
+func (p *InteractionServiceGetHiddenTargetsArgs) InitDefault() {
 }
 
-var InteractionServiceCreateCommentArgs_Req_DEFAULT *CreateCommentRequest
+var InteractionServiceGetHiddenTargetsArgs_Req_DEFAULT *GetHiddenTargetsRequest
 
-func (p *InteractionServiceCreateCommentArgs) GetReq() (v *CreateCommentRequest) {
+func (p *InteractionServiceGetHiddenTargetsArgs) GetReq() (v *GetHiddenTargetsRequest) {
 	if !p.IsSetReq() {
-		return InteractionServiceCreateCommentArgs_Req_DEFAULT
+		return InteractionServiceGetHiddenTargetsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *InteractionServiceCreateCommentArgs) SetReq(val *CreateCommentRequest) {
+func (p *InteractionServiceGetHiddenTargetsArgs) SetReq(val *GetHiddenTargetsRequest) {
 	p.Req = val
 }
 
-func (p *InteractionServiceCreateCommentArgs) IsSetReq() bool {
+func (p *InteractionServiceGetHiddenTargetsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionServiceCreateCommentArgs) String() string {
+func (p *InteractionServiceGetHiddenTargetsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceCreateCommentArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceGetHiddenTargetsArgs(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceCreateCommentArgs = map[int16]string{
+var fieldIDToName_InteractionServiceGetHiddenTargetsArgs = map[int16]string{
 	1: "req",
 }
 
-type InteractionServiceCreateCommentResult struct {
-	Success *CreateCommentResponse `thrift:"success,0,optional" frugal:"0,optional,CreateCommentResponse" json:"success,omitempty"`
+type InteractionServiceGetHiddenTargetsResult struct {
+	Success *GetHiddenTargetsResponse `thrift:"success,0,optional" frugal:"0,optional,GetHiddenTargetsResponse" json:"success,omitempty"`
 }
 
-func NewInteractionServiceCreateCommentResult() *InteractionServiceCreateCommentResult {
-	return &InteractionServiceCreateCommentResult{}
+func NewInteractionServiceGetHiddenTargetsResult() *InteractionServiceGetHiddenTargetsResult {
+	return &InteractionServiceGetHiddenTargetsResult{}
 }
 
-func (p *InteractionServiceCreateCommentResult) InitDefault() {
+func (p *InteractionServiceGetHiddenTargetsResult) InitDefault() {
 }
 
-var InteractionServiceCreateCommentResult_Success_DEFAULT *CreateCommentResponse
+var InteractionServiceGetHiddenTargetsResult_Success_DEFAULT *GetHiddenTargetsResponse
 
-func (p *InteractionServiceCreateCommentResult) GetSuccess() (v *CreateCommentResponse) {
+func (p *InteractionServiceGetHiddenTargetsResult) GetSuccess() (v *GetHiddenTargetsResponse) {
 	if !p.IsSetSuccess() {
-		return InteractionServiceCreateCommentResult_Success_DEFAULT
+		return InteractionServiceGetHiddenTargetsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *InteractionServiceCreateCommentResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CreateCommentResponse)
+func (p *InteractionServiceGetHiddenTargetsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetHiddenTargetsResponse)
 }
 
-func (p *InteractionServiceCreateCommentResult) IsSetSuccess() bool {
+func (p *InteractionServiceGetHiddenTargetsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionServiceCreateCommentResult) String() string {
+func (p *InteractionServiceGetHiddenTargetsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceCreateCommentResult(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceGetHiddenTargetsResult(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceCreateCommentResult = map[int16]string{
+var fieldIDToName_InteractionServiceGetHiddenTargetsResult = map[int16]string{
 	0: "success",
 }
 
-type InteractionServiceListCommentArgs struct {
-	Req *ListCommentRequest `thrift:"req,1" frugal:"1,default,ListCommentRequest" json:"req"`
+type InteractionServiceGetCoInteractionsArgs struct {
+	Req *GetCoInteractionsRequest `thrift:"req,1" frugal:"1,default,GetCoInteractionsRequest" json:"req"`
 }
 
-func NewInteractionServiceListCommentArgs() *InteractionServiceListCommentArgs {
-	return &InteractionServiceListCommentArgs{}
+func NewInteractionServiceGetCoInteractionsArgs() *InteractionServiceGetCoInteractionsArgs {
+	return &InteractionServiceGetCoInteractionsArgs{}
 }
 
-func (p *InteractionServiceListCommentArgs) InitDefault() {
+func (p *InteractionServiceGetCoInteractionsArgs) InitDefault() {
 }
 
-var InteractionServiceListCommentArgs_Req_DEFAULT *ListCommentRequest
+var InteractionServiceGetCoInteractionsArgs_Req_DEFAULT *GetCoInteractionsRequest
 
-func (p *InteractionServiceListCommentArgs) GetReq() (v *ListCommentRequest) {
+func (p *InteractionServiceGetCoInteractionsArgs) GetReq() (v *GetCoInteractionsRequest) {
 	if !p.IsSetReq() {
-		return InteractionServiceListCommentArgs_Req_DEFAULT
+		return InteractionServiceGetCoInteractionsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *InteractionServiceListCommentArgs) SetReq(val *ListCommentRequest) {
+func (p *InteractionServiceGetCoInteractionsArgs) SetReq(val *GetCoInteractionsRequest) {
 	p.Req = val
 }
 
-func (p *InteractionServiceListCommentArgs) IsSetReq() bool {
+func (p *InteractionServiceGetCoInteractionsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionServiceListCommentArgs) String() string {
+func (p *InteractionServiceGetCoInteractionsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceListCommentArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceGetCoInteractionsArgs(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceListCommentArgs = map[int16]string{
+var fieldIDToName_InteractionServiceGetCoInteractionsArgs = map[int16]string{
 	1: "req",
 }
 
-type InteractionServiceListCommentResult struct {
-	Success *ListCommentResponse `thrift:"success,0,optional" frugal:"0,optional,ListCommentResponse" json:"success,omitempty"`
+type InteractionServiceGetCoInteractionsResult struct {
+	Success *GetCoInteractionsResponse `thrift:"success,0,optional" frugal:"0,optional,GetCoInteractionsResponse" json:"success,omitempty"`
 }
-
-func NewInteractionServiceListCommentResult() *InteractionServiceListCommentResult {
-	return &InteractionServiceListCommentResult{}
+
+func NewInteractionServiceGetCoInteractionsResult() *InteractionServiceGetCoInteractionsResult {
+	return &InteractionServiceGetCoInteractionsResult{}
 }
 
-func (p *InteractionServiceListCommentResult) InitDefault() {
+func (p *InteractionServiceGetCoInteractionsResult) InitDefault() {
 }
 
-var InteractionServiceListCommentResult_Success_DEFAULT *ListCommentResponse
+var InteractionServiceGetCoInteractionsResult_Success_DEFAULT *GetCoInteractionsResponse
 
-func (p *InteractionServiceListCommentResult) GetSuccess() (v *ListCommentResponse) {
+func (p *InteractionServiceGetCoInteractionsResult) GetSuccess() (v *GetCoInteractionsResponse) {
 	if !p.IsSetSuccess() {
-		return InteractionServiceListCommentResult_Success_DEFAULT
+		return InteractionServiceGetCoInteractionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *InteractionServiceListCommentResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListCommentResponse)
+func (p *InteractionServiceGetCoInteractionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetCoInteractionsResponse)
 }
 
-func (p *InteractionServiceListCommentResult) IsSetSuccess() bool {
+func (p *InteractionServiceGetCoInteractionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionServiceListCommentResult) String() string {
+func (p *InteractionServiceGetCoInteractionsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceListCommentResult(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceGetCoInteractionsResult(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceListCommentResult = map[int16]string{
+var fieldIDToName_InteractionServiceGetCoInteractionsResult = map[int16]string{
 	0: "success",
 }
 
@@ -1851,3 +5409,383 @@ func (p *InteractionServiceMarkNotificationReadResult) String() string {
 var fieldIDToName_InteractionServiceMarkNotificationReadResult = map[int16]string{
 	0: "success",
 }
+
+type InteractionServiceListDeadLetterQueuesArgs struct {
+	Req *ListDeadLetterQueuesRequest `thrift:"req,1" frugal:"1,default,ListDeadLetterQueuesRequest" json:"req"`
+}
+
+func NewInteractionServiceListDeadLetterQueuesArgs() *InteractionServiceListDeadLetterQueuesArgs {
+	return &InteractionServiceListDeadLetterQueuesArgs{}
+}
+
+func (p *InteractionServiceListDeadLetterQueuesArgs) InitDefault() {
+}
+
+var InteractionServiceListDeadLetterQueuesArgs_Req_DEFAULT *ListDeadLetterQueuesRequest
+
+func (p *InteractionServiceListDeadLetterQueuesArgs) GetReq() (v *ListDeadLetterQueuesRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceListDeadLetterQueuesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceListDeadLetterQueuesArgs) SetReq(val *ListDeadLetterQueuesRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceListDeadLetterQueuesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceListDeadLetterQueuesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceListDeadLetterQueuesArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceListDeadLetterQueuesArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceListDeadLetterQueuesResult struct {
+	Success *ListDeadLetterQueuesResponse `thrift:"success,0,optional" frugal:"0,optional,ListDeadLetterQueuesResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceListDeadLetterQueuesResult() *InteractionServiceListDeadLetterQueuesResult {
+	return &InteractionServiceListDeadLetterQueuesResult{}
+}
+
+func (p *InteractionServiceListDeadLetterQueuesResult) InitDefault() {
+}
+
+var InteractionServiceListDeadLetterQueuesResult_Success_DEFAULT *ListDeadLetterQueuesResponse
+
+func (p *InteractionServiceListDeadLetterQueuesResult) GetSuccess() (v *ListDeadLetterQueuesResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceListDeadLetterQueuesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceListDeadLetterQueuesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListDeadLetterQueuesResponse)
+}
+
+func (p *InteractionServiceListDeadLetterQueuesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceListDeadLetterQueuesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceListDeadLetterQueuesResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceListDeadLetterQueuesResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceListDeadLettersArgs struct {
+	Req *ListDeadLettersRequest `thrift:"req,1" frugal:"1,default,ListDeadLettersRequest" json:"req"`
+}
+
+func NewInteractionServiceListDeadLettersArgs() *InteractionServiceListDeadLettersArgs {
+	return &InteractionServiceListDeadLettersArgs{}
+}
+
+func (p *InteractionServiceListDeadLettersArgs) InitDefault() {
+}
+
+var InteractionServiceListDeadLettersArgs_Req_DEFAULT *ListDeadLettersRequest
+
+func (p *InteractionServiceListDeadLettersArgs) GetReq() (v *ListDeadLettersRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceListDeadLettersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceListDeadLettersArgs) SetReq(val *ListDeadLettersRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceListDeadLettersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceListDeadLettersArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceListDeadLettersArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceListDeadLettersArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceListDeadLettersResult struct {
+	Success *ListDeadLettersResponse `thrift:"success,0,optional" frugal:"0,optional,ListDeadLettersResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceListDeadLettersResult() *InteractionServiceListDeadLettersResult {
+	return &InteractionServiceListDeadLettersResult{}
+}
+
+func (p *InteractionServiceListDeadLettersResult) InitDefault() {
+}
+
+var InteractionServiceListDeadLettersResult_Success_DEFAULT *ListDeadLettersResponse
+
+func (p *InteractionServiceListDeadLettersResult) GetSuccess() (v *ListDeadLettersResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceListDeadLettersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceListDeadLettersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListDeadLettersResponse)
+}
+
+func (p *InteractionServiceListDeadLettersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceListDeadLettersResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceListDeadLettersResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceListDeadLettersResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceGetDeadLetterArgs struct {
+	Req *GetDeadLetterRequest `thrift:"req,1" frugal:"1,default,GetDeadLetterRequest" json:"req"`
+}
+
+func NewInteractionServiceGetDeadLetterArgs() *InteractionServiceGetDeadLetterArgs {
+	return &InteractionServiceGetDeadLetterArgs{}
+}
+
+func (p *InteractionServiceGetDeadLetterArgs) InitDefault() {
+}
+
+var InteractionServiceGetDeadLetterArgs_Req_DEFAULT *GetDeadLetterRequest
+
+func (p *InteractionServiceGetDeadLetterArgs) GetReq() (v *GetDeadLetterRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceGetDeadLetterArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceGetDeadLetterArgs) SetReq(val *GetDeadLetterRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceGetDeadLetterArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceGetDeadLetterArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetDeadLetterArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetDeadLetterArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceGetDeadLetterResult struct {
+	Success *GetDeadLetterResponse `thrift:"success,0,optional" frugal:"0,optional,GetDeadLetterResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceGetDeadLetterResult() *InteractionServiceGetDeadLetterResult {
+	return &InteractionServiceGetDeadLetterResult{}
+}
+
+func (p *InteractionServiceGetDeadLetterResult) InitDefault() {
+}
+
+var InteractionServiceGetDeadLetterResult_Success_DEFAULT *GetDeadLetterResponse
+
+func (p *InteractionServiceGetDeadLetterResult) GetSuccess() (v *GetDeadLetterResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceGetDeadLetterResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceGetDeadLetterResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetDeadLetterResponse)
+}
+
+func (p *InteractionServiceGetDeadLetterResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceGetDeadLetterResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetDeadLetterResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetDeadLetterResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceReplayDeadLettersArgs struct {
+	Req *ReplayDeadLettersRequest `thrift:"req,1" frugal:"1,default,ReplayDeadLettersRequest" json:"req"`
+}
+
+func NewInteractionServiceReplayDeadLettersArgs() *InteractionServiceReplayDeadLettersArgs {
+	return &InteractionServiceReplayDeadLettersArgs{}
+}
+
+func (p *InteractionServiceReplayDeadLettersArgs) InitDefault() {
+}
+
+var InteractionServiceReplayDeadLettersArgs_Req_DEFAULT *ReplayDeadLettersRequest
+
+func (p *InteractionServiceReplayDeadLettersArgs) GetReq() (v *ReplayDeadLettersRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceReplayDeadLettersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceReplayDeadLettersArgs) SetReq(val *ReplayDeadLettersRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceReplayDeadLettersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceReplayDeadLettersArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceReplayDeadLettersArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceReplayDeadLettersArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceReplayDeadLettersResult struct {
+	Success *ReplayDeadLettersResponse `thrift:"success,0,optional" frugal:"0,optional,ReplayDeadLettersResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceReplayDeadLettersResult() *InteractionServiceReplayDeadLettersResult {
+	return &InteractionServiceReplayDeadLettersResult{}
+}
+
+func (p *InteractionServiceReplayDeadLettersResult) InitDefault() {
+}
+
+var InteractionServiceReplayDeadLettersResult_Success_DEFAULT *ReplayDeadLettersResponse
+
+func (p *InteractionServiceReplayDeadLettersResult) GetSuccess() (v *ReplayDeadLettersResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceReplayDeadLettersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceReplayDeadLettersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReplayDeadLettersResponse)
+}
+
+func (p *InteractionServiceReplayDeadLettersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceReplayDeadLettersResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceReplayDeadLettersResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceReplayDeadLettersResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServicePurgeDeadLettersArgs struct {
+	Req *PurgeDeadLettersRequest `thrift:"req,1" frugal:"1,default,PurgeDeadLettersRequest" json:"req"`
+}
+
+func NewInteractionServicePurgeDeadLettersArgs() *InteractionServicePurgeDeadLettersArgs {
+	return &InteractionServicePurgeDeadLettersArgs{}
+}
+
+func (p *InteractionServicePurgeDeadLettersArgs) InitDefault() {
+}
+
+var InteractionServicePurgeDeadLettersArgs_Req_DEFAULT *PurgeDeadLettersRequest
+
+func (p *InteractionServicePurgeDeadLettersArgs) GetReq() (v *PurgeDeadLettersRequest) {
+	if !p.IsSetReq() {
+		return InteractionServicePurgeDeadLettersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServicePurgeDeadLettersArgs) SetReq(val *PurgeDeadLettersRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServicePurgeDeadLettersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServicePurgeDeadLettersArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServicePurgeDeadLettersArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServicePurgeDeadLettersArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServicePurgeDeadLettersResult struct {
+	Success *PurgeDeadLettersResponse `thrift:"success,0,optional" frugal:"0,optional,PurgeDeadLettersResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServicePurgeDeadLettersResult() *InteractionServicePurgeDeadLettersResult {
+	return &InteractionServicePurgeDeadLettersResult{}
+}
+
+func (p *InteractionServicePurgeDeadLettersResult) InitDefault() {
+}
+
+var InteractionServicePurgeDeadLettersResult_Success_DEFAULT *PurgeDeadLettersResponse
+
+func (p *InteractionServicePurgeDeadLettersResult) GetSuccess() (v *PurgeDeadLettersResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServicePurgeDeadLettersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServicePurgeDeadLettersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*PurgeDeadLettersResponse)
+}
+
+func (p *InteractionServicePurgeDeadLettersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServicePurgeDeadLettersResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServicePurgeDeadLettersResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServicePurgeDeadLettersResult = map[int16]string{
+	0: "success",
+}
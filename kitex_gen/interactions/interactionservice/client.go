@@ -15,11 +15,30 @@ type Client interface {
 	LikeList(ctx context.Context, req *interactions.LikeListRequest, callOptions ...callopt.Option) (r *interactions.LikeListResponse, err error)
 	CreateComment(ctx context.Context, req *interactions.CreateCommentRequest, callOptions ...callopt.Option) (r *interactions.CreateCommentResponse, err error)
 	ListComment(ctx context.Context, req *interactions.ListCommentRequest, callOptions ...callopt.Option) (r *interactions.ListCommentResponse, err error)
+	ListCommentThreads(ctx context.Context, req *interactions.ListCommentThreadsRequest, callOptions ...callopt.Option) (r *interactions.ListCommentThreadsResponse, err error)
+	PinComment(ctx context.Context, req *interactions.PinCommentRequest, callOptions ...callopt.Option) (r *interactions.PinCommentResponse, err error)
+	EditComment(ctx context.Context, req *interactions.EditCommentRequest, callOptions ...callopt.Option) (r *interactions.EditCommentResponse, err error)
+	ListCommentEdits(ctx context.Context, req *interactions.ListCommentEditsRequest, callOptions ...callopt.Option) (r *interactions.ListCommentEditsResponse, err error)
+	ListCommentReviews(ctx context.Context, req *interactions.ListCommentReviewsRequest, callOptions ...callopt.Option) (r *interactions.ListCommentReviewsResponse, err error)
+	ApproveCommentReview(ctx context.Context, req *interactions.ApproveCommentReviewRequest, callOptions ...callopt.Option) (r *interactions.ApproveCommentReviewResponse, err error)
+	RejectCommentReview(ctx context.Context, req *interactions.RejectCommentReviewRequest, callOptions ...callopt.Option) (r *interactions.RejectCommentReviewResponse, err error)
+	Report(ctx context.Context, req *interactions.ReportRequest, callOptions ...callopt.Option) (r *interactions.ReportResponse, err error)
+	ListReportCases(ctx context.Context, req *interactions.ListReportCasesRequest, callOptions ...callopt.Option) (r *interactions.ListReportCasesResponse, err error)
+	AssignReportCase(ctx context.Context, req *interactions.AssignReportCaseRequest, callOptions ...callopt.Option) (r *interactions.AssignReportCaseResponse, err error)
+	ResolveReportCase(ctx context.Context, req *interactions.ResolveReportCaseRequest, callOptions ...callopt.Option) (r *interactions.ResolveReportCaseResponse, err error)
+	DismissReportCase(ctx context.Context, req *interactions.DismissReportCaseRequest, callOptions ...callopt.Option) (r *interactions.DismissReportCaseResponse, err error)
+	GetHiddenTargets(ctx context.Context, req *interactions.GetHiddenTargetsRequest, callOptions ...callopt.Option) (r *interactions.GetHiddenTargetsResponse, err error)
+	GetCoInteractions(ctx context.Context, req *interactions.GetCoInteractionsRequest, callOptions ...callopt.Option) (r *interactions.GetCoInteractionsResponse, err error)
 	DeleteComment(ctx context.Context, req *interactions.CommentDeleteRequest, callOptions ...callopt.Option) (r *interactions.CommentDeleteResponse, err error)
 	VideoPopularList(ctx context.Context, req *interactions.VideoPopularListRequest, callOptions ...callopt.Option) (r *interactions.VideoPopularListResponse, err error)
 	DeleteVideoInfo(ctx context.Context, req *interactions.DeleteVideoInfoRequest, callOptions ...callopt.Option) (r *interactions.DeleteVideoInfoResponse, err error)
 	GetNotifications(ctx context.Context, req *interactions.GetNotificationsRequest, callOptions ...callopt.Option) (r *interactions.GetNotificationsResponse, err error)
 	MarkNotificationRead(ctx context.Context, req *interactions.MarkNotificationReadRequest, callOptions ...callopt.Option) (r *interactions.MarkNotificationReadResponse, err error)
+	ListDeadLetterQueues(ctx context.Context, req *interactions.ListDeadLetterQueuesRequest, callOptions ...callopt.Option) (r *interactions.ListDeadLetterQueuesResponse, err error)
+	ListDeadLetters(ctx context.Context, req *interactions.ListDeadLettersRequest, callOptions ...callopt.Option) (r *interactions.ListDeadLettersResponse, err error)
+	GetDeadLetter(ctx context.Context, req *interactions.GetDeadLetterRequest, callOptions ...callopt.Option) (r *interactions.GetDeadLetterResponse, err error)
+	ReplayDeadLetters(ctx context.Context, req *interactions.ReplayDeadLettersRequest, callOptions ...callopt.Option) (r *interactions.ReplayDeadLettersResponse, err error)
+	PurgeDeadLetters(ctx context.Context, req *interactions.PurgeDeadLettersRequest, callOptions ...callopt.Option) (r *interactions.PurgeDeadLettersResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -71,6 +90,76 @@ func (p *kInteractionServiceClient) ListComment(ctx context.Context, req *intera
 	return p.kClient.ListComment(ctx, req)
 }
 
+func (p *kInteractionServiceClient) ListCommentThreads(ctx context.Context, req *interactions.ListCommentThreadsRequest, callOptions ...callopt.Option) (r *interactions.ListCommentThreadsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListCommentThreads(ctx, req)
+}
+
+func (p *kInteractionServiceClient) PinComment(ctx context.Context, req *interactions.PinCommentRequest, callOptions ...callopt.Option) (r *interactions.PinCommentResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.PinComment(ctx, req)
+}
+
+func (p *kInteractionServiceClient) EditComment(ctx context.Context, req *interactions.EditCommentRequest, callOptions ...callopt.Option) (r *interactions.EditCommentResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.EditComment(ctx, req)
+}
+
+func (p *kInteractionServiceClient) ListCommentEdits(ctx context.Context, req *interactions.ListCommentEditsRequest, callOptions ...callopt.Option) (r *interactions.ListCommentEditsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListCommentEdits(ctx, req)
+}
+
+func (p *kInteractionServiceClient) ListCommentReviews(ctx context.Context, req *interactions.ListCommentReviewsRequest, callOptions ...callopt.Option) (r *interactions.ListCommentReviewsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListCommentReviews(ctx, req)
+}
+
+func (p *kInteractionServiceClient) ApproveCommentReview(ctx context.Context, req *interactions.ApproveCommentReviewRequest, callOptions ...callopt.Option) (r *interactions.ApproveCommentReviewResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ApproveCommentReview(ctx, req)
+}
+
+func (p *kInteractionServiceClient) RejectCommentReview(ctx context.Context, req *interactions.RejectCommentReviewRequest, callOptions ...callopt.Option) (r *interactions.RejectCommentReviewResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RejectCommentReview(ctx, req)
+}
+
+func (p *kInteractionServiceClient) Report(ctx context.Context, req *interactions.ReportRequest, callOptions ...callopt.Option) (r *interactions.ReportResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Report(ctx, req)
+}
+
+func (p *kInteractionServiceClient) ListReportCases(ctx context.Context, req *interactions.ListReportCasesRequest, callOptions ...callopt.Option) (r *interactions.ListReportCasesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListReportCases(ctx, req)
+}
+
+func (p *kInteractionServiceClient) AssignReportCase(ctx context.Context, req *interactions.AssignReportCaseRequest, callOptions ...callopt.Option) (r *interactions.AssignReportCaseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AssignReportCase(ctx, req)
+}
+
+func (p *kInteractionServiceClient) ResolveReportCase(ctx context.Context, req *interactions.ResolveReportCaseRequest, callOptions ...callopt.Option) (r *interactions.ResolveReportCaseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ResolveReportCase(ctx, req)
+}
+
+func (p *kInteractionServiceClient) DismissReportCase(ctx context.Context, req *interactions.DismissReportCaseRequest, callOptions ...callopt.Option) (r *interactions.DismissReportCaseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DismissReportCase(ctx, req)
+}
+
+func (p *kInteractionServiceClient) GetHiddenTargets(ctx context.Context, req *interactions.GetHiddenTargetsRequest, callOptions ...callopt.Option) (r *interactions.GetHiddenTargetsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetHiddenTargets(ctx, req)
+}
+
+func (p *kInteractionServiceClient) GetCoInteractions(ctx context.Context, req *interactions.GetCoInteractionsRequest, callOptions ...callopt.Option) (r *interactions.GetCoInteractionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetCoInteractions(ctx, req)
+}
+
 func (p *kInteractionServiceClient) DeleteComment(ctx context.Context, req *interactions.CommentDeleteRequest, callOptions ...callopt.Option) (r *interactions.CommentDeleteResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.DeleteComment(ctx, req)
@@ -95,3 +184,28 @@ func (p *kInteractionServiceClient) MarkNotificationRead(ctx context.Context, re
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.MarkNotificationRead(ctx, req)
 }
+
+func (p *kInteractionServiceClient) ListDeadLetterQueues(ctx context.Context, req *interactions.ListDeadLetterQueuesRequest, callOptions ...callopt.Option) (r *interactions.ListDeadLetterQueuesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListDeadLetterQueues(ctx, req)
+}
+
+func (p *kInteractionServiceClient) ListDeadLetters(ctx context.Context, req *interactions.ListDeadLettersRequest, callOptions ...callopt.Option) (r *interactions.ListDeadLettersResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListDeadLetters(ctx, req)
+}
+
+func (p *kInteractionServiceClient) GetDeadLetter(ctx context.Context, req *interactions.GetDeadLetterRequest, callOptions ...callopt.Option) (r *interactions.GetDeadLetterResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetDeadLetter(ctx, req)
+}
+
+func (p *kInteractionServiceClient) ReplayDeadLetters(ctx context.Context, req *interactions.ReplayDeadLettersRequest, callOptions ...callopt.Option) (r *interactions.ReplayDeadLettersResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReplayDeadLetters(ctx, req)
+}
+
+func (p *kInteractionServiceClient) PurgeDeadLetters(ctx context.Context, req *interactions.PurgeDeadLettersRequest, callOptions ...callopt.Option) (r *interactions.PurgeDeadLettersResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.PurgeDeadLetters(ctx, req)
+}
@@ -41,6 +41,104 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"ListCommentThreads": kitex.NewMethodInfo(
+		listCommentThreadsHandler,
+		newInteractionServiceListCommentThreadsArgs,
+		newInteractionServiceListCommentThreadsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"PinComment": kitex.NewMethodInfo(
+		pinCommentHandler,
+		newInteractionServicePinCommentArgs,
+		newInteractionServicePinCommentResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"EditComment": kitex.NewMethodInfo(
+		editCommentHandler,
+		newInteractionServiceEditCommentArgs,
+		newInteractionServiceEditCommentResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListCommentEdits": kitex.NewMethodInfo(
+		listCommentEditsHandler,
+		newInteractionServiceListCommentEditsArgs,
+		newInteractionServiceListCommentEditsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListCommentReviews": kitex.NewMethodInfo(
+		listCommentReviewsHandler,
+		newInteractionServiceListCommentReviewsArgs,
+		newInteractionServiceListCommentReviewsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ApproveCommentReview": kitex.NewMethodInfo(
+		approveCommentReviewHandler,
+		newInteractionServiceApproveCommentReviewArgs,
+		newInteractionServiceApproveCommentReviewResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RejectCommentReview": kitex.NewMethodInfo(
+		rejectCommentReviewHandler,
+		newInteractionServiceRejectCommentReviewArgs,
+		newInteractionServiceRejectCommentReviewResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"Report": kitex.NewMethodInfo(
+		reportHandler,
+		newInteractionServiceReportArgs,
+		newInteractionServiceReportResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListReportCases": kitex.NewMethodInfo(
+		listReportCasesHandler,
+		newInteractionServiceListReportCasesArgs,
+		newInteractionServiceListReportCasesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"AssignReportCase": kitex.NewMethodInfo(
+		assignReportCaseHandler,
+		newInteractionServiceAssignReportCaseArgs,
+		newInteractionServiceAssignReportCaseResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ResolveReportCase": kitex.NewMethodInfo(
+		resolveReportCaseHandler,
+		newInteractionServiceResolveReportCaseArgs,
+		newInteractionServiceResolveReportCaseResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DismissReportCase": kitex.NewMethodInfo(
+		dismissReportCaseHandler,
+		newInteractionServiceDismissReportCaseArgs,
+		newInteractionServiceDismissReportCaseResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetHiddenTargets": kitex.NewMethodInfo(
+		getHiddenTargetsHandler,
+		newInteractionServiceGetHiddenTargetsArgs,
+		newInteractionServiceGetHiddenTargetsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetCoInteractions": kitex.NewMethodInfo(
+		getCoInteractionsHandler,
+		newInteractionServiceGetCoInteractionsArgs,
+		newInteractionServiceGetCoInteractionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"DeleteComment": kitex.NewMethodInfo(
 		deleteCommentHandler,
 		newInteractionServiceDeleteCommentArgs,
@@ -76,6 +174,41 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"ListDeadLetterQueues": kitex.NewMethodInfo(
+		listDeadLetterQueuesHandler,
+		newInteractionServiceListDeadLetterQueuesArgs,
+		newInteractionServiceListDeadLetterQueuesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListDeadLetters": kitex.NewMethodInfo(
+		listDeadLettersHandler,
+		newInteractionServiceListDeadLettersArgs,
+		newInteractionServiceListDeadLettersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetDeadLetter": kitex.NewMethodInfo(
+		getDeadLetterHandler,
+		newInteractionServiceGetDeadLetterArgs,
+		newInteractionServiceGetDeadLetterResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ReplayDeadLetters": kitex.NewMethodInfo(
+		replayDeadLettersHandler,
+		newInteractionServiceReplayDeadLettersArgs,
+		newInteractionServiceReplayDeadLettersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"PurgeDeadLetters": kitex.NewMethodInfo(
+		purgeDeadLettersHandler,
+		newInteractionServicePurgeDeadLettersArgs,
+		newInteractionServicePurgeDeadLettersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -214,6 +347,258 @@ func newInteractionServiceListCommentResult() interface{} {
 	return interactions.NewInteractionServiceListCommentResult()
 }
 
+func listCommentThreadsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interactions.InteractionServiceListCommentThreadsArgs)
+	realResult := result.(*interactions.InteractionServiceListCommentThreadsResult)
+	success, err := handler.(interactions.InteractionService).ListCommentThreads(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceListCommentThreadsArgs() interface{} {
+	return interactions.NewInteractionServiceListCommentThreadsArgs()
+}
+
+func newInteractionServiceListCommentThreadsResult() interface{} {
+	return interactions.NewInteractionServiceListCommentThreadsResult()
+}
+
+func pinCommentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interactions.InteractionServicePinCommentArgs)
+	realResult := result.(*interactions.InteractionServicePinCommentResult)
+	success, err := handler.(interactions.InteractionService).PinComment(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServicePinCommentArgs() interface{} {
+	return interactions.NewInteractionServicePinCommentArgs()
+}
+
+func newInteractionServicePinCommentResult() interface{} {
+	return interactions.NewInteractionServicePinCommentResult()
+}
+
+func editCommentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interactions.InteractionServiceEditCommentArgs)
+	realResult := result.(*interactions.InteractionServiceEditCommentResult)
+	success, err := handler.(interactions.InteractionService).EditComment(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceEditCommentArgs() interface{} {
+	return interactions.NewInteractionServiceEditCommentArgs()
+}
+
+func newInteractionServiceEditCommentResult() interface{} {
+	return interactions.NewInteractionServiceEditCommentResult()
+}
+
+func listCommentEditsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interactions.InteractionServiceListCommentEditsArgs)
+	realResult := result.(*interactions.InteractionServiceListCommentEditsResult)
+	success, err := handler.(interactions.InteractionService).ListCommentEdits(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceListCommentEditsArgs() interface{} {
+	return interactions.NewInteractionServiceListCommentEditsArgs()
+}
+
+func newInteractionServiceListCommentEditsResult() interface{} {
+	return interactions.NewInteractionServiceListCommentEditsResult()
+}
+
+func listCommentReviewsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interactions.InteractionServiceListCommentReviewsArgs)
+	realResult := result.(*interactions.InteractionServiceListCommentReviewsResult)
+	success, err := handler.(interactions.InteractionService).ListCommentReviews(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceListCommentReviewsArgs() interface{} {
+	return interactions.NewInteractionServiceListCommentReviewsArgs()
+}
+
+func newInteractionServiceListCommentReviewsResult() interface{} {
+	return interactions.NewInteractionServiceListCommentReviewsResult()
+}
+
+func approveCommentReviewHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interactions.InteractionServiceApproveCommentReviewArgs)
+	realResult := result.(*interactions.InteractionServiceApproveCommentReviewResult)
+	success, err := handler.(interactions.InteractionService).ApproveCommentReview(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceApproveCommentReviewArgs() interface{} {
+	return interactions.NewInteractionServiceApproveCommentReviewArgs()
+}
+
+func newInteractionServiceApproveCommentReviewResult() interface{} {
+	return interactions.NewInteractionServiceApproveCommentReviewResult()
+}
+
+func rejectCommentReviewHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interactions.InteractionServiceRejectCommentReviewArgs)
+	realResult := result.(*interactions.InteractionServiceRejectCommentReviewResult)
+	success, err := handler.(interactions.InteractionService).RejectCommentReview(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceRejectCommentReviewArgs() interface{} {
+	return interactions.NewInteractionServiceRejectCommentReviewArgs()
+}
+
+func newInteractionServiceRejectCommentReviewResult() interface{} {
+	return interactions.NewInteractionServiceRejectCommentReviewResult()
+}
+
+func reportHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interactions.InteractionServiceReportArgs)
+	realResult := result.(*interactions.InteractionServiceReportResult)
+	success, err := handler.(interactions.InteractionService).Report(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceReportArgs() interface{} {
+	return interactions.NewInteractionServiceReportArgs()
+}
+
+func newInteractionServiceReportResult() interface{} {
+	return interactions.NewInteractionServiceReportResult()
+}
+
+func listReportCasesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interactions.InteractionServiceListReportCasesArgs)
+	realResult := result.(*interactions.InteractionServiceListReportCasesResult)
+	success, err := handler.(interactions.InteractionService).ListReportCases(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceListReportCasesArgs() interface{} {
+	return interactions.NewInteractionServiceListReportCasesArgs()
+}
+
+func newInteractionServiceListReportCasesResult() interface{} {
+	return interactions.NewInteractionServiceListReportCasesResult()
+}
+
+func assignReportCaseHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interactions.InteractionServiceAssignReportCaseArgs)
+	realResult := result.(*interactions.InteractionServiceAssignReportCaseResult)
+	success, err := handler.(interactions.InteractionService).AssignReportCase(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceAssignReportCaseArgs() interface{} {
+	return interactions.NewInteractionServiceAssignReportCaseArgs()
+}
+
+func newInteractionServiceAssignReportCaseResult() interface{} {
+	return interactions.NewInteractionServiceAssignReportCaseResult()
+}
+
+func resolveReportCaseHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interactions.InteractionServiceResolveReportCaseArgs)
+	realResult := result.(*interactions.InteractionServiceResolveReportCaseResult)
+	success, err := handler.(interactions.InteractionService).ResolveReportCase(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceResolveReportCaseArgs() interface{} {
+	return interactions.NewInteractionServiceResolveReportCaseArgs()
+}
+
+func newInteractionServiceResolveReportCaseResult() interface{} {
+	return interactions.NewInteractionServiceResolveReportCaseResult()
+}
+
+func dismissReportCaseHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interactions.InteractionServiceDismissReportCaseArgs)
+	realResult := result.(*interactions.InteractionServiceDismissReportCaseResult)
+	success, err := handler.(interactions.InteractionService).DismissReportCase(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceDismissReportCaseArgs() interface{} {
+	return interactions.NewInteractionServiceDismissReportCaseArgs()
+}
+
+func newInteractionServiceDismissReportCaseResult() interface{} {
+	return interactions.NewInteractionServiceDismissReportCaseResult()
+}
+
+func getHiddenTargetsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interactions.InteractionServiceGetHiddenTargetsArgs)
+	realResult := result.(*interactions.InteractionServiceGetHiddenTargetsResult)
+	success, err := handler.(interactions.InteractionService).GetHiddenTargets(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceGetHiddenTargetsArgs() interface{} {
+	return interactions.NewInteractionServiceGetHiddenTargetsArgs()
+}
+
+func newInteractionServiceGetHiddenTargetsResult() interface{} {
+	return interactions.NewInteractionServiceGetHiddenTargetsResult()
+}
+
+func getCoInteractionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interactions.InteractionServiceGetCoInteractionsArgs)
+	realResult := result.(*interactions.InteractionServiceGetCoInteractionsResult)
+	success, err := handler.(interactions.InteractionService).GetCoInteractions(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceGetCoInteractionsArgs() interface{} {
+	return interactions.NewInteractionServiceGetCoInteractionsArgs()
+}
+
+func newInteractionServiceGetCoInteractionsResult() interface{} {
+	return interactions.NewInteractionServiceGetCoInteractionsResult()
+}
+
 func deleteCommentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*interactions.InteractionServiceDeleteCommentArgs)
 	realResult := result.(*interactions.InteractionServiceDeleteCommentResult)
@@ -304,6 +689,96 @@ func newInteractionServiceMarkNotificationReadResult() interface{} {
 	return interactions.NewInteractionServiceMarkNotificationReadResult()
 }
 
+func listDeadLetterQueuesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interactions.InteractionServiceListDeadLetterQueuesArgs)
+	realResult := result.(*interactions.InteractionServiceListDeadLetterQueuesResult)
+	success, err := handler.(interactions.InteractionService).ListDeadLetterQueues(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceListDeadLetterQueuesArgs() interface{} {
+	return interactions.NewInteractionServiceListDeadLetterQueuesArgs()
+}
+
+func newInteractionServiceListDeadLetterQueuesResult() interface{} {
+	return interactions.NewInteractionServiceListDeadLetterQueuesResult()
+}
+
+func listDeadLettersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interactions.InteractionServiceListDeadLettersArgs)
+	realResult := result.(*interactions.InteractionServiceListDeadLettersResult)
+	success, err := handler.(interactions.InteractionService).ListDeadLetters(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceListDeadLettersArgs() interface{} {
+	return interactions.NewInteractionServiceListDeadLettersArgs()
+}
+
+func newInteractionServiceListDeadLettersResult() interface{} {
+	return interactions.NewInteractionServiceListDeadLettersResult()
+}
+
+func getDeadLetterHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interactions.InteractionServiceGetDeadLetterArgs)
+	realResult := result.(*interactions.InteractionServiceGetDeadLetterResult)
+	success, err := handler.(interactions.InteractionService).GetDeadLetter(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceGetDeadLetterArgs() interface{} {
+	return interactions.NewInteractionServiceGetDeadLetterArgs()
+}
+
+func newInteractionServiceGetDeadLetterResult() interface{} {
+	return interactions.NewInteractionServiceGetDeadLetterResult()
+}
+
+func replayDeadLettersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interactions.InteractionServiceReplayDeadLettersArgs)
+	realResult := result.(*interactions.InteractionServiceReplayDeadLettersResult)
+	success, err := handler.(interactions.InteractionService).ReplayDeadLetters(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceReplayDeadLettersArgs() interface{} {
+	return interactions.NewInteractionServiceReplayDeadLettersArgs()
+}
+
+func newInteractionServiceReplayDeadLettersResult() interface{} {
+	return interactions.NewInteractionServiceReplayDeadLettersResult()
+}
+
+func purgeDeadLettersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interactions.InteractionServicePurgeDeadLettersArgs)
+	realResult := result.(*interactions.InteractionServicePurgeDeadLettersResult)
+	success, err := handler.(interactions.InteractionService).PurgeDeadLetters(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServicePurgeDeadLettersArgs() interface{} {
+	return interactions.NewInteractionServicePurgeDeadLettersArgs()
+}
+
+func newInteractionServicePurgeDeadLettersResult() interface{} {
+	return interactions.NewInteractionServicePurgeDeadLettersResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -354,6 +829,146 @@ func (p *kClient) ListComment(ctx context.Context, req *interactions.ListComment
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) ListCommentThreads(ctx context.Context, req *interactions.ListCommentThreadsRequest) (r *interactions.ListCommentThreadsResponse, err error) {
+	var _args interactions.InteractionServiceListCommentThreadsArgs
+	_args.Req = req
+	var _result interactions.InteractionServiceListCommentThreadsResult
+	if err = p.c.Call(ctx, "ListCommentThreads", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) PinComment(ctx context.Context, req *interactions.PinCommentRequest) (r *interactions.PinCommentResponse, err error) {
+	var _args interactions.InteractionServicePinCommentArgs
+	_args.Req = req
+	var _result interactions.InteractionServicePinCommentResult
+	if err = p.c.Call(ctx, "PinComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) EditComment(ctx context.Context, req *interactions.EditCommentRequest) (r *interactions.EditCommentResponse, err error) {
+	var _args interactions.InteractionServiceEditCommentArgs
+	_args.Req = req
+	var _result interactions.InteractionServiceEditCommentResult
+	if err = p.c.Call(ctx, "EditComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListCommentEdits(ctx context.Context, req *interactions.ListCommentEditsRequest) (r *interactions.ListCommentEditsResponse, err error) {
+	var _args interactions.InteractionServiceListCommentEditsArgs
+	_args.Req = req
+	var _result interactions.InteractionServiceListCommentEditsResult
+	if err = p.c.Call(ctx, "ListCommentEdits", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListCommentReviews(ctx context.Context, req *interactions.ListCommentReviewsRequest) (r *interactions.ListCommentReviewsResponse, err error) {
+	var _args interactions.InteractionServiceListCommentReviewsArgs
+	_args.Req = req
+	var _result interactions.InteractionServiceListCommentReviewsResult
+	if err = p.c.Call(ctx, "ListCommentReviews", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ApproveCommentReview(ctx context.Context, req *interactions.ApproveCommentReviewRequest) (r *interactions.ApproveCommentReviewResponse, err error) {
+	var _args interactions.InteractionServiceApproveCommentReviewArgs
+	_args.Req = req
+	var _result interactions.InteractionServiceApproveCommentReviewResult
+	if err = p.c.Call(ctx, "ApproveCommentReview", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RejectCommentReview(ctx context.Context, req *interactions.RejectCommentReviewRequest) (r *interactions.RejectCommentReviewResponse, err error) {
+	var _args interactions.InteractionServiceRejectCommentReviewArgs
+	_args.Req = req
+	var _result interactions.InteractionServiceRejectCommentReviewResult
+	if err = p.c.Call(ctx, "RejectCommentReview", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Report(ctx context.Context, req *interactions.ReportRequest) (r *interactions.ReportResponse, err error) {
+	var _args interactions.InteractionServiceReportArgs
+	_args.Req = req
+	var _result interactions.InteractionServiceReportResult
+	if err = p.c.Call(ctx, "Report", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListReportCases(ctx context.Context, req *interactions.ListReportCasesRequest) (r *interactions.ListReportCasesResponse, err error) {
+	var _args interactions.InteractionServiceListReportCasesArgs
+	_args.Req = req
+	var _result interactions.InteractionServiceListReportCasesResult
+	if err = p.c.Call(ctx, "ListReportCases", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AssignReportCase(ctx context.Context, req *interactions.AssignReportCaseRequest) (r *interactions.AssignReportCaseResponse, err error) {
+	var _args interactions.InteractionServiceAssignReportCaseArgs
+	_args.Req = req
+	var _result interactions.InteractionServiceAssignReportCaseResult
+	if err = p.c.Call(ctx, "AssignReportCase", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ResolveReportCase(ctx context.Context, req *interactions.ResolveReportCaseRequest) (r *interactions.ResolveReportCaseResponse, err error) {
+	var _args interactions.InteractionServiceResolveReportCaseArgs
+	_args.Req = req
+	var _result interactions.InteractionServiceResolveReportCaseResult
+	if err = p.c.Call(ctx, "ResolveReportCase", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DismissReportCase(ctx context.Context, req *interactions.DismissReportCaseRequest) (r *interactions.DismissReportCaseResponse, err error) {
+	var _args interactions.InteractionServiceDismissReportCaseArgs
+	_args.Req = req
+	var _result interactions.InteractionServiceDismissReportCaseResult
+	if err = p.c.Call(ctx, "DismissReportCase", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetHiddenTargets(ctx context.Context, req *interactions.GetHiddenTargetsRequest) (r *interactions.GetHiddenTargetsResponse, err error) {
+	var _args interactions.InteractionServiceGetHiddenTargetsArgs
+	_args.Req = req
+	var _result interactions.InteractionServiceGetHiddenTargetsResult
+	if err = p.c.Call(ctx, "GetHiddenTargets", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetCoInteractions(ctx context.Context, req *interactions.GetCoInteractionsRequest) (r *interactions.GetCoInteractionsResponse, err error) {
+	var _args interactions.InteractionServiceGetCoInteractionsArgs
+	_args.Req = req
+	var _result interactions.InteractionServiceGetCoInteractionsResult
+	if err = p.c.Call(ctx, "GetCoInteractions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) DeleteComment(ctx context.Context, req *interactions.CommentDeleteRequest) (r *interactions.CommentDeleteResponse, err error) {
 	var _args interactions.InteractionServiceDeleteCommentArgs
 	_args.Req = req
@@ -403,3 +1018,53 @@ func (p *kClient) MarkNotificationRead(ctx context.Context, req *interactions.Ma
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) ListDeadLetterQueues(ctx context.Context, req *interactions.ListDeadLetterQueuesRequest) (r *interactions.ListDeadLetterQueuesResponse, err error) {
+	var _args interactions.InteractionServiceListDeadLetterQueuesArgs
+	_args.Req = req
+	var _result interactions.InteractionServiceListDeadLetterQueuesResult
+	if err = p.c.Call(ctx, "ListDeadLetterQueues", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListDeadLetters(ctx context.Context, req *interactions.ListDeadLettersRequest) (r *interactions.ListDeadLettersResponse, err error) {
+	var _args interactions.InteractionServiceListDeadLettersArgs
+	_args.Req = req
+	var _result interactions.InteractionServiceListDeadLettersResult
+	if err = p.c.Call(ctx, "ListDeadLetters", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetDeadLetter(ctx context.Context, req *interactions.GetDeadLetterRequest) (r *interactions.GetDeadLetterResponse, err error) {
+	var _args interactions.InteractionServiceGetDeadLetterArgs
+	_args.Req = req
+	var _result interactions.InteractionServiceGetDeadLetterResult
+	if err = p.c.Call(ctx, "GetDeadLetter", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReplayDeadLetters(ctx context.Context, req *interactions.ReplayDeadLettersRequest) (r *interactions.ReplayDeadLettersResponse, err error) {
+	var _args interactions.InteractionServiceReplayDeadLettersArgs
+	_args.Req = req
+	var _result interactions.InteractionServiceReplayDeadLettersResult
+	if err = p.c.Call(ctx, "ReplayDeadLetters", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) PurgeDeadLetters(ctx context.Context, req *interactions.PurgeDeadLettersRequest) (r *interactions.PurgeDeadLettersResponse, err error) {
+	var _args interactions.InteractionServicePurgeDeadLettersArgs
+	_args.Req = req
+	var _result interactions.InteractionServicePurgeDeadLettersResult
+	if err = p.c.Call(ctx, "PurgeDeadLetters", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
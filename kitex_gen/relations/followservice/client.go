@@ -15,6 +15,18 @@ type Client interface {
 	FollowingList(ctx context.Context, req *relations.FollowingListRequest, callOptions ...callopt.Option) (r *relations.FollowingListResponse, err error)
 	FollowerList(ctx context.Context, req *relations.FollowerListRequest, callOptions ...callopt.Option) (r *relations.FollowerListResponse, err error)
 	FriendList(ctx context.Context, req *relations.FriendListRequest, callOptions ...callopt.Option) (r *relations.FriendListResponse, err error)
+	FollowRequestList(ctx context.Context, req *relations.FollowRequestListRequest, callOptions ...callopt.Option) (r *relations.FollowRequestListResponse, err error)
+	AcceptFollowRequest(ctx context.Context, req *relations.AcceptFollowRequestRequest, callOptions ...callopt.Option) (r *relations.AcceptFollowRequestResponse, err error)
+	RejectFollowRequest(ctx context.Context, req *relations.RejectFollowRequestRequest, callOptions ...callopt.Option) (r *relations.RejectFollowRequestResponse, err error)
+	BlockAction(ctx context.Context, req *relations.BlockActionRequest, callOptions ...callopt.Option) (r *relations.BlockActionResponse, err error)
+	BlockList(ctx context.Context, req *relations.BlockListRequest, callOptions ...callopt.Option) (r *relations.BlockListResponse, err error)
+	CheckBlock(ctx context.Context, req *relations.CheckBlockRequest, callOptions ...callopt.Option) (r *relations.CheckBlockResponse, err error)
+	UpdateFollowType(ctx context.Context, req *relations.UpdateFollowTypeRequest, callOptions ...callopt.Option) (r *relations.UpdateFollowTypeResponse, err error)
+	SetFollowRemark(ctx context.Context, req *relations.SetFollowRemarkRequest, callOptions ...callopt.Option) (r *relations.SetFollowRemarkResponse, err error)
+	GetFollowType(ctx context.Context, req *relations.GetFollowTypeRequest, callOptions ...callopt.Option) (r *relations.GetFollowTypeResponse, err error)
+	GetFollowingIDs(ctx context.Context, req *relations.GetFollowingIDsRequest, callOptions ...callopt.Option) (r *relations.GetFollowingIDsResponse, err error)
+	GetFollowerIDs(ctx context.Context, req *relations.GetFollowerIDsRequest, callOptions ...callopt.Option) (r *relations.GetFollowerIDsResponse, err error)
+	FollowSuggestion(ctx context.Context, req *relations.FollowSuggestionRequest, callOptions ...callopt.Option) (r *relations.FollowSuggestionResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -65,3 +77,63 @@ func (p *kFollowServiceClient) FriendList(ctx context.Context, req *relations.Fr
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.FriendList(ctx, req)
 }
+
+func (p *kFollowServiceClient) FollowRequestList(ctx context.Context, req *relations.FollowRequestListRequest, callOptions ...callopt.Option) (r *relations.FollowRequestListResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.FollowRequestList(ctx, req)
+}
+
+func (p *kFollowServiceClient) AcceptFollowRequest(ctx context.Context, req *relations.AcceptFollowRequestRequest, callOptions ...callopt.Option) (r *relations.AcceptFollowRequestResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AcceptFollowRequest(ctx, req)
+}
+
+func (p *kFollowServiceClient) RejectFollowRequest(ctx context.Context, req *relations.RejectFollowRequestRequest, callOptions ...callopt.Option) (r *relations.RejectFollowRequestResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RejectFollowRequest(ctx, req)
+}
+
+func (p *kFollowServiceClient) BlockAction(ctx context.Context, req *relations.BlockActionRequest, callOptions ...callopt.Option) (r *relations.BlockActionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BlockAction(ctx, req)
+}
+
+func (p *kFollowServiceClient) BlockList(ctx context.Context, req *relations.BlockListRequest, callOptions ...callopt.Option) (r *relations.BlockListResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BlockList(ctx, req)
+}
+
+func (p *kFollowServiceClient) CheckBlock(ctx context.Context, req *relations.CheckBlockRequest, callOptions ...callopt.Option) (r *relations.CheckBlockResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CheckBlock(ctx, req)
+}
+
+func (p *kFollowServiceClient) UpdateFollowType(ctx context.Context, req *relations.UpdateFollowTypeRequest, callOptions ...callopt.Option) (r *relations.UpdateFollowTypeResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateFollowType(ctx, req)
+}
+
+func (p *kFollowServiceClient) SetFollowRemark(ctx context.Context, req *relations.SetFollowRemarkRequest, callOptions ...callopt.Option) (r *relations.SetFollowRemarkResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetFollowRemark(ctx, req)
+}
+
+func (p *kFollowServiceClient) GetFollowType(ctx context.Context, req *relations.GetFollowTypeRequest, callOptions ...callopt.Option) (r *relations.GetFollowTypeResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetFollowType(ctx, req)
+}
+
+func (p *kFollowServiceClient) GetFollowingIDs(ctx context.Context, req *relations.GetFollowingIDsRequest, callOptions ...callopt.Option) (r *relations.GetFollowingIDsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetFollowingIDs(ctx, req)
+}
+
+func (p *kFollowServiceClient) GetFollowerIDs(ctx context.Context, req *relations.GetFollowerIDsRequest, callOptions ...callopt.Option) (r *relations.GetFollowerIDsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetFollowerIDs(ctx, req)
+}
+
+func (p *kFollowServiceClient) FollowSuggestion(ctx context.Context, req *relations.FollowSuggestionRequest, callOptions ...callopt.Option) (r *relations.FollowSuggestionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.FollowSuggestion(ctx, req)
+}
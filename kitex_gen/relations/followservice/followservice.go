@@ -41,6 +41,90 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"FollowRequestList": kitex.NewMethodInfo(
+		followRequestListHandler,
+		newFollowServiceFollowRequestListArgs,
+		newFollowServiceFollowRequestListResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"AcceptFollowRequest": kitex.NewMethodInfo(
+		acceptFollowRequestHandler,
+		newFollowServiceAcceptFollowRequestArgs,
+		newFollowServiceAcceptFollowRequestResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RejectFollowRequest": kitex.NewMethodInfo(
+		rejectFollowRequestHandler,
+		newFollowServiceRejectFollowRequestArgs,
+		newFollowServiceRejectFollowRequestResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"BlockAction": kitex.NewMethodInfo(
+		blockActionHandler,
+		newFollowServiceBlockActionArgs,
+		newFollowServiceBlockActionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"BlockList": kitex.NewMethodInfo(
+		blockListHandler,
+		newFollowServiceBlockListArgs,
+		newFollowServiceBlockListResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CheckBlock": kitex.NewMethodInfo(
+		checkBlockHandler,
+		newFollowServiceCheckBlockArgs,
+		newFollowServiceCheckBlockResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateFollowType": kitex.NewMethodInfo(
+		updateFollowTypeHandler,
+		newFollowServiceUpdateFollowTypeArgs,
+		newFollowServiceUpdateFollowTypeResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SetFollowRemark": kitex.NewMethodInfo(
+		setFollowRemarkHandler,
+		newFollowServiceSetFollowRemarkArgs,
+		newFollowServiceSetFollowRemarkResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetFollowType": kitex.NewMethodInfo(
+		getFollowTypeHandler,
+		newFollowServiceGetFollowTypeArgs,
+		newFollowServiceGetFollowTypeResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetFollowingIDs": kitex.NewMethodInfo(
+		getFollowingIDsHandler,
+		newFollowServiceGetFollowingIDsArgs,
+		newFollowServiceGetFollowingIDsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetFollowerIDs": kitex.NewMethodInfo(
+		getFollowerIDsHandler,
+		newFollowServiceGetFollowerIDsArgs,
+		newFollowServiceGetFollowerIDsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"FollowSuggestion": kitex.NewMethodInfo(
+		followSuggestionHandler,
+		newFollowServiceFollowSuggestionArgs,
+		newFollowServiceFollowSuggestionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -179,6 +263,222 @@ func newFollowServiceFriendListResult() interface{} {
 	return relations.NewFollowServiceFriendListResult()
 }
 
+func followRequestListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*relations.FollowServiceFollowRequestListArgs)
+	realResult := result.(*relations.FollowServiceFollowRequestListResult)
+	success, err := handler.(relations.FollowService).FollowRequestList(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFollowServiceFollowRequestListArgs() interface{} {
+	return relations.NewFollowServiceFollowRequestListArgs()
+}
+
+func newFollowServiceFollowRequestListResult() interface{} {
+	return relations.NewFollowServiceFollowRequestListResult()
+}
+
+func acceptFollowRequestHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*relations.FollowServiceAcceptFollowRequestArgs)
+	realResult := result.(*relations.FollowServiceAcceptFollowRequestResult)
+	success, err := handler.(relations.FollowService).AcceptFollowRequest(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFollowServiceAcceptFollowRequestArgs() interface{} {
+	return relations.NewFollowServiceAcceptFollowRequestArgs()
+}
+
+func newFollowServiceAcceptFollowRequestResult() interface{} {
+	return relations.NewFollowServiceAcceptFollowRequestResult()
+}
+
+func rejectFollowRequestHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*relations.FollowServiceRejectFollowRequestArgs)
+	realResult := result.(*relations.FollowServiceRejectFollowRequestResult)
+	success, err := handler.(relations.FollowService).RejectFollowRequest(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFollowServiceRejectFollowRequestArgs() interface{} {
+	return relations.NewFollowServiceRejectFollowRequestArgs()
+}
+
+func newFollowServiceRejectFollowRequestResult() interface{} {
+	return relations.NewFollowServiceRejectFollowRequestResult()
+}
+
+func blockActionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*relations.FollowServiceBlockActionArgs)
+	realResult := result.(*relations.FollowServiceBlockActionResult)
+	success, err := handler.(relations.FollowService).BlockAction(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFollowServiceBlockActionArgs() interface{} {
+	return relations.NewFollowServiceBlockActionArgs()
+}
+
+func newFollowServiceBlockActionResult() interface{} {
+	return relations.NewFollowServiceBlockActionResult()
+}
+
+func blockListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*relations.FollowServiceBlockListArgs)
+	realResult := result.(*relations.FollowServiceBlockListResult)
+	success, err := handler.(relations.FollowService).BlockList(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFollowServiceBlockListArgs() interface{} {
+	return relations.NewFollowServiceBlockListArgs()
+}
+
+func newFollowServiceBlockListResult() interface{} {
+	return relations.NewFollowServiceBlockListResult()
+}
+
+func checkBlockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*relations.FollowServiceCheckBlockArgs)
+	realResult := result.(*relations.FollowServiceCheckBlockResult)
+	success, err := handler.(relations.FollowService).CheckBlock(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFollowServiceCheckBlockArgs() interface{} {
+	return relations.NewFollowServiceCheckBlockArgs()
+}
+
+func newFollowServiceCheckBlockResult() interface{} {
+	return relations.NewFollowServiceCheckBlockResult()
+}
+
+func updateFollowTypeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*relations.FollowServiceUpdateFollowTypeArgs)
+	realResult := result.(*relations.FollowServiceUpdateFollowTypeResult)
+	success, err := handler.(relations.FollowService).UpdateFollowType(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFollowServiceUpdateFollowTypeArgs() interface{} {
+	return relations.NewFollowServiceUpdateFollowTypeArgs()
+}
+
+func newFollowServiceUpdateFollowTypeResult() interface{} {
+	return relations.NewFollowServiceUpdateFollowTypeResult()
+}
+
+func setFollowRemarkHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*relations.FollowServiceSetFollowRemarkArgs)
+	realResult := result.(*relations.FollowServiceSetFollowRemarkResult)
+	success, err := handler.(relations.FollowService).SetFollowRemark(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFollowServiceSetFollowRemarkArgs() interface{} {
+	return relations.NewFollowServiceSetFollowRemarkArgs()
+}
+
+func newFollowServiceSetFollowRemarkResult() interface{} {
+	return relations.NewFollowServiceSetFollowRemarkResult()
+}
+
+func getFollowTypeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*relations.FollowServiceGetFollowTypeArgs)
+	realResult := result.(*relations.FollowServiceGetFollowTypeResult)
+	success, err := handler.(relations.FollowService).GetFollowType(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFollowServiceGetFollowTypeArgs() interface{} {
+	return relations.NewFollowServiceGetFollowTypeArgs()
+}
+
+func newFollowServiceGetFollowTypeResult() interface{} {
+	return relations.NewFollowServiceGetFollowTypeResult()
+}
+
+func getFollowingIDsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*relations.FollowServiceGetFollowingIDsArgs)
+	realResult := result.(*relations.FollowServiceGetFollowingIDsResult)
+	success, err := handler.(relations.FollowService).GetFollowingIDs(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFollowServiceGetFollowingIDsArgs() interface{} {
+	return relations.NewFollowServiceGetFollowingIDsArgs()
+}
+
+func newFollowServiceGetFollowingIDsResult() interface{} {
+	return relations.NewFollowServiceGetFollowingIDsResult()
+}
+
+func getFollowerIDsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*relations.FollowServiceGetFollowerIDsArgs)
+	realResult := result.(*relations.FollowServiceGetFollowerIDsResult)
+	success, err := handler.(relations.FollowService).GetFollowerIDs(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFollowServiceGetFollowerIDsArgs() interface{} {
+	return relations.NewFollowServiceGetFollowerIDsArgs()
+}
+
+func newFollowServiceGetFollowerIDsResult() interface{} {
+	return relations.NewFollowServiceGetFollowerIDsResult()
+}
+
+func followSuggestionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*relations.FollowServiceFollowSuggestionArgs)
+	realResult := result.(*relations.FollowServiceFollowSuggestionResult)
+	success, err := handler.(relations.FollowService).FollowSuggestion(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFollowServiceFollowSuggestionArgs() interface{} {
+	return relations.NewFollowServiceFollowSuggestionArgs()
+}
+
+func newFollowServiceFollowSuggestionResult() interface{} {
+	return relations.NewFollowServiceFollowSuggestionResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -228,3 +528,123 @@ func (p *kClient) FriendList(ctx context.Context, req *relations.FriendListReque
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) FollowRequestList(ctx context.Context, req *relations.FollowRequestListRequest) (r *relations.FollowRequestListResponse, err error) {
+	var _args relations.FollowServiceFollowRequestListArgs
+	_args.Req = req
+	var _result relations.FollowServiceFollowRequestListResult
+	if err = p.c.Call(ctx, "FollowRequestList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AcceptFollowRequest(ctx context.Context, req *relations.AcceptFollowRequestRequest) (r *relations.AcceptFollowRequestResponse, err error) {
+	var _args relations.FollowServiceAcceptFollowRequestArgs
+	_args.Req = req
+	var _result relations.FollowServiceAcceptFollowRequestResult
+	if err = p.c.Call(ctx, "AcceptFollowRequest", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RejectFollowRequest(ctx context.Context, req *relations.RejectFollowRequestRequest) (r *relations.RejectFollowRequestResponse, err error) {
+	var _args relations.FollowServiceRejectFollowRequestArgs
+	_args.Req = req
+	var _result relations.FollowServiceRejectFollowRequestResult
+	if err = p.c.Call(ctx, "RejectFollowRequest", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BlockAction(ctx context.Context, req *relations.BlockActionRequest) (r *relations.BlockActionResponse, err error) {
+	var _args relations.FollowServiceBlockActionArgs
+	_args.Req = req
+	var _result relations.FollowServiceBlockActionResult
+	if err = p.c.Call(ctx, "BlockAction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BlockList(ctx context.Context, req *relations.BlockListRequest) (r *relations.BlockListResponse, err error) {
+	var _args relations.FollowServiceBlockListArgs
+	_args.Req = req
+	var _result relations.FollowServiceBlockListResult
+	if err = p.c.Call(ctx, "BlockList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CheckBlock(ctx context.Context, req *relations.CheckBlockRequest) (r *relations.CheckBlockResponse, err error) {
+	var _args relations.FollowServiceCheckBlockArgs
+	_args.Req = req
+	var _result relations.FollowServiceCheckBlockResult
+	if err = p.c.Call(ctx, "CheckBlock", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateFollowType(ctx context.Context, req *relations.UpdateFollowTypeRequest) (r *relations.UpdateFollowTypeResponse, err error) {
+	var _args relations.FollowServiceUpdateFollowTypeArgs
+	_args.Req = req
+	var _result relations.FollowServiceUpdateFollowTypeResult
+	if err = p.c.Call(ctx, "UpdateFollowType", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetFollowRemark(ctx context.Context, req *relations.SetFollowRemarkRequest) (r *relations.SetFollowRemarkResponse, err error) {
+	var _args relations.FollowServiceSetFollowRemarkArgs
+	_args.Req = req
+	var _result relations.FollowServiceSetFollowRemarkResult
+	if err = p.c.Call(ctx, "SetFollowRemark", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetFollowType(ctx context.Context, req *relations.GetFollowTypeRequest) (r *relations.GetFollowTypeResponse, err error) {
+	var _args relations.FollowServiceGetFollowTypeArgs
+	_args.Req = req
+	var _result relations.FollowServiceGetFollowTypeResult
+	if err = p.c.Call(ctx, "GetFollowType", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetFollowingIDs(ctx context.Context, req *relations.GetFollowingIDsRequest) (r *relations.GetFollowingIDsResponse, err error) {
+	var _args relations.FollowServiceGetFollowingIDsArgs
+	_args.Req = req
+	var _result relations.FollowServiceGetFollowingIDsResult
+	if err = p.c.Call(ctx, "GetFollowingIDs", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetFollowerIDs(ctx context.Context, req *relations.GetFollowerIDsRequest) (r *relations.GetFollowerIDsResponse, err error) {
+	var _args relations.FollowServiceGetFollowerIDsArgs
+	_args.Req = req
+	var _result relations.FollowServiceGetFollowerIDsResult
+	if err = p.c.Call(ctx, "GetFollowerIDs", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) FollowSuggestion(ctx context.Context, req *relations.FollowSuggestionRequest) (r *relations.FollowSuggestionResponse, err error) {
+	var _args relations.FollowServiceFollowSuggestionArgs
+	_args.Req = req
+	var _result relations.FollowServiceFollowSuggestionResult
+	if err = p.c.Call(ctx, "FollowSuggestion", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -56,7 +56,8 @@ var fieldIDToName_RelationServiceRequest = map[int16]string{
 }
 
 type RelationServiceResponse struct {
-	Base *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Base    *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Pending bool         `thrift:"pending,2" frugal:"2,default,bool" json:"pending"`
 }
 
 func NewRelationServiceResponse() *RelationServiceResponse {
@@ -74,9 +75,16 @@ func (p *RelationServiceResponse) GetBase() (v *base.Status) {
 	}
 	return p.Base
 }
+
+func (p *RelationServiceResponse) GetPending() (v bool) {
+	return p.Pending
+}
 func (p *RelationServiceResponse) SetBase(val *base.Status) {
 	p.Base = val
 }
+func (p *RelationServiceResponse) SetPending(val bool) {
+	p.Pending = val
+}
 
 func (p *RelationServiceResponse) IsSetBase() bool {
 	return p.Base != nil
@@ -91,12 +99,17 @@ func (p *RelationServiceResponse) String() string {
 
 var fieldIDToName_RelationServiceResponse = map[int16]string{
 	1: "base",
+	2: "pending",
 }
 
 type FollowingListRequest struct {
-	UserId   int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
-	PageNum  int64 `thrift:"page_num,2" frugal:"2,default,i64" json:"page_num"`
-	PageSize int64 `thrift:"page_size,3" frugal:"3,default,i64" json:"page_size"`
+	UserId     int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	PageNum    int64  `thrift:"page_num,2" frugal:"2,default,i64" json:"page_num"`
+	PageSize   int64  `thrift:"page_size,3" frugal:"3,default,i64" json:"page_size"`
+	ViewerId   int64  `thrift:"viewer_id,4" frugal:"4,default,i64" json:"viewer_id"`
+	FollowType int64  `thrift:"follow_type,5" frugal:"5,default,i64" json:"follow_type"`
+	Cursor     string `thrift:"cursor,6" frugal:"6,default,string" json:"cursor"`
+	UseCursor  bool   `thrift:"use_cursor,7" frugal:"7,default,bool" json:"use_cursor"`
 }
 
 func NewFollowingListRequest() *FollowingListRequest {
@@ -117,6 +130,22 @@ func (p *FollowingListRequest) GetPageNum() (v int64) {
 func (p *FollowingListRequest) GetPageSize() (v int64) {
 	return p.PageSize
 }
+
+func (p *FollowingListRequest) GetViewerId() (v int64) {
+	return p.ViewerId
+}
+
+func (p *FollowingListRequest) GetFollowType() (v int64) {
+	return p.FollowType
+}
+
+func (p *FollowingListRequest) GetCursor() (v string) {
+	return p.Cursor
+}
+
+func (p *FollowingListRequest) GetUseCursor() (v bool) {
+	return p.UseCursor
+}
 func (p *FollowingListRequest) SetUserId(val int64) {
 	p.UserId = val
 }
@@ -126,6 +155,18 @@ func (p *FollowingListRequest) SetPageNum(val int64) {
 func (p *FollowingListRequest) SetPageSize(val int64) {
 	p.PageSize = val
 }
+func (p *FollowingListRequest) SetViewerId(val int64) {
+	p.ViewerId = val
+}
+func (p *FollowingListRequest) SetFollowType(val int64) {
+	p.FollowType = val
+}
+func (p *FollowingListRequest) SetCursor(val string) {
+	p.Cursor = val
+}
+func (p *FollowingListRequest) SetUseCursor(val bool) {
+	p.UseCursor = val
+}
 
 func (p *FollowingListRequest) String() string {
 	if p == nil {
@@ -138,12 +179,18 @@ var fieldIDToName_FollowingListRequest = map[int16]string{
 	1: "user_id",
 	2: "page_num",
 	3: "page_size",
+	4: "viewer_id",
+	5: "follow_type",
+	6: "cursor",
+	7: "use_cursor",
 }
 
 type FollowingListResponse struct {
-	Base  *base.Status     `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
-	Items []*base.UserLite `thrift:"items,2" frugal:"2,default,list<base.UserLite>" json:"items"`
-	Total int64            `thrift:"total,3" frugal:"3,default,i64" json:"total"`
+	Base       *base.Status     `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Items      []*base.UserLite `thrift:"items,2" frugal:"2,default,list<base.UserLite>" json:"items"`
+	Total      int64            `thrift:"total,3" frugal:"3,default,i64" json:"total"`
+	HasMore    bool             `thrift:"has_more,4" frugal:"4,default,bool" json:"has_more"`
+	NextCursor string           `thrift:"next_cursor,5" frugal:"5,default,string" json:"next_cursor"`
 }
 
 func NewFollowingListResponse() *FollowingListResponse {
@@ -169,6 +216,14 @@ func (p *FollowingListResponse) GetItems() (v []*base.UserLite) {
 func (p *FollowingListResponse) GetTotal() (v int64) {
 	return p.Total
 }
+
+func (p *FollowingListResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
+
+func (p *FollowingListResponse) GetNextCursor() (v string) {
+	return p.NextCursor
+}
 func (p *FollowingListResponse) SetBase(val *base.Status) {
 	p.Base = val
 }
@@ -178,6 +233,12 @@ func (p *FollowingListResponse) SetItems(val []*base.UserLite) {
 func (p *FollowingListResponse) SetTotal(val int64) {
 	p.Total = val
 }
+func (p *FollowingListResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
+func (p *FollowingListResponse) SetNextCursor(val string) {
+	p.NextCursor = val
+}
 
 func (p *FollowingListResponse) IsSetBase() bool {
 	return p.Base != nil
@@ -194,12 +255,17 @@ var fieldIDToName_FollowingListResponse = map[int16]string{
 	1: "base",
 	2: "items",
 	3: "total",
+	4: "has_more",
+	5: "next_cursor",
 }
 
 type FollowerListRequest struct {
-	UserId   int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
-	PageNum  int64 `thrift:"page_num,2" frugal:"2,default,i64" json:"page_num"`
-	PageSize int64 `thrift:"page_size,3" frugal:"3,default,i64" json:"page_size"`
+	UserId    int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	PageNum   int64  `thrift:"page_num,2" frugal:"2,default,i64" json:"page_num"`
+	PageSize  int64  `thrift:"page_size,3" frugal:"3,default,i64" json:"page_size"`
+	ViewerId  int64  `thrift:"viewer_id,4" frugal:"4,default,i64" json:"viewer_id"`
+	Cursor    string `thrift:"cursor,5" frugal:"5,default,string" json:"cursor"`
+	UseCursor bool   `thrift:"use_cursor,6" frugal:"6,default,bool" json:"use_cursor"`
 }
 
 func NewFollowerListRequest() *FollowerListRequest {
@@ -220,6 +286,18 @@ func (p *FollowerListRequest) GetPageNum() (v int64) {
 func (p *FollowerListRequest) GetPageSize() (v int64) {
 	return p.PageSize
 }
+
+func (p *FollowerListRequest) GetViewerId() (v int64) {
+	return p.ViewerId
+}
+
+func (p *FollowerListRequest) GetCursor() (v string) {
+	return p.Cursor
+}
+
+func (p *FollowerListRequest) GetUseCursor() (v bool) {
+	return p.UseCursor
+}
 func (p *FollowerListRequest) SetUserId(val int64) {
 	p.UserId = val
 }
@@ -229,6 +307,15 @@ func (p *FollowerListRequest) SetPageNum(val int64) {
 func (p *FollowerListRequest) SetPageSize(val int64) {
 	p.PageSize = val
 }
+func (p *FollowerListRequest) SetViewerId(val int64) {
+	p.ViewerId = val
+}
+func (p *FollowerListRequest) SetCursor(val string) {
+	p.Cursor = val
+}
+func (p *FollowerListRequest) SetUseCursor(val bool) {
+	p.UseCursor = val
+}
 
 func (p *FollowerListRequest) String() string {
 	if p == nil {
@@ -241,12 +328,17 @@ var fieldIDToName_FollowerListRequest = map[int16]string{
 	1: "user_id",
 	2: "page_num",
 	3: "page_size",
+	4: "viewer_id",
+	5: "cursor",
+	6: "use_cursor",
 }
 
 type FollowerListResponse struct {
-	Base  *base.Status     `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
-	Items []*base.UserLite `thrift:"items,2" frugal:"2,default,list<base.UserLite>" json:"items"`
-	Total int64            `thrift:"total,3" frugal:"3,default,i64" json:"total"`
+	Base       *base.Status     `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Items      []*base.UserLite `thrift:"items,2" frugal:"2,default,list<base.UserLite>" json:"items"`
+	Total      int64            `thrift:"total,3" frugal:"3,default,i64" json:"total"`
+	HasMore    bool             `thrift:"has_more,4" frugal:"4,default,bool" json:"has_more"`
+	NextCursor string           `thrift:"next_cursor,5" frugal:"5,default,string" json:"next_cursor"`
 }
 
 func NewFollowerListResponse() *FollowerListResponse {
@@ -272,6 +364,14 @@ func (p *FollowerListResponse) GetItems() (v []*base.UserLite) {
 func (p *FollowerListResponse) GetTotal() (v int64) {
 	return p.Total
 }
+
+func (p *FollowerListResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
+
+func (p *FollowerListResponse) GetNextCursor() (v string) {
+	return p.NextCursor
+}
 func (p *FollowerListResponse) SetBase(val *base.Status) {
 	p.Base = val
 }
@@ -281,6 +381,12 @@ func (p *FollowerListResponse) SetItems(val []*base.UserLite) {
 func (p *FollowerListResponse) SetTotal(val int64) {
 	p.Total = val
 }
+func (p *FollowerListResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
+func (p *FollowerListResponse) SetNextCursor(val string) {
+	p.NextCursor = val
+}
 
 func (p *FollowerListResponse) IsSetBase() bool {
 	return p.Base != nil
@@ -297,6 +403,8 @@ var fieldIDToName_FollowerListResponse = map[int16]string{
 	1: "base",
 	2: "items",
 	3: "total",
+	4: "has_more",
+	5: "next_cursor",
 }
 
 type FriendListRequest struct {
@@ -402,316 +510,2418 @@ var fieldIDToName_FriendListResponse = map[int16]string{
 	3: "total",
 }
 
-type FollowService interface {
-	RelationService(ctx context.Context, req *RelationServiceRequest) (r *RelationServiceResponse, err error)
-
-	FollowingList(ctx context.Context, req *FollowingListRequest) (r *FollowingListResponse, err error)
-
-	FollowerList(ctx context.Context, req *FollowerListRequest) (r *FollowerListResponse, err error)
-
-	FriendList(ctx context.Context, req *FriendListRequest) (r *FriendListResponse, err error)
+type FollowRequestListRequest struct {
+	UserId   int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	PageNum  int64 `thrift:"page_num,2" frugal:"2,default,i64" json:"page_num"`
+	PageSize int64 `thrift:"page_size,3" frugal:"3,default,i64" json:"page_size"`
 }
 
-type FollowServiceRelationServiceArgs struct {
-	Req *RelationServiceRequest `thrift:"req,1" frugal:"1,default,RelationServiceRequest" json:"req"`
+func NewFollowRequestListRequest() *FollowRequestListRequest {
+	return &FollowRequestListRequest{}
 }
 
-func NewFollowServiceRelationServiceArgs() *FollowServiceRelationServiceArgs {
-	return &FollowServiceRelationServiceArgs{}
+func (p *FollowRequestListRequest) InitDefault() {
 }
 
-func (p *FollowServiceRelationServiceArgs) InitDefault() {
+func (p *FollowRequestListRequest) GetUserId() (v int64) {
+	return p.UserId
 }
 
-var FollowServiceRelationServiceArgs_Req_DEFAULT *RelationServiceRequest
+func (p *FollowRequestListRequest) GetPageNum() (v int64) {
+	return p.PageNum
+}
 
-func (p *FollowServiceRelationServiceArgs) GetReq() (v *RelationServiceRequest) {
-	if !p.IsSetReq() {
-		return FollowServiceRelationServiceArgs_Req_DEFAULT
-	}
-	return p.Req
+func (p *FollowRequestListRequest) GetPageSize() (v int64) {
+	return p.PageSize
 }
-func (p *FollowServiceRelationServiceArgs) SetReq(val *RelationServiceRequest) {
-	p.Req = val
+func (p *FollowRequestListRequest) SetUserId(val int64) {
+	p.UserId = val
 }
-
-func (p *FollowServiceRelationServiceArgs) IsSetReq() bool {
-	return p.Req != nil
+func (p *FollowRequestListRequest) SetPageNum(val int64) {
+	p.PageNum = val
+}
+func (p *FollowRequestListRequest) SetPageSize(val int64) {
+	p.PageSize = val
 }
 
-func (p *FollowServiceRelationServiceArgs) String() string {
+func (p *FollowRequestListRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceRelationServiceArgs(%+v)", *p)
+	return fmt.Sprintf("FollowRequestListRequest(%+v)", *p)
 }
 
-var fieldIDToName_FollowServiceRelationServiceArgs = map[int16]string{
-	1: "req",
+var fieldIDToName_FollowRequestListRequest = map[int16]string{
+	1: "user_id",
+	2: "page_num",
+	3: "page_size",
 }
 
-type FollowServiceRelationServiceResult struct {
-	Success *RelationServiceResponse `thrift:"success,0,optional" frugal:"0,optional,RelationServiceResponse" json:"success,omitempty"`
+type FollowRequestListResponse struct {
+	Base  *base.Status     `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Items []*base.UserLite `thrift:"items,2" frugal:"2,default,list<base.UserLite>" json:"items"`
+	Total int64            `thrift:"total,3" frugal:"3,default,i64" json:"total"`
 }
 
-func NewFollowServiceRelationServiceResult() *FollowServiceRelationServiceResult {
-	return &FollowServiceRelationServiceResult{}
+func NewFollowRequestListResponse() *FollowRequestListResponse {
+	return &FollowRequestListResponse{}
 }
 
-func (p *FollowServiceRelationServiceResult) InitDefault() {
+func (p *FollowRequestListResponse) InitDefault() {
 }
 
-var FollowServiceRelationServiceResult_Success_DEFAULT *RelationServiceResponse
+var FollowRequestListResponse_Base_DEFAULT *base.Status
 
-func (p *FollowServiceRelationServiceResult) GetSuccess() (v *RelationServiceResponse) {
-	if !p.IsSetSuccess() {
-		return FollowServiceRelationServiceResult_Success_DEFAULT
+func (p *FollowRequestListResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return FollowRequestListResponse_Base_DEFAULT
 	}
-	return p.Success
+	return p.Base
 }
-func (p *FollowServiceRelationServiceResult) SetSuccess(x interface{}) {
-	p.Success = x.(*RelationServiceResponse)
+
+func (p *FollowRequestListResponse) GetItems() (v []*base.UserLite) {
+	return p.Items
 }
 
-func (p *FollowServiceRelationServiceResult) IsSetSuccess() bool {
-	return p.Success != nil
+func (p *FollowRequestListResponse) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *FollowRequestListResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *FollowRequestListResponse) SetItems(val []*base.UserLite) {
+	p.Items = val
+}
+func (p *FollowRequestListResponse) SetTotal(val int64) {
+	p.Total = val
 }
 
-func (p *FollowServiceRelationServiceResult) String() string {
+func (p *FollowRequestListResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *FollowRequestListResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceRelationServiceResult(%+v)", *p)
+	return fmt.Sprintf("FollowRequestListResponse(%+v)", *p)
 }
 
-var fieldIDToName_FollowServiceRelationServiceResult = map[int16]string{
-	0: "success",
+var fieldIDToName_FollowRequestListResponse = map[int16]string{
+	1: "base",
+	2: "items",
+	3: "total",
 }
 
-type FollowServiceFollowingListArgs struct {
-	Req *FollowingListRequest `thrift:"req,1" frugal:"1,default,FollowingListRequest" json:"req"`
+type AcceptFollowRequestRequest struct {
+	UserId     int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	FromUserId int64 `thrift:"from_user_id,2" frugal:"2,default,i64" json:"from_user_id"`
 }
 
-func NewFollowServiceFollowingListArgs() *FollowServiceFollowingListArgs {
-	return &FollowServiceFollowingListArgs{}
+func NewAcceptFollowRequestRequest() *AcceptFollowRequestRequest {
+	return &AcceptFollowRequestRequest{}
 }
 
-func (p *FollowServiceFollowingListArgs) InitDefault() {
+func (p *AcceptFollowRequestRequest) InitDefault() {
 }
 
-var FollowServiceFollowingListArgs_Req_DEFAULT *FollowingListRequest
+func (p *AcceptFollowRequestRequest) GetUserId() (v int64) {
+	return p.UserId
+}
 
-func (p *FollowServiceFollowingListArgs) GetReq() (v *FollowingListRequest) {
-	if !p.IsSetReq() {
-		return FollowServiceFollowingListArgs_Req_DEFAULT
-	}
-	return p.Req
+func (p *AcceptFollowRequestRequest) GetFromUserId() (v int64) {
+	return p.FromUserId
 }
-func (p *FollowServiceFollowingListArgs) SetReq(val *FollowingListRequest) {
-	p.Req = val
+func (p *AcceptFollowRequestRequest) SetUserId(val int64) {
+	p.UserId = val
 }
-
-func (p *FollowServiceFollowingListArgs) IsSetReq() bool {
-	return p.Req != nil
+func (p *AcceptFollowRequestRequest) SetFromUserId(val int64) {
+	p.FromUserId = val
 }
 
-func (p *FollowServiceFollowingListArgs) String() string {
+func (p *AcceptFollowRequestRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceFollowingListArgs(%+v)", *p)
+	return fmt.Sprintf("AcceptFollowRequestRequest(%+v)", *p)
 }
 
-var fieldIDToName_FollowServiceFollowingListArgs = map[int16]string{
-	1: "req",
+var fieldIDToName_AcceptFollowRequestRequest = map[int16]string{
+	1: "user_id",
+	2: "from_user_id",
 }
 
-type FollowServiceFollowingListResult struct {
-	Success *FollowingListResponse `thrift:"success,0,optional" frugal:"0,optional,FollowingListResponse" json:"success,omitempty"`
+type AcceptFollowRequestResponse struct {
+	Base *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
 }
 
-func NewFollowServiceFollowingListResult() *FollowServiceFollowingListResult {
-	return &FollowServiceFollowingListResult{}
+func NewAcceptFollowRequestResponse() *AcceptFollowRequestResponse {
+	return &AcceptFollowRequestResponse{}
 }
 
-func (p *FollowServiceFollowingListResult) InitDefault() {
+func (p *AcceptFollowRequestResponse) InitDefault() {
 }
 
-var FollowServiceFollowingListResult_Success_DEFAULT *FollowingListResponse
+var AcceptFollowRequestResponse_Base_DEFAULT *base.Status
 
-func (p *FollowServiceFollowingListResult) GetSuccess() (v *FollowingListResponse) {
-	if !p.IsSetSuccess() {
-		return FollowServiceFollowingListResult_Success_DEFAULT
+func (p *AcceptFollowRequestResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return AcceptFollowRequestResponse_Base_DEFAULT
 	}
-	return p.Success
+	return p.Base
 }
-func (p *FollowServiceFollowingListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*FollowingListResponse)
+func (p *AcceptFollowRequestResponse) SetBase(val *base.Status) {
+	p.Base = val
 }
 
-func (p *FollowServiceFollowingListResult) IsSetSuccess() bool {
-	return p.Success != nil
+func (p *AcceptFollowRequestResponse) IsSetBase() bool {
+	return p.Base != nil
 }
 
-func (p *FollowServiceFollowingListResult) String() string {
+func (p *AcceptFollowRequestResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceFollowingListResult(%+v)", *p)
+	return fmt.Sprintf("AcceptFollowRequestResponse(%+v)", *p)
 }
 
-var fieldIDToName_FollowServiceFollowingListResult = map[int16]string{
-	0: "success",
+var fieldIDToName_AcceptFollowRequestResponse = map[int16]string{
+	1: "base",
 }
 
-type FollowServiceFollowerListArgs struct {
-	Req *FollowerListRequest `thrift:"req,1" frugal:"1,default,FollowerListRequest" json:"req"`
+type RejectFollowRequestRequest struct {
+	UserId     int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	FromUserId int64 `thrift:"from_user_id,2" frugal:"2,default,i64" json:"from_user_id"`
 }
 
-func NewFollowServiceFollowerListArgs() *FollowServiceFollowerListArgs {
-	return &FollowServiceFollowerListArgs{}
+func NewRejectFollowRequestRequest() *RejectFollowRequestRequest {
+	return &RejectFollowRequestRequest{}
 }
 
-func (p *FollowServiceFollowerListArgs) InitDefault() {
+func (p *RejectFollowRequestRequest) InitDefault() {
 }
 
-var FollowServiceFollowerListArgs_Req_DEFAULT *FollowerListRequest
+func (p *RejectFollowRequestRequest) GetUserId() (v int64) {
+	return p.UserId
+}
 
-func (p *FollowServiceFollowerListArgs) GetReq() (v *FollowerListRequest) {
-	if !p.IsSetReq() {
-		return FollowServiceFollowerListArgs_Req_DEFAULT
-	}
-	return p.Req
+func (p *RejectFollowRequestRequest) GetFromUserId() (v int64) {
+	return p.FromUserId
 }
-func (p *FollowServiceFollowerListArgs) SetReq(val *FollowerListRequest) {
-	p.Req = val
+func (p *RejectFollowRequestRequest) SetUserId(val int64) {
+	p.UserId = val
 }
-
-func (p *FollowServiceFollowerListArgs) IsSetReq() bool {
-	return p.Req != nil
+func (p *RejectFollowRequestRequest) SetFromUserId(val int64) {
+	p.FromUserId = val
 }
 
-func (p *FollowServiceFollowerListArgs) String() string {
+func (p *RejectFollowRequestRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceFollowerListArgs(%+v)", *p)
+	return fmt.Sprintf("RejectFollowRequestRequest(%+v)", *p)
 }
 
-var fieldIDToName_FollowServiceFollowerListArgs = map[int16]string{
-	1: "req",
+var fieldIDToName_RejectFollowRequestRequest = map[int16]string{
+	1: "user_id",
+	2: "from_user_id",
 }
 
-type FollowServiceFollowerListResult struct {
-	Success *FollowerListResponse `thrift:"success,0,optional" frugal:"0,optional,FollowerListResponse" json:"success,omitempty"`
+type RejectFollowRequestResponse struct {
+	Base *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
 }
 
-func NewFollowServiceFollowerListResult() *FollowServiceFollowerListResult {
-	return &FollowServiceFollowerListResult{}
+func NewRejectFollowRequestResponse() *RejectFollowRequestResponse {
+	return &RejectFollowRequestResponse{}
 }
 
-func (p *FollowServiceFollowerListResult) InitDefault() {
+func (p *RejectFollowRequestResponse) InitDefault() {
 }
 
-var FollowServiceFollowerListResult_Success_DEFAULT *FollowerListResponse
+var RejectFollowRequestResponse_Base_DEFAULT *base.Status
 
-func (p *FollowServiceFollowerListResult) GetSuccess() (v *FollowerListResponse) {
-	if !p.IsSetSuccess() {
-		return FollowServiceFollowerListResult_Success_DEFAULT
+func (p *RejectFollowRequestResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return RejectFollowRequestResponse_Base_DEFAULT
 	}
-	return p.Success
+	return p.Base
 }
-func (p *FollowServiceFollowerListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*FollowerListResponse)
+func (p *RejectFollowRequestResponse) SetBase(val *base.Status) {
+	p.Base = val
 }
 
-func (p *FollowServiceFollowerListResult) IsSetSuccess() bool {
-	return p.Success != nil
+func (p *RejectFollowRequestResponse) IsSetBase() bool {
+	return p.Base != nil
 }
 
-func (p *FollowServiceFollowerListResult) String() string {
+func (p *RejectFollowRequestResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceFollowerListResult(%+v)", *p)
+	return fmt.Sprintf("RejectFollowRequestResponse(%+v)", *p)
 }
 
-var fieldIDToName_FollowServiceFollowerListResult = map[int16]string{
-	0: "success",
+var fieldIDToName_RejectFollowRequestResponse = map[int16]string{
+	1: "base",
 }
 
-type FollowServiceFriendListArgs struct {
-	Req *FriendListRequest `thrift:"req,1" frugal:"1,default,FriendListRequest" json:"req"`
+type BlockActionRequest struct {
+	UserId       int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	TargetUserId int64 `thrift:"target_user_id,2" frugal:"2,default,i64" json:"target_user_id"`
+	ActionType   int64 `thrift:"action_type,3" frugal:"3,default,i64" json:"action_type"`
 }
 
-func NewFollowServiceFriendListArgs() *FollowServiceFriendListArgs {
-	return &FollowServiceFriendListArgs{}
+func NewBlockActionRequest() *BlockActionRequest {
+	return &BlockActionRequest{}
 }
 
-func (p *FollowServiceFriendListArgs) InitDefault() {
+func (p *BlockActionRequest) InitDefault() {
 }
 
-var FollowServiceFriendListArgs_Req_DEFAULT *FriendListRequest
-
-func (p *FollowServiceFriendListArgs) GetReq() (v *FriendListRequest) {
-	if !p.IsSetReq() {
-		return FollowServiceFriendListArgs_Req_DEFAULT
-	}
-	return p.Req
-}
-func (p *FollowServiceFriendListArgs) SetReq(val *FriendListRequest) {
-	p.Req = val
+func (p *BlockActionRequest) GetUserId() (v int64) {
+	return p.UserId
 }
 
-func (p *FollowServiceFriendListArgs) IsSetReq() bool {
-	return p.Req != nil
+func (p *BlockActionRequest) GetTargetUserId() (v int64) {
+	return p.TargetUserId
 }
 
-func (p *FollowServiceFriendListArgs) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("FollowServiceFriendListArgs(%+v)", *p)
+func (p *BlockActionRequest) GetActionType() (v int64) {
+	return p.ActionType
+}
+func (p *BlockActionRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *BlockActionRequest) SetTargetUserId(val int64) {
+	p.TargetUserId = val
+}
+func (p *BlockActionRequest) SetActionType(val int64) {
+	p.ActionType = val
+}
+
+func (p *BlockActionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BlockActionRequest(%+v)", *p)
+}
+
+var fieldIDToName_BlockActionRequest = map[int16]string{
+	1: "user_id",
+	2: "target_user_id",
+	3: "action_type",
+}
+
+type BlockActionResponse struct {
+	Base *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+}
+
+func NewBlockActionResponse() *BlockActionResponse {
+	return &BlockActionResponse{}
+}
+
+func (p *BlockActionResponse) InitDefault() {
+}
+
+var BlockActionResponse_Base_DEFAULT *base.Status
+
+func (p *BlockActionResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return BlockActionResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *BlockActionResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+
+func (p *BlockActionResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *BlockActionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BlockActionResponse(%+v)", *p)
+}
+
+var fieldIDToName_BlockActionResponse = map[int16]string{
+	1: "base",
+}
+
+type BlockListRequest struct {
+	UserId    int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	BlockType int64 `thrift:"block_type,2" frugal:"2,default,i64" json:"block_type"`
+	PageNum   int64 `thrift:"page_num,3" frugal:"3,default,i64" json:"page_num"`
+	PageSize  int64 `thrift:"page_size,4" frugal:"4,default,i64" json:"page_size"`
+}
+
+func NewBlockListRequest() *BlockListRequest {
+	return &BlockListRequest{}
+}
+
+func (p *BlockListRequest) InitDefault() {
+}
+
+func (p *BlockListRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *BlockListRequest) GetBlockType() (v int64) {
+	return p.BlockType
+}
+
+func (p *BlockListRequest) GetPageNum() (v int64) {
+	return p.PageNum
+}
+
+func (p *BlockListRequest) GetPageSize() (v int64) {
+	return p.PageSize
+}
+func (p *BlockListRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *BlockListRequest) SetBlockType(val int64) {
+	p.BlockType = val
+}
+func (p *BlockListRequest) SetPageNum(val int64) {
+	p.PageNum = val
+}
+func (p *BlockListRequest) SetPageSize(val int64) {
+	p.PageSize = val
+}
+
+func (p *BlockListRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BlockListRequest(%+v)", *p)
+}
+
+var fieldIDToName_BlockListRequest = map[int16]string{
+	1: "user_id",
+	2: "block_type",
+	3: "page_num",
+	4: "page_size",
+}
+
+type BlockListResponse struct {
+	Base  *base.Status     `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Items []*base.UserLite `thrift:"items,2" frugal:"2,default,list<base.UserLite>" json:"items"`
+	Total int64            `thrift:"total,3" frugal:"3,default,i64" json:"total"`
+}
+
+func NewBlockListResponse() *BlockListResponse {
+	return &BlockListResponse{}
+}
+
+func (p *BlockListResponse) InitDefault() {
+}
+
+var BlockListResponse_Base_DEFAULT *base.Status
+
+func (p *BlockListResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return BlockListResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *BlockListResponse) GetItems() (v []*base.UserLite) {
+	return p.Items
+}
+
+func (p *BlockListResponse) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *BlockListResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *BlockListResponse) SetItems(val []*base.UserLite) {
+	p.Items = val
+}
+func (p *BlockListResponse) SetTotal(val int64) {
+	p.Total = val
+}
+
+func (p *BlockListResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *BlockListResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BlockListResponse(%+v)", *p)
+}
+
+var fieldIDToName_BlockListResponse = map[int16]string{
+	1: "base",
+	2: "items",
+	3: "total",
+}
+
+type CheckBlockRequest struct {
+	UserId        int64   `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	TargetUserIds []int64 `thrift:"target_user_ids,2" frugal:"2,default,list<i64>" json:"target_user_ids"`
+}
+
+func NewCheckBlockRequest() *CheckBlockRequest {
+	return &CheckBlockRequest{}
+}
+
+func (p *CheckBlockRequest) InitDefault() {
+}
+
+func (p *CheckBlockRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *CheckBlockRequest) GetTargetUserIds() (v []int64) {
+	return p.TargetUserIds
+}
+func (p *CheckBlockRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *CheckBlockRequest) SetTargetUserIds(val []int64) {
+	p.TargetUserIds = val
+}
+
+func (p *CheckBlockRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckBlockRequest(%+v)", *p)
+}
+
+var fieldIDToName_CheckBlockRequest = map[int16]string{
+	1: "user_id",
+	2: "target_user_ids",
+}
+
+type CheckBlockResponse struct {
+	Base           *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	BlockedUserIds []int64      `thrift:"blocked_user_ids,2" frugal:"2,default,list<i64>" json:"blocked_user_ids"`
+	MutedUserIds   []int64      `thrift:"muted_user_ids,3" frugal:"3,default,list<i64>" json:"muted_user_ids"`
+}
+
+func NewCheckBlockResponse() *CheckBlockResponse {
+	return &CheckBlockResponse{}
+}
+
+func (p *CheckBlockResponse) InitDefault() {
+}
+
+var CheckBlockResponse_Base_DEFAULT *base.Status
+
+func (p *CheckBlockResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return CheckBlockResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *CheckBlockResponse) GetBlockedUserIds() (v []int64) {
+	return p.BlockedUserIds
+}
+
+func (p *CheckBlockResponse) GetMutedUserIds() (v []int64) {
+	return p.MutedUserIds
+}
+func (p *CheckBlockResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *CheckBlockResponse) SetBlockedUserIds(val []int64) {
+	p.BlockedUserIds = val
+}
+func (p *CheckBlockResponse) SetMutedUserIds(val []int64) {
+	p.MutedUserIds = val
+}
+
+func (p *CheckBlockResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CheckBlockResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckBlockResponse(%+v)", *p)
+}
+
+var fieldIDToName_CheckBlockResponse = map[int16]string{
+	1: "base",
+	2: "blocked_user_ids",
+	3: "muted_user_ids",
+}
+
+type UpdateFollowTypeRequest struct {
+	UserId       int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	TargetUserId int64 `thrift:"target_user_id,2" frugal:"2,default,i64" json:"target_user_id"`
+	FollowType   int64 `thrift:"follow_type,3" frugal:"3,default,i64" json:"follow_type"`
+}
+
+func NewUpdateFollowTypeRequest() *UpdateFollowTypeRequest {
+	return &UpdateFollowTypeRequest{}
+}
+
+func (p *UpdateFollowTypeRequest) InitDefault() {
+}
+
+func (p *UpdateFollowTypeRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *UpdateFollowTypeRequest) GetTargetUserId() (v int64) {
+	return p.TargetUserId
+}
+
+func (p *UpdateFollowTypeRequest) GetFollowType() (v int64) {
+	return p.FollowType
+}
+func (p *UpdateFollowTypeRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *UpdateFollowTypeRequest) SetTargetUserId(val int64) {
+	p.TargetUserId = val
+}
+func (p *UpdateFollowTypeRequest) SetFollowType(val int64) {
+	p.FollowType = val
+}
+
+func (p *UpdateFollowTypeRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateFollowTypeRequest(%+v)", *p)
+}
+
+var fieldIDToName_UpdateFollowTypeRequest = map[int16]string{
+	1: "user_id",
+	2: "target_user_id",
+	3: "follow_type",
+}
+
+type UpdateFollowTypeResponse struct {
+	Base *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+}
+
+func NewUpdateFollowTypeResponse() *UpdateFollowTypeResponse {
+	return &UpdateFollowTypeResponse{}
+}
+
+func (p *UpdateFollowTypeResponse) InitDefault() {
+}
+
+var UpdateFollowTypeResponse_Base_DEFAULT *base.Status
+
+func (p *UpdateFollowTypeResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return UpdateFollowTypeResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *UpdateFollowTypeResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+
+func (p *UpdateFollowTypeResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UpdateFollowTypeResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateFollowTypeResponse(%+v)", *p)
+}
+
+var fieldIDToName_UpdateFollowTypeResponse = map[int16]string{
+	1: "base",
+}
+
+type SetFollowRemarkRequest struct {
+	UserId       int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	TargetUserId int64  `thrift:"target_user_id,2" frugal:"2,default,i64" json:"target_user_id"`
+	Remark       string `thrift:"remark,3" frugal:"3,default,string" json:"remark"`
+}
+
+func NewSetFollowRemarkRequest() *SetFollowRemarkRequest {
+	return &SetFollowRemarkRequest{}
+}
+
+func (p *SetFollowRemarkRequest) InitDefault() {
+}
+
+func (p *SetFollowRemarkRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *SetFollowRemarkRequest) GetTargetUserId() (v int64) {
+	return p.TargetUserId
+}
+
+func (p *SetFollowRemarkRequest) GetRemark() (v string) {
+	return p.Remark
+}
+func (p *SetFollowRemarkRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *SetFollowRemarkRequest) SetTargetUserId(val int64) {
+	p.TargetUserId = val
+}
+func (p *SetFollowRemarkRequest) SetRemark(val string) {
+	p.Remark = val
+}
+
+func (p *SetFollowRemarkRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetFollowRemarkRequest(%+v)", *p)
+}
+
+var fieldIDToName_SetFollowRemarkRequest = map[int16]string{
+	1: "user_id",
+	2: "target_user_id",
+	3: "remark",
+}
+
+type SetFollowRemarkResponse struct {
+	Base *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+}
+
+func NewSetFollowRemarkResponse() *SetFollowRemarkResponse {
+	return &SetFollowRemarkResponse{}
+}
+
+func (p *SetFollowRemarkResponse) InitDefault() {
+}
+
+var SetFollowRemarkResponse_Base_DEFAULT *base.Status
+
+func (p *SetFollowRemarkResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return SetFollowRemarkResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *SetFollowRemarkResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+
+func (p *SetFollowRemarkResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *SetFollowRemarkResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetFollowRemarkResponse(%+v)", *p)
+}
+
+var fieldIDToName_SetFollowRemarkResponse = map[int16]string{
+	1: "base",
+}
+
+type GetFollowTypeRequest struct {
+	UserId       int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	TargetUserId int64 `thrift:"target_user_id,2" frugal:"2,default,i64" json:"target_user_id"`
+}
+
+func NewGetFollowTypeRequest() *GetFollowTypeRequest {
+	return &GetFollowTypeRequest{}
+}
+
+func (p *GetFollowTypeRequest) InitDefault() {
+}
+
+func (p *GetFollowTypeRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *GetFollowTypeRequest) GetTargetUserId() (v int64) {
+	return p.TargetUserId
+}
+func (p *GetFollowTypeRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *GetFollowTypeRequest) SetTargetUserId(val int64) {
+	p.TargetUserId = val
+}
+
+func (p *GetFollowTypeRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetFollowTypeRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetFollowTypeRequest = map[int16]string{
+	1: "user_id",
+	2: "target_user_id",
+}
+
+type GetFollowTypeResponse struct {
+	Base       *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	FollowType int64        `thrift:"follow_type,2" frugal:"2,default,i64" json:"follow_type"`
+}
+
+func NewGetFollowTypeResponse() *GetFollowTypeResponse {
+	return &GetFollowTypeResponse{}
+}
+
+func (p *GetFollowTypeResponse) InitDefault() {
+}
+
+var GetFollowTypeResponse_Base_DEFAULT *base.Status
+
+func (p *GetFollowTypeResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return GetFollowTypeResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetFollowTypeResponse) GetFollowType() (v int64) {
+	return p.FollowType
+}
+func (p *GetFollowTypeResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *GetFollowTypeResponse) SetFollowType(val int64) {
+	p.FollowType = val
+}
+
+func (p *GetFollowTypeResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetFollowTypeResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetFollowTypeResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetFollowTypeResponse = map[int16]string{
+	1: "base",
+	2: "follow_type",
+}
+
+type GetFollowingIDsRequest struct {
+	UserId int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	Limit  int64 `thrift:"limit,2" frugal:"2,default,i64" json:"limit"`
+}
+
+func NewGetFollowingIDsRequest() *GetFollowingIDsRequest {
+	return &GetFollowingIDsRequest{}
+}
+
+func (p *GetFollowingIDsRequest) InitDefault() {
+}
+
+func (p *GetFollowingIDsRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *GetFollowingIDsRequest) GetLimit() (v int64) {
+	return p.Limit
+}
+func (p *GetFollowingIDsRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *GetFollowingIDsRequest) SetLimit(val int64) {
+	p.Limit = val
+}
+
+func (p *GetFollowingIDsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetFollowingIDsRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetFollowingIDsRequest = map[int16]string{
+	1: "user_id",
+	2: "limit",
+}
+
+type GetFollowingIDsResponse struct {
+	Base    *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	UserIds []int64      `thrift:"user_ids,2" frugal:"2,default,list<i64>" json:"user_ids"`
+}
+
+func NewGetFollowingIDsResponse() *GetFollowingIDsResponse {
+	return &GetFollowingIDsResponse{}
+}
+
+func (p *GetFollowingIDsResponse) InitDefault() {
+}
+
+var GetFollowingIDsResponse_Base_DEFAULT *base.Status
+
+func (p *GetFollowingIDsResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return GetFollowingIDsResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetFollowingIDsResponse) GetUserIds() (v []int64) {
+	return p.UserIds
+}
+func (p *GetFollowingIDsResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *GetFollowingIDsResponse) SetUserIds(val []int64) {
+	p.UserIds = val
+}
+
+func (p *GetFollowingIDsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetFollowingIDsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetFollowingIDsResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetFollowingIDsResponse = map[int16]string{
+	1: "base",
+	2: "user_ids",
+}
+
+type GetFollowerIDsRequest struct {
+	UserId  int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	AfterId int64 `thrift:"after_id,2" frugal:"2,default,i64" json:"after_id"`
+	Limit   int64 `thrift:"limit,3" frugal:"3,default,i64" json:"limit"`
+}
+
+func NewGetFollowerIDsRequest() *GetFollowerIDsRequest {
+	return &GetFollowerIDsRequest{}
+}
+
+func (p *GetFollowerIDsRequest) InitDefault() {
+}
+
+func (p *GetFollowerIDsRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *GetFollowerIDsRequest) GetAfterId() (v int64) {
+	return p.AfterId
+}
+
+func (p *GetFollowerIDsRequest) GetLimit() (v int64) {
+	return p.Limit
+}
+func (p *GetFollowerIDsRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *GetFollowerIDsRequest) SetAfterId(val int64) {
+	p.AfterId = val
+}
+func (p *GetFollowerIDsRequest) SetLimit(val int64) {
+	p.Limit = val
+}
+
+func (p *GetFollowerIDsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetFollowerIDsRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetFollowerIDsRequest = map[int16]string{
+	1: "user_id",
+	2: "after_id",
+	3: "limit",
+}
+
+type GetFollowerIDsResponse struct {
+	Base    *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	UserIds []int64      `thrift:"user_ids,2" frugal:"2,default,list<i64>" json:"user_ids"`
+	HasMore bool         `thrift:"has_more,3" frugal:"3,default,bool" json:"has_more"`
+	Total   int64        `thrift:"total,4" frugal:"4,default,i64" json:"total"`
+}
+
+func NewGetFollowerIDsResponse() *GetFollowerIDsResponse {
+	return &GetFollowerIDsResponse{}
+}
+
+func (p *GetFollowerIDsResponse) InitDefault() {
+}
+
+var GetFollowerIDsResponse_Base_DEFAULT *base.Status
+
+func (p *GetFollowerIDsResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return GetFollowerIDsResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetFollowerIDsResponse) GetUserIds() (v []int64) {
+	return p.UserIds
+}
+
+func (p *GetFollowerIDsResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
+
+func (p *GetFollowerIDsResponse) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *GetFollowerIDsResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *GetFollowerIDsResponse) SetUserIds(val []int64) {
+	p.UserIds = val
+}
+func (p *GetFollowerIDsResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
+func (p *GetFollowerIDsResponse) SetTotal(val int64) {
+	p.Total = val
+}
+
+func (p *GetFollowerIDsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetFollowerIDsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetFollowerIDsResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetFollowerIDsResponse = map[int16]string{
+	1: "base",
+	2: "user_ids",
+	3: "has_more",
+	4: "total",
+}
+
+type FollowSuggestion struct {
+	User               *base.UserLite `thrift:"user,1" frugal:"1,default,base.UserLite" json:"user"`
+	Reason             string         `thrift:"reason,2" frugal:"2,default,string" json:"reason"`
+	MutualCount        int64          `thrift:"mutual_count,3" frugal:"3,default,i64" json:"mutual_count"`
+	CoInteractionCount int64          `thrift:"co_interaction_count,4" frugal:"4,default,i64" json:"co_interaction_count"`
+}
+
+func NewFollowSuggestion() *FollowSuggestion {
+	return &FollowSuggestion{}
+}
+
+func (p *FollowSuggestion) InitDefault() {
+}
+
+var FollowSuggestion_User_DEFAULT *base.UserLite
+
+func (p *FollowSuggestion) GetUser() (v *base.UserLite) {
+	if !p.IsSetUser() {
+		return FollowSuggestion_User_DEFAULT
+	}
+	return p.User
+}
+
+func (p *FollowSuggestion) GetReason() (v string) {
+	return p.Reason
+}
+
+func (p *FollowSuggestion) GetMutualCount() (v int64) {
+	return p.MutualCount
+}
+
+func (p *FollowSuggestion) GetCoInteractionCount() (v int64) {
+	return p.CoInteractionCount
+}
+func (p *FollowSuggestion) SetUser(val *base.UserLite) {
+	p.User = val
+}
+func (p *FollowSuggestion) SetReason(val string) {
+	p.Reason = val
+}
+func (p *FollowSuggestion) SetMutualCount(val int64) {
+	p.MutualCount = val
+}
+func (p *FollowSuggestion) SetCoInteractionCount(val int64) {
+	p.CoInteractionCount = val
+}
+
+func (p *FollowSuggestion) IsSetUser() bool {
+	return p.User != nil
+}
+
+func (p *FollowSuggestion) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowSuggestion(%+v)", *p)
+}
+
+var fieldIDToName_FollowSuggestion = map[int16]string{
+	1: "user",
+	2: "reason",
+	3: "mutual_count",
+	4: "co_interaction_count",
+}
+
+type FollowSuggestionRequest struct {
+	UserId   int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	PageNum  int64 `thrift:"page_num,2" frugal:"2,default,i64" json:"page_num"`
+	PageSize int64 `thrift:"page_size,3" frugal:"3,default,i64" json:"page_size"`
+}
+
+func NewFollowSuggestionRequest() *FollowSuggestionRequest {
+	return &FollowSuggestionRequest{}
+}
+
+func (p *FollowSuggestionRequest) InitDefault() {
+}
+
+func (p *FollowSuggestionRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *FollowSuggestionRequest) GetPageNum() (v int64) {
+	return p.PageNum
+}
+
+func (p *FollowSuggestionRequest) GetPageSize() (v int64) {
+	return p.PageSize
+}
+func (p *FollowSuggestionRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *FollowSuggestionRequest) SetPageNum(val int64) {
+	p.PageNum = val
+}
+func (p *FollowSuggestionRequest) SetPageSize(val int64) {
+	p.PageSize = val
+}
+
+func (p *FollowSuggestionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowSuggestionRequest(%+v)", *p)
+}
+
+var fieldIDToName_FollowSuggestionRequest = map[int16]string{
+	1: "user_id",
+	2: "page_num",
+	3: "page_size",
+}
+
+type FollowSuggestionResponse struct {
+	Base  *base.Status        `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	Items []*FollowSuggestion `thrift:"items,2" frugal:"2,default,list<FollowSuggestion>" json:"items"`
+	Total int64               `thrift:"total,3" frugal:"3,default,i64" json:"total"`
+}
+
+func NewFollowSuggestionResponse() *FollowSuggestionResponse {
+	return &FollowSuggestionResponse{}
+}
+
+func (p *FollowSuggestionResponse) InitDefault() {
+}
+
+var FollowSuggestionResponse_Base_DEFAULT *base.Status
+
+func (p *FollowSuggestionResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return FollowSuggestionResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *FollowSuggestionResponse) GetItems() (v []*FollowSuggestion) {
+	return p.Items
+}
+
+func (p *FollowSuggestionResponse) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *FollowSuggestionResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *FollowSuggestionResponse) SetItems(val []*FollowSuggestion) {
+	p.Items = val
+}
+func (p *FollowSuggestionResponse) SetTotal(val int64) {
+	p.Total = val
+}
+
+func (p *FollowSuggestionResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *FollowSuggestionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowSuggestionResponse(%+v)", *p)
+}
+
+var fieldIDToName_FollowSuggestionResponse = map[int16]string{
+	1: "base",
+	2: "items",
+	3: "total",
+}
+
+type FollowService interface {
+	RelationService(ctx context.Context, req *RelationServiceRequest) (r *RelationServiceResponse, err error)
+
+	FollowingList(ctx context.Context, req *FollowingListRequest) (r *FollowingListResponse, err error)
+
+	FollowerList(ctx context.Context, req *FollowerListRequest) (r *FollowerListResponse, err error)
+
+	FriendList(ctx context.Context, req *FriendListRequest) (r *FriendListResponse, err error)
+
+	FollowRequestList(ctx context.Context, req *FollowRequestListRequest) (r *FollowRequestListResponse, err error)
+
+	AcceptFollowRequest(ctx context.Context, req *AcceptFollowRequestRequest) (r *AcceptFollowRequestResponse, err error)
+
+	RejectFollowRequest(ctx context.Context, req *RejectFollowRequestRequest) (r *RejectFollowRequestResponse, err error)
+
+	BlockAction(ctx context.Context, req *BlockActionRequest) (r *BlockActionResponse, err error)
+
+	BlockList(ctx context.Context, req *BlockListRequest) (r *BlockListResponse, err error)
+
+	CheckBlock(ctx context.Context, req *CheckBlockRequest) (r *CheckBlockResponse, err error)
+
+	UpdateFollowType(ctx context.Context, req *UpdateFollowTypeRequest) (r *UpdateFollowTypeResponse, err error)
+
+	SetFollowRemark(ctx context.Context, req *SetFollowRemarkRequest) (r *SetFollowRemarkResponse, err error)
+
+	GetFollowType(ctx context.Context, req *GetFollowTypeRequest) (r *GetFollowTypeResponse, err error)
+
+	GetFollowingIDs(ctx context.Context, req *GetFollowingIDsRequest) (r *GetFollowingIDsResponse, err error)
+
+	GetFollowerIDs(ctx context.Context, req *GetFollowerIDsRequest) (r *GetFollowerIDsResponse, err error)
+
+	FollowSuggestion(ctx context.Context, req *FollowSuggestionRequest) (r *FollowSuggestionResponse, err error)
+}
+
+type FollowServiceRelationServiceArgs struct {
+	Req *RelationServiceRequest `thrift:"req,1" frugal:"1,default,RelationServiceRequest" json:"req"`
+}
+
+func NewFollowServiceRelationServiceArgs() *FollowServiceRelationServiceArgs {
+	return &FollowServiceRelationServiceArgs{}
+}
+
+func (p *FollowServiceRelationServiceArgs) InitDefault() {
+}
+
+var FollowServiceRelationServiceArgs_Req_DEFAULT *RelationServiceRequest
+
+func (p *FollowServiceRelationServiceArgs) GetReq() (v *RelationServiceRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceRelationServiceArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceRelationServiceArgs) SetReq(val *RelationServiceRequest) {
+	p.Req = val
+}
+
+func (p *FollowServiceRelationServiceArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceRelationServiceArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceRelationServiceArgs(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceRelationServiceArgs = map[int16]string{
+	1: "req",
+}
+
+type FollowServiceRelationServiceResult struct {
+	Success *RelationServiceResponse `thrift:"success,0,optional" frugal:"0,optional,RelationServiceResponse" json:"success,omitempty"`
+}
+
+func NewFollowServiceRelationServiceResult() *FollowServiceRelationServiceResult {
+	return &FollowServiceRelationServiceResult{}
+}
+
+func (p *FollowServiceRelationServiceResult) InitDefault() {
+}
+
+var FollowServiceRelationServiceResult_Success_DEFAULT *RelationServiceResponse
+
+func (p *FollowServiceRelationServiceResult) GetSuccess() (v *RelationServiceResponse) {
+	if !p.IsSetSuccess() {
+		return FollowServiceRelationServiceResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FollowServiceRelationServiceResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RelationServiceResponse)
+}
+
+func (p *FollowServiceRelationServiceResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FollowServiceRelationServiceResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceRelationServiceResult(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceRelationServiceResult = map[int16]string{
+	0: "success",
+}
+
+type FollowServiceFollowingListArgs struct {
+	Req *FollowingListRequest `thrift:"req,1" frugal:"1,default,FollowingListRequest" json:"req"`
+}
+
+func NewFollowServiceFollowingListArgs() *FollowServiceFollowingListArgs {
+	return &FollowServiceFollowingListArgs{}
+}
+
+func (p *FollowServiceFollowingListArgs) InitDefault() {
+}
+
+var FollowServiceFollowingListArgs_Req_DEFAULT *FollowingListRequest
+
+func (p *FollowServiceFollowingListArgs) GetReq() (v *FollowingListRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceFollowingListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceFollowingListArgs) SetReq(val *FollowingListRequest) {
+	p.Req = val
+}
+
+func (p *FollowServiceFollowingListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceFollowingListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceFollowingListArgs(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceFollowingListArgs = map[int16]string{
+	1: "req",
+}
+
+type FollowServiceFollowingListResult struct {
+	Success *FollowingListResponse `thrift:"success,0,optional" frugal:"0,optional,FollowingListResponse" json:"success,omitempty"`
+}
+
+func NewFollowServiceFollowingListResult() *FollowServiceFollowingListResult {
+	return &FollowServiceFollowingListResult{}
+}
+
+func (p *FollowServiceFollowingListResult) InitDefault() {
+}
+
+var FollowServiceFollowingListResult_Success_DEFAULT *FollowingListResponse
+
+func (p *FollowServiceFollowingListResult) GetSuccess() (v *FollowingListResponse) {
+	if !p.IsSetSuccess() {
+		return FollowServiceFollowingListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FollowServiceFollowingListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FollowingListResponse)
+}
+
+func (p *FollowServiceFollowingListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FollowServiceFollowingListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceFollowingListResult(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceFollowingListResult = map[int16]string{
+	0: "success",
+}
+
+type FollowServiceFollowerListArgs struct {
+	Req *FollowerListRequest `thrift:"req,1" frugal:"1,default,FollowerListRequest" json:"req"`
+}
+
+func NewFollowServiceFollowerListArgs() *FollowServiceFollowerListArgs {
+	return &FollowServiceFollowerListArgs{}
+}
+
+func (p *FollowServiceFollowerListArgs) InitDefault() {
+}
+
+var FollowServiceFollowerListArgs_Req_DEFAULT *FollowerListRequest
+
+func (p *FollowServiceFollowerListArgs) GetReq() (v *FollowerListRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceFollowerListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceFollowerListArgs) SetReq(val *FollowerListRequest) {
+	p.Req = val
+}
+
+func (p *FollowServiceFollowerListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceFollowerListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceFollowerListArgs(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceFollowerListArgs = map[int16]string{
+	1: "req",
+}
+
+type FollowServiceFollowerListResult struct {
+	Success *FollowerListResponse `thrift:"success,0,optional" frugal:"0,optional,FollowerListResponse" json:"success,omitempty"`
+}
+
+func NewFollowServiceFollowerListResult() *FollowServiceFollowerListResult {
+	return &FollowServiceFollowerListResult{}
+}
+
+func (p *FollowServiceFollowerListResult) InitDefault() {
+}
+
+var FollowServiceFollowerListResult_Success_DEFAULT *FollowerListResponse
+
+func (p *FollowServiceFollowerListResult) GetSuccess() (v *FollowerListResponse) {
+	if !p.IsSetSuccess() {
+		return FollowServiceFollowerListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FollowServiceFollowerListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FollowerListResponse)
+}
+
+func (p *FollowServiceFollowerListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FollowServiceFollowerListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceFollowerListResult(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceFollowerListResult = map[int16]string{
+	0: "success",
+}
+
+type FollowServiceFriendListArgs struct {
+	Req *FriendListRequest `thrift:"req,1" frugal:"1,default,FriendListRequest" json:"req"`
+}
+
+func NewFollowServiceFriendListArgs() *FollowServiceFriendListArgs {
+	return &FollowServiceFriendListArgs{}
+}
+
+func (p *FollowServiceFriendListArgs) InitDefault() {
+}
+
+var FollowServiceFriendListArgs_Req_DEFAULT *FriendListRequest
+
+func (p *FollowServiceFriendListArgs) GetReq() (v *FriendListRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceFriendListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceFriendListArgs) SetReq(val *FriendListRequest) {
+	p.Req = val
+}
+
+func (p *FollowServiceFriendListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceFriendListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceFriendListArgs(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceFriendListArgs = map[int16]string{
+	1: "req",
+}
+
+type FollowServiceFriendListResult struct {
+	Success *FriendListResponse `thrift:"success,0,optional" frugal:"0,optional,FriendListResponse" json:"success,omitempty"`
+}
+
+func NewFollowServiceFriendListResult() *FollowServiceFriendListResult {
+	return &FollowServiceFriendListResult{}
+}
+
+func (p *FollowServiceFriendListResult) InitDefault() {
+}
+
+var FollowServiceFriendListResult_Success_DEFAULT *FriendListResponse
+
+func (p *FollowServiceFriendListResult) GetSuccess() (v *FriendListResponse) {
+	if !p.IsSetSuccess() {
+		return FollowServiceFriendListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FollowServiceFriendListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FriendListResponse)
+}
+
+func (p *FollowServiceFriendListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FollowServiceFriendListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceFriendListResult(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceFriendListResult = map[int16]string{
+	0: "success",
+}
+
+type FollowServiceFollowRequestListArgs struct {
+	Req *FollowRequestListRequest `thrift:"req,1" frugal:"1,default,FollowRequestListRequest" json:"req"`
+}
+
+func NewFollowServiceFollowRequestListArgs() *FollowServiceFollowRequestListArgs {
+	return &FollowServiceFollowRequestListArgs{}
+}
+
+func (p *FollowServiceFollowRequestListArgs) InitDefault() {
+}
+
+var FollowServiceFollowRequestListArgs_Req_DEFAULT *FollowRequestListRequest
+
+func (p *FollowServiceFollowRequestListArgs) GetReq() (v *FollowRequestListRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceFollowRequestListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceFollowRequestListArgs) SetReq(val *FollowRequestListRequest) {
+	p.Req = val
+}
+
+func (p *FollowServiceFollowRequestListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceFollowRequestListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceFollowRequestListArgs(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceFollowRequestListArgs = map[int16]string{
+	1: "req",
+}
+
+type FollowServiceFollowRequestListResult struct {
+	Success *FollowRequestListResponse `thrift:"success,0,optional" frugal:"0,optional,FollowRequestListResponse" json:"success,omitempty"`
+}
+
+func NewFollowServiceFollowRequestListResult() *FollowServiceFollowRequestListResult {
+	return &FollowServiceFollowRequestListResult{}
+}
+
+func (p *FollowServiceFollowRequestListResult) InitDefault() {
+}
+
+var FollowServiceFollowRequestListResult_Success_DEFAULT *FollowRequestListResponse
+
+func (p *FollowServiceFollowRequestListResult) GetSuccess() (v *FollowRequestListResponse) {
+	if !p.IsSetSuccess() {
+		return FollowServiceFollowRequestListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FollowServiceFollowRequestListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FollowRequestListResponse)
+}
+
+func (p *FollowServiceFollowRequestListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FollowServiceFollowRequestListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceFollowRequestListResult(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceFollowRequestListResult = map[int16]string{
+	0: "success",
+}
+
+type FollowServiceAcceptFollowRequestArgs struct {
+	Req *AcceptFollowRequestRequest `thrift:"req,1" frugal:"1,default,AcceptFollowRequestRequest" json:"req"`
+}
+
+func NewFollowServiceAcceptFollowRequestArgs() *FollowServiceAcceptFollowRequestArgs {
+	return &FollowServiceAcceptFollowRequestArgs{}
+}
+
+func (p *FollowServiceAcceptFollowRequestArgs) InitDefault() {
+}
+
+var FollowServiceAcceptFollowRequestArgs_Req_DEFAULT *AcceptFollowRequestRequest
+
+func (p *FollowServiceAcceptFollowRequestArgs) GetReq() (v *AcceptFollowRequestRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceAcceptFollowRequestArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceAcceptFollowRequestArgs) SetReq(val *AcceptFollowRequestRequest) {
+	p.Req = val
+}
+
+func (p *FollowServiceAcceptFollowRequestArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceAcceptFollowRequestArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceAcceptFollowRequestArgs(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceAcceptFollowRequestArgs = map[int16]string{
+	1: "req",
+}
+
+type FollowServiceAcceptFollowRequestResult struct {
+	Success *AcceptFollowRequestResponse `thrift:"success,0,optional" frugal:"0,optional,AcceptFollowRequestResponse" json:"success,omitempty"`
+}
+
+func NewFollowServiceAcceptFollowRequestResult() *FollowServiceAcceptFollowRequestResult {
+	return &FollowServiceAcceptFollowRequestResult{}
+}
+
+func (p *FollowServiceAcceptFollowRequestResult) InitDefault() {
+}
+
+var FollowServiceAcceptFollowRequestResult_Success_DEFAULT *AcceptFollowRequestResponse
+
+func (p *FollowServiceAcceptFollowRequestResult) GetSuccess() (v *AcceptFollowRequestResponse) {
+	if !p.IsSetSuccess() {
+		return FollowServiceAcceptFollowRequestResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FollowServiceAcceptFollowRequestResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AcceptFollowRequestResponse)
+}
+
+func (p *FollowServiceAcceptFollowRequestResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FollowServiceAcceptFollowRequestResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceAcceptFollowRequestResult(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceAcceptFollowRequestResult = map[int16]string{
+	0: "success",
+}
+
+type FollowServiceRejectFollowRequestArgs struct {
+	Req *RejectFollowRequestRequest `thrift:"req,1" frugal:"1,default,RejectFollowRequestRequest" json:"req"`
+}
+
+func NewFollowServiceRejectFollowRequestArgs() *FollowServiceRejectFollowRequestArgs {
+	return &FollowServiceRejectFollowRequestArgs{}
+}
+
+func (p *FollowServiceRejectFollowRequestArgs) InitDefault() {
+}
+
+var FollowServiceRejectFollowRequestArgs_Req_DEFAULT *RejectFollowRequestRequest
+
+func (p *FollowServiceRejectFollowRequestArgs) GetReq() (v *RejectFollowRequestRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceRejectFollowRequestArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceRejectFollowRequestArgs) SetReq(val *RejectFollowRequestRequest) {
+	p.Req = val
+}
+
+func (p *FollowServiceRejectFollowRequestArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceRejectFollowRequestArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceRejectFollowRequestArgs(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceRejectFollowRequestArgs = map[int16]string{
+	1: "req",
+}
+
+type FollowServiceRejectFollowRequestResult struct {
+	Success *RejectFollowRequestResponse `thrift:"success,0,optional" frugal:"0,optional,RejectFollowRequestResponse" json:"success,omitempty"`
+}
+
+func NewFollowServiceRejectFollowRequestResult() *FollowServiceRejectFollowRequestResult {
+	return &FollowServiceRejectFollowRequestResult{}
+}
+
+func (p *FollowServiceRejectFollowRequestResult) InitDefault() {
+}
+
+var FollowServiceRejectFollowRequestResult_Success_DEFAULT *RejectFollowRequestResponse
+
+func (p *FollowServiceRejectFollowRequestResult) GetSuccess() (v *RejectFollowRequestResponse) {
+	if !p.IsSetSuccess() {
+		return FollowServiceRejectFollowRequestResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FollowServiceRejectFollowRequestResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RejectFollowRequestResponse)
+}
+
+func (p *FollowServiceRejectFollowRequestResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FollowServiceRejectFollowRequestResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceRejectFollowRequestResult(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceRejectFollowRequestResult = map[int16]string{
+	0: "success",
+}
+
+type FollowServiceBlockActionArgs struct {
+	Req *BlockActionRequest `thrift:"req,1" frugal:"1,default,BlockActionRequest" json:"req"`
+}
+
+func NewFollowServiceBlockActionArgs() *FollowServiceBlockActionArgs {
+	return &FollowServiceBlockActionArgs{}
+}
+
+func (p *FollowServiceBlockActionArgs) InitDefault() {
+}
+
+var FollowServiceBlockActionArgs_Req_DEFAULT *BlockActionRequest
+
+func (p *FollowServiceBlockActionArgs) GetReq() (v *BlockActionRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceBlockActionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceBlockActionArgs) SetReq(val *BlockActionRequest) {
+	p.Req = val
+}
+
+func (p *FollowServiceBlockActionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceBlockActionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceBlockActionArgs(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceBlockActionArgs = map[int16]string{
+	1: "req",
+}
+
+type FollowServiceBlockActionResult struct {
+	Success *BlockActionResponse `thrift:"success,0,optional" frugal:"0,optional,BlockActionResponse" json:"success,omitempty"`
+}
+
+func NewFollowServiceBlockActionResult() *FollowServiceBlockActionResult {
+	return &FollowServiceBlockActionResult{}
+}
+
+func (p *FollowServiceBlockActionResult) InitDefault() {
+}
+
+var FollowServiceBlockActionResult_Success_DEFAULT *BlockActionResponse
+
+func (p *FollowServiceBlockActionResult) GetSuccess() (v *BlockActionResponse) {
+	if !p.IsSetSuccess() {
+		return FollowServiceBlockActionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FollowServiceBlockActionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BlockActionResponse)
+}
+
+func (p *FollowServiceBlockActionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FollowServiceBlockActionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceBlockActionResult(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceBlockActionResult = map[int16]string{
+	0: "success",
+}
+
+type FollowServiceBlockListArgs struct {
+	Req *BlockListRequest `thrift:"req,1" frugal:"1,default,BlockListRequest" json:"req"`
+}
+
+func NewFollowServiceBlockListArgs() *FollowServiceBlockListArgs {
+	return &FollowServiceBlockListArgs{}
+}
+
+func (p *FollowServiceBlockListArgs) InitDefault() {
+}
+
+var FollowServiceBlockListArgs_Req_DEFAULT *BlockListRequest
+
+func (p *FollowServiceBlockListArgs) GetReq() (v *BlockListRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceBlockListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceBlockListArgs) SetReq(val *BlockListRequest) {
+	p.Req = val
+}
+
+func (p *FollowServiceBlockListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceBlockListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceBlockListArgs(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceBlockListArgs = map[int16]string{
+	1: "req",
+}
+
+type FollowServiceBlockListResult struct {
+	Success *BlockListResponse `thrift:"success,0,optional" frugal:"0,optional,BlockListResponse" json:"success,omitempty"`
+}
+
+func NewFollowServiceBlockListResult() *FollowServiceBlockListResult {
+	return &FollowServiceBlockListResult{}
+}
+
+func (p *FollowServiceBlockListResult) InitDefault() {
+}
+
+var FollowServiceBlockListResult_Success_DEFAULT *BlockListResponse
+
+func (p *FollowServiceBlockListResult) GetSuccess() (v *BlockListResponse) {
+	if !p.IsSetSuccess() {
+		return FollowServiceBlockListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FollowServiceBlockListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BlockListResponse)
+}
+
+func (p *FollowServiceBlockListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FollowServiceBlockListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceBlockListResult(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceBlockListResult = map[int16]string{
+	0: "success",
+}
+
+type FollowServiceCheckBlockArgs struct {
+	Req *CheckBlockRequest `thrift:"req,1" frugal:"1,default,CheckBlockRequest" json:"req"`
+}
+
+func NewFollowServiceCheckBlockArgs() *FollowServiceCheckBlockArgs {
+	return &FollowServiceCheckBlockArgs{}
+}
+
+func (p *FollowServiceCheckBlockArgs) InitDefault() {
+}
+
+var FollowServiceCheckBlockArgs_Req_DEFAULT *CheckBlockRequest
+
+func (p *FollowServiceCheckBlockArgs) GetReq() (v *CheckBlockRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceCheckBlockArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceCheckBlockArgs) SetReq(val *CheckBlockRequest) {
+	p.Req = val
+}
+
+func (p *FollowServiceCheckBlockArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceCheckBlockArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceCheckBlockArgs(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceCheckBlockArgs = map[int16]string{
+	1: "req",
+}
+
+type FollowServiceCheckBlockResult struct {
+	Success *CheckBlockResponse `thrift:"success,0,optional" frugal:"0,optional,CheckBlockResponse" json:"success,omitempty"`
+}
+
+func NewFollowServiceCheckBlockResult() *FollowServiceCheckBlockResult {
+	return &FollowServiceCheckBlockResult{}
+}
+
+func (p *FollowServiceCheckBlockResult) InitDefault() {
+}
+
+var FollowServiceCheckBlockResult_Success_DEFAULT *CheckBlockResponse
+
+func (p *FollowServiceCheckBlockResult) GetSuccess() (v *CheckBlockResponse) {
+	if !p.IsSetSuccess() {
+		return FollowServiceCheckBlockResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FollowServiceCheckBlockResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CheckBlockResponse)
+}
+
+func (p *FollowServiceCheckBlockResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FollowServiceCheckBlockResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceCheckBlockResult(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceCheckBlockResult = map[int16]string{
+	0: "success",
+}
+
+type FollowServiceUpdateFollowTypeArgs struct {
+	Req *UpdateFollowTypeRequest `thrift:"req,1" frugal:"1,default,UpdateFollowTypeRequest" json:"req"`
+}
+
+func NewFollowServiceUpdateFollowTypeArgs() *FollowServiceUpdateFollowTypeArgs {
+	return &FollowServiceUpdateFollowTypeArgs{}
+}
+
+func (p *FollowServiceUpdateFollowTypeArgs) InitDefault() {
+}
+
+var FollowServiceUpdateFollowTypeArgs_Req_DEFAULT *UpdateFollowTypeRequest
+
+func (p *FollowServiceUpdateFollowTypeArgs) GetReq() (v *UpdateFollowTypeRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceUpdateFollowTypeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceUpdateFollowTypeArgs) SetReq(val *UpdateFollowTypeRequest) {
+	p.Req = val
+}
+
+func (p *FollowServiceUpdateFollowTypeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceUpdateFollowTypeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceUpdateFollowTypeArgs(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceUpdateFollowTypeArgs = map[int16]string{
+	1: "req",
+}
+
+type FollowServiceUpdateFollowTypeResult struct {
+	Success *UpdateFollowTypeResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateFollowTypeResponse" json:"success,omitempty"`
 }
 
-var fieldIDToName_FollowServiceFriendListArgs = map[int16]string{
+func NewFollowServiceUpdateFollowTypeResult() *FollowServiceUpdateFollowTypeResult {
+	return &FollowServiceUpdateFollowTypeResult{}
+}
+
+func (p *FollowServiceUpdateFollowTypeResult) InitDefault() {
+}
+
+var FollowServiceUpdateFollowTypeResult_Success_DEFAULT *UpdateFollowTypeResponse
+
+func (p *FollowServiceUpdateFollowTypeResult) GetSuccess() (v *UpdateFollowTypeResponse) {
+	if !p.IsSetSuccess() {
+		return FollowServiceUpdateFollowTypeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FollowServiceUpdateFollowTypeResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateFollowTypeResponse)
+}
+
+func (p *FollowServiceUpdateFollowTypeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FollowServiceUpdateFollowTypeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceUpdateFollowTypeResult(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceUpdateFollowTypeResult = map[int16]string{
+	0: "success",
+}
+
+type FollowServiceSetFollowRemarkArgs struct {
+	Req *SetFollowRemarkRequest `thrift:"req,1" frugal:"1,default,SetFollowRemarkRequest" json:"req"`
+}
+
+func NewFollowServiceSetFollowRemarkArgs() *FollowServiceSetFollowRemarkArgs {
+	return &FollowServiceSetFollowRemarkArgs{}
+}
+
+func (p *FollowServiceSetFollowRemarkArgs) InitDefault() {
+}
+
+var FollowServiceSetFollowRemarkArgs_Req_DEFAULT *SetFollowRemarkRequest
+
+func (p *FollowServiceSetFollowRemarkArgs) GetReq() (v *SetFollowRemarkRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceSetFollowRemarkArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceSetFollowRemarkArgs) SetReq(val *SetFollowRemarkRequest) {
+	p.Req = val
+}
+
+func (p *FollowServiceSetFollowRemarkArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceSetFollowRemarkArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceSetFollowRemarkArgs(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceSetFollowRemarkArgs = map[int16]string{
 	1: "req",
 }
 
-type FollowServiceFriendListResult struct {
-	Success *FriendListResponse `thrift:"success,0,optional" frugal:"0,optional,FriendListResponse" json:"success,omitempty"`
+type FollowServiceSetFollowRemarkResult struct {
+	Success *SetFollowRemarkResponse `thrift:"success,0,optional" frugal:"0,optional,SetFollowRemarkResponse" json:"success,omitempty"`
 }
 
-func NewFollowServiceFriendListResult() *FollowServiceFriendListResult {
-	return &FollowServiceFriendListResult{}
+func NewFollowServiceSetFollowRemarkResult() *FollowServiceSetFollowRemarkResult {
+	return &FollowServiceSetFollowRemarkResult{}
 }
 
-func (p *FollowServiceFriendListResult) InitDefault() {
+func (p *FollowServiceSetFollowRemarkResult) InitDefault() {
 }
 
-var FollowServiceFriendListResult_Success_DEFAULT *FriendListResponse
+var FollowServiceSetFollowRemarkResult_Success_DEFAULT *SetFollowRemarkResponse
 
-func (p *FollowServiceFriendListResult) GetSuccess() (v *FriendListResponse) {
+func (p *FollowServiceSetFollowRemarkResult) GetSuccess() (v *SetFollowRemarkResponse) {
 	if !p.IsSetSuccess() {
-		return FollowServiceFriendListResult_Success_DEFAULT
+		return FollowServiceSetFollowRemarkResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *FollowServiceFriendListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*FriendListResponse)
+func (p *FollowServiceSetFollowRemarkResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SetFollowRemarkResponse)
 }
 
-func (p *FollowServiceFriendListResult) IsSetSuccess() bool {
+func (p *FollowServiceSetFollowRemarkResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *FollowServiceFriendListResult) String() string {
+func (p *FollowServiceSetFollowRemarkResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceFriendListResult(%+v)", *p)
+	return fmt.Sprintf("FollowServiceSetFollowRemarkResult(%+v)", *p)
 }
 
-var fieldIDToName_FollowServiceFriendListResult = map[int16]string{
+var fieldIDToName_FollowServiceSetFollowRemarkResult = map[int16]string{
+	0: "success",
+}
+
+type FollowServiceGetFollowTypeArgs struct {
+	Req *GetFollowTypeRequest `thrift:"req,1" frugal:"1,default,GetFollowTypeRequest" json:"req"`
+}
+
+func NewFollowServiceGetFollowTypeArgs() *FollowServiceGetFollowTypeArgs {
+	return &FollowServiceGetFollowTypeArgs{}
+}
+
+func (p *FollowServiceGetFollowTypeArgs) InitDefault() {
+}
+
+var FollowServiceGetFollowTypeArgs_Req_DEFAULT *GetFollowTypeRequest
+
+func (p *FollowServiceGetFollowTypeArgs) GetReq() (v *GetFollowTypeRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceGetFollowTypeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceGetFollowTypeArgs) SetReq(val *GetFollowTypeRequest) {
+	p.Req = val
+}
+
+func (p *FollowServiceGetFollowTypeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceGetFollowTypeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceGetFollowTypeArgs(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceGetFollowTypeArgs = map[int16]string{
+	1: "req",
+}
+
+type FollowServiceGetFollowTypeResult struct {
+	Success *GetFollowTypeResponse `thrift:"success,0,optional" frugal:"0,optional,GetFollowTypeResponse" json:"success,omitempty"`
+}
+
+func NewFollowServiceGetFollowTypeResult() *FollowServiceGetFollowTypeResult {
+	return &FollowServiceGetFollowTypeResult{}
+}
+
+func (p *FollowServiceGetFollowTypeResult) InitDefault() {
+}
+
+var FollowServiceGetFollowTypeResult_Success_DEFAULT *GetFollowTypeResponse
+
+func (p *FollowServiceGetFollowTypeResult) GetSuccess() (v *GetFollowTypeResponse) {
+	if !p.IsSetSuccess() {
+		return FollowServiceGetFollowTypeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FollowServiceGetFollowTypeResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetFollowTypeResponse)
+}
+
+func (p *FollowServiceGetFollowTypeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FollowServiceGetFollowTypeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceGetFollowTypeResult(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceGetFollowTypeResult = map[int16]string{
+	0: "success",
+}
+
+type FollowServiceGetFollowingIDsArgs struct {
+	Req *GetFollowingIDsRequest `thrift:"req,1" frugal:"1,default,GetFollowingIDsRequest" json:"req"`
+}
+
+func NewFollowServiceGetFollowingIDsArgs() *FollowServiceGetFollowingIDsArgs {
+	return &FollowServiceGetFollowingIDsArgs{}
+}
+
+func (p *FollowServiceGetFollowingIDsArgs) InitDefault() {
+}
+
+var FollowServiceGetFollowingIDsArgs_Req_DEFAULT *GetFollowingIDsRequest
+
+func (p *FollowServiceGetFollowingIDsArgs) GetReq() (v *GetFollowingIDsRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceGetFollowingIDsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceGetFollowingIDsArgs) SetReq(val *GetFollowingIDsRequest) {
+	p.Req = val
+}
+
+func (p *FollowServiceGetFollowingIDsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceGetFollowingIDsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceGetFollowingIDsArgs(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceGetFollowingIDsArgs = map[int16]string{
+	1: "req",
+}
+
+type FollowServiceGetFollowingIDsResult struct {
+	Success *GetFollowingIDsResponse `thrift:"success,0,optional" frugal:"0,optional,GetFollowingIDsResponse" json:"success,omitempty"`
+}
+
+func NewFollowServiceGetFollowingIDsResult() *FollowServiceGetFollowingIDsResult {
+	return &FollowServiceGetFollowingIDsResult{}
+}
+
+func (p *FollowServiceGetFollowingIDsResult) InitDefault() {
+}
+
+var FollowServiceGetFollowingIDsResult_Success_DEFAULT *GetFollowingIDsResponse
+
+func (p *FollowServiceGetFollowingIDsResult) GetSuccess() (v *GetFollowingIDsResponse) {
+	if !p.IsSetSuccess() {
+		return FollowServiceGetFollowingIDsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FollowServiceGetFollowingIDsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetFollowingIDsResponse)
+}
+
+func (p *FollowServiceGetFollowingIDsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FollowServiceGetFollowingIDsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceGetFollowingIDsResult(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceGetFollowingIDsResult = map[int16]string{
+	0: "success",
+}
+
+type FollowServiceGetFollowerIDsArgs struct {
+	Req *GetFollowerIDsRequest `thrift:"req,1" frugal:"1,default,GetFollowerIDsRequest" json:"req"`
+}
+
+func NewFollowServiceGetFollowerIDsArgs() *FollowServiceGetFollowerIDsArgs {
+	return &FollowServiceGetFollowerIDsArgs{}
+}
+
+func (p *FollowServiceGetFollowerIDsArgs) InitDefault() {
+}
+
+var FollowServiceGetFollowerIDsArgs_Req_DEFAULT *GetFollowerIDsRequest
+
+func (p *FollowServiceGetFollowerIDsArgs) GetReq() (v *GetFollowerIDsRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceGetFollowerIDsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceGetFollowerIDsArgs) SetReq(val *GetFollowerIDsRequest) {
+	p.Req = val
+}
+
+func (p *FollowServiceGetFollowerIDsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceGetFollowerIDsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceGetFollowerIDsArgs(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceGetFollowerIDsArgs = map[int16]string{
+	1: "req",
+}
+
+type FollowServiceGetFollowerIDsResult struct {
+	Success *GetFollowerIDsResponse `thrift:"success,0,optional" frugal:"0,optional,GetFollowerIDsResponse" json:"success,omitempty"`
+}
+
+func NewFollowServiceGetFollowerIDsResult() *FollowServiceGetFollowerIDsResult {
+	return &FollowServiceGetFollowerIDsResult{}
+}
+
+func (p *FollowServiceGetFollowerIDsResult) InitDefault() {
+}
+
+var FollowServiceGetFollowerIDsResult_Success_DEFAULT *GetFollowerIDsResponse
+
+func (p *FollowServiceGetFollowerIDsResult) GetSuccess() (v *GetFollowerIDsResponse) {
+	if !p.IsSetSuccess() {
+		return FollowServiceGetFollowerIDsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FollowServiceGetFollowerIDsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetFollowerIDsResponse)
+}
+
+func (p *FollowServiceGetFollowerIDsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FollowServiceGetFollowerIDsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceGetFollowerIDsResult(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceGetFollowerIDsResult = map[int16]string{
+	0: "success",
+}
+
+type FollowServiceFollowSuggestionArgs struct {
+	Req *FollowSuggestionRequest `thrift:"req,1" frugal:"1,default,FollowSuggestionRequest" json:"req"`
+}
+
+func NewFollowServiceFollowSuggestionArgs() *FollowServiceFollowSuggestionArgs {
+	return &FollowServiceFollowSuggestionArgs{}
+}
+
+func (p *FollowServiceFollowSuggestionArgs) InitDefault() {
+}
+
+var FollowServiceFollowSuggestionArgs_Req_DEFAULT *FollowSuggestionRequest
+
+func (p *FollowServiceFollowSuggestionArgs) GetReq() (v *FollowSuggestionRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceFollowSuggestionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceFollowSuggestionArgs) SetReq(val *FollowSuggestionRequest) {
+	p.Req = val
+}
+
+func (p *FollowServiceFollowSuggestionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceFollowSuggestionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceFollowSuggestionArgs(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceFollowSuggestionArgs = map[int16]string{
+	1: "req",
+}
+
+type FollowServiceFollowSuggestionResult struct {
+	Success *FollowSuggestionResponse `thrift:"success,0,optional" frugal:"0,optional,FollowSuggestionResponse" json:"success,omitempty"`
+}
+
+func NewFollowServiceFollowSuggestionResult() *FollowServiceFollowSuggestionResult {
+	return &FollowServiceFollowSuggestionResult{}
+}
+
+func (p *FollowServiceFollowSuggestionResult) InitDefault() {
+}
+
+var FollowServiceFollowSuggestionResult_Success_DEFAULT *FollowSuggestionResponse
+
+func (p *FollowServiceFollowSuggestionResult) GetSuccess() (v *FollowSuggestionResponse) {
+	if !p.IsSetSuccess() {
+		return FollowServiceFollowSuggestionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FollowServiceFollowSuggestionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FollowSuggestionResponse)
+}
+
+func (p *FollowServiceFollowSuggestionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FollowServiceFollowSuggestionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceFollowSuggestionResult(%+v)", *p)
+}
+
+var fieldIDToName_FollowServiceFollowSuggestionResult = map[int16]string{
 	0: "success",
 }
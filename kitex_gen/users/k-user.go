@@ -4210,6 +4210,1099 @@ func (p *ChangePasswordResponse) field1Length() int {
 	return l
 }
 
+func (p *UpdateProfileRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateProfileRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UpdateProfileRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.UserId = _field
+	return offset, nil
+}
+
+func (p *UpdateProfileRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Bio = _field
+	return offset, nil
+}
+
+func (p *UpdateProfileRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Website = _field
+	return offset, nil
+}
+
+func (p *UpdateProfileRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Birthday = _field
+	return offset, nil
+}
+
+func (p *UpdateProfileRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UpdateProfileRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UpdateProfileRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UpdateProfileRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.UserId)
+	return offset
+}
+
+func (p *UpdateProfileRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBio() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Bio)
+	}
+	return offset
+}
+
+func (p *UpdateProfileRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetWebsite() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Website)
+	}
+	return offset
+}
+
+func (p *UpdateProfileRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBirthday() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Birthday)
+	}
+	return offset
+}
+
+func (p *UpdateProfileRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *UpdateProfileRequest) field2Length() int {
+	l := 0
+	if p.IsSetBio() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Bio)
+	}
+	return l
+}
+
+func (p *UpdateProfileRequest) field3Length() int {
+	l := 0
+	if p.IsSetWebsite() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Website)
+	}
+	return l
+}
+
+func (p *UpdateProfileRequest) field4Length() int {
+	l := 0
+	if p.IsSetBirthday() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Birthday)
+	}
+	return l
+}
+
+func (p *UpdateProfileResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateProfileResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UpdateProfileResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewStatus()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *UpdateProfileResponse) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewUser()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.User = _field
+	return offset, nil
+}
+
+func (p *UpdateProfileResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UpdateProfileResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UpdateProfileResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UpdateProfileResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *UpdateProfileResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+	offset += p.User.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *UpdateProfileResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Base.BLength()
+	return l
+}
+
+func (p *UpdateProfileResponse) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.User.BLength()
+	return l
+}
+
+func (p *UploadBackgroundRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UploadBackgroundRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UploadBackgroundRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.UserId = _field
+	return offset, nil
+}
+
+func (p *UploadBackgroundRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field []byte
+	if v, l, err := thrift.Binary.ReadBinary(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		_field = []byte(v)
+	}
+	p.Data = _field
+	return offset, nil
+}
+
+func (p *UploadBackgroundRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Filesize = _field
+	return offset, nil
+}
+
+func (p *UploadBackgroundRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UploadBackgroundRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UploadBackgroundRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UploadBackgroundRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.UserId)
+	return offset
+}
+
+func (p *UploadBackgroundRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+	offset += thrift.Binary.WriteBinaryNocopy(buf[offset:], w, []byte(p.Data))
+	return offset
+}
+
+func (p *UploadBackgroundRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.Filesize)
+	return offset
+}
+
+func (p *UploadBackgroundRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *UploadBackgroundRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.BinaryLengthNocopy([]byte(p.Data))
+	return l
+}
+
+func (p *UploadBackgroundRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *UploadBackgroundResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UploadBackgroundResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UploadBackgroundResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewStatus()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *UploadBackgroundResponse) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.BackgroundUrl = _field
+	return offset, nil
+}
+
+func (p *UploadBackgroundResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UploadBackgroundResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UploadBackgroundResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UploadBackgroundResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *UploadBackgroundResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.BackgroundUrl)
+	return offset
+}
+
+func (p *UploadBackgroundResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Base.BLength()
+	return l
+}
+
+func (p *UploadBackgroundResponse) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.BackgroundUrl)
+	return l
+}
+
+func (p *UpdatePrivacySettingsRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdatePrivacySettingsRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UpdatePrivacySettingsRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.UserId = _field
+	return offset, nil
+}
+
+func (p *UpdatePrivacySettingsRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.IsPrivate = _field
+	return offset, nil
+}
+
+func (p *UpdatePrivacySettingsRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.HideLikes = _field
+	return offset, nil
+}
+
+func (p *UpdatePrivacySettingsRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.HideFollowing = _field
+	return offset, nil
+}
+
+func (p *UpdatePrivacySettingsRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UpdatePrivacySettingsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UpdatePrivacySettingsRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UpdatePrivacySettingsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.UserId)
+	return offset
+}
+
+func (p *UpdatePrivacySettingsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetIsPrivate() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 2)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.IsPrivate)
+	}
+	return offset
+}
+
+func (p *UpdatePrivacySettingsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetHideLikes() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 3)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.HideLikes)
+	}
+	return offset
+}
+
+func (p *UpdatePrivacySettingsRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetHideFollowing() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 4)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.HideFollowing)
+	}
+	return offset
+}
+
+func (p *UpdatePrivacySettingsRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *UpdatePrivacySettingsRequest) field2Length() int {
+	l := 0
+	if p.IsSetIsPrivate() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *UpdatePrivacySettingsRequest) field3Length() int {
+	l := 0
+	if p.IsSetHideLikes() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *UpdatePrivacySettingsRequest) field4Length() int {
+	l := 0
+	if p.IsSetHideFollowing() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *UpdatePrivacySettingsResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdatePrivacySettingsResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UpdatePrivacySettingsResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewStatus()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *UpdatePrivacySettingsResponse) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewUser()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.User = _field
+	return offset, nil
+}
+
+func (p *UpdatePrivacySettingsResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UpdatePrivacySettingsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UpdatePrivacySettingsResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UpdatePrivacySettingsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *UpdatePrivacySettingsResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+	offset += p.User.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *UpdatePrivacySettingsResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Base.BLength()
+	return l
+}
+
+func (p *UpdatePrivacySettingsResponse) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.User.BLength()
+	return l
+}
+
 func (p *UserServiceUpdateUserArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
@@ -4254,14 +5347,608 @@ func (p *UserServiceUpdateUserArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateUserArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateUserArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UserServiceUpdateUserArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewUpdateUserRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *UserServiceUpdateUserArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UserServiceUpdateUserArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UserServiceUpdateUserArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UserServiceUpdateUserArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *UserServiceUpdateUserArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *UserServiceUpdateUserResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateUserResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UserServiceUpdateUserResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewUpdateUserResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *UserServiceUpdateUserResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UserServiceUpdateUserResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UserServiceUpdateUserResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UserServiceUpdateUserResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *UserServiceUpdateUserResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *UserServiceDeleteUserArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceDeleteUserArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UserServiceDeleteUserArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewDeleteUserRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *UserServiceDeleteUserArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UserServiceDeleteUserArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UserServiceDeleteUserArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UserServiceDeleteUserArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *UserServiceDeleteUserArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *UserServiceDeleteUserResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceDeleteUserResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UserServiceDeleteUserResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewDeleteUserResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *UserServiceDeleteUserResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UserServiceDeleteUserResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UserServiceDeleteUserResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UserServiceDeleteUserResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *UserServiceDeleteUserResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *UserServiceQueryUserArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceQueryUserArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UserServiceQueryUserArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewQueryUserRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *UserServiceQueryUserArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UserServiceQueryUserArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UserServiceQueryUserArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UserServiceQueryUserArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *UserServiceQueryUserArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *UserServiceQueryUserResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceQueryUserResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UserServiceQueryUserResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewQueryUserResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *UserServiceQueryUserResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UserServiceQueryUserResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UserServiceQueryUserResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UserServiceQueryUserResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *UserServiceQueryUserResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *UserServiceCreateUserArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceCreateUserArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceUpdateUserArgs) FastReadField1(buf []byte) (int, error) {
+func (p *UserServiceCreateUserArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateUserRequest()
+	_field := NewCreateUserRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4271,11 +5958,11 @@ func (p *UserServiceUpdateUserArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *UserServiceUpdateUserArgs) FastWrite(buf []byte) int {
+func (p *UserServiceCreateUserArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceUpdateUserArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceCreateUserArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -4284,7 +5971,7 @@ func (p *UserServiceUpdateUserArgs) FastWriteNocopy(buf []byte, w thrift.NocopyW
 	return offset
 }
 
-func (p *UserServiceUpdateUserArgs) BLength() int {
+func (p *UserServiceCreateUserArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -4293,21 +5980,21 @@ func (p *UserServiceUpdateUserArgs) BLength() int {
 	return l
 }
 
-func (p *UserServiceUpdateUserArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceCreateUserArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *UserServiceUpdateUserArgs) field1Length() int {
+func (p *UserServiceCreateUserArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *UserServiceUpdateUserResult) FastRead(buf []byte) (int, error) {
+func (p *UserServiceCreateUserResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4351,14 +6038,14 @@ func (p *UserServiceUpdateUserResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateUserResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceCreateUserResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceUpdateUserResult) FastReadField0(buf []byte) (int, error) {
+func (p *UserServiceCreateUserResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateUserResponse()
+	_field := NewCreateUserResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4368,11 +6055,11 @@ func (p *UserServiceUpdateUserResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *UserServiceUpdateUserResult) FastWrite(buf []byte) int {
+func (p *UserServiceCreateUserResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceUpdateUserResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceCreateUserResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -4381,7 +6068,7 @@ func (p *UserServiceUpdateUserResult) FastWriteNocopy(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *UserServiceUpdateUserResult) BLength() int {
+func (p *UserServiceCreateUserResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -4390,7 +6077,7 @@ func (p *UserServiceUpdateUserResult) BLength() int {
 	return l
 }
 
-func (p *UserServiceUpdateUserResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceCreateUserResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -4399,7 +6086,7 @@ func (p *UserServiceUpdateUserResult) fastWriteField0(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *UserServiceUpdateUserResult) field0Length() int {
+func (p *UserServiceCreateUserResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -4408,7 +6095,7 @@ func (p *UserServiceUpdateUserResult) field0Length() int {
 	return l
 }
 
-func (p *UserServiceDeleteUserArgs) FastRead(buf []byte) (int, error) {
+func (p *UserServiceLoginUserArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4452,14 +6139,14 @@ func (p *UserServiceDeleteUserArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceDeleteUserArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceLoginUserArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceDeleteUserArgs) FastReadField1(buf []byte) (int, error) {
+func (p *UserServiceLoginUserArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteUserRequest()
+	_field := NewLoginUserResquest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4469,11 +6156,11 @@ func (p *UserServiceDeleteUserArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *UserServiceDeleteUserArgs) FastWrite(buf []byte) int {
+func (p *UserServiceLoginUserArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceDeleteUserArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceLoginUserArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -4482,7 +6169,7 @@ func (p *UserServiceDeleteUserArgs) FastWriteNocopy(buf []byte, w thrift.NocopyW
 	return offset
 }
 
-func (p *UserServiceDeleteUserArgs) BLength() int {
+func (p *UserServiceLoginUserArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -4491,21 +6178,21 @@ func (p *UserServiceDeleteUserArgs) BLength() int {
 	return l
 }
 
-func (p *UserServiceDeleteUserArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceLoginUserArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *UserServiceDeleteUserArgs) field1Length() int {
+func (p *UserServiceLoginUserArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *UserServiceDeleteUserResult) FastRead(buf []byte) (int, error) {
+func (p *UserServiceLoginUserResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4549,14 +6236,14 @@ func (p *UserServiceDeleteUserResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceDeleteUserResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceLoginUserResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceDeleteUserResult) FastReadField0(buf []byte) (int, error) {
+func (p *UserServiceLoginUserResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteUserResponse()
+	_field := NewLoginUserResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4566,11 +6253,11 @@ func (p *UserServiceDeleteUserResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *UserServiceDeleteUserResult) FastWrite(buf []byte) int {
+func (p *UserServiceLoginUserResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceDeleteUserResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceLoginUserResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -4579,7 +6266,7 @@ func (p *UserServiceDeleteUserResult) FastWriteNocopy(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *UserServiceDeleteUserResult) BLength() int {
+func (p *UserServiceLoginUserResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -4588,7 +6275,7 @@ func (p *UserServiceDeleteUserResult) BLength() int {
 	return l
 }
 
-func (p *UserServiceDeleteUserResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceLoginUserResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -4597,7 +6284,7 @@ func (p *UserServiceDeleteUserResult) fastWriteField0(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *UserServiceDeleteUserResult) field0Length() int {
+func (p *UserServiceLoginUserResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -4606,7 +6293,7 @@ func (p *UserServiceDeleteUserResult) field0Length() int {
 	return l
 }
 
-func (p *UserServiceQueryUserArgs) FastRead(buf []byte) (int, error) {
+func (p *UserServiceGetUserInfoArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4650,14 +6337,14 @@ func (p *UserServiceQueryUserArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceQueryUserArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUserInfoArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceQueryUserArgs) FastReadField1(buf []byte) (int, error) {
+func (p *UserServiceGetUserInfoArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewQueryUserRequest()
+	_field := NewGetUserInfoRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4667,11 +6354,11 @@ func (p *UserServiceQueryUserArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *UserServiceQueryUserArgs) FastWrite(buf []byte) int {
+func (p *UserServiceGetUserInfoArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceQueryUserArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceGetUserInfoArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -4680,7 +6367,7 @@ func (p *UserServiceQueryUserArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWr
 	return offset
 }
 
-func (p *UserServiceQueryUserArgs) BLength() int {
+func (p *UserServiceGetUserInfoArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -4689,21 +6376,21 @@ func (p *UserServiceQueryUserArgs) BLength() int {
 	return l
 }
 
-func (p *UserServiceQueryUserArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceGetUserInfoArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *UserServiceQueryUserArgs) field1Length() int {
+func (p *UserServiceGetUserInfoArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *UserServiceQueryUserResult) FastRead(buf []byte) (int, error) {
+func (p *UserServiceGetUserInfoResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4747,14 +6434,14 @@ func (p *UserServiceQueryUserResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceQueryUserResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUserInfoResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceQueryUserResult) FastReadField0(buf []byte) (int, error) {
+func (p *UserServiceGetUserInfoResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewQueryUserResponse()
+	_field := NewGetUserInfoResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4764,11 +6451,11 @@ func (p *UserServiceQueryUserResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *UserServiceQueryUserResult) FastWrite(buf []byte) int {
+func (p *UserServiceGetUserInfoResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceQueryUserResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceGetUserInfoResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -4777,7 +6464,7 @@ func (p *UserServiceQueryUserResult) FastWriteNocopy(buf []byte, w thrift.Nocopy
 	return offset
 }
 
-func (p *UserServiceQueryUserResult) BLength() int {
+func (p *UserServiceGetUserInfoResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -4786,7 +6473,7 @@ func (p *UserServiceQueryUserResult) BLength() int {
 	return l
 }
 
-func (p *UserServiceQueryUserResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceGetUserInfoResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -4795,7 +6482,7 @@ func (p *UserServiceQueryUserResult) fastWriteField0(buf []byte, w thrift.Nocopy
 	return offset
 }
 
-func (p *UserServiceQueryUserResult) field0Length() int {
+func (p *UserServiceGetUserInfoResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -4804,7 +6491,7 @@ func (p *UserServiceQueryUserResult) field0Length() int {
 	return l
 }
 
-func (p *UserServiceCreateUserArgs) FastRead(buf []byte) (int, error) {
+func (p *UserServiceCheckUserExistsByIdArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4848,14 +6535,14 @@ func (p *UserServiceCreateUserArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceCreateUserArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceCheckUserExistsByIdArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceCreateUserArgs) FastReadField1(buf []byte) (int, error) {
+func (p *UserServiceCheckUserExistsByIdArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateUserRequest()
+	_field := NewCheckUserExistsByIdRequst()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4865,11 +6552,11 @@ func (p *UserServiceCreateUserArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *UserServiceCreateUserArgs) FastWrite(buf []byte) int {
+func (p *UserServiceCheckUserExistsByIdArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceCreateUserArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceCheckUserExistsByIdArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -4878,7 +6565,7 @@ func (p *UserServiceCreateUserArgs) FastWriteNocopy(buf []byte, w thrift.NocopyW
 	return offset
 }
 
-func (p *UserServiceCreateUserArgs) BLength() int {
+func (p *UserServiceCheckUserExistsByIdArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -4887,21 +6574,21 @@ func (p *UserServiceCreateUserArgs) BLength() int {
 	return l
 }
 
-func (p *UserServiceCreateUserArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceCheckUserExistsByIdArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *UserServiceCreateUserArgs) field1Length() int {
+func (p *UserServiceCheckUserExistsByIdArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *UserServiceCreateUserResult) FastRead(buf []byte) (int, error) {
+func (p *UserServiceCheckUserExistsByIdResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4945,14 +6632,14 @@ func (p *UserServiceCreateUserResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceCreateUserResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceCheckUserExistsByIdResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceCreateUserResult) FastReadField0(buf []byte) (int, error) {
+func (p *UserServiceCheckUserExistsByIdResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateUserResponse()
+	_field := NewCheckUserExistsByIdResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4962,11 +6649,11 @@ func (p *UserServiceCreateUserResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *UserServiceCreateUserResult) FastWrite(buf []byte) int {
+func (p *UserServiceCheckUserExistsByIdResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceCreateUserResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceCheckUserExistsByIdResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -4975,7 +6662,7 @@ func (p *UserServiceCreateUserResult) FastWriteNocopy(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *UserServiceCreateUserResult) BLength() int {
+func (p *UserServiceCheckUserExistsByIdResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -4984,7 +6671,7 @@ func (p *UserServiceCreateUserResult) BLength() int {
 	return l
 }
 
-func (p *UserServiceCreateUserResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceCheckUserExistsByIdResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -4993,7 +6680,7 @@ func (p *UserServiceCreateUserResult) fastWriteField0(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *UserServiceCreateUserResult) field0Length() int {
+func (p *UserServiceCheckUserExistsByIdResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -5002,7 +6689,7 @@ func (p *UserServiceCreateUserResult) field0Length() int {
 	return l
 }
 
-func (p *UserServiceLoginUserArgs) FastRead(buf []byte) (int, error) {
+func (p *UserServiceVerifyCodeArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5046,14 +6733,14 @@ func (p *UserServiceLoginUserArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceLoginUserArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceVerifyCodeArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceLoginUserArgs) FastReadField1(buf []byte) (int, error) {
+func (p *UserServiceVerifyCodeArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewLoginUserResquest()
+	_field := NewVerifyCodeRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -5063,11 +6750,11 @@ func (p *UserServiceLoginUserArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *UserServiceLoginUserArgs) FastWrite(buf []byte) int {
+func (p *UserServiceVerifyCodeArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceLoginUserArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceVerifyCodeArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -5076,7 +6763,7 @@ func (p *UserServiceLoginUserArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWr
 	return offset
 }
 
-func (p *UserServiceLoginUserArgs) BLength() int {
+func (p *UserServiceVerifyCodeArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -5085,21 +6772,21 @@ func (p *UserServiceLoginUserArgs) BLength() int {
 	return l
 }
 
-func (p *UserServiceLoginUserArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceVerifyCodeArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *UserServiceLoginUserArgs) field1Length() int {
+func (p *UserServiceVerifyCodeArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *UserServiceLoginUserResult) FastRead(buf []byte) (int, error) {
+func (p *UserServiceVerifyCodeResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5143,14 +6830,14 @@ func (p *UserServiceLoginUserResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceLoginUserResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceVerifyCodeResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceLoginUserResult) FastReadField0(buf []byte) (int, error) {
+func (p *UserServiceVerifyCodeResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewLoginUserResponse()
+	_field := NewVerifyCodeResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -5160,11 +6847,11 @@ func (p *UserServiceLoginUserResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *UserServiceLoginUserResult) FastWrite(buf []byte) int {
+func (p *UserServiceVerifyCodeResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceLoginUserResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceVerifyCodeResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -5173,7 +6860,7 @@ func (p *UserServiceLoginUserResult) FastWriteNocopy(buf []byte, w thrift.Nocopy
 	return offset
 }
 
-func (p *UserServiceLoginUserResult) BLength() int {
+func (p *UserServiceVerifyCodeResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -5182,7 +6869,7 @@ func (p *UserServiceLoginUserResult) BLength() int {
 	return l
 }
 
-func (p *UserServiceLoginUserResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceVerifyCodeResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -5191,7 +6878,7 @@ func (p *UserServiceLoginUserResult) fastWriteField0(buf []byte, w thrift.Nocopy
 	return offset
 }
 
-func (p *UserServiceLoginUserResult) field0Length() int {
+func (p *UserServiceVerifyCodeResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -5200,7 +6887,7 @@ func (p *UserServiceLoginUserResult) field0Length() int {
 	return l
 }
 
-func (p *UserServiceGetUserInfoArgs) FastRead(buf []byte) (int, error) {
+func (p *UserServiceSendCodeArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5244,14 +6931,14 @@ func (p *UserServiceGetUserInfoArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUserInfoArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceSendCodeArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceGetUserInfoArgs) FastReadField1(buf []byte) (int, error) {
+func (p *UserServiceSendCodeArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetUserInfoRequest()
+	_field := NewSendCodeRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -5261,11 +6948,11 @@ func (p *UserServiceGetUserInfoArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *UserServiceGetUserInfoArgs) FastWrite(buf []byte) int {
+func (p *UserServiceSendCodeArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceGetUserInfoArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceSendCodeArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -5274,7 +6961,7 @@ func (p *UserServiceGetUserInfoArgs) FastWriteNocopy(buf []byte, w thrift.Nocopy
 	return offset
 }
 
-func (p *UserServiceGetUserInfoArgs) BLength() int {
+func (p *UserServiceSendCodeArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -5283,21 +6970,21 @@ func (p *UserServiceGetUserInfoArgs) BLength() int {
 	return l
 }
 
-func (p *UserServiceGetUserInfoArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceSendCodeArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *UserServiceGetUserInfoArgs) field1Length() int {
+func (p *UserServiceSendCodeArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *UserServiceGetUserInfoResult) FastRead(buf []byte) (int, error) {
+func (p *UserServiceSendCodeResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5341,14 +7028,14 @@ func (p *UserServiceGetUserInfoResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUserInfoResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceSendCodeResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceGetUserInfoResult) FastReadField0(buf []byte) (int, error) {
+func (p *UserServiceSendCodeResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetUserInfoResponse()
+	_field := NewSendCodeResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -5358,11 +7045,11 @@ func (p *UserServiceGetUserInfoResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *UserServiceGetUserInfoResult) FastWrite(buf []byte) int {
+func (p *UserServiceSendCodeResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceGetUserInfoResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceSendCodeResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -5371,7 +7058,7 @@ func (p *UserServiceGetUserInfoResult) FastWriteNocopy(buf []byte, w thrift.Noco
 	return offset
 }
 
-func (p *UserServiceGetUserInfoResult) BLength() int {
+func (p *UserServiceSendCodeResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -5380,7 +7067,7 @@ func (p *UserServiceGetUserInfoResult) BLength() int {
 	return l
 }
 
-func (p *UserServiceGetUserInfoResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceSendCodeResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -5389,7 +7076,7 @@ func (p *UserServiceGetUserInfoResult) fastWriteField0(buf []byte, w thrift.Noco
 	return offset
 }
 
-func (p *UserServiceGetUserInfoResult) field0Length() int {
+func (p *UserServiceSendCodeResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -5398,7 +7085,7 @@ func (p *UserServiceGetUserInfoResult) field0Length() int {
 	return l
 }
 
-func (p *UserServiceCheckUserExistsByIdArgs) FastRead(buf []byte) (int, error) {
+func (p *UserServiceForgotPasswordArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5442,14 +7129,14 @@ func (p *UserServiceCheckUserExistsByIdArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceCheckUserExistsByIdArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceForgotPasswordArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceCheckUserExistsByIdArgs) FastReadField1(buf []byte) (int, error) {
+func (p *UserServiceForgotPasswordArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCheckUserExistsByIdRequst()
+	_field := NewForgotPasswordRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -5459,11 +7146,11 @@ func (p *UserServiceCheckUserExistsByIdArgs) FastReadField1(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *UserServiceCheckUserExistsByIdArgs) FastWrite(buf []byte) int {
+func (p *UserServiceForgotPasswordArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceCheckUserExistsByIdArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceForgotPasswordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -5472,7 +7159,7 @@ func (p *UserServiceCheckUserExistsByIdArgs) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *UserServiceCheckUserExistsByIdArgs) BLength() int {
+func (p *UserServiceForgotPasswordArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -5481,21 +7168,21 @@ func (p *UserServiceCheckUserExistsByIdArgs) BLength() int {
 	return l
 }
 
-func (p *UserServiceCheckUserExistsByIdArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceForgotPasswordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *UserServiceCheckUserExistsByIdArgs) field1Length() int {
+func (p *UserServiceForgotPasswordArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *UserServiceCheckUserExistsByIdResult) FastRead(buf []byte) (int, error) {
+func (p *UserServiceForgotPasswordResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5539,14 +7226,14 @@ func (p *UserServiceCheckUserExistsByIdResult) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceCheckUserExistsByIdResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceForgotPasswordResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceCheckUserExistsByIdResult) FastReadField0(buf []byte) (int, error) {
+func (p *UserServiceForgotPasswordResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCheckUserExistsByIdResponse()
+	_field := NewForgotPasswordResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -5556,11 +7243,11 @@ func (p *UserServiceCheckUserExistsByIdResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *UserServiceCheckUserExistsByIdResult) FastWrite(buf []byte) int {
+func (p *UserServiceForgotPasswordResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceCheckUserExistsByIdResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceForgotPasswordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -5569,7 +7256,7 @@ func (p *UserServiceCheckUserExistsByIdResult) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *UserServiceCheckUserExistsByIdResult) BLength() int {
+func (p *UserServiceForgotPasswordResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -5578,7 +7265,7 @@ func (p *UserServiceCheckUserExistsByIdResult) BLength() int {
 	return l
 }
 
-func (p *UserServiceCheckUserExistsByIdResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceForgotPasswordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -5587,7 +7274,7 @@ func (p *UserServiceCheckUserExistsByIdResult) fastWriteField0(buf []byte, w thr
 	return offset
 }
 
-func (p *UserServiceCheckUserExistsByIdResult) field0Length() int {
+func (p *UserServiceForgotPasswordResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -5596,7 +7283,7 @@ func (p *UserServiceCheckUserExistsByIdResult) field0Length() int {
 	return l
 }
 
-func (p *UserServiceVerifyCodeArgs) FastRead(buf []byte) (int, error) {
+func (p *UserServiceResetPasswordArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5640,14 +7327,14 @@ func (p *UserServiceVerifyCodeArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceVerifyCodeArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceResetPasswordArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceVerifyCodeArgs) FastReadField1(buf []byte) (int, error) {
+func (p *UserServiceResetPasswordArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVerifyCodeRequest()
+	_field := NewResetPasswordRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -5657,11 +7344,11 @@ func (p *UserServiceVerifyCodeArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *UserServiceVerifyCodeArgs) FastWrite(buf []byte) int {
+func (p *UserServiceResetPasswordArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceVerifyCodeArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceResetPasswordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -5670,7 +7357,7 @@ func (p *UserServiceVerifyCodeArgs) FastWriteNocopy(buf []byte, w thrift.NocopyW
 	return offset
 }
 
-func (p *UserServiceVerifyCodeArgs) BLength() int {
+func (p *UserServiceResetPasswordArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -5679,21 +7366,21 @@ func (p *UserServiceVerifyCodeArgs) BLength() int {
 	return l
 }
 
-func (p *UserServiceVerifyCodeArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceResetPasswordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *UserServiceVerifyCodeArgs) field1Length() int {
+func (p *UserServiceResetPasswordArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *UserServiceVerifyCodeResult) FastRead(buf []byte) (int, error) {
+func (p *UserServiceResetPasswordResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5737,14 +7424,14 @@ func (p *UserServiceVerifyCodeResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceVerifyCodeResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceResetPasswordResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceVerifyCodeResult) FastReadField0(buf []byte) (int, error) {
+func (p *UserServiceResetPasswordResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVerifyCodeResponse()
+	_field := NewResetPasswordResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -5754,11 +7441,11 @@ func (p *UserServiceVerifyCodeResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *UserServiceVerifyCodeResult) FastWrite(buf []byte) int {
+func (p *UserServiceResetPasswordResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceVerifyCodeResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceResetPasswordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -5767,7 +7454,7 @@ func (p *UserServiceVerifyCodeResult) FastWriteNocopy(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *UserServiceVerifyCodeResult) BLength() int {
+func (p *UserServiceResetPasswordResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -5776,7 +7463,7 @@ func (p *UserServiceVerifyCodeResult) BLength() int {
 	return l
 }
 
-func (p *UserServiceVerifyCodeResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceResetPasswordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -5785,7 +7472,7 @@ func (p *UserServiceVerifyCodeResult) fastWriteField0(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *UserServiceVerifyCodeResult) field0Length() int {
+func (p *UserServiceResetPasswordResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -5794,7 +7481,7 @@ func (p *UserServiceVerifyCodeResult) field0Length() int {
 	return l
 }
 
-func (p *UserServiceSendCodeArgs) FastRead(buf []byte) (int, error) {
+func (p *UserServiceGetMyProfileArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5838,14 +7525,14 @@ func (p *UserServiceSendCodeArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceSendCodeArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetMyProfileArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceSendCodeArgs) FastReadField1(buf []byte) (int, error) {
+func (p *UserServiceGetMyProfileArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewSendCodeRequest()
+	_field := NewGetMyProfileRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -5855,11 +7542,11 @@ func (p *UserServiceSendCodeArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *UserServiceSendCodeArgs) FastWrite(buf []byte) int {
+func (p *UserServiceGetMyProfileArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceSendCodeArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceGetMyProfileArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -5868,7 +7555,7 @@ func (p *UserServiceSendCodeArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWri
 	return offset
 }
 
-func (p *UserServiceSendCodeArgs) BLength() int {
+func (p *UserServiceGetMyProfileArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -5877,21 +7564,21 @@ func (p *UserServiceSendCodeArgs) BLength() int {
 	return l
 }
 
-func (p *UserServiceSendCodeArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceGetMyProfileArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *UserServiceSendCodeArgs) field1Length() int {
+func (p *UserServiceGetMyProfileArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *UserServiceSendCodeResult) FastRead(buf []byte) (int, error) {
+func (p *UserServiceGetMyProfileResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5935,14 +7622,14 @@ func (p *UserServiceSendCodeResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceSendCodeResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetMyProfileResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceSendCodeResult) FastReadField0(buf []byte) (int, error) {
+func (p *UserServiceGetMyProfileResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewSendCodeResponse()
+	_field := NewGetMyProfileResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -5952,11 +7639,11 @@ func (p *UserServiceSendCodeResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *UserServiceSendCodeResult) FastWrite(buf []byte) int {
+func (p *UserServiceGetMyProfileResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceSendCodeResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceGetMyProfileResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -5965,7 +7652,7 @@ func (p *UserServiceSendCodeResult) FastWriteNocopy(buf []byte, w thrift.NocopyW
 	return offset
 }
 
-func (p *UserServiceSendCodeResult) BLength() int {
+func (p *UserServiceGetMyProfileResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -5974,7 +7661,7 @@ func (p *UserServiceSendCodeResult) BLength() int {
 	return l
 }
 
-func (p *UserServiceSendCodeResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceGetMyProfileResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -5983,7 +7670,7 @@ func (p *UserServiceSendCodeResult) fastWriteField0(buf []byte, w thrift.NocopyW
 	return offset
 }
 
-func (p *UserServiceSendCodeResult) field0Length() int {
+func (p *UserServiceGetMyProfileResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -5992,7 +7679,7 @@ func (p *UserServiceSendCodeResult) field0Length() int {
 	return l
 }
 
-func (p *UserServiceForgotPasswordArgs) FastRead(buf []byte) (int, error) {
+func (p *UserServiceGetAvatarUploadUrlArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -6036,14 +7723,14 @@ func (p *UserServiceForgotPasswordArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceForgotPasswordArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetAvatarUploadUrlArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceForgotPasswordArgs) FastReadField1(buf []byte) (int, error) {
+func (p *UserServiceGetAvatarUploadUrlArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewForgotPasswordRequest()
+	_field := NewGetAvatarUploadUrlRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -6053,11 +7740,11 @@ func (p *UserServiceForgotPasswordArgs) FastReadField1(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *UserServiceForgotPasswordArgs) FastWrite(buf []byte) int {
+func (p *UserServiceGetAvatarUploadUrlArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceForgotPasswordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceGetAvatarUploadUrlArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -6066,7 +7753,7 @@ func (p *UserServiceForgotPasswordArgs) FastWriteNocopy(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *UserServiceForgotPasswordArgs) BLength() int {
+func (p *UserServiceGetAvatarUploadUrlArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -6075,21 +7762,21 @@ func (p *UserServiceForgotPasswordArgs) BLength() int {
 	return l
 }
 
-func (p *UserServiceForgotPasswordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceGetAvatarUploadUrlArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *UserServiceForgotPasswordArgs) field1Length() int {
+func (p *UserServiceGetAvatarUploadUrlArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *UserServiceForgotPasswordResult) FastRead(buf []byte) (int, error) {
+func (p *UserServiceGetAvatarUploadUrlResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -6133,14 +7820,14 @@ func (p *UserServiceForgotPasswordResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceForgotPasswordResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetAvatarUploadUrlResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceForgotPasswordResult) FastReadField0(buf []byte) (int, error) {
+func (p *UserServiceGetAvatarUploadUrlResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewForgotPasswordResponse()
+	_field := NewGetAvatarUploadUrlResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -6150,11 +7837,11 @@ func (p *UserServiceForgotPasswordResult) FastReadField0(buf []byte) (int, error
 	return offset, nil
 }
 
-func (p *UserServiceForgotPasswordResult) FastWrite(buf []byte) int {
+func (p *UserServiceGetAvatarUploadUrlResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceForgotPasswordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceGetAvatarUploadUrlResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -6163,7 +7850,7 @@ func (p *UserServiceForgotPasswordResult) FastWriteNocopy(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *UserServiceForgotPasswordResult) BLength() int {
+func (p *UserServiceGetAvatarUploadUrlResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -6172,7 +7859,7 @@ func (p *UserServiceForgotPasswordResult) BLength() int {
 	return l
 }
 
-func (p *UserServiceForgotPasswordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceGetAvatarUploadUrlResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -6181,7 +7868,7 @@ func (p *UserServiceForgotPasswordResult) fastWriteField0(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *UserServiceForgotPasswordResult) field0Length() int {
+func (p *UserServiceGetAvatarUploadUrlResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -6190,7 +7877,7 @@ func (p *UserServiceForgotPasswordResult) field0Length() int {
 	return l
 }
 
-func (p *UserServiceResetPasswordArgs) FastRead(buf []byte) (int, error) {
+func (p *UserServiceUpdateAvatarArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -6234,14 +7921,14 @@ func (p *UserServiceResetPasswordArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceResetPasswordArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateAvatarArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceResetPasswordArgs) FastReadField1(buf []byte) (int, error) {
+func (p *UserServiceUpdateAvatarArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewResetPasswordRequest()
+	_field := NewUpdateAvatarRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -6251,11 +7938,11 @@ func (p *UserServiceResetPasswordArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *UserServiceResetPasswordArgs) FastWrite(buf []byte) int {
+func (p *UserServiceUpdateAvatarArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceResetPasswordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceUpdateAvatarArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -6264,7 +7951,7 @@ func (p *UserServiceResetPasswordArgs) FastWriteNocopy(buf []byte, w thrift.Noco
 	return offset
 }
 
-func (p *UserServiceResetPasswordArgs) BLength() int {
+func (p *UserServiceUpdateAvatarArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -6273,21 +7960,21 @@ func (p *UserServiceResetPasswordArgs) BLength() int {
 	return l
 }
 
-func (p *UserServiceResetPasswordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceUpdateAvatarArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *UserServiceResetPasswordArgs) field1Length() int {
+func (p *UserServiceUpdateAvatarArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *UserServiceResetPasswordResult) FastRead(buf []byte) (int, error) {
+func (p *UserServiceUpdateAvatarResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -6331,14 +8018,14 @@ func (p *UserServiceResetPasswordResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceResetPasswordResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateAvatarResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceResetPasswordResult) FastReadField0(buf []byte) (int, error) {
+func (p *UserServiceUpdateAvatarResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewResetPasswordResponse()
+	_field := NewUpdateAvatarResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -6348,11 +8035,11 @@ func (p *UserServiceResetPasswordResult) FastReadField0(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *UserServiceResetPasswordResult) FastWrite(buf []byte) int {
+func (p *UserServiceUpdateAvatarResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceResetPasswordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceUpdateAvatarResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -6361,7 +8048,7 @@ func (p *UserServiceResetPasswordResult) FastWriteNocopy(buf []byte, w thrift.No
 	return offset
 }
 
-func (p *UserServiceResetPasswordResult) BLength() int {
+func (p *UserServiceUpdateAvatarResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -6370,7 +8057,7 @@ func (p *UserServiceResetPasswordResult) BLength() int {
 	return l
 }
 
-func (p *UserServiceResetPasswordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceUpdateAvatarResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -6379,7 +8066,7 @@ func (p *UserServiceResetPasswordResult) fastWriteField0(buf []byte, w thrift.No
 	return offset
 }
 
-func (p *UserServiceResetPasswordResult) field0Length() int {
+func (p *UserServiceUpdateAvatarResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -6388,7 +8075,7 @@ func (p *UserServiceResetPasswordResult) field0Length() int {
 	return l
 }
 
-func (p *UserServiceGetMyProfileArgs) FastRead(buf []byte) (int, error) {
+func (p *UserServiceChangePasswordArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -6432,14 +8119,14 @@ func (p *UserServiceGetMyProfileArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetMyProfileArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceChangePasswordArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceGetMyProfileArgs) FastReadField1(buf []byte) (int, error) {
+func (p *UserServiceChangePasswordArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetMyProfileRequest()
+	_field := NewChangePasswordRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -6449,11 +8136,11 @@ func (p *UserServiceGetMyProfileArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *UserServiceGetMyProfileArgs) FastWrite(buf []byte) int {
+func (p *UserServiceChangePasswordArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceGetMyProfileArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceChangePasswordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -6462,7 +8149,7 @@ func (p *UserServiceGetMyProfileArgs) FastWriteNocopy(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *UserServiceGetMyProfileArgs) BLength() int {
+func (p *UserServiceChangePasswordArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -6471,21 +8158,21 @@ func (p *UserServiceGetMyProfileArgs) BLength() int {
 	return l
 }
 
-func (p *UserServiceGetMyProfileArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceChangePasswordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *UserServiceGetMyProfileArgs) field1Length() int {
+func (p *UserServiceChangePasswordArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *UserServiceGetMyProfileResult) FastRead(buf []byte) (int, error) {
+func (p *UserServiceChangePasswordResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -6529,14 +8216,14 @@ func (p *UserServiceGetMyProfileResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetMyProfileResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceChangePasswordResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceGetMyProfileResult) FastReadField0(buf []byte) (int, error) {
+func (p *UserServiceChangePasswordResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetMyProfileResponse()
+	_field := NewChangePasswordResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -6546,11 +8233,11 @@ func (p *UserServiceGetMyProfileResult) FastReadField0(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *UserServiceGetMyProfileResult) FastWrite(buf []byte) int {
+func (p *UserServiceChangePasswordResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceGetMyProfileResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceChangePasswordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -6559,7 +8246,7 @@ func (p *UserServiceGetMyProfileResult) FastWriteNocopy(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *UserServiceGetMyProfileResult) BLength() int {
+func (p *UserServiceChangePasswordResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -6568,7 +8255,7 @@ func (p *UserServiceGetMyProfileResult) BLength() int {
 	return l
 }
 
-func (p *UserServiceGetMyProfileResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceChangePasswordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -6577,7 +8264,7 @@ func (p *UserServiceGetMyProfileResult) fastWriteField0(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *UserServiceGetMyProfileResult) field0Length() int {
+func (p *UserServiceChangePasswordResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -6586,7 +8273,7 @@ func (p *UserServiceGetMyProfileResult) field0Length() int {
 	return l
 }
 
-func (p *UserServiceGetAvatarUploadUrlArgs) FastRead(buf []byte) (int, error) {
+func (p *UserServiceUpdateProfileArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -6630,14 +8317,14 @@ func (p *UserServiceGetAvatarUploadUrlArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetAvatarUploadUrlArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateProfileArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceGetAvatarUploadUrlArgs) FastReadField1(buf []byte) (int, error) {
+func (p *UserServiceUpdateProfileArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetAvatarUploadUrlRequest()
+	_field := NewUpdateProfileRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -6647,11 +8334,11 @@ func (p *UserServiceGetAvatarUploadUrlArgs) FastReadField1(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *UserServiceGetAvatarUploadUrlArgs) FastWrite(buf []byte) int {
+func (p *UserServiceUpdateProfileArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceGetAvatarUploadUrlArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceUpdateProfileArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -6660,7 +8347,7 @@ func (p *UserServiceGetAvatarUploadUrlArgs) FastWriteNocopy(buf []byte, w thrift
 	return offset
 }
 
-func (p *UserServiceGetAvatarUploadUrlArgs) BLength() int {
+func (p *UserServiceUpdateProfileArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -6669,21 +8356,21 @@ func (p *UserServiceGetAvatarUploadUrlArgs) BLength() int {
 	return l
 }
 
-func (p *UserServiceGetAvatarUploadUrlArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceUpdateProfileArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *UserServiceGetAvatarUploadUrlArgs) field1Length() int {
+func (p *UserServiceUpdateProfileArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *UserServiceGetAvatarUploadUrlResult) FastRead(buf []byte) (int, error) {
+func (p *UserServiceUpdateProfileResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -6727,14 +8414,14 @@ func (p *UserServiceGetAvatarUploadUrlResult) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetAvatarUploadUrlResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateProfileResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceGetAvatarUploadUrlResult) FastReadField0(buf []byte) (int, error) {
+func (p *UserServiceUpdateProfileResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetAvatarUploadUrlResponse()
+	_field := NewUpdateProfileResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -6744,11 +8431,11 @@ func (p *UserServiceGetAvatarUploadUrlResult) FastReadField0(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *UserServiceGetAvatarUploadUrlResult) FastWrite(buf []byte) int {
+func (p *UserServiceUpdateProfileResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceGetAvatarUploadUrlResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceUpdateProfileResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -6757,7 +8444,7 @@ func (p *UserServiceGetAvatarUploadUrlResult) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *UserServiceGetAvatarUploadUrlResult) BLength() int {
+func (p *UserServiceUpdateProfileResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -6766,7 +8453,7 @@ func (p *UserServiceGetAvatarUploadUrlResult) BLength() int {
 	return l
 }
 
-func (p *UserServiceGetAvatarUploadUrlResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceUpdateProfileResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -6775,7 +8462,7 @@ func (p *UserServiceGetAvatarUploadUrlResult) fastWriteField0(buf []byte, w thri
 	return offset
 }
 
-func (p *UserServiceGetAvatarUploadUrlResult) field0Length() int {
+func (p *UserServiceUpdateProfileResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -6784,7 +8471,7 @@ func (p *UserServiceGetAvatarUploadUrlResult) field0Length() int {
 	return l
 }
 
-func (p *UserServiceUpdateAvatarArgs) FastRead(buf []byte) (int, error) {
+func (p *UserServiceUploadBackgroundArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -6828,14 +8515,14 @@ func (p *UserServiceUpdateAvatarArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateAvatarArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUploadBackgroundArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceUpdateAvatarArgs) FastReadField1(buf []byte) (int, error) {
+func (p *UserServiceUploadBackgroundArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateAvatarRequest()
+	_field := NewUploadBackgroundRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -6845,11 +8532,11 @@ func (p *UserServiceUpdateAvatarArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *UserServiceUpdateAvatarArgs) FastWrite(buf []byte) int {
+func (p *UserServiceUploadBackgroundArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceUpdateAvatarArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceUploadBackgroundArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -6858,7 +8545,7 @@ func (p *UserServiceUpdateAvatarArgs) FastWriteNocopy(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *UserServiceUpdateAvatarArgs) BLength() int {
+func (p *UserServiceUploadBackgroundArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -6867,21 +8554,21 @@ func (p *UserServiceUpdateAvatarArgs) BLength() int {
 	return l
 }
 
-func (p *UserServiceUpdateAvatarArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceUploadBackgroundArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *UserServiceUpdateAvatarArgs) field1Length() int {
+func (p *UserServiceUploadBackgroundArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *UserServiceUpdateAvatarResult) FastRead(buf []byte) (int, error) {
+func (p *UserServiceUploadBackgroundResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -6925,14 +8612,14 @@ func (p *UserServiceUpdateAvatarResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateAvatarResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUploadBackgroundResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceUpdateAvatarResult) FastReadField0(buf []byte) (int, error) {
+func (p *UserServiceUploadBackgroundResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateAvatarResponse()
+	_field := NewUploadBackgroundResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -6942,11 +8629,11 @@ func (p *UserServiceUpdateAvatarResult) FastReadField0(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *UserServiceUpdateAvatarResult) FastWrite(buf []byte) int {
+func (p *UserServiceUploadBackgroundResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceUpdateAvatarResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceUploadBackgroundResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -6955,7 +8642,7 @@ func (p *UserServiceUpdateAvatarResult) FastWriteNocopy(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *UserServiceUpdateAvatarResult) BLength() int {
+func (p *UserServiceUploadBackgroundResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -6964,7 +8651,7 @@ func (p *UserServiceUpdateAvatarResult) BLength() int {
 	return l
 }
 
-func (p *UserServiceUpdateAvatarResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceUploadBackgroundResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -6973,7 +8660,7 @@ func (p *UserServiceUpdateAvatarResult) fastWriteField0(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *UserServiceUpdateAvatarResult) field0Length() int {
+func (p *UserServiceUploadBackgroundResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -6982,7 +8669,7 @@ func (p *UserServiceUpdateAvatarResult) field0Length() int {
 	return l
 }
 
-func (p *UserServiceChangePasswordArgs) FastRead(buf []byte) (int, error) {
+func (p *UserServiceUpdatePrivacySettingsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -7026,14 +8713,14 @@ func (p *UserServiceChangePasswordArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceChangePasswordArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdatePrivacySettingsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceChangePasswordArgs) FastReadField1(buf []byte) (int, error) {
+func (p *UserServiceUpdatePrivacySettingsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewChangePasswordRequest()
+	_field := NewUpdatePrivacySettingsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -7043,11 +8730,11 @@ func (p *UserServiceChangePasswordArgs) FastReadField1(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *UserServiceChangePasswordArgs) FastWrite(buf []byte) int {
+func (p *UserServiceUpdatePrivacySettingsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceChangePasswordArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceUpdatePrivacySettingsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -7056,7 +8743,7 @@ func (p *UserServiceChangePasswordArgs) FastWriteNocopy(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *UserServiceChangePasswordArgs) BLength() int {
+func (p *UserServiceUpdatePrivacySettingsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -7065,21 +8752,21 @@ func (p *UserServiceChangePasswordArgs) BLength() int {
 	return l
 }
 
-func (p *UserServiceChangePasswordArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceUpdatePrivacySettingsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *UserServiceChangePasswordArgs) field1Length() int {
+func (p *UserServiceUpdatePrivacySettingsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *UserServiceChangePasswordResult) FastRead(buf []byte) (int, error) {
+func (p *UserServiceUpdatePrivacySettingsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -7123,14 +8810,14 @@ func (p *UserServiceChangePasswordResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceChangePasswordResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdatePrivacySettingsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserServiceChangePasswordResult) FastReadField0(buf []byte) (int, error) {
+func (p *UserServiceUpdatePrivacySettingsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewChangePasswordResponse()
+	_field := NewUpdatePrivacySettingsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -7140,11 +8827,11 @@ func (p *UserServiceChangePasswordResult) FastReadField0(buf []byte) (int, error
 	return offset, nil
 }
 
-func (p *UserServiceChangePasswordResult) FastWrite(buf []byte) int {
+func (p *UserServiceUpdatePrivacySettingsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserServiceChangePasswordResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceUpdatePrivacySettingsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -7153,7 +8840,7 @@ func (p *UserServiceChangePasswordResult) FastWriteNocopy(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *UserServiceChangePasswordResult) BLength() int {
+func (p *UserServiceUpdatePrivacySettingsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -7162,7 +8849,7 @@ func (p *UserServiceChangePasswordResult) BLength() int {
 	return l
 }
 
-func (p *UserServiceChangePasswordResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *UserServiceUpdatePrivacySettingsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -7171,7 +8858,7 @@ func (p *UserServiceChangePasswordResult) fastWriteField0(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *UserServiceChangePasswordResult) field0Length() int {
+func (p *UserServiceUpdatePrivacySettingsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -7299,3 +8986,27 @@ func (p *UserServiceChangePasswordArgs) GetFirstArgument() interface{} {
 func (p *UserServiceChangePasswordResult) GetResult() interface{} {
 	return p.Success
 }
+
+func (p *UserServiceUpdateProfileArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *UserServiceUpdateProfileResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *UserServiceUploadBackgroundArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *UserServiceUploadBackgroundResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *UserServiceUpdatePrivacySettingsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *UserServiceUpdatePrivacySettingsResult) GetResult() interface{} {
+	return p.Success
+}
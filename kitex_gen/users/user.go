@@ -1318,6 +1318,378 @@ var fieldIDToName_ChangePasswordResponse = map[int16]string{
 	1: "base",
 }
 
+type UpdateProfileRequest struct {
+	UserId   int64   `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	Bio      *string `thrift:"bio,2,optional" frugal:"2,optional,string" json:"bio,omitempty"`
+	Website  *string `thrift:"website,3,optional" frugal:"3,optional,string" json:"website,omitempty"`
+	Birthday *string `thrift:"birthday,4,optional" frugal:"4,optional,string" json:"birthday,omitempty"`
+}
+
+func NewUpdateProfileRequest() *UpdateProfileRequest {
+	return &UpdateProfileRequest{}
+}
+
+func (p *UpdateProfileRequest) InitDefault() {
+}
+
+func (p *UpdateProfileRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+var UpdateProfileRequest_Bio_DEFAULT string
+
+func (p *UpdateProfileRequest) GetBio() (v string) {
+	if !p.IsSetBio() {
+		return UpdateProfileRequest_Bio_DEFAULT
+	}
+	return *p.Bio
+}
+
+var UpdateProfileRequest_Website_DEFAULT string
+
+func (p *UpdateProfileRequest) GetWebsite() (v string) {
+	if !p.IsSetWebsite() {
+		return UpdateProfileRequest_Website_DEFAULT
+	}
+	return *p.Website
+}
+
+var UpdateProfileRequest_Birthday_DEFAULT string
+
+func (p *UpdateProfileRequest) GetBirthday() (v string) {
+	if !p.IsSetBirthday() {
+		return UpdateProfileRequest_Birthday_DEFAULT
+	}
+	return *p.Birthday
+}
+func (p *UpdateProfileRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *UpdateProfileRequest) SetBio(val *string) {
+	p.Bio = val
+}
+func (p *UpdateProfileRequest) SetWebsite(val *string) {
+	p.Website = val
+}
+func (p *UpdateProfileRequest) SetBirthday(val *string) {
+	p.Birthday = val
+}
+
+func (p *UpdateProfileRequest) IsSetBio() bool {
+	return p.Bio != nil
+}
+
+func (p *UpdateProfileRequest) IsSetWebsite() bool {
+	return p.Website != nil
+}
+
+func (p *UpdateProfileRequest) IsSetBirthday() bool {
+	return p.Birthday != nil
+}
+
+func (p *UpdateProfileRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateProfileRequest(%+v)", *p)
+}
+
+var fieldIDToName_UpdateProfileRequest = map[int16]string{
+	1: "user_id",
+	2: "bio",
+	3: "website",
+	4: "birthday",
+}
+
+type UpdateProfileResponse struct {
+	Base *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	User *base.User   `thrift:"user,2" frugal:"2,default,base.User" json:"user"`
+}
+
+func NewUpdateProfileResponse() *UpdateProfileResponse {
+	return &UpdateProfileResponse{}
+}
+
+func (p *UpdateProfileResponse) InitDefault() {
+}
+
+var UpdateProfileResponse_Base_DEFAULT *base.Status
+
+func (p *UpdateProfileResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return UpdateProfileResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var UpdateProfileResponse_User_DEFAULT *base.User
+
+func (p *UpdateProfileResponse) GetUser() (v *base.User) {
+	if !p.IsSetUser() {
+		return UpdateProfileResponse_User_DEFAULT
+	}
+	return p.User
+}
+func (p *UpdateProfileResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *UpdateProfileResponse) SetUser(val *base.User) {
+	p.User = val
+}
+
+func (p *UpdateProfileResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UpdateProfileResponse) IsSetUser() bool {
+	return p.User != nil
+}
+
+func (p *UpdateProfileResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateProfileResponse(%+v)", *p)
+}
+
+var fieldIDToName_UpdateProfileResponse = map[int16]string{
+	1: "base",
+	2: "user",
+}
+
+type UploadBackgroundRequest struct {
+	UserId   int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	Data     []byte `thrift:"data,2" frugal:"2,default,binary" json:"data"`
+	Filesize int64  `thrift:"filesize,3" frugal:"3,default,i64" json:"filesize"`
+}
+
+func NewUploadBackgroundRequest() *UploadBackgroundRequest {
+	return &UploadBackgroundRequest{}
+}
+
+func (p *UploadBackgroundRequest) InitDefault() {
+}
+
+func (p *UploadBackgroundRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *UploadBackgroundRequest) GetData() (v []byte) {
+	return p.Data
+}
+
+func (p *UploadBackgroundRequest) GetFilesize() (v int64) {
+	return p.Filesize
+}
+func (p *UploadBackgroundRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *UploadBackgroundRequest) SetData(val []byte) {
+	p.Data = val
+}
+func (p *UploadBackgroundRequest) SetFilesize(val int64) {
+	p.Filesize = val
+}
+
+func (p *UploadBackgroundRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UploadBackgroundRequest(%+v)", *p)
+}
+
+var fieldIDToName_UploadBackgroundRequest = map[int16]string{
+	1: "user_id",
+	2: "data",
+	3: "filesize",
+}
+
+type UploadBackgroundResponse struct {
+	Base          *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	BackgroundUrl string       `thrift:"background_url,2" frugal:"2,default,string" json:"background_url"`
+}
+
+func NewUploadBackgroundResponse() *UploadBackgroundResponse {
+	return &UploadBackgroundResponse{}
+}
+
+func (p *UploadBackgroundResponse) InitDefault() {
+}
+
+var UploadBackgroundResponse_Base_DEFAULT *base.Status
+
+func (p *UploadBackgroundResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return UploadBackgroundResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *UploadBackgroundResponse) GetBackgroundUrl() (v string) {
+	return p.BackgroundUrl
+}
+func (p *UploadBackgroundResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *UploadBackgroundResponse) SetBackgroundUrl(val string) {
+	p.BackgroundUrl = val
+}
+
+func (p *UploadBackgroundResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UploadBackgroundResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UploadBackgroundResponse(%+v)", *p)
+}
+
+var fieldIDToName_UploadBackgroundResponse = map[int16]string{
+	1: "base",
+	2: "background_url",
+}
+
+type UpdatePrivacySettingsRequest struct {
+	UserId        int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	IsPrivate     *bool `thrift:"is_private,2,optional" frugal:"2,optional,bool" json:"is_private,omitempty"`
+	HideLikes     *bool `thrift:"hide_likes,3,optional" frugal:"3,optional,bool" json:"hide_likes,omitempty"`
+	HideFollowing *bool `thrift:"hide_following,4,optional" frugal:"4,optional,bool" json:"hide_following,omitempty"`
+}
+
+func NewUpdatePrivacySettingsRequest() *UpdatePrivacySettingsRequest {
+	return &UpdatePrivacySettingsRequest{}
+}
+
+func (p *UpdatePrivacySettingsRequest) InitDefault() {
+}
+
+func (p *UpdatePrivacySettingsRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+var UpdatePrivacySettingsRequest_IsPrivate_DEFAULT bool
+
+func (p *UpdatePrivacySettingsRequest) GetIsPrivate() (v bool) {
+	if !p.IsSetIsPrivate() {
+		return UpdatePrivacySettingsRequest_IsPrivate_DEFAULT
+	}
+	return *p.IsPrivate
+}
+
+var UpdatePrivacySettingsRequest_HideLikes_DEFAULT bool
+
+func (p *UpdatePrivacySettingsRequest) GetHideLikes() (v bool) {
+	if !p.IsSetHideLikes() {
+		return UpdatePrivacySettingsRequest_HideLikes_DEFAULT
+	}
+	return *p.HideLikes
+}
+
+var UpdatePrivacySettingsRequest_HideFollowing_DEFAULT bool
+
+func (p *UpdatePrivacySettingsRequest) GetHideFollowing() (v bool) {
+	if !p.IsSetHideFollowing() {
+		return UpdatePrivacySettingsRequest_HideFollowing_DEFAULT
+	}
+	return *p.HideFollowing
+}
+func (p *UpdatePrivacySettingsRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *UpdatePrivacySettingsRequest) SetIsPrivate(val *bool) {
+	p.IsPrivate = val
+}
+func (p *UpdatePrivacySettingsRequest) SetHideLikes(val *bool) {
+	p.HideLikes = val
+}
+func (p *UpdatePrivacySettingsRequest) SetHideFollowing(val *bool) {
+	p.HideFollowing = val
+}
+
+func (p *UpdatePrivacySettingsRequest) IsSetIsPrivate() bool {
+	return p.IsPrivate != nil
+}
+
+func (p *UpdatePrivacySettingsRequest) IsSetHideLikes() bool {
+	return p.HideLikes != nil
+}
+
+func (p *UpdatePrivacySettingsRequest) IsSetHideFollowing() bool {
+	return p.HideFollowing != nil
+}
+
+func (p *UpdatePrivacySettingsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdatePrivacySettingsRequest(%+v)", *p)
+}
+
+var fieldIDToName_UpdatePrivacySettingsRequest = map[int16]string{
+	1: "user_id",
+	2: "is_private",
+	3: "hide_likes",
+	4: "hide_following",
+}
+
+type UpdatePrivacySettingsResponse struct {
+	Base *base.Status `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	User *base.User   `thrift:"user,2" frugal:"2,default,base.User" json:"user"`
+}
+
+func NewUpdatePrivacySettingsResponse() *UpdatePrivacySettingsResponse {
+	return &UpdatePrivacySettingsResponse{}
+}
+
+func (p *UpdatePrivacySettingsResponse) InitDefault() {
+}
+
+var UpdatePrivacySettingsResponse_Base_DEFAULT *base.Status
+
+func (p *UpdatePrivacySettingsResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return UpdatePrivacySettingsResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var UpdatePrivacySettingsResponse_User_DEFAULT *base.User
+
+func (p *UpdatePrivacySettingsResponse) GetUser() (v *base.User) {
+	if !p.IsSetUser() {
+		return UpdatePrivacySettingsResponse_User_DEFAULT
+	}
+	return p.User
+}
+func (p *UpdatePrivacySettingsResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *UpdatePrivacySettingsResponse) SetUser(val *base.User) {
+	p.User = val
+}
+
+func (p *UpdatePrivacySettingsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UpdatePrivacySettingsResponse) IsSetUser() bool {
+	return p.User != nil
+}
+
+func (p *UpdatePrivacySettingsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdatePrivacySettingsResponse(%+v)", *p)
+}
+
+var fieldIDToName_UpdatePrivacySettingsResponse = map[int16]string{
+	1: "base",
+	2: "user",
+}
+
 type UserService interface {
 	UpdateUser(ctx context.Context, req *UpdateUserRequest) (r *UpdateUserResponse, err error)
 
@@ -1348,6 +1720,12 @@ type UserService interface {
 	UpdateAvatar(ctx context.Context, req *UpdateAvatarRequest) (r *UpdateAvatarResponse, err error)
 
 	ChangePassword(ctx context.Context, req *ChangePasswordRequest) (r *ChangePasswordResponse, err error)
+
+	UpdateProfile(ctx context.Context, req *UpdateProfileRequest) (r *UpdateProfileResponse, err error)
+
+	UploadBackground(ctx context.Context, req *UploadBackgroundRequest) (r *UploadBackgroundResponse, err error)
+
+	UpdatePrivacySettings(ctx context.Context, req *UpdatePrivacySettingsRequest) (r *UpdatePrivacySettingsResponse, err error)
 }
 
 type UserServiceUpdateUserArgs struct {
@@ -2489,3 +2867,231 @@ func (p *UserServiceChangePasswordResult) String() string {
 var fieldIDToName_UserServiceChangePasswordResult = map[int16]string{
 	0: "success",
 }
+
+type UserServiceUpdateProfileArgs struct {
+	Req *UpdateProfileRequest `thrift:"req,1" frugal:"1,default,UpdateProfileRequest" json:"req"`
+}
+
+func NewUserServiceUpdateProfileArgs() *UserServiceUpdateProfileArgs {
+	return &UserServiceUpdateProfileArgs{}
+}
+
+func (p *UserServiceUpdateProfileArgs) InitDefault() {
+}
+
+var UserServiceUpdateProfileArgs_Req_DEFAULT *UpdateProfileRequest
+
+func (p *UserServiceUpdateProfileArgs) GetReq() (v *UpdateProfileRequest) {
+	if !p.IsSetReq() {
+		return UserServiceUpdateProfileArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceUpdateProfileArgs) SetReq(val *UpdateProfileRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceUpdateProfileArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUpdateProfileArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateProfileArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceUpdateProfileArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceUpdateProfileResult struct {
+	Success *UpdateProfileResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateProfileResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceUpdateProfileResult() *UserServiceUpdateProfileResult {
+	return &UserServiceUpdateProfileResult{}
+}
+
+func (p *UserServiceUpdateProfileResult) InitDefault() {
+}
+
+var UserServiceUpdateProfileResult_Success_DEFAULT *UpdateProfileResponse
+
+func (p *UserServiceUpdateProfileResult) GetSuccess() (v *UpdateProfileResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceUpdateProfileResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceUpdateProfileResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateProfileResponse)
+}
+
+func (p *UserServiceUpdateProfileResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUpdateProfileResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateProfileResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceUpdateProfileResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceUploadBackgroundArgs struct {
+	Req *UploadBackgroundRequest `thrift:"req,1" frugal:"1,default,UploadBackgroundRequest" json:"req"`
+}
+
+func NewUserServiceUploadBackgroundArgs() *UserServiceUploadBackgroundArgs {
+	return &UserServiceUploadBackgroundArgs{}
+}
+
+func (p *UserServiceUploadBackgroundArgs) InitDefault() {
+}
+
+var UserServiceUploadBackgroundArgs_Req_DEFAULT *UploadBackgroundRequest
+
+func (p *UserServiceUploadBackgroundArgs) GetReq() (v *UploadBackgroundRequest) {
+	if !p.IsSetReq() {
+		return UserServiceUploadBackgroundArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceUploadBackgroundArgs) SetReq(val *UploadBackgroundRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceUploadBackgroundArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUploadBackgroundArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUploadBackgroundArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceUploadBackgroundArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceUploadBackgroundResult struct {
+	Success *UploadBackgroundResponse `thrift:"success,0,optional" frugal:"0,optional,UploadBackgroundResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceUploadBackgroundResult() *UserServiceUploadBackgroundResult {
+	return &UserServiceUploadBackgroundResult{}
+}
+
+func (p *UserServiceUploadBackgroundResult) InitDefault() {
+}
+
+var UserServiceUploadBackgroundResult_Success_DEFAULT *UploadBackgroundResponse
+
+func (p *UserServiceUploadBackgroundResult) GetSuccess() (v *UploadBackgroundResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceUploadBackgroundResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceUploadBackgroundResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UploadBackgroundResponse)
+}
+
+func (p *UserServiceUploadBackgroundResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUploadBackgroundResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUploadBackgroundResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceUploadBackgroundResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceUpdatePrivacySettingsArgs struct {
+	Req *UpdatePrivacySettingsRequest `thrift:"req,1" frugal:"1,default,UpdatePrivacySettingsRequest" json:"req"`
+}
+
+func NewUserServiceUpdatePrivacySettingsArgs() *UserServiceUpdatePrivacySettingsArgs {
+	return &UserServiceUpdatePrivacySettingsArgs{}
+}
+
+func (p *UserServiceUpdatePrivacySettingsArgs) InitDefault() {
+}
+
+var UserServiceUpdatePrivacySettingsArgs_Req_DEFAULT *UpdatePrivacySettingsRequest
+
+func (p *UserServiceUpdatePrivacySettingsArgs) GetReq() (v *UpdatePrivacySettingsRequest) {
+	if !p.IsSetReq() {
+		return UserServiceUpdatePrivacySettingsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceUpdatePrivacySettingsArgs) SetReq(val *UpdatePrivacySettingsRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceUpdatePrivacySettingsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUpdatePrivacySettingsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdatePrivacySettingsArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceUpdatePrivacySettingsArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceUpdatePrivacySettingsResult struct {
+	Success *UpdatePrivacySettingsResponse `thrift:"success,0,optional" frugal:"0,optional,UpdatePrivacySettingsResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceUpdatePrivacySettingsResult() *UserServiceUpdatePrivacySettingsResult {
+	return &UserServiceUpdatePrivacySettingsResult{}
+}
+
+func (p *UserServiceUpdatePrivacySettingsResult) InitDefault() {
+}
+
+var UserServiceUpdatePrivacySettingsResult_Success_DEFAULT *UpdatePrivacySettingsResponse
+
+func (p *UserServiceUpdatePrivacySettingsResult) GetSuccess() (v *UpdatePrivacySettingsResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceUpdatePrivacySettingsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceUpdatePrivacySettingsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdatePrivacySettingsResponse)
+}
+
+func (p *UserServiceUpdatePrivacySettingsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUpdatePrivacySettingsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdatePrivacySettingsResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceUpdatePrivacySettingsResult = map[int16]string{
+	0: "success",
+}
@@ -26,6 +26,9 @@ type Client interface {
 	GetAvatarUploadUrl(ctx context.Context, req *users.GetAvatarUploadUrlRequest, callOptions ...callopt.Option) (r *users.GetAvatarUploadUrlResponse, err error)
 	UpdateAvatar(ctx context.Context, req *users.UpdateAvatarRequest, callOptions ...callopt.Option) (r *users.UpdateAvatarResponse, err error)
 	ChangePassword(ctx context.Context, req *users.ChangePasswordRequest, callOptions ...callopt.Option) (r *users.ChangePasswordResponse, err error)
+	UpdateProfile(ctx context.Context, req *users.UpdateProfileRequest, callOptions ...callopt.Option) (r *users.UpdateProfileResponse, err error)
+	UploadBackground(ctx context.Context, req *users.UploadBackgroundRequest, callOptions ...callopt.Option) (r *users.UploadBackgroundResponse, err error)
+	UpdatePrivacySettings(ctx context.Context, req *users.UpdatePrivacySettingsRequest, callOptions ...callopt.Option) (r *users.UpdatePrivacySettingsResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -131,3 +134,18 @@ func (p *kUserServiceClient) ChangePassword(ctx context.Context, req *users.Chan
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.ChangePassword(ctx, req)
 }
+
+func (p *kUserServiceClient) UpdateProfile(ctx context.Context, req *users.UpdateProfileRequest, callOptions ...callopt.Option) (r *users.UpdateProfileResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateProfile(ctx, req)
+}
+
+func (p *kUserServiceClient) UploadBackground(ctx context.Context, req *users.UploadBackgroundRequest, callOptions ...callopt.Option) (r *users.UploadBackgroundResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UploadBackground(ctx, req)
+}
+
+func (p *kUserServiceClient) UpdatePrivacySettings(ctx context.Context, req *users.UpdatePrivacySettingsRequest, callOptions ...callopt.Option) (r *users.UpdatePrivacySettingsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdatePrivacySettings(ctx, req)
+}
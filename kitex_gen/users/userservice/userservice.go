@@ -118,6 +118,27 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"UpdateProfile": kitex.NewMethodInfo(
+		updateProfileHandler,
+		newUserServiceUpdateProfileArgs,
+		newUserServiceUpdateProfileResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UploadBackground": kitex.NewMethodInfo(
+		uploadBackgroundHandler,
+		newUserServiceUploadBackgroundArgs,
+		newUserServiceUploadBackgroundResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdatePrivacySettings": kitex.NewMethodInfo(
+		updatePrivacySettingsHandler,
+		newUserServiceUpdatePrivacySettingsArgs,
+		newUserServiceUpdatePrivacySettingsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -454,6 +475,60 @@ func newUserServiceChangePasswordResult() interface{} {
 	return users.NewUserServiceChangePasswordResult()
 }
 
+func updateProfileHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*users.UserServiceUpdateProfileArgs)
+	realResult := result.(*users.UserServiceUpdateProfileResult)
+	success, err := handler.(users.UserService).UpdateProfile(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceUpdateProfileArgs() interface{} {
+	return users.NewUserServiceUpdateProfileArgs()
+}
+
+func newUserServiceUpdateProfileResult() interface{} {
+	return users.NewUserServiceUpdateProfileResult()
+}
+
+func uploadBackgroundHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*users.UserServiceUploadBackgroundArgs)
+	realResult := result.(*users.UserServiceUploadBackgroundResult)
+	success, err := handler.(users.UserService).UploadBackground(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceUploadBackgroundArgs() interface{} {
+	return users.NewUserServiceUploadBackgroundArgs()
+}
+
+func newUserServiceUploadBackgroundResult() interface{} {
+	return users.NewUserServiceUploadBackgroundResult()
+}
+
+func updatePrivacySettingsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*users.UserServiceUpdatePrivacySettingsArgs)
+	realResult := result.(*users.UserServiceUpdatePrivacySettingsResult)
+	success, err := handler.(users.UserService).UpdatePrivacySettings(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceUpdatePrivacySettingsArgs() interface{} {
+	return users.NewUserServiceUpdatePrivacySettingsArgs()
+}
+
+func newUserServiceUpdatePrivacySettingsResult() interface{} {
+	return users.NewUserServiceUpdatePrivacySettingsResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -613,3 +688,33 @@ func (p *kClient) ChangePassword(ctx context.Context, req *users.ChangePasswordR
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) UpdateProfile(ctx context.Context, req *users.UpdateProfileRequest) (r *users.UpdateProfileResponse, err error) {
+	var _args users.UserServiceUpdateProfileArgs
+	_args.Req = req
+	var _result users.UserServiceUpdateProfileResult
+	if err = p.c.Call(ctx, "UpdateProfile", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UploadBackground(ctx context.Context, req *users.UploadBackgroundRequest) (r *users.UploadBackgroundResponse, err error) {
+	var _args users.UserServiceUploadBackgroundArgs
+	_args.Req = req
+	var _result users.UserServiceUploadBackgroundResult
+	if err = p.c.Call(ctx, "UploadBackground", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdatePrivacySettings(ctx context.Context, req *users.UpdatePrivacySettingsRequest) (r *users.UpdatePrivacySettingsResponse, err error) {
+	var _args users.UserServiceUpdatePrivacySettingsArgs
+	_args.Req = req
+	var _result users.UserServiceUpdatePrivacySettingsResult
+	if err = p.c.Call(ctx, "UpdatePrivacySettings", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
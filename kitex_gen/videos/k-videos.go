@@ -5121,6 +5121,48 @@ func (p *VideoFeedListRequestV2) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField8(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField9(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -5233,6 +5275,48 @@ func (p *VideoFeedListRequestV2) FastReadField6(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *VideoFeedListRequestV2) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ViewerId = _field
+	return offset, nil
+}
+
+func (p *VideoFeedListRequestV2) FastReadField8(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Cursor = _field
+	return offset, nil
+}
+
+func (p *VideoFeedListRequestV2) FastReadField9(buf []byte) (int, error) {
+	offset := 0
+
+	var _field bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.UseCursor = _field
+	return offset, nil
+}
+
 func (p *VideoFeedListRequestV2) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -5243,9 +5327,12 @@ func (p *VideoFeedListRequestV2) FastWriteNocopy(buf []byte, w thrift.NocopyWrit
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField9(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField5(buf[offset:], w)
 		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
@@ -5260,6 +5347,9 @@ func (p *VideoFeedListRequestV2) BLength() int {
 		l += p.field4Length()
 		l += p.field5Length()
 		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field8Length()
+		l += p.field9Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -5314,6 +5404,27 @@ func (p *VideoFeedListRequestV2) fastWriteField6(buf []byte, w thrift.NocopyWrit
 	return offset
 }
 
+func (p *VideoFeedListRequestV2) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 7)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ViewerId)
+	return offset
+}
+
+func (p *VideoFeedListRequestV2) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 8)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Cursor)
+	return offset
+}
+
+func (p *VideoFeedListRequestV2) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 9)
+	offset += thrift.Binary.WriteBool(buf[offset:], p.UseCursor)
+	return offset
+}
+
 func (p *VideoFeedListRequestV2) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
@@ -5360,6 +5471,27 @@ func (p *VideoFeedListRequestV2) field6Length() int {
 	return l
 }
 
+func (p *VideoFeedListRequestV2) field7Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *VideoFeedListRequestV2) field8Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.Cursor)
+	return l
+}
+
+func (p *VideoFeedListRequestV2) field9Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.BoolLength()
+	return l
+}
+
 func (p *VideoFeedListResponseV2) FastRead(buf []byte) (int, error) {
 
 	var err error
@@ -5784,6 +5916,20 @@ func (p *VideoSearchRequestV2) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField9(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -5934,6 +6080,20 @@ func (p *VideoSearchRequestV2) FastReadField8(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *VideoSearchRequestV2) FastReadField9(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ViewerId = _field
+	return offset, nil
+}
+
 func (p *VideoSearchRequestV2) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -5943,6 +6103,7 @@ func (p *VideoSearchRequestV2) FastWriteNocopy(buf []byte, w thrift.NocopyWriter
 	if p != nil {
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField9(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField5(buf[offset:], w)
@@ -5965,6 +6126,7 @@ func (p *VideoSearchRequestV2) BLength() int {
 		l += p.field6Length()
 		l += p.field7Length()
 		l += p.field8Length()
+		l += p.field9Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -6040,6 +6202,13 @@ func (p *VideoSearchRequestV2) fastWriteField8(buf []byte, w thrift.NocopyWriter
 	return offset
 }
 
+func (p *VideoSearchRequestV2) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 9)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ViewerId)
+	return offset
+}
+
 func (p *VideoSearchRequestV2) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
@@ -6104,6 +6273,13 @@ func (p *VideoSearchRequestV2) field8Length() int {
 	return l
 }
 
+func (p *VideoSearchRequestV2) field9Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
 func (p *VideoSearchResponseV2) FastRead(buf []byte) (int, error) {
 
 	var err error
@@ -6525,6 +6701,20 @@ func (p *VideoPopularRequestV2) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -6599,6 +6789,20 @@ func (p *VideoPopularRequestV2) FastReadField4(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *VideoPopularRequestV2) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ViewerId = _field
+	return offset, nil
+}
+
 func (p *VideoPopularRequestV2) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -6608,6 +6812,7 @@ func (p *VideoPopularRequestV2) FastWriteNocopy(buf []byte, w thrift.NocopyWrite
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
 	}
@@ -6622,6 +6827,7 @@ func (p *VideoPopularRequestV2) BLength() int {
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
+		l += p.field5Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -6655,6 +6861,13 @@ func (p *VideoPopularRequestV2) fastWriteField4(buf []byte, w thrift.NocopyWrite
 	return offset
 }
 
+func (p *VideoPopularRequestV2) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 5)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ViewerId)
+	return offset
+}
+
 func (p *VideoPopularRequestV2) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
@@ -6683,6 +6896,13 @@ func (p *VideoPopularRequestV2) field4Length() int {
 	return l
 }
 
+func (p *VideoPopularRequestV2) field5Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
 func (p *VideoPopularResponseV2) FastRead(buf []byte) (int, error) {
 
 	var err error
@@ -11696,6 +11916,48 @@ func (p *GetFavoriteVideoListRequestV2) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField8(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -11784,19 +12046,64 @@ func (p *GetFavoriteVideoListRequestV2) FastReadField5(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *GetFavoriteVideoListRequestV2) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
+func (p *GetFavoriteVideoListRequestV2) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Cursor = _field
+	return offset, nil
 }
 
-func (p *GetFavoriteVideoListRequestV2) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *GetFavoriteVideoListRequestV2) FastReadField7(buf []byte) (int, error) {
 	offset := 0
-	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
-	}
+
+	var _field bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.UseCursor = _field
+	return offset, nil
+}
+
+func (p *GetFavoriteVideoListRequestV2) FastReadField8(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ViewerId = _field
+	return offset, nil
+}
+
+func (p *GetFavoriteVideoListRequestV2) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetFavoriteVideoListRequestV2) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
@@ -11809,6 +12116,9 @@ func (p *GetFavoriteVideoListRequestV2) BLength() int {
 		l += p.field3Length()
 		l += p.field4Length()
 		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field8Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -11849,6 +12159,27 @@ func (p *GetFavoriteVideoListRequestV2) fastWriteField5(buf []byte, w thrift.Noc
 	return offset
 }
 
+func (p *GetFavoriteVideoListRequestV2) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 6)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Cursor)
+	return offset
+}
+
+func (p *GetFavoriteVideoListRequestV2) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 7)
+	offset += thrift.Binary.WriteBool(buf[offset:], p.UseCursor)
+	return offset
+}
+
+func (p *GetFavoriteVideoListRequestV2) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 8)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ViewerId)
+	return offset
+}
+
 func (p *GetFavoriteVideoListRequestV2) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
@@ -11884,6 +12215,27 @@ func (p *GetFavoriteVideoListRequestV2) field5Length() int {
 	return l
 }
 
+func (p *GetFavoriteVideoListRequestV2) field6Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.Cursor)
+	return l
+}
+
+func (p *GetFavoriteVideoListRequestV2) field7Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.BoolLength()
+	return l
+}
+
+func (p *GetFavoriteVideoListRequestV2) field8Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
 func (p *GetFavoriteVideoListResponseV2) FastRead(buf []byte) (int, error) {
 
 	var err error
@@ -11943,6 +12295,34 @@ func (p *GetFavoriteVideoListResponseV2) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -12012,6 +12392,34 @@ func (p *GetFavoriteVideoListResponseV2) FastReadField3(buf []byte) (int, error)
 	return offset, nil
 }
 
+func (p *GetFavoriteVideoListResponseV2) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.HasMore = _field
+	return offset, nil
+}
+
+func (p *GetFavoriteVideoListResponseV2) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.NextCursor = _field
+	return offset, nil
+}
+
 func (p *GetFavoriteVideoListResponseV2) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -12020,8 +12428,10 @@ func (p *GetFavoriteVideoListResponseV2) FastWriteNocopy(buf []byte, w thrift.No
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
@@ -12033,6 +12443,8 @@ func (p *GetFavoriteVideoListResponseV2) BLength() int {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -12066,6 +12478,20 @@ func (p *GetFavoriteVideoListResponseV2) fastWriteField3(buf []byte, w thrift.No
 	return offset
 }
 
+func (p *GetFavoriteVideoListResponseV2) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 4)
+	offset += thrift.Binary.WriteBool(buf[offset:], p.HasMore)
+	return offset
+}
+
+func (p *GetFavoriteVideoListResponseV2) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.NextCursor)
+	return offset
+}
+
 func (p *GetFavoriteVideoListResponseV2) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
@@ -12091,6 +12517,20 @@ func (p *GetFavoriteVideoListResponseV2) field3Length() int {
 	return l
 }
 
+func (p *GetFavoriteVideoListResponseV2) field4Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.BoolLength()
+	return l
+}
+
+func (p *GetFavoriteVideoListResponseV2) field5Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.NextCursor)
+	return l
+}
+
 func (p *DeleteFavoriteRequestV2) FastRead(buf []byte) (int, error) {
 
 	var err error
@@ -13710,7 +14150,7 @@ func (p *RecommendVideoResponseV2) field4Length() int {
 	return l
 }
 
-func (p *VideoStorageInfo) FastRead(buf []byte) (int, error) {
+func (p *RelatedVideosRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -13756,7 +14196,7 @@ func (p *VideoStorageInfo) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -13769,107 +14209,154 @@ func (p *VideoStorageInfo) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 4:
-			if fieldTypeId == thrift.MAP {
-				l, err = p.FastReadField4(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.MAP {
-				l, err = p.FastReadField5(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 6:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField6(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 7:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField7(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 8:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField8(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 9:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField9(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
 			}
-		case 10:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField10(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 11:
-			if fieldTypeId == thrift.I32 {
-				l, err = p.FastReadField11(buf[offset:])
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelatedVideosRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *RelatedVideosRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.VideoId = _field
+	return offset, nil
+}
+
+func (p *RelatedVideosRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.UserId = _field
+	return offset, nil
+}
+
+func (p *RelatedVideosRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Count = _field
+	return offset, nil
+}
+
+func (p *RelatedVideosRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *RelatedVideosRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *RelatedVideosRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *RelatedVideosRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.VideoId)
+	return offset
+}
+
+func (p *RelatedVideosRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.UserId)
+	return offset
+}
+
+func (p *RelatedVideosRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
+	offset += thrift.Binary.WriteI32(buf[offset:], p.Count)
+	return offset
+}
+
+func (p *RelatedVideosRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *RelatedVideosRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *RelatedVideosRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I32Length()
+	return l
+}
+
+func (p *RelatedVideosResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -13881,9 +14368,9 @@ func (p *VideoStorageInfo) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 12:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField12(buf[offset:])
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -13895,9 +14382,9 @@ func (p *VideoStorageInfo) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 13:
+		case 3:
 			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField13(buf[offset:])
+				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -13922,118 +14409,49 @@ func (p *VideoStorageInfo) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoStorageInfo[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelatedVideosResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoStorageInfo) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.UserId = _field
-	return offset, nil
-}
-
-func (p *VideoStorageInfo) FastReadField2(buf []byte) (int, error) {
-	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.VideoId = _field
-	return offset, nil
-}
-
-func (p *VideoStorageInfo) FastReadField3(buf []byte) (int, error) {
+func (p *RelatedVideosResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-
-	var _field string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_field := base.NewStatus()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
-	}
-	p.SourcePath = _field
-	return offset, nil
-}
-
-func (p *VideoStorageInfo) FastReadField4(buf []byte) (int, error) {
-	offset := 0
-
-	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make(map[int32]string, size)
-	for i := 0; i < size; i++ {
-		var _key int32
-		if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_key = v
-		}
-
-		var _val string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_val = v
-		}
-
-		_field[_key] = _val
 	}
-	p.ProcessedPaths = _field
+	p.Base = _field
 	return offset, nil
 }
 
-func (p *VideoStorageInfo) FastReadField5(buf []byte) (int, error) {
+func (p *RelatedVideosResponse) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
 	offset += l
 	if err != nil {
 		return offset, err
 	}
-	_field := make(map[string]string, size)
+	_field := make([]*base.Video, 0, size)
+	values := make([]base.Video, size)
 	for i := 0; i < size; i++ {
-		var _key string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_key = v
-		}
-
-		var _val string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
 			return offset, err
 		} else {
 			offset += l
-			_val = v
 		}
 
-		_field[_key] = _val
+		_field = append(_field, _elem)
 	}
-	p.ThumbnailPaths = _field
+	p.VideoList = _field
 	return offset, nil
 }
 
-func (p *VideoStorageInfo) FastReadField6(buf []byte) (int, error) {
+func (p *RelatedVideosResponse) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
 	var _field string
@@ -14043,363 +14461,233 @@ func (p *VideoStorageInfo) FastReadField6(buf []byte) (int, error) {
 		offset += l
 		_field = v
 	}
-	p.AnimatedCoverPath = _field
+	p.Source = _field
 	return offset, nil
 }
 
-func (p *VideoStorageInfo) FastReadField7(buf []byte) (int, error) {
-	offset := 0
-
-	var _field string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.MetadataPath = _field
-	return offset, nil
+func (p *RelatedVideosResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoStorageInfo) FastReadField8(buf []byte) (int, error) {
+func (p *RelatedVideosResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
 
-	var _field string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
+func (p *RelatedVideosResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
 	}
-	p.StorageTier = _field
-	return offset, nil
+	l += thrift.Binary.FieldStopLength()
+	return l
 }
 
-func (p *VideoStorageInfo) FastReadField9(buf []byte) (int, error) {
+func (p *RelatedVideosResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
 
-	var _field string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
+func (p *RelatedVideosResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.VideoList {
+		length++
+		offset += v.FastWriteNocopy(buf[offset:], w)
 	}
-	p.BucketName = _field
-	return offset, nil
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	return offset
 }
 
-func (p *VideoStorageInfo) FastReadField10(buf []byte) (int, error) {
+func (p *RelatedVideosResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Source)
+	return offset
+}
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
+func (p *RelatedVideosResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Base.BLength()
+	return l
+}
+
+func (p *RelatedVideosResponse) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.ListBeginLength()
+	for _, v := range p.VideoList {
+		_ = v
+		l += v.BLength()
 	}
-	p.FileSize = _field
-	return offset, nil
+	return l
 }
 
-func (p *VideoStorageInfo) FastReadField11(buf []byte) (int, error) {
-	offset := 0
+func (p *RelatedVideosResponse) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.Source)
+	return l
+}
 
-	var _field int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
-		return offset, err
-	} else {
+func (p *ForYouFeedRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
-		_field = v
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
 	}
-	p.DurationSeconds = _field
+
 	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ForYouFeedRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoStorageInfo) FastReadField12(buf []byte) (int, error) {
+func (p *ForYouFeedRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoResolution()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = v
 	}
-	p.Resolution = _field
+	p.UserId = _field
 	return offset, nil
 }
 
-func (p *VideoStorageInfo) FastReadField13(buf []byte) (int, error) {
+func (p *ForYouFeedRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = v
 	}
-	p.Format = _field
+	p.Count = _field
 	return offset, nil
 }
 
-func (p *VideoStorageInfo) FastWrite(buf []byte) int {
+func (p *ForYouFeedRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoStorageInfo) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ForYouFeedRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField10(buf[offset:], w)
-		offset += p.fastWriteField11(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
-		offset += p.fastWriteField6(buf[offset:], w)
-		offset += p.fastWriteField7(buf[offset:], w)
-		offset += p.fastWriteField8(buf[offset:], w)
-		offset += p.fastWriteField9(buf[offset:], w)
-		offset += p.fastWriteField12(buf[offset:], w)
-		offset += p.fastWriteField13(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *VideoStorageInfo) BLength() int {
+func (p *ForYouFeedRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
-		l += p.field3Length()
-		l += p.field4Length()
-		l += p.field5Length()
-		l += p.field6Length()
-		l += p.field7Length()
-		l += p.field8Length()
-		l += p.field9Length()
-		l += p.field10Length()
-		l += p.field11Length()
-		l += p.field12Length()
-		l += p.field13Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *VideoStorageInfo) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ForYouFeedRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.UserId)
 	return offset
 }
 
-func (p *VideoStorageInfo) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.VideoId)
-	return offset
-}
-
-func (p *VideoStorageInfo) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.SourcePath)
-	return offset
-}
-
-func (p *VideoStorageInfo) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 4)
-	mapBeginOffset := offset
-	offset += thrift.Binary.MapBeginLength()
-	var length int
-	for k, v := range p.ProcessedPaths {
-		length++
-		offset += thrift.Binary.WriteI32(buf[offset:], k)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
-	}
-	thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.I32, thrift.STRING, length)
-	return offset
-}
-
-func (p *VideoStorageInfo) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 5)
-	mapBeginOffset := offset
-	offset += thrift.Binary.MapBeginLength()
-	var length int
-	for k, v := range p.ThumbnailPaths {
-		length++
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
-	}
-	thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRING, length)
-	return offset
-}
-
-func (p *VideoStorageInfo) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+func (p *ForYouFeedRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 6)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.AnimatedCoverPath)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 2)
+	offset += thrift.Binary.WriteI32(buf[offset:], p.Count)
 	return offset
 }
 
-func (p *VideoStorageInfo) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 7)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.MetadataPath)
-	return offset
+func (p *ForYouFeedRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
 }
 
-func (p *VideoStorageInfo) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 8)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.StorageTier)
-	return offset
+func (p *ForYouFeedRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I32Length()
+	return l
 }
 
-func (p *VideoStorageInfo) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 9)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.BucketName)
-	return offset
-}
-
-func (p *VideoStorageInfo) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 10)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.FileSize)
-	return offset
-}
-
-func (p *VideoStorageInfo) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 11)
-	offset += thrift.Binary.WriteI32(buf[offset:], p.DurationSeconds)
-	return offset
-}
-
-func (p *VideoStorageInfo) fastWriteField12(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 12)
-	offset += p.Resolution.FastWriteNocopy(buf[offset:], w)
-	return offset
-}
-
-func (p *VideoStorageInfo) fastWriteField13(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 13)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Format)
-	return offset
-}
-
-func (p *VideoStorageInfo) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
-}
-
-func (p *VideoStorageInfo) field2Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
-}
-
-func (p *VideoStorageInfo) field3Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.SourcePath)
-	return l
-}
-
-func (p *VideoStorageInfo) field4Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.MapBeginLength()
-	for k, v := range p.ProcessedPaths {
-		_, _ = k, v
-
-		l += thrift.Binary.I32Length()
-		l += thrift.Binary.StringLengthNocopy(v)
-	}
-	return l
-}
-
-func (p *VideoStorageInfo) field5Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.MapBeginLength()
-	for k, v := range p.ThumbnailPaths {
-		_, _ = k, v
-
-		l += thrift.Binary.StringLengthNocopy(k)
-		l += thrift.Binary.StringLengthNocopy(v)
-	}
-	return l
-}
-
-func (p *VideoStorageInfo) field6Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.AnimatedCoverPath)
-	return l
-}
-
-func (p *VideoStorageInfo) field7Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.MetadataPath)
-	return l
-}
-
-func (p *VideoStorageInfo) field8Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.StorageTier)
-	return l
-}
-
-func (p *VideoStorageInfo) field9Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.BucketName)
-	return l
-}
-
-func (p *VideoStorageInfo) field10Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
-}
-
-func (p *VideoStorageInfo) field11Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I32Length()
-	return l
-}
-
-func (p *VideoStorageInfo) field12Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += p.Resolution.BLength()
-	return l
-}
-
-func (p *VideoStorageInfo) field13Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.Format)
-	return l
-}
-
-func (p *VideoHeatManagementRequest) FastRead(buf []byte) (int, error) {
+func (p *ForYouFeedResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -14417,7 +14705,7 @@ func (p *VideoHeatManagementRequest) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -14431,7 +14719,7 @@ func (p *VideoHeatManagementRequest) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -14445,7 +14733,7 @@ func (p *VideoHeatManagementRequest) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -14471,69 +14759,78 @@ func (p *VideoHeatManagementRequest) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoHeatManagementRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ForYouFeedResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoHeatManagementRequest) FastReadField1(buf []byte) (int, error) {
+func (p *ForYouFeedResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_field := base.NewStatus()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
 	}
-	p.VideoId = _field
+	p.Base = _field
 	return offset, nil
 }
 
-func (p *VideoHeatManagementRequest) FastReadField2(buf []byte) (int, error) {
+func (p *ForYouFeedResponse) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
-		_field = v
 	}
-	p.Operation = _field
+	_field := make([]*base.Video, 0, size)
+	values := make([]base.Video, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.VideoList = _field
 	return offset, nil
 }
 
-func (p *VideoHeatManagementRequest) FastReadField3(buf []byte) (int, error) {
+func (p *ForYouFeedResponse) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = v
 	}
-	p.Reason = _field
+	p.ExploreCount = _field
 	return offset, nil
 }
 
-func (p *VideoHeatManagementRequest) FastWrite(buf []byte) int {
+func (p *ForYouFeedResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoHeatManagementRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ForYouFeedResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
+		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *VideoHeatManagementRequest) BLength() int {
+func (p *ForYouFeedResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -14544,49 +14841,60 @@ func (p *VideoHeatManagementRequest) BLength() int {
 	return l
 }
 
-func (p *VideoHeatManagementRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ForYouFeedResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.VideoId)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Base.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoHeatManagementRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ForYouFeedResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Operation)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.VideoList {
+		length++
+		offset += v.FastWriteNocopy(buf[offset:], w)
+	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	return offset
 }
 
-func (p *VideoHeatManagementRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *ForYouFeedResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Reason)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
+	offset += thrift.Binary.WriteI32(buf[offset:], p.ExploreCount)
 	return offset
 }
 
-func (p *VideoHeatManagementRequest) field1Length() int {
+func (p *ForYouFeedResponse) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	l += p.Base.BLength()
 	return l
 }
 
-func (p *VideoHeatManagementRequest) field2Length() int {
+func (p *ForYouFeedResponse) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.Operation)
+	l += thrift.Binary.ListBeginLength()
+	for _, v := range p.VideoList {
+		_ = v
+		l += v.BLength()
+	}
 	return l
 }
 
-func (p *VideoHeatManagementRequest) field3Length() int {
+func (p *ForYouFeedResponse) field3Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.Reason)
+	l += thrift.Binary.I32Length()
 	return l
 }
 
-func (p *VideoHeatManagementResponse) FastRead(buf []byte) (int, error) {
+func (p *FollowingTimelineRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -14604,7 +14912,7 @@ func (p *VideoHeatManagementResponse) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -14632,7 +14940,7 @@ func (p *VideoHeatManagementResponse) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -14645,20 +14953,6 @@ func (p *VideoHeatManagementResponse) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField4(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -14672,24 +14966,26 @@ func (p *VideoHeatManagementResponse) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoHeatManagementResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowingTimelineRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoHeatManagementResponse) FastReadField1(buf []byte) (int, error) {
+func (p *FollowingTimelineRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewStatus()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = v
 	}
-	p.Base = _field
+	p.UserId = _field
 	return offset, nil
 }
 
-func (p *VideoHeatManagementResponse) FastReadField2(buf []byte) (int, error) {
+func (p *FollowingTimelineRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field string
@@ -14699,123 +14995,93 @@ func (p *VideoHeatManagementResponse) FastReadField2(buf []byte) (int, error) {
 		offset += l
 		_field = v
 	}
-	p.OldTier = _field
+	p.Cursor = _field
 	return offset, nil
 }
 
-func (p *VideoHeatManagementResponse) FastReadField3(buf []byte) (int, error) {
+func (p *FollowingTimelineRequest) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = v
 	}
-	p.NewTier_ = _field
+	p.Count = _field
 	return offset, nil
 }
 
-func (p *VideoHeatManagementResponse) FastReadField4(buf []byte) (int, error) {
-	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.OperationCostBytes = _field
-	return offset, nil
-}
-
-func (p *VideoHeatManagementResponse) FastWrite(buf []byte) int {
+func (p *FollowingTimelineRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoHeatManagementResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *FollowingTimelineRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *VideoHeatManagementResponse) BLength() int {
+func (p *FollowingTimelineRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
-		l += p.field4Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *VideoHeatManagementResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *FollowingTimelineRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-	offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.UserId)
 	return offset
 }
 
-func (p *VideoHeatManagementResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *FollowingTimelineRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.OldTier)
-	return offset
-}
-
-func (p *VideoHeatManagementResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.NewTier_)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Cursor)
 	return offset
 }
 
-func (p *VideoHeatManagementResponse) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *FollowingTimelineRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.OperationCostBytes)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
+	offset += thrift.Binary.WriteI32(buf[offset:], p.Count)
 	return offset
 }
 
-func (p *VideoHeatManagementResponse) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += p.Base.BLength()
-	return l
-}
-
-func (p *VideoHeatManagementResponse) field2Length() int {
+func (p *FollowingTimelineRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.OldTier)
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *VideoHeatManagementResponse) field3Length() int {
+func (p *FollowingTimelineRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.NewTier_)
+	l += thrift.Binary.StringLengthNocopy(p.Cursor)
 	return l
 }
 
-func (p *VideoHeatManagementResponse) field4Length() int {
+func (p *FollowingTimelineRequest) field3Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	l += thrift.Binary.I32Length()
 	return l
 }
 
-func (p *UserQuotaManagementRequest) FastRead(buf []byte) (int, error) {
+func (p *FollowingTimelineResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -14833,7 +15099,7 @@ func (p *UserQuotaManagementRequest) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -14847,7 +15113,7 @@ func (p *UserQuotaManagementRequest) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -14861,7 +15127,7 @@ func (p *UserQuotaManagementRequest) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -14874,6 +15140,20 @@ func (p *UserQuotaManagementRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -14887,26 +15167,49 @@ func (p *UserQuotaManagementRequest) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserQuotaManagementRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowingTimelineResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *UserQuotaManagementRequest) FastReadField1(buf []byte) (int, error) {
+func (p *FollowingTimelineResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_field := base.NewStatus()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
 	}
-	p.UserId = _field
+	p.Base = _field
 	return offset, nil
 }
 
-func (p *UserQuotaManagementRequest) FastReadField2(buf []byte) (int, error) {
+func (p *FollowingTimelineResponse) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*base.Video, 0, size)
+	values := make([]base.Video, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.VideoList = _field
+	return offset, nil
+}
+
+func (p *FollowingTimelineResponse) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
 	var _field string
@@ -14916,29 +15219,32 @@ func (p *UserQuotaManagementRequest) FastReadField2(buf []byte) (int, error) {
 		offset += l
 		_field = v
 	}
-	p.Operation = _field
+	p.NextCursor = _field
 	return offset, nil
 }
 
-func (p *UserQuotaManagementRequest) FastReadField3(buf []byte) (int, error) {
+func (p *FollowingTimelineResponse) FastReadField4(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUserStorageQuota()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = v
 	}
-	p.NewQuota_ = _field
+	p.HasMore = _field
 	return offset, nil
 }
 
-func (p *UserQuotaManagementRequest) FastWrite(buf []byte) int {
+func (p *FollowingTimelineResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *UserQuotaManagementRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *FollowingTimelineResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
+		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
@@ -14947,60 +15253,86 @@ func (p *UserQuotaManagementRequest) FastWriteNocopy(buf []byte, w thrift.Nocopy
 	return offset
 }
 
-func (p *UserQuotaManagementRequest) BLength() int {
+func (p *FollowingTimelineResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
+		l += p.field4Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *UserQuotaManagementRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *FollowingTimelineResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.UserId)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Base.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *UserQuotaManagementRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *FollowingTimelineResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Operation)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.VideoList {
+		length++
+		offset += v.FastWriteNocopy(buf[offset:], w)
+	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	return offset
 }
 
-func (p *UserQuotaManagementRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *FollowingTimelineResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
-	offset += p.NewQuota_.FastWriteNocopy(buf[offset:], w)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.NextCursor)
 	return offset
 }
 
-func (p *UserQuotaManagementRequest) field1Length() int {
+func (p *FollowingTimelineResponse) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 4)
+	offset += thrift.Binary.WriteBool(buf[offset:], p.HasMore)
+	return offset
+}
+
+func (p *FollowingTimelineResponse) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	l += p.Base.BLength()
 	return l
 }
 
-func (p *UserQuotaManagementRequest) field2Length() int {
+func (p *FollowingTimelineResponse) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.Operation)
+	l += thrift.Binary.ListBeginLength()
+	for _, v := range p.VideoList {
+		_ = v
+		l += v.BLength()
+	}
 	return l
 }
 
-func (p *UserQuotaManagementRequest) field3Length() int {
+func (p *FollowingTimelineResponse) field3Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += p.NewQuota_.BLength()
+	l += thrift.Binary.StringLengthNocopy(p.NextCursor)
 	return l
 }
 
-func (p *UserQuotaManagementResponse) FastRead(buf []byte) (int, error) {
+func (p *FollowingTimelineResponse) field4Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.BoolLength()
+	return l
+}
+
+func (p *VideoStorageInfo) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -15018,7 +15350,7 @@ func (p *UserQuotaManagementResponse) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -15032,7 +15364,7 @@ func (p *UserQuotaManagementResponse) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -15046,7 +15378,7 @@ func (p *UserQuotaManagementResponse) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -15060,7 +15392,7 @@ func (p *UserQuotaManagementResponse) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.MAP {
 				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
@@ -15073,201 +15405,23 @@ func (p *UserQuotaManagementResponse) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		default:
-			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-			offset += l
-			if err != nil {
-				goto SkipFieldError
+		case 5:
+			if fieldTypeId == thrift.MAP {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
 			}
-		}
-	}
-
-	return offset, nil
-ReadFieldBeginError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserQuotaManagementResponse[fieldId]), err)
-SkipFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-}
-
-func (p *UserQuotaManagementResponse) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-	_field := base.NewStatus()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.Base = _field
-	return offset, nil
-}
-
-func (p *UserQuotaManagementResponse) FastReadField2(buf []byte) (int, error) {
-	offset := 0
-	_field := NewUserStorageQuota()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.CurrentQuota = _field
-	return offset, nil
-}
-
-func (p *UserQuotaManagementResponse) FastReadField3(buf []byte) (int, error) {
-	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]string, 0, size)
-	for i := 0; i < size; i++ {
-		var _elem string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_elem = v
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.QuotaWarnings = _field
-	return offset, nil
-}
-
-func (p *UserQuotaManagementResponse) FastReadField4(buf []byte) (int, error) {
-	offset := 0
-
-	var _field bool
-	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.QuotaExceeded = _field
-	return offset, nil
-}
-
-func (p *UserQuotaManagementResponse) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
-}
-
-func (p *UserQuotaManagementResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p != nil {
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-	}
-	offset += thrift.Binary.WriteFieldStop(buf[offset:])
-	return offset
-}
-
-func (p *UserQuotaManagementResponse) BLength() int {
-	l := 0
-	if p != nil {
-		l += p.field1Length()
-		l += p.field2Length()
-		l += p.field3Length()
-		l += p.field4Length()
-	}
-	l += thrift.Binary.FieldStopLength()
-	return l
-}
-
-func (p *UserQuotaManagementResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-	offset += p.Base.FastWriteNocopy(buf[offset:], w)
-	return offset
-}
-
-func (p *UserQuotaManagementResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
-	offset += p.CurrentQuota.FastWriteNocopy(buf[offset:], w)
-	return offset
-}
-
-func (p *UserQuotaManagementResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
-	listBeginOffset := offset
-	offset += thrift.Binary.ListBeginLength()
-	var length int
-	for _, v := range p.QuotaWarnings {
-		length++
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
-	}
-	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
-	return offset
-}
-
-func (p *UserQuotaManagementResponse) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 4)
-	offset += thrift.Binary.WriteBool(buf[offset:], p.QuotaExceeded)
-	return offset
-}
-
-func (p *UserQuotaManagementResponse) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += p.Base.BLength()
-	return l
-}
-
-func (p *UserQuotaManagementResponse) field2Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += p.CurrentQuota.BLength()
-	return l
-}
-
-func (p *UserQuotaManagementResponse) field3Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.ListBeginLength()
-	for _, v := range p.QuotaWarnings {
-		_ = v
-		l += thrift.Binary.StringLengthNocopy(v)
-	}
-	return l
-}
-
-func (p *UserQuotaManagementResponse) field4Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.BoolLength()
-	return l
-}
-
-func (p *BatchVideoOperationRequest) FastRead(buf []byte) (int, error) {
-
-	var err error
-	var offset int
-	var l int
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	for {
-		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
-		offset += l
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField1(buf[offset:])
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField6(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -15279,9 +15433,9 @@ func (p *BatchVideoOperationRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 2:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField2(buf[offset:])
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField7(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -15293,9 +15447,9 @@ func (p *BatchVideoOperationRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 3:
+		case 8:
 			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField3(buf[offset:])
+				l, err = p.FastReadField8(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -15307,9 +15461,65 @@ func (p *BatchVideoOperationRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 4:
-			if fieldTypeId == thrift.MAP {
-				l, err = p.FastReadField4(buf[offset:])
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField9(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField10(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 11:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField11(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 12:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField12(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 13:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField13(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -15334,12 +15544,12 @@ func (p *BatchVideoOperationRequest) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchVideoOperationRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoStorageInfo[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *BatchVideoOperationRequest) FastReadField1(buf []byte) (int, error) {
+func (p *VideoStorageInfo) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -15353,31 +15563,21 @@ func (p *BatchVideoOperationRequest) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *BatchVideoOperationRequest) FastReadField2(buf []byte) (int, error) {
+func (p *VideoStorageInfo) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = v
 	}
-	_field := make([]int64, 0, size)
-	for i := 0; i < size; i++ {
-		var _elem int64
-		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_elem = v
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.VideoIds = _field
+	p.VideoId = _field
 	return offset, nil
 }
 
-func (p *BatchVideoOperationRequest) FastReadField3(buf []byte) (int, error) {
+func (p *VideoStorageInfo) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
 	var _field string
@@ -15387,11 +15587,11 @@ func (p *BatchVideoOperationRequest) FastReadField3(buf []byte) (int, error) {
 		offset += l
 		_field = v
 	}
-	p.Operation = _field
+	p.SourcePath = _field
 	return offset, nil
 }
 
-func (p *BatchVideoOperationRequest) FastReadField4(buf []byte) (int, error) {
+func (p *VideoStorageInfo) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
 	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
@@ -15399,10 +15599,10 @@ func (p *BatchVideoOperationRequest) FastReadField4(buf []byte) (int, error) {
 	if err != nil {
 		return offset, err
 	}
-	_field := make(map[string]string, size)
+	_field := make(map[int32]string, size)
 	for i := 0; i < size; i++ {
-		var _key string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		var _key int32
+		if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 			return offset, err
 		} else {
 			offset += l
@@ -15419,19 +15619,2051 @@ func (p *BatchVideoOperationRequest) FastReadField4(buf []byte) (int, error) {
 
 		_field[_key] = _val
 	}
-	p.OperationParams = _field
+	p.ProcessedPaths = _field
 	return offset, nil
 }
 
-func (p *BatchVideoOperationRequest) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
-}
-
-func (p *BatchVideoOperationRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoStorageInfo) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		var _val string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	p.ThumbnailPaths = _field
+	return offset, nil
+}
+
+func (p *VideoStorageInfo) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.AnimatedCoverPath = _field
+	return offset, nil
+}
+
+func (p *VideoStorageInfo) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.MetadataPath = _field
+	return offset, nil
+}
+
+func (p *VideoStorageInfo) FastReadField8(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.StorageTier = _field
+	return offset, nil
+}
+
+func (p *VideoStorageInfo) FastReadField9(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.BucketName = _field
+	return offset, nil
+}
+
+func (p *VideoStorageInfo) FastReadField10(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.FileSize = _field
+	return offset, nil
+}
+
+func (p *VideoStorageInfo) FastReadField11(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.DurationSeconds = _field
+	return offset, nil
+}
+
+func (p *VideoStorageInfo) FastReadField12(buf []byte) (int, error) {
+	offset := 0
+	_field := NewVideoResolution()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Resolution = _field
+	return offset, nil
+}
+
+func (p *VideoStorageInfo) FastReadField13(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Format = _field
+	return offset, nil
+}
+
+func (p *VideoStorageInfo) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *VideoStorageInfo) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField10(buf[offset:], w)
+		offset += p.fastWriteField11(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
+		offset += p.fastWriteField9(buf[offset:], w)
+		offset += p.fastWriteField12(buf[offset:], w)
+		offset += p.fastWriteField13(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *VideoStorageInfo) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field8Length()
+		l += p.field9Length()
+		l += p.field10Length()
+		l += p.field11Length()
+		l += p.field12Length()
+		l += p.field13Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *VideoStorageInfo) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.UserId)
+	return offset
+}
+
+func (p *VideoStorageInfo) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.VideoId)
+	return offset
+}
+
+func (p *VideoStorageInfo) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.SourcePath)
+	return offset
+}
+
+func (p *VideoStorageInfo) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 4)
+	mapBeginOffset := offset
+	offset += thrift.Binary.MapBeginLength()
+	var length int
+	for k, v := range p.ProcessedPaths {
+		length++
+		offset += thrift.Binary.WriteI32(buf[offset:], k)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+	}
+	thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.I32, thrift.STRING, length)
+	return offset
+}
+
+func (p *VideoStorageInfo) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 5)
+	mapBeginOffset := offset
+	offset += thrift.Binary.MapBeginLength()
+	var length int
+	for k, v := range p.ThumbnailPaths {
+		length++
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+	}
+	thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRING, length)
+	return offset
+}
+
+func (p *VideoStorageInfo) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 6)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.AnimatedCoverPath)
+	return offset
+}
+
+func (p *VideoStorageInfo) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 7)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.MetadataPath)
+	return offset
+}
+
+func (p *VideoStorageInfo) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 8)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.StorageTier)
+	return offset
+}
+
+func (p *VideoStorageInfo) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 9)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.BucketName)
+	return offset
+}
+
+func (p *VideoStorageInfo) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 10)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.FileSize)
+	return offset
+}
+
+func (p *VideoStorageInfo) fastWriteField11(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 11)
+	offset += thrift.Binary.WriteI32(buf[offset:], p.DurationSeconds)
+	return offset
+}
+
+func (p *VideoStorageInfo) fastWriteField12(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 12)
+	offset += p.Resolution.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *VideoStorageInfo) fastWriteField13(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 13)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Format)
+	return offset
+}
+
+func (p *VideoStorageInfo) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *VideoStorageInfo) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *VideoStorageInfo) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.SourcePath)
+	return l
+}
+
+func (p *VideoStorageInfo) field4Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.MapBeginLength()
+	for k, v := range p.ProcessedPaths {
+		_, _ = k, v
+
+		l += thrift.Binary.I32Length()
+		l += thrift.Binary.StringLengthNocopy(v)
+	}
+	return l
+}
+
+func (p *VideoStorageInfo) field5Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.MapBeginLength()
+	for k, v := range p.ThumbnailPaths {
+		_, _ = k, v
+
+		l += thrift.Binary.StringLengthNocopy(k)
+		l += thrift.Binary.StringLengthNocopy(v)
+	}
+	return l
+}
+
+func (p *VideoStorageInfo) field6Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.AnimatedCoverPath)
+	return l
+}
+
+func (p *VideoStorageInfo) field7Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.MetadataPath)
+	return l
+}
+
+func (p *VideoStorageInfo) field8Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.StorageTier)
+	return l
+}
+
+func (p *VideoStorageInfo) field9Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.BucketName)
+	return l
+}
+
+func (p *VideoStorageInfo) field10Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *VideoStorageInfo) field11Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I32Length()
+	return l
+}
+
+func (p *VideoStorageInfo) field12Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Resolution.BLength()
+	return l
+}
+
+func (p *VideoStorageInfo) field13Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.Format)
+	return l
+}
+
+func (p *VideoHeatManagementRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoHeatManagementRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *VideoHeatManagementRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.VideoId = _field
+	return offset, nil
+}
+
+func (p *VideoHeatManagementRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Operation = _field
+	return offset, nil
+}
+
+func (p *VideoHeatManagementRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Reason = _field
+	return offset, nil
+}
+
+func (p *VideoHeatManagementRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *VideoHeatManagementRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *VideoHeatManagementRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *VideoHeatManagementRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.VideoId)
+	return offset
+}
+
+func (p *VideoHeatManagementRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Operation)
+	return offset
+}
+
+func (p *VideoHeatManagementRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Reason)
+	return offset
+}
+
+func (p *VideoHeatManagementRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *VideoHeatManagementRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.Operation)
+	return l
+}
+
+func (p *VideoHeatManagementRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.Reason)
+	return l
+}
+
+func (p *VideoHeatManagementResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoHeatManagementResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *VideoHeatManagementResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewStatus()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *VideoHeatManagementResponse) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.OldTier = _field
+	return offset, nil
+}
+
+func (p *VideoHeatManagementResponse) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.NewTier_ = _field
+	return offset, nil
+}
+
+func (p *VideoHeatManagementResponse) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.OperationCostBytes = _field
+	return offset, nil
+}
+
+func (p *VideoHeatManagementResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *VideoHeatManagementResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *VideoHeatManagementResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *VideoHeatManagementResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *VideoHeatManagementResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.OldTier)
+	return offset
+}
+
+func (p *VideoHeatManagementResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.NewTier_)
+	return offset
+}
+
+func (p *VideoHeatManagementResponse) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.OperationCostBytes)
+	return offset
+}
+
+func (p *VideoHeatManagementResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Base.BLength()
+	return l
+}
+
+func (p *VideoHeatManagementResponse) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.OldTier)
+	return l
+}
+
+func (p *VideoHeatManagementResponse) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.NewTier_)
+	return l
+}
+
+func (p *VideoHeatManagementResponse) field4Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *UserQuotaManagementRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserQuotaManagementRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UserQuotaManagementRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.UserId = _field
+	return offset, nil
+}
+
+func (p *UserQuotaManagementRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Operation = _field
+	return offset, nil
+}
+
+func (p *UserQuotaManagementRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+	_field := NewUserStorageQuota()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.NewQuota_ = _field
+	return offset, nil
+}
+
+func (p *UserQuotaManagementRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UserQuotaManagementRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UserQuotaManagementRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UserQuotaManagementRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.UserId)
+	return offset
+}
+
+func (p *UserQuotaManagementRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Operation)
+	return offset
+}
+
+func (p *UserQuotaManagementRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
+	offset += p.NewQuota_.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *UserQuotaManagementRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *UserQuotaManagementRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.Operation)
+	return l
+}
+
+func (p *UserQuotaManagementRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.NewQuota_.BLength()
+	return l
+}
+
+func (p *UserQuotaManagementResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserQuotaManagementResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UserQuotaManagementResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewStatus()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *UserQuotaManagementResponse) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+	_field := NewUserStorageQuota()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.CurrentQuota = _field
+	return offset, nil
+}
+
+func (p *UserQuotaManagementResponse) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.QuotaWarnings = _field
+	return offset, nil
+}
+
+func (p *UserQuotaManagementResponse) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.QuotaExceeded = _field
+	return offset, nil
+}
+
+func (p *UserQuotaManagementResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UserQuotaManagementResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UserQuotaManagementResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UserQuotaManagementResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *UserQuotaManagementResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+	offset += p.CurrentQuota.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *UserQuotaManagementResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.QuotaWarnings {
+		length++
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
+	return offset
+}
+
+func (p *UserQuotaManagementResponse) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 4)
+	offset += thrift.Binary.WriteBool(buf[offset:], p.QuotaExceeded)
+	return offset
+}
+
+func (p *UserQuotaManagementResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Base.BLength()
+	return l
+}
+
+func (p *UserQuotaManagementResponse) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.CurrentQuota.BLength()
+	return l
+}
+
+func (p *UserQuotaManagementResponse) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.ListBeginLength()
+	for _, v := range p.QuotaWarnings {
+		_ = v
+		l += thrift.Binary.StringLengthNocopy(v)
+	}
+	return l
+}
+
+func (p *UserQuotaManagementResponse) field4Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.BoolLength()
+	return l
+}
+
+func (p *BatchVideoOperationRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.MAP {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchVideoOperationRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *BatchVideoOperationRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.UserId = _field
+	return offset, nil
+}
+
+func (p *BatchVideoOperationRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.VideoIds = _field
+	return offset, nil
+}
+
+func (p *BatchVideoOperationRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Operation = _field
+	return offset, nil
+}
+
+func (p *BatchVideoOperationRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		var _val string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	p.OperationParams = _field
+	return offset, nil
+}
+
+func (p *BatchVideoOperationRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *BatchVideoOperationRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *BatchVideoOperationRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *BatchVideoOperationRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.UserId)
+	return offset
+}
+
+func (p *BatchVideoOperationRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.VideoIds {
+		length++
+		offset += thrift.Binary.WriteI64(buf[offset:], v)
+	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
+	return offset
+}
+
+func (p *BatchVideoOperationRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Operation)
+	return offset
+}
+
+func (p *BatchVideoOperationRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 4)
+	mapBeginOffset := offset
+	offset += thrift.Binary.MapBeginLength()
+	var length int
+	for k, v := range p.OperationParams {
+		length++
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+	}
+	thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRING, length)
+	return offset
+}
+
+func (p *BatchVideoOperationRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *BatchVideoOperationRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.ListBeginLength()
+	l +=
+		thrift.Binary.I64Length() * len(p.VideoIds)
+	return l
+}
+
+func (p *BatchVideoOperationRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.Operation)
+	return l
+}
+
+func (p *BatchVideoOperationRequest) field4Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.MapBeginLength()
+	for k, v := range p.OperationParams {
+		_, _ = k, v
+
+		l += thrift.Binary.StringLengthNocopy(k)
+		l += thrift.Binary.StringLengthNocopy(v)
+	}
+	return l
+}
+
+func (p *BatchVideoOperationResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.MAP {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchVideoOperationResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *BatchVideoOperationResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewStatus()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *BatchVideoOperationResponse) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.SuccessVideoIds = _field
+	return offset, nil
+}
+
+func (p *BatchVideoOperationResponse) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make(map[int64]string, size)
+	for i := 0; i < size; i++ {
+		var _key int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		var _val string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	p.FailedVideoErrors = _field
+	return offset, nil
+}
+
+func (p *BatchVideoOperationResponse) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+	_field := NewUserStorageQuota()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.UpdatedQuota = _field
+	return offset, nil
+}
+
+func (p *BatchVideoOperationResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *BatchVideoOperationResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *BatchVideoOperationResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *BatchVideoOperationResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *BatchVideoOperationResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.SuccessVideoIds {
+		length++
+		offset += thrift.Binary.WriteI64(buf[offset:], v)
+	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
+	return offset
+}
+
+func (p *BatchVideoOperationResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 3)
+	mapBeginOffset := offset
+	offset += thrift.Binary.MapBeginLength()
+	var length int
+	for k, v := range p.FailedVideoErrors {
+		length++
+		offset += thrift.Binary.WriteI64(buf[offset:], k)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+	}
+	thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.I64, thrift.STRING, length)
+	return offset
+}
+
+func (p *BatchVideoOperationResponse) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
+	offset += p.UpdatedQuota.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *BatchVideoOperationResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Base.BLength()
+	return l
+}
+
+func (p *BatchVideoOperationResponse) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.ListBeginLength()
+	l +=
+		thrift.Binary.I64Length() * len(p.SuccessVideoIds)
+	return l
+}
+
+func (p *BatchVideoOperationResponse) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.MapBeginLength()
+	for k, v := range p.FailedVideoErrors {
+		_, _ = k, v
+
+		l += thrift.Binary.I64Length()
+		l += thrift.Binary.StringLengthNocopy(v)
+	}
+	return l
+}
+
+func (p *BatchVideoOperationResponse) field4Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.UpdatedQuota.BLength()
+	return l
+}
+
+func (p *VideoTranscodingRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoTranscodingRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *VideoTranscodingRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.UserId = _field
+	return offset, nil
+}
+
+func (p *VideoTranscodingRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.VideoId = _field
+	return offset, nil
+}
+
+func (p *VideoTranscodingRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]int32, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int32
+		if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.TargetQualities = _field
+	return offset, nil
+}
+
+func (p *VideoTranscodingRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.TargetFormats = _field
+	return offset, nil
+}
+
+func (p *VideoTranscodingRequest) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.GenerateThumbnails = _field
+	return offset, nil
+}
+
+func (p *VideoTranscodingRequest) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ThumbnailCount = _field
+	return offset, nil
+}
+
+func (p *VideoTranscodingRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *VideoTranscodingRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
 	}
@@ -15439,98 +17671,125 @@ func (p *BatchVideoOperationRequest) FastWriteNocopy(buf []byte, w thrift.Nocopy
 	return offset
 }
 
-func (p *BatchVideoOperationRequest) BLength() int {
+func (p *VideoTranscodingRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *BatchVideoOperationRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoTranscodingRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.UserId)
 	return offset
 }
 
-func (p *BatchVideoOperationRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoTranscodingRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.VideoId)
+	return offset
+}
+
+func (p *VideoTranscodingRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
 	listBeginOffset := offset
 	offset += thrift.Binary.ListBeginLength()
 	var length int
-	for _, v := range p.VideoIds {
+	for _, v := range p.TargetQualities {
 		length++
-		offset += thrift.Binary.WriteI64(buf[offset:], v)
+		offset += thrift.Binary.WriteI32(buf[offset:], v)
 	}
-	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
-	return offset
-}
-
-func (p *BatchVideoOperationRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Operation)
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I32, length)
 	return offset
 }
 
-func (p *BatchVideoOperationRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoTranscodingRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 4)
-	mapBeginOffset := offset
-	offset += thrift.Binary.MapBeginLength()
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
 	var length int
-	for k, v := range p.OperationParams {
+	for _, v := range p.TargetFormats {
 		length++
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
 		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
 	}
-	thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRING, length)
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
 	return offset
 }
 
-func (p *BatchVideoOperationRequest) field1Length() int {
+func (p *VideoTranscodingRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 5)
+	offset += thrift.Binary.WriteBool(buf[offset:], p.GenerateThumbnails)
+	return offset
+}
+
+func (p *VideoTranscodingRequest) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 6)
+	offset += thrift.Binary.WriteI32(buf[offset:], p.ThumbnailCount)
+	return offset
+}
+
+func (p *VideoTranscodingRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *BatchVideoOperationRequest) field2Length() int {
+func (p *VideoTranscodingRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *VideoTranscodingRequest) field3Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.ListBeginLength()
 	l +=
-		thrift.Binary.I64Length() * len(p.VideoIds)
+		thrift.Binary.I32Length() * len(p.TargetQualities)
 	return l
 }
 
-func (p *BatchVideoOperationRequest) field3Length() int {
+func (p *VideoTranscodingRequest) field4Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.Operation)
+	l += thrift.Binary.ListBeginLength()
+	for _, v := range p.TargetFormats {
+		_ = v
+		l += thrift.Binary.StringLengthNocopy(v)
+	}
 	return l
 }
 
-func (p *BatchVideoOperationRequest) field4Length() int {
+func (p *VideoTranscodingRequest) field5Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.MapBeginLength()
-	for k, v := range p.OperationParams {
-		_, _ = k, v
+	l += thrift.Binary.BoolLength()
+	return l
+}
 
-		l += thrift.Binary.StringLengthNocopy(k)
-		l += thrift.Binary.StringLengthNocopy(v)
-	}
+func (p *VideoTranscodingRequest) field6Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I32Length()
 	return l
 }
 
-func (p *BatchVideoOperationResponse) FastRead(buf []byte) (int, error) {
+func (p *VideoTranscodingResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -15562,7 +17821,7 @@ func (p *BatchVideoOperationResponse) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -15576,7 +17835,7 @@ func (p *BatchVideoOperationResponse) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -15590,7 +17849,7 @@ func (p *BatchVideoOperationResponse) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.MAP {
 				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
@@ -15603,6 +17862,34 @@ func (p *BatchVideoOperationResponse) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -15616,12 +17903,12 @@ func (p *BatchVideoOperationResponse) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchVideoOperationResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoTranscodingResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *BatchVideoOperationResponse) FastReadField1(buf []byte) (int, error) {
+func (p *VideoTranscodingResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewStatus()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -15633,31 +17920,35 @@ func (p *BatchVideoOperationResponse) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *BatchVideoOperationResponse) FastReadField2(buf []byte) (int, error) {
+func (p *VideoTranscodingResponse) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = v
 	}
-	_field := make([]int64, 0, size)
-	for i := 0; i < size; i++ {
-		var _elem int64
-		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_elem = v
-		}
+	p.TranscodingJobId = _field
+	return offset, nil
+}
 
-		_field = append(_field, _elem)
+func (p *VideoTranscodingResponse) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
 	}
-	p.SuccessVideoIds = _field
+	p.JobStatus = _field
 	return offset, nil
 }
 
-func (p *BatchVideoOperationResponse) FastReadField3(buf []byte) (int, error) {
+func (p *VideoTranscodingResponse) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
 	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
@@ -15665,10 +17956,10 @@ func (p *BatchVideoOperationResponse) FastReadField3(buf []byte) (int, error) {
 	if err != nil {
 		return offset, err
 	}
-	_field := make(map[int64]string, size)
+	_field := make(map[int32]string, size)
 	for i := 0; i < size; i++ {
-		var _key int64
-		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		var _key int32
+		if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 			return offset, err
 		} else {
 			offset += l
@@ -15685,130 +17976,190 @@ func (p *BatchVideoOperationResponse) FastReadField3(buf []byte) (int, error) {
 
 		_field[_key] = _val
 	}
-	p.FailedVideoErrors = _field
+	p.TranscodedUrls = _field
 	return offset, nil
 }
 
-func (p *BatchVideoOperationResponse) FastReadField4(buf []byte) (int, error) {
+func (p *VideoTranscodingResponse) FastReadField5(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUserStorageQuota()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ThumbnailUrls = _field
+	return offset, nil
+}
+
+func (p *VideoTranscodingResponse) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = v
 	}
-	p.UpdatedQuota = _field
+	p.EstimatedCompletionTime = _field
 	return offset, nil
 }
 
-func (p *BatchVideoOperationResponse) FastWrite(buf []byte) int {
+func (p *VideoTranscodingResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *BatchVideoOperationResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoTranscodingResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *BatchVideoOperationResponse) BLength() int {
+func (p *VideoTranscodingResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *BatchVideoOperationResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoTranscodingResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Base.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *BatchVideoOperationResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoTranscodingResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
-	listBeginOffset := offset
-	offset += thrift.Binary.ListBeginLength()
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.TranscodingJobId)
+	return offset
+}
+
+func (p *VideoTranscodingResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.JobStatus)
+	return offset
+}
+
+func (p *VideoTranscodingResponse) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 4)
+	mapBeginOffset := offset
+	offset += thrift.Binary.MapBeginLength()
 	var length int
-	for _, v := range p.SuccessVideoIds {
+	for k, v := range p.TranscodedUrls {
 		length++
-		offset += thrift.Binary.WriteI64(buf[offset:], v)
+		offset += thrift.Binary.WriteI32(buf[offset:], k)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
 	}
-	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
+	thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.I32, thrift.STRING, length)
 	return offset
 }
 
-func (p *BatchVideoOperationResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoTranscodingResponse) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 3)
-	mapBeginOffset := offset
-	offset += thrift.Binary.MapBeginLength()
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 5)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
 	var length int
-	for k, v := range p.FailedVideoErrors {
+	for _, v := range p.ThumbnailUrls {
 		length++
-		offset += thrift.Binary.WriteI64(buf[offset:], k)
 		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
 	}
-	thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.I64, thrift.STRING, length)
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
 	return offset
 }
 
-func (p *BatchVideoOperationResponse) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoTranscodingResponse) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
-	offset += p.UpdatedQuota.FastWriteNocopy(buf[offset:], w)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 6)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EstimatedCompletionTime)
 	return offset
 }
 
-func (p *BatchVideoOperationResponse) field1Length() int {
+func (p *VideoTranscodingResponse) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Base.BLength()
 	return l
 }
 
-func (p *BatchVideoOperationResponse) field2Length() int {
+func (p *VideoTranscodingResponse) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.ListBeginLength()
-	l +=
-		thrift.Binary.I64Length() * len(p.SuccessVideoIds)
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *BatchVideoOperationResponse) field3Length() int {
+func (p *VideoTranscodingResponse) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.JobStatus)
+	return l
+}
+
+func (p *VideoTranscodingResponse) field4Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.MapBeginLength()
-	for k, v := range p.FailedVideoErrors {
+	for k, v := range p.TranscodedUrls {
 		_, _ = k, v
 
-		l += thrift.Binary.I64Length()
+		l += thrift.Binary.I32Length()
 		l += thrift.Binary.StringLengthNocopy(v)
 	}
 	return l
 }
 
-func (p *BatchVideoOperationResponse) field4Length() int {
+func (p *VideoTranscodingResponse) field5Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += p.UpdatedQuota.BLength()
+	l += thrift.Binary.ListBeginLength()
+	for _, v := range p.ThumbnailUrls {
+		_ = v
+		l += thrift.Binary.StringLengthNocopy(v)
+	}
 	return l
 }
 
-func (p *VideoTranscodingRequest) FastRead(buf []byte) (int, error) {
+func (p *VideoTranscodingResponse) field6Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *VideoAnalyticsRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -15840,7 +18191,7 @@ func (p *VideoTranscodingRequest) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -15854,7 +18205,7 @@ func (p *VideoTranscodingRequest) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -15868,7 +18219,7 @@ func (p *VideoTranscodingRequest) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
@@ -15882,7 +18233,7 @@ func (p *VideoTranscodingRequest) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 5:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField5(buf[offset:])
 				offset += l
 				if err != nil {
@@ -15895,20 +18246,6 @@ func (p *VideoTranscodingRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 6:
-			if fieldTypeId == thrift.I32 {
-				l, err = p.FastReadField6(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -15922,12 +18259,12 @@ func (p *VideoTranscodingRequest) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoTranscodingRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoAnalyticsRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoTranscodingRequest) FastReadField1(buf []byte) (int, error) {
+func (p *VideoAnalyticsRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -15941,21 +18278,7 @@ func (p *VideoTranscodingRequest) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *VideoTranscodingRequest) FastReadField2(buf []byte) (int, error) {
-	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.VideoId = _field
-	return offset, nil
-}
-
-func (p *VideoTranscodingRequest) FastReadField3(buf []byte) (int, error) {
+func (p *VideoAnalyticsRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -15963,23 +18286,51 @@ func (p *VideoTranscodingRequest) FastReadField3(buf []byte) (int, error) {
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]int32, 0, size)
+	_field := make([]int64, 0, size)
 	for i := 0; i < size; i++ {
-		var _elem int32
-		if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 			return offset, err
 		} else {
 			offset += l
 			_elem = v
 		}
 
-		_field = append(_field, _elem)
+		_field = append(_field, _elem)
+	}
+	p.VideoIds = _field
+	return offset, nil
+}
+
+func (p *VideoAnalyticsRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.DateRangeStart = _field
+	return offset, nil
+}
+
+func (p *VideoAnalyticsRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
 	}
-	p.TargetQualities = _field
+	p.DateRangeEnd = _field
 	return offset, nil
 }
 
-func (p *VideoTranscodingRequest) FastReadField4(buf []byte) (int, error) {
+func (p *VideoAnalyticsRequest) FastReadField5(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -15999,57 +18350,28 @@ func (p *VideoTranscodingRequest) FastReadField4(buf []byte) (int, error) {
 
 		_field = append(_field, _elem)
 	}
-	p.TargetFormats = _field
-	return offset, nil
-}
-
-func (p *VideoTranscodingRequest) FastReadField5(buf []byte) (int, error) {
-	offset := 0
-
-	var _field bool
-	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.GenerateThumbnails = _field
-	return offset, nil
-}
-
-func (p *VideoTranscodingRequest) FastReadField6(buf []byte) (int, error) {
-	offset := 0
-
-	var _field int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.ThumbnailCount = _field
+	p.Metrics = _field
 	return offset, nil
 }
 
-func (p *VideoTranscodingRequest) FastWrite(buf []byte) int {
+func (p *VideoAnalyticsRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoTranscodingRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoAnalyticsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
-		offset += p.fastWriteField6(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *VideoTranscodingRequest) BLength() int {
+func (p *VideoAnalyticsRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -16057,117 +18379,102 @@ func (p *VideoTranscodingRequest) BLength() int {
 		l += p.field3Length()
 		l += p.field4Length()
 		l += p.field5Length()
-		l += p.field6Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *VideoTranscodingRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoAnalyticsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.UserId)
 	return offset
 }
 
-func (p *VideoTranscodingRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.VideoId)
-	return offset
-}
-
-func (p *VideoTranscodingRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoAnalyticsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
 	listBeginOffset := offset
 	offset += thrift.Binary.ListBeginLength()
 	var length int
-	for _, v := range p.TargetQualities {
+	for _, v := range p.VideoIds {
 		length++
-		offset += thrift.Binary.WriteI32(buf[offset:], v)
+		offset += thrift.Binary.WriteI64(buf[offset:], v)
 	}
-	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I32, length)
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
 	return offset
 }
 
-func (p *VideoTranscodingRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoAnalyticsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
-	listBeginOffset := offset
-	offset += thrift.Binary.ListBeginLength()
-	var length int
-	for _, v := range p.TargetFormats {
-		length++
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
-	}
-	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.DateRangeStart)
 	return offset
 }
 
-func (p *VideoTranscodingRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoAnalyticsRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 5)
-	offset += thrift.Binary.WriteBool(buf[offset:], p.GenerateThumbnails)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.DateRangeEnd)
 	return offset
 }
 
-func (p *VideoTranscodingRequest) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoAnalyticsRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 6)
-	offset += thrift.Binary.WriteI32(buf[offset:], p.ThumbnailCount)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 5)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.Metrics {
+		length++
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
 	return offset
 }
 
-func (p *VideoTranscodingRequest) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
-}
-
-func (p *VideoTranscodingRequest) field2Length() int {
+func (p *VideoAnalyticsRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *VideoTranscodingRequest) field3Length() int {
+func (p *VideoAnalyticsRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.ListBeginLength()
 	l +=
-		thrift.Binary.I32Length() * len(p.TargetQualities)
+		thrift.Binary.I64Length() * len(p.VideoIds)
 	return l
 }
 
-func (p *VideoTranscodingRequest) field4Length() int {
+func (p *VideoAnalyticsRequest) field3Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.ListBeginLength()
-	for _, v := range p.TargetFormats {
-		_ = v
-		l += thrift.Binary.StringLengthNocopy(v)
-	}
+	l += thrift.Binary.StringLengthNocopy(p.DateRangeStart)
 	return l
 }
 
-func (p *VideoTranscodingRequest) field5Length() int {
+func (p *VideoAnalyticsRequest) field4Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.BoolLength()
+	l += thrift.Binary.StringLengthNocopy(p.DateRangeEnd)
 	return l
 }
 
-func (p *VideoTranscodingRequest) field6Length() int {
+func (p *VideoAnalyticsRequest) field5Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I32Length()
+	l += thrift.Binary.ListBeginLength()
+	for _, v := range p.Metrics {
+		_ = v
+		l += thrift.Binary.StringLengthNocopy(v)
+	}
 	return l
 }
 
-func (p *VideoTranscodingResponse) FastRead(buf []byte) (int, error) {
+func (p *VideoAnalyticsResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16199,7 +18506,7 @@ func (p *VideoTranscodingResponse) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.MAP {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -16213,7 +18520,7 @@ func (p *VideoTranscodingResponse) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.MAP {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -16227,7 +18534,7 @@ func (p *VideoTranscodingResponse) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
@@ -16241,7 +18548,7 @@ func (p *VideoTranscodingResponse) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 5:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField5(buf[offset:])
 				offset += l
 				if err != nil {
@@ -16254,20 +18561,6 @@ func (p *VideoTranscodingResponse) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 6:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField6(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -16281,12 +18574,12 @@ func (p *VideoTranscodingResponse) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoTranscodingResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoAnalyticsResponse[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoTranscodingResponse) FastReadField1(buf []byte) (int, error) {
+func (p *VideoAnalyticsResponse) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewStatus()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -16298,35 +18591,57 @@ func (p *VideoTranscodingResponse) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *VideoTranscodingResponse) FastReadField2(buf []byte) (int, error) {
+func (p *VideoAnalyticsResponse) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
-		_field = v
 	}
-	p.TranscodingJobId = _field
-	return offset, nil
-}
-
-func (p *VideoTranscodingResponse) FastReadField3(buf []byte) (int, error) {
-	offset := 0
+	_field := make(map[int64]map[string]int64, size)
+	for i := 0; i < size; i++ {
+		var _key int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
 
-	var _field string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
+		_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
 		offset += l
-		_field = v
+		if err != nil {
+			return offset, err
+		}
+		_val := make(map[string]int64, size)
+		for i := 0; i < size; i++ {
+			var _key1 string
+			if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+				return offset, err
+			} else {
+				offset += l
+				_key1 = v
+			}
+
+			var _val1 int64
+			if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+				return offset, err
+			} else {
+				offset += l
+				_val1 = v
+			}
+
+			_val[_key1] = _val1
+		}
+
+		_field[_key] = _val
 	}
-	p.JobStatus = _field
+	p.VideoMetrics = _field
 	return offset, nil
 }
 
-func (p *VideoTranscodingResponse) FastReadField4(buf []byte) (int, error) {
+func (p *VideoAnalyticsResponse) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
 	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
@@ -16334,18 +18649,18 @@ func (p *VideoTranscodingResponse) FastReadField4(buf []byte) (int, error) {
 	if err != nil {
 		return offset, err
 	}
-	_field := make(map[int32]string, size)
+	_field := make(map[string]int64, size)
 	for i := 0; i < size; i++ {
-		var _key int32
-		if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		var _key string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 			return offset, err
 		} else {
 			offset += l
 			_key = v
 		}
 
-		var _val string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		var _val int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 			return offset, err
 		} else {
 			offset += l
@@ -16354,11 +18669,11 @@ func (p *VideoTranscodingResponse) FastReadField4(buf []byte) (int, error) {
 
 		_field[_key] = _val
 	}
-	p.TranscodedUrls = _field
+	p.TotalMetrics = _field
 	return offset, nil
 }
 
-func (p *VideoTranscodingResponse) FastReadField5(buf []byte) (int, error) {
+func (p *VideoAnalyticsResponse) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -16378,34 +18693,33 @@ func (p *VideoTranscodingResponse) FastReadField5(buf []byte) (int, error) {
 
 		_field = append(_field, _elem)
 	}
-	p.ThumbnailUrls = _field
+	p.TopPerformingVideos = _field
 	return offset, nil
 }
 
-func (p *VideoTranscodingResponse) FastReadField6(buf []byte) (int, error) {
+func (p *VideoAnalyticsResponse) FastReadField5(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = v
 	}
-	p.EstimatedCompletionTime = _field
+	p.ReportGeneratedAt = _field
 	return offset, nil
 }
 
-func (p *VideoTranscodingResponse) FastWrite(buf []byte) int {
+func (p *VideoAnalyticsResponse) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoTranscodingResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoAnalyticsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField6(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField5(buf[offset:], w)
@@ -16414,7 +18728,7 @@ func (p *VideoTranscodingResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWr
 	return offset
 }
 
-func (p *VideoTranscodingResponse) BLength() int {
+func (p *VideoAnalyticsResponse) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -16422,55 +18736,63 @@ func (p *VideoTranscodingResponse) BLength() int {
 		l += p.field3Length()
 		l += p.field4Length()
 		l += p.field5Length()
-		l += p.field6Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *VideoTranscodingResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoAnalyticsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Base.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoTranscodingResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.TranscodingJobId)
-	return offset
-}
-
-func (p *VideoTranscodingResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoAnalyticsResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.JobStatus)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 2)
+	mapBeginOffset := offset
+	offset += thrift.Binary.MapBeginLength()
+	var length int
+	for k, v := range p.VideoMetrics {
+		length++
+		offset += thrift.Binary.WriteI64(buf[offset:], k)
+		mapBeginOffset := offset
+		offset += thrift.Binary.MapBeginLength()
+		var length int
+		for k, v := range v {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
+		}
+		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.I64, length)
+	}
+	thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.I64, thrift.MAP, length)
 	return offset
 }
 
-func (p *VideoTranscodingResponse) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoAnalyticsResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 4)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 3)
 	mapBeginOffset := offset
 	offset += thrift.Binary.MapBeginLength()
 	var length int
-	for k, v := range p.TranscodedUrls {
+	for k, v := range p.TotalMetrics {
 		length++
-		offset += thrift.Binary.WriteI32(buf[offset:], k)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
+		offset += thrift.Binary.WriteI64(buf[offset:], v)
 	}
-	thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.I32, thrift.STRING, length)
+	thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.I64, length)
 	return offset
 }
 
-func (p *VideoTranscodingResponse) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoAnalyticsResponse) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 5)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
 	listBeginOffset := offset
 	offset += thrift.Binary.ListBeginLength()
 	var length int
-	for _, v := range p.ThumbnailUrls {
+	for _, v := range p.TopPerformingVideos {
 		length++
 		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
 	}
@@ -16478,66 +18800,71 @@ func (p *VideoTranscodingResponse) fastWriteField5(buf []byte, w thrift.NocopyWr
 	return offset
 }
 
-func (p *VideoTranscodingResponse) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoAnalyticsResponse) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 6)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.EstimatedCompletionTime)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.ReportGeneratedAt)
 	return offset
 }
 
-func (p *VideoTranscodingResponse) field1Length() int {
+func (p *VideoAnalyticsResponse) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Base.BLength()
 	return l
 }
 
-func (p *VideoTranscodingResponse) field2Length() int {
+func (p *VideoAnalyticsResponse) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
-}
+	l += thrift.Binary.MapBeginLength()
+	for k, v := range p.VideoMetrics {
+		_, _ = k, v
 
-func (p *VideoTranscodingResponse) field3Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.JobStatus)
+		l += thrift.Binary.I64Length()
+		l += thrift.Binary.MapBeginLength()
+		for k, v := range v {
+			_, _ = k, v
+
+			l += thrift.Binary.StringLengthNocopy(k)
+			l += thrift.Binary.I64Length()
+		}
+	}
 	return l
 }
 
-func (p *VideoTranscodingResponse) field4Length() int {
+func (p *VideoAnalyticsResponse) field3Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.MapBeginLength()
-	for k, v := range p.TranscodedUrls {
+	for k, v := range p.TotalMetrics {
 		_, _ = k, v
 
-		l += thrift.Binary.I32Length()
-		l += thrift.Binary.StringLengthNocopy(v)
+		l += thrift.Binary.StringLengthNocopy(k)
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *VideoTranscodingResponse) field5Length() int {
+func (p *VideoAnalyticsResponse) field4Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.ListBeginLength()
-	for _, v := range p.ThumbnailUrls {
+	for _, v := range p.TopPerformingVideos {
 		_ = v
 		l += thrift.Binary.StringLengthNocopy(v)
 	}
 	return l
 }
 
-func (p *VideoTranscodingResponse) field6Length() int {
+func (p *VideoAnalyticsResponse) field5Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	l += thrift.Binary.StringLengthNocopy(p.ReportGeneratedAt)
 	return l
 }
 
-func (p *VideoAnalyticsRequest) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceVideoPublishStartV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16555,7 +18882,7 @@ func (p *VideoAnalyticsRequest) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -16568,23 +18895,92 @@ func (p *VideoAnalyticsRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 2:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField3(buf[offset:])
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoPublishStartV2Args[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *VideoServiceVideoPublishStartV2Args) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewVideoPublishStartRequestV2()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *VideoServiceVideoPublishStartV2Args) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *VideoServiceVideoPublishStartV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *VideoServiceVideoPublishStartV2Args) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *VideoServiceVideoPublishStartV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *VideoServiceVideoPublishStartV2Args) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *VideoServiceVideoPublishStartV2Result) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -16596,23 +18992,96 @@ func (p *VideoAnalyticsRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField4(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
 			}
-		case 5:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField5(buf[offset:])
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoPublishStartV2Result[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *VideoServiceVideoPublishStartV2Result) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewVideoPublishStartResponseV2()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *VideoServiceVideoPublishStartV2Result) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *VideoServiceVideoPublishStartV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *VideoServiceVideoPublishStartV2Result) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *VideoServiceVideoPublishStartV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *VideoServiceVideoPublishStartV2Result) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *VideoServiceVideoPublishUploadingV2Args) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -16637,222 +19106,161 @@ func (p *VideoAnalyticsRequest) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoAnalyticsRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoPublishUploadingV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoAnalyticsRequest) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.UserId = _field
-	return offset, nil
-}
-
-func (p *VideoAnalyticsRequest) FastReadField2(buf []byte) (int, error) {
-	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]int64, 0, size)
-	for i := 0; i < size; i++ {
-		var _elem int64
-		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_elem = v
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.VideoIds = _field
-	return offset, nil
-}
-
-func (p *VideoAnalyticsRequest) FastReadField3(buf []byte) (int, error) {
-	offset := 0
-
-	var _field string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.DateRangeStart = _field
-	return offset, nil
-}
-
-func (p *VideoAnalyticsRequest) FastReadField4(buf []byte) (int, error) {
+func (p *VideoServiceVideoPublishUploadingV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-
-	var _field string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_field := NewVideoPublishUploadingRequestV2()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
-	}
-	p.DateRangeEnd = _field
-	return offset, nil
-}
-
-func (p *VideoAnalyticsRequest) FastReadField5(buf []byte) (int, error) {
-	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]string, 0, size)
-	for i := 0; i < size; i++ {
-		var _elem string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_elem = v
-		}
-
-		_field = append(_field, _elem)
 	}
-	p.Metrics = _field
+	p.Req = _field
 	return offset, nil
 }
 
-func (p *VideoAnalyticsRequest) FastWrite(buf []byte) int {
+func (p *VideoServiceVideoPublishUploadingV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoAnalyticsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoPublishUploadingV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *VideoAnalyticsRequest) BLength() int {
+func (p *VideoServiceVideoPublishUploadingV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field2Length()
-		l += p.field3Length()
-		l += p.field4Length()
-		l += p.field5Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *VideoAnalyticsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoPublishUploadingV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.UserId)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoAnalyticsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
-	listBeginOffset := offset
-	offset += thrift.Binary.ListBeginLength()
-	var length int
-	for _, v := range p.VideoIds {
-		length++
-		offset += thrift.Binary.WriteI64(buf[offset:], v)
-	}
-	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
-	return offset
+func (p *VideoServiceVideoPublishUploadingV2Args) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
 }
 
-func (p *VideoAnalyticsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.DateRangeStart)
-	return offset
-}
+func (p *VideoServiceVideoPublishUploadingV2Result) FastRead(buf []byte) (int, error) {
 
-func (p *VideoAnalyticsRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.DateRangeEnd)
-	return offset
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoPublishUploadingV2Result[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoAnalyticsRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoPublishUploadingV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 5)
-	listBeginOffset := offset
-	offset += thrift.Binary.ListBeginLength()
-	var length int
-	for _, v := range p.Metrics {
-		length++
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+	_field := NewVideoPublishUploadingResponseV2()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
 	}
-	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
-	return offset
+	p.Success = _field
+	return offset, nil
 }
 
-func (p *VideoAnalyticsRequest) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
+func (p *VideoServiceVideoPublishUploadingV2Result) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoAnalyticsRequest) field2Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.ListBeginLength()
-	l +=
-		thrift.Binary.I64Length() * len(p.VideoIds)
-	return l
+func (p *VideoServiceVideoPublishUploadingV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
 }
 
-func (p *VideoAnalyticsRequest) field3Length() int {
+func (p *VideoServiceVideoPublishUploadingV2Result) BLength() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.DateRangeStart)
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *VideoAnalyticsRequest) field4Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.DateRangeEnd)
-	return l
+func (p *VideoServiceVideoPublishUploadingV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
 }
 
-func (p *VideoAnalyticsRequest) field5Length() int {
+func (p *VideoServiceVideoPublishUploadingV2Result) field0Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.ListBeginLength()
-	for _, v := range p.Metrics {
-		_ = v
-		l += thrift.Binary.StringLengthNocopy(v)
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
 	}
 	return l
 }
 
-func (p *VideoAnalyticsResponse) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceVideoPublishCompleteV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16883,62 +19291,6 @@ func (p *VideoAnalyticsResponse) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 2:
-			if fieldTypeId == thrift.MAP {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 3:
-			if fieldTypeId == thrift.MAP {
-				l, err = p.FastReadField3(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField4(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField5(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -16952,297 +19304,161 @@ func (p *VideoAnalyticsResponse) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoAnalyticsResponse[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoPublishCompleteV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoAnalyticsResponse) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceVideoPublishCompleteV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := base.NewStatus()
+	_field := NewVideoPublishCompleteRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Base = _field
+	p.Req = _field
 	return offset, nil
 }
 
-func (p *VideoAnalyticsResponse) FastReadField2(buf []byte) (int, error) {
-	offset := 0
+func (p *VideoServiceVideoPublishCompleteV2Args) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
 
-	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
+func (p *VideoServiceVideoPublishCompleteV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
 	}
-	_field := make(map[int64]map[string]int64, size)
-	for i := 0; i < size; i++ {
-		var _key int64
-		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_key = v
-		}
-
-		_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
-		offset += l
-		if err != nil {
-			return offset, err
-		}
-		_val := make(map[string]int64, size)
-		for i := 0; i < size; i++ {
-			var _key1 string
-			if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-				return offset, err
-			} else {
-				offset += l
-				_key1 = v
-			}
-
-			var _val1 int64
-			if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-				return offset, err
-			} else {
-				offset += l
-				_val1 = v
-			}
-
-			_val[_key1] = _val1
-		}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
 
-		_field[_key] = _val
+func (p *VideoServiceVideoPublishCompleteV2Args) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
 	}
-	p.VideoMetrics = _field
-	return offset, nil
+	l += thrift.Binary.FieldStopLength()
+	return l
 }
 
-func (p *VideoAnalyticsResponse) FastReadField3(buf []byte) (int, error) {
+func (p *VideoServiceVideoPublishCompleteV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
 
-	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make(map[string]int64, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_key = v
-		}
-
-		var _val int64
-		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_val = v
-		}
-
-		_field[_key] = _val
-	}
-	p.TotalMetrics = _field
-	return offset, nil
+func (p *VideoServiceVideoPublishCompleteV2Args) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
 }
 
-func (p *VideoAnalyticsResponse) FastReadField4(buf []byte) (int, error) {
-	offset := 0
+func (p *VideoServiceVideoPublishCompleteV2Result) FastRead(buf []byte) (int, error) {
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]string, 0, size)
-	for i := 0; i < size; i++ {
-		var _elem string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
-			_elem = v
+			if err != nil {
+				goto SkipFieldError
+			}
 		}
-
-		_field = append(_field, _elem)
 	}
-	p.TopPerformingVideos = _field
+
 	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoPublishCompleteV2Result[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoAnalyticsResponse) FastReadField5(buf []byte) (int, error) {
+func (p *VideoServiceVideoPublishCompleteV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-
-	var _field string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_field := NewVideoPublishCompleteResponseV2()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
 	}
-	p.ReportGeneratedAt = _field
+	p.Success = _field
 	return offset, nil
 }
 
-func (p *VideoAnalyticsResponse) FastWrite(buf []byte) int {
+func (p *VideoServiceVideoPublishCompleteV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoAnalyticsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoPublishCompleteV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField0(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *VideoAnalyticsResponse) BLength() int {
+func (p *VideoServiceVideoPublishCompleteV2Result) BLength() int {
 	l := 0
 	if p != nil {
-		l += p.field1Length()
-		l += p.field2Length()
-		l += p.field3Length()
-		l += p.field4Length()
-		l += p.field5Length()
+		l += p.field0Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *VideoAnalyticsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-	offset += p.Base.FastWriteNocopy(buf[offset:], w)
-	return offset
-}
-
-func (p *VideoAnalyticsResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 2)
-	mapBeginOffset := offset
-	offset += thrift.Binary.MapBeginLength()
-	var length int
-	for k, v := range p.VideoMetrics {
-		length++
-		offset += thrift.Binary.WriteI64(buf[offset:], k)
-		mapBeginOffset := offset
-		offset += thrift.Binary.MapBeginLength()
-		var length int
-		for k, v := range v {
-			length++
-			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
-			offset += thrift.Binary.WriteI64(buf[offset:], v)
-		}
-		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.I64, length)
-	}
-	thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.I64, thrift.MAP, length)
-	return offset
-}
-
-func (p *VideoAnalyticsResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 3)
-	mapBeginOffset := offset
-	offset += thrift.Binary.MapBeginLength()
-	var length int
-	for k, v := range p.TotalMetrics {
-		length++
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
-		offset += thrift.Binary.WriteI64(buf[offset:], v)
-	}
-	thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.I64, length)
-	return offset
-}
-
-func (p *VideoAnalyticsResponse) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoPublishCompleteV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
-	listBeginOffset := offset
-	offset += thrift.Binary.ListBeginLength()
-	var length int
-	for _, v := range p.TopPerformingVideos {
-		length++
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
 	}
-	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
-	return offset
-}
-
-func (p *VideoAnalyticsResponse) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.ReportGeneratedAt)
 	return offset
 }
 
-func (p *VideoAnalyticsResponse) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += p.Base.BLength()
-	return l
-}
-
-func (p *VideoAnalyticsResponse) field2Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.MapBeginLength()
-	for k, v := range p.VideoMetrics {
-		_, _ = k, v
-
-		l += thrift.Binary.I64Length()
-		l += thrift.Binary.MapBeginLength()
-		for k, v := range v {
-			_, _ = k, v
-
-			l += thrift.Binary.StringLengthNocopy(k)
-			l += thrift.Binary.I64Length()
-		}
-	}
-	return l
-}
-
-func (p *VideoAnalyticsResponse) field3Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.MapBeginLength()
-	for k, v := range p.TotalMetrics {
-		_, _ = k, v
-
-		l += thrift.Binary.StringLengthNocopy(k)
-		l += thrift.Binary.I64Length()
-	}
-	return l
-}
-
-func (p *VideoAnalyticsResponse) field4Length() int {
+func (p *VideoServiceVideoPublishCompleteV2Result) field0Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.ListBeginLength()
-	for _, v := range p.TopPerformingVideos {
-		_ = v
-		l += thrift.Binary.StringLengthNocopy(v)
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
 	}
 	return l
 }
 
-func (p *VideoAnalyticsResponse) field5Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.ReportGeneratedAt)
-	return l
-}
-
-func (p *VideoServiceVideoPublishStartV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceVideoPublishCancelV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17286,14 +19502,14 @@ func (p *VideoServiceVideoPublishStartV2Args) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoPublishStartV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoPublishCancelV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceVideoPublishStartV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceVideoPublishCancelV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoPublishStartRequestV2()
+	_field := NewVideoPublishCancelRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17303,11 +19519,11 @@ func (p *VideoServiceVideoPublishStartV2Args) FastReadField1(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *VideoServiceVideoPublishStartV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceVideoPublishCancelV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceVideoPublishStartV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoPublishCancelV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -17316,7 +19532,7 @@ func (p *VideoServiceVideoPublishStartV2Args) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *VideoServiceVideoPublishStartV2Args) BLength() int {
+func (p *VideoServiceVideoPublishCancelV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -17325,21 +19541,21 @@ func (p *VideoServiceVideoPublishStartV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceVideoPublishStartV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoPublishCancelV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceVideoPublishStartV2Args) field1Length() int {
+func (p *VideoServiceVideoPublishCancelV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceVideoPublishStartV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceVideoPublishCancelV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17383,14 +19599,14 @@ func (p *VideoServiceVideoPublishStartV2Result) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoPublishStartV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoPublishCancelV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceVideoPublishStartV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceVideoPublishCancelV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoPublishStartResponseV2()
+	_field := NewVideoPublishCancelResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17400,11 +19616,11 @@ func (p *VideoServiceVideoPublishStartV2Result) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *VideoServiceVideoPublishStartV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceVideoPublishCancelV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceVideoPublishStartV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoPublishCancelV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -17413,7 +19629,7 @@ func (p *VideoServiceVideoPublishStartV2Result) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *VideoServiceVideoPublishStartV2Result) BLength() int {
+func (p *VideoServiceVideoPublishCancelV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -17422,7 +19638,7 @@ func (p *VideoServiceVideoPublishStartV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceVideoPublishStartV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoPublishCancelV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -17431,7 +19647,7 @@ func (p *VideoServiceVideoPublishStartV2Result) fastWriteField0(buf []byte, w th
 	return offset
 }
 
-func (p *VideoServiceVideoPublishStartV2Result) field0Length() int {
+func (p *VideoServiceVideoPublishCancelV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -17440,7 +19656,7 @@ func (p *VideoServiceVideoPublishStartV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceVideoPublishUploadingV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceGetUploadProgressV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17484,14 +19700,14 @@ func (p *VideoServiceVideoPublishUploadingV2Args) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoPublishUploadingV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetUploadProgressV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceVideoPublishUploadingV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceGetUploadProgressV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoPublishUploadingRequestV2()
+	_field := NewVideoPublishProgressRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17501,11 +19717,11 @@ func (p *VideoServiceVideoPublishUploadingV2Args) FastReadField1(buf []byte) (in
 	return offset, nil
 }
 
-func (p *VideoServiceVideoPublishUploadingV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceGetUploadProgressV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceVideoPublishUploadingV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetUploadProgressV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -17514,7 +19730,7 @@ func (p *VideoServiceVideoPublishUploadingV2Args) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *VideoServiceVideoPublishUploadingV2Args) BLength() int {
+func (p *VideoServiceGetUploadProgressV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -17523,21 +19739,21 @@ func (p *VideoServiceVideoPublishUploadingV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceVideoPublishUploadingV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetUploadProgressV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceVideoPublishUploadingV2Args) field1Length() int {
+func (p *VideoServiceGetUploadProgressV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceVideoPublishUploadingV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceGetUploadProgressV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17581,14 +19797,14 @@ func (p *VideoServiceVideoPublishUploadingV2Result) FastRead(buf []byte) (int, e
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoPublishUploadingV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetUploadProgressV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceVideoPublishUploadingV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceGetUploadProgressV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoPublishUploadingResponseV2()
+	_field := NewVideoPublishProgressResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17598,11 +19814,11 @@ func (p *VideoServiceVideoPublishUploadingV2Result) FastReadField0(buf []byte) (
 	return offset, nil
 }
 
-func (p *VideoServiceVideoPublishUploadingV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceGetUploadProgressV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceVideoPublishUploadingV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetUploadProgressV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -17611,7 +19827,7 @@ func (p *VideoServiceVideoPublishUploadingV2Result) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *VideoServiceVideoPublishUploadingV2Result) BLength() int {
+func (p *VideoServiceGetUploadProgressV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -17620,7 +19836,7 @@ func (p *VideoServiceVideoPublishUploadingV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceVideoPublishUploadingV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetUploadProgressV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -17629,7 +19845,7 @@ func (p *VideoServiceVideoPublishUploadingV2Result) fastWriteField0(buf []byte,
 	return offset
 }
 
-func (p *VideoServiceVideoPublishUploadingV2Result) field0Length() int {
+func (p *VideoServiceGetUploadProgressV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -17638,7 +19854,7 @@ func (p *VideoServiceVideoPublishUploadingV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceVideoPublishCompleteV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceResumeUploadV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17682,14 +19898,14 @@ func (p *VideoServiceVideoPublishCompleteV2Args) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoPublishCompleteV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceResumeUploadV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceVideoPublishCompleteV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceResumeUploadV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoPublishCompleteRequestV2()
+	_field := NewVideoPublishResumeRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17699,11 +19915,11 @@ func (p *VideoServiceVideoPublishCompleteV2Args) FastReadField1(buf []byte) (int
 	return offset, nil
 }
 
-func (p *VideoServiceVideoPublishCompleteV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceResumeUploadV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceVideoPublishCompleteV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceResumeUploadV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -17712,7 +19928,7 @@ func (p *VideoServiceVideoPublishCompleteV2Args) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *VideoServiceVideoPublishCompleteV2Args) BLength() int {
+func (p *VideoServiceResumeUploadV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -17721,21 +19937,21 @@ func (p *VideoServiceVideoPublishCompleteV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceVideoPublishCompleteV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceResumeUploadV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceVideoPublishCompleteV2Args) field1Length() int {
+func (p *VideoServiceResumeUploadV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceVideoPublishCompleteV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceResumeUploadV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17779,14 +19995,14 @@ func (p *VideoServiceVideoPublishCompleteV2Result) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoPublishCompleteV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceResumeUploadV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceVideoPublishCompleteV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceResumeUploadV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoPublishCompleteResponseV2()
+	_field := NewVideoPublishResumeResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17796,11 +20012,11 @@ func (p *VideoServiceVideoPublishCompleteV2Result) FastReadField0(buf []byte) (i
 	return offset, nil
 }
 
-func (p *VideoServiceVideoPublishCompleteV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceResumeUploadV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceVideoPublishCompleteV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceResumeUploadV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -17809,7 +20025,7 @@ func (p *VideoServiceVideoPublishCompleteV2Result) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *VideoServiceVideoPublishCompleteV2Result) BLength() int {
+func (p *VideoServiceResumeUploadV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -17818,7 +20034,7 @@ func (p *VideoServiceVideoPublishCompleteV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceVideoPublishCompleteV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceResumeUploadV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -17827,7 +20043,7 @@ func (p *VideoServiceVideoPublishCompleteV2Result) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *VideoServiceVideoPublishCompleteV2Result) field0Length() int {
+func (p *VideoServiceResumeUploadV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -17836,7 +20052,7 @@ func (p *VideoServiceVideoPublishCompleteV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceVideoPublishCancelV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceVideoFeedListV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17880,14 +20096,14 @@ func (p *VideoServiceVideoPublishCancelV2Args) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoPublishCancelV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoFeedListV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceVideoPublishCancelV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceVideoFeedListV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoPublishCancelRequestV2()
+	_field := NewVideoFeedListRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17897,11 +20113,11 @@ func (p *VideoServiceVideoPublishCancelV2Args) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *VideoServiceVideoPublishCancelV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceVideoFeedListV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceVideoPublishCancelV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoFeedListV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -17910,7 +20126,7 @@ func (p *VideoServiceVideoPublishCancelV2Args) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *VideoServiceVideoPublishCancelV2Args) BLength() int {
+func (p *VideoServiceVideoFeedListV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -17919,21 +20135,21 @@ func (p *VideoServiceVideoPublishCancelV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceVideoPublishCancelV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoFeedListV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceVideoPublishCancelV2Args) field1Length() int {
+func (p *VideoServiceVideoFeedListV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceVideoPublishCancelV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceVideoFeedListV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17977,14 +20193,14 @@ func (p *VideoServiceVideoPublishCancelV2Result) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoPublishCancelV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoFeedListV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceVideoPublishCancelV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceVideoFeedListV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoPublishCancelResponseV2()
+	_field := NewVideoFeedListResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17994,11 +20210,11 @@ func (p *VideoServiceVideoPublishCancelV2Result) FastReadField0(buf []byte) (int
 	return offset, nil
 }
 
-func (p *VideoServiceVideoPublishCancelV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceVideoFeedListV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceVideoPublishCancelV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoFeedListV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -18007,7 +20223,7 @@ func (p *VideoServiceVideoPublishCancelV2Result) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *VideoServiceVideoPublishCancelV2Result) BLength() int {
+func (p *VideoServiceVideoFeedListV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -18016,7 +20232,7 @@ func (p *VideoServiceVideoPublishCancelV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceVideoPublishCancelV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoFeedListV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -18025,7 +20241,7 @@ func (p *VideoServiceVideoPublishCancelV2Result) fastWriteField0(buf []byte, w t
 	return offset
 }
 
-func (p *VideoServiceVideoPublishCancelV2Result) field0Length() int {
+func (p *VideoServiceVideoFeedListV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -18034,7 +20250,7 @@ func (p *VideoServiceVideoPublishCancelV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceGetUploadProgressV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceVideoSearchV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18078,14 +20294,14 @@ func (p *VideoServiceGetUploadProgressV2Args) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetUploadProgressV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoSearchV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceGetUploadProgressV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceVideoSearchV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoPublishProgressRequestV2()
+	_field := NewVideoSearchRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18095,11 +20311,11 @@ func (p *VideoServiceGetUploadProgressV2Args) FastReadField1(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *VideoServiceGetUploadProgressV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceVideoSearchV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceGetUploadProgressV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoSearchV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -18108,7 +20324,7 @@ func (p *VideoServiceGetUploadProgressV2Args) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *VideoServiceGetUploadProgressV2Args) BLength() int {
+func (p *VideoServiceVideoSearchV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -18117,21 +20333,21 @@ func (p *VideoServiceGetUploadProgressV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceGetUploadProgressV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoSearchV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceGetUploadProgressV2Args) field1Length() int {
+func (p *VideoServiceVideoSearchV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceGetUploadProgressV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceVideoSearchV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18175,14 +20391,14 @@ func (p *VideoServiceGetUploadProgressV2Result) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetUploadProgressV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoSearchV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceGetUploadProgressV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceVideoSearchV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoPublishProgressResponseV2()
+	_field := NewVideoSearchResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18192,11 +20408,11 @@ func (p *VideoServiceGetUploadProgressV2Result) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *VideoServiceGetUploadProgressV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceVideoSearchV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceGetUploadProgressV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoSearchV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -18205,7 +20421,7 @@ func (p *VideoServiceGetUploadProgressV2Result) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *VideoServiceGetUploadProgressV2Result) BLength() int {
+func (p *VideoServiceVideoSearchV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -18214,7 +20430,7 @@ func (p *VideoServiceGetUploadProgressV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceGetUploadProgressV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoSearchV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -18223,7 +20439,7 @@ func (p *VideoServiceGetUploadProgressV2Result) fastWriteField0(buf []byte, w th
 	return offset
 }
 
-func (p *VideoServiceGetUploadProgressV2Result) field0Length() int {
+func (p *VideoServiceVideoSearchV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -18232,7 +20448,7 @@ func (p *VideoServiceGetUploadProgressV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceResumeUploadV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceVideoPopularV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18276,14 +20492,14 @@ func (p *VideoServiceResumeUploadV2Args) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceResumeUploadV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoPopularV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceResumeUploadV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceVideoPopularV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoPublishResumeRequestV2()
+	_field := NewVideoPopularRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18293,11 +20509,11 @@ func (p *VideoServiceResumeUploadV2Args) FastReadField1(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *VideoServiceResumeUploadV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceVideoPopularV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceResumeUploadV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoPopularV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -18306,7 +20522,7 @@ func (p *VideoServiceResumeUploadV2Args) FastWriteNocopy(buf []byte, w thrift.No
 	return offset
 }
 
-func (p *VideoServiceResumeUploadV2Args) BLength() int {
+func (p *VideoServiceVideoPopularV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -18315,21 +20531,21 @@ func (p *VideoServiceResumeUploadV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceResumeUploadV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoPopularV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceResumeUploadV2Args) field1Length() int {
+func (p *VideoServiceVideoPopularV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceResumeUploadV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceVideoPopularV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18373,14 +20589,14 @@ func (p *VideoServiceResumeUploadV2Result) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceResumeUploadV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoPopularV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceResumeUploadV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceVideoPopularV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoPublishResumeResponseV2()
+	_field := NewVideoPopularResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18390,11 +20606,11 @@ func (p *VideoServiceResumeUploadV2Result) FastReadField0(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *VideoServiceResumeUploadV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceVideoPopularV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceResumeUploadV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoPopularV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -18403,7 +20619,7 @@ func (p *VideoServiceResumeUploadV2Result) FastWriteNocopy(buf []byte, w thrift.
 	return offset
 }
 
-func (p *VideoServiceResumeUploadV2Result) BLength() int {
+func (p *VideoServiceVideoPopularV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -18412,7 +20628,7 @@ func (p *VideoServiceResumeUploadV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceResumeUploadV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoPopularV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -18421,7 +20637,7 @@ func (p *VideoServiceResumeUploadV2Result) fastWriteField0(buf []byte, w thrift.
 	return offset
 }
 
-func (p *VideoServiceResumeUploadV2Result) field0Length() int {
+func (p *VideoServiceVideoPopularV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -18430,7 +20646,7 @@ func (p *VideoServiceResumeUploadV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceVideoFeedListV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceVideoInfoV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18474,14 +20690,14 @@ func (p *VideoServiceVideoFeedListV2Args) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoFeedListV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoInfoV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceVideoFeedListV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceVideoInfoV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoFeedListRequestV2()
+	_field := NewVideoInfoRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18491,11 +20707,11 @@ func (p *VideoServiceVideoFeedListV2Args) FastReadField1(buf []byte) (int, error
 	return offset, nil
 }
 
-func (p *VideoServiceVideoFeedListV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceVideoInfoV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceVideoFeedListV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoInfoV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -18504,7 +20720,7 @@ func (p *VideoServiceVideoFeedListV2Args) FastWriteNocopy(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *VideoServiceVideoFeedListV2Args) BLength() int {
+func (p *VideoServiceVideoInfoV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -18513,21 +20729,21 @@ func (p *VideoServiceVideoFeedListV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceVideoFeedListV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoInfoV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceVideoFeedListV2Args) field1Length() int {
+func (p *VideoServiceVideoInfoV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceVideoFeedListV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceVideoInfoV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18571,14 +20787,14 @@ func (p *VideoServiceVideoFeedListV2Result) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoFeedListV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoInfoV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceVideoFeedListV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceVideoInfoV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoFeedListResponseV2()
+	_field := NewVideoInfoResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18588,11 +20804,11 @@ func (p *VideoServiceVideoFeedListV2Result) FastReadField0(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *VideoServiceVideoFeedListV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceVideoInfoV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceVideoFeedListV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoInfoV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -18601,7 +20817,7 @@ func (p *VideoServiceVideoFeedListV2Result) FastWriteNocopy(buf []byte, w thrift
 	return offset
 }
 
-func (p *VideoServiceVideoFeedListV2Result) BLength() int {
+func (p *VideoServiceVideoInfoV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -18610,7 +20826,7 @@ func (p *VideoServiceVideoFeedListV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceVideoFeedListV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoInfoV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -18619,7 +20835,7 @@ func (p *VideoServiceVideoFeedListV2Result) fastWriteField0(buf []byte, w thrift
 	return offset
 }
 
-func (p *VideoServiceVideoFeedListV2Result) field0Length() int {
+func (p *VideoServiceVideoInfoV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -18628,7 +20844,7 @@ func (p *VideoServiceVideoFeedListV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceVideoSearchV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceVideoDeleteV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18672,14 +20888,14 @@ func (p *VideoServiceVideoSearchV2Args) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoSearchV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoDeleteV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceVideoSearchV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceVideoDeleteV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoSearchRequestV2()
+	_field := NewVideoDeleteRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18689,11 +20905,11 @@ func (p *VideoServiceVideoSearchV2Args) FastReadField1(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *VideoServiceVideoSearchV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceVideoDeleteV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceVideoSearchV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoDeleteV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -18702,7 +20918,7 @@ func (p *VideoServiceVideoSearchV2Args) FastWriteNocopy(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *VideoServiceVideoSearchV2Args) BLength() int {
+func (p *VideoServiceVideoDeleteV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -18711,21 +20927,21 @@ func (p *VideoServiceVideoSearchV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceVideoSearchV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoDeleteV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceVideoSearchV2Args) field1Length() int {
+func (p *VideoServiceVideoDeleteV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceVideoSearchV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceVideoDeleteV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18769,14 +20985,14 @@ func (p *VideoServiceVideoSearchV2Result) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoSearchV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoDeleteV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceVideoSearchV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceVideoDeleteV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoSearchResponseV2()
+	_field := NewVideoDeleteResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18786,11 +21002,11 @@ func (p *VideoServiceVideoSearchV2Result) FastReadField0(buf []byte) (int, error
 	return offset, nil
 }
 
-func (p *VideoServiceVideoSearchV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceVideoDeleteV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceVideoSearchV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoDeleteV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -18799,7 +21015,7 @@ func (p *VideoServiceVideoSearchV2Result) FastWriteNocopy(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *VideoServiceVideoSearchV2Result) BLength() int {
+func (p *VideoServiceVideoDeleteV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -18808,7 +21024,7 @@ func (p *VideoServiceVideoSearchV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceVideoSearchV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoDeleteV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -18817,7 +21033,7 @@ func (p *VideoServiceVideoSearchV2Result) fastWriteField0(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *VideoServiceVideoSearchV2Result) field0Length() int {
+func (p *VideoServiceVideoDeleteV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -18826,7 +21042,7 @@ func (p *VideoServiceVideoSearchV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceVideoPopularV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceVideoVisitV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18870,14 +21086,14 @@ func (p *VideoServiceVideoPopularV2Args) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoPopularV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoVisitV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceVideoPopularV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceVideoVisitV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoPopularRequestV2()
+	_field := NewVideoVisitRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18887,11 +21103,11 @@ func (p *VideoServiceVideoPopularV2Args) FastReadField1(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *VideoServiceVideoPopularV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceVideoVisitV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceVideoPopularV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoVisitV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -18900,7 +21116,7 @@ func (p *VideoServiceVideoPopularV2Args) FastWriteNocopy(buf []byte, w thrift.No
 	return offset
 }
 
-func (p *VideoServiceVideoPopularV2Args) BLength() int {
+func (p *VideoServiceVideoVisitV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -18909,21 +21125,21 @@ func (p *VideoServiceVideoPopularV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceVideoPopularV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoVisitV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceVideoPopularV2Args) field1Length() int {
+func (p *VideoServiceVideoVisitV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceVideoPopularV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceVideoVisitV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18967,14 +21183,14 @@ func (p *VideoServiceVideoPopularV2Result) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoPopularV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoVisitV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceVideoPopularV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceVideoVisitV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoPopularResponseV2()
+	_field := NewVideoVisitResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18984,11 +21200,11 @@ func (p *VideoServiceVideoPopularV2Result) FastReadField0(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *VideoServiceVideoPopularV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceVideoVisitV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceVideoPopularV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoVisitV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -18997,7 +21213,7 @@ func (p *VideoServiceVideoPopularV2Result) FastWriteNocopy(buf []byte, w thrift.
 	return offset
 }
 
-func (p *VideoServiceVideoPopularV2Result) BLength() int {
+func (p *VideoServiceVideoVisitV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -19006,7 +21222,7 @@ func (p *VideoServiceVideoPopularV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceVideoPopularV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceVideoVisitV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -19015,7 +21231,7 @@ func (p *VideoServiceVideoPopularV2Result) fastWriteField0(buf []byte, w thrift.
 	return offset
 }
 
-func (p *VideoServiceVideoPopularV2Result) field0Length() int {
+func (p *VideoServiceVideoVisitV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -19024,7 +21240,7 @@ func (p *VideoServiceVideoPopularV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceVideoInfoV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceUpdateVisitCountV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19068,14 +21284,14 @@ func (p *VideoServiceVideoInfoV2Args) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoInfoV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceUpdateVisitCountV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceVideoInfoV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceUpdateVisitCountV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoInfoRequestV2()
+	_field := NewUpdateVisitCountRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19085,11 +21301,11 @@ func (p *VideoServiceVideoInfoV2Args) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *VideoServiceVideoInfoV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceUpdateVisitCountV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceVideoInfoV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceUpdateVisitCountV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -19098,7 +21314,7 @@ func (p *VideoServiceVideoInfoV2Args) FastWriteNocopy(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *VideoServiceVideoInfoV2Args) BLength() int {
+func (p *VideoServiceUpdateVisitCountV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -19107,21 +21323,21 @@ func (p *VideoServiceVideoInfoV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceVideoInfoV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceUpdateVisitCountV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceVideoInfoV2Args) field1Length() int {
+func (p *VideoServiceUpdateVisitCountV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceVideoInfoV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceUpdateVisitCountV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19165,14 +21381,14 @@ func (p *VideoServiceVideoInfoV2Result) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoInfoV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceUpdateVisitCountV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceVideoInfoV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceUpdateVisitCountV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoInfoResponseV2()
+	_field := NewUpdateVisitCountResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19182,11 +21398,11 @@ func (p *VideoServiceVideoInfoV2Result) FastReadField0(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *VideoServiceVideoInfoV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceUpdateVisitCountV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceVideoInfoV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceUpdateVisitCountV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -19195,7 +21411,7 @@ func (p *VideoServiceVideoInfoV2Result) FastWriteNocopy(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *VideoServiceVideoInfoV2Result) BLength() int {
+func (p *VideoServiceUpdateVisitCountV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -19204,7 +21420,7 @@ func (p *VideoServiceVideoInfoV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceVideoInfoV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceUpdateVisitCountV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -19213,7 +21429,7 @@ func (p *VideoServiceVideoInfoV2Result) fastWriteField0(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *VideoServiceVideoInfoV2Result) field0Length() int {
+func (p *VideoServiceUpdateVisitCountV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -19222,7 +21438,7 @@ func (p *VideoServiceVideoInfoV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceVideoDeleteV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceUpdateVideoCommentCountV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19266,14 +21482,14 @@ func (p *VideoServiceVideoDeleteV2Args) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoDeleteV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceUpdateVideoCommentCountV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceVideoDeleteV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceUpdateVideoCommentCountV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoDeleteRequestV2()
+	_field := NewUpdateVideoCommentCountRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19283,11 +21499,11 @@ func (p *VideoServiceVideoDeleteV2Args) FastReadField1(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *VideoServiceVideoDeleteV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceUpdateVideoCommentCountV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceVideoDeleteV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceUpdateVideoCommentCountV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -19296,7 +21512,7 @@ func (p *VideoServiceVideoDeleteV2Args) FastWriteNocopy(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *VideoServiceVideoDeleteV2Args) BLength() int {
+func (p *VideoServiceUpdateVideoCommentCountV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -19305,21 +21521,21 @@ func (p *VideoServiceVideoDeleteV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceVideoDeleteV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceUpdateVideoCommentCountV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceVideoDeleteV2Args) field1Length() int {
+func (p *VideoServiceUpdateVideoCommentCountV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceVideoDeleteV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceUpdateVideoCommentCountV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19363,14 +21579,14 @@ func (p *VideoServiceVideoDeleteV2Result) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoDeleteV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceUpdateVideoCommentCountV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceVideoDeleteV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceUpdateVideoCommentCountV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoDeleteResponseV2()
+	_field := NewUpdateVideoCommentCountResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19380,11 +21596,11 @@ func (p *VideoServiceVideoDeleteV2Result) FastReadField0(buf []byte) (int, error
 	return offset, nil
 }
 
-func (p *VideoServiceVideoDeleteV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceUpdateVideoCommentCountV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceVideoDeleteV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceUpdateVideoCommentCountV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -19393,7 +21609,7 @@ func (p *VideoServiceVideoDeleteV2Result) FastWriteNocopy(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *VideoServiceVideoDeleteV2Result) BLength() int {
+func (p *VideoServiceUpdateVideoCommentCountV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -19402,7 +21618,7 @@ func (p *VideoServiceVideoDeleteV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceVideoDeleteV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceUpdateVideoCommentCountV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -19411,7 +21627,7 @@ func (p *VideoServiceVideoDeleteV2Result) fastWriteField0(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *VideoServiceVideoDeleteV2Result) field0Length() int {
+func (p *VideoServiceUpdateVideoCommentCountV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -19420,7 +21636,7 @@ func (p *VideoServiceVideoDeleteV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceVideoVisitV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceUpdateVideoLikeCountV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19464,14 +21680,14 @@ func (p *VideoServiceVideoVisitV2Args) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoVisitV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceUpdateVideoLikeCountV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceVideoVisitV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceUpdateVideoLikeCountV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoVisitRequestV2()
+	_field := NewUpdateLikeCountRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19481,11 +21697,11 @@ func (p *VideoServiceVideoVisitV2Args) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *VideoServiceVideoVisitV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceUpdateVideoLikeCountV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceVideoVisitV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceUpdateVideoLikeCountV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -19494,7 +21710,7 @@ func (p *VideoServiceVideoVisitV2Args) FastWriteNocopy(buf []byte, w thrift.Noco
 	return offset
 }
 
-func (p *VideoServiceVideoVisitV2Args) BLength() int {
+func (p *VideoServiceUpdateVideoLikeCountV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -19503,21 +21719,21 @@ func (p *VideoServiceVideoVisitV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceVideoVisitV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceUpdateVideoLikeCountV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceVideoVisitV2Args) field1Length() int {
+func (p *VideoServiceUpdateVideoLikeCountV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceVideoVisitV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceUpdateVideoLikeCountV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19561,14 +21777,14 @@ func (p *VideoServiceVideoVisitV2Result) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceVideoVisitV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceUpdateVideoLikeCountV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceVideoVisitV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceUpdateVideoLikeCountV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoVisitResponseV2()
+	_field := NewUpdateLikeCountResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19578,11 +21794,11 @@ func (p *VideoServiceVideoVisitV2Result) FastReadField0(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *VideoServiceVideoVisitV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceUpdateVideoLikeCountV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceVideoVisitV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceUpdateVideoLikeCountV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -19591,7 +21807,7 @@ func (p *VideoServiceVideoVisitV2Result) FastWriteNocopy(buf []byte, w thrift.No
 	return offset
 }
 
-func (p *VideoServiceVideoVisitV2Result) BLength() int {
+func (p *VideoServiceUpdateVideoLikeCountV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -19600,7 +21816,7 @@ func (p *VideoServiceVideoVisitV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceVideoVisitV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceUpdateVideoLikeCountV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -19609,7 +21825,7 @@ func (p *VideoServiceVideoVisitV2Result) fastWriteField0(buf []byte, w thrift.No
 	return offset
 }
 
-func (p *VideoServiceVideoVisitV2Result) field0Length() int {
+func (p *VideoServiceUpdateVideoLikeCountV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -19618,7 +21834,7 @@ func (p *VideoServiceVideoVisitV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceUpdateVisitCountV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceGetVideoVisitCountV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19662,14 +21878,14 @@ func (p *VideoServiceUpdateVisitCountV2Args) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceUpdateVisitCountV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetVideoVisitCountV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceUpdateVisitCountV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceGetVideoVisitCountV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateVisitCountRequestV2()
+	_field := NewGetVideoVisitCountRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19679,11 +21895,11 @@ func (p *VideoServiceUpdateVisitCountV2Args) FastReadField1(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *VideoServiceUpdateVisitCountV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceGetVideoVisitCountV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceUpdateVisitCountV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetVideoVisitCountV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -19692,7 +21908,7 @@ func (p *VideoServiceUpdateVisitCountV2Args) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *VideoServiceUpdateVisitCountV2Args) BLength() int {
+func (p *VideoServiceGetVideoVisitCountV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -19701,21 +21917,21 @@ func (p *VideoServiceUpdateVisitCountV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceUpdateVisitCountV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetVideoVisitCountV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceUpdateVisitCountV2Args) field1Length() int {
+func (p *VideoServiceGetVideoVisitCountV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceUpdateVisitCountV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceGetVideoVisitCountV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19759,14 +21975,14 @@ func (p *VideoServiceUpdateVisitCountV2Result) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceUpdateVisitCountV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetVideoVisitCountV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceUpdateVisitCountV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceGetVideoVisitCountV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateVisitCountResponseV2()
+	_field := NewGetVideoVisitCountResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19776,11 +21992,11 @@ func (p *VideoServiceUpdateVisitCountV2Result) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *VideoServiceUpdateVisitCountV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceGetVideoVisitCountV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceUpdateVisitCountV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetVideoVisitCountV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -19789,7 +22005,7 @@ func (p *VideoServiceUpdateVisitCountV2Result) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *VideoServiceUpdateVisitCountV2Result) BLength() int {
+func (p *VideoServiceGetVideoVisitCountV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -19798,7 +22014,7 @@ func (p *VideoServiceUpdateVisitCountV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceUpdateVisitCountV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetVideoVisitCountV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -19807,7 +22023,7 @@ func (p *VideoServiceUpdateVisitCountV2Result) fastWriteField0(buf []byte, w thr
 	return offset
 }
 
-func (p *VideoServiceUpdateVisitCountV2Result) field0Length() int {
+func (p *VideoServiceGetVideoVisitCountV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -19816,7 +22032,7 @@ func (p *VideoServiceUpdateVisitCountV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceUpdateVideoCommentCountV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceStreamVideoV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19860,14 +22076,14 @@ func (p *VideoServiceUpdateVideoCommentCountV2Args) FastRead(buf []byte) (int, e
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceUpdateVideoCommentCountV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceStreamVideoV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceUpdateVideoCommentCountV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceStreamVideoV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateVideoCommentCountRequestV2()
+	_field := NewStreamVideoRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19877,11 +22093,11 @@ func (p *VideoServiceUpdateVideoCommentCountV2Args) FastReadField1(buf []byte) (
 	return offset, nil
 }
 
-func (p *VideoServiceUpdateVideoCommentCountV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceStreamVideoV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceUpdateVideoCommentCountV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceStreamVideoV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -19890,7 +22106,7 @@ func (p *VideoServiceUpdateVideoCommentCountV2Args) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *VideoServiceUpdateVideoCommentCountV2Args) BLength() int {
+func (p *VideoServiceStreamVideoV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -19899,21 +22115,21 @@ func (p *VideoServiceUpdateVideoCommentCountV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceUpdateVideoCommentCountV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceStreamVideoV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceUpdateVideoCommentCountV2Args) field1Length() int {
+func (p *VideoServiceStreamVideoV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceUpdateVideoCommentCountV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceStreamVideoV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19957,14 +22173,14 @@ func (p *VideoServiceUpdateVideoCommentCountV2Result) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceUpdateVideoCommentCountV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceStreamVideoV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceUpdateVideoCommentCountV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceStreamVideoV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateVideoCommentCountResponseV2()
+	_field := NewStreamVideoResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19974,11 +22190,11 @@ func (p *VideoServiceUpdateVideoCommentCountV2Result) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *VideoServiceUpdateVideoCommentCountV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceStreamVideoV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceUpdateVideoCommentCountV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceStreamVideoV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -19987,7 +22203,7 @@ func (p *VideoServiceUpdateVideoCommentCountV2Result) FastWriteNocopy(buf []byte
 	return offset
 }
 
-func (p *VideoServiceUpdateVideoCommentCountV2Result) BLength() int {
+func (p *VideoServiceStreamVideoV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -19996,7 +22212,7 @@ func (p *VideoServiceUpdateVideoCommentCountV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceUpdateVideoCommentCountV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceStreamVideoV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -20005,7 +22221,7 @@ func (p *VideoServiceUpdateVideoCommentCountV2Result) fastWriteField0(buf []byte
 	return offset
 }
 
-func (p *VideoServiceUpdateVideoCommentCountV2Result) field0Length() int {
+func (p *VideoServiceStreamVideoV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -20014,7 +22230,7 @@ func (p *VideoServiceUpdateVideoCommentCountV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceUpdateVideoLikeCountV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceCreateFavoriteV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20058,14 +22274,14 @@ func (p *VideoServiceUpdateVideoLikeCountV2Args) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceUpdateVideoLikeCountV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceCreateFavoriteV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceUpdateVideoLikeCountV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceCreateFavoriteV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateLikeCountRequestV2()
+	_field := NewCreateFavoriteRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20075,11 +22291,11 @@ func (p *VideoServiceUpdateVideoLikeCountV2Args) FastReadField1(buf []byte) (int
 	return offset, nil
 }
 
-func (p *VideoServiceUpdateVideoLikeCountV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceCreateFavoriteV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceUpdateVideoLikeCountV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceCreateFavoriteV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20088,7 +22304,7 @@ func (p *VideoServiceUpdateVideoLikeCountV2Args) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *VideoServiceUpdateVideoLikeCountV2Args) BLength() int {
+func (p *VideoServiceCreateFavoriteV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20097,21 +22313,21 @@ func (p *VideoServiceUpdateVideoLikeCountV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceUpdateVideoLikeCountV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceCreateFavoriteV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceUpdateVideoLikeCountV2Args) field1Length() int {
+func (p *VideoServiceCreateFavoriteV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceUpdateVideoLikeCountV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceCreateFavoriteV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20155,14 +22371,14 @@ func (p *VideoServiceUpdateVideoLikeCountV2Result) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceUpdateVideoLikeCountV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceCreateFavoriteV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceUpdateVideoLikeCountV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceCreateFavoriteV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateLikeCountResponseV2()
+	_field := NewCreateFavoriteResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20172,11 +22388,11 @@ func (p *VideoServiceUpdateVideoLikeCountV2Result) FastReadField0(buf []byte) (i
 	return offset, nil
 }
 
-func (p *VideoServiceUpdateVideoLikeCountV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceCreateFavoriteV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceUpdateVideoLikeCountV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceCreateFavoriteV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -20185,7 +22401,7 @@ func (p *VideoServiceUpdateVideoLikeCountV2Result) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *VideoServiceUpdateVideoLikeCountV2Result) BLength() int {
+func (p *VideoServiceCreateFavoriteV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -20194,7 +22410,7 @@ func (p *VideoServiceUpdateVideoLikeCountV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceUpdateVideoLikeCountV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceCreateFavoriteV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -20203,7 +22419,7 @@ func (p *VideoServiceUpdateVideoLikeCountV2Result) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *VideoServiceUpdateVideoLikeCountV2Result) field0Length() int {
+func (p *VideoServiceCreateFavoriteV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -20212,7 +22428,7 @@ func (p *VideoServiceUpdateVideoLikeCountV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceGetVideoVisitCountV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceGetFavoriteVideoListV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20256,14 +22472,14 @@ func (p *VideoServiceGetVideoVisitCountV2Args) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetVideoVisitCountV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetFavoriteVideoListV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceGetVideoVisitCountV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceGetFavoriteVideoListV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetVideoVisitCountRequestV2()
+	_field := NewGetFavoriteVideoListRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20273,11 +22489,11 @@ func (p *VideoServiceGetVideoVisitCountV2Args) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *VideoServiceGetVideoVisitCountV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceGetFavoriteVideoListV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceGetVideoVisitCountV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetFavoriteVideoListV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20286,7 +22502,7 @@ func (p *VideoServiceGetVideoVisitCountV2Args) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *VideoServiceGetVideoVisitCountV2Args) BLength() int {
+func (p *VideoServiceGetFavoriteVideoListV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20295,21 +22511,21 @@ func (p *VideoServiceGetVideoVisitCountV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceGetVideoVisitCountV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetFavoriteVideoListV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceGetVideoVisitCountV2Args) field1Length() int {
+func (p *VideoServiceGetFavoriteVideoListV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceGetVideoVisitCountV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceGetFavoriteVideoListV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20353,14 +22569,14 @@ func (p *VideoServiceGetVideoVisitCountV2Result) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetVideoVisitCountV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetFavoriteVideoListV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceGetVideoVisitCountV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceGetFavoriteVideoListV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetVideoVisitCountResponseV2()
+	_field := NewGetFavoriteVideoListResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20370,11 +22586,11 @@ func (p *VideoServiceGetVideoVisitCountV2Result) FastReadField0(buf []byte) (int
 	return offset, nil
 }
 
-func (p *VideoServiceGetVideoVisitCountV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceGetFavoriteVideoListV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceGetVideoVisitCountV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetFavoriteVideoListV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -20383,7 +22599,7 @@ func (p *VideoServiceGetVideoVisitCountV2Result) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *VideoServiceGetVideoVisitCountV2Result) BLength() int {
+func (p *VideoServiceGetFavoriteVideoListV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -20392,7 +22608,7 @@ func (p *VideoServiceGetVideoVisitCountV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceGetVideoVisitCountV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetFavoriteVideoListV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -20401,7 +22617,7 @@ func (p *VideoServiceGetVideoVisitCountV2Result) fastWriteField0(buf []byte, w t
 	return offset
 }
 
-func (p *VideoServiceGetVideoVisitCountV2Result) field0Length() int {
+func (p *VideoServiceGetFavoriteVideoListV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -20410,7 +22626,7 @@ func (p *VideoServiceGetVideoVisitCountV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceStreamVideoV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceGetFavoriteListV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20454,14 +22670,14 @@ func (p *VideoServiceStreamVideoV2Args) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceStreamVideoV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetFavoriteListV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceStreamVideoV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceGetFavoriteListV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewStreamVideoRequestV2()
+	_field := NewGetFavoriteListRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20471,11 +22687,11 @@ func (p *VideoServiceStreamVideoV2Args) FastReadField1(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *VideoServiceStreamVideoV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceGetFavoriteListV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceStreamVideoV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetFavoriteListV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20484,7 +22700,7 @@ func (p *VideoServiceStreamVideoV2Args) FastWriteNocopy(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *VideoServiceStreamVideoV2Args) BLength() int {
+func (p *VideoServiceGetFavoriteListV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20493,21 +22709,21 @@ func (p *VideoServiceStreamVideoV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceStreamVideoV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetFavoriteListV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceStreamVideoV2Args) field1Length() int {
+func (p *VideoServiceGetFavoriteListV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceStreamVideoV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceGetFavoriteListV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20551,14 +22767,14 @@ func (p *VideoServiceStreamVideoV2Result) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceStreamVideoV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetFavoriteListV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceStreamVideoV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceGetFavoriteListV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewStreamVideoResponseV2()
+	_field := NewGetFavoriteListResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20568,11 +22784,11 @@ func (p *VideoServiceStreamVideoV2Result) FastReadField0(buf []byte) (int, error
 	return offset, nil
 }
 
-func (p *VideoServiceStreamVideoV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceGetFavoriteListV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceStreamVideoV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetFavoriteListV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -20581,7 +22797,7 @@ func (p *VideoServiceStreamVideoV2Result) FastWriteNocopy(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *VideoServiceStreamVideoV2Result) BLength() int {
+func (p *VideoServiceGetFavoriteListV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -20590,7 +22806,7 @@ func (p *VideoServiceStreamVideoV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceStreamVideoV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetFavoriteListV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -20599,7 +22815,7 @@ func (p *VideoServiceStreamVideoV2Result) fastWriteField0(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *VideoServiceStreamVideoV2Result) field0Length() int {
+func (p *VideoServiceGetFavoriteListV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -20608,7 +22824,7 @@ func (p *VideoServiceStreamVideoV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceCreateFavoriteV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceAddFavoriteVideoV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20652,14 +22868,14 @@ func (p *VideoServiceCreateFavoriteV2Args) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceCreateFavoriteV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceAddFavoriteVideoV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceCreateFavoriteV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceAddFavoriteVideoV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateFavoriteRequestV2()
+	_field := NewAddFavoriteVideoRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20669,11 +22885,11 @@ func (p *VideoServiceCreateFavoriteV2Args) FastReadField1(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *VideoServiceCreateFavoriteV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceAddFavoriteVideoV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceCreateFavoriteV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceAddFavoriteVideoV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20682,7 +22898,7 @@ func (p *VideoServiceCreateFavoriteV2Args) FastWriteNocopy(buf []byte, w thrift.
 	return offset
 }
 
-func (p *VideoServiceCreateFavoriteV2Args) BLength() int {
+func (p *VideoServiceAddFavoriteVideoV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20691,21 +22907,21 @@ func (p *VideoServiceCreateFavoriteV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceCreateFavoriteV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceAddFavoriteVideoV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceCreateFavoriteV2Args) field1Length() int {
+func (p *VideoServiceAddFavoriteVideoV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceCreateFavoriteV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceAddFavoriteVideoV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20749,14 +22965,14 @@ func (p *VideoServiceCreateFavoriteV2Result) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceCreateFavoriteV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceAddFavoriteVideoV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceCreateFavoriteV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceAddFavoriteVideoV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateFavoriteResponseV2()
+	_field := NewAddFavoriteVideoResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20766,11 +22982,11 @@ func (p *VideoServiceCreateFavoriteV2Result) FastReadField0(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *VideoServiceCreateFavoriteV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceAddFavoriteVideoV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceCreateFavoriteV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceAddFavoriteVideoV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -20779,7 +22995,7 @@ func (p *VideoServiceCreateFavoriteV2Result) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *VideoServiceCreateFavoriteV2Result) BLength() int {
+func (p *VideoServiceAddFavoriteVideoV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -20788,7 +23004,7 @@ func (p *VideoServiceCreateFavoriteV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceCreateFavoriteV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceAddFavoriteVideoV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -20797,7 +23013,7 @@ func (p *VideoServiceCreateFavoriteV2Result) fastWriteField0(buf []byte, w thrif
 	return offset
 }
 
-func (p *VideoServiceCreateFavoriteV2Result) field0Length() int {
+func (p *VideoServiceAddFavoriteVideoV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -20806,7 +23022,7 @@ func (p *VideoServiceCreateFavoriteV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceGetFavoriteVideoListV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceDeleteFavoriteV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20850,14 +23066,14 @@ func (p *VideoServiceGetFavoriteVideoListV2Args) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetFavoriteVideoListV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDeleteFavoriteV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceGetFavoriteVideoListV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceDeleteFavoriteV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetFavoriteVideoListRequestV2()
+	_field := NewDeleteFavoriteRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20867,11 +23083,11 @@ func (p *VideoServiceGetFavoriteVideoListV2Args) FastReadField1(buf []byte) (int
 	return offset, nil
 }
 
-func (p *VideoServiceGetFavoriteVideoListV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceDeleteFavoriteV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceGetFavoriteVideoListV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceDeleteFavoriteV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20880,7 +23096,7 @@ func (p *VideoServiceGetFavoriteVideoListV2Args) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *VideoServiceGetFavoriteVideoListV2Args) BLength() int {
+func (p *VideoServiceDeleteFavoriteV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20889,21 +23105,21 @@ func (p *VideoServiceGetFavoriteVideoListV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceGetFavoriteVideoListV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceDeleteFavoriteV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceGetFavoriteVideoListV2Args) field1Length() int {
+func (p *VideoServiceDeleteFavoriteV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceGetFavoriteVideoListV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceDeleteFavoriteV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20947,14 +23163,14 @@ func (p *VideoServiceGetFavoriteVideoListV2Result) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetFavoriteVideoListV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDeleteFavoriteV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceGetFavoriteVideoListV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceDeleteFavoriteV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetFavoriteVideoListResponseV2()
+	_field := NewDeleteFavoriteResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20964,11 +23180,11 @@ func (p *VideoServiceGetFavoriteVideoListV2Result) FastReadField0(buf []byte) (i
 	return offset, nil
 }
 
-func (p *VideoServiceGetFavoriteVideoListV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceDeleteFavoriteV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceGetFavoriteVideoListV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceDeleteFavoriteV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -20977,7 +23193,7 @@ func (p *VideoServiceGetFavoriteVideoListV2Result) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *VideoServiceGetFavoriteVideoListV2Result) BLength() int {
+func (p *VideoServiceDeleteFavoriteV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -20986,7 +23202,7 @@ func (p *VideoServiceGetFavoriteVideoListV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceGetFavoriteVideoListV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceDeleteFavoriteV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -20995,7 +23211,7 @@ func (p *VideoServiceGetFavoriteVideoListV2Result) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *VideoServiceGetFavoriteVideoListV2Result) field0Length() int {
+func (p *VideoServiceDeleteFavoriteV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -21004,7 +23220,7 @@ func (p *VideoServiceGetFavoriteVideoListV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceGetFavoriteListV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceDeleteVideoFromFavoriteV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21048,14 +23264,14 @@ func (p *VideoServiceGetFavoriteListV2Args) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetFavoriteListV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDeleteVideoFromFavoriteV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceGetFavoriteListV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceDeleteVideoFromFavoriteV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetFavoriteListRequestV2()
+	_field := NewDeleteVideoFromFavoriteRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21065,11 +23281,11 @@ func (p *VideoServiceGetFavoriteListV2Args) FastReadField1(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *VideoServiceGetFavoriteListV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceDeleteVideoFromFavoriteV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceGetFavoriteListV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceDeleteVideoFromFavoriteV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -21078,7 +23294,7 @@ func (p *VideoServiceGetFavoriteListV2Args) FastWriteNocopy(buf []byte, w thrift
 	return offset
 }
 
-func (p *VideoServiceGetFavoriteListV2Args) BLength() int {
+func (p *VideoServiceDeleteVideoFromFavoriteV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -21087,21 +23303,21 @@ func (p *VideoServiceGetFavoriteListV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceGetFavoriteListV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceDeleteVideoFromFavoriteV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceGetFavoriteListV2Args) field1Length() int {
+func (p *VideoServiceDeleteVideoFromFavoriteV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceGetFavoriteListV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceDeleteVideoFromFavoriteV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21145,14 +23361,14 @@ func (p *VideoServiceGetFavoriteListV2Result) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetFavoriteListV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDeleteVideoFromFavoriteV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceGetFavoriteListV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceDeleteVideoFromFavoriteV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetFavoriteListResponseV2()
+	_field := NewDeleteVideoFromFavoriteResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21162,11 +23378,11 @@ func (p *VideoServiceGetFavoriteListV2Result) FastReadField0(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *VideoServiceGetFavoriteListV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceDeleteVideoFromFavoriteV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceGetFavoriteListV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceDeleteVideoFromFavoriteV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -21175,7 +23391,7 @@ func (p *VideoServiceGetFavoriteListV2Result) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *VideoServiceGetFavoriteListV2Result) BLength() int {
+func (p *VideoServiceDeleteVideoFromFavoriteV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -21184,7 +23400,7 @@ func (p *VideoServiceGetFavoriteListV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceGetFavoriteListV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceDeleteVideoFromFavoriteV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -21193,7 +23409,7 @@ func (p *VideoServiceGetFavoriteListV2Result) fastWriteField0(buf []byte, w thri
 	return offset
 }
 
-func (p *VideoServiceGetFavoriteListV2Result) field0Length() int {
+func (p *VideoServiceDeleteVideoFromFavoriteV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -21202,7 +23418,7 @@ func (p *VideoServiceGetFavoriteListV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceAddFavoriteVideoV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceSharedVideoV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21246,14 +23462,14 @@ func (p *VideoServiceAddFavoriteVideoV2Args) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceAddFavoriteVideoV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceSharedVideoV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceAddFavoriteVideoV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceSharedVideoV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewAddFavoriteVideoRequestV2()
+	_field := NewSharedVideoRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21263,11 +23479,11 @@ func (p *VideoServiceAddFavoriteVideoV2Args) FastReadField1(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *VideoServiceAddFavoriteVideoV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceSharedVideoV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceAddFavoriteVideoV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceSharedVideoV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -21276,7 +23492,7 @@ func (p *VideoServiceAddFavoriteVideoV2Args) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *VideoServiceAddFavoriteVideoV2Args) BLength() int {
+func (p *VideoServiceSharedVideoV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -21285,21 +23501,21 @@ func (p *VideoServiceAddFavoriteVideoV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceAddFavoriteVideoV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceSharedVideoV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceAddFavoriteVideoV2Args) field1Length() int {
+func (p *VideoServiceSharedVideoV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceAddFavoriteVideoV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceSharedVideoV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21343,14 +23559,14 @@ func (p *VideoServiceAddFavoriteVideoV2Result) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceAddFavoriteVideoV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceSharedVideoV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceAddFavoriteVideoV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceSharedVideoV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewAddFavoriteVideoResponseV2()
+	_field := NewSharedVideoResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21360,11 +23576,11 @@ func (p *VideoServiceAddFavoriteVideoV2Result) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *VideoServiceAddFavoriteVideoV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceSharedVideoV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceAddFavoriteVideoV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceSharedVideoV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -21373,7 +23589,7 @@ func (p *VideoServiceAddFavoriteVideoV2Result) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *VideoServiceAddFavoriteVideoV2Result) BLength() int {
+func (p *VideoServiceSharedVideoV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -21382,7 +23598,7 @@ func (p *VideoServiceAddFavoriteVideoV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceAddFavoriteVideoV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceSharedVideoV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -21391,7 +23607,7 @@ func (p *VideoServiceAddFavoriteVideoV2Result) fastWriteField0(buf []byte, w thr
 	return offset
 }
 
-func (p *VideoServiceAddFavoriteVideoV2Result) field0Length() int {
+func (p *VideoServiceSharedVideoV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -21400,7 +23616,7 @@ func (p *VideoServiceAddFavoriteVideoV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceDeleteFavoriteV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceRecommendVideoV2Args) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21444,14 +23660,14 @@ func (p *VideoServiceDeleteFavoriteV2Args) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDeleteFavoriteV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceRecommendVideoV2Args[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceDeleteFavoriteV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceRecommendVideoV2Args) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteFavoriteRequestV2()
+	_field := NewRecommendVideoRequestV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21461,11 +23677,11 @@ func (p *VideoServiceDeleteFavoriteV2Args) FastReadField1(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *VideoServiceDeleteFavoriteV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceRecommendVideoV2Args) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceDeleteFavoriteV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceRecommendVideoV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -21474,7 +23690,7 @@ func (p *VideoServiceDeleteFavoriteV2Args) FastWriteNocopy(buf []byte, w thrift.
 	return offset
 }
 
-func (p *VideoServiceDeleteFavoriteV2Args) BLength() int {
+func (p *VideoServiceRecommendVideoV2Args) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -21483,21 +23699,21 @@ func (p *VideoServiceDeleteFavoriteV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceDeleteFavoriteV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceRecommendVideoV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceDeleteFavoriteV2Args) field1Length() int {
+func (p *VideoServiceRecommendVideoV2Args) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceDeleteFavoriteV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceRecommendVideoV2Result) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21541,14 +23757,14 @@ func (p *VideoServiceDeleteFavoriteV2Result) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDeleteFavoriteV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceRecommendVideoV2Result[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceDeleteFavoriteV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceRecommendVideoV2Result) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteFavoriteResponseV2()
+	_field := NewRecommendVideoResponseV2()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21558,11 +23774,11 @@ func (p *VideoServiceDeleteFavoriteV2Result) FastReadField0(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *VideoServiceDeleteFavoriteV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceRecommendVideoV2Result) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceDeleteFavoriteV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceRecommendVideoV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -21571,7 +23787,7 @@ func (p *VideoServiceDeleteFavoriteV2Result) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *VideoServiceDeleteFavoriteV2Result) BLength() int {
+func (p *VideoServiceRecommendVideoV2Result) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -21580,7 +23796,7 @@ func (p *VideoServiceDeleteFavoriteV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceDeleteFavoriteV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceRecommendVideoV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -21589,7 +23805,7 @@ func (p *VideoServiceDeleteFavoriteV2Result) fastWriteField0(buf []byte, w thrif
 	return offset
 }
 
-func (p *VideoServiceDeleteFavoriteV2Result) field0Length() int {
+func (p *VideoServiceRecommendVideoV2Result) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -21598,7 +23814,7 @@ func (p *VideoServiceDeleteFavoriteV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceDeleteVideoFromFavoriteV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceRelatedVideosArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21642,14 +23858,14 @@ func (p *VideoServiceDeleteVideoFromFavoriteV2Args) FastRead(buf []byte) (int, e
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDeleteVideoFromFavoriteV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceRelatedVideosArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceDeleteVideoFromFavoriteV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceRelatedVideosArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteVideoFromFavoriteRequestV2()
+	_field := NewRelatedVideosRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21659,11 +23875,11 @@ func (p *VideoServiceDeleteVideoFromFavoriteV2Args) FastReadField1(buf []byte) (
 	return offset, nil
 }
 
-func (p *VideoServiceDeleteVideoFromFavoriteV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceRelatedVideosArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceDeleteVideoFromFavoriteV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceRelatedVideosArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -21672,7 +23888,7 @@ func (p *VideoServiceDeleteVideoFromFavoriteV2Args) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *VideoServiceDeleteVideoFromFavoriteV2Args) BLength() int {
+func (p *VideoServiceRelatedVideosArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -21681,21 +23897,21 @@ func (p *VideoServiceDeleteVideoFromFavoriteV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceDeleteVideoFromFavoriteV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceRelatedVideosArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceDeleteVideoFromFavoriteV2Args) field1Length() int {
+func (p *VideoServiceRelatedVideosArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceDeleteVideoFromFavoriteV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceRelatedVideosResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21739,14 +23955,14 @@ func (p *VideoServiceDeleteVideoFromFavoriteV2Result) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDeleteVideoFromFavoriteV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceRelatedVideosResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceDeleteVideoFromFavoriteV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceRelatedVideosResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteVideoFromFavoriteResponseV2()
+	_field := NewRelatedVideosResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21756,11 +23972,11 @@ func (p *VideoServiceDeleteVideoFromFavoriteV2Result) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *VideoServiceDeleteVideoFromFavoriteV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceRelatedVideosResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceDeleteVideoFromFavoriteV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceRelatedVideosResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -21769,7 +23985,7 @@ func (p *VideoServiceDeleteVideoFromFavoriteV2Result) FastWriteNocopy(buf []byte
 	return offset
 }
 
-func (p *VideoServiceDeleteVideoFromFavoriteV2Result) BLength() int {
+func (p *VideoServiceRelatedVideosResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -21778,7 +23994,7 @@ func (p *VideoServiceDeleteVideoFromFavoriteV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceDeleteVideoFromFavoriteV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceRelatedVideosResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -21787,7 +24003,7 @@ func (p *VideoServiceDeleteVideoFromFavoriteV2Result) fastWriteField0(buf []byte
 	return offset
 }
 
-func (p *VideoServiceDeleteVideoFromFavoriteV2Result) field0Length() int {
+func (p *VideoServiceRelatedVideosResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -21796,7 +24012,7 @@ func (p *VideoServiceDeleteVideoFromFavoriteV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceSharedVideoV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceForYouFeedArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21840,14 +24056,14 @@ func (p *VideoServiceSharedVideoV2Args) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceSharedVideoV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceForYouFeedArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceSharedVideoV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceForYouFeedArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewSharedVideoRequestV2()
+	_field := NewForYouFeedRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21857,11 +24073,11 @@ func (p *VideoServiceSharedVideoV2Args) FastReadField1(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *VideoServiceSharedVideoV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceForYouFeedArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceSharedVideoV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceForYouFeedArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -21870,7 +24086,7 @@ func (p *VideoServiceSharedVideoV2Args) FastWriteNocopy(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *VideoServiceSharedVideoV2Args) BLength() int {
+func (p *VideoServiceForYouFeedArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -21879,21 +24095,21 @@ func (p *VideoServiceSharedVideoV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceSharedVideoV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceForYouFeedArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceSharedVideoV2Args) field1Length() int {
+func (p *VideoServiceForYouFeedArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceSharedVideoV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceForYouFeedResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -21937,14 +24153,14 @@ func (p *VideoServiceSharedVideoV2Result) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceSharedVideoV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceForYouFeedResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceSharedVideoV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceForYouFeedResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewSharedVideoResponseV2()
+	_field := NewForYouFeedResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -21954,11 +24170,11 @@ func (p *VideoServiceSharedVideoV2Result) FastReadField0(buf []byte) (int, error
 	return offset, nil
 }
 
-func (p *VideoServiceSharedVideoV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceForYouFeedResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceSharedVideoV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceForYouFeedResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -21967,7 +24183,7 @@ func (p *VideoServiceSharedVideoV2Result) FastWriteNocopy(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *VideoServiceSharedVideoV2Result) BLength() int {
+func (p *VideoServiceForYouFeedResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -21976,7 +24192,7 @@ func (p *VideoServiceSharedVideoV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceSharedVideoV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceForYouFeedResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -21985,7 +24201,7 @@ func (p *VideoServiceSharedVideoV2Result) fastWriteField0(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *VideoServiceSharedVideoV2Result) field0Length() int {
+func (p *VideoServiceForYouFeedResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -21994,7 +24210,7 @@ func (p *VideoServiceSharedVideoV2Result) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceRecommendVideoV2Args) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceFollowingTimelineArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -22038,14 +24254,14 @@ func (p *VideoServiceRecommendVideoV2Args) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceRecommendVideoV2Args[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceFollowingTimelineArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceRecommendVideoV2Args) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceFollowingTimelineArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRecommendVideoRequestV2()
+	_field := NewFollowingTimelineRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -22055,11 +24271,11 @@ func (p *VideoServiceRecommendVideoV2Args) FastReadField1(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *VideoServiceRecommendVideoV2Args) FastWrite(buf []byte) int {
+func (p *VideoServiceFollowingTimelineArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceRecommendVideoV2Args) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceFollowingTimelineArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -22068,7 +24284,7 @@ func (p *VideoServiceRecommendVideoV2Args) FastWriteNocopy(buf []byte, w thrift.
 	return offset
 }
 
-func (p *VideoServiceRecommendVideoV2Args) BLength() int {
+func (p *VideoServiceFollowingTimelineArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -22077,21 +24293,21 @@ func (p *VideoServiceRecommendVideoV2Args) BLength() int {
 	return l
 }
 
-func (p *VideoServiceRecommendVideoV2Args) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceFollowingTimelineArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceRecommendVideoV2Args) field1Length() int {
+func (p *VideoServiceFollowingTimelineArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceRecommendVideoV2Result) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceFollowingTimelineResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -22135,14 +24351,14 @@ func (p *VideoServiceRecommendVideoV2Result) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceRecommendVideoV2Result[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceFollowingTimelineResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceRecommendVideoV2Result) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceFollowingTimelineResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRecommendVideoResponseV2()
+	_field := NewFollowingTimelineResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -22152,11 +24368,11 @@ func (p *VideoServiceRecommendVideoV2Result) FastReadField0(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *VideoServiceRecommendVideoV2Result) FastWrite(buf []byte) int {
+func (p *VideoServiceFollowingTimelineResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceRecommendVideoV2Result) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceFollowingTimelineResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -22165,7 +24381,7 @@ func (p *VideoServiceRecommendVideoV2Result) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *VideoServiceRecommendVideoV2Result) BLength() int {
+func (p *VideoServiceFollowingTimelineResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -22174,7 +24390,7 @@ func (p *VideoServiceRecommendVideoV2Result) BLength() int {
 	return l
 }
 
-func (p *VideoServiceRecommendVideoV2Result) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceFollowingTimelineResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -22183,7 +24399,7 @@ func (p *VideoServiceRecommendVideoV2Result) fastWriteField0(buf []byte, w thrif
 	return offset
 }
 
-func (p *VideoServiceRecommendVideoV2Result) field0Length() int {
+func (p *VideoServiceFollowingTimelineResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -23382,6 +25598,30 @@ func (p *VideoServiceRecommendVideoV2Result) GetResult() interface{} {
 	return p.Success
 }
 
+func (p *VideoServiceRelatedVideosArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *VideoServiceRelatedVideosResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *VideoServiceForYouFeedArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *VideoServiceForYouFeedResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *VideoServiceFollowingTimelineArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *VideoServiceFollowingTimelineResult) GetResult() interface{} {
+	return p.Success
+}
+
 func (p *VideoServiceManageVideoHeatV2Args) GetFirstArgument() interface{} {
 	return p.Req
 }
@@ -1213,6 +1213,9 @@ type VideoFeedListRequestV2 struct {
 	CategoryFilter string   `thrift:"category_filter,4" frugal:"4,default,string" json:"category_filter"`
 	PrivacyFilter  string   `thrift:"privacy_filter,5" frugal:"5,default,string" json:"privacy_filter"`
 	TagFilters     []string `thrift:"tag_filters,6" frugal:"6,default,list<string>" json:"tag_filters"`
+	ViewerId       int64    `thrift:"viewer_id,7" frugal:"7,default,i64" json:"viewer_id"`
+	Cursor         string   `thrift:"cursor,8" frugal:"8,default,string" json:"cursor"`
+	UseCursor      bool     `thrift:"use_cursor,9" frugal:"9,default,bool" json:"use_cursor"`
 }
 
 func NewVideoFeedListRequestV2() *VideoFeedListRequestV2 {
@@ -1245,6 +1248,18 @@ func (p *VideoFeedListRequestV2) GetPrivacyFilter() (v string) {
 func (p *VideoFeedListRequestV2) GetTagFilters() (v []string) {
 	return p.TagFilters
 }
+
+func (p *VideoFeedListRequestV2) GetViewerId() (v int64) {
+	return p.ViewerId
+}
+
+func (p *VideoFeedListRequestV2) GetCursor() (v string) {
+	return p.Cursor
+}
+
+func (p *VideoFeedListRequestV2) GetUseCursor() (v bool) {
+	return p.UseCursor
+}
 func (p *VideoFeedListRequestV2) SetUserId(val int64) {
 	p.UserId = val
 }
@@ -1263,6 +1278,15 @@ func (p *VideoFeedListRequestV2) SetPrivacyFilter(val string) {
 func (p *VideoFeedListRequestV2) SetTagFilters(val []string) {
 	p.TagFilters = val
 }
+func (p *VideoFeedListRequestV2) SetViewerId(val int64) {
+	p.ViewerId = val
+}
+func (p *VideoFeedListRequestV2) SetCursor(val string) {
+	p.Cursor = val
+}
+func (p *VideoFeedListRequestV2) SetUseCursor(val bool) {
+	p.UseCursor = val
+}
 
 func (p *VideoFeedListRequestV2) String() string {
 	if p == nil {
@@ -1278,6 +1302,9 @@ var fieldIDToName_VideoFeedListRequestV2 = map[int16]string{
 	4: "category_filter",
 	5: "privacy_filter",
 	6: "tag_filters",
+	7: "viewer_id",
+	8: "cursor",
+	9: "use_cursor",
 }
 
 type VideoFeedListResponseV2 struct {
@@ -1363,6 +1390,7 @@ type VideoSearchRequestV2 struct {
 	Categories []string `thrift:"categories,6" frugal:"6,default,list<string>" json:"categories"`
 	Tags       []string `thrift:"tags,7" frugal:"7,default,list<string>" json:"tags"`
 	SortBy     string   `thrift:"sort_by,8" frugal:"8,default,string" json:"sort_by"`
+	ViewerId   int64    `thrift:"viewer_id,9" frugal:"9,default,i64" json:"viewer_id"`
 }
 
 func NewVideoSearchRequestV2() *VideoSearchRequestV2 {
@@ -1403,6 +1431,10 @@ func (p *VideoSearchRequestV2) GetTags() (v []string) {
 func (p *VideoSearchRequestV2) GetSortBy() (v string) {
 	return p.SortBy
 }
+
+func (p *VideoSearchRequestV2) GetViewerId() (v int64) {
+	return p.ViewerId
+}
 func (p *VideoSearchRequestV2) SetKeyword(val string) {
 	p.Keyword = val
 }
@@ -1427,6 +1459,9 @@ func (p *VideoSearchRequestV2) SetTags(val []string) {
 func (p *VideoSearchRequestV2) SetSortBy(val string) {
 	p.SortBy = val
 }
+func (p *VideoSearchRequestV2) SetViewerId(val int64) {
+	p.ViewerId = val
+}
 
 func (p *VideoSearchRequestV2) String() string {
 	if p == nil {
@@ -1444,6 +1479,7 @@ var fieldIDToName_VideoSearchRequestV2 = map[int16]string{
 	6: "categories",
 	7: "tags",
 	8: "sort_by",
+	9: "viewer_id",
 }
 
 type VideoSearchResponseV2 struct {
@@ -1525,6 +1561,7 @@ type VideoPopularRequestV2 struct {
 	PageSize  int64  `thrift:"page_size,2" frugal:"2,default,i64" json:"page_size"`
 	TimeRange string `thrift:"time_range,3" frugal:"3,default,string" json:"time_range"`
 	Category  string `thrift:"category,4" frugal:"4,default,string" json:"category"`
+	ViewerId  int64  `thrift:"viewer_id,5" frugal:"5,default,i64" json:"viewer_id"`
 }
 
 func NewVideoPopularRequestV2() *VideoPopularRequestV2 {
@@ -1549,6 +1586,10 @@ func (p *VideoPopularRequestV2) GetTimeRange() (v string) {
 func (p *VideoPopularRequestV2) GetCategory() (v string) {
 	return p.Category
 }
+
+func (p *VideoPopularRequestV2) GetViewerId() (v int64) {
+	return p.ViewerId
+}
 func (p *VideoPopularRequestV2) SetPageNum(val int64) {
 	p.PageNum = val
 }
@@ -1561,6 +1602,9 @@ func (p *VideoPopularRequestV2) SetTimeRange(val string) {
 func (p *VideoPopularRequestV2) SetCategory(val string) {
 	p.Category = val
 }
+func (p *VideoPopularRequestV2) SetViewerId(val int64) {
+	p.ViewerId = val
+}
 
 func (p *VideoPopularRequestV2) String() string {
 	if p == nil {
@@ -1574,6 +1618,7 @@ var fieldIDToName_VideoPopularRequestV2 = map[int16]string{
 	2: "page_size",
 	3: "time_range",
 	4: "category",
+	5: "viewer_id",
 }
 
 type VideoPopularResponseV2 struct {
@@ -2888,6 +2933,9 @@ type GetFavoriteVideoListRequestV2 struct {
 	PageNum    int64  `thrift:"page_num,3" frugal:"3,default,i64" json:"page_num"`
 	PageSize   int64  `thrift:"page_size,4" frugal:"4,default,i64" json:"page_size"`
 	SortBy     string `thrift:"sort_by,5" frugal:"5,default,string" json:"sort_by"`
+	Cursor     string `thrift:"cursor,6" frugal:"6,default,string" json:"cursor"`
+	UseCursor  bool   `thrift:"use_cursor,7" frugal:"7,default,bool" json:"use_cursor"`
+	ViewerId   int64  `thrift:"viewer_id,8" frugal:"8,default,i64" json:"viewer_id"`
 }
 
 func NewGetFavoriteVideoListRequestV2() *GetFavoriteVideoListRequestV2 {
@@ -2916,6 +2964,18 @@ func (p *GetFavoriteVideoListRequestV2) GetPageSize() (v int64) {
 func (p *GetFavoriteVideoListRequestV2) GetSortBy() (v string) {
 	return p.SortBy
 }
+
+func (p *GetFavoriteVideoListRequestV2) GetCursor() (v string) {
+	return p.Cursor
+}
+
+func (p *GetFavoriteVideoListRequestV2) GetUseCursor() (v bool) {
+	return p.UseCursor
+}
+
+func (p *GetFavoriteVideoListRequestV2) GetViewerId() (v int64) {
+	return p.ViewerId
+}
 func (p *GetFavoriteVideoListRequestV2) SetUserId(val int64) {
 	p.UserId = val
 }
@@ -2931,6 +2991,15 @@ func (p *GetFavoriteVideoListRequestV2) SetPageSize(val int64) {
 func (p *GetFavoriteVideoListRequestV2) SetSortBy(val string) {
 	p.SortBy = val
 }
+func (p *GetFavoriteVideoListRequestV2) SetCursor(val string) {
+	p.Cursor = val
+}
+func (p *GetFavoriteVideoListRequestV2) SetUseCursor(val bool) {
+	p.UseCursor = val
+}
+func (p *GetFavoriteVideoListRequestV2) SetViewerId(val int64) {
+	p.ViewerId = val
+}
 
 func (p *GetFavoriteVideoListRequestV2) String() string {
 	if p == nil {
@@ -2945,12 +3014,17 @@ var fieldIDToName_GetFavoriteVideoListRequestV2 = map[int16]string{
 	3: "page_num",
 	4: "page_size",
 	5: "sort_by",
+	6: "cursor",
+	7: "use_cursor",
+	8: "viewer_id",
 }
 
 type GetFavoriteVideoListResponseV2 struct {
 	Base       *base.Status  `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
 	VideoList  []*base.Video `thrift:"video_list,2" frugal:"2,default,list<base.Video>" json:"video_list"`
 	TotalCount int64         `thrift:"total_count,3" frugal:"3,default,i64" json:"total_count"`
+	HasMore    bool          `thrift:"has_more,4" frugal:"4,default,bool" json:"has_more"`
+	NextCursor string        `thrift:"next_cursor,5" frugal:"5,default,string" json:"next_cursor"`
 }
 
 func NewGetFavoriteVideoListResponseV2() *GetFavoriteVideoListResponseV2 {
@@ -2976,6 +3050,14 @@ func (p *GetFavoriteVideoListResponseV2) GetVideoList() (v []*base.Video) {
 func (p *GetFavoriteVideoListResponseV2) GetTotalCount() (v int64) {
 	return p.TotalCount
 }
+
+func (p *GetFavoriteVideoListResponseV2) GetHasMore() (v bool) {
+	return p.HasMore
+}
+
+func (p *GetFavoriteVideoListResponseV2) GetNextCursor() (v string) {
+	return p.NextCursor
+}
 func (p *GetFavoriteVideoListResponseV2) SetBase(val *base.Status) {
 	p.Base = val
 }
@@ -2985,6 +3067,12 @@ func (p *GetFavoriteVideoListResponseV2) SetVideoList(val []*base.Video) {
 func (p *GetFavoriteVideoListResponseV2) SetTotalCount(val int64) {
 	p.TotalCount = val
 }
+func (p *GetFavoriteVideoListResponseV2) SetHasMore(val bool) {
+	p.HasMore = val
+}
+func (p *GetFavoriteVideoListResponseV2) SetNextCursor(val string) {
+	p.NextCursor = val
+}
 
 func (p *GetFavoriteVideoListResponseV2) IsSetBase() bool {
 	return p.Base != nil
@@ -3001,6 +3089,8 @@ var fieldIDToName_GetFavoriteVideoListResponseV2 = map[int16]string{
 	1: "base",
 	2: "video_list",
 	3: "total_count",
+	4: "has_more",
+	5: "next_cursor",
 }
 
 type DeleteFavoriteRequestV2 struct {
@@ -3433,6 +3523,315 @@ var fieldIDToName_RecommendVideoResponseV2 = map[int16]string{
 	4: "algorithm_used",
 }
 
+type RelatedVideosRequest struct {
+	VideoId int64 `thrift:"video_id,1" frugal:"1,default,i64" json:"video_id"`
+	UserId  int64 `thrift:"user_id,2" frugal:"2,default,i64" json:"user_id"`
+	Count   int32 `thrift:"count,3" frugal:"3,default,i32" json:"count"`
+}
+
+func NewRelatedVideosRequest() *RelatedVideosRequest {
+	return &RelatedVideosRequest{}
+}
+
+func (p *RelatedVideosRequest) InitDefault() {
+}
+
+func (p *RelatedVideosRequest) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+func (p *RelatedVideosRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *RelatedVideosRequest) GetCount() (v int32) {
+	return p.Count
+}
+func (p *RelatedVideosRequest) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *RelatedVideosRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *RelatedVideosRequest) SetCount(val int32) {
+	p.Count = val
+}
+
+func (p *RelatedVideosRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelatedVideosRequest(%+v)", *p)
+}
+
+var fieldIDToName_RelatedVideosRequest = map[int16]string{
+	1: "video_id",
+	2: "user_id",
+	3: "count",
+}
+
+type RelatedVideosResponse struct {
+	Base      *base.Status  `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	VideoList []*base.Video `thrift:"video_list,2" frugal:"2,default,list<base.Video>" json:"video_list"`
+	Source    string        `thrift:"source,3" frugal:"3,default,string" json:"source"`
+}
+
+func NewRelatedVideosResponse() *RelatedVideosResponse {
+	return &RelatedVideosResponse{}
+}
+
+func (p *RelatedVideosResponse) InitDefault() {
+}
+
+var RelatedVideosResponse_Base_DEFAULT *base.Status
+
+func (p *RelatedVideosResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return RelatedVideosResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *RelatedVideosResponse) GetVideoList() (v []*base.Video) {
+	return p.VideoList
+}
+
+func (p *RelatedVideosResponse) GetSource() (v string) {
+	return p.Source
+}
+func (p *RelatedVideosResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *RelatedVideosResponse) SetVideoList(val []*base.Video) {
+	p.VideoList = val
+}
+func (p *RelatedVideosResponse) SetSource(val string) {
+	p.Source = val
+}
+
+func (p *RelatedVideosResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RelatedVideosResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelatedVideosResponse(%+v)", *p)
+}
+
+var fieldIDToName_RelatedVideosResponse = map[int16]string{
+	1: "base",
+	2: "video_list",
+	3: "source",
+}
+
+type ForYouFeedRequest struct {
+	UserId int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	Count  int32 `thrift:"count,2" frugal:"2,default,i32" json:"count"`
+}
+
+func NewForYouFeedRequest() *ForYouFeedRequest {
+	return &ForYouFeedRequest{}
+}
+
+func (p *ForYouFeedRequest) InitDefault() {
+}
+
+func (p *ForYouFeedRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *ForYouFeedRequest) GetCount() (v int32) {
+	return p.Count
+}
+func (p *ForYouFeedRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *ForYouFeedRequest) SetCount(val int32) {
+	p.Count = val
+}
+
+func (p *ForYouFeedRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ForYouFeedRequest(%+v)", *p)
+}
+
+var fieldIDToName_ForYouFeedRequest = map[int16]string{
+	1: "user_id",
+	2: "count",
+}
+
+type ForYouFeedResponse struct {
+	Base         *base.Status  `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	VideoList    []*base.Video `thrift:"video_list,2" frugal:"2,default,list<base.Video>" json:"video_list"`
+	ExploreCount int32         `thrift:"explore_count,3" frugal:"3,default,i32" json:"explore_count"`
+}
+
+func NewForYouFeedResponse() *ForYouFeedResponse {
+	return &ForYouFeedResponse{}
+}
+
+func (p *ForYouFeedResponse) InitDefault() {
+}
+
+var ForYouFeedResponse_Base_DEFAULT *base.Status
+
+func (p *ForYouFeedResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return ForYouFeedResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ForYouFeedResponse) GetVideoList() (v []*base.Video) {
+	return p.VideoList
+}
+
+func (p *ForYouFeedResponse) GetExploreCount() (v int32) {
+	return p.ExploreCount
+}
+func (p *ForYouFeedResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *ForYouFeedResponse) SetVideoList(val []*base.Video) {
+	p.VideoList = val
+}
+func (p *ForYouFeedResponse) SetExploreCount(val int32) {
+	p.ExploreCount = val
+}
+
+func (p *ForYouFeedResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ForYouFeedResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ForYouFeedResponse(%+v)", *p)
+}
+
+var fieldIDToName_ForYouFeedResponse = map[int16]string{
+	1: "base",
+	2: "video_list",
+	3: "explore_count",
+}
+
+type FollowingTimelineRequest struct {
+	UserId int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	Cursor string `thrift:"cursor,2" frugal:"2,default,string" json:"cursor"`
+	Count  int32  `thrift:"count,3" frugal:"3,default,i32" json:"count"`
+}
+
+func NewFollowingTimelineRequest() *FollowingTimelineRequest {
+	return &FollowingTimelineRequest{}
+}
+
+func (p *FollowingTimelineRequest) InitDefault() {
+}
+
+func (p *FollowingTimelineRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *FollowingTimelineRequest) GetCursor() (v string) {
+	return p.Cursor
+}
+
+func (p *FollowingTimelineRequest) GetCount() (v int32) {
+	return p.Count
+}
+func (p *FollowingTimelineRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *FollowingTimelineRequest) SetCursor(val string) {
+	p.Cursor = val
+}
+func (p *FollowingTimelineRequest) SetCount(val int32) {
+	p.Count = val
+}
+
+func (p *FollowingTimelineRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowingTimelineRequest(%+v)", *p)
+}
+
+var fieldIDToName_FollowingTimelineRequest = map[int16]string{
+	1: "user_id",
+	2: "cursor",
+	3: "count",
+}
+
+type FollowingTimelineResponse struct {
+	Base       *base.Status  `thrift:"base,1" frugal:"1,default,base.Status" json:"base"`
+	VideoList  []*base.Video `thrift:"video_list,2" frugal:"2,default,list<base.Video>" json:"video_list"`
+	NextCursor string        `thrift:"next_cursor,3" frugal:"3,default,string" json:"next_cursor"`
+	HasMore    bool          `thrift:"has_more,4" frugal:"4,default,bool" json:"has_more"`
+}
+
+func NewFollowingTimelineResponse() *FollowingTimelineResponse {
+	return &FollowingTimelineResponse{}
+}
+
+func (p *FollowingTimelineResponse) InitDefault() {
+}
+
+var FollowingTimelineResponse_Base_DEFAULT *base.Status
+
+func (p *FollowingTimelineResponse) GetBase() (v *base.Status) {
+	if !p.IsSetBase() {
+		return FollowingTimelineResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *FollowingTimelineResponse) GetVideoList() (v []*base.Video) {
+	return p.VideoList
+}
+
+func (p *FollowingTimelineResponse) GetNextCursor() (v string) {
+	return p.NextCursor
+}
+
+func (p *FollowingTimelineResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
+func (p *FollowingTimelineResponse) SetBase(val *base.Status) {
+	p.Base = val
+}
+func (p *FollowingTimelineResponse) SetVideoList(val []*base.Video) {
+	p.VideoList = val
+}
+func (p *FollowingTimelineResponse) SetNextCursor(val string) {
+	p.NextCursor = val
+}
+func (p *FollowingTimelineResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
+
+func (p *FollowingTimelineResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *FollowingTimelineResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowingTimelineResponse(%+v)", *p)
+}
+
+var fieldIDToName_FollowingTimelineResponse = map[int16]string{
+	1: "base",
+	2: "video_list",
+	3: "next_cursor",
+	4: "has_more",
+}
+
 type VideoStorageInfo struct {
 	UserId            int64             `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
 	VideoId           int64             `thrift:"video_id,2" frugal:"2,default,i64" json:"video_id"`
@@ -4298,6 +4697,12 @@ type VideoService interface {
 
 	RecommendVideoV2(ctx context.Context, req *RecommendVideoRequestV2) (r *RecommendVideoResponseV2, err error)
 
+	RelatedVideos(ctx context.Context, req *RelatedVideosRequest) (r *RelatedVideosResponse, err error)
+
+	ForYouFeed(ctx context.Context, req *ForYouFeedRequest) (r *ForYouFeedResponse, err error)
+
+	FollowingTimeline(ctx context.Context, req *FollowingTimelineRequest) (r *FollowingTimelineResponse, err error)
+
 	ManageVideoHeatV2(ctx context.Context, req *VideoHeatManagementRequest) (r *VideoHeatManagementResponse, err error)
 
 	ManageUserQuotaV2(ctx context.Context, req *UserQuotaManagementRequest) (r *UserQuotaManagementResponse, err error)
@@ -6209,6 +6614,234 @@ var fieldIDToName_VideoServiceRecommendVideoV2Result = map[int16]string{
 	0: "success",
 }
 
+type VideoServiceRelatedVideosArgs struct {
+	Req *RelatedVideosRequest `thrift:"req,1" frugal:"1,default,RelatedVideosRequest" json:"req"`
+}
+
+func NewVideoServiceRelatedVideosArgs() *VideoServiceRelatedVideosArgs {
+	return &VideoServiceRelatedVideosArgs{}
+}
+
+func (p *VideoServiceRelatedVideosArgs) InitDefault() {
+}
+
+var VideoServiceRelatedVideosArgs_Req_DEFAULT *RelatedVideosRequest
+
+func (p *VideoServiceRelatedVideosArgs) GetReq() (v *RelatedVideosRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceRelatedVideosArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceRelatedVideosArgs) SetReq(val *RelatedVideosRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceRelatedVideosArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceRelatedVideosArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceRelatedVideosArgs(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceRelatedVideosArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceRelatedVideosResult struct {
+	Success *RelatedVideosResponse `thrift:"success,0,optional" frugal:"0,optional,RelatedVideosResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceRelatedVideosResult() *VideoServiceRelatedVideosResult {
+	return &VideoServiceRelatedVideosResult{}
+}
+
+func (p *VideoServiceRelatedVideosResult) InitDefault() {
+}
+
+var VideoServiceRelatedVideosResult_Success_DEFAULT *RelatedVideosResponse
+
+func (p *VideoServiceRelatedVideosResult) GetSuccess() (v *RelatedVideosResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceRelatedVideosResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceRelatedVideosResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RelatedVideosResponse)
+}
+
+func (p *VideoServiceRelatedVideosResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceRelatedVideosResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceRelatedVideosResult(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceRelatedVideosResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceForYouFeedArgs struct {
+	Req *ForYouFeedRequest `thrift:"req,1" frugal:"1,default,ForYouFeedRequest" json:"req"`
+}
+
+func NewVideoServiceForYouFeedArgs() *VideoServiceForYouFeedArgs {
+	return &VideoServiceForYouFeedArgs{}
+}
+
+func (p *VideoServiceForYouFeedArgs) InitDefault() {
+}
+
+var VideoServiceForYouFeedArgs_Req_DEFAULT *ForYouFeedRequest
+
+func (p *VideoServiceForYouFeedArgs) GetReq() (v *ForYouFeedRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceForYouFeedArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceForYouFeedArgs) SetReq(val *ForYouFeedRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceForYouFeedArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceForYouFeedArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceForYouFeedArgs(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceForYouFeedArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceForYouFeedResult struct {
+	Success *ForYouFeedResponse `thrift:"success,0,optional" frugal:"0,optional,ForYouFeedResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceForYouFeedResult() *VideoServiceForYouFeedResult {
+	return &VideoServiceForYouFeedResult{}
+}
+
+func (p *VideoServiceForYouFeedResult) InitDefault() {
+}
+
+var VideoServiceForYouFeedResult_Success_DEFAULT *ForYouFeedResponse
+
+func (p *VideoServiceForYouFeedResult) GetSuccess() (v *ForYouFeedResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceForYouFeedResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceForYouFeedResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ForYouFeedResponse)
+}
+
+func (p *VideoServiceForYouFeedResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceForYouFeedResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceForYouFeedResult(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceForYouFeedResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceFollowingTimelineArgs struct {
+	Req *FollowingTimelineRequest `thrift:"req,1" frugal:"1,default,FollowingTimelineRequest" json:"req"`
+}
+
+func NewVideoServiceFollowingTimelineArgs() *VideoServiceFollowingTimelineArgs {
+	return &VideoServiceFollowingTimelineArgs{}
+}
+
+func (p *VideoServiceFollowingTimelineArgs) InitDefault() {
+}
+
+var VideoServiceFollowingTimelineArgs_Req_DEFAULT *FollowingTimelineRequest
+
+func (p *VideoServiceFollowingTimelineArgs) GetReq() (v *FollowingTimelineRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceFollowingTimelineArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceFollowingTimelineArgs) SetReq(val *FollowingTimelineRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceFollowingTimelineArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceFollowingTimelineArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceFollowingTimelineArgs(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceFollowingTimelineArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceFollowingTimelineResult struct {
+	Success *FollowingTimelineResponse `thrift:"success,0,optional" frugal:"0,optional,FollowingTimelineResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceFollowingTimelineResult() *VideoServiceFollowingTimelineResult {
+	return &VideoServiceFollowingTimelineResult{}
+}
+
+func (p *VideoServiceFollowingTimelineResult) InitDefault() {
+}
+
+var VideoServiceFollowingTimelineResult_Success_DEFAULT *FollowingTimelineResponse
+
+func (p *VideoServiceFollowingTimelineResult) GetSuccess() (v *FollowingTimelineResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceFollowingTimelineResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceFollowingTimelineResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FollowingTimelineResponse)
+}
+
+func (p *VideoServiceFollowingTimelineResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceFollowingTimelineResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceFollowingTimelineResult(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceFollowingTimelineResult = map[int16]string{
+	0: "success",
+}
+
 type VideoServiceManageVideoHeatV2Args struct {
 	Req *VideoHeatManagementRequest `thrift:"req,1" frugal:"1,default,VideoHeatManagementRequest" json:"req"`
 }
@@ -36,6 +36,9 @@ type Client interface {
 	DeleteVideoFromFavoriteV2(ctx context.Context, req *videos.DeleteVideoFromFavoriteRequestV2, callOptions ...callopt.Option) (r *videos.DeleteVideoFromFavoriteResponseV2, err error)
 	SharedVideoV2(ctx context.Context, req *videos.SharedVideoRequestV2, callOptions ...callopt.Option) (r *videos.SharedVideoResponseV2, err error)
 	RecommendVideoV2(ctx context.Context, req *videos.RecommendVideoRequestV2, callOptions ...callopt.Option) (r *videos.RecommendVideoResponseV2, err error)
+	RelatedVideos(ctx context.Context, req *videos.RelatedVideosRequest, callOptions ...callopt.Option) (r *videos.RelatedVideosResponse, err error)
+	ForYouFeed(ctx context.Context, req *videos.ForYouFeedRequest, callOptions ...callopt.Option) (r *videos.ForYouFeedResponse, err error)
+	FollowingTimeline(ctx context.Context, req *videos.FollowingTimelineRequest, callOptions ...callopt.Option) (r *videos.FollowingTimelineResponse, err error)
 	ManageVideoHeatV2(ctx context.Context, req *videos.VideoHeatManagementRequest, callOptions ...callopt.Option) (r *videos.VideoHeatManagementResponse, err error)
 	ManageUserQuotaV2(ctx context.Context, req *videos.UserQuotaManagementRequest, callOptions ...callopt.Option) (r *videos.UserQuotaManagementResponse, err error)
 	BatchOperateVideosV2(ctx context.Context, req *videos.BatchVideoOperationRequest, callOptions ...callopt.Option) (r *videos.BatchVideoOperationResponse, err error)
@@ -197,6 +200,21 @@ func (p *kVideoServiceClient) RecommendVideoV2(ctx context.Context, req *videos.
 	return p.kClient.RecommendVideoV2(ctx, req)
 }
 
+func (p *kVideoServiceClient) RelatedVideos(ctx context.Context, req *videos.RelatedVideosRequest, callOptions ...callopt.Option) (r *videos.RelatedVideosResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RelatedVideos(ctx, req)
+}
+
+func (p *kVideoServiceClient) ForYouFeed(ctx context.Context, req *videos.ForYouFeedRequest, callOptions ...callopt.Option) (r *videos.ForYouFeedResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ForYouFeed(ctx, req)
+}
+
+func (p *kVideoServiceClient) FollowingTimeline(ctx context.Context, req *videos.FollowingTimelineRequest, callOptions ...callopt.Option) (r *videos.FollowingTimelineResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.FollowingTimeline(ctx, req)
+}
+
 func (p *kVideoServiceClient) ManageVideoHeatV2(ctx context.Context, req *videos.VideoHeatManagementRequest, callOptions ...callopt.Option) (r *videos.VideoHeatManagementResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.ManageVideoHeatV2(ctx, req)
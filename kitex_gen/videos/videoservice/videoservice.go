@@ -188,6 +188,27 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"RelatedVideos": kitex.NewMethodInfo(
+		relatedVideosHandler,
+		newVideoServiceRelatedVideosArgs,
+		newVideoServiceRelatedVideosResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ForYouFeed": kitex.NewMethodInfo(
+		forYouFeedHandler,
+		newVideoServiceForYouFeedArgs,
+		newVideoServiceForYouFeedResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"FollowingTimeline": kitex.NewMethodInfo(
+		followingTimelineHandler,
+		newVideoServiceFollowingTimelineArgs,
+		newVideoServiceFollowingTimelineResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"ManageVideoHeatV2": kitex.NewMethodInfo(
 		manageVideoHeatV2Handler,
 		newVideoServiceManageVideoHeatV2Args,
@@ -739,6 +760,60 @@ func newVideoServiceRecommendVideoV2Result() interface{} {
 	return videos.NewVideoServiceRecommendVideoV2Result()
 }
 
+func relatedVideosHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*videos.VideoServiceRelatedVideosArgs)
+	realResult := result.(*videos.VideoServiceRelatedVideosResult)
+	success, err := handler.(videos.VideoService).RelatedVideos(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceRelatedVideosArgs() interface{} {
+	return videos.NewVideoServiceRelatedVideosArgs()
+}
+
+func newVideoServiceRelatedVideosResult() interface{} {
+	return videos.NewVideoServiceRelatedVideosResult()
+}
+
+func forYouFeedHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*videos.VideoServiceForYouFeedArgs)
+	realResult := result.(*videos.VideoServiceForYouFeedResult)
+	success, err := handler.(videos.VideoService).ForYouFeed(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceForYouFeedArgs() interface{} {
+	return videos.NewVideoServiceForYouFeedArgs()
+}
+
+func newVideoServiceForYouFeedResult() interface{} {
+	return videos.NewVideoServiceForYouFeedResult()
+}
+
+func followingTimelineHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*videos.VideoServiceFollowingTimelineArgs)
+	realResult := result.(*videos.VideoServiceFollowingTimelineResult)
+	success, err := handler.(videos.VideoService).FollowingTimeline(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceFollowingTimelineArgs() interface{} {
+	return videos.NewVideoServiceFollowingTimelineArgs()
+}
+
+func newVideoServiceFollowingTimelineResult() interface{} {
+	return videos.NewVideoServiceFollowingTimelineResult()
+}
+
 func manageVideoHeatV2Handler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*videos.VideoServiceManageVideoHeatV2Args)
 	realResult := result.(*videos.VideoServiceManageVideoHeatV2Result)
@@ -1089,6 +1164,36 @@ func (p *kClient) RecommendVideoV2(ctx context.Context, req *videos.RecommendVid
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) RelatedVideos(ctx context.Context, req *videos.RelatedVideosRequest) (r *videos.RelatedVideosResponse, err error) {
+	var _args videos.VideoServiceRelatedVideosArgs
+	_args.Req = req
+	var _result videos.VideoServiceRelatedVideosResult
+	if err = p.c.Call(ctx, "RelatedVideos", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ForYouFeed(ctx context.Context, req *videos.ForYouFeedRequest) (r *videos.ForYouFeedResponse, err error) {
+	var _args videos.VideoServiceForYouFeedArgs
+	_args.Req = req
+	var _result videos.VideoServiceForYouFeedResult
+	if err = p.c.Call(ctx, "ForYouFeed", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) FollowingTimeline(ctx context.Context, req *videos.FollowingTimelineRequest) (r *videos.FollowingTimelineResponse, err error) {
+	var _args videos.VideoServiceFollowingTimelineArgs
+	_args.Req = req
+	var _result videos.VideoServiceFollowingTimelineResult
+	if err = p.c.Call(ctx, "FollowingTimeline", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) ManageVideoHeatV2(ctx context.Context, req *videos.VideoHeatManagementRequest) (r *videos.VideoHeatManagementResponse, err error) {
 	var _args videos.VideoServiceManageVideoHeatV2Args
 	_args.Req = req
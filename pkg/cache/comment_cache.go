@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
+	"strconv"
 	"time"
 
 	"HuaTug.com/cmd/model"
@@ -18,6 +20,7 @@ type CommentCacheManager struct {
 	hotCommentExpire    time.Duration // 热门评论缓存时间
 	normalCommentExpire time.Duration // 普通评论缓存时间
 	counterExpire       time.Duration // 计数器缓存时间
+	hotIndexExpire      time.Duration // 热度排行缓存时间，每次读取时续期
 }
 
 // NewCommentCacheManager 创建评论缓存管理器
@@ -27,6 +30,7 @@ func NewCommentCacheManager(client *redis.Client) *CommentCacheManager {
 		hotCommentExpire:    30 * time.Minute, // 热门评论缓存30分钟
 		normalCommentExpire: 10 * time.Minute, // 普通评论缓存10分钟
 		counterExpire:       1 * time.Hour,    // 计数器缓存1小时
+		hotIndexExpire:      7 * 24 * time.Hour,
 	}
 }
 
@@ -42,12 +46,72 @@ const (
 	VideoCommentCountKey = "video:comment_count:%d"
 	// 用户评论列表缓存键
 	UserCommentsKey = "user:comments:%d:page:%d"
-	// 热门评论分数缓存键
-	CommentHotScoreKey = "comment:hot_score:%d"
+	// 视频评论热度排行缓存键，ZSet成员为评论ID、分数为随时间衰减的热度
+	CommentHotScoreKey = "video:comment_hot:%d"
+	// 计算热度所需的评论数据缓存键，Hash字段<评论ID>:e为互动分、<评论ID>:t为发布时间
+	CommentHotDataKey = "video:comment_hot_data:%d"
+	// 近期读取过热度排行的视频，分数为最近读取时间，定期重算时只处理这些视频
+	CommentHotVideosKey = "comment:hot_videos"
 	// 评论子评论列表缓存键
 	CommentChildrenKey = "comment:children:%d:page:%d"
 )
 
+const (
+	// 评论热度 = (互动分 + 1) / (发布小时数 + commentHotAgeOffset) ^ commentHotGravity，新评论有机会排到高赞旧评论之前
+	commentHotGravity    = 1.5
+	commentHotAgeOffset  = 2
+	commentHotBuiltField = "_built" // 热度排行建立的时间，没有评论的视频也据此判断排行已建立
+)
+
+// updateCommentHotScript 累加评论互动分并按发布时长重算热度，排行未建立时不做处理，避免残缺的排行
+//
+// KEYS: 排行ZSet、评论数据Hash；ARGV: 评论ID、互动分增量、发布时间(0表示不是新评论)、当前时间、年龄偏移、重力
+var updateCommentHotScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[2]) == 0 then
+	return 0
+end
+local t = redis.call('HGET', KEYS[2], ARGV[1] .. ':t')
+if not t then
+	if tonumber(ARGV[3]) == 0 then
+		return 0
+	end
+	t = ARGV[3]
+	redis.call('HSET', KEYS[2], ARGV[1] .. ':t', t)
+end
+local e = tonumber(redis.call('HINCRBYFLOAT', KEYS[2], ARGV[1] .. ':e', ARGV[2]))
+local hours = math.max(tonumber(ARGV[4]) - tonumber(t), 0) / 3600
+redis.call('ZADD', KEYS[1], (math.max(e, 0) + 1) / math.pow(hours + tonumber(ARGV[5]), tonumber(ARGV[6])), ARGV[1])
+return 1
+`)
+
+// rescoreCommentHotScript 按当前时间重算排行中所有评论的热度
+//
+// KEYS: 排行ZSet、评论数据Hash；ARGV: 当前时间、年龄偏移、重力
+var rescoreCommentHotScript = redis.NewScript(`
+local data = redis.call('HGETALL', KEYS[2])
+local engagement, created = {}, {}
+for i = 1, #data, 2 do
+	local id, kind = string.match(data[i], '^(%d+):(%a)$')
+	if kind == 'e' then
+		engagement[id] = tonumber(data[i + 1])
+	elseif kind == 't' then
+		created[id] = tonumber(data[i + 1])
+	end
+end
+local now, offset, gravity = tonumber(ARGV[1]), tonumber(ARGV[2]), tonumber(ARGV[3])
+for id, t in pairs(created) do
+	local hours = math.max(now - t, 0) / 3600
+	redis.call('ZADD', KEYS[1], (math.max(engagement[id] or 0, 0) + 1) / math.pow(hours + offset, gravity), id)
+end
+return 1
+`)
+
+// commentHotScore 与脚本中相同的热度计算，用于重建排行
+func commentHotScore(engagement float64, createdAt, now time.Time) float64 {
+	hours := math.Max(now.Sub(createdAt).Hours(), 0)
+	return (math.Max(engagement, 0) + 1) / math.Pow(hours+commentHotAgeOffset, commentHotGravity)
+}
+
 // CacheCommentList 缓存评论列表
 func (ccm *CommentCacheManager) CacheCommentList(ctx context.Context, videoID int64,
 	sortType string, page int, comments []int64) error {
@@ -193,17 +257,29 @@ func (ccm *CommentCacheManager) SetVideoCommentCount(ctx context.Context, videoI
 	return ccm.client.Set(ctx, key, count, ccm.counterExpire).Err()
 }
 
-// CacheCommentHotScore 缓存评论热度分数
-func (ccm *CommentCacheManager) CacheCommentHotScore(ctx context.Context, commentID int64, score float64) error {
-	key := fmt.Sprintf(CommentHotScoreKey, commentID)
-	return ccm.client.Set(ctx, key, score, ccm.hotCommentExpire).Err()
+// CacheCommentHotScore 把评论的互动分增加delta并重算热度，只更新已建立的排行；
+// 评论不在排行中且createdAt不为零值时作为新评论加入，返回false表示排行尚未建立或评论不在排行中
+func (ccm *CommentCacheManager) CacheCommentHotScore(ctx context.Context, videoID, commentID int64,
+	delta float64, createdAt, now time.Time) (bool, error) {
+
+	var created int64
+	if !createdAt.IsZero() {
+		created = createdAt.Unix()
+	}
+	keys := []string{fmt.Sprintf(CommentHotScoreKey, videoID), fmt.Sprintf(CommentHotDataKey, videoID)}
+	updated, err := updateCommentHotScript.Run(ctx, ccm.client, keys,
+		commentID, delta, created, now.Unix(), commentHotAgeOffset, commentHotGravity).Int()
+	if err != nil {
+		return false, fmt.Errorf("failed to cache comment hot score: %w", err)
+	}
+	return updated == 1, nil
 }
 
-// GetCommentHotScore 获取评论热度分数
-func (ccm *CommentCacheManager) GetCommentHotScore(ctx context.Context, commentID int64) (float64, error) {
-	key := fmt.Sprintf(CommentHotScoreKey, commentID)
+// GetCommentHotScore 获取评论热度分数，评论不在排行中时返回0
+func (ccm *CommentCacheManager) GetCommentHotScore(ctx context.Context, videoID, commentID int64) (float64, error) {
+	key := fmt.Sprintf(CommentHotScoreKey, videoID)
 
-	score, err := ccm.client.Get(ctx, key).Float64()
+	score, err := ccm.client.ZScore(ctx, key, strconv.FormatInt(commentID, 10)).Result()
 	if err != nil {
 		if err == redis.Nil {
 			return 0, nil // 缓存未命中
@@ -214,6 +290,120 @@ func (ccm *CommentCacheManager) GetCommentHotScore(ctx context.Context, commentI
 	return score, nil
 }
 
+// CommentHotEntry 建立热度排行时一条评论的数据
+type CommentHotEntry struct {
+	CommentID  int64
+	Engagement float64 // 点赞和回复折算的互动分
+	CreatedAt  time.Time
+}
+
+// CommentHotIndexExists 视频的热度排行是否已建立，没有评论的视频也会建立空排行
+func (ccm *CommentCacheManager) CommentHotIndexExists(ctx context.Context, videoID int64) (bool, error) {
+	n, err := ccm.client.Exists(ctx, fmt.Sprintf(CommentHotDataKey, videoID)).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to check comment hot index: %w", err)
+	}
+	return n > 0, nil
+}
+
+// RebuildCommentHotIndex 用entries整体替换视频的热度排行
+func (ccm *CommentCacheManager) RebuildCommentHotIndex(ctx context.Context, videoID int64, entries []CommentHotEntry, now time.Time) error {
+	rankKey := fmt.Sprintf(CommentHotScoreKey, videoID)
+	dataKey := fmt.Sprintf(CommentHotDataKey, videoID)
+
+	data := map[string]interface{}{commentHotBuiltField: now.Unix()}
+	members := make([]redis.Z, 0, len(entries))
+	for _, e := range entries {
+		member := strconv.FormatInt(e.CommentID, 10)
+		data[member+":e"] = e.Engagement
+		data[member+":t"] = e.CreatedAt.Unix()
+		members = append(members, redis.Z{Score: commentHotScore(e.Engagement, e.CreatedAt, now), Member: member})
+	}
+
+	pipe := ccm.client.TxPipeline()
+	pipe.Del(ctx, rankKey, dataKey)
+	pipe.HSet(ctx, dataKey, data)
+	if len(members) > 0 {
+		pipe.ZAdd(ctx, rankKey, members...)
+	}
+	pipe.Expire(ctx, rankKey, ccm.hotIndexExpire)
+	pipe.Expire(ctx, dataKey, ccm.hotIndexExpire)
+	pipe.ZAdd(ctx, CommentHotVideosKey, redis.Z{Score: float64(now.Unix()), Member: videoID})
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to rebuild comment hot index: %w", err)
+	}
+	return nil
+}
+
+// GetHotCommentIDs 按热度倒序获取视频从offset开始的至多limit条评论，读取时续期并记录为活跃视频
+func (ccm *CommentCacheManager) GetHotCommentIDs(ctx context.Context, videoID int64, offset, limit int64) ([]int64, error) {
+	rankKey := fmt.Sprintf(CommentHotScoreKey, videoID)
+
+	pipe := ccm.client.Pipeline()
+	members := pipe.ZRevRange(ctx, rankKey, offset, offset+limit-1)
+	pipe.Expire(ctx, rankKey, ccm.hotIndexExpire)
+	pipe.Expire(ctx, fmt.Sprintf(CommentHotDataKey, videoID), ccm.hotIndexExpire)
+	pipe.ZAdd(ctx, CommentHotVideosKey, redis.Z{Score: float64(time.Now().Unix()), Member: videoID})
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get hot comments: %w", err)
+	}
+
+	commentIDs := make([]int64, 0, len(members.Val()))
+	for _, m := range members.Val() {
+		if id, err := strconv.ParseInt(m, 10, 64); err == nil {
+			commentIDs = append(commentIDs, id)
+		}
+	}
+	return commentIDs, nil
+}
+
+// RemoveCommentHotScore 从视频的热度排行中移除评论
+func (ccm *CommentCacheManager) RemoveCommentHotScore(ctx context.Context, videoID, commentID int64) error {
+	member := strconv.FormatInt(commentID, 10)
+
+	pipe := ccm.client.Pipeline()
+	pipe.ZRem(ctx, fmt.Sprintf(CommentHotScoreKey, videoID), member)
+	pipe.HDel(ctx, fmt.Sprintf(CommentHotDataKey, videoID), member+":e", member+":t")
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// DeleteCommentHotIndex 删除视频的热度排行，用于删除视频
+func (ccm *CommentCacheManager) DeleteCommentHotIndex(ctx context.Context, videoID int64) error {
+	pipe := ccm.client.Pipeline()
+	pipe.Del(ctx, fmt.Sprintf(CommentHotScoreKey, videoID), fmt.Sprintf(CommentHotDataKey, videoID))
+	pipe.ZRem(ctx, CommentHotVideosKey, videoID)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// GetActiveCommentHotVideos 获取since之后读取过热度排行的视频，同时清理更早的记录
+func (ccm *CommentCacheManager) GetActiveCommentHotVideos(ctx context.Context, since time.Time) ([]int64, error) {
+	pipe := ccm.client.Pipeline()
+	pipe.ZRemRangeByScore(ctx, CommentHotVideosKey, "-inf", "("+strconv.FormatInt(since.Unix(), 10))
+	members := pipe.ZRange(ctx, CommentHotVideosKey, 0, -1)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get active comment hot videos: %w", err)
+	}
+
+	videoIDs := make([]int64, 0, len(members.Val()))
+	for _, m := range members.Val() {
+		if id, err := strconv.ParseInt(m, 10, 64); err == nil {
+			videoIDs = append(videoIDs, id)
+		}
+	}
+	return videoIDs, nil
+}
+
+// RescoreCommentHotIndex 按当前时间重算视频热度排行中所有评论的分数，排行不存在时不做处理
+func (ccm *CommentCacheManager) RescoreCommentHotIndex(ctx context.Context, videoID int64, now time.Time) error {
+	keys := []string{fmt.Sprintf(CommentHotScoreKey, videoID), fmt.Sprintf(CommentHotDataKey, videoID)}
+	if err := rescoreCommentHotScript.Run(ctx, ccm.client, keys, now.Unix(), commentHotAgeOffset, commentHotGravity).Err(); err != nil && err != redis.Nil {
+		return fmt.Errorf("failed to rescore comment hot index: %w", err)
+	}
+	return nil
+}
+
 // InvalidateVideoCommentCache 清除视频相关的评论缓存
 func (ccm *CommentCacheManager) InvalidateVideoCommentCache(ctx context.Context, videoID int64) error {
 	// 构建模式匹配键
@@ -244,7 +434,6 @@ func (ccm *CommentCacheManager) InvalidateCommentCache(ctx context.Context, comm
 	keys := []string{
 		fmt.Sprintf(CommentDetailKey, commentID),
 		fmt.Sprintf(CommentLikeCountKey, commentID),
-		fmt.Sprintf(CommentHotScoreKey, commentID),
 	}
 
 	// 同时清除子评论列表缓存
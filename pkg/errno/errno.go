@@ -43,6 +43,9 @@ const (
 
 	DataProcessFailed = 10015
 	VerifyCodeErrCode = 10016
+
+	UserBlockedErrCode        = 10017
+	ServiceUnavailableErrCode = 10018
 )
 
 type ErrNo struct {
@@ -85,6 +88,9 @@ var (
 
 	DataProcessErr = NewErrNo(DataProcessFailed, "DataProcess failed")
 	VerifyCodeErr  = NewErrNo(VerifyCodeErrCode, "VerifyCode failed")
+
+	UserBlockedErr        = NewErrNo(UserBlockedErrCode, "User has been blocked")
+	ServiceUnavailableErr = NewErrNo(ServiceUnavailableErrCode, "Service is temporarily unavailable, please retry")
 )
 
 // ConvertErr convert error to Errno
@@ -0,0 +1,127 @@
+package idgen
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+)
+
+const (
+	// DefaultSegmentStep 号段表中没有业务记录时新建记录使用的步长
+	DefaultSegmentStep = 1000
+	// segmentPrefetchRatio 当前号段剩余不足该比例时异步预取下一个号段
+	segmentPrefetchRatio = 0.2
+)
+
+// idSegment 号段表id_segments中的一行，每个业务一行，max_id为已经分配出去的最大ID
+type idSegment struct {
+	BizTag string `gorm:"column:biz_tag;primaryKey"`
+	MaxID  int64  `gorm:"column:max_id"`
+	Step   int64  `gorm:"column:step"`
+}
+
+func (idSegment) TableName() string {
+	return "id_segments"
+}
+
+// segmentRange 内存中的号段，next到max（含）之间的ID可以直接分配
+type segmentRange struct {
+	next, max, step int64
+}
+
+func (r *segmentRange) remaining() int64 {
+	return r.max - r.next + 1
+}
+
+// segmentBuffer 单个业务的双号段缓冲，当前号段消耗到一定比例时预取下一个号段
+type segmentBuffer struct {
+	mu       sync.Mutex
+	current  *segmentRange
+	standby  *segmentRange
+	fetching bool
+}
+
+// SegmentGenerator 号段模式：每次从数据库领取一段连续的ID在内存中分配，
+// 生成的ID按业务递增且较短，不依赖时钟和etcd，但重启会丢弃未用完的号段
+type SegmentGenerator struct {
+	db      *gorm.DB
+	step    int64
+	buffers sync.Map // biz -> *segmentBuffer
+	// fetchSegment 领取号段，默认为fetch，测试中替换为不依赖数据库的实现
+	fetchSegment func(ctx context.Context, biz string) (*segmentRange, error)
+}
+
+// NewSegmentGenerator 创建号段生成器，step为新业务的默认步长，不大于0时使用DefaultSegmentStep
+func NewSegmentGenerator(db *gorm.DB, step int64) *SegmentGenerator {
+	if step <= 0 {
+		step = DefaultSegmentStep
+	}
+	g := &SegmentGenerator{db: db, step: step}
+	g.fetchSegment = g.fetch
+	return g
+}
+
+// NextID 分配biz业务的下一个ID
+func (g *SegmentGenerator) NextID(ctx context.Context, biz string) (int64, error) {
+	value, _ := g.buffers.LoadOrStore(biz, &segmentBuffer{})
+	buf := value.(*segmentBuffer)
+
+	buf.mu.Lock()
+	defer buf.mu.Unlock()
+
+	for buf.current == nil || buf.current.remaining() <= 0 {
+		if buf.standby != nil {
+			buf.current, buf.standby = buf.standby, nil
+			continue
+		}
+		// 预取尚未完成时直接同步领取，并发领取的号段互不重叠，只是多占用一段
+		segment, err := g.fetchSegment(ctx, biz)
+		if err != nil {
+			return 0, err
+		}
+		buf.current = segment
+	}
+
+	id := buf.current.next
+	buf.current.next++
+
+	if buf.standby == nil && !buf.fetching &&
+		float64(buf.current.remaining()) < float64(buf.current.step)*segmentPrefetchRatio {
+		buf.fetching = true
+		go g.prefetch(biz, buf)
+	}
+	return id, nil
+}
+
+// prefetch 异步领取下一个号段放入备用缓冲
+func (g *SegmentGenerator) prefetch(biz string, buf *segmentBuffer) {
+	segment, err := g.fetchSegment(context.Background(), biz)
+
+	buf.mu.Lock()
+	defer buf.mu.Unlock()
+	buf.fetching = false
+	if err != nil {
+		hlog.Warnf("Failed to prefetch id segment for %s: %v", biz, err)
+		return
+	}
+	buf.standby = segment
+}
+
+// fetch 在事务中把biz的max_id增加一个步长并返回新领取的号段，业务不存在时按默认步长新建
+func (g *SegmentGenerator) fetch(ctx context.Context, biz string) (*segmentRange, error) {
+	var row idSegment
+	err := g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("INSERT INTO id_segments (biz_tag, max_id, step) VALUES (?, ?, ?) "+
+			"ON DUPLICATE KEY UPDATE max_id = max_id + step", biz, g.step, g.step).Error; err != nil {
+			return err
+		}
+		return tx.Where("biz_tag = ?", biz).Take(&row).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch id segment for %s: %w", biz, err)
+	}
+	return &segmentRange{next: row.MaxID - row.Step + 1, max: row.MaxID, step: row.Step}, nil
+}
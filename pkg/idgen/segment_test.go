@@ -0,0 +1,209 @@
+package idgen
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeSegments 按步长依次发放不重叠的号段，failAt中的第n次领取返回错误
+type fakeSegments struct {
+	mu      sync.Mutex
+	step    int64
+	maxID   int64
+	calls   int
+	failAt  map[int]bool
+	fetched chan struct{}
+}
+
+var errFetchFailed = errors.New("fetch failed")
+
+func newFakeSegments(step int64, failAt ...int) *fakeSegments {
+	f := &fakeSegments{step: step, failAt: make(map[int]bool), fetched: make(chan struct{}, 16)}
+	for _, n := range failAt {
+		f.failAt[n] = true
+	}
+	return f
+}
+
+func (f *fakeSegments) fetch(_ context.Context, _ string) (*segmentRange, error) {
+	f.mu.Lock()
+	defer func() {
+		f.mu.Unlock()
+		select {
+		case f.fetched <- struct{}{}:
+		default:
+		}
+	}()
+	f.calls++
+	if f.failAt[f.calls] {
+		return nil, errFetchFailed
+	}
+	f.maxID += f.step
+	return &segmentRange{next: f.maxID - f.step + 1, max: f.maxID, step: f.step}, nil
+}
+
+func (f *fakeSegments) Calls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+// waitFetch 等待一次领取完成，包括异步预取
+func (f *fakeSegments) waitFetch(t *testing.T) {
+	t.Helper()
+	select {
+	case <-f.fetched:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for segment fetch")
+	}
+}
+
+func newTestSegmentGenerator(f *fakeSegments) *SegmentGenerator {
+	g := NewSegmentGenerator(nil, f.step)
+	g.fetchSegment = f.fetch
+	return g
+}
+
+// waitStandby 等待异步预取的号段放入备用缓冲
+func waitStandby(t *testing.T, g *SegmentGenerator, biz string) {
+	t.Helper()
+	value, _ := g.buffers.Load(biz)
+	buf := value.(*segmentBuffer)
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		buf.mu.Lock()
+		done := !buf.fetching
+		buf.mu.Unlock()
+		if done {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("timed out waiting for prefetch")
+}
+
+func nextIDs(t *testing.T, g *SegmentGenerator, biz string, n int) []int64 {
+	t.Helper()
+	ids := make([]int64, 0, n)
+	for i := 0; i < n; i++ {
+		id, err := g.NextID(context.Background(), biz)
+		if err != nil {
+			t.Fatalf("NextID() #%d error = %v", i, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func assertConsecutive(t *testing.T, ids []int64, from int64) {
+	t.Helper()
+	for i, id := range ids {
+		if id != from+int64(i) {
+			t.Fatalf("ids[%d] = %d, want %d (ids %v)", i, id, from+int64(i), ids)
+		}
+	}
+}
+
+func TestSegmentRolloverToPrefetched(t *testing.T) {
+	f := newFakeSegments(10)
+	g := newTestSegmentGenerator(f)
+
+	// 剩余不足步长的20%（少于2个）时触发预取：第9个ID之后剩余1个
+	assertConsecutive(t, nextIDs(t, g, BizComment, 8), 1)
+	if calls := f.Calls(); calls != 1 {
+		t.Fatalf("fetch calls before prefetch = %d, want 1", calls)
+	}
+	assertConsecutive(t, nextIDs(t, g, BizComment, 1), 9)
+	f.waitFetch(t)
+	f.waitFetch(t)
+	waitStandby(t, g, BizComment)
+
+	// 当前号段用完后切换到预取的号段，不再同步领取
+	assertConsecutive(t, nextIDs(t, g, BizComment, 9), 10)
+	if calls := f.Calls(); calls != 2 {
+		t.Errorf("fetch calls after rollover = %d, want 2", calls)
+	}
+}
+
+func TestSegmentRefillAfterPrefetchFailure(t *testing.T) {
+	// 第2次领取（异步预取）失败，当前号段用完后同步领取
+	f := newFakeSegments(10, 2)
+	g := newTestSegmentGenerator(f)
+
+	assertConsecutive(t, nextIDs(t, g, BizComment, 9), 1)
+	f.waitFetch(t)
+	f.waitFetch(t)
+	waitStandby(t, g, BizComment)
+
+	ids := nextIDs(t, g, BizComment, 3)
+	if ids[0] != 10 {
+		t.Fatalf("last id of first segment = %d, want 10", ids[0])
+	}
+	// 失败的领取没有占用号段，同步领取得到11~20
+	assertConsecutive(t, ids[1:], 11)
+	if calls := f.Calls(); calls != 3 {
+		t.Errorf("fetch calls = %d, want 3", calls)
+	}
+}
+
+func TestSegmentFetchError(t *testing.T) {
+	f := newFakeSegments(10, 1)
+	g := newTestSegmentGenerator(f)
+
+	if _, err := g.NextID(context.Background(), BizComment); !errors.Is(err, errFetchFailed) {
+		t.Fatalf("NextID() error = %v, want %v", err, errFetchFailed)
+	}
+	// 领取恢复后正常分配
+	id, err := g.NextID(context.Background(), BizComment)
+	if err != nil || id != 1 {
+		t.Errorf("NextID() = %d, %v, want 1, nil", id, err)
+	}
+}
+
+func TestSegmentBizIsolation(t *testing.T) {
+	f := newFakeSegments(100)
+	g := newTestSegmentGenerator(f)
+
+	user := nextIDs(t, g, BizUser, 2)
+	video := nextIDs(t, g, BizVideo, 2)
+	assertConsecutive(t, user, 1)
+	assertConsecutive(t, video, 101)
+}
+
+func TestSegmentConcurrentUnique(t *testing.T) {
+	f := newFakeSegments(7)
+	g := newTestSegmentGenerator(f)
+
+	const workers, perWorker = 8, 50
+	var (
+		mu   sync.Mutex
+		seen = make(map[int64]bool, workers*perWorker)
+		wg   sync.WaitGroup
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				id, err := g.NextID(context.Background(), BizComment)
+				if err != nil {
+					t.Errorf("NextID() error = %v", err)
+					return
+				}
+				mu.Lock()
+				if seen[id] {
+					t.Errorf("duplicate id %d", id)
+				}
+				seen[id] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if len(seen) != workers*perWorker {
+		t.Errorf("generated %d unique ids, want %d", len(seen), workers*perWorker)
+	}
+}
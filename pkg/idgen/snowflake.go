@@ -0,0 +1,139 @@
+package idgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	epoch          = int64(1577836800000) // 起始时间戳 (2020-01-01)，与原有评论ID保持一致
+	nodeIDBits     = uint(10)             // 节点ID位数，对应原来的5位数据中心ID和5位工作节点ID
+	sequenceBits   = uint(12)             // 序列号位数
+	MaxNodeID      = int64(-1 ^ (-1 << nodeIDBits))
+	maxSequence    = int64(-1 ^ (-1 << sequenceBits))
+	nodeIDShift    = sequenceBits
+	timestampShift = sequenceBits + nodeIDBits
+
+	// DefaultMaxClockBackward 允许等待的最大时钟回拨，超过时拒绝生成ID
+	DefaultMaxClockBackward = 10 * time.Millisecond
+)
+
+var (
+	// ErrClockBackward 时钟回拨超过允许范围
+	ErrClockBackward = errors.New("idgen: clock moved backwards")
+	// ErrNodeUnavailable 节点ID尚未分配或租约已丢失，此时生成ID可能与其他节点重复
+	ErrNodeUnavailable = errors.New("idgen: snowflake node id is unavailable")
+)
+
+// Snowflake 雪花算法：41位毫秒时间戳 + 10位节点ID + 12位序列号
+// 节点ID由WorkerRegistry从etcd租约中分配，租约丢失期间拒绝生成ID
+type Snowflake struct {
+	mu          sync.Mutex
+	nodeID      int64
+	available   bool
+	lastTime    int64
+	sequence    int64
+	maxBackward time.Duration
+	clock       func() int64 // 当前毫秒时间戳，测试中替换为可控的时钟
+}
+
+// NewSnowflake 创建使用固定节点ID的雪花算法实例，maxBackward不大于0时使用DefaultMaxClockBackward
+func NewSnowflake(nodeID int64, maxBackward time.Duration) (*Snowflake, error) {
+	s := newSnowflake(maxBackward)
+	if err := s.setNode(nodeID, 0); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func newSnowflake(maxBackward time.Duration) *Snowflake {
+	if maxBackward <= 0 {
+		maxBackward = DefaultMaxClockBackward
+	}
+	return &Snowflake{maxBackward: maxBackward, clock: func() int64 { return time.Now().UnixMilli() }}
+}
+
+// setNode 切换到新分配的节点ID，lastTime为该节点ID上次使用者记录的时间戳，
+// 本机时钟落后于它时按时钟回拨处理，避免与上一个使用者生成重复的ID
+func (s *Snowflake) setNode(nodeID, lastTime int64) error {
+	if nodeID < 0 || nodeID > MaxNodeID {
+		return fmt.Errorf("idgen: node id %d out of range [0, %d]", nodeID, MaxNodeID)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nodeID = nodeID
+	s.available = true
+	if lastTime > s.lastTime {
+		s.lastTime = lastTime
+		s.sequence = maxSequence // 强制从下一毫秒开始
+	}
+	return nil
+}
+
+// invalidate 租约丢失后停止生成ID，直到重新分配节点ID
+func (s *Snowflake) invalidate() {
+	s.mu.Lock()
+	s.available = false
+	s.mu.Unlock()
+}
+
+// LastTime 最近一次生成ID使用的毫秒时间戳
+func (s *Snowflake) LastTime() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastTime
+}
+
+// NextID 生成唯一ID，biz仅用于满足Generator接口
+// 时钟回拨不超过maxBackward时等待时钟追上，否则返回ErrClockBackward
+func (s *Snowflake) NextID(ctx context.Context, _ string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.available {
+		return 0, ErrNodeUnavailable
+	}
+
+	now := s.clock()
+	if now < s.lastTime {
+		backward := time.Duration(s.lastTime-now) * time.Millisecond
+		if backward > s.maxBackward {
+			return 0, fmt.Errorf("%w by %v", ErrClockBackward, backward)
+		}
+		select {
+		case <-time.After(backward):
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+		if now = s.clock(); now < s.lastTime {
+			return 0, fmt.Errorf("%w by %v", ErrClockBackward, time.Duration(s.lastTime-now)*time.Millisecond)
+		}
+	}
+
+	if now == s.lastTime {
+		s.sequence = (s.sequence + 1) & maxSequence
+		if s.sequence == 0 {
+			// 序列号用完，等待下一毫秒
+			for now <= s.lastTime {
+				time.Sleep(100 * time.Microsecond)
+				now = s.clock()
+			}
+		}
+	} else {
+		s.sequence = 0
+	}
+
+	s.lastTime = now
+	return ((now - epoch) << timestampShift) | (s.nodeID << nodeIDShift) | s.sequence, nil
+}
+
+// ParseID 解析ID得到生成时间、节点ID和序列号
+func ParseID(id int64) (timestamp time.Time, nodeID, sequence int64) {
+	timestamp = time.UnixMilli((id >> timestampShift) + epoch)
+	nodeID = (id >> nodeIDShift) & MaxNodeID
+	sequence = id & maxSequence
+	return
+}
@@ -0,0 +1,216 @@
+package idgen
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// base 测试使用的起始毫秒时间戳
+const base = epoch + 1000
+
+// scriptedClock 依次返回times中的时间戳，用完后一直返回最后一个
+func scriptedClock(times ...int64) func() int64 {
+	i := 0
+	return func() int64 {
+		t := times[min(i, len(times)-1)]
+		i++
+		return t
+	}
+}
+
+func newTestSnowflake(t *testing.T, nodeID int64, maxBackward time.Duration, clock func() int64) *Snowflake {
+	t.Helper()
+	s := newSnowflake(maxBackward)
+	s.clock = clock
+	if err := s.setNode(nodeID, 0); err != nil {
+		t.Fatalf("setNode() error = %v", err)
+	}
+	return s
+}
+
+func TestSnowflakeNextID(t *testing.T) {
+	s := newTestSnowflake(t, 37, 0, scriptedClock(base, base, base+1))
+	var ids []int64
+	for i := 0; i < 3; i++ {
+		id, err := s.NextID(context.Background(), BizComment)
+		if err != nil {
+			t.Fatalf("NextID() error = %v", err)
+		}
+		ids = append(ids, id)
+	}
+
+	want := []struct {
+		ms, sequence int64
+	}{{base, 0}, {base, 1}, {base + 1, 0}}
+	for i, id := range ids {
+		timestamp, nodeID, sequence := ParseID(id)
+		if timestamp.UnixMilli() != want[i].ms || nodeID != 37 || sequence != want[i].sequence {
+			t.Errorf("ParseID(ids[%d]) = %d, %d, %d, want %d, 37, %d",
+				i, timestamp.UnixMilli(), nodeID, sequence, want[i].ms, want[i].sequence)
+		}
+	}
+}
+
+func TestSnowflakeSequenceOverflow(t *testing.T) {
+	// 同一毫秒内生成maxSequence+1个ID后序列号用完，下一个ID等到下一毫秒并从0开始
+	calls := int64(0)
+	s := newTestSnowflake(t, 1, 0, func() int64 {
+		calls++
+		if calls <= maxSequence+2 {
+			return base
+		}
+		return base + 1
+	})
+
+	seen := make(map[int64]bool, maxSequence+2)
+	var last int64
+	for i := int64(0); i <= maxSequence+1; i++ {
+		id, err := s.NextID(context.Background(), BizComment)
+		if err != nil {
+			t.Fatalf("NextID() #%d error = %v", i, err)
+		}
+		if seen[id] || id <= last {
+			t.Fatalf("NextID() #%d = %d is not unique and increasing, last %d", i, id, last)
+		}
+		seen[id] = true
+		last = id
+	}
+
+	timestamp, _, sequence := ParseID(last)
+	if timestamp.UnixMilli() != base+1 || sequence != 0 {
+		t.Errorf("ID after overflow at %d seq %d, want %d seq 0", timestamp.UnixMilli(), sequence, base+1)
+	}
+	if s.LastTime() != base+1 {
+		t.Errorf("LastTime() = %d, want %d", s.LastTime(), base+1)
+	}
+}
+
+func TestSnowflakeClockBackward(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxBackward time.Duration
+		times       []int64 // 第一次生成ID之后时钟依次返回的时间戳
+		wantErr     error
+		wantMs      int64
+	}{
+		{
+			name:        "within max backward waits for clock",
+			maxBackward: 10 * time.Millisecond,
+			times:       []int64{base + 15, base + 20},
+			wantMs:      base + 20,
+		},
+		{
+			name:        "still behind after waiting",
+			maxBackward: 10 * time.Millisecond,
+			times:       []int64{base + 15, base + 19},
+			wantErr:     ErrClockBackward,
+		},
+		{
+			name:        "beyond max backward",
+			maxBackward: 10 * time.Millisecond,
+			times:       []int64{base + 5},
+			wantErr:     ErrClockBackward,
+		},
+		{
+			name:        "exactly max backward",
+			maxBackward: 5 * time.Millisecond,
+			times:       []int64{base + 15, base + 21},
+			wantMs:      base + 21,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestSnowflake(t, 2, tt.maxBackward, scriptedClock(append([]int64{base + 20}, tt.times...)...))
+			first, err := s.NextID(context.Background(), BizComment)
+			if err != nil {
+				t.Fatalf("first NextID() error = %v", err)
+			}
+
+			id, err := s.NextID(context.Background(), BizComment)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NextID() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if s.LastTime() != base+20 {
+					t.Errorf("LastTime() = %d after rejected ID, want %d", s.LastTime(), base+20)
+				}
+				return
+			}
+			timestamp, _, _ := ParseID(id)
+			if id <= first || timestamp.UnixMilli() != tt.wantMs {
+				t.Errorf("NextID() = %d at %d, want greater than %d at %d", id, timestamp.UnixMilli(), first, tt.wantMs)
+			}
+		})
+	}
+}
+
+func TestSnowflakeClockBackwardCanceled(t *testing.T) {
+	s := newTestSnowflake(t, 3, time.Second, scriptedClock(base+500, base))
+	if _, err := s.NextID(context.Background(), BizComment); err != nil {
+		t.Fatalf("first NextID() error = %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := s.NextID(ctx, BizComment); !errors.Is(err, context.Canceled) {
+		t.Errorf("NextID() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestSnowflakeSetNode(t *testing.T) {
+	t.Run("hand off from previous owner", func(t *testing.T) {
+		// 上一个使用者最后在base+5生成过ID，本机时钟落后3ms，等待后跳过该毫秒
+		s := newTestSnowflake(t, 4, 0, scriptedClock(base+2, base+5, base+5, base+6))
+		s.invalidate()
+		if err := s.setNode(9, base+5); err != nil {
+			t.Fatalf("setNode() error = %v", err)
+		}
+		id, err := s.NextID(context.Background(), BizComment)
+		if err != nil {
+			t.Fatalf("NextID() error = %v", err)
+		}
+		timestamp, nodeID, sequence := ParseID(id)
+		if timestamp.UnixMilli() != base+6 || nodeID != 9 || sequence != 0 {
+			t.Errorf("ParseID() = %d, %d, %d, want %d, 9, 0", timestamp.UnixMilli(), nodeID, sequence, base+6)
+		}
+	})
+
+	t.Run("older last time keeps local state", func(t *testing.T) {
+		s := newTestSnowflake(t, 4, 0, scriptedClock(base+10, base+10))
+		first, err := s.NextID(context.Background(), BizComment)
+		if err != nil {
+			t.Fatalf("NextID() error = %v", err)
+		}
+		if err := s.setNode(4, base); err != nil {
+			t.Fatalf("setNode() error = %v", err)
+		}
+		if s.LastTime() != base+10 {
+			t.Errorf("LastTime() = %d, want %d", s.LastTime(), base+10)
+		}
+		id, err := s.NextID(context.Background(), BizComment)
+		if err != nil {
+			t.Fatalf("NextID() error = %v", err)
+		}
+		if _, _, sequence := ParseID(id); id <= first || sequence != 1 {
+			t.Errorf("NextID() = %d with sequence %d, want greater than %d with sequence 1", id, sequence, first)
+		}
+	})
+
+	t.Run("node id out of range", func(t *testing.T) {
+		s := newSnowflake(0)
+		for _, nodeID := range []int64{-1, MaxNodeID + 1} {
+			if err := s.setNode(nodeID, 0); err == nil {
+				t.Errorf("setNode(%d) error = nil, want out of range", nodeID)
+			}
+		}
+	})
+
+	t.Run("unavailable after invalidate", func(t *testing.T) {
+		s := newTestSnowflake(t, 5, 0, scriptedClock(base))
+		s.invalidate()
+		if _, err := s.NextID(context.Background(), BizComment); !errors.Is(err, ErrNodeUnavailable) {
+			t.Errorf("NextID() error = %v, want %v", err, ErrNodeUnavailable)
+		}
+	})
+}
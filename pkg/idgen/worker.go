@@ -0,0 +1,218 @@
+package idgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	// DefaultKeyPrefix etcd中节点ID分配信息的根路径
+	DefaultKeyPrefix = "/tiktok/idgen"
+	// DefaultLeaseTTL 节点ID租约的有效期，进程异常退出后节点ID在该时间后释放
+	DefaultLeaseTTL = 10 * time.Second
+)
+
+// WorkerRegistry 通过etcd租约为雪花算法分配节点ID：
+// <prefix>/workers/<nodeID> 绑定租约，进程存活期间独占该节点ID；
+// <prefix>/timestamps/<nodeID> 不绑定租约，定期记录最近使用的时间戳，
+// 下一个拿到该节点ID的进程不会生成早于它的ID，从而避免重启后的时钟回拨导致重复
+type WorkerRegistry struct {
+	client    *clientv3.Client
+	prefix    string
+	ttl       time.Duration
+	snowflake *Snowflake
+
+	mu      sync.Mutex
+	leaseID clientv3.LeaseID
+	nodeID  int64
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// NewWorkerRegistry 创建节点ID注册器，prefix为空时使用DefaultKeyPrefix，ttl不大于0时使用DefaultLeaseTTL
+func NewWorkerRegistry(client *clientv3.Client, prefix string, ttl time.Duration, snowflake *Snowflake) *WorkerRegistry {
+	if prefix == "" {
+		prefix = DefaultKeyPrefix
+	}
+	if ttl <= 0 {
+		ttl = DefaultLeaseTTL
+	}
+	return &WorkerRegistry{
+		client:    client,
+		prefix:    prefix,
+		ttl:       ttl,
+		snowflake: snowflake,
+		nodeID:    -1,
+	}
+}
+
+// Start 分配节点ID并在后台续约，租约丢失时雪花算法停止生成ID并重新分配
+func (w *WorkerRegistry) Start(ctx context.Context) error {
+	if err := w.acquire(ctx); err != nil {
+		return err
+	}
+	runCtx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.done = make(chan struct{})
+	go w.run(runCtx)
+	return nil
+}
+
+// NodeID 当前持有的节点ID，未持有时为-1
+func (w *WorkerRegistry) NodeID() int64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.nodeID
+}
+
+// Stop 记录最后使用的时间戳并释放节点ID
+func (w *WorkerRegistry) Stop() {
+	if w.cancel == nil {
+		return
+	}
+	w.cancel()
+	<-w.done
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	w.snowflake.invalidate()
+	w.saveTimestamp(ctx)
+	w.mu.Lock()
+	leaseID := w.leaseID
+	w.mu.Unlock()
+	if _, err := w.client.Revoke(ctx, leaseID); err != nil {
+		hlog.Warnf("Failed to revoke idgen lease: %v", err)
+	}
+}
+
+func (w *WorkerRegistry) workerKey(nodeID int64) string {
+	return fmt.Sprintf("%s/workers/%d", w.prefix, nodeID)
+}
+
+func (w *WorkerRegistry) timestampKey(nodeID int64) string {
+	return fmt.Sprintf("%s/timestamps/%d", w.prefix, nodeID)
+}
+
+// acquire 申请租约并抢占第一个空闲的节点ID
+func (w *WorkerRegistry) acquire(ctx context.Context) error {
+	lease, err := w.client.Grant(ctx, int64(w.ttl/time.Second))
+	if err != nil {
+		return fmt.Errorf("failed to grant idgen lease: %w", err)
+	}
+
+	resp, err := w.client.Get(ctx, w.prefix+"/workers/", clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	if err != nil {
+		return fmt.Errorf("failed to list idgen workers: %w", err)
+	}
+	used := make(map[string]bool, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		used[string(kv.Key)] = true
+	}
+
+	hostname, _ := os.Hostname()
+	owner := fmt.Sprintf("%s:%d", hostname, os.Getpid())
+	for nodeID := int64(0); nodeID <= MaxNodeID; nodeID++ {
+		key := w.workerKey(nodeID)
+		if used[key] {
+			continue
+		}
+		txn, err := w.client.Txn(ctx).
+			If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+			Then(clientv3.OpPut(key, owner, clientv3.WithLease(lease.ID)), clientv3.OpGet(w.timestampKey(nodeID))).
+			Commit()
+		if err != nil {
+			return fmt.Errorf("failed to claim idgen node %d: %w", nodeID, err)
+		}
+		if !txn.Succeeded {
+			continue // 被其他进程抢先
+		}
+
+		var lastTime int64
+		if kvs := txn.Responses[1].GetResponseRange().Kvs; len(kvs) > 0 {
+			lastTime, _ = strconv.ParseInt(string(kvs[0].Value), 10, 64)
+		}
+		if err := w.snowflake.setNode(nodeID, lastTime); err != nil {
+			return err
+		}
+		w.mu.Lock()
+		w.leaseID, w.nodeID = lease.ID, nodeID
+		w.mu.Unlock()
+		hlog.Infof("Acquired idgen node id %d (owner %s, last timestamp %d)", nodeID, owner, lastTime)
+		return nil
+	}
+
+	_, _ = w.client.Revoke(ctx, lease.ID)
+	return errors.New("no free idgen node id")
+}
+
+// run 续约并定期记录时间戳，租约丢失后重新分配节点ID
+func (w *WorkerRegistry) run(ctx context.Context) {
+	defer close(w.done)
+	for {
+		w.mu.Lock()
+		leaseID := w.leaseID
+		w.mu.Unlock()
+
+		keepAlive, err := w.client.KeepAlive(ctx, leaseID)
+		if err == nil {
+			w.keepAlive(ctx, keepAlive)
+			// 通道关闭说明租约已过期或被撤销，etcd客户端不会给出具体错误
+			err = errors.New("keepalive channel closed")
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		// 租约已丢失，其他进程可能已经拿到同一个节点ID
+		w.snowflake.invalidate()
+		hlog.Errorf("Lost idgen lease %x for node %d, reacquiring: %v", leaseID, w.NodeID(), err)
+		for ctx.Err() == nil {
+			acquireCtx, cancel := context.WithTimeout(ctx, w.ttl)
+			err := w.acquire(acquireCtx)
+			cancel()
+			if err == nil {
+				break
+			}
+			hlog.Errorf("Failed to reacquire idgen node id: %v", err)
+			select {
+			case <-ctx.Done():
+			case <-time.After(time.Second):
+			}
+		}
+	}
+}
+
+// keepAlive 消费续约响应直到通道关闭，期间每隔ttl/3记录一次时间戳
+func (w *WorkerRegistry) keepAlive(ctx context.Context, keepAlive <-chan *clientv3.LeaseKeepAliveResponse) {
+	ticker := time.NewTicker(w.ttl / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case _, ok := <-keepAlive:
+			if !ok {
+				return
+			}
+		case <-ticker.C:
+			w.saveTimestamp(ctx)
+		}
+	}
+}
+
+// saveTimestamp 记录当前节点最近使用的时间戳
+func (w *WorkerRegistry) saveTimestamp(ctx context.Context) {
+	lastTime := w.snowflake.LastTime()
+	if now := time.Now().UnixMilli(); now > lastTime {
+		lastTime = now
+	}
+	if _, err := w.client.Put(ctx, w.timestampKey(w.NodeID()), strconv.FormatInt(lastTime, 10)); err != nil && ctx.Err() == nil {
+		hlog.Warnf("Failed to save idgen timestamp: %v", err)
+	}
+}
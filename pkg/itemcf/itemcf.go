@@ -0,0 +1,149 @@
+// Package itemcf 基于用户隐式反馈的物品协同过滤（item-to-item），离线计算每个物品的相似物品。
+//
+// 相似度为带权余弦：
+//
+//	sim(i,j) = Σ w(u)·r(u,i)·r(u,j) / sqrt(Σ w(u)·r(u,i)² · Σ w(u)·r(u,j)²)
+//
+// r(u,i)为用户u对物品i各种互动的权重之和，w(u)=1/log(1+|I(u)|)降低互动很多的用户（包括刷量账号）的影响。
+package itemcf
+
+import (
+	"math"
+	"sort"
+)
+
+// Neighbor 一个相似物品
+type Neighbor struct {
+	ItemID     int64
+	Similarity float64
+	Support    int // 同时互动过两个物品的用户数
+}
+
+// Options 计算参数
+type Options struct {
+	TopK            int // 每个物品保留的相似物品数
+	MinSupport      int // 共同互动用户数低于此值的物品对视为噪声
+	MaxItemsPerUser int // 每个用户只取权重最高的物品参与计算，控制物品对的数量
+}
+
+// DefaultOptions 默认计算参数
+var DefaultOptions = Options{
+	TopK:            50,
+	MinSupport:      2,
+	MaxItemsPerUser: 200,
+}
+
+type pair struct {
+	a, b int64 // a < b
+}
+
+type pairStat struct {
+	dot     float64
+	support int
+}
+
+// Builder 累积用户互动并计算物品相似度，不是并发安全的
+type Builder struct {
+	ratings map[int64]map[int64]float64 // user -> item -> 权重
+}
+
+func NewBuilder() *Builder {
+	return &Builder{ratings: make(map[int64]map[int64]float64)}
+}
+
+// Add 记录用户对物品的一次互动，同一用户对同一物品的多次互动权重累加
+func (b *Builder) Add(userID, itemID int64, weight float64) {
+	if userID == 0 || itemID == 0 || weight <= 0 {
+		return
+	}
+	items, ok := b.ratings[userID]
+	if !ok {
+		items = make(map[int64]float64)
+		b.ratings[userID] = items
+	}
+	items[itemID] += weight
+}
+
+// Users 已记录互动的用户数
+func (b *Builder) Users() int {
+	return len(b.ratings)
+}
+
+// Build 计算每个物品按相似度倒序的至多TopK个相似物品，没有满足MinSupport的相似物品的物品不出现在结果中
+func (b *Builder) Build(opts Options) map[int64][]Neighbor {
+	if opts.TopK <= 0 {
+		opts.TopK = DefaultOptions.TopK
+	}
+	if opts.MinSupport <= 0 {
+		opts.MinSupport = DefaultOptions.MinSupport
+	}
+	if opts.MaxItemsPerUser <= 0 {
+		opts.MaxItemsPerUser = DefaultOptions.MaxItemsPerUser
+	}
+
+	norms := make(map[int64]float64)
+	pairs := make(map[pair]*pairStat)
+	for _, ratings := range b.ratings {
+		items := topItems(ratings, opts.MaxItemsPerUser)
+		w := 1 / math.Log1p(float64(len(items)))
+		for _, i := range items {
+			norms[i] += w * ratings[i] * ratings[i]
+		}
+		for x := 0; x < len(items); x++ {
+			for y := x + 1; y < len(items); y++ {
+				p := pair{a: items[x], b: items[y]}
+				if p.a > p.b {
+					p.a, p.b = p.b, p.a
+				}
+				s, ok := pairs[p]
+				if !ok {
+					s = &pairStat{}
+					pairs[p] = s
+				}
+				s.dot += w * ratings[items[x]] * ratings[items[y]]
+				s.support++
+			}
+		}
+	}
+
+	neighbors := make(map[int64][]Neighbor)
+	for p, s := range pairs {
+		if s.support < opts.MinSupport {
+			continue
+		}
+		sim := s.dot / math.Sqrt(norms[p.a]*norms[p.b])
+		neighbors[p.a] = append(neighbors[p.a], Neighbor{ItemID: p.b, Similarity: sim, Support: s.support})
+		neighbors[p.b] = append(neighbors[p.b], Neighbor{ItemID: p.a, Similarity: sim, Support: s.support})
+	}
+	for item, list := range neighbors {
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].Similarity != list[j].Similarity {
+				return list[i].Similarity > list[j].Similarity
+			}
+			return list[i].ItemID < list[j].ItemID
+		})
+		if len(list) > opts.TopK {
+			list = list[:opts.TopK]
+		}
+		neighbors[item] = list
+	}
+	return neighbors
+}
+
+// topItems 按权重倒序取用户至多limit个物品
+func topItems(ratings map[int64]float64, limit int) []int64 {
+	items := make([]int64, 0, len(ratings))
+	for item := range ratings {
+		items = append(items, item)
+	}
+	if len(items) <= limit {
+		return items
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if ratings[items[i]] != ratings[items[j]] {
+			return ratings[items[i]] > ratings[items[j]]
+		}
+		return items[i] < items[j]
+	})
+	return items[:limit]
+}
@@ -0,0 +1,17 @@
+package middleware
+
+import (
+	"context"
+
+	"HuaTug.com/pkg/sharding"
+	"github.com/cloudwego/kitex/pkg/endpoint"
+)
+
+var _ endpoint.Middleware = ReadYourWritesMiddleware
+
+// ReadYourWritesMiddleware 为每个请求开启分片读写一致性跟踪，请求内写过的分片后续读取走主库
+func ReadYourWritesMiddleware(next endpoint.Endpoint) endpoint.Endpoint {
+	return func(ctx context.Context, req, resp interface{}) (err error) {
+		return next(sharding.WithReadYourWrites(ctx), req, resp)
+	}
+}
@@ -0,0 +1,49 @@
+package moderation
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// 带协议或www前缀的链接，以及常见顶级域名的裸域名
+	urlPattern = regexp.MustCompile(`(?i)(https?://|www\.)[^\s]+|\b[a-z0-9][a-z0-9-]*\.(com|cn|net|org|io|me|cc|top|xyz|vip|app)\b`)
+	// 邮箱、手机号和即时通讯账号
+	emailPattern   = regexp.MustCompile(`(?i)\b[a-z0-9._%+-]+@[a-z0-9.-]+\.[a-z]{2,}\b`)
+	phonePattern   = regexp.MustCompile(`(?:^|\D)(1[3-9]\d{9})(?:\D|$)`)
+	accountPattern = regexp.MustCompile(`(?i)(微信|威信|薇信|v信|vx|wx|wechat|qq|扣扣|tg|telegram)\s*[:：号]?\s*[a-z0-9_-]{5,}`)
+)
+
+// DetectLinks 返回文本中的链接
+func DetectLinks(text string) []string {
+	return urlPattern.FindAllString(text, -1)
+}
+
+// DetectContacts 返回文本中的邮箱、手机号和即时通讯账号；数字间夹杂空格等分隔符的写法先去掉分隔符再识别
+func DetectContacts(text string) []string {
+	contacts := emailPattern.FindAllString(text, -1)
+	compact := strings.NewReplacer(" ", "", "-", "", "　", "").Replace(text)
+	for _, m := range phonePattern.FindAllStringSubmatch(compact, -1) {
+		contacts = append(contacts, m[1])
+	}
+	contacts = append(contacts, accountPattern.FindAllString(text, -1)...)
+	return contacts
+}
+
+// hasExcessiveRepetition 连续重复超过5次的字符通常是刷屏
+func hasExcessiveRepetition(text string) bool {
+	var prev rune
+	count := 0
+	for _, r := range text {
+		if r == prev {
+			count++
+			if count > 5 {
+				return true
+			}
+		} else {
+			prev = r
+			count = 1
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package moderation
+
+import "testing"
+
+func TestDetectLinks(t *testing.T) {
+	tests := []struct {
+		text string
+		want int
+	}{
+		{"看这里 https://example.com/a?b=1 好东西", 1},
+		{"HTTP://EXAMPLE.ORG", 1},
+		{"www.example 没有顶级域名也算", 1},
+		{"去 shop-1.vip 或 abc.xyz 看看", 2},
+		{"普通评论，没有链接", 0},
+		{"版本1.0发布", 0},
+		{"文件名readme.txt", 0},
+	}
+	for _, tt := range tests {
+		if got := DetectLinks(tt.text); len(got) != tt.want {
+			t.Errorf("DetectLinks(%q) = %v, want %d links", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestDetectContacts(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"email", "联系 someone.x@mail.example.com 谢谢", []string{"someone.x@mail.example.com"}},
+		{"phone", "电话13812345678", []string{"13812345678"}},
+		{"phone with separators", "打138 1234-5678", []string{"13812345678"}},
+		{"phone with full-width space", "138　1234　5678", []string{"13812345678"}},
+		{"too many digits", "订单号138123456789", nil},
+		{"not a mobile prefix", "12812345678", nil},
+		{"wechat account", "加vx：abc_123", []string{"vx：abc_123"}},
+		{"qq account", "QQ号 123456789", []string{"QQ号 123456789"}},
+		{"account too short", "加微信ab12", nil},
+		{"none", "这个视频真好看", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DetectContacts(tt.text)
+			if len(got) != len(tt.want) {
+				t.Fatalf("DetectContacts(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("DetectContacts(%q) = %q, want %q", tt.text, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestHasExcessiveRepetition(t *testing.T) {
+	tests := []struct {
+		text string
+		want bool
+	}{
+		{"哈哈哈哈哈", false},
+		{"哈哈哈哈哈哈", true},
+		{"aaaaabaaaaa", false},
+		{"好看!!!!!!!", true},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := hasExcessiveRepetition(tt.text); got != tt.want {
+			t.Errorf("hasExcessiveRepetition(%q) = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+}
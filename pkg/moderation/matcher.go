@@ -0,0 +1,136 @@
+// Package moderation 评论等用户文本的内容安全检查：敏感词、链接和联系方式识别，结合用户信誉打分后给出处置结果。
+//
+// 敏感词用Aho-Corasick自动机匹配，一次扫描找出文本中所有敏感词，代价与词表大小无关。
+package moderation
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Word 一个敏感词及命中时计入的风险分
+type Word struct {
+	Text  string
+	Score int
+}
+
+// Match 文本中命中的一个敏感词，Start和End是按rune计的区间[Start, End)
+type Match struct {
+	Word  string
+	Score int
+	Start int
+	End   int
+}
+
+type acNode struct {
+	next map[rune]int
+	fail int
+	// 以该节点结尾的敏感词（包括沿失败链可达的），记录词在words中的下标
+	output []int
+}
+
+// Matcher 敏感词自动机，构建后只读，可以并发使用
+type Matcher struct {
+	nodes []acNode
+	words []Word
+}
+
+// normalizeRune 匹配时忽略大小写和全角半角的差异
+func normalizeRune(r rune) rune {
+	if r >= '！' && r <= '～' {
+		r = r - '！' + '!'
+	} else if r == '　' {
+		r = ' '
+	}
+	return unicode.ToLower(r)
+}
+
+// NewMatcher 用敏感词构建自动机，空词被忽略，归一化后重复的词保留风险分最高的
+func NewMatcher(words []Word) *Matcher {
+	m := &Matcher{nodes: []acNode{{next: map[rune]int{}}}}
+	index := make(map[string]int, len(words))
+	for _, w := range words {
+		text := strings.Map(normalizeRune, strings.TrimSpace(w.Text))
+		if text == "" {
+			continue
+		}
+		node := 0
+		for _, r := range text {
+			child, ok := m.nodes[node].next[r]
+			if !ok {
+				child = len(m.nodes)
+				m.nodes = append(m.nodes, acNode{next: map[rune]int{}})
+				m.nodes[node].next[r] = child
+			}
+			node = child
+		}
+		if i, ok := index[text]; ok {
+			if w.Score > m.words[i].Score {
+				m.words[i].Score = w.Score
+			}
+			continue
+		}
+		index[text] = len(m.words)
+		m.nodes[node].output = append(m.nodes[node].output, len(m.words))
+		m.words = append(m.words, Word{Text: text, Score: w.Score})
+	}
+	m.buildFailLinks()
+	return m
+}
+
+// buildFailLinks 按层次遍历计算失败指针，并把失败链上的输出合并到每个节点
+func (m *Matcher) buildFailLinks() {
+	queue := make([]int, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[node].next {
+			fail := m.nodes[node].fail
+			for fail != 0 {
+				if _, ok := m.nodes[fail].next[r]; ok {
+					break
+				}
+				fail = m.nodes[fail].fail
+			}
+			if target, ok := m.nodes[fail].next[r]; ok && target != child {
+				m.nodes[child].fail = target
+			}
+			m.nodes[child].output = append(m.nodes[child].output, m.nodes[m.nodes[child].fail].output...)
+			queue = append(queue, child)
+		}
+	}
+}
+
+// Len 词表中的敏感词数
+func (m *Matcher) Len() int {
+	return len(m.words)
+}
+
+// FindAll 找出文本中所有命中的敏感词，按结束位置排列，重叠的词都会返回
+func (m *Matcher) FindAll(text string) []Match {
+	var matches []Match
+	node := 0
+	pos := 0
+	for _, r := range text {
+		r = normalizeRune(r)
+		for node != 0 {
+			if _, ok := m.nodes[node].next[r]; ok {
+				break
+			}
+			node = m.nodes[node].fail
+		}
+		if next, ok := m.nodes[node].next[r]; ok {
+			node = next
+		}
+		pos++
+		for _, i := range m.nodes[node].output {
+			w := m.words[i]
+			length := len([]rune(w.Text))
+			matches = append(matches, Match{Word: w.Text, Score: w.Score, Start: pos - length, End: pos})
+		}
+	}
+	return matches
+}
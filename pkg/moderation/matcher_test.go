@@ -0,0 +1,117 @@
+package moderation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatcherFindAll(t *testing.T) {
+	tests := []struct {
+		name  string
+		words []Word
+		text  string
+		want  []Match
+	}{
+		{
+			name:  "overlapping words",
+			words: []Word{{"he", 1}, {"she", 2}, {"his", 3}, {"hers", 4}},
+			text:  "ushers",
+			want:  []Match{{"she", 2, 1, 4}, {"he", 1, 2, 4}, {"hers", 4, 2, 6}},
+		},
+		{
+			name:  "nested word",
+			words: []Word{{"abcd", 10}, {"bc", 5}},
+			text:  "xabcdx",
+			want:  []Match{{"bc", 5, 2, 4}, {"abcd", 10, 1, 5}},
+		},
+		{
+			name:  "repeated occurrences",
+			words: []Word{{"坏蛋", 40}},
+			text:  "坏蛋和坏蛋",
+			want:  []Match{{"坏蛋", 40, 0, 2}, {"坏蛋", 40, 3, 5}},
+		},
+		{
+			name:  "rune offsets after multibyte text",
+			words: []Word{{"spam", 30}},
+			text:  "😀你好spam",
+			want:  []Match{{"spam", 30, 3, 7}},
+		},
+		{
+			name:  "case and full-width normalized",
+			words: []Word{{"BadWord", 40}},
+			text:  "ｂａｄ　ｗｏｒｄ不算，ｂａｄＷＯＲＤ算",
+			want:  []Match{{"badword", 40, 11, 18}},
+		},
+		{
+			name:  "full-width word in list",
+			words: []Word{{"ＶＸ", 20}},
+			text:  "加vx",
+			want:  []Match{{"vx", 20, 1, 3}},
+		},
+		{
+			name:  "failure link across partial match",
+			words: []Word{{"abd", 1}, {"bc", 2}},
+			text:  "abc",
+			want:  []Match{{"bc", 2, 1, 3}},
+		},
+		{
+			name:  "no match",
+			words: []Word{{"坏蛋", 40}},
+			text:  "你好世界",
+			want:  nil,
+		},
+		{
+			name: "empty word list",
+			text: "anything",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewMatcher(tt.words).FindAll(tt.text)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindAll(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewMatcherWordList(t *testing.T) {
+	m := NewMatcher([]Word{
+		{"Spam", 10},
+		{"  spam ", 30}, // 归一化后重复，保留较高的分数
+		{"ＳＰＡＭ", 20},
+		{"", 50},
+		{"   ", 50},
+		{"scam", 40},
+	})
+	if m.Len() != 2 {
+		t.Fatalf("Len() = %d, want 2", m.Len())
+	}
+	got := m.FindAll("SPAM")
+	want := []Match{{"spam", 30, 0, 4}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FindAll() = %v, want %v", got, want)
+	}
+}
+
+func TestNormalizeRune(t *testing.T) {
+	tests := []struct {
+		in, want rune
+	}{
+		{'A', 'a'},
+		{'ａ', 'a'},
+		{'Ｚ', 'z'},
+		{'０', '0'},
+		{'！', '!'},
+		{'～', '~'},
+		{'　', ' '},
+		{'中', '中'},
+		{'Ä', 'ä'},
+	}
+	for _, tt := range tests {
+		if got := normalizeRune(tt.in); got != tt.want {
+			t.Errorf("normalizeRune(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
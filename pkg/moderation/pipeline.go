@@ -0,0 +1,151 @@
+package moderation
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+)
+
+// Action 内容检查的处置结果
+type Action string
+
+const (
+	ActionAllow  Action = "allow"  // 直接发布
+	ActionMask   Action = "mask"   // 把命中的敏感词替换为*后发布
+	ActionReview Action = "review" // 进入人工审核，通过后才发布
+	ActionReject Action = "reject" // 拒绝发布
+)
+
+// 各类风险命中时计入的分数，敏感词的分数由词表给出
+const (
+	linkScore       = 30
+	contactScore    = 50
+	repetitionScore = 30
+	// 信誉为负时每一分增加的风险分及上限，信誉为正时每一分减少的风险分及上限
+	badReputationScore     = 10
+	maxBadReputationScore  = 40
+	goodReputationScore    = 2
+	maxGoodReputationScore = 20
+)
+
+// Thresholds 风险分达到Review进入人工审核，达到Reject直接拒绝；低于Review但命中敏感词时打码发布
+type Thresholds struct {
+	Review int
+	Reject int
+}
+
+// DefaultThresholds 默认的处置阈值
+var DefaultThresholds = Thresholds{Review: 50, Reject: 80}
+
+// Result 一段文本的检查结果
+type Result struct {
+	Action  Action
+	Score   int
+	Reasons []string // 命中的风险项，如"word:xxx"、"link"、"contact"
+	Content string   // 处置为mask时是打码后的文本，否则是原文
+}
+
+// Pipeline 内容检查流水线，词表可以在运行中重新加载，并发安全
+type Pipeline struct {
+	source     WordSource
+	thresholds Thresholds
+	matcher    atomic.Pointer[Matcher]
+}
+
+// NewPipeline 创建流水线，首次Reload之前只做链接、联系方式和刷屏检查
+func NewPipeline(source WordSource, thresholds Thresholds) *Pipeline {
+	if thresholds.Review <= 0 {
+		thresholds.Review = DefaultThresholds.Review
+	}
+	if thresholds.Reject <= 0 {
+		thresholds.Reject = DefaultThresholds.Reject
+	}
+	p := &Pipeline{source: source, thresholds: thresholds}
+	p.matcher.Store(NewMatcher(nil))
+	return p
+}
+
+// Reload 从词表来源重新构建敏感词自动机，失败时继续使用原有词表，返回新词表的敏感词数
+func (p *Pipeline) Reload(ctx context.Context) (int, error) {
+	if p.source == nil {
+		return 0, nil
+	}
+	words, err := p.source.Load(ctx)
+	if err != nil {
+		return 0, err
+	}
+	m := NewMatcher(words)
+	p.matcher.Store(m)
+	return m.Len(), nil
+}
+
+// Check 按文本内容和作者信誉给出处置结果，reputation为0表示信誉中性
+func (p *Pipeline) Check(text string, reputation int) *Result {
+	result := &Result{Content: text}
+	matches := p.matcher.Load().FindAll(text)
+	seen := make(map[string]bool, len(matches))
+	for _, m := range matches {
+		if seen[m.Word] {
+			continue
+		}
+		seen[m.Word] = true
+		result.Score += m.Score
+		result.Reasons = append(result.Reasons, "word:"+m.Word)
+	}
+	if len(DetectLinks(text)) > 0 {
+		result.Score += linkScore
+		result.Reasons = append(result.Reasons, "link")
+	}
+	if len(DetectContacts(text)) > 0 {
+		result.Score += contactScore
+		result.Reasons = append(result.Reasons, "contact")
+	}
+	if hasExcessiveRepetition(text) {
+		result.Score += repetitionScore
+		result.Reasons = append(result.Reasons, "repetition")
+	}
+	// 信誉只调整已有风险，不会让没有风险项的文本进入审核
+	if len(result.Reasons) > 0 {
+		result.Score += reputationScore(reputation)
+		if result.Score < 0 {
+			result.Score = 0
+		}
+	}
+
+	switch {
+	case result.Score >= p.thresholds.Reject:
+		result.Action = ActionReject
+	case result.Score >= p.thresholds.Review:
+		result.Action = ActionReview
+	case len(matches) > 0:
+		result.Action = ActionMask
+		result.Content = mask(text, matches)
+	default:
+		result.Action = ActionAllow
+	}
+	return result
+}
+
+// reputationScore 信誉对风险分的调整
+func reputationScore(reputation int) int {
+	if reputation < 0 {
+		return min(-reputation*badReputationScore, maxBadReputationScore)
+	}
+	return -min(reputation*goodReputationScore, maxGoodReputationScore)
+}
+
+// mask 把命中的敏感词逐字替换为*
+func mask(text string, matches []Match) string {
+	runes := []rune(text)
+	for _, m := range matches {
+		for i := m.Start; i < m.End && i < len(runes); i++ {
+			runes[i] = '*'
+		}
+	}
+	return string(runes)
+}
+
+// ReasonString 把风险项拼成一个字符串，用于保存审核记录
+func (r *Result) ReasonString() string {
+	return strings.Join(r.Reasons, ",")
+}
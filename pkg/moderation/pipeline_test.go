@@ -0,0 +1,139 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type staticSource struct {
+	words []Word
+	err   error
+}
+
+func (s *staticSource) Load(context.Context) ([]Word, error) {
+	return s.words, s.err
+}
+
+func newTestPipeline(t *testing.T, words ...Word) *Pipeline {
+	t.Helper()
+	p := NewPipeline(&staticSource{words: words}, DefaultThresholds)
+	if _, err := p.Reload(context.Background()); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	return p
+}
+
+func TestPipelineCheckThresholds(t *testing.T) {
+	p := newTestPipeline(t,
+		Word{"轻微", 40},
+		Word{"四十九", 49},
+		Word{"五十", 50},
+		Word{"七十九", 79},
+		Word{"八十", 80},
+	)
+	tests := []struct {
+		name       string
+		text       string
+		reputation int
+		wantAction Action
+		wantScore  int
+		wantReason []string
+	}{
+		{"clean text", "今天天气不错", 0, ActionAllow, 0, nil},
+		{"clean text ignores bad reputation", "今天天气不错", -10, ActionAllow, 0, nil},
+		{"word below review is masked", "有点轻微", 0, ActionMask, 40, []string{"word:轻微"}},
+		{"just below review", "四十九", 0, ActionMask, 49, []string{"word:四十九"}},
+		{"review boundary", "五十", 0, ActionReview, 50, []string{"word:五十"}},
+		{"just below reject", "七十九", 0, ActionReview, 79, []string{"word:七十九"}},
+		{"reject boundary", "八十", 0, ActionReject, 80, []string{"word:八十"}},
+		{"same word counted once", "轻微轻微轻微", 0, ActionMask, 40, []string{"word:轻微"}},
+		{"words add up", "轻微五十", 0, ActionReject, 90, []string{"word:轻微", "word:五十"}},
+		{"link alone is allowed", "见 www.example.com", 0, ActionAllow, 30, []string{"link"}},
+		{"contact goes to review", "电话13812345678", 0, ActionReview, 50, []string{"contact"}},
+		{"link and repetition", "www.example.com 啊啊啊啊啊啊", 0, ActionReview, 60, []string{"link", "repetition"}},
+		{"bad reputation pushes to review", "轻微", -1, ActionReview, 50, []string{"word:轻微"}},
+		{"bad reputation is capped", "轻微", -10, ActionReject, 80, []string{"word:轻微"}},
+		{"good reputation lowers score", "五十", 1, ActionMask, 48, []string{"word:五十"}},
+		{"good reputation is capped", "八十", 10, ActionReview, 60, []string{"word:八十"}},
+		{"score never negative", "见 www.example.com", 100, ActionAllow, 10, []string{"link"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := p.Check(tt.text, tt.reputation)
+			if got.Action != tt.wantAction || got.Score != tt.wantScore || !reflect.DeepEqual(got.Reasons, tt.wantReason) {
+				t.Errorf("Check(%q, %d) = %s %d %v, want %s %d %v", tt.text, tt.reputation,
+					got.Action, got.Score, got.Reasons, tt.wantAction, tt.wantScore, tt.wantReason)
+			}
+			if got.Action != ActionMask && got.Content != tt.text {
+				t.Errorf("Check(%q).Content = %q, want original text", tt.text, got.Content)
+			}
+		})
+	}
+}
+
+func TestPipelineCheckMask(t *testing.T) {
+	p := newTestPipeline(t, Word{"坏蛋", 20}, Word{"bad", 10}, Word{"蛋糕", 10})
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"你是坏蛋吗", "你是**吗"},
+		{"😀坏蛋😀", "😀**😀"},
+		{"ＢＡＤ guy", "*** guy"},
+		{"坏蛋糕", "***"}, // 重叠的词一起打码
+		{"bad坏蛋bad", "********"},
+	}
+	for _, tt := range tests {
+		got := p.Check(tt.text, 0)
+		if got.Action != ActionMask || got.Content != tt.want {
+			t.Errorf("Check(%q) = %s %q, want mask %q", tt.text, got.Action, got.Content, tt.want)
+		}
+	}
+}
+
+func TestPipelineReload(t *testing.T) {
+	source := &staticSource{words: []Word{{"旧词", 40}}}
+	p := NewPipeline(source, Thresholds{})
+	if p.Check("旧词", 0).Action != ActionAllow {
+		t.Fatal("Check() before Reload should not match any word")
+	}
+
+	if n, err := p.Reload(context.Background()); err != nil || n != 1 {
+		t.Fatalf("Reload() = %d, %v, want 1, nil", n, err)
+	}
+	if got := p.Check("旧词", 0); got.Action != ActionMask {
+		t.Errorf("Check() after Reload = %s, want mask", got.Action)
+	}
+
+	// 加载失败时继续使用原有词表
+	source.words, source.err = nil, errors.New("unavailable")
+	if _, err := p.Reload(context.Background()); err == nil {
+		t.Fatal("Reload() error = nil, want error")
+	}
+	if got := p.Check("旧词", 0); got.Action != ActionMask {
+		t.Errorf("Check() after failed Reload = %s, want mask", got.Action)
+	}
+
+	source.words, source.err = []Word{{"新词", 90}}, nil
+	if _, err := p.Reload(context.Background()); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if got := p.Check("旧词新词", 0); got.Action != ActionReject || got.Score != 90 {
+		t.Errorf("Check() after second Reload = %s %d, want reject 90", got.Action, got.Score)
+	}
+}
+
+func TestNewPipelineThresholds(t *testing.T) {
+	p := NewPipeline(nil, Thresholds{Review: 30})
+	if p.thresholds.Review != 30 || p.thresholds.Reject != DefaultThresholds.Reject {
+		t.Errorf("thresholds = %+v, want Review 30 and default Reject", p.thresholds)
+	}
+	if n, err := p.Reload(context.Background()); n != 0 || err != nil {
+		t.Errorf("Reload() without source = %d, %v, want 0, nil", n, err)
+	}
+	if got := p.Check("见 www.example.com", 0); got.Action != ActionReview {
+		t.Errorf("Check() with Review 30 = %s, want review", got.Action)
+	}
+}
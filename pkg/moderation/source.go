@@ -0,0 +1,53 @@
+package moderation
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// DefaultWordScore 词表中没有写风险分的敏感词的分数
+const DefaultWordScore = 40
+
+// WordSource 敏感词的来源，Pipeline.Reload时重新读取
+type WordSource interface {
+	Load(ctx context.Context) ([]Word, error)
+}
+
+// FileSource 从文本文件读取敏感词，每行一个词，可以在词后用空白分隔写风险分；空行和#开头的行被忽略。
+// 文件修改后在下一次Reload时生效
+type FileSource struct {
+	Path string
+}
+
+func (s FileSource) Load(ctx context.Context) ([]Word, error) {
+	f, err := os.Open(s.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sensitive word file: %w", err)
+	}
+	defer f.Close()
+
+	var words []Word
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		word := Word{Text: text, Score: DefaultWordScore}
+		if i := strings.LastIndexAny(text, " \t"); i > 0 {
+			// 最后一段不是数字时整行都是敏感词
+			if score, err := strconv.Atoi(text[i+1:]); err == nil {
+				word = Word{Text: strings.TrimSpace(text[:i]), Score: score}
+			}
+		}
+		words = append(words, word)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read sensitive word file: %w", err)
+	}
+	return words, nil
+}
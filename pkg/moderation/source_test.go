@@ -0,0 +1,47 @@
+package moderation
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestFileSourceLoad(t *testing.T) {
+	content := "# 注释行\n" +
+		"\n" +
+		"坏蛋\n" +
+		"  广告 60  \n" +
+		"free money\t70\n" +
+		"buy now\n" +
+		"版本 v2\n" +
+		"-5分 -5\n"
+	path := filepath.Join(t.TempDir(), "words.txt")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := FileSource{Path: path}.Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	want := []Word{
+		{"坏蛋", DefaultWordScore},
+		{"广告", 60},
+		{"free money", 70},
+		{"buy now", DefaultWordScore}, // 最后一段不是数字时整行都是敏感词
+		{"版本 v2", DefaultWordScore},
+		{"-5分", -5},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Load() = %v, want %v", got, want)
+	}
+}
+
+func TestFileSourceMissingFile(t *testing.T) {
+	_, err := FileSource{Path: filepath.Join(t.TempDir(), "missing.txt")}.Load(context.Background())
+	if err == nil {
+		t.Error("Load() error = nil, want error for missing file")
+	}
+}
@@ -0,0 +1,80 @@
+package mq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rabbitmq/amqp091-go"
+)
+
+// confirmPublisher 在开启了发布确认的独立通道上发布消息，broker确认后才返回成功，
+// 用于发件箱中继、重试和死信转移等不能丢消息的场景，通道关闭后在下次发布时重新打开
+type confirmPublisher struct {
+	conn    *amqp091.Connection
+	timeout time.Duration
+
+	mu      sync.Mutex
+	channel *amqp091.Channel
+}
+
+func newConfirmPublisher(conn *amqp091.Connection, timeout time.Duration) *confirmPublisher {
+	return &confirmPublisher{conn: conn, timeout: timeout}
+}
+
+// publish 发布一条消息并等待broker确认
+func (p *confirmPublisher) publish(ctx context.Context, exchange, routingKey string, msg amqp091.Publishing) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.channel == nil || p.channel.IsClosed() {
+		ch, err := p.conn.Channel()
+		if err != nil {
+			return fmt.Errorf("failed to open confirm channel: %w", err)
+		}
+		if err := ch.Confirm(false); err != nil {
+			ch.Close()
+			return fmt.Errorf("failed to enable publisher confirms: %w", err)
+		}
+		p.channel = ch
+	}
+
+	publishCtx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	confirm, err := p.channel.PublishWithDeferredConfirmWithContext(
+		publishCtx,
+		exchange,
+		routingKey,
+		false, // mandatory
+		false, // immediate
+		msg,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to publish: %w", err)
+	}
+
+	acked, err := confirm.WaitContext(publishCtx)
+	if err != nil {
+		// 确认超时后通道上可能还有未完成的确认，重新打开通道避免错配
+		p.channel.Close()
+		p.channel = nil
+		return fmt.Errorf("failed to wait for publisher confirm: %w", err)
+	}
+	if !acked {
+		return errors.New("message was nacked by broker")
+	}
+	return nil
+}
+
+// close 关闭发布通道
+func (p *confirmPublisher) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.channel != nil {
+		p.channel.Close()
+		p.channel = nil
+	}
+}
@@ -0,0 +1,40 @@
+package mq
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/go-redis/redis"
+)
+
+const (
+	// DedupeTTL 事件去重标记的保留时间，覆盖消息重投的时间窗口即可
+	DedupeTTL = 24 * time.Hour
+	// dedupeKeyPrefix 已处理事件的标记，<consumer>:<event_id>，各消费者独立去重
+	dedupeKeyPrefix = "processed_event:"
+)
+
+// Dedupe 消费者的幂等处理：在rdb中标记consumer已处理eventID后执行apply，事件之前已处理过时直接跳过；
+// apply失败时撤销标记并返回其错误，保证消息重投后能被再次处理。eventID为空时不去重
+func Dedupe(ctx context.Context, rdb *redis.Client, consumer, eventID string, apply func() error) error {
+	if eventID == "" {
+		return apply()
+	}
+	key := dedupeKeyPrefix + consumer + ":" + eventID
+	first, err := rdb.SetNX(key, 1, DedupeTTL).Result()
+	if err != nil {
+		return err
+	}
+	if !first {
+		hlog.CtxInfof(ctx, "Skip duplicated %s event: %s", consumer, eventID)
+		return nil
+	}
+	if err := apply(); err != nil {
+		if delErr := rdb.Del(key).Err(); delErr != nil {
+			hlog.CtxWarnf(ctx, "Failed to unmark %s event %s: %v", consumer, eventID, delErr)
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,260 @@
+package mq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rabbitmq/amqp091-go"
+)
+
+var (
+	// ErrUnknownQueue 队列没有对应的死信队列
+	ErrUnknownQueue = errors.New("unknown consumer queue")
+	// ErrDeadLetterNotFound 死信队列中没有指定ID的消息
+	ErrDeadLetterNotFound = errors.New("dead letter not found")
+)
+
+// DeadLetterQueueStat 一个消费队列对应的死信队列的积压情况
+type DeadLetterQueueStat struct {
+	Queue           string // 消费队列
+	DeadLetterQueue string // 死信队列
+	Messages        int    // 死信消息数
+}
+
+// DeadLetter 死信队列中的一条消息
+type DeadLetter struct {
+	MessageID      string
+	Queue          string // 消息原本所在的消费队列
+	Attempts       int    // 进入死信队列前失败的次数
+	LastError      string // 最近一次处理失败的原因
+	DeadLetteredAt time.Time
+	PublishedAt    time.Time
+	Body           []byte
+}
+
+// DLQAdmin 查看、重放和清除死信消息。
+// 查看时逐条读取消息但不确认，结束后关闭通道让它们回到死信队列，因此读取期间其他管理操作看不到这些消息
+type DLQAdmin struct {
+	conn      *amqp091.Connection
+	publisher *confirmPublisher
+}
+
+// NewDLQAdmin 使用事件总线的连接创建死信管理器
+func NewDLQAdmin(bus *Bus) *DLQAdmin {
+	return &DLQAdmin{
+		conn:      bus.conn,
+		publisher: newConfirmPublisher(bus.conn, 5*time.Second),
+	}
+}
+
+// Close 关闭重放使用的发布通道
+func (a *DLQAdmin) Close() {
+	a.publisher.close()
+}
+
+// Stats 本进程注册的事件的默认消费队列的死信积压，消费组的队列可以按名称直接查看
+func (a *DLQAdmin) Stats(ctx context.Context) ([]DeadLetterQueueStat, error) {
+	queues := ConsumerQueues()
+	stats := make([]DeadLetterQueueStat, 0, len(queues))
+	for _, queue := range queues {
+		q, err := a.inspect(queue)
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, DeadLetterQueueStat{
+			Queue:           queue,
+			DeadLetterQueue: q.Name,
+			Messages:        q.Messages,
+		})
+	}
+	return stats, nil
+}
+
+// List 按入队顺序列出queue的死信队列中最多limit条消息，limit不大于0时列出全部
+func (a *DLQAdmin) List(ctx context.Context, queue string, limit int) ([]*DeadLetter, error) {
+	var letters []*DeadLetter
+	err := a.scan(ctx, queue, func(d amqp091.Delivery) (bool, bool, error) {
+		letters = append(letters, toDeadLetter(queue, d))
+		return false, limit > 0 && len(letters) >= limit, nil
+	})
+	return letters, err
+}
+
+// Get 查找死信队列中指定ID的消息
+func (a *DLQAdmin) Get(ctx context.Context, queue, messageID string) (*DeadLetter, error) {
+	var letter *DeadLetter
+	err := a.scan(ctx, queue, func(d amqp091.Delivery) (bool, bool, error) {
+		if d.MessageId != messageID {
+			return false, false, nil
+		}
+		letter = toDeadLetter(queue, d)
+		return false, true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if letter == nil {
+		return nil, ErrDeadLetterNotFound
+	}
+	return letter, nil
+}
+
+// Replay 把死信消息重新投递到原消费队列并清除失败记录，messageIDs为空时重放全部，返回重放的条数
+func (a *DLQAdmin) Replay(ctx context.Context, queue string, messageIDs []string) (int, error) {
+	match := matcher(messageIDs)
+	replayed := 0
+	err := a.scan(ctx, queue, func(d amqp091.Delivery) (bool, bool, error) {
+		if !match(d.MessageId) {
+			return false, false, nil
+		}
+
+		headers := amqp091.Table{}
+		for k, v := range d.Headers {
+			headers[k] = v
+		}
+		for _, k := range []string{HeaderAttempts, HeaderLastError, HeaderOriginalQueue, HeaderDeadLetteredAt} {
+			delete(headers, k)
+		}
+		// 经默认交换机直接投递到原队列，不会被交换机上的其他绑定重复消费
+		err := a.publisher.publish(ctx, "", queue, amqp091.Publishing{
+			Headers:      headers,
+			ContentType:  d.ContentType,
+			DeliveryMode: amqp091.Persistent,
+			MessageId:    d.MessageId,
+			Timestamp:    d.Timestamp,
+			Body:         d.Body,
+		})
+		if err != nil {
+			return false, true, fmt.Errorf("failed to replay message %s: %w", d.MessageId, err)
+		}
+		replayed++
+		return true, false, nil
+	})
+	return replayed, err
+}
+
+// Purge 删除死信消息，messageIDs为空时清空死信队列，返回删除的条数
+func (a *DLQAdmin) Purge(ctx context.Context, queue string, messageIDs []string) (int, error) {
+	if len(messageIDs) == 0 {
+		if _, err := a.inspect(queue); err != nil {
+			return 0, err
+		}
+		ch, err := a.conn.Channel()
+		if err != nil {
+			return 0, fmt.Errorf("failed to open a channel: %w", err)
+		}
+		defer ch.Close()
+		purged, err := ch.QueuePurge(DeadLetterQueue(queue), false)
+		if err != nil {
+			return 0, fmt.Errorf("failed to purge queue %s: %w", DeadLetterQueue(queue), err)
+		}
+		return purged, nil
+	}
+
+	match := matcher(messageIDs)
+	purged := 0
+	err := a.scan(ctx, queue, func(d amqp091.Delivery) (bool, bool, error) {
+		if !match(d.MessageId) {
+			return false, false, nil
+		}
+		purged++
+		return true, false, nil
+	})
+	return purged, err
+}
+
+// scan 逐条读取queue的死信队列中现有的消息，visit返回remove时确认该消息使其移出死信队列，
+// 返回stop时提前结束；未确认的消息在通道关闭后回到死信队列
+func (a *DLQAdmin) scan(ctx context.Context, queue string, visit func(d amqp091.Delivery) (remove, stop bool, err error)) error {
+	q, err := a.inspect(queue)
+	if err != nil {
+		return err
+	}
+
+	ch, err := a.conn.Channel()
+	if err != nil {
+		return fmt.Errorf("failed to open a channel: %w", err)
+	}
+	defer ch.Close()
+
+	dlq := q.Name
+	// 只读取开始时已有的消息，扫描期间新进入的死信留给下一次
+	for i := 0; i < q.Messages; i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		d, ok, err := ch.Get(dlq, false)
+		if err != nil {
+			return fmt.Errorf("failed to get message from %s: %w", dlq, err)
+		}
+		if !ok {
+			return nil
+		}
+
+		remove, stop, err := visit(d)
+		if err != nil {
+			return err
+		}
+		if remove {
+			if err := d.Ack(false); err != nil {
+				return fmt.Errorf("failed to ack message %s: %w", d.MessageId, err)
+			}
+		}
+		if stop {
+			return nil
+		}
+	}
+	return nil
+}
+
+// inspect 查看queue的死信队列，死信队列不存在时返回ErrUnknownQueue。
+// 被动声明失败会关闭通道，因此使用单独的通道
+func (a *DLQAdmin) inspect(queue string) (amqp091.Queue, error) {
+	ch, err := a.conn.Channel()
+	if err != nil {
+		return amqp091.Queue{}, fmt.Errorf("failed to open a channel: %w", err)
+	}
+	defer ch.Close()
+
+	dlq := DeadLetterQueue(queue)
+	q, err := ch.QueueDeclarePassive(dlq, true, false, false, false, nil)
+	if err != nil {
+		var amqpErr *amqp091.Error
+		if errors.As(err, &amqpErr) && amqpErr.Code == amqp091.NotFound {
+			return amqp091.Queue{}, ErrUnknownQueue
+		}
+		return amqp091.Queue{}, fmt.Errorf("failed to inspect queue %s: %w", dlq, err)
+	}
+	return q, nil
+}
+
+// matcher messageIDs为空时匹配所有消息
+func matcher(messageIDs []string) func(string) bool {
+	if len(messageIDs) == 0 {
+		return func(string) bool { return true }
+	}
+	ids := make(map[string]bool, len(messageIDs))
+	for _, id := range messageIDs {
+		ids[id] = true
+	}
+	return func(id string) bool { return ids[id] }
+}
+
+func toDeadLetter(queue string, d amqp091.Delivery) *DeadLetter {
+	letter := &DeadLetter{
+		MessageID:   d.MessageId,
+		Queue:       queue,
+		Attempts:    deliveryAttempts(d),
+		PublishedAt: d.Timestamp,
+		Body:        d.Body,
+	}
+	if lastError, ok := d.Headers[HeaderLastError].(string); ok {
+		letter.LastError = lastError
+	}
+	if deadLetteredAt := headerInt(d.Headers[HeaderDeadLetteredAt]); deadLetteredAt > 0 {
+		letter.DeadLetteredAt = time.Unix(int64(deadLetteredAt), 0)
+	}
+	return letter
+}
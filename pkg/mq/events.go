@@ -1,6 +1,10 @@
 package mq
 
-import "HuaTug.com/cmd/model"
+import (
+	"fmt"
+
+	"HuaTug.com/cmd/model"
+)
 
 // LikeEvent 点赞事件
 type LikeEvent struct {
@@ -11,6 +15,8 @@ type LikeEvent struct {
 	EventType  string `json:"event_type"`  // "video_like" or "comment_like"
 	Timestamp  int64  `json:"timestamp"`   // 时间戳
 	EventID    string `json:"event_id"`    // 事件ID
+	AuthorID   int64  `json:"author_id"`   // 视频点赞时为视频作者ID，用于更新作者的获赞数
+	Category   string `json:"category"`    // 视频点赞时为视频分类，用于计入分类热门榜单
 }
 
 // CommentEvent 评论事件
@@ -25,19 +31,85 @@ type CommentEvent struct {
 
 // NotificationEvent 通知事件
 type NotificationEvent struct {
-	UserID           int64                  `json:"user_id"`           // 接收者ID (兼容字段)
-	FromUserID       int64                  `json:"from_user_id"`      // 发送者ID (兼容字段)
-	Type             string                 `json:"type"`              // comment, like, reply
-	ReceiverID       int64                  `json:"receiver_id"`       // 接收者ID
-	SenderID         int64                  `json:"sender_id"`         // 发送者ID
-	CommentID        int64                  `json:"comment_id"`        // 评论ID
-	VideoID          int64                  `json:"video_id"`          // 视频ID
-	Content          string                 `json:"content"`           // 通知内容
-	Extra            map[string]interface{} `json:"extra,omitempty"`   // 额外数据
-	Timestamp        int64                  `json:"timestamp"`         // 时间戳
-	EventID          string                 `json:"event_id"`          // 事件ID
-	NotificationType string                 `json:"notification_type"` // 通知类型 (兼容字段)
-	TargetID         int64                  `json:"target_id"`         // 目标ID (兼容字段)
+	UserID           int64                  `json:"user_id"`            // 接收者ID (兼容字段)
+	FromUserID       int64                  `json:"from_user_id"`       // 发送者ID (兼容字段)
+	Type             string                 `json:"type"`               // comment, like, reply
+	ReceiverID       int64                  `json:"receiver_id"`        // 接收者ID
+	SenderID         int64                  `json:"sender_id"`          // 发送者ID
+	CommentID        int64                  `json:"comment_id"`         // 评论ID
+	VideoID          int64                  `json:"video_id"`           // 视频ID
+	Content          string                 `json:"content"`            // 通知内容
+	Extra            map[string]interface{} `json:"extra,omitempty"`    // 额外数据
+	Timestamp        int64                  `json:"timestamp"`          // 时间戳
+	EventID          string                 `json:"event_id"`           // 事件ID
+	NotificationType string                 `json:"notification_type"`  // 通知类型 (兼容字段)
+	TargetID         int64                  `json:"target_id"`          // 目标ID (兼容字段)
+	Priority         int                    `json:"priority,omitempty"` // 投递优先级，特别关注的用户产生的通知为高优先级
+}
+
+// 通知投递优先级
+const (
+	NotificationPriorityNormal = 0
+	NotificationPriorityHigh   = 1
+)
+
+// UserStatsEvent 用户计数变更事件，由user服务消费并更新user_stats表
+type UserStatsEvent struct {
+	UserID    int64  `json:"user_id"`   // 计数所属用户ID
+	StatType  string `json:"stat_type"` // follower_count, following_count, total_likes, video_count
+	Delta     int64  `json:"delta"`     // 变化量，可以为负数
+	Source    string `json:"source"`    // 事件来源服务
+	Timestamp int64  `json:"timestamp"` // 时间戳
+	EventID   string `json:"event_id"`  // 事件ID，用于消费端幂等
+}
+
+// 用户计数类型
+const (
+	StatTypeFollowerCount  = "follower_count"
+	StatTypeFollowingCount = "following_count"
+	StatTypeTotalLikes     = "total_likes"
+	StatTypeVideoCount     = "video_count"
+)
+
+// VideoEngagementEvent 用户对视频的评论、分享和观看，video服务据此更新用户兴趣画像和视频热度
+type VideoEngagementEvent struct {
+	UserID    int64  `json:"user_id"`   // 用户ID，未登录用户的观看为0
+	VideoID   int64  `json:"video_id"`  // 视频ID
+	Category  string `json:"category"`  // 视频分类，用于计入分类热门榜单
+	Action    string `json:"action"`    // comment, share, watch
+	Timestamp int64  `json:"timestamp"` // 时间戳
+	EventID   string `json:"event_id"`  // 事件ID，用于消费端幂等
+}
+
+// 视频互动类型
+const (
+	EngagementComment = "comment"
+	EngagementShare   = "share"
+	EngagementWatch   = "watch"
+)
+
+// FollowEvent 关注关系变更事件，关注请求通过时也发布follow；video服务据此补齐或清理关注流
+type FollowEvent struct {
+	FollowerID int64  `json:"follower_id"` // 关注者ID
+	FolloweeID int64  `json:"followee_id"` // 被关注者ID
+	Action     string `json:"action"`      // follow, unfollow
+	Timestamp  int64  `json:"timestamp"`   // 时间戳
+	EventID    string `json:"event_id"`    // 事件ID
+}
+
+// 关注关系变更类型
+const (
+	FollowActionFollow   = "follow"
+	FollowActionUnfollow = "unfollow"
+)
+
+// VideoPublishedEvent 视频发布事件，video服务据此把视频写入粉丝的关注流
+type VideoPublishedEvent struct {
+	VideoID   int64  `json:"video_id"`   // 视频ID
+	AuthorID  int64  `json:"author_id"`  // 作者ID
+	CreatedAt string `json:"created_at"` // 视频的发布日期，与videos.created_at一致
+	Timestamp int64  `json:"timestamp"`  // 时间戳
+	EventID   string `json:"event_id"`   // 事件ID
 }
 
 // 常量定义
@@ -46,9 +118,119 @@ const (
 	LikeEventExchange         = "like_events"
 	CommentEventExchange      = "comment_events"
 	NotificationEventExchange = "notification_events"
+	UserStatsEventExchange    = "user_stats_events"
+	EngagementEventExchange   = "video_engagement_events"
+	FollowEventExchange       = "follow_events"
+	VideoPublishedExchange    = "video_published_events"
 
 	// 队列名称
 	LikeEventQueue         = "like_event_queue"
 	CommentEventQueue      = "comment_event_queue"
 	NotificationEventQueue = "notification_event_queue"
+	UserStatsEventQueue    = "user_stats_event_queue"
+	EngagementEventQueue   = "video_engagement_event_queue"
+	FollowEventQueue       = "follow_event_queue"
+	VideoPublishedQueue    = "video_published_event_queue"
+
+	// 高优先级通知使用独立队列和消费协程，不会被普通通知的积压阻塞
+	NotificationPriorityQueue      = "notification_priority_queue"
+	NotificationPriorityRoutingKey = "priority"
 )
+
+func init() {
+	// 同一用户对同一对象的点赞和取消点赞按顺序发布
+	RegisterEvent(EventSchema[LikeEvent]{
+		Name:     "like",
+		Version:  1,
+		Exchange: LikeEventExchange,
+		Queues:   []QueueBinding{{Queue: LikeEventQueue}},
+		AggregateKey: func(event *LikeEvent) string {
+			targetID := event.VideoID
+			if event.EventType == "comment_like" {
+				targetID = event.CommentID
+			}
+			return fmt.Sprintf("%s:%d:%d", event.EventType, targetID, event.UserID)
+		},
+	})
+
+	// 同一条评论的事件按顺序发布
+	RegisterEvent(EventSchema[CommentEvent]{
+		Name:     "comment",
+		Version:  1,
+		Exchange: CommentEventExchange,
+		Queues:   []QueueBinding{{Queue: CommentEventQueue}},
+		AggregateKey: func(event *CommentEvent) string {
+			if event.Comment != nil {
+				return fmt.Sprintf("comment:%d", event.Comment.CommentId)
+			}
+			return fmt.Sprintf("video:%d", event.VideoID)
+		},
+	})
+
+	// 高优先级通知按路由键投递到独立队列；同一接收者的通知按顺序发布
+	RegisterEvent(EventSchema[NotificationEvent]{
+		Name:     "notification",
+		Version:  1,
+		Exchange: NotificationEventExchange,
+		Queues: []QueueBinding{
+			{Queue: NotificationEventQueue},
+			{Queue: NotificationPriorityQueue, RoutingKey: NotificationPriorityRoutingKey},
+		},
+		RoutingKey: func(event *NotificationEvent) string {
+			if event.Priority >= NotificationPriorityHigh {
+				return NotificationPriorityRoutingKey
+			}
+			return ""
+		},
+		AggregateKey: func(event *NotificationEvent) string {
+			receiverID := event.ReceiverID
+			if receiverID == 0 {
+				receiverID = event.UserID
+			}
+			return fmt.Sprintf("notification:%d", receiverID)
+		},
+	})
+
+	// 同一用户的计数变更按顺序发布
+	RegisterEvent(EventSchema[UserStatsEvent]{
+		Name:     "user_stats",
+		Version:  1,
+		Exchange: UserStatsEventExchange,
+		Queues:   []QueueBinding{{Queue: UserStatsEventQueue}},
+		AggregateKey: func(event *UserStatsEvent) string {
+			return fmt.Sprintf("user_stats:%d", event.UserID)
+		},
+	})
+
+	// 同一用户的互动按顺序发布
+	RegisterEvent(EventSchema[VideoEngagementEvent]{
+		Name:     "video_engagement",
+		Version:  1,
+		Exchange: EngagementEventExchange,
+		Queues:   []QueueBinding{{Queue: EngagementEventQueue}},
+		AggregateKey: func(event *VideoEngagementEvent) string {
+			return fmt.Sprintf("engagement:%d", event.UserID)
+		},
+	})
+
+	// 同一对用户的关注和取消关注按顺序发布
+	RegisterEvent(EventSchema[FollowEvent]{
+		Name:     "follow",
+		Version:  1,
+		Exchange: FollowEventExchange,
+		Queues:   []QueueBinding{{Queue: FollowEventQueue}},
+		AggregateKey: func(event *FollowEvent) string {
+			return fmt.Sprintf("follow:%d:%d", event.FollowerID, event.FolloweeID)
+		},
+	})
+
+	RegisterEvent(EventSchema[VideoPublishedEvent]{
+		Name:     "video_published",
+		Version:  1,
+		Exchange: VideoPublishedExchange,
+		Queues:   []QueueBinding{{Queue: VideoPublishedQueue}},
+		AggregateKey: func(event *VideoPublishedEvent) string {
+			return fmt.Sprintf("video:%d", event.VideoID)
+		},
+	})
+}
@@ -0,0 +1,81 @@
+package mq
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 事务性发件箱：业务变更和待发布的事件在同一个本地事务中写入mq_outbox表，
+// 由OutboxRelay在事务提交后发布到RabbitMQ并删除记录。
+// 发布成功但删除失败时事件会被再次发布，消费端需要按EventID或消息ID幂等；
+// 同一个聚合键的事件按写入顺序发布，前一条发布失败时后面的事件等待它重试成功或进入死信状态。
+
+const (
+	outboxTable = "mq_outbox"
+
+	// 发件箱记录状态
+	OutboxStatusPending = "pending" // 等待发布
+	OutboxStatusDead    = "dead"    // 超过最大重试次数，不再自动发布
+)
+
+// OutboxMessage 发件箱中的一条待发布消息
+type OutboxMessage struct {
+	ID            int64      `gorm:"column:id;primaryKey;autoIncrement"`
+	MessageID     string     `gorm:"column:message_id"` // 发布时作为AMQP消息ID，便于消费端去重
+	EventName     string     `gorm:"column:event_name"` // 事件名和版本，发布时写入消息头
+	EventVersion  int        `gorm:"column:event_version"`
+	Exchange      string     `gorm:"column:exchange"`      // 目标交换机
+	RoutingKey    string     `gorm:"column:routing_key"`   // 路由键
+	AggregateKey  string     `gorm:"column:aggregate_key"` // 聚合键，相同聚合键的消息按ID顺序发布
+	Payload       string     `gorm:"column:payload"`       // 消息体，与Bus直接发布的JSON格式一致
+	Status        string     `gorm:"column:status"`
+	Attempts      int        `gorm:"column:attempts"`   // 发布失败次数
+	LastError     string     `gorm:"column:last_error"` // 最近一次发布失败原因
+	NextAttemptAt time.Time  `gorm:"column:next_attempt_at"`
+	LockedUntil   *time.Time `gorm:"column:locked_until"` // 被中继认领后的租约到期时间，未认领时为空
+	CreatedAt     time.Time  `gorm:"column:created_at"`
+}
+
+func (OutboxMessage) TableName() string {
+	return outboxTable
+}
+
+// Outbox 在调用方的数据库事务中写入待发布事件，实现Publisher接口，
+// 可以替换直接发布的Bus，使事件与业务变更一起提交或回滚
+type Outbox struct {
+	tx *gorm.DB
+}
+
+// NewOutbox 创建绑定到事务tx的发件箱，tx必须与业务变更使用同一个数据库连接
+func NewOutbox(tx *gorm.DB) *Outbox {
+	return &Outbox{tx: tx}
+}
+
+// PublishMessage 把消息写入发件箱，相同聚合键的消息按写入顺序发布，没有聚合键的消息按消息ID独立发布
+func (o *Outbox) PublishMessage(ctx context.Context, msg *Message) error {
+	aggregateKey := msg.AggregateKey
+	if aggregateKey == "" {
+		aggregateKey = msg.ID
+	}
+
+	now := time.Now()
+	message := &OutboxMessage{
+		MessageID:     msg.ID,
+		EventName:     msg.Event,
+		EventVersion:  msg.Version,
+		Exchange:      msg.Exchange,
+		RoutingKey:    msg.RoutingKey,
+		AggregateKey:  aggregateKey,
+		Payload:       string(msg.Body),
+		Status:        OutboxStatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+	if err := o.tx.WithContext(ctx).Create(message).Error; err != nil {
+		return fmt.Errorf("failed to write outbox message: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,333 @@
+package mq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/rabbitmq/amqp091-go"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OutboxSource 遍历需要中继的数据库，分库的服务可以直接传入分片路由的ForEachDatabase
+type OutboxSource func(ctx context.Context, fn func(name string, db *gorm.DB) error) error
+
+// SingleOutboxSource 只包含一个数据库的发件箱来源
+func SingleOutboxSource(name string, db *gorm.DB) OutboxSource {
+	return func(ctx context.Context, fn func(name string, db *gorm.DB) error) error {
+		return fn(name, db)
+	}
+}
+
+// noEarlierBackoff 同一聚合键中没有更早的、仍在退避的待发布消息，用于保持聚合键内的发布顺序
+const noEarlierBackoff = "NOT EXISTS (SELECT 1 FROM " + outboxTable + " AS earlier" +
+	" WHERE earlier.aggregate_key = " + outboxTable + ".aggregate_key AND earlier.status = ?" +
+	" AND earlier.id < " + outboxTable + ".id AND earlier.next_attempt_at > ?)"
+
+// unleased 没有被其他中继实例认领，或认领的租约已经到期
+const unleased = "(locked_until IS NULL OR locked_until <= ?)"
+
+// OutboxRelayConfig 发件箱中继配置，零值字段使用默认值
+type OutboxRelayConfig struct {
+	BatchSize      int           // 每个数据库每轮最多处理的消息数
+	Interval       time.Duration // 轮询间隔
+	MaxAttempts    int           // 超过该失败次数的消息标记为dead
+	BaseBackoff    time.Duration // 首次失败后的重试间隔，之后每次翻倍
+	MaxBackoff     time.Duration // 最大重试间隔
+	PublishTimeout time.Duration // 单条消息等待broker确认的超时时间
+	// Lease 认领一批消息的租约时长，实例在发布中途崩溃时其他实例在租约到期后重新认领；
+	// 剩余租约不足一次发布超时的消息不再发布，释放给下一轮
+	Lease time.Duration
+}
+
+// DefaultOutboxRelayConfig 默认的发件箱中继配置
+var DefaultOutboxRelayConfig = OutboxRelayConfig{
+	BatchSize:      100,
+	Interval:       time.Second,
+	MaxAttempts:    10,
+	BaseBackoff:    time.Second,
+	MaxBackoff:     5 * time.Minute,
+	PublishTimeout: 5 * time.Second,
+	Lease:          time.Minute,
+}
+
+// OutboxRelay 把发件箱中的消息发布到RabbitMQ，broker确认后才删除记录，保证至少一次投递。
+// 每批消息在一个短事务中用FOR UPDATE SKIP LOCKED认领并写入租约，发布在事务之外进行，
+// 多个实例同时运行时各自认领不同的消息，不会因等待broker确认而长时间持有行锁；
+// 认领后同一个聚合键中还有更早的待发布消息时跳过该聚合键，因此同一个聚合键的消息不会被并发或乱序发布
+type OutboxRelay struct {
+	publisher *confirmPublisher // 与Bus的发布通道互不影响
+	sources   []OutboxSource
+	config    OutboxRelayConfig
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewOutboxRelay 创建发件箱中继，config为nil时使用DefaultOutboxRelayConfig
+func NewOutboxRelay(bus *Bus, config *OutboxRelayConfig, sources ...OutboxSource) *OutboxRelay {
+	cfg := DefaultOutboxRelayConfig
+	if config != nil {
+		if config.BatchSize > 0 {
+			cfg.BatchSize = config.BatchSize
+		}
+		if config.Interval > 0 {
+			cfg.Interval = config.Interval
+		}
+		if config.MaxAttempts > 0 {
+			cfg.MaxAttempts = config.MaxAttempts
+		}
+		if config.BaseBackoff > 0 {
+			cfg.BaseBackoff = config.BaseBackoff
+		}
+		if config.MaxBackoff > 0 {
+			cfg.MaxBackoff = config.MaxBackoff
+		}
+		if config.PublishTimeout > 0 {
+			cfg.PublishTimeout = config.PublishTimeout
+		}
+		if config.Lease > 0 {
+			cfg.Lease = config.Lease
+		}
+	}
+	return &OutboxRelay{
+		publisher: newConfirmPublisher(bus.conn, cfg.PublishTimeout),
+		sources:   sources,
+		config:    cfg,
+	}
+}
+
+// Start 在后台按固定间隔中继发件箱
+func (r *OutboxRelay) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.done = make(chan struct{})
+
+	go func() {
+		defer close(r.done)
+		ticker := time.NewTicker(r.config.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := r.RelayOnce(ctx); err != nil && ctx.Err() == nil {
+					hlog.Warnf("Outbox relay round failed: %v", err)
+				}
+			}
+		}
+	}()
+	hlog.Infof("Outbox relay started with %d sources", len(r.sources))
+}
+
+// Stop 停止后台中继并关闭发布通道
+func (r *OutboxRelay) Stop() {
+	if r.cancel == nil {
+		return
+	}
+	r.cancel()
+	<-r.done
+	r.publisher.close()
+}
+
+// RelayOnce 对每个数据库中继一批消息，返回成功发布的条数
+func (r *OutboxRelay) RelayOnce(ctx context.Context) (int, error) {
+	published := 0
+	var errs []error
+	for _, source := range r.sources {
+		err := source(ctx, func(name string, db *gorm.DB) error {
+			n, err := r.relayBatch(ctx, db)
+			published += n
+			if err != nil {
+				// 单个数据库失败不影响其他数据库
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			}
+			return nil
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return published, errors.Join(errs...)
+}
+
+// relayBatch 认领最早的一批已到发布时间的消息并按ID顺序发布。
+// 在数据库中过滤未到重试时间的消息，避免退避中的消息占满整批而阻塞其他事件；
+// 同一聚合键中更早的消息仍在退避时，该聚合键后续的消息也不选取，本批中发布失败的聚合键后续消息跳过
+func (r *OutboxRelay) relayBatch(ctx context.Context, db *gorm.DB) (int, error) {
+	messages, leaseUntil, err := r.claim(ctx, db)
+	if err != nil || len(messages) == 0 {
+		return 0, err
+	}
+	blocked, err := r.earlierPending(ctx, db, messages)
+	if err != nil {
+		r.release(ctx, db, messages)
+		return 0, err
+	}
+
+	published := 0
+	var skipped []*OutboxMessage
+	for _, message := range messages {
+		if blocked[message.AggregateKey] || time.Until(leaseUntil) < r.config.PublishTimeout {
+			skipped = append(skipped, message)
+			continue
+		}
+
+		if err := r.publish(ctx, message); err != nil {
+			blocked[message.AggregateKey] = true
+			if err := r.markFailed(db.WithContext(ctx), message, err); err != nil {
+				hlog.CtxWarnf(ctx, "%v", err)
+			}
+			continue
+		}
+		// 删除失败时消息在租约到期后被再次发布
+		if err := db.WithContext(ctx).Delete(message).Error; err != nil {
+			hlog.CtxWarnf(ctx, "Failed to delete published outbox message %d: %v", message.ID, err)
+		}
+		published++
+	}
+	r.release(ctx, db, skipped)
+	return published, nil
+}
+
+// claim 在短事务中锁定一批可发布且未被认领的消息并写入租约，其他实例的SKIP LOCKED会跳过这些行
+func (r *OutboxRelay) claim(ctx context.Context, db *gorm.DB) ([]*OutboxMessage, time.Time, error) {
+	var messages []*OutboxMessage
+	now := time.Now()
+	leaseUntil := now.Add(r.config.Lease)
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: clause.LockingOptionsSkipLocked}).
+			Where("status = ? AND next_attempt_at <= ?", OutboxStatusPending, now).
+			Where(unleased, now).
+			Where(noEarlierBackoff, OutboxStatusPending, now).
+			Order("id ASC").Limit(r.config.BatchSize).
+			Find(&messages).Error; err != nil {
+			return fmt.Errorf("failed to load outbox messages: %w", err)
+		}
+		if len(messages) == 0 {
+			return nil
+		}
+		ids := make([]int64, 0, len(messages))
+		for _, message := range messages {
+			ids = append(ids, message.ID)
+		}
+		if err := tx.Model(&OutboxMessage{}).Where("id IN ?", ids).
+			Update("locked_until", leaseUntil).Error; err != nil {
+			return fmt.Errorf("failed to lease outbox messages: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return messages, leaseUntil, nil
+}
+
+// earlierPending 认领提交后检查各聚合键中是否还有本批之外更早的待发布消息（被其他实例认领或正在退避），
+// 有则本批中该聚合键之后的消息都不发布；消息发布后才删除，检查结果在提交之后读取，不会漏掉并发认领的更早消息
+func (r *OutboxRelay) earlierPending(ctx context.Context, db *gorm.DB, messages []*OutboxMessage) (map[string]bool, error) {
+	first := make(map[string]int64, len(messages))
+	ids := make([]int64, 0, len(messages))
+	for _, message := range messages {
+		if _, ok := first[message.AggregateKey]; !ok {
+			first[message.AggregateKey] = message.ID
+		}
+		ids = append(ids, message.ID)
+	}
+	keys := make([]string, 0, len(first))
+	for key := range first {
+		keys = append(keys, key)
+	}
+
+	var rows []struct {
+		AggregateKey string
+		MinID        int64
+	}
+	if err := db.WithContext(ctx).Model(&OutboxMessage{}).
+		Select("aggregate_key, MIN(id) AS min_id").
+		Where("status = ? AND aggregate_key IN ? AND id NOT IN ?", OutboxStatusPending, keys, ids).
+		Group("aggregate_key").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to check outbox ordering: %w", err)
+	}
+	blocked := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		if row.MinID < first[row.AggregateKey] {
+			blocked[row.AggregateKey] = true
+		}
+	}
+	return blocked, nil
+}
+
+// release 释放未发布消息的租约，下一轮可以立即重新认领
+func (r *OutboxRelay) release(ctx context.Context, db *gorm.DB, messages []*OutboxMessage) {
+	if len(messages) == 0 {
+		return
+	}
+	ids := make([]int64, 0, len(messages))
+	for _, message := range messages {
+		ids = append(ids, message.ID)
+	}
+	if err := db.WithContext(ctx).Model(&OutboxMessage{}).Where("id IN ?", ids).
+		Update("locked_until", nil).Error; err != nil {
+		hlog.CtxWarnf(ctx, "Failed to release outbox messages, retried after the lease expires: %v", err)
+	}
+}
+
+// markFailed 记录一次发布失败、释放租约并按指数退避安排下次重试，超过最大次数时标记为dead
+func (r *OutboxRelay) markFailed(db *gorm.DB, message *OutboxMessage, cause error) error {
+	attempts := message.Attempts + 1
+	status := OutboxStatusPending
+	if attempts >= r.config.MaxAttempts {
+		status = OutboxStatusDead
+		hlog.Errorf("Outbox message %d (%s) exceeded %d attempts, marked dead: %v",
+			message.ID, message.AggregateKey, r.config.MaxAttempts, cause)
+	} else {
+		hlog.Warnf("Failed to publish outbox message %d (%s), attempt %d: %v",
+			message.ID, message.AggregateKey, attempts, cause)
+	}
+
+	msg := cause.Error()
+	if len(msg) > 255 {
+		msg = msg[:255]
+	}
+	err := db.Model(&OutboxMessage{}).Where("id = ?", message.ID).Updates(map[string]interface{}{
+		"status":          status,
+		"attempts":        attempts,
+		"last_error":      msg,
+		"next_attempt_at": time.Now().Add(r.backoff(attempts)),
+		"locked_until":    nil,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to update outbox message %d: %w", message.ID, err)
+	}
+	return nil
+}
+
+// backoff 第attempts次失败后的重试间隔
+func (r *OutboxRelay) backoff(attempts int) time.Duration {
+	delay := r.config.BaseBackoff
+	for i := 1; i < attempts && delay < r.config.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > r.config.MaxBackoff {
+		delay = r.config.MaxBackoff
+	}
+	return delay
+}
+
+// publish 把发件箱消息发布到目标交换机并等待broker确认
+func (r *OutboxRelay) publish(ctx context.Context, message *OutboxMessage) error {
+	return r.publisher.publish(ctx, message.Exchange, message.RoutingKey, amqp091.Publishing{
+		Headers:      eventHeaders(message.EventName, message.EventVersion),
+		ContentType:  "application/json",
+		DeliveryMode: amqp091.Persistent,
+		MessageId:    message.MessageID,
+		Timestamp:    message.CreatedAt,
+		Body:         []byte(message.Payload),
+	})
+}
@@ -0,0 +1,192 @@
+package mq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/google/uuid"
+	"github.com/rabbitmq/amqp091-go"
+)
+
+// 重试与死信拓扑：
+// 每个消费队列Q有一个重试交换机Q.retry，按退避时间绑定若干重试队列Q.retry.<delay>，
+// 重试队列设置了消息TTL，过期后经默认交换机回到Q；
+// 超过最大次数或无法解析的消息转入死信交换机，按原队列名路由到Q.dlq，由DLQAdmin查看、重放或清除。
+// 消费队列本身的参数不变，已经部署的队列不需要重建。
+
+const (
+	// DeadLetterExchange 死信交换机，路由键为原队列名
+	DeadLetterExchange = "dead_letters"
+
+	// 重试和死信消息的头
+	HeaderAttempts       = "x-attempts"         // 已经失败的次数
+	HeaderLastError      = "x-last-error"       // 最近一次处理失败的原因
+	HeaderOriginalQueue  = "x-original-queue"   // 消息原本所在的消费队列
+	HeaderDeadLetteredAt = "x-dead-lettered-at" // 进入死信队列的时间（unix秒）
+
+	maxHeaderErrorLength = 1024
+)
+
+// RetryExchange 队列对应的重试交换机
+func RetryExchange(queue string) string {
+	return queue + ".retry"
+}
+
+// DeadLetterQueue 队列对应的死信队列
+func DeadLetterQueue(queue string) string {
+	return queue + ".dlq"
+}
+
+// retryQueue 队列在某个退避时间上的重试队列，队列名包含退避时间，修改重试策略不会与已有队列的TTL冲突
+func retryQueue(queue string, delay time.Duration) string {
+	return fmt.Sprintf("%s.retry.%s", queue, delay)
+}
+
+// RetryPolicy 消费失败的重试策略，第n次失败后等待BaseDelay*2^(n-1)，不超过MaxDelay
+type RetryPolicy struct {
+	MaxAttempts int // 达到该失败次数后转入死信队列
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// DefaultRetryPolicy 默认重试策略：最多处理5次，重试间隔1s、2s、4s、8s
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 5,
+	BaseDelay:   time.Second,
+	MaxDelay:    time.Minute,
+}
+
+// delay 第attempts次失败后的重试间隔
+func (p RetryPolicy) delay(attempts int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempts && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	return delay
+}
+
+// delays 所有可能用到的重试间隔，去重后按从小到大排列
+func (p RetryPolicy) delays() []time.Duration {
+	var delays []time.Duration
+	for attempts := 1; attempts < p.MaxAttempts; attempts++ {
+		delay := p.delay(attempts)
+		if len(delays) == 0 || delays[len(delays)-1] != delay {
+			delays = append(delays, delay)
+		}
+	}
+	return delays
+}
+
+// declareRetryTopology 声明消费队列的重试交换机、重试队列和死信队列，死信交换机由Bus统一声明
+func declareRetryTopology(ch *amqp091.Channel, queue string, policy RetryPolicy) error {
+	dlq := DeadLetterQueue(queue)
+	if _, err := ch.QueueDeclare(dlq, true, false, false, false, nil); err != nil {
+		return fmt.Errorf("failed to declare queue %s: %w", dlq, err)
+	}
+	if err := ch.QueueBind(dlq, queue, DeadLetterExchange, false, nil); err != nil {
+		return fmt.Errorf("failed to bind queue %s to exchange %s: %w", dlq, DeadLetterExchange, err)
+	}
+
+	exchange := RetryExchange(queue)
+	if err := ch.ExchangeDeclare(exchange, "direct", true, false, false, false, nil); err != nil {
+		return fmt.Errorf("failed to declare exchange %s: %w", exchange, err)
+	}
+	for _, delay := range policy.delays() {
+		name := retryQueue(queue, delay)
+		_, err := ch.QueueDeclare(name, true, false, false, false, amqp091.Table{
+			"x-message-ttl":             delay.Milliseconds(),
+			"x-dead-letter-exchange":    "", // 过期后经默认交换机回到原队列
+			"x-dead-letter-routing-key": queue,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to declare queue %s: %w", name, err)
+		}
+		if err := ch.QueueBind(name, delay.String(), exchange, false, nil); err != nil {
+			return fmt.Errorf("failed to bind queue %s to exchange %s: %w", name, exchange, err)
+		}
+	}
+	return nil
+}
+
+// errMalformedMessage 消息体无法解析，重试也不会成功，直接转入死信队列
+var errMalformedMessage = errors.New("malformed message")
+
+// deliveryAttempts 消息已经失败的次数
+func deliveryAttempts(d amqp091.Delivery) int {
+	return headerInt(d.Headers[HeaderAttempts])
+}
+
+// headerInt 读取整数消息头，AMQP客户端可能把整数解码为不同宽度的类型
+func headerInt(value interface{}) int {
+	switch v := value.(type) {
+	case int32:
+		return int(v)
+	case int64:
+		return int(v)
+	case int:
+		return v
+	}
+	return 0
+}
+
+// republishing 复制消息用于重新投递，并在头中记录失败次数、失败原因和原队列
+func republishing(d amqp091.Delivery, queue string, attempts int, cause error) amqp091.Publishing {
+	headers := amqp091.Table{}
+	for k, v := range d.Headers {
+		headers[k] = v
+	}
+	lastError := cause.Error()
+	if len(lastError) > maxHeaderErrorLength {
+		lastError = lastError[:maxHeaderErrorLength]
+	}
+	headers[HeaderAttempts] = int32(attempts)
+	headers[HeaderLastError] = lastError
+	headers[HeaderOriginalQueue] = queue
+
+	messageID := d.MessageId
+	if messageID == "" {
+		// 死信管理按消息ID定位消息
+		messageID = uuid.New().String()
+	}
+	return amqp091.Publishing{
+		Headers:      headers,
+		ContentType:  d.ContentType,
+		DeliveryMode: amqp091.Persistent,
+		MessageId:    messageID,
+		Timestamp:    d.Timestamp,
+		Body:         d.Body,
+	}
+}
+
+// handleFailure 处理消费失败的消息：未达到最大次数时投递到重试队列延迟重新消费，否则转入死信队列。
+// 转移成功后才确认原消息，转移失败时原消息重新入队
+func (b *Bus) handleFailure(ctx context.Context, queue string, policy RetryPolicy, d amqp091.Delivery, cause error) {
+	attempts := deliveryAttempts(d) + 1
+
+	var (
+		exchange, routingKey string
+		msg                  = republishing(d, queue, attempts, cause)
+	)
+	if errors.Is(cause, errMalformedMessage) || attempts >= policy.MaxAttempts {
+		exchange, routingKey = DeadLetterExchange, queue
+		msg.Headers[HeaderDeadLetteredAt] = time.Now().Unix()
+		hlog.Errorf("Message %s from %s dead-lettered after %d attempts: %v", msg.MessageId, queue, attempts, cause)
+	} else {
+		delay := policy.delay(attempts)
+		exchange, routingKey = RetryExchange(queue), delay.String()
+		hlog.Warnf("Message %s from %s failed (attempt %d), retrying in %v: %v", msg.MessageId, queue, attempts, delay, cause)
+	}
+
+	if err := b.publisher.publish(ctx, exchange, routingKey, msg); err != nil {
+		hlog.Errorf("Failed to move message %s from %s to %s: %v", msg.MessageId, queue, exchange, err)
+		d.Nack(false, true)
+		return
+	}
+	d.Ack(false)
+}
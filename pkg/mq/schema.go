@@ -0,0 +1,130 @@
+package mq
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// 事件注册表：每种事件类型注册一次名称、版本、交换机和默认消费组的队列，
+// Publish和Subscribe按Go类型查找注册信息，不再为每种事件单独编写发布和消费方法。
+// 事件名和版本写入消息头，消费端据此拒绝投错队列的消息，并把新版本生产者发出的消息留给升级后的消费者
+
+const (
+	HeaderEventName    = "x-event"         // 事件名
+	HeaderEventVersion = "x-event-version" // 事件结构版本
+)
+
+// QueueBinding 默认消费组的一个队列及其绑定的路由键
+type QueueBinding struct {
+	Queue      string
+	RoutingKey string
+}
+
+// EventSchema 事件类型T的注册信息
+type EventSchema[T any] struct {
+	Name    string // 事件名，全局唯一
+	Version int    // 当前结构版本，只做向后兼容的修改时不需要升级
+	// 事件发布到的direct交换机；为空时经默认交换机直接投递到唯一的默认队列，这类事件不支持其他消费组
+	Exchange string
+	Queues   []QueueBinding // 默认消费组的队列
+
+	RoutingKey   func(event *T) string // 为空时路由键为""
+	AggregateKey func(event *T) string // 发件箱中按聚合键保证发布顺序，为空时每条消息独立
+}
+
+// schema 注册表中类型擦除后的事件信息
+type schema struct {
+	name         string
+	version      int
+	exchange     string
+	queues       []QueueBinding
+	routingKey   func(event any) string
+	aggregateKey func(event any) string
+}
+
+var registry = struct {
+	sync.RWMutex
+	byType map[reflect.Type]*schema
+	byName map[string]*schema
+}{
+	byType: make(map[reflect.Type]*schema),
+	byName: make(map[string]*schema),
+}
+
+// RegisterEvent 注册事件类型T，应在包初始化时调用，名称或类型重复时panic
+func RegisterEvent[T any](s EventSchema[T]) {
+	if s.Name == "" || s.Version <= 0 || len(s.Queues) == 0 {
+		panic(fmt.Sprintf("mq: invalid schema for event %q", s.Name))
+	}
+	if s.Exchange == "" && (len(s.Queues) != 1 || s.Queues[0].RoutingKey != "") {
+		panic(fmt.Sprintf("mq: event %q without exchange must have exactly one queue", s.Name))
+	}
+
+	registered := &schema{
+		name:     s.Name,
+		version:  s.Version,
+		exchange: s.Exchange,
+		queues:   s.Queues,
+		routingKey: func(event any) string {
+			if s.RoutingKey == nil {
+				return ""
+			}
+			return s.RoutingKey(event.(*T))
+		},
+		aggregateKey: func(event any) string {
+			if s.AggregateKey == nil {
+				return ""
+			}
+			return s.AggregateKey(event.(*T))
+		},
+	}
+
+	typ := reflect.TypeFor[T]()
+	registry.Lock()
+	defer registry.Unlock()
+	if _, ok := registry.byType[typ]; ok {
+		panic(fmt.Sprintf("mq: event type %s registered twice", typ))
+	}
+	if _, ok := registry.byName[s.Name]; ok {
+		panic(fmt.Sprintf("mq: event %q registered twice", s.Name))
+	}
+	registry.byType[typ] = registered
+	registry.byName[s.Name] = registered
+}
+
+// schemaOf 查找事件类型T的注册信息
+func schemaOf[T any]() (*schema, error) {
+	typ := reflect.TypeFor[T]()
+	registry.RLock()
+	defer registry.RUnlock()
+	s, ok := registry.byType[typ]
+	if !ok {
+		return nil, fmt.Errorf("event type %s is not registered", typ)
+	}
+	return s, nil
+}
+
+// schemas 所有已注册的事件，按名称排序
+func schemas() []*schema {
+	registry.RLock()
+	defer registry.RUnlock()
+	result := make([]*schema, 0, len(registry.byName))
+	for _, s := range registry.byName {
+		result = append(result, s)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].name < result[j].name })
+	return result
+}
+
+// ConsumerQueues 本进程注册的所有事件的默认消费队列，它们都配置了重试和死信队列
+func ConsumerQueues() []string {
+	var queues []string
+	for _, s := range schemas() {
+		for _, binding := range s.queues {
+			queues = append(queues, binding.Queue)
+		}
+	}
+	return queues
+}
@@ -0,0 +1,174 @@
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/google/uuid"
+	"github.com/rabbitmq/amqp091-go"
+)
+
+// Handler 事件处理函数，返回错误时消息按重试策略延迟重新投递
+type Handler[T any] func(ctx context.Context, event *T) error
+
+// SubscribeOptions 订阅参数，零值字段使用BusConfig中的默认值
+type SubscribeOptions struct {
+	// 消费组：同组的实例竞争消费同一个队列，不同组各自收到全部事件；
+	// 为空时使用事件注册的默认队列，否则使用<Group>.<默认队列名>
+	Group       string
+	Prefetch    int          // 未确认消息的上限
+	Concurrency int          // 并发处理的协程数，大于1时同一队列的消息不再按顺序处理
+	Retry       *RetryPolicy // 消费失败的重试策略
+}
+
+// Subscribe 订阅事件类型T，事件有多个队列时（如不同优先级）每个队列独立消费
+func Subscribe[T any](bus *Bus, opts *SubscribeOptions, handler Handler[T]) error {
+	s, err := schemaOf[T]()
+	if err != nil {
+		return err
+	}
+	if opts == nil {
+		opts = &SubscribeOptions{}
+	}
+
+	queues := s.queues
+	if opts.Group != "" {
+		if s.exchange == "" {
+			return fmt.Errorf("event %s is delivered to a single queue and does not support consumer groups", s.name)
+		}
+		if strings.ContainsAny(opts.Group, ". ") {
+			return fmt.Errorf("invalid consumer group %q", opts.Group)
+		}
+		queues = make([]QueueBinding, 0, len(s.queues))
+		for _, binding := range s.queues {
+			queues = append(queues, QueueBinding{
+				Queue:      opts.Group + "." + binding.Queue,
+				RoutingKey: binding.RoutingKey,
+			})
+		}
+	}
+
+	handle := func(ctx context.Context, d amqp091.Delivery) error {
+		if err := checkEventHeaders(s, d); err != nil {
+			return err
+		}
+		var event T
+		if err := json.Unmarshal(d.Body, &event); err != nil {
+			return fmt.Errorf("%w: %v", errMalformedMessage, err)
+		}
+		return handler(ctx, &event)
+	}
+
+	for _, binding := range queues {
+		if err := bus.subscribe(s, binding, opts, handle); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkEventHeaders 校验消息头中的事件名和版本，注册表之前或外部系统发布的消息不带事件头，直接按当前结构解析
+func checkEventHeaders(s *schema, d amqp091.Delivery) error {
+	name, ok := d.Headers[HeaderEventName].(string)
+	if !ok {
+		return nil
+	}
+	if name != s.name {
+		return fmt.Errorf("%w: expected %s event, got %s", errMalformedMessage, s.name, name)
+	}
+	// 新版本生产者先于消费者上线时，消息经重试进入死信队列，消费者升级后重放
+	if version := headerInt(d.Headers[HeaderEventVersion]); version > s.version {
+		return fmt.Errorf("%s event version %d is newer than supported version %d", s.name, version, s.version)
+	}
+	return nil
+}
+
+// subscription 一个队列上的消费者
+type subscription struct {
+	queue   string
+	channel *amqp091.Channel
+	tag     string
+}
+
+// stop 停止接收新消息，已经推送到本地的消息处理完后处理协程退出
+func (sub *subscription) stop() {
+	if err := sub.channel.Cancel(sub.tag, false); err != nil {
+		hlog.Warnf("Failed to cancel consumer on %s: %v", sub.queue, err)
+	}
+}
+
+func (b *Bus) subscribe(s *schema, binding QueueBinding, opts *SubscribeOptions, handle func(ctx context.Context, d amqp091.Delivery) error) error {
+	prefetch, concurrency, policy := b.config.Prefetch, b.config.Concurrency, b.config.Retry
+	if opts.Prefetch > 0 {
+		prefetch = opts.Prefetch
+	}
+	if opts.Concurrency > 0 {
+		concurrency = opts.Concurrency
+	}
+	if opts.Retry != nil {
+		policy = *opts.Retry
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return fmt.Errorf("event bus is closed")
+	}
+
+	ch, err := b.conn.Channel()
+	if err != nil {
+		return fmt.Errorf("failed to open a channel: %w", err)
+	}
+	if err := ch.Qos(prefetch, 0, false); err != nil {
+		ch.Close()
+		return fmt.Errorf("failed to set QoS: %w", err)
+	}
+	// 消费组的队列在首次订阅时创建
+	if opts.Group != "" {
+		if err := b.declareQueues(ch, s, []QueueBinding{binding}); err != nil {
+			ch.Close()
+			return err
+		}
+	}
+
+	sub := &subscription{
+		queue:   binding.Queue,
+		channel: ch,
+		tag:     fmt.Sprintf("%s-%s", binding.Queue, uuid.New().String()),
+	}
+	deliveries, err := ch.Consume(
+		sub.queue,
+		sub.tag,
+		false, // auto-ack (设置为false，手动确认)
+		false, // exclusive
+		false, // no-local
+		false, // no-wait
+		nil,   // args
+	)
+	if err != nil {
+		ch.Close()
+		return fmt.Errorf("failed to register a consumer on %s: %w", sub.queue, err)
+	}
+	b.subscriptions = append(b.subscriptions, sub)
+
+	for i := 0; i < concurrency; i++ {
+		b.wg.Add(1)
+		go func() {
+			defer b.wg.Done()
+			for d := range deliveries {
+				if err := handle(b.ctx, d); err != nil {
+					hlog.Errorf("Failed to handle %s event from %s: %v", s.name, sub.queue, err)
+					b.handleFailure(b.ctx, sub.queue, policy, d, err)
+					continue
+				}
+				d.Ack(false) // 确认消息
+			}
+		}()
+	}
+
+	hlog.Infof("Subscribed to %s events on %s (prefetch %d, concurrency %d)", s.name, sub.queue, prefetch, concurrency)
+	return nil
+}
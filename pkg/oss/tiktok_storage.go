@@ -486,6 +486,32 @@ func (ts *TikTokStorage) UploadUserAvatar(ctx context.Context, userID int64, dat
 	return avatarURLs, nil
 }
 
+// UploadUserBackground 上传用户主页背景图，新图片直接覆盖旧图片
+func (ts *TikTokStorage) UploadUserBackground(ctx context.Context, userID int64, data []byte, contentType string) (string, error) {
+	switch contentType {
+	case "image/jpeg", "image/jpg", "image/png":
+	default:
+		return "", fmt.Errorf("unsupported image format: %s", contentType)
+	}
+
+	// 确保用户目录存在
+	if err := ts.ensureUserDirectoryStructure(ctx, userID); err != nil {
+		return "", err
+	}
+
+	backgroundPath := ts.getUserBackgroundPath(userID)
+	_, err := ts.client.PutObject(ctx, BUCKET_USER_CONTENT, backgroundPath, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload background: %w", err)
+	}
+
+	hlog.Infof("Successfully uploaded background for user %d", userID)
+	// 路径固定不变，附加版本号避免客户端缓存旧图片
+	return fmt.Sprintf("%s?v=%d", ts.generateURL(BUCKET_USER_CONTENT, backgroundPath), time.Now().Unix()), nil
+}
+
 // 删除用户旧头像
 func (ts *TikTokStorage) deleteUserAvatars(ctx context.Context, userID int64) {
 	sizes := []string{"small", "medium", "large"}
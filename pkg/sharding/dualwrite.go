@@ -0,0 +1,230 @@
+package sharding
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 重分片期间的双写：Router.Execute在写连接上附带另一侧拓扑的目标位置，
+// 写语句在原位置执行成功后，由gorm回调把同一条SQL改写表名后在目标位置重放。
+// 重放只发生在原位置提交之后：不在事务中的语句在自身提交后立即重放，显式事务中的语句先暂存，
+// 由Router.Execute在fn成功返回（事务已提交）后重放，fn返回错误时丢弃，回滚的写入不会到达目标位置。
+// 自增主键的INSERT重放时带上原位置生成的主键，保证切换后按主键查询和(created_at, id)游标不受影响；
+// 代理主键的表（TableRule.SurrogateKey）在目标表中重新生成主键，重放的INSERT不带主键，以免与复制过去的行冲突。
+// 同一连接上对同分片子表（TableRule.CoSharded）的写入改写到子表在目标位置的分表，与主表一起重放。
+// 重放失败只记录日志，两侧的差异由迁移工具的校验步骤修复。
+
+const dualWriteSettingKey = "sharding:dual_write"
+
+// dualWriteTarget 双写的目标位置以及等待提交后重放的语句
+type dualWriteTarget struct {
+	db     *gorm.DB
+	tables map[string]dualWriteTable // 原位置的物理表名 -> 目标位置的分表
+
+	mu      sync.Mutex
+	pending []replayStatement
+}
+
+// dualWriteTable 双写目标位置中的一张分表
+type dualWriteTable struct {
+	name string
+	// surrogateKey 目标表重新生成主键，重放INSERT时不携带原位置的主键
+	surrogateKey bool
+}
+
+// replayStatement 一条改写到目标位置的写语句
+type replayStatement struct {
+	table string
+	sql   string
+	vars  []interface{}
+}
+
+// WithDualWrite 为写连接附加双写目标，target为nil时原样返回
+func WithDualWrite(db *gorm.DB, sourceTable string, target *gorm.DB, targetTable string) *gorm.DB {
+	if target == nil {
+		return db
+	}
+	return db.Set(dualWriteSettingKey, &dualWriteTarget{
+		db:     target,
+		tables: map[string]dualWriteTable{sourceTable: {name: targetTable}},
+	})
+}
+
+// RegisterDualWrite 在连接上注册双写回调，每个主库连接打开后调用一次
+// 回调排在gorm默认事务提交之后，语句自身的事务回滚时db.Error不为空，不会重放
+func RegisterDualWrite(db *gorm.DB) error {
+	callback := db.Callback()
+	if err := callback.Create().After("gorm:commit_or_rollback_transaction").Register("sharding:dual_write_create", replayWrite); err != nil {
+		return err
+	}
+	if err := callback.Update().After("gorm:commit_or_rollback_transaction").Register("sharding:dual_write_update", replayWrite); err != nil {
+		return err
+	}
+	if err := callback.Delete().After("gorm:commit_or_rollback_transaction").Register("sharding:dual_write_delete", replayWrite); err != nil {
+		return err
+	}
+	return callback.Raw().After("gorm:raw").Register("sharding:dual_write_raw", replayWrite)
+}
+
+// dualWriteTargetOf 连接上附带的双写目标，没有时返回nil
+func dualWriteTargetOf(db *gorm.DB) *dualWriteTarget {
+	value, ok := db.Get(dualWriteSettingKey)
+	if !ok {
+		return nil
+	}
+	target, _ := value.(*dualWriteTarget)
+	return target
+}
+
+// applyRule 按逻辑表的规则补全双写目标：标记代理主键，加入同分片子表在两侧的分表。
+// 每次Route都生成新的双写目标，修改不会影响其他请求
+func (t *dualWriteTarget) applyRule(rule TableRule, sourceTable string) {
+	main, ok := t.tables[sourceTable]
+	if !ok {
+		return
+	}
+	main.surrogateKey = rule.SurrogateKey
+	t.tables[sourceTable] = main
+
+	sourceSuffix := strings.TrimPrefix(sourceTable, rule.Name)
+	targetSuffix := strings.TrimPrefix(main.name, rule.Name)
+	for _, co := range rule.CoSharded {
+		t.tables[co.Name+sourceSuffix] = dualWriteTable{name: co.Name + targetSuffix, surrogateKey: co.SurrogateKey}
+	}
+}
+
+// flushDualWrite 在Router.Execute的fn返回后调用，fn成功时重放暂存的语句，失败时丢弃
+func flushDualWrite(ctx context.Context, db *gorm.DB, err error) {
+	target := dualWriteTargetOf(db)
+	if target == nil {
+		return
+	}
+	target.mu.Lock()
+	pending := target.pending
+	target.pending = nil
+	target.mu.Unlock()
+	if err != nil {
+		return
+	}
+	for _, stmt := range pending {
+		target.exec(ctx, stmt)
+	}
+}
+
+func replayWrite(db *gorm.DB) {
+	if db.Error != nil || db.Statement.SQL.Len() == 0 {
+		return
+	}
+	target := dualWriteTargetOf(db)
+	if target == nil {
+		return
+	}
+	table, ok := target.tables[db.Statement.Table]
+	if !ok {
+		return // 同一事务中对其他表的写入不需要双写
+	}
+
+	var stmt replayStatement
+	ok = false
+	if !table.surrogateKey {
+		stmt, ok = insertWithPrimaryKey(db, table.name)
+	}
+	if !ok {
+		quote := func(table string) string {
+			var b strings.Builder
+			db.Dialector.QuoteTo(&b, table)
+			return b.String()
+		}
+		stmt = replayStatement{
+			table: table.name,
+			sql:   strings.ReplaceAll(db.Statement.SQL.String(), quote(db.Statement.Table), quote(table.name)),
+			vars:  db.Statement.Vars,
+		}
+	}
+
+	// 仍在显式事务中的语句等事务提交后再重放
+	if _, inTx := db.Statement.ConnPool.(gorm.TxCommitter); inTx {
+		target.mu.Lock()
+		target.pending = append(target.pending, stmt)
+		target.mu.Unlock()
+		return
+	}
+	target.exec(db.Statement.Context, stmt)
+}
+
+func (t *dualWriteTarget) exec(ctx context.Context, stmt replayStatement) {
+	if err := t.db.WithContext(ctx).Exec(stmt.sql, stmt.vars...).Error; err != nil {
+		hlog.CtxWarnf(ctx, "Dual write to %s failed, left for reshard verification: %v", stmt.table, err)
+	}
+}
+
+// insertWithPrimaryKey 为没有指定自增主键的Create重新生成写入目标表的INSERT，带上原位置生成的主键；
+// 不是Create、主键已在列中或有主键未回填时返回false，按原SQL重放
+func insertWithPrimaryKey(db *gorm.DB, targetTable string) (replayStatement, bool) {
+	stmt := db.Statement
+	if stmt.Schema == nil || stmt.Schema.PrioritizedPrimaryField == nil || !stmt.Schema.PrioritizedPrimaryField.AutoIncrement {
+		return replayStatement{}, false
+	}
+	valuesClause, ok := stmt.Clauses["VALUES"]
+	if !ok {
+		return replayStatement{}, false
+	}
+	values, ok := valuesClause.Expression.(clause.Values)
+	if !ok || len(values.Values) == 0 || len(stmt.BuildClauses) == 0 {
+		return replayStatement{}, false
+	}
+	field := stmt.Schema.PrioritizedPrimaryField
+	for _, column := range values.Columns {
+		if column.Name == field.DBName {
+			return replayStatement{}, false
+		}
+	}
+
+	// 每行VALUES按顺序对应ReflectValue中的一个元素
+	var rows []reflect.Value
+	switch stmt.ReflectValue.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < stmt.ReflectValue.Len(); i++ {
+			rows = append(rows, reflect.Indirect(stmt.ReflectValue.Index(i)))
+		}
+	case reflect.Struct:
+		rows = append(rows, stmt.ReflectValue)
+	}
+	if len(rows) != len(values.Values) {
+		return replayStatement{}, false
+	}
+
+	withKey := clause.Values{
+		Columns: append([]clause.Column{{Name: field.DBName}}, values.Columns...),
+		Values:  make([][]interface{}, len(values.Values)),
+	}
+	for i, row := range rows {
+		key, zero := field.ValueOf(stmt.Context, row)
+		if zero {
+			return replayStatement{}, false
+		}
+		withKey.Values[i] = append([]interface{}{key}, values.Values[i]...)
+	}
+
+	replay := &gorm.Statement{
+		DB:      db,
+		Context: stmt.Context,
+		Table:   targetTable,
+		Clauses: make(map[string]clause.Clause, len(stmt.BuildClauses)),
+	}
+	for _, name := range stmt.BuildClauses {
+		if c, ok := stmt.Clauses[name]; ok {
+			replay.Clauses[name] = c
+		}
+	}
+	valuesClause.Expression = withKey
+	replay.Clauses["VALUES"] = valuesClause
+	replay.Build(stmt.BuildClauses...)
+	return replayStatement{table: targetTable, sql: replay.SQL.String(), vars: replay.Vars}, true
+}
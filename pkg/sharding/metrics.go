@@ -0,0 +1,117 @@
+package sharding
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	// DefaultSlowQueryThreshold 单个分片上耗时超过该值的操作计为慢查询
+	DefaultSlowQueryThreshold = 200 * time.Millisecond
+	// DefaultMetricsLogInterval 分片指标输出到日志的间隔
+	DefaultMetricsLogInterval = time.Minute
+)
+
+// ShardStat 单个物理分表的访问指标
+type ShardStat struct {
+	Shard        string // 分片标识，如db_0.comments_3
+	Reads        int64
+	Writes       int64
+	Errors       int64
+	SlowQueries  int64
+	TotalLatency time.Duration
+	MaxLatency   time.Duration
+}
+
+// AvgLatency 平均耗时
+func (s ShardStat) AvgLatency() time.Duration {
+	if total := s.Reads + s.Writes; total > 0 {
+		return s.TotalLatency / time.Duration(total)
+	}
+	return 0
+}
+
+// ShardMetrics 按物理分表统计读写次数、错误和耗时，用于发现热点分片和慢分片
+type ShardMetrics struct {
+	mu            sync.Mutex
+	stats         map[string]*ShardStat
+	slowThreshold time.Duration
+}
+
+// NewShardMetrics 创建分片指标，slowThreshold不大于0时使用DefaultSlowQueryThreshold
+func NewShardMetrics(slowThreshold time.Duration) *ShardMetrics {
+	if slowThreshold <= 0 {
+		slowThreshold = DefaultSlowQueryThreshold
+	}
+	return &ShardMetrics{
+		stats:         make(map[string]*ShardStat),
+		slowThreshold: slowThreshold,
+	}
+}
+
+// Observe 记录一次分片操作
+func (m *ShardMetrics) Observe(shard Shard, write bool, latency time.Duration, err error) {
+	key := shard.String()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stat, ok := m.stats[key]
+	if !ok {
+		stat = &ShardStat{Shard: key}
+		m.stats[key] = stat
+	}
+	if write {
+		stat.Writes++
+	} else {
+		stat.Reads++
+	}
+	if err != nil {
+		stat.Errors++
+	}
+	if latency >= m.slowThreshold {
+		stat.SlowQueries++
+	}
+	stat.TotalLatency += latency
+	if latency > stat.MaxLatency {
+		stat.MaxLatency = latency
+	}
+}
+
+// Snapshot 当前所有分片指标的副本，按分片标识排序
+func (m *ShardMetrics) Snapshot() []ShardStat {
+	m.mu.Lock()
+	result := make([]ShardStat, 0, len(m.stats))
+	for _, stat := range m.stats {
+		result = append(result, *stat)
+	}
+	m.mu.Unlock()
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Shard < result[j].Shard })
+	return result
+}
+
+// Reset 清空指标，返回清空前的快照
+func (m *ShardMetrics) Reset() []ShardStat {
+	m.mu.Lock()
+	stats := m.stats
+	m.stats = make(map[string]*ShardStat)
+	m.mu.Unlock()
+
+	result := make([]ShardStat, 0, len(stats))
+	for _, stat := range stats {
+		result = append(result, *stat)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Shard < result[j].Shard })
+	return result
+}
+
+// logMetrics 输出一个周期内各分片的指标并清空
+func (m *ShardMetrics) logMetrics(name string) {
+	for _, stat := range m.Reset() {
+		hlog.Infof("Shard Metrics %s %s - Reads: %d, Writes: %d, Errors: %d, Slow: %d, Avg Latency: %v, Max Latency: %v",
+			name, stat.Shard, stat.Reads, stat.Writes, stat.Errors, stat.SlowQueries, stat.AvgLatency(), stat.MaxLatency)
+	}
+}
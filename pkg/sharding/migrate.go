@@ -0,0 +1,580 @@
+package sharding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TableSpec 参与重分片的逻辑表
+type TableSpec struct {
+	Prefix     string   // 物理表名前缀，如follows
+	ShardKey   string   // 分片键列，Parent不为nil时为父表中的分片键列
+	CursorKey  string   // 源表中单调递增的主键列，用于分批扫描
+	UniqueKeys []string // 唯一标识一行的列，复制和校验都按这些列匹配
+	Surrogate  bool     // CursorKey是否为各分表独立自增的代理主键，是则复制时不携带，由目标表重新生成
+	Strategy   Strategy // 与服务中该逻辑表的分片策略一致，为nil时使用取模分片
+	// Parent 随父表分布且自身没有分片键列的子表（如comment_likes随comments），
+	// 分片键从同一分库中同下标的父表行读取；父表的TableSpec必须排在子表之前
+	Parent *ParentSpec
+}
+
+// ParentSpec 子表通过外键列引用的父表
+type ParentSpec struct {
+	Prefix    string // 父表的物理表名前缀
+	Column    string // 子表中引用父表的列
+	ParentKey string // 父表中被引用的唯一列
+}
+
+// location 一个物理分表
+type location struct {
+	dbIndex int
+	dsn     string
+	table   string
+}
+
+func (l location) key() string {
+	return fmt.Sprintf("%d/%s", l.dbIndex, l.table)
+}
+
+// locations 拓扑中某个逻辑表的全部物理分表
+func locations(m *ShardMap, prefix string) []location {
+	dsns := m.MasterDSNs()
+	shards := m.Shards(prefix)
+	result := make([]location, 0, len(shards))
+	for _, shard := range shards {
+		result = append(result, location{dbIndex: shard.Database, dsn: dsns[shard.Database], table: shard.TableName})
+	}
+	return result
+}
+
+// parentTable 与loc同分库同下标的父表
+func (l location) parentTable(spec TableSpec) string {
+	return spec.Parent.Prefix + strings.TrimPrefix(l.table, spec.Prefix)
+}
+
+func locate(m *ShardMap, spec TableSpec, shardKey int64) location {
+	shard := m.Locate(spec.Strategy, shardKey, spec.Prefix)
+	return location{
+		dbIndex: shard.Database,
+		dsn:     m.MasterDSNs()[shard.Database],
+		table:   shard.TableName,
+	}
+}
+
+// sameTable 两个位置是否为同一张物理表
+func sameTable(a, b location) bool {
+	return a.dsn == b.dsn && a.table == b.table
+}
+
+// Migrator 在两个版本的拓扑之间复制和校验数据，所有读写都直接访问主库
+type Migrator struct {
+	metaDB    *gorm.DB
+	task      *ReshardTask
+	from      *ShardMap
+	to        *ShardMap
+	specs     []TableSpec
+	batchSize int
+	conns     map[string]*gorm.DB
+	progress  map[string]int64
+
+	// Logf 输出进度，为nil时不输出
+	Logf func(format string, args ...interface{})
+}
+
+// NewMigrator 为重分片任务创建迁移器，打开两个拓扑中所有分库的连接
+func NewMigrator(metaDB *gorm.DB, task *ReshardTask, from, to *ShardMap, specs []TableSpec, batchSize int) (*Migrator, error) {
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	m := &Migrator{
+		metaDB:    metaDB,
+		task:      task,
+		from:      from,
+		to:        to,
+		specs:     specs,
+		batchSize: batchSize,
+		conns:     make(map[string]*gorm.DB),
+		progress:  make(map[string]int64),
+	}
+	if task.Progress != "" {
+		if err := json.Unmarshal([]byte(task.Progress), &m.progress); err != nil {
+			return nil, fmt.Errorf("invalid reshard progress: %w", err)
+		}
+	}
+	for _, dsn := range append(from.MasterDSNs(), to.MasterDSNs()...) {
+		if _, ok := m.conns[dsn]; ok {
+			continue
+		}
+		db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{SkipDefaultTransaction: true})
+		if err != nil {
+			m.Close()
+			return nil, fmt.Errorf("failed to open shard database: %w", err)
+		}
+		m.conns[dsn] = db
+	}
+	return m, nil
+}
+
+// Close 关闭迁移器打开的连接
+func (m *Migrator) Close() {
+	for _, db := range m.conns {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	}
+}
+
+func (m *Migrator) logf(format string, args ...interface{}) {
+	if m.Logf != nil {
+		m.Logf(format, args...)
+	}
+}
+
+// EnsureTables 按源拓扑第一张分表的表结构在目标拓扑中创建缺失的分表
+func (m *Migrator) EnsureTables(ctx context.Context) error {
+	for _, spec := range m.specs {
+		template := fmt.Sprintf("%s_0", spec.Prefix)
+		var name, ddl string
+		if err := m.conns[m.from.MasterDSNs()[0]].WithContext(ctx).
+			Raw(fmt.Sprintf("SHOW CREATE TABLE `%s`", template)).Row().Scan(&name, &ddl); err != nil {
+			return fmt.Errorf("failed to read schema of %s: %w", template, err)
+		}
+		for _, loc := range locations(m.to, spec.Prefix) {
+			stmt := strings.Replace(ddl, fmt.Sprintf("CREATE TABLE `%s`", template),
+				fmt.Sprintf("CREATE TABLE IF NOT EXISTS `%s`", loc.table), 1)
+			if err := m.conns[loc.dsn].WithContext(ctx).Exec(stmt).Error; err != nil {
+				return fmt.Errorf("failed to create %s: %w", loc.key(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// Copy 把源拓扑中所有需要移动的行复制到目标位置，进度按源表游标保存在任务中，中断后可以继续
+func (m *Migrator) Copy(ctx context.Context) (int64, error) {
+	var copied int64
+	for _, spec := range m.specs {
+		for _, src := range locations(m.from, spec.Prefix) {
+			n, err := m.scan(ctx, spec, src, m.progress[src.key()], func(rows []map[string]interface{}, cursor int64) error {
+				moved, err := m.copyRows(ctx, spec, src, rows)
+				if err != nil {
+					return err
+				}
+				copied += moved
+				m.progress[src.key()] = cursor
+				return m.saveProgress(ctx, moved)
+			})
+			if err != nil {
+				return copied, fmt.Errorf("failed to copy %s: %w", src.key(), err)
+			}
+			m.logf("copied %s: scanned %d rows, total copied %d", src.key(), n, copied)
+		}
+	}
+	return copied, nil
+}
+
+// Verify 校验目标位置的数据：按校验和比对源行与目标行，不一致或缺失的行从源表重新复制；
+// 目标位置中源表已不存在的行被删除。返回发现并修复的不一致行数
+func (m *Migrator) Verify(ctx context.Context) (int64, error) {
+	var mismatched int64
+	for _, spec := range m.specs {
+		for _, src := range locations(m.from, spec.Prefix) {
+			_, err := m.scan(ctx, spec, src, 0, func(rows []map[string]interface{}, _ int64) error {
+				n, err := m.verifyRows(ctx, spec, src, rows)
+				mismatched += n
+				return err
+			})
+			if err != nil {
+				return mismatched, fmt.Errorf("failed to verify %s: %w", src.key(), err)
+			}
+		}
+		for _, dst := range locations(m.to, spec.Prefix) {
+			_, err := m.scan(ctx, spec, dst, 0, func(rows []map[string]interface{}, _ int64) error {
+				n, err := m.removeOrphans(ctx, spec, dst, rows)
+				mismatched += n
+				return err
+			})
+			if err != nil {
+				return mismatched, fmt.Errorf("failed to verify %s: %w", dst.key(), err)
+			}
+		}
+		m.logf("verified %s: %d mismatched rows repaired so far", spec.Prefix, mismatched)
+	}
+	return mismatched, nil
+}
+
+// Cleanup 删除stale拓扑的分表中按active拓扑不属于该表的行，用于切换完成或任务放弃后清理残留数据；
+// 子表先于父表清理，清理子表时父表行还在，能查到分片键
+func (m *Migrator) Cleanup(ctx context.Context, active, stale *ShardMap) (int64, error) {
+	var removed int64
+	for i := len(m.specs) - 1; i >= 0; i-- {
+		spec := m.specs[i]
+		for _, loc := range locations(stale, spec.Prefix) {
+			_, err := m.scan(ctx, spec, loc, 0, func(rows []map[string]interface{}, _ int64) error {
+				keys, err := m.shardKeys(ctx, spec, loc, rows)
+				if err != nil {
+					return err
+				}
+				var misplaced []map[string]interface{}
+				for i, row := range rows {
+					key, ok := keys[i]
+					if ok && !sameTable(locate(active, spec, key), loc) {
+						misplaced = append(misplaced, row)
+					}
+				}
+				if err := m.deleteRows(ctx, spec, loc, misplaced); err != nil {
+					return err
+				}
+				removed += int64(len(misplaced))
+				return nil
+			})
+			if err != nil {
+				return removed, fmt.Errorf("failed to clean up %s: %w", loc.key(), err)
+			}
+		}
+		m.logf("cleaned up %s: %d rows removed so far", spec.Prefix, removed)
+	}
+	return removed, nil
+}
+
+// scan 从cursor之后按CursorKey分批读取一张分表，返回读取的行数
+func (m *Migrator) scan(ctx context.Context, spec TableSpec, loc location, cursor int64,
+	fn func(rows []map[string]interface{}, cursor int64) error) (int64, error) {
+	var total int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+		var rows []map[string]interface{}
+		if err := m.conns[loc.dsn].WithContext(ctx).Table(loc.table).
+			Where(fmt.Sprintf("`%s` > ?", spec.CursorKey), cursor).
+			Order(spec.CursorKey).Limit(m.batchSize).
+			Find(&rows).Error; err != nil {
+			return total, err
+		}
+		if len(rows) == 0 {
+			return total, nil
+		}
+		cursor = toInt64(rows[len(rows)-1][spec.CursorKey])
+		total += int64(len(rows))
+		if err := fn(rows, cursor); err != nil {
+			return total, err
+		}
+	}
+}
+
+// copyRows 把一批源行写入各自的目标位置，已在目标位置的行跳过
+func (m *Migrator) copyRows(ctx context.Context, spec TableSpec, src location, rows []map[string]interface{}) (int64, error) {
+	groups, err := m.groupByTarget(ctx, spec, src, rows)
+	if err != nil {
+		return 0, err
+	}
+	var copied int64
+	for dst, group := range groups {
+		if err := m.upsertRows(ctx, spec, dst, group); err != nil {
+			return copied, err
+		}
+		copied += int64(len(group))
+	}
+	return copied, nil
+}
+
+// verifyRows 比对一批源行与目标行，返回重新复制的行数
+func (m *Migrator) verifyRows(ctx context.Context, spec TableSpec, src location, rows []map[string]interface{}) (int64, error) {
+	groups, err := m.groupByTarget(ctx, spec, src, rows)
+	if err != nil {
+		return 0, err
+	}
+	var repaired int64
+	for dst, group := range groups {
+		targets, err := m.findRows(ctx, spec, dst, group)
+		if err != nil {
+			return repaired, err
+		}
+		var stale []map[string]interface{}
+		for _, row := range group {
+			target, ok := targets[rowKey(row, spec.UniqueKeys)]
+			if !ok || rowChecksum(row, spec) != rowChecksum(target, spec) {
+				stale = append(stale, row)
+			}
+		}
+		if len(stale) == 0 {
+			continue
+		}
+		// 重新读取源行，避免用扫描时的旧值覆盖期间双写的新值
+		fresh, err := m.findRows(ctx, spec, src, stale)
+		if err != nil {
+			return repaired, err
+		}
+		latest := make([]map[string]interface{}, 0, len(fresh))
+		for _, row := range fresh {
+			latest = append(latest, row)
+		}
+		if err := m.upsertRows(ctx, spec, dst, latest); err != nil {
+			return repaired, err
+		}
+		repaired += int64(len(stale))
+	}
+	return repaired, nil
+}
+
+// removeOrphans 删除目标分表中源表已不存在的行，返回删除的行数
+func (m *Migrator) removeOrphans(ctx context.Context, spec TableSpec, dst location, rows []map[string]interface{}) (int64, error) {
+	keys, err := m.shardKeys(ctx, spec, dst, rows)
+	if err != nil {
+		return 0, err
+	}
+	bySource := make(map[location][]map[string]interface{})
+	for i, row := range rows {
+		key, ok := keys[i]
+		if !ok {
+			continue // 父表行已不存在，子表行不属于任何位置，不在此处删除
+		}
+		if !sameTable(locate(m.to, spec, key), dst) {
+			continue // 不属于目标拓扑的该分表，由Cleanup处理
+		}
+		src := locate(m.from, spec, key)
+		if sameTable(src, dst) {
+			continue
+		}
+		bySource[src] = append(bySource[src], row)
+	}
+
+	var removed int64
+	for src, group := range bySource {
+		sources, err := m.findRows(ctx, spec, src, group)
+		if err != nil {
+			return removed, err
+		}
+		var orphans []map[string]interface{}
+		for _, row := range group {
+			if _, ok := sources[rowKey(row, spec.UniqueKeys)]; !ok {
+				orphans = append(orphans, row)
+			}
+		}
+		if err := m.deleteRows(ctx, spec, dst, orphans); err != nil {
+			return removed, err
+		}
+		removed += int64(len(orphans))
+	}
+	return removed, nil
+}
+
+// groupByTarget 按目标位置分组，跳过目标位置与源位置相同的行以及找不到父表行的子表行
+func (m *Migrator) groupByTarget(ctx context.Context, spec TableSpec, src location, rows []map[string]interface{}) (map[location][]map[string]interface{}, error) {
+	keys, err := m.shardKeys(ctx, spec, src, rows)
+	if err != nil {
+		return nil, err
+	}
+	groups := make(map[location][]map[string]interface{})
+	for i, row := range rows {
+		key, ok := keys[i]
+		if !ok {
+			continue
+		}
+		dst := locate(m.to, spec, key)
+		if sameTable(src, dst) {
+			continue
+		}
+		groups[dst] = append(groups[dst], row)
+	}
+	return groups, nil
+}
+
+// shardKeys 分表loc中一批行的分片键，按行下标返回；子表行从loc同分库同下标的父表读取，父表行不存在时没有对应的键
+func (m *Migrator) shardKeys(ctx context.Context, spec TableSpec, loc location, rows []map[string]interface{}) (map[int]int64, error) {
+	keys := make(map[int]int64, len(rows))
+	if spec.Parent == nil {
+		for i, row := range rows {
+			keys[i] = toInt64(row[spec.ShardKey])
+		}
+		return keys, nil
+	}
+
+	refs := make([]interface{}, 0, len(rows))
+	for _, row := range rows {
+		refs = append(refs, row[spec.Parent.Column])
+	}
+	var parents []map[string]interface{}
+	if err := m.conns[loc.dsn].WithContext(ctx).Table(loc.parentTable(spec)).
+		Select(spec.Parent.ParentKey, spec.ShardKey).
+		Where(fmt.Sprintf("`%s` IN ?", spec.Parent.ParentKey), refs).
+		Find(&parents).Error; err != nil {
+		return nil, err
+	}
+	byRef := make(map[string]int64, len(parents))
+	for _, parent := range parents {
+		byRef[normalizeValue(parent[spec.Parent.ParentKey])] = toInt64(parent[spec.ShardKey])
+	}
+	for i, row := range rows {
+		if key, ok := byRef[normalizeValue(row[spec.Parent.Column])]; ok {
+			keys[i] = key
+		}
+	}
+	return keys, nil
+}
+
+func (m *Migrator) upsertRows(ctx context.Context, spec TableSpec, dst location, rows []map[string]interface{}) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	values := make([]map[string]interface{}, 0, len(rows))
+	for _, row := range rows {
+		value := make(map[string]interface{}, len(row))
+		for column, v := range row {
+			if spec.Surrogate && column == spec.CursorKey {
+				continue
+			}
+			value[column] = v
+		}
+		values = append(values, value)
+	}
+	columns := make([]string, 0, len(values[0]))
+	for column := range values[0] {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	return m.conns[dst.dsn].WithContext(ctx).Table(dst.table).
+		Clauses(clause.OnConflict{DoUpdates: clause.AssignmentColumns(columns)}).
+		Create(&values).Error
+}
+
+// findRows 按唯一键在分表中查找rows对应的行
+func (m *Migrator) findRows(ctx context.Context, spec TableSpec, loc location, rows []map[string]interface{}) (map[string]map[string]interface{}, error) {
+	result := make(map[string]map[string]interface{}, len(rows))
+	if len(rows) == 0 {
+		return result, nil
+	}
+	var found []map[string]interface{}
+	if err := m.conns[loc.dsn].WithContext(ctx).Table(loc.table).
+		Where(uniqueKeyCondition(spec), uniqueKeyTuples(spec, rows)).
+		Find(&found).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range found {
+		result[rowKey(row, spec.UniqueKeys)] = row
+	}
+	return result, nil
+}
+
+func (m *Migrator) deleteRows(ctx context.Context, spec TableSpec, loc location, rows []map[string]interface{}) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	return m.conns[loc.dsn].WithContext(ctx).
+		Exec(fmt.Sprintf("DELETE FROM `%s` WHERE %s", loc.table, uniqueKeyCondition(spec)), uniqueKeyTuples(spec, rows)).Error
+}
+
+func (m *Migrator) saveProgress(ctx context.Context, copied int64) error {
+	raw, err := json.Marshal(m.progress)
+	if err != nil {
+		return err
+	}
+	m.task.Progress = string(raw)
+	m.task.CopiedRows += copied
+	return m.metaDB.WithContext(ctx).Table(reshardTaskTable).Where("id = ?", m.task.ID).
+		Updates(map[string]interface{}{
+			"progress":    m.task.Progress,
+			"copied_rows": gorm.Expr("copied_rows + ?", copied),
+			"updated_at":  time.Now(),
+		}).Error
+}
+
+func uniqueKeyCondition(spec TableSpec) string {
+	quoted := make([]string, 0, len(spec.UniqueKeys))
+	for _, column := range spec.UniqueKeys {
+		quoted = append(quoted, "`"+column+"`")
+	}
+	return fmt.Sprintf("(%s) IN ?", strings.Join(quoted, ", "))
+}
+
+func uniqueKeyTuples(spec TableSpec, rows []map[string]interface{}) [][]interface{} {
+	tuples := make([][]interface{}, 0, len(rows))
+	for _, row := range rows {
+		tuple := make([]interface{}, 0, len(spec.UniqueKeys))
+		for _, column := range spec.UniqueKeys {
+			tuple = append(tuple, row[column])
+		}
+		tuples = append(tuples, tuple)
+	}
+	return tuples
+}
+
+func rowKey(row map[string]interface{}, columns []string) string {
+	parts := make([]string, 0, len(columns))
+	for _, column := range columns {
+		parts = append(parts, normalizeValue(row[column]))
+	}
+	return strings.Join(parts, "\x00")
+}
+
+// rowChecksum 行内容的校验和，代理主键在两侧不同，不参与计算
+func rowChecksum(row map[string]interface{}, spec TableSpec) uint32 {
+	columns := make([]string, 0, len(row))
+	for column := range row {
+		if spec.Surrogate && column == spec.CursorKey {
+			continue
+		}
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	h := crc32.NewIEEE()
+	for _, column := range columns {
+		fmt.Fprintf(h, "%s=%s;", column, normalizeValue(row[column]))
+	}
+	return h.Sum32()
+}
+
+func normalizeValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return "NULL"
+	case time.Time:
+		return val.UTC().Format(time.RFC3339Nano)
+	case *time.Time:
+		if val == nil {
+			return "NULL"
+		}
+		return val.UTC().Format(time.RFC3339Nano)
+	case []byte:
+		return string(val)
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+func toInt64(v interface{}) int64 {
+	switch val := v.(type) {
+	case int64:
+		return val
+	case int32:
+		return int64(val)
+	case int:
+		return int64(val)
+	case uint64:
+		return int64(val)
+	case uint32:
+		return int64(val)
+	case []byte:
+		n, _ := strconv.ParseInt(string(val), 10, 64)
+		return n
+	case string:
+		n, _ := strconv.ParseInt(val, 10, 64)
+		return n
+	default:
+		n, _ := strconv.ParseInt(fmt.Sprint(val), 10, 64)
+		return n
+	}
+}
@@ -0,0 +1,262 @@
+package sharding
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultReplicaMaxLag        = 5 * time.Second  // 复制延迟超过该值的从库被摘除
+	DefaultReplicaCheckInterval = 10 * time.Second // 从库健康检查间隔
+	replicaCheckTimeout         = 3 * time.Second
+)
+
+// ReplicaSet 一个分片的主库及其从库，读请求在健康的从库间轮询，没有可用从库时回落到主库
+type ReplicaSet struct {
+	name     string
+	master   *gorm.DB
+	replicas []*replica
+	maxLag   time.Duration
+	next     atomic.Uint64
+}
+
+type replica struct {
+	index   int
+	db      *gorm.DB
+	healthy atomic.Bool
+	lag     atomic.Int64 // 最近一次检查到的复制延迟（纳秒）
+}
+
+// NewReplicaSet 创建分片的主从连接集合，name需要在进程内唯一，用于读写一致性标记
+// 从库初始视为健康，由健康检查负责摘除
+func NewReplicaSet(name string, master *gorm.DB, replicas []*gorm.DB, maxLag time.Duration) *ReplicaSet {
+	if maxLag <= 0 {
+		maxLag = DefaultReplicaMaxLag
+	}
+	rs := &ReplicaSet{
+		name:   name,
+		master: master,
+		maxLag: maxLag,
+	}
+	for i, db := range replicas {
+		r := &replica{index: i, db: db}
+		r.healthy.Store(true)
+		rs.replicas = append(rs.replicas, r)
+	}
+	return rs
+}
+
+// Name 分片名称
+func (rs *ReplicaSet) Name() string {
+	return rs.name
+}
+
+// Master 主库连接
+func (rs *ReplicaSet) Master() *gorm.DB {
+	return rs.master
+}
+
+// DB 根据读写类型选择连接：写请求使用主库并标记当前请求写过该分片，
+// 同一请求中写过该分片之后的读请求也走主库，保证读到自己的写入
+func (rs *ReplicaSet) DB(ctx context.Context, write bool) *gorm.DB {
+	if write {
+		MarkWritten(ctx, rs.name)
+		return rs.master
+	}
+	if HasWritten(ctx, rs.name) {
+		return rs.master
+	}
+	return rs.Reader()
+}
+
+// Reader 轮询选择一个健康的从库，全部不可用时返回主库
+func (rs *ReplicaSet) Reader() *gorm.DB {
+	n := len(rs.replicas)
+	if n == 0 {
+		return rs.master
+	}
+	start := rs.next.Add(1)
+	for i := 0; i < n; i++ {
+		r := rs.replicas[(start+uint64(i))%uint64(n)]
+		if r.healthy.Load() {
+			return r.db
+		}
+	}
+	return rs.master
+}
+
+// HealthyReplicas 当前健康的从库数量
+func (rs *ReplicaSet) HealthyReplicas() int {
+	count := 0
+	for _, r := range rs.replicas {
+		if r.healthy.Load() {
+			count++
+		}
+	}
+	return count
+}
+
+// CheckReplicas 检查所有从库的连通性和复制延迟，不可达、复制中断或延迟过大的从库被摘除，恢复后重新加入
+func (rs *ReplicaSet) CheckReplicas(ctx context.Context) {
+	for _, r := range rs.replicas {
+		checkCtx, cancel := context.WithTimeout(ctx, replicaCheckTimeout)
+		lag, err := replicationLag(checkCtx, r.db)
+		cancel()
+
+		healthy := err == nil && lag <= rs.maxLag
+		r.lag.Store(int64(lag))
+		if was := r.healthy.Swap(healthy); was != healthy {
+			if healthy {
+				hlog.Infof("Replica %d of %s is back in rotation, lag: %v", r.index, rs.name, lag)
+			} else {
+				hlog.Warnf("Replica %d of %s ejected, lag: %v, err: %v", r.index, rs.name, lag, err)
+			}
+		}
+	}
+}
+
+// Ping 检查主库连通性
+func (rs *ReplicaSet) Ping(ctx context.Context) error {
+	sqlDB, err := rs.master.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get sql.DB for %s: %w", rs.name, err)
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return fmt.Errorf("failed to ping master of %s: %w", rs.name, err)
+	}
+	return nil
+}
+
+// Close 关闭主库和所有从库连接
+func (rs *ReplicaSet) Close() {
+	dbs := []*gorm.DB{rs.master}
+	for _, r := range rs.replicas {
+		dbs = append(dbs, r.db)
+	}
+	for _, db := range dbs {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	}
+}
+
+// StartReplicaHealthCheck 定期检查sets返回的所有分片的从库，ctx取消时退出
+func StartReplicaHealthCheck(ctx context.Context, interval time.Duration, sets func() []*ReplicaSet) {
+	if interval <= 0 {
+		interval = DefaultReplicaCheckInterval
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			for _, rs := range sets() {
+				rs.CheckReplicas(ctx)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// replicationLag 查询从库的复制延迟，没有配置复制的实例视为没有延迟
+func replicationLag(ctx context.Context, db *gorm.DB) (time.Duration, error) {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return 0, err
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return 0, err
+	}
+
+	// MySQL 8.0.22之后为SHOW REPLICA STATUS，旧版本只支持SHOW SLAVE STATUS
+	rows, err := sqlDB.QueryContext(ctx, "SHOW REPLICA STATUS")
+	if err != nil {
+		rows, err = sqlDB.QueryContext(ctx, "SHOW SLAVE STATUS")
+		if err != nil {
+			return 0, err
+		}
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return 0, rows.Err()
+	}
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	values := make([]sql.RawBytes, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return 0, err
+	}
+
+	for i, column := range columns {
+		if column != "Seconds_Behind_Source" && column != "Seconds_Behind_Master" {
+			continue
+		}
+		// 复制线程停止时该字段为NULL
+		if values[i] == nil {
+			return 0, fmt.Errorf("replication is not running")
+		}
+		var seconds int64
+		if _, err := fmt.Sscan(string(values[i]), &seconds); err != nil {
+			return 0, fmt.Errorf("invalid replication lag %q: %w", values[i], err)
+		}
+		return time.Duration(seconds) * time.Second, nil
+	}
+	return 0, nil
+}
+
+// 读写一致性：同一个请求上下文中写过某个分片后，该分片后续的读请求走主库
+
+type writtenShardsKey struct{}
+
+type writtenShards struct {
+	mu     sync.Mutex
+	shards map[string]struct{}
+}
+
+// WithReadYourWrites 为请求上下文开启读写一致性跟踪，已开启时原样返回
+func WithReadYourWrites(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(writtenShardsKey{}).(*writtenShards); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, writtenShardsKey{}, &writtenShards{shards: make(map[string]struct{})})
+}
+
+// MarkWritten 记录当前请求写过shard，上下文未开启跟踪时忽略
+func MarkWritten(ctx context.Context, shard string) {
+	ws, ok := ctx.Value(writtenShardsKey{}).(*writtenShards)
+	if !ok {
+		return
+	}
+	ws.mu.Lock()
+	ws.shards[shard] = struct{}{}
+	ws.mu.Unlock()
+}
+
+// HasWritten 当前请求是否写过shard
+func HasWritten(ctx context.Context, shard string) bool {
+	ws, ok := ctx.Value(writtenShardsKey{}).(*writtenShards)
+	if !ok {
+		return false
+	}
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	_, written := ws.shards[shard]
+	return written
+}
@@ -0,0 +1,249 @@
+package sharding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// TableRule 逻辑表的分片规则，同一服务中的逻辑表共用一套分库和拓扑，各自选择分片键和策略
+type TableRule struct {
+	Name     string   // 逻辑表名，也是物理表名前缀，物理表为<Name>_<分表索引>
+	ShardKey string   // 分片键列
+	Strategy Strategy // 为nil时使用取模分片
+	// SurrogateKey 主键是各分表独立自增的代理主键，行由唯一键标识；重分片时目标表重新生成主键，
+	// 双写重放INSERT也不携带原位置的主键，与迁移工具中TableSpec.Surrogate一致
+	SurrogateKey bool
+	// CoSharded 与该表使用同一分库和分表下标、没有自己分片规则的子表，如comment_likes_<i>随comments_<i>分布；
+	// 子表通过该表的Execute取得连接后读写，重分片时随该表一起双写
+	CoSharded []CoShardedTable
+}
+
+// CoShardedTable 随主表分布的子表
+type CoShardedTable struct {
+	Name         string // 物理表名前缀，物理表为<Name>_<主表的分表索引>
+	SurrogateKey bool   // 同TableRule.SurrogateKey
+}
+
+// RouterConfig 路由配置
+type RouterConfig struct {
+	TopologyConfig
+	Tables             []TableRule
+	SlowQueryThreshold time.Duration // 单个分片上的慢查询阈值
+	MetricsLogInterval time.Duration // 分片指标输出到日志的间隔，小于0时不输出
+	ScatterConcurrency int           // 跨分片查询时同时访问的分表数
+	ShardTimeout       time.Duration // 跨分片查询时单个分表的超时时间
+}
+
+// Router 分片路由：按逻辑表的规则把分片键路由到物理分表，支持跨分片的扫描、合并排序与计数，
+// 并按物理分表记录访问指标
+type Router struct {
+	name     string
+	topology *Topology
+	tables   map[string]TableRule
+	metrics  *ShardMetrics
+	cancel   context.CancelFunc
+
+	scatterConcurrency int
+	shardTimeout       time.Duration
+}
+
+// NewRouter 创建分片路由并打开各分库连接
+func NewRouter(config *RouterConfig) (*Router, error) {
+	if config == nil {
+		return nil, errors.New("router config cannot be nil")
+	}
+	if len(config.Tables) == 0 {
+		return nil, fmt.Errorf("no table rules for %s", config.Name)
+	}
+
+	tables := make(map[string]TableRule, len(config.Tables))
+	for _, rule := range config.Tables {
+		if rule.Name == "" {
+			return nil, errors.New("table rule name cannot be empty")
+		}
+		if _, exists := tables[rule.Name]; exists {
+			return nil, fmt.Errorf("duplicate table rule %s", rule.Name)
+		}
+		if rule.Strategy == nil {
+			rule.Strategy = HashStrategy{}
+		}
+		tables[rule.Name] = rule
+	}
+
+	topology, err := NewTopology(&config.TopologyConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &Router{
+		name:               config.Name,
+		topology:           topology,
+		tables:             tables,
+		metrics:            NewShardMetrics(config.SlowQueryThreshold),
+		cancel:             cancel,
+		scatterConcurrency: config.ScatterConcurrency,
+		shardTimeout:       config.ShardTimeout,
+	}
+	if r.scatterConcurrency <= 0 {
+		r.scatterConcurrency = DefaultScatterConcurrency
+	}
+	if r.shardTimeout <= 0 {
+		r.shardTimeout = DefaultShardTimeout
+	}
+	if config.MetricsLogInterval >= 0 {
+		go r.logMetricsLoop(ctx, config.MetricsLogInterval)
+	}
+	return r, nil
+}
+
+// Execute 在分片键对应的物理分表上执行fn
+// 写操作和同一请求中写过该分库之后的读操作使用主库，其余读操作使用健康的从库；
+// 重分片双写期间，fn中事务内的写入在fn成功返回后才重放到目标位置
+func (r *Router) Execute(ctx context.Context, table string, shardKey int64, write bool, fn func(db *gorm.DB, tableName string) error) error {
+	rule, err := r.rule(table)
+	if err != nil {
+		return err
+	}
+	db, shard, err := r.topology.Route(ctx, rule.Strategy, shardKey, rule.Name, write)
+	if err != nil {
+		return err
+	}
+	if target := dualWriteTargetOf(db); target != nil {
+		target.applyRule(rule, shard.TableName)
+	}
+	err = r.observe(shard, write, func() error {
+		return fn(db, shard.TableName)
+	})
+	flushDualWrite(ctx, db, err)
+	return err
+}
+
+// Locate 分片键在当前拓扑中对应的物理分表
+func (r *Router) Locate(table string, shardKey int64) (Shard, error) {
+	rule, err := r.rule(table)
+	if err != nil {
+		return Shard{}, err
+	}
+	return r.topology.Current().Locate(rule.Strategy, shardKey, rule.Name), nil
+}
+
+// Shards 逻辑表在当前拓扑中的全部物理分表
+func (r *Router) Shards(table string) ([]Shard, error) {
+	rule, err := r.rule(table)
+	if err != nil {
+		return nil, err
+	}
+	return r.topology.Current().Shards(rule.Name), nil
+}
+
+// snapshot 读取一次当前拓扑，返回该拓扑和逻辑表在其中的全部物理分表，跨分片操作全程使用同一份快照
+func (r *Router) snapshot(table string) (*ShardMap, []Shard, error) {
+	rule, err := r.rule(table)
+	if err != nil {
+		return nil, nil, err
+	}
+	current := r.topology.Current()
+	return current, current.Shards(rule.Name), nil
+}
+
+// ForEachShard 依次在逻辑表的每个物理分表上执行fn，fn返回ErrStopScatter时提前结束且不视为错误
+// 不限制执行时间，用于修复、迁移等需要逐个分表长时间扫描的任务，在线查询使用ScatterGather等并发版本
+func (r *Router) ForEachShard(ctx context.Context, table string, write bool, fn func(db *gorm.DB, shard Shard) error) error {
+	current, shards, err := r.snapshot(table)
+	if err != nil {
+		return err
+	}
+	for _, shard := range shards {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		db, err := r.topology.connIn(ctx, current, shard.Database, write)
+		if err != nil {
+			return err
+		}
+		err = r.observe(shard, write, func() error {
+			return fn(db, shard)
+		})
+		if errors.Is(err, ErrStopScatter) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", shard, err)
+		}
+	}
+	return nil
+}
+
+// ForEachDatabase 依次在当前拓扑的每个分库上执行fn，用于不分表的库级表（如outbox）
+func (r *Router) ForEachDatabase(ctx context.Context, write bool, fn func(dbName string, db *gorm.DB) error) error {
+	for i := 0; i < r.topology.Current().DatabaseCount; i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		db, err := r.topology.Conn(ctx, i, write)
+		if err != nil {
+			return err
+		}
+		if err := fn(fmt.Sprintf("db_%d", i), db); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Metrics 各物理分表自上次输出日志以来的访问指标
+func (r *Router) Metrics() []ShardStat {
+	return r.metrics.Snapshot()
+}
+
+// Ping 检查当前拓扑中所有分库主库的连通性，从库由后台健康检查负责摘除
+func (r *Router) Ping(ctx context.Context) error {
+	return r.topology.Ping(ctx)
+}
+
+// Close 停止后台任务并关闭所有连接
+func (r *Router) Close() {
+	r.cancel()
+	r.topology.Close()
+}
+
+func (r *Router) rule(table string) (TableRule, error) {
+	rule, ok := r.tables[table]
+	if !ok {
+		return TableRule{}, fmt.Errorf("table %s is not sharded in %s", table, r.name)
+	}
+	return rule, nil
+}
+
+func (r *Router) observe(shard Shard, write bool, fn func() error) error {
+	start := time.Now()
+	err := fn()
+	// 提前结束、未找到记录以及得到结果后被取消都不算分片错误
+	if errors.Is(err, ErrStopScatter) || errors.Is(err, gorm.ErrRecordNotFound) || errors.Is(err, context.Canceled) {
+		r.metrics.Observe(shard, write, time.Since(start), nil)
+	} else {
+		r.metrics.Observe(shard, write, time.Since(start), err)
+	}
+	return err
+}
+
+func (r *Router) logMetricsLoop(ctx context.Context, interval time.Duration) {
+	if interval == 0 {
+		interval = DefaultMetricsLogInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.metrics.logMetrics(r.name)
+		}
+	}
+}
@@ -0,0 +1,377 @@
+package sharding
+
+import (
+	"container/heap"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// DefaultScatterConcurrency 跨分片查询时同时访问的分表数
+	DefaultScatterConcurrency = 8
+	// DefaultShardTimeout 跨分片查询时单个分表的超时时间
+	DefaultShardTimeout = 2 * time.Second
+	// DefaultPageLimit 游标分页未指定每页条数时的默认值
+	DefaultPageLimit = 20
+)
+
+var (
+	// ErrStopScatter 在ForEachShard或并发查询的回调中返回，表示已经得到结果，不再访问剩余分片
+	ErrStopScatter = errors.New("sharding: stop scatter")
+	// ErrInvalidCursor 游标无法解析或不属于当前列表
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// scatter 并发在拓扑current的shards上执行fn，index为分表在shards中的下标，同时执行的分表数不超过scatterConcurrency，
+// 单个分表的执行时间不超过shardTimeout。任意分表失败时取消其余分表并返回该错误；
+// fn返回ErrStopScatter时取消其余分表并正常返回。
+// shards由调用方通过snapshot取得并用于确定结果数组的长度，不能在这里重新读取拓扑，
+// 否则两次读取之间拓扑刷新（如重分片后分表数变化）会导致下标越界
+func (r *Router) scatter(ctx context.Context, current *ShardMap, shards []Shard, write bool, fn func(db *gorm.DB, shard Shard, index int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		stopped  atomic.Bool
+		sem      = make(chan struct{}, r.scatterConcurrency)
+	)
+dispatch:
+	for i, shard := range shards {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break dispatch
+		}
+
+		wg.Add(1)
+		go func(index int, shard Shard) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			shardCtx, shardCancel := context.WithTimeout(ctx, r.shardTimeout)
+			defer shardCancel()
+
+			db, err := r.topology.connIn(shardCtx, current, shard.Database, write)
+			if err == nil {
+				err = r.observe(shard, write, func() error {
+					return fn(db.WithContext(shardCtx), shard, index)
+				})
+			}
+			switch {
+			case err == nil:
+			case errors.Is(err, ErrStopScatter):
+				stopped.Store(true)
+				cancel()
+			case stopped.Load():
+				// 已经得到结果后被取消的分表，忽略其错误
+			default:
+				if errors.Is(shardCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+					err = fmt.Errorf("timed out after %v: %w", r.shardTimeout, err)
+				}
+				once.Do(func() {
+					firstErr = fmt.Errorf("%s: %w", shard, err)
+					cancel()
+				})
+			}
+		}(i, shard)
+	}
+	wg.Wait()
+
+	if stopped.Load() {
+		return nil
+	}
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// ScatterQuery 跨分片查询：并发在逻辑表的每个物理分表上执行Fetch，按Less做k路归并后截取Offset和Limit
+type ScatterQuery[T any] struct {
+	Table string
+	Write bool // 是否读主库
+	// Fetch 查询单个分表，limit为每个分表最多需要返回的行数（Offset+Limit），为0表示不限制，
+	// 分表内的排序必须与Less一致，否则归并结果不正确
+	Fetch  func(db *gorm.DB, tableName string, limit int) ([]T, error)
+	Less   func(a, b T) bool // 为nil时按分片顺序拼接
+	Offset int
+	Limit  int // 为0表示不限制
+}
+
+// ScatterGather 执行跨分片查询并合并结果
+// 偏移量较大时每个分表都要返回Offset+Limit行，深分页应使用ScatterPage
+func ScatterGather[T any](ctx context.Context, r *Router, q ScatterQuery[T]) ([]T, error) {
+	perShard := 0
+	if q.Limit > 0 {
+		perShard = q.Offset + q.Limit
+	}
+
+	current, shards, err := r.snapshot(q.Table)
+	if err != nil {
+		return nil, err
+	}
+	streams := make([][]T, len(shards))
+	err = r.scatter(ctx, current, shards, q.Write, func(db *gorm.DB, shard Shard, index int) error {
+		rows, err := q.Fetch(db, shard.TableName, perShard)
+		streams[index] = rows
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items, _ := mergeStreams(streams, q.Less, q.Offset, q.Limit)
+	return items, nil
+}
+
+// PageQuery 跨分片的游标分页查询
+type PageQuery[T any] struct {
+	Table string
+	Write bool // 是否读主库
+	// Fetch 查询单个分表中排在after之后的至多limit行，after为nil时从头开始，
+	// 分表内的排序必须与Less一致，after之后的条件通常写成按排序列的行比较
+	Fetch  func(db *gorm.DB, tableName string, after *T, limit int) ([]T, error)
+	Less   func(a, b T) bool
+	Cursor string // 上一页返回的NextCursor，为空表示第一页
+	Limit  int
+}
+
+// Page 一页结果
+type Page[T any] struct {
+	Items      []T
+	NextCursor string // 最后一条记录编码后的游标，没有下一页时为空
+	HasMore    bool
+}
+
+// ScatterPage 执行跨分片的游标分页查询：每个分表只读取游标之后的Limit+1行，k路归并后取前Limit行，
+// 翻页代价与页码无关
+func ScatterPage[T any](ctx context.Context, r *Router, q PageQuery[T]) (*Page[T], error) {
+	if q.Less == nil {
+		return nil, errors.New("page query requires a sort order")
+	}
+	if q.Limit <= 0 {
+		q.Limit = DefaultPageLimit
+	}
+	after, err := DecodeCursor[T](q.Table, q.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	current, shards, err := r.snapshot(q.Table)
+	if err != nil {
+		return nil, err
+	}
+	streams := make([][]T, len(shards))
+	err = r.scatter(ctx, current, shards, q.Write, func(db *gorm.DB, shard Shard, index int) error {
+		// 多读一行用于判断该分表是否还有剩余
+		rows, err := q.Fetch(db, shard.TableName, after, q.Limit+1)
+		streams[index] = rows
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items, hasMore := mergeStreams(streams, q.Less, 0, q.Limit)
+	page := &Page[T]{Items: items, HasMore: hasMore}
+	if hasMore && len(items) > 0 {
+		if page.NextCursor, err = EncodeCursor(q.Table, items[len(items)-1]); err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+// ScatterFirst 并发在各分表上执行find，返回任意一个命中的结果并取消其余分表，全部未命中时found为false
+// 适用于按唯一键查找但只知道非分片键的场景
+func ScatterFirst[T any](ctx context.Context, r *Router, table string, write bool,
+	find func(db *gorm.DB, tableName string) (T, bool, error)) (result T, found bool, err error) {
+	current, shards, err := r.snapshot(table)
+	if err != nil {
+		return result, false, err
+	}
+	var once sync.Once
+	err = r.scatter(ctx, current, shards, write, func(db *gorm.DB, shard Shard, _ int) error {
+		value, ok, err := find(db, shard.TableName)
+		if err != nil {
+			return err
+		}
+		if ok {
+			once.Do(func() {
+				result, found = value, true
+			})
+			return ErrStopScatter
+		}
+		return nil
+	})
+	return result, found, err
+}
+
+// ScatterCount 并发在各分表上执行count并求和
+func ScatterCount(ctx context.Context, r *Router, table string, count func(db *gorm.DB, tableName string) (int64, error)) (int64, error) {
+	current, shards, err := r.snapshot(table)
+	if err != nil {
+		return 0, err
+	}
+	var total atomic.Int64
+	err = r.scatter(ctx, current, shards, false, func(db *gorm.DB, shard Shard, _ int) error {
+		n, err := count(db, shard.TableName)
+		if err != nil {
+			return err
+		}
+		total.Add(n)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return total.Load(), nil
+}
+
+// cursorPayload 游标内容，记录所属列表防止跨列表误用
+type cursorPayload[T any] struct {
+	Scope string `json:"t"`
+	Key   T      `json:"k"`
+}
+
+// EncodeCursor 把一页的最后一条记录编码为不透明的游标，T应只包含排序需要的列。
+// scope为列表标识，ScatterPage使用逻辑表名，其他列表使用各自的名字；所有服务的游标分页都使用这一种格式
+func EncodeCursor[T any](scope string, last T) (string, error) {
+	raw, err := json.Marshal(cursorPayload[T]{Scope: scope, Key: last})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// DecodeCursor 解析EncodeCursor生成的游标，cursor为空时返回nil，不属于scope时返回ErrInvalidCursor
+func DecodeCursor[T any](scope, cursor string) (*T, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var payload cursorPayload[T]
+	if err := json.Unmarshal(raw, &payload); err != nil || payload.Scope != scope {
+		return nil, ErrInvalidCursor
+	}
+	return &payload.Key, nil
+}
+
+// KeyCursor 按(排序键, ID)翻页的游标位置，排序键由调用方格式化为字符串（时间、计数等），
+// ID用于排序键相同时的次序；适用于不需要专门定义排序键类型的列表
+type KeyCursor struct {
+	Key string `json:"k"`
+	ID  int64  `json:"i"`
+}
+
+// Encode 把游标位置编码为scope下的不透明游标，KeyCursor只包含字符串和整数，编码不会失败
+func (c KeyCursor) Encode(scope string) string {
+	cursor, _ := EncodeCursor(scope, c)
+	return cursor
+}
+
+// mergeStreams 对各分片内已排好序的结果做k路归并，跳过前skip条后返回至多limit条（limit为0时返回全部），
+// 同时返回是否还有剩余的行；less为nil时按分片顺序拼接
+func mergeStreams[T any](streams [][]T, less func(a, b T) bool, skip, limit int) ([]T, bool) {
+	h := &streamHeap[T]{streams: streams, pos: make([]int, len(streams)), less: less}
+	for i, stream := range streams {
+		if len(stream) > 0 {
+			h.heads = append(h.heads, i)
+		}
+	}
+	if less != nil {
+		heap.Init(h)
+	}
+
+	items := []T{}
+	for h.Len() > 0 {
+		if limit > 0 && len(items) >= limit {
+			return items, true
+		}
+		var item T
+		if less != nil {
+			item = h.pop()
+		} else {
+			item = h.popFirst()
+		}
+		if skip > 0 {
+			skip--
+			continue
+		}
+		items = append(items, item)
+	}
+	return items, false
+}
+
+// streamHeap 以各分片当前首行构成的小顶堆，值相同时分片序号小的优先，保证结果稳定
+type streamHeap[T any] struct {
+	streams [][]T
+	pos     []int // 各分片已取出的行数
+	heads   []int // 仍有剩余行的分片序号
+	less    func(a, b T) bool
+}
+
+func (h *streamHeap[T]) head(stream int) T {
+	return h.streams[stream][h.pos[stream]]
+}
+
+func (h *streamHeap[T]) Len() int { return len(h.heads) }
+
+func (h *streamHeap[T]) Less(i, j int) bool {
+	a, b := h.head(h.heads[i]), h.head(h.heads[j])
+	if h.less(a, b) {
+		return true
+	}
+	if h.less(b, a) {
+		return false
+	}
+	return h.heads[i] < h.heads[j]
+}
+
+func (h *streamHeap[T]) Swap(i, j int) { h.heads[i], h.heads[j] = h.heads[j], h.heads[i] }
+
+func (h *streamHeap[T]) Push(x any) { h.heads = append(h.heads, x.(int)) }
+
+func (h *streamHeap[T]) Pop() any {
+	last := h.heads[len(h.heads)-1]
+	h.heads = h.heads[:len(h.heads)-1]
+	return last
+}
+
+// pop 取出堆顶分片的首行，该分片还有剩余时调整堆，否则移出堆
+func (h *streamHeap[T]) pop() T {
+	stream := h.heads[0]
+	item := h.head(stream)
+	h.pos[stream]++
+	if h.pos[stream] < len(h.streams[stream]) {
+		heap.Fix(h, 0)
+	} else {
+		heap.Pop(h)
+	}
+	return item
+}
+
+// popFirst 按分片顺序取出下一行
+func (h *streamHeap[T]) popFirst() T {
+	stream := h.heads[0]
+	item := h.head(stream)
+	h.pos[stream]++
+	if h.pos[stream] >= len(h.streams[stream]) {
+		h.heads = h.heads[1:]
+	}
+	return item
+}
@@ -0,0 +1,230 @@
+package sharding
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type mergeRow struct {
+	Score int
+	ID    int
+}
+
+// lessRow 按Score降序、ID降序，与评论等列表的排序方式一致
+func lessRow(a, b mergeRow) bool {
+	if a.Score != b.Score {
+		return a.Score > b.Score
+	}
+	return a.ID > b.ID
+}
+
+func TestMergeStreams(t *testing.T) {
+	lessInt := func(a, b int) bool { return a < b }
+	tests := []struct {
+		name        string
+		streams     [][]int
+		less        func(a, b int) bool
+		skip, limit int
+		want        []int
+		wantMore    bool
+	}{
+		{
+			name:    "k-way merge",
+			streams: [][]int{{1, 4, 7}, {2, 5, 8}, {3, 6, 9}},
+			less:    lessInt,
+			want:    []int{1, 2, 3, 4, 5, 6, 7, 8, 9},
+		},
+		{
+			name:    "empty and nil streams",
+			streams: [][]int{nil, {2, 3}, {}, {1}},
+			less:    lessInt,
+			want:    []int{1, 2, 3},
+		},
+		{
+			name:    "all empty",
+			streams: [][]int{nil, {}},
+			less:    lessInt,
+			want:    []int{},
+		},
+		{
+			name:     "limit leaves rows",
+			streams:  [][]int{{1, 3}, {2, 4}},
+			less:     lessInt,
+			limit:    3,
+			want:     []int{1, 2, 3},
+			wantMore: true,
+		},
+		{
+			name:    "limit equals total",
+			streams: [][]int{{1, 3}, {2, 4}},
+			less:    lessInt,
+			limit:   4,
+			want:    []int{1, 2, 3, 4},
+		},
+		{
+			name:     "skip then limit",
+			streams:  [][]int{{1, 3, 5}, {2, 4, 6}},
+			less:     lessInt,
+			skip:     2,
+			limit:    2,
+			want:     []int{3, 4},
+			wantMore: true,
+		},
+		{
+			name:    "skip past the end",
+			streams: [][]int{{1}, {2}},
+			less:    lessInt,
+			skip:    5,
+			limit:   2,
+			want:    []int{},
+		},
+		{
+			name:     "concatenate without order",
+			streams:  [][]int{{9, 1}, nil, {5}, {3, 2}},
+			limit:    4,
+			want:     []int{9, 1, 5, 3},
+			wantMore: true,
+		},
+		{
+			name:    "concatenate with skip",
+			streams: [][]int{{9, 1}, {5}},
+			skip:    1,
+			want:    []int{1, 5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, more := mergeStreams(tt.streams, tt.less, tt.skip, tt.limit)
+			if !reflect.DeepEqual(got, tt.want) || more != tt.wantMore {
+				t.Errorf("mergeStreams() = %v, %v, want %v, %v", got, more, tt.want, tt.wantMore)
+			}
+		})
+	}
+}
+
+func TestMergeStreamsTies(t *testing.T) {
+	// 排序值相同的行按分片序号输出，同一分片内保持原有顺序
+	type tagged struct {
+		Score int
+		Shard int
+		Seq   int
+	}
+	less := func(a, b tagged) bool { return a.Score > b.Score }
+	streams := [][]tagged{
+		{{5, 0, 0}, {5, 0, 1}, {1, 0, 2}},
+		{{7, 1, 0}, {5, 1, 1}},
+		{{5, 2, 0}, {1, 2, 1}},
+	}
+	got, more := mergeStreams(streams, less, 0, 0)
+	want := []tagged{{7, 1, 0}, {5, 0, 0}, {5, 0, 1}, {5, 1, 1}, {5, 2, 0}, {1, 0, 2}, {1, 2, 1}}
+	if !reflect.DeepEqual(got, want) || more {
+		t.Errorf("mergeStreams() = %v, %v, want %v, false", got, more, want)
+	}
+}
+
+func TestMergeStreamsPaging(t *testing.T) {
+	// 按ScatterPage的方式逐页读取：每个分片取游标之后的limit+1行，归并后取前limit行，
+	// 拼接起来应与整体排序的结果一致，且不重复、不遗漏
+	shards := [][]mergeRow{
+		{{9, 1}, {7, 4}, {7, 2}, {3, 8}},
+		{{9, 6}, {8, 3}, {7, 7}, {1, 5}},
+		{{7, 9}, {5, 10}},
+		nil,
+	}
+	var all []mergeRow
+	for _, shard := range shards {
+		all = append(all, shard...)
+	}
+	want := append([]mergeRow(nil), all...)
+	sortRows(want)
+
+	const limit = 3
+	var got []mergeRow
+	var after *mergeRow
+	for page := 0; ; page++ {
+		if page > len(all) {
+			t.Fatal("paging did not terminate")
+		}
+		streams := make([][]mergeRow, len(shards))
+		for i, shard := range shards {
+			for _, row := range shard {
+				if after != nil && !lessRow(*after, row) {
+					continue
+				}
+				if len(streams[i]) == limit+1 {
+					break
+				}
+				streams[i] = append(streams[i], row)
+			}
+		}
+		items, more := mergeStreams(streams, lessRow, 0, limit)
+		got = append(got, items...)
+		if !more {
+			break
+		}
+		last := items[len(items)-1]
+		after = &last
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("paged rows = %v, want %v", got, want)
+	}
+}
+
+func sortRows(rows []mergeRow) {
+	for i := 1; i < len(rows); i++ {
+		for j := i; j > 0 && lessRow(rows[j], rows[j-1]); j-- {
+			rows[j], rows[j-1] = rows[j-1], rows[j]
+		}
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	type key struct {
+		CreatedAt time.Time
+		ID        int64
+	}
+	last := key{CreatedAt: time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC), ID: 1 << 60}
+	cursor, err := EncodeCursor("comments", last)
+	if err != nil {
+		t.Fatalf("EncodeCursor() error = %v", err)
+	}
+	got, err := DecodeCursor[key]("comments", cursor)
+	if err != nil {
+		t.Fatalf("DecodeCursor() error = %v", err)
+	}
+	if got == nil || !got.CreatedAt.Equal(last.CreatedAt) || got.ID != last.ID {
+		t.Errorf("DecodeCursor() = %v, want %v", got, last)
+	}
+}
+
+func TestDecodeCursor(t *testing.T) {
+	type key struct{ ID int64 }
+	other, err := EncodeCursor("follows", key{ID: 1})
+	if err != nil {
+		t.Fatalf("EncodeCursor() error = %v", err)
+	}
+	tests := []struct {
+		name    string
+		cursor  string
+		wantNil bool
+		wantErr error
+	}{
+		{name: "empty cursor is first page", cursor: "", wantNil: true},
+		{name: "cursor of another table", cursor: other, wantErr: ErrInvalidCursor},
+		{name: "not base64", cursor: "!!!", wantErr: ErrInvalidCursor},
+		{name: "not json", cursor: "bm90IGpzb24", wantErr: ErrInvalidCursor},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeCursor[key]("comments", tt.cursor)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DecodeCursor() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantNil && got != nil {
+				t.Errorf("DecodeCursor() = %v, want nil", got)
+			}
+		})
+	}
+}
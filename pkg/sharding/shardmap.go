@@ -0,0 +1,342 @@
+package sharding
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 分片拓扑保存在主库中并带版本号，修改分库分表数量时通过重分片任务迁移数据后再切换版本，
+// 各服务定期刷新当前生效的拓扑，不会因为取模基数变化把已有数据路由到错误的分表
+
+const (
+	shardMapTable    = "shard_maps"
+	reshardTaskTable = "reshard_tasks"
+
+	// ShardMapRefreshInterval 服务刷新分片拓扑的间隔，重分片工具在切换状态后至少等待两个间隔
+	ShardMapRefreshInterval = 5 * time.Second
+)
+
+// 分片拓扑状态
+const (
+	ShardMapStatePending = "pending" // 重分片的目标拓扑，数据迁移中
+	ShardMapStateActive  = "active"  // 当前生效的拓扑
+	ShardMapStateRetired = "retired" // 已被替换或放弃的拓扑
+)
+
+// 重分片任务状态
+const (
+	ReshardStatePlanned   = "planned"   // 已创建目标拓扑，尚未开始
+	ReshardStateCopying   = "copying"   // 双写已开启，正在复制存量数据
+	ReshardStateVerifying = "verifying" // 复制完成，正在校验
+	ReshardStateReady     = "ready"     // 校验通过，可以切换
+	ReshardStateCutover   = "cutover"   // 已切换到目标拓扑，反向双写到原拓扑直到所有服务完成切换
+	ReshardStateDone      = "done"      // 切换完成
+	ReshardStateAborted   = "aborted"   // 已放弃
+)
+
+// ShardMap 一个版本的分片拓扑
+type ShardMap struct {
+	ID            int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	Name          string    `gorm:"column:name"` // 逻辑库名称，如follows、comments
+	Version       int       `gorm:"column:version"`
+	DatabaseCount int       `gorm:"column:database_count"`
+	TableCount    int       `gorm:"column:table_count"`
+	DSNs          string    `gorm:"column:master_dsns"` // JSON数组，下标即分库索引
+	State         string    `gorm:"column:state"`
+	CreatedAt     time.Time `gorm:"column:created_at"`
+	UpdatedAt     time.Time `gorm:"column:updated_at"`
+
+	masterDSNs []string
+}
+
+// NewShardMap 创建拓扑，dsns的数量必须等于databaseCount
+func NewShardMap(name string, version, databaseCount, tableCount int, dsns []string) (*ShardMap, error) {
+	if databaseCount <= 0 || tableCount <= 0 {
+		return nil, errors.New("database count and table count must be greater than 0")
+	}
+	if len(dsns) != databaseCount {
+		return nil, fmt.Errorf("expected %d master dsns, got %d", databaseCount, len(dsns))
+	}
+	raw, err := json.Marshal(dsns)
+	if err != nil {
+		return nil, err
+	}
+	return &ShardMap{
+		Name:          name,
+		Version:       version,
+		DatabaseCount: databaseCount,
+		TableCount:    tableCount,
+		DSNs:          string(raw),
+		masterDSNs:    dsns,
+	}, nil
+}
+
+// MasterDSNs 各分库主库的DSN
+func (m *ShardMap) MasterDSNs() []string {
+	if m.masterDSNs == nil && m.DSNs != "" {
+		_ = json.Unmarshal([]byte(m.DSNs), &m.masterDSNs)
+	}
+	return m.masterDSNs
+}
+
+// Shard 一个物理分表
+type Shard struct {
+	Database  int    // 分库索引
+	Table     int    // 分表索引
+	TableName string // 物理表名，为<表名前缀>_<分表索引>
+}
+
+// String 分片标识，如db_0.comments_3
+func (s Shard) String() string {
+	return fmt.Sprintf("db_%d.%s", s.Database, s.TableName)
+}
+
+// Locate 按strategy计算分片键在该拓扑中的物理分表，strategy为nil时使用取模分片
+func (m *ShardMap) Locate(strategy Strategy, shardKey int64, tablePrefix string) Shard {
+	if strategy == nil {
+		strategy = HashStrategy{}
+	}
+	dbIndex, tableIndex := strategy.Locate(shardKey, m.DatabaseCount, m.TableCount)
+	return m.Shard(dbIndex, tableIndex, tablePrefix)
+}
+
+// Shard 拓扑中指定位置的物理分表
+func (m *ShardMap) Shard(dbIndex, tableIndex int, tablePrefix string) Shard {
+	return Shard{
+		Database:  dbIndex,
+		Table:     tableIndex,
+		TableName: fmt.Sprintf("%s_%d", tablePrefix, tableIndex),
+	}
+}
+
+// Shards 逻辑表在该拓扑中的全部物理分表
+func (m *ShardMap) Shards(tablePrefix string) []Shard {
+	shards := make([]Shard, 0, m.DatabaseCount*m.TableCount)
+	for dbIndex := 0; dbIndex < m.DatabaseCount; dbIndex++ {
+		for tableIndex := 0; tableIndex < m.TableCount; tableIndex++ {
+			shards = append(shards, m.Shard(dbIndex, tableIndex, tablePrefix))
+		}
+	}
+	return shards
+}
+
+// ReshardTask 重分片任务
+type ReshardTask struct {
+	ID             int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	Name           string    `gorm:"column:name"`
+	FromVersion    int       `gorm:"column:from_version"`
+	ToVersion      int       `gorm:"column:to_version"`
+	State          string    `gorm:"column:state"`
+	CopiedRows     int64     `gorm:"column:copied_rows"`
+	MismatchedRows int64     `gorm:"column:mismatched_rows"` // 最近一次校验发现并修复的不一致行数
+	Progress       string    `gorm:"column:progress"`        // JSON，各源表已复制到的游标
+	LastError      string    `gorm:"column:last_error"`
+	CreatedAt      time.Time `gorm:"column:created_at"`
+	UpdatedAt      time.Time `gorm:"column:updated_at"`
+}
+
+// Routing 服务当前使用的路由：写入Current，存在Shadow时同时双写到Shadow
+type Routing struct {
+	Current *ShardMap
+	Shadow  *ShardMap
+	Task    *ReshardTask
+}
+
+// EnsureShardMap 获取name当前生效的拓扑，不存在时以配置文件中的拓扑作为第一个版本
+func EnsureShardMap(ctx context.Context, db *gorm.DB, name string, databaseCount, tableCount int, dsns []string) (*ShardMap, error) {
+	current, err := GetActiveShardMap(ctx, db, name)
+	if err != nil {
+		return nil, err
+	}
+	if current != nil {
+		return current, nil
+	}
+
+	initial, err := NewShardMap(name, 1, databaseCount, tableCount, dsns)
+	if err != nil {
+		return nil, err
+	}
+	initial.State = ShardMapStateActive
+	initial.CreatedAt = time.Now()
+	initial.UpdatedAt = initial.CreatedAt
+	if err := db.WithContext(ctx).Table(shardMapTable).Create(initial).Error; err != nil {
+		// 多个实例同时启动时可能已被其他实例创建
+		if current, getErr := GetActiveShardMap(ctx, db, name); getErr == nil && current != nil {
+			return current, nil
+		}
+		return nil, fmt.Errorf("failed to create initial shard map: %w", err)
+	}
+	return initial, nil
+}
+
+// GetActiveShardMap 获取name当前生效的拓扑，不存在时返回nil
+func GetActiveShardMap(ctx context.Context, db *gorm.DB, name string) (*ShardMap, error) {
+	var maps []*ShardMap
+	if err := db.WithContext(ctx).Table(shardMapTable).
+		Where("name = ? AND state = ?", name, ShardMapStateActive).
+		Order("version DESC").Limit(1).Find(&maps).Error; err != nil {
+		return nil, fmt.Errorf("failed to get active shard map: %w", err)
+	}
+	if len(maps) == 0 {
+		return nil, nil
+	}
+	return maps[0], nil
+}
+
+// GetShardMap 获取name指定版本的拓扑
+func GetShardMap(ctx context.Context, db *gorm.DB, name string, version int) (*ShardMap, error) {
+	var m ShardMap
+	if err := db.WithContext(ctx).Table(shardMapTable).
+		Where("name = ? AND version = ?", name, version).Take(&m).Error; err != nil {
+		return nil, fmt.Errorf("failed to get shard map %s v%d: %w", name, version, err)
+	}
+	return &m, nil
+}
+
+// GetRunningReshardTask 获取name未结束的重分片任务，不存在时返回nil
+func GetRunningReshardTask(ctx context.Context, db *gorm.DB, name string) (*ReshardTask, error) {
+	var tasks []*ReshardTask
+	if err := db.WithContext(ctx).Table(reshardTaskTable).
+		Where("name = ? AND state NOT IN ?", name, []string{ReshardStateDone, ReshardStateAborted}).
+		Order("id DESC").Limit(1).Find(&tasks).Error; err != nil {
+		return nil, fmt.Errorf("failed to get reshard task: %w", err)
+	}
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+	return tasks[0], nil
+}
+
+// LoadRouting 加载name当前的路由，重分片任务开启双写后Shadow为另一侧的拓扑
+func LoadRouting(ctx context.Context, db *gorm.DB, name string) (*Routing, error) {
+	current, err := GetActiveShardMap(ctx, db, name)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, fmt.Errorf("no active shard map for %s", name)
+	}
+
+	routing := &Routing{Current: current}
+	task, err := GetRunningReshardTask(ctx, db, name)
+	if err != nil || task == nil {
+		return routing, err
+	}
+	routing.Task = task
+
+	switch task.State {
+	case ReshardStateCopying, ReshardStateVerifying, ReshardStateReady:
+		routing.Shadow, err = GetShardMap(ctx, db, name, task.ToVersion)
+	case ReshardStateCutover:
+		routing.Shadow, err = GetShardMap(ctx, db, name, task.FromVersion)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return routing, nil
+}
+
+// PlanReshard 创建目标拓扑和重分片任务，同一逻辑库同时只能有一个未结束的任务
+func PlanReshard(ctx context.Context, db *gorm.DB, name string, databaseCount, tableCount int, dsns []string) (*ReshardTask, error) {
+	var task *ReshardTask
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		running, err := GetRunningReshardTask(ctx, tx, name)
+		if err != nil {
+			return err
+		}
+		if running != nil {
+			return fmt.Errorf("reshard task %d of %s is still %s", running.ID, name, running.State)
+		}
+		current, err := GetActiveShardMap(ctx, tx, name)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return fmt.Errorf("no active shard map for %s, start the service once to bootstrap it", name)
+		}
+
+		var maxVersion int
+		if err := tx.Table(shardMapTable).Where("name = ?", name).
+			Select("COALESCE(MAX(version), 0)").Scan(&maxVersion).Error; err != nil {
+			return err
+		}
+		target, err := NewShardMap(name, maxVersion+1, databaseCount, tableCount, dsns)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		target.State = ShardMapStatePending
+		target.CreatedAt = now
+		target.UpdatedAt = now
+		if err := tx.Table(shardMapTable).Create(target).Error; err != nil {
+			return fmt.Errorf("failed to create target shard map: %w", err)
+		}
+
+		task = &ReshardTask{
+			Name:        name,
+			FromVersion: current.Version,
+			ToVersion:   target.Version,
+			State:       ReshardStatePlanned,
+			Progress:    "{}",
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		return tx.Table(reshardTaskTable).Create(task).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// UpdateReshardTask 从fromStates之一更新任务状态及其他字段
+func UpdateReshardTask(ctx context.Context, db *gorm.DB, taskID int64, fromStates []string, updates map[string]interface{}) error {
+	updates["updated_at"] = time.Now()
+	query := db.WithContext(ctx).Table(reshardTaskTable).Where("id = ?", taskID)
+	if len(fromStates) > 0 {
+		query = query.Where("state IN ?", fromStates)
+	}
+	result := query.Updates(updates)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update reshard task: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("reshard task %d is not in state %v", taskID, fromStates)
+	}
+	return nil
+}
+
+// CutoverReshard 将目标拓扑设为生效，原拓扑退役，任务进入cutover状态
+func CutoverReshard(ctx context.Context, db *gorm.DB, task *ReshardTask) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := UpdateReshardTask(ctx, tx, task.ID, []string{ReshardStateReady},
+			map[string]interface{}{"state": ReshardStateCutover}); err != nil {
+			return err
+		}
+		now := time.Now()
+		if err := tx.Table(shardMapTable).Where("name = ? AND version = ?", task.Name, task.FromVersion).
+			Updates(map[string]interface{}{"state": ShardMapStateRetired, "updated_at": now}).Error; err != nil {
+			return err
+		}
+		return tx.Table(shardMapTable).Where("name = ? AND version = ?", task.Name, task.ToVersion).
+			Updates(map[string]interface{}{"state": ShardMapStateActive, "updated_at": now}).Error
+	})
+}
+
+// AbortReshard 放弃切换前的任务，目标拓扑退役，已复制到目标位置的数据需要单独清理
+func AbortReshard(ctx context.Context, db *gorm.DB, task *ReshardTask) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := UpdateReshardTask(ctx, tx, task.ID,
+			[]string{ReshardStatePlanned, ReshardStateCopying, ReshardStateVerifying, ReshardStateReady},
+			map[string]interface{}{"state": ReshardStateAborted}); err != nil {
+			return err
+		}
+		return tx.Table(shardMapTable).Where("name = ? AND version = ?", task.Name, task.ToVersion).
+			Updates(map[string]interface{}{"state": ShardMapStateRetired, "updated_at": time.Now()}).Error
+	})
+}
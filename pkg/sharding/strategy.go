@@ -0,0 +1,155 @@
+package sharding
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+)
+
+// Strategy 分片策略，把分片键映射到拓扑中的分库和分表索引
+// 同一逻辑表的策略一旦确定不能直接更换，否则已有数据会被路由到错误的分表，需要通过重分片迁移
+type Strategy interface {
+	Name() string
+	Locate(shardKey int64, databaseCount, tableCount int) (dbIndex, tableIndex int)
+}
+
+// HashStrategy 取模分片：分库索引为key % 分库数，分表索引为key / 分库数 % 分表数
+type HashStrategy struct{}
+
+// Name 策略名称
+func (HashStrategy) Name() string {
+	return "hash"
+}
+
+// Locate 计算分库和分表索引
+func (HashStrategy) Locate(shardKey int64, databaseCount, tableCount int) (int, int) {
+	if shardKey < 0 {
+		shardKey = -shardKey
+	}
+	return int(shardKey % int64(databaseCount)), int((shardKey / int64(databaseCount)) % int64(tableCount))
+}
+
+// RangeStrategy 范围分片：按上界把分片键划分为连续区间，第i个区间落在第i个分片上，
+// 分片按分库优先编号，即先填满db_0的各分表再到db_1；超出最后一个上界的键落在最后一个分片
+type RangeStrategy struct {
+	bounds []int64
+}
+
+// NewRangeStrategy 创建范围分片策略，bounds为递增的区间上界（不含）
+func NewRangeStrategy(bounds ...int64) (*RangeStrategy, error) {
+	for i := 1; i < len(bounds); i++ {
+		if bounds[i] <= bounds[i-1] {
+			return nil, fmt.Errorf("range bounds must be strictly increasing, got %d after %d", bounds[i], bounds[i-1])
+		}
+	}
+	return &RangeStrategy{bounds: append([]int64(nil), bounds...)}, nil
+}
+
+// Name 策略名称
+func (s *RangeStrategy) Name() string {
+	return "range"
+}
+
+// Locate 计算分库和分表索引
+func (s *RangeStrategy) Locate(shardKey int64, databaseCount, tableCount int) (int, int) {
+	slot := sort.Search(len(s.bounds), func(i int) bool { return shardKey < s.bounds[i] })
+	if slots := databaseCount * tableCount; slot >= slots {
+		slot = slots - 1
+	}
+	return slot / tableCount, slot % tableCount
+}
+
+// DefaultVirtualNodes 一致性哈希中每个分片的虚拟节点数
+const DefaultVirtualNodes = 64
+
+// ConsistentHashStrategy 一致性哈希分片：每个分片以db-table命名在环上放置若干虚拟节点，
+// 增加分库或分表时只有落到新分片上的键需要迁移
+type ConsistentHashStrategy struct {
+	virtualNodes int
+
+	mu    sync.RWMutex
+	rings map[[2]int]*hashRing // 按分库数和分表数缓存的哈希环
+}
+
+type hashRing struct {
+	points []uint64
+	slots  map[uint64][2]int
+}
+
+// NewConsistentHashStrategy 创建一致性哈希分片策略，virtualNodes不大于0时使用DefaultVirtualNodes
+func NewConsistentHashStrategy(virtualNodes int) *ConsistentHashStrategy {
+	if virtualNodes <= 0 {
+		virtualNodes = DefaultVirtualNodes
+	}
+	return &ConsistentHashStrategy{
+		virtualNodes: virtualNodes,
+		rings:        make(map[[2]int]*hashRing),
+	}
+}
+
+// Name 策略名称
+func (s *ConsistentHashStrategy) Name() string {
+	return "consistent_hash"
+}
+
+// Locate 计算分库和分表索引
+func (s *ConsistentHashStrategy) Locate(shardKey int64, databaseCount, tableCount int) (int, int) {
+	ring := s.ring(databaseCount, tableCount)
+
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(shardKey))
+	h := hash64(buf[:])
+
+	i := sort.Search(len(ring.points), func(i int) bool { return ring.points[i] >= h })
+	if i == len(ring.points) {
+		i = 0
+	}
+	slot := ring.slots[ring.points[i]]
+	return slot[0], slot[1]
+}
+
+func (s *ConsistentHashStrategy) ring(databaseCount, tableCount int) *hashRing {
+	key := [2]int{databaseCount, tableCount}
+	s.mu.RLock()
+	ring, ok := s.rings[key]
+	s.mu.RUnlock()
+	if ok {
+		return ring
+	}
+
+	ring = &hashRing{slots: make(map[uint64][2]int, databaseCount*tableCount*s.virtualNodes)}
+	for dbIndex := 0; dbIndex < databaseCount; dbIndex++ {
+		for tableIndex := 0; tableIndex < tableCount; tableIndex++ {
+			for v := 0; v < s.virtualNodes; v++ {
+				point := hash64([]byte(fmt.Sprintf("%d-%d#%d", dbIndex, tableIndex, v)))
+				if _, exists := ring.slots[point]; exists {
+					continue // 极少出现的哈希冲突，保留先放置的节点
+				}
+				ring.slots[point] = [2]int{dbIndex, tableIndex}
+				ring.points = append(ring.points, point)
+			}
+		}
+	}
+	sort.Slice(ring.points, func(i, j int) bool { return ring.points[i] < ring.points[j] })
+
+	s.mu.Lock()
+	s.rings[key] = ring
+	s.mu.Unlock()
+	return ring
+}
+
+// hash64 FNV-1a之后再做一次murmur3的fmix64混合：只差最后一个字节的输入（如连续的ID）
+// 经FNV-1a得到的哈希值相互接近，会集中落在环上同一段，混合后才能均匀分布
+func hash64(data []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(data)
+	x := h.Sum64()
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
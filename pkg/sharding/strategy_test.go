@@ -0,0 +1,129 @@
+package sharding
+
+import "testing"
+
+func TestNewRangeStrategy(t *testing.T) {
+	tests := []struct {
+		name    string
+		bounds  []int64
+		wantErr bool
+	}{
+		{name: "increasing", bounds: []int64{100, 200, 300}},
+		{name: "no bounds", bounds: nil},
+		{name: "unsorted", bounds: []int64{100, 300, 200}, wantErr: true},
+		{name: "duplicate", bounds: []int64{100, 100, 200}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRangeStrategy(tt.bounds...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewRangeStrategy(%v) err = %v, wantErr %v", tt.bounds, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRangeStrategyLocate(t *testing.T) {
+	tests := []struct {
+		name                      string
+		bounds                    []int64
+		key                       int64
+		databaseCount, tableCount int
+		wantDB, wantTable         int
+	}{
+		{name: "below first bound", bounds: []int64{100, 200, 300}, key: -5, databaseCount: 2, tableCount: 2},
+		{name: "last key of first range", bounds: []int64{100, 200, 300}, key: 99, databaseCount: 2, tableCount: 2},
+		{name: "bound is exclusive", bounds: []int64{100, 200, 300}, key: 100, databaseCount: 2, tableCount: 2, wantTable: 1},
+		{name: "fills db_0 before db_1", bounds: []int64{100, 200, 300}, key: 250, databaseCount: 2, tableCount: 2, wantDB: 1},
+		{name: "at last bound", bounds: []int64{100, 200, 300}, key: 300, databaseCount: 2, tableCount: 2, wantDB: 1, wantTable: 1},
+		{name: "above last bound", bounds: []int64{100, 200, 300}, key: 1 << 40, databaseCount: 2, tableCount: 2, wantDB: 1, wantTable: 1},
+		{name: "more ranges than shards", bounds: []int64{100, 200, 300}, key: 250, databaseCount: 1, tableCount: 2, wantTable: 1},
+		{name: "no bounds", bounds: nil, key: 12345, databaseCount: 2, tableCount: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewRangeStrategy(tt.bounds...)
+			if err != nil {
+				t.Fatalf("NewRangeStrategy(%v) failed: %v", tt.bounds, err)
+			}
+			dbIndex, tableIndex := s.Locate(tt.key, tt.databaseCount, tt.tableCount)
+			if dbIndex != tt.wantDB || tableIndex != tt.wantTable {
+				t.Fatalf("Locate(%d) = (%d, %d), want (%d, %d)", tt.key, dbIndex, tableIndex, tt.wantDB, tt.wantTable)
+			}
+		})
+	}
+}
+
+func TestConsistentHashStrategyLocate(t *testing.T) {
+	const keys = 10000
+	tests := []struct {
+		name                      string
+		virtualNodes              int
+		databaseCount, tableCount int
+	}{
+		{name: "default virtual nodes", virtualNodes: DefaultVirtualNodes, databaseCount: 2, tableCount: 4},
+		{name: "zero virtual nodes", virtualNodes: 0, databaseCount: 2, tableCount: 4},
+		{name: "negative virtual nodes", virtualNodes: -1, databaseCount: 2, tableCount: 4},
+		{name: "single shard", virtualNodes: 8, databaseCount: 1, tableCount: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewConsistentHashStrategy(tt.virtualNodes)
+			if tt.virtualNodes <= 0 && s.virtualNodes != DefaultVirtualNodes {
+				t.Fatalf("virtualNodes = %d, want %d", s.virtualNodes, DefaultVirtualNodes)
+			}
+			// 另一个实例的哈希环独立构建，同一个键应落在同一个分片
+			other := NewConsistentHashStrategy(tt.virtualNodes)
+			used := make(map[[2]int]int)
+			for key := int64(0); key < keys; key++ {
+				dbIndex, tableIndex := s.Locate(key, tt.databaseCount, tt.tableCount)
+				if dbIndex < 0 || dbIndex >= tt.databaseCount || tableIndex < 0 || tableIndex >= tt.tableCount {
+					t.Fatalf("Locate(%d) = (%d, %d) is out of range", key, dbIndex, tableIndex)
+				}
+				if d, tb := other.Locate(key, tt.databaseCount, tt.tableCount); d != dbIndex || tb != tableIndex {
+					t.Fatalf("Locate(%d) is not stable: (%d, %d) vs (%d, %d)", key, dbIndex, tableIndex, d, tb)
+				}
+				used[[2]int{dbIndex, tableIndex}]++
+			}
+			if len(used) != tt.databaseCount*tt.tableCount {
+				t.Fatalf("keys landed on %d of %d shards", len(used), tt.databaseCount*tt.tableCount)
+			}
+		})
+	}
+}
+
+func TestConsistentHashStrategyAddShard(t *testing.T) {
+	const keys = 10000
+	tests := []struct {
+		name              string
+		fromDB, fromTable int
+		toDB, toTable     int
+	}{
+		{name: "add table", fromDB: 1, fromTable: 4, toDB: 1, toTable: 5},
+		{name: "add database", fromDB: 2, fromTable: 2, toDB: 3, toTable: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewConsistentHashStrategy(0)
+			moved := 0
+			for key := int64(0); key < keys; key++ {
+				fromDB, fromTable := s.Locate(key, tt.fromDB, tt.fromTable)
+				toDB, toTable := s.Locate(key, tt.toDB, tt.toTable)
+				if fromDB == toDB && fromTable == toTable {
+					continue
+				}
+				moved++
+				// 只有落到新分片上的键需要迁移
+				if toDB < tt.fromDB && toTable < tt.fromTable {
+					t.Fatalf("key %d moved from (%d, %d) to existing shard (%d, %d)", key, fromDB, fromTable, toDB, toTable)
+				}
+			}
+			// 新分片平均分到的比例，留出虚拟节点分布不均的余量
+			added := tt.toDB*tt.toTable - tt.fromDB*tt.fromTable
+			expected := keys * added / (tt.toDB * tt.toTable)
+			if moved == 0 || moved > expected*2 {
+				t.Fatalf("moved %d of %d keys, expected about %d", moved, keys, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,320 @@
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// TopologyConfig 分片拓扑配置，配置文件中的拓扑只在主库中还没有拓扑记录时作为第一个版本
+type TopologyConfig struct {
+	Name                 string // 逻辑库名称，如follows、comments
+	DatabaseCount        int
+	TableCount           int
+	MasterDSNs           []string
+	SlaveDSNs            [][]string // 每个分库的从库DSN，下标与MasterDSNs一致
+	MaxOpenConns         int
+	MaxIdleConns         int
+	ConnMaxLifetime      time.Duration
+	ReplicaMaxLag        time.Duration
+	ReplicaCheckInterval time.Duration
+	MetaDB               *gorm.DB // 保存分片拓扑的主库，为nil时只使用配置文件中的拓扑
+}
+
+// Topology 维护当前生效的分片拓扑和各分库的主从连接，并定期从主库刷新拓扑与重分片状态
+type Topology struct {
+	config *TopologyConfig
+
+	mu      sync.RWMutex
+	current *ShardMap
+	shadow  *ShardMap              // 重分片期间双写的另一侧拓扑
+	state   string                 // 当前重分片任务状态
+	pools   map[string]*ReplicaSet // 按主库DSN复用连接
+
+	cancel context.CancelFunc
+}
+
+// NewTopology 加载拓扑并打开连接，主库中的拓扑不可用时退回到配置文件中的拓扑
+func NewTopology(config *TopologyConfig) (*Topology, error) {
+	static, err := NewShardMap(config.Name, 0, config.DatabaseCount, config.TableCount, config.MasterDSNs)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Topology{
+		config:  config,
+		current: static,
+		pools:   make(map[string]*ReplicaSet),
+	}
+
+	routing := &Routing{Current: static}
+	if config.MetaDB != nil {
+		ctx := context.Background()
+		if _, err := EnsureShardMap(ctx, config.MetaDB, config.Name, config.DatabaseCount, config.TableCount, config.MasterDSNs); err != nil {
+			hlog.Warnf("Failed to bootstrap shard map of %s, using static config: %v", config.Name, err)
+		} else if loaded, err := LoadRouting(ctx, config.MetaDB, config.Name); err != nil {
+			hlog.Warnf("Failed to load shard routing of %s, using static config: %v", config.Name, err)
+		} else {
+			routing = loaded
+			if routing.Current.DatabaseCount != config.DatabaseCount || routing.Current.TableCount != config.TableCount {
+				hlog.Warnf("Shard map %s v%d (%d dbs x %d tables) overrides static config (%d dbs x %d tables)",
+					config.Name, routing.Current.Version, routing.Current.DatabaseCount, routing.Current.TableCount,
+					config.DatabaseCount, config.TableCount)
+			}
+		}
+	}
+	if err := t.apply(routing); err != nil {
+		t.Close()
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancel = cancel
+	StartReplicaHealthCheck(ctx, config.ReplicaCheckInterval, t.replicaSets)
+	if config.MetaDB != nil {
+		go t.refreshLoop(ctx)
+	}
+	return t, nil
+}
+
+// Current 当前生效的拓扑
+func (t *Topology) Current() *ShardMap {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.current
+}
+
+// Route 按strategy返回分片键对应的连接和物理分表
+// 写操作和同一请求中写过该分库之后的读操作使用主库，其余读操作使用健康的从库；
+// 重分片双写期间，写连接上附带另一侧拓扑中的目标位置
+func (t *Topology) Route(ctx context.Context, strategy Strategy, shardKey int64, tablePrefix string, write bool) (*gorm.DB, Shard, error) {
+	t.mu.RLock()
+	current, shadow := t.current, t.shadow
+	shard := current.Locate(strategy, shardKey, tablePrefix)
+	dsn := current.MasterDSNs()[shard.Database]
+	rs, exists := t.pools[dsn]
+	var shadowRS *ReplicaSet
+	var shadowTable string
+	if write && shadow != nil {
+		shadowShard := shadow.Locate(strategy, shardKey, tablePrefix)
+		shadowDSN := shadow.MasterDSNs()[shadowShard.Database]
+		if shadowDSN != dsn || shadowShard.TableName != shard.TableName {
+			shadowRS = t.pools[shadowDSN]
+			shadowTable = shadowShard.TableName
+		}
+	}
+	t.mu.RUnlock()
+
+	if !exists {
+		return nil, shard, fmt.Errorf("database db_%d of %s not found", shard.Database, t.config.Name)
+	}
+
+	db := rs.DB(ctx, write)
+	if shadowRS != nil {
+		db = WithDualWrite(db, shard.TableName, shadowRS.Master(), shadowTable)
+	}
+	return db, shard, nil
+}
+
+// Conn 当前拓扑中第dbIndex个分库的连接，读写选择规则与Route一致，不附带双写
+func (t *Topology) Conn(ctx context.Context, dbIndex int, write bool) (*gorm.DB, error) {
+	return t.connIn(ctx, t.Current(), dbIndex, write)
+}
+
+// connIn 拓扑m中第dbIndex个分库的连接，跨分片操作按同一份拓扑快照解析分表和连接，
+// 期间拓扑被刷新也不会错位；旧拓扑的连接在刷新后仍然保留
+func (t *Topology) connIn(ctx context.Context, m *ShardMap, dbIndex int, write bool) (*gorm.DB, error) {
+	dsns := m.MasterDSNs()
+	t.mu.RLock()
+	var rs *ReplicaSet
+	if dbIndex >= 0 && dbIndex < len(dsns) {
+		rs = t.pools[dsns[dbIndex]]
+	}
+	t.mu.RUnlock()
+
+	if rs == nil {
+		return nil, fmt.Errorf("database db_%d of %s not found", dbIndex, t.config.Name)
+	}
+	return rs.DB(ctx, write), nil
+}
+
+// Databases 当前拓扑中各分库的主库连接，键为db_<分库索引>
+func (t *Topology) Databases() map[string]*gorm.DB {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	result := make(map[string]*gorm.DB, t.current.DatabaseCount)
+	for i, dsn := range t.current.MasterDSNs() {
+		result[fmt.Sprintf("db_%d", i)] = t.pools[dsn].Master()
+	}
+	return result
+}
+
+// Ping 检查当前拓扑中所有分库主库的连通性
+func (t *Topology) Ping(ctx context.Context) error {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	for _, dsn := range t.current.MasterDSNs() {
+		if err := t.pools[dsn].Ping(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close 停止刷新和健康检查并关闭所有连接
+func (t *Topology) Close() {
+	if t.cancel != nil {
+		t.cancel()
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, rs := range t.pools {
+		rs.Close()
+	}
+	t.pools = make(map[string]*ReplicaSet)
+}
+
+func (t *Topology) replicaSets() []*ReplicaSet {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	sets := make([]*ReplicaSet, 0, len(t.pools))
+	for _, rs := range t.pools {
+		sets = append(sets, rs)
+	}
+	return sets
+}
+
+func (t *Topology) refreshLoop(ctx context.Context) {
+	ticker := time.NewTicker(ShardMapRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			routing, err := LoadRouting(ctx, t.config.MetaDB, t.config.Name)
+			if err != nil {
+				hlog.CtxWarnf(ctx, "Failed to refresh shard routing of %s: %v", t.config.Name, err)
+				continue
+			}
+			if err := t.apply(routing); err != nil {
+				hlog.CtxErrorf(ctx, "Failed to apply shard routing of %s: %v", t.config.Name, err)
+			}
+		}
+	}
+}
+
+// apply 切换到新的路由，先打开新拓扑需要的连接再替换
+func (t *Topology) apply(routing *Routing) error {
+	state := ""
+	if routing.Task != nil {
+		state = routing.Task.State
+	}
+
+	t.mu.RLock()
+	unchanged := t.current.Version == routing.Current.Version && len(t.pools) > 0 &&
+		versionOf(t.shadow) == versionOf(routing.Shadow) && t.state == state
+	t.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	dsns := routing.Current.MasterDSNs()
+	if routing.Shadow != nil {
+		dsns = append(append([]string{}, dsns...), routing.Shadow.MasterDSNs()...)
+	}
+	opened := make(map[string]*ReplicaSet)
+	for _, dsn := range dsns {
+		t.mu.RLock()
+		_, exists := t.pools[dsn]
+		t.mu.RUnlock()
+		if exists || opened[dsn] != nil {
+			continue
+		}
+		rs, err := t.openReplicaSet(dsn, len(t.pools)+len(opened))
+		if err != nil {
+			for _, o := range opened {
+				o.Close()
+			}
+			return err
+		}
+		opened[dsn] = rs
+	}
+
+	t.mu.Lock()
+	for dsn, rs := range opened {
+		t.pools[dsn] = rs
+	}
+	t.current = routing.Current
+	t.shadow = routing.Shadow
+	t.state = state
+	t.mu.Unlock()
+
+	if routing.Task != nil {
+		hlog.Infof("Shard routing of %s: v%d active, reshard task %d %s, dual write to v%d",
+			t.config.Name, routing.Current.Version, routing.Task.ID, state, versionOf(routing.Shadow))
+	} else {
+		hlog.Infof("Shard routing of %s: v%d active", t.config.Name, routing.Current.Version)
+	}
+	return nil
+}
+
+// openReplicaSet 打开主库连接，主库是配置文件中的分库时同时打开其从库
+func (t *Topology) openReplicaSet(dsn string, index int) (*ReplicaSet, error) {
+	name := fmt.Sprintf("%s_db_%d", t.config.Name, index)
+	master, err := t.openDB(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database connection for %s: %w", name, err)
+	}
+	if err := RegisterDualWrite(master); err != nil {
+		NewReplicaSet(name, master, nil, 0).Close()
+		return nil, fmt.Errorf("failed to register dual write for %s: %w", name, err)
+	}
+
+	var replicas []*gorm.DB
+	for i, configured := range t.config.MasterDSNs {
+		if configured != dsn || i >= len(t.config.SlaveDSNs) {
+			continue
+		}
+		for j, slaveDSN := range t.config.SlaveDSNs[i] {
+			replica, err := t.openDB(slaveDSN)
+			if err != nil {
+				NewReplicaSet(name, master, replicas, 0).Close()
+				return nil, fmt.Errorf("failed to open replica %d for %s: %w", j, name, err)
+			}
+			replicas = append(replicas, replica)
+		}
+	}
+	return NewReplicaSet(name, master, replicas, t.config.ReplicaMaxLag), nil
+}
+
+func (t *Topology) openDB(dsn string) (*gorm.DB, error) {
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql.DB: %w", err)
+	}
+
+	sqlDB.SetMaxOpenConns(t.config.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(t.config.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(t.config.ConnMaxLifetime)
+	return db, nil
+}
+
+func versionOf(m *ShardMap) int {
+	if m == nil {
+		return 0
+	}
+	return m.Version
+}